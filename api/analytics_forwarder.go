@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// analyticsForwarder sends page views to a self-hosted Plausible or Umami instance instead of the built-in store
+// A nil forwarder, or one without a provider, leaves page views to the built-in store
+type analyticsForwarder struct {
+	logger      zerolog.Logger
+	provider    string
+	instanceURL string
+	siteID      string
+	siteURL     string
+	useIP       bool
+	jobs        *lifecycle
+}
+
+// newAnalyticsForwarder returns nil unless provider is one of the supported services and the instance is configured
+// siteURL is the public URL of the site, which the provider needs to build absolute page URLs
+func newAnalyticsForwarder(provider, instanceURL, siteID, siteURL string, useIP bool, jobs *lifecycle) *analyticsForwarder {
+	logger := log.With().Str("handlerName", "analyticsForwarder").Logger()
+
+	provider = strings.ToLower(strings.TrimSpace(provider))
+	switch provider {
+	case "", "builtin":
+		return nil
+	case services.AnalyticsProviderPlausible, services.AnalyticsProviderUmami:
+	default:
+		logger.Warn().Str("provider", provider).Msg("Unknown analytics provider, using the built-in store")
+		return nil
+	}
+	if instanceURL == "" || siteID == "" || siteURL == "" {
+		logger.Warn().Str("provider", provider).Msg("ANALYTICS_PROVIDER_URL, ANALYTICS_SITE_ID and BASE_URL are required to forward analytics, using the built-in store")
+		return nil
+	}
+
+	return &analyticsForwarder{
+		logger:      logger,
+		provider:    provider,
+		instanceURL: instanceURL,
+		siteID:      siteID,
+		siteURL:     strings.TrimSuffix(siteURL, "/"),
+		useIP:       useIP,
+		jobs:        jobs,
+	}
+}
+
+// pageView forwards a page view in the background so the frontend never waits on the analytics instance
+// Sends still running at shutdown are waited for, but never retried
+// Only the UTM parameters of query are passed on, for the same reason the built-in store drops query strings
+func (f *analyticsForwarder) pageView(r *http.Request, path string, query url.Values, referrer *string) {
+	pageURL := f.siteURL + path
+	utm := url.Values{}
+	for _, name := range []string{"utm_source", "utm_medium", "utm_campaign"} {
+		if value := query.Get(name); value != "" {
+			utm.Set(name, value)
+		}
+	}
+	if len(utm) > 0 {
+		pageURL += "?" + utm.Encode()
+	}
+
+	event := services.AnalyticsEvent{
+		Name:      "pageview",
+		URL:       pageURL,
+		UserAgent: r.UserAgent(),
+	}
+	if referrer != nil {
+		event.Referrer = *referrer
+	}
+	if f.useIP {
+		event.ClientIP = clientIP(r)
+	}
+
+	f.jobs.goJob(func(work context.Context) {
+		ctx, cancel := context.WithTimeout(work, 10*time.Second)
+		defer cancel()
+		if err := services.SendAnalyticsEvent(ctx, f.provider, f.instanceURL, f.siteID, event); err != nil {
+			f.logger.Error().Err(err).Str("provider", f.provider).Msg("Failed to forward page view")
+		}
+	})
+}
@@ -0,0 +1,658 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	maxPageViewPathLength  = 2048
+	maxUTMValueLength      = 100
+	defaultAnalyticsDays   = 30
+	defaultSourcesPerEntry = 10
+	maxSourcesPerEntry     = 100
+	overviewTopContent     = 10
+)
+
+type analyticsHandler struct {
+	responder      Responder
+	logger         zerolog.Logger
+	pageViewRepo   *database.PageViewRepo
+	blogPostRepo   *database.BlogPostRepo
+	projectRepo    *database.ProjectRepo
+	socialPostRepo *database.SocialPostRepo
+	visitors       *visitorHasher
+	forwarder      *analyticsForwarder
+	geo            *geoLocator
+}
+
+func newAnalyticsHandler(pageViewRepo *database.PageViewRepo, blogPostRepo *database.BlogPostRepo, projectRepo *database.ProjectRepo, socialPostRepo *database.SocialPostRepo, visitors *visitorHasher, forwarder *analyticsForwarder, geo *geoLocator) analyticsHandler {
+	logger := log.With().Str("handlerName", "analyticsHandler").Logger()
+
+	return analyticsHandler{
+		responder:      NewResponder(logger),
+		logger:         logger,
+		pageViewRepo:   pageViewRepo,
+		blogPostRepo:   blogPostRepo,
+		projectRepo:    projectRepo,
+		socialPostRepo: socialPostRepo,
+		visitors:       visitors,
+		forwarder:      forwarder,
+		geo:            geo,
+	}
+}
+
+// PageViewRequest is the body the frontend sends for each page view
+// Path may include the query string so utm_source, utm_medium and utm_campaign can be read from it.
+// Referrer should be document.referrer, since the request's own Referer header is the site itself.
+// ContentType and ContentID identify the blog post or project the page shows, if any
+type PageViewRequest struct {
+	Path        string     `json:"path" example:"/blog/my-post?utm_source=newsletter"`
+	Referrer    *string    `json:"referrer,omitempty" example:"https://news.ycombinator.com/item?id=1"`
+	ContentType *string    `json:"contentType,omitempty" enums:"blogPost,project"`
+	ContentID   *uuid.UUID `json:"contentId,omitempty"`
+}
+
+// recordPageView stores a first-party page view, or forwards it to Plausible or Umami when one is configured
+// @Summary Record page view
+// @Description Records one page view for first-party analytics. UTM tags are read from the query string of path, then the query string and fragment are dropped; only the host of referrer is kept, and the user agent is stored as a SHA-256 hash. Unique visitors are counted with a salted hash of IP and user agent whose salt changes daily and is then deleted. When GEOIP_DB_PATH is set, only the country and region of the IP are stored. Views are rolled up into daily per-path counts in the background. When ANALYTICS_PROVIDER is plausible or umami the view is forwarded there instead and nothing is stored
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Param pageView body PageViewRequest true "Page view"
+// @Success 202 {object} map[string]string "Page view recorded"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid path or content"
+// @Failure 429 {object} api.ErrorResponse "Too Many Requests - Rate limit exceeded"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error recording page view"
+// @Router /analytics/pageview [post]
+func (h analyticsHandler) recordPageView() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			h.logger.Error().Err(err).Msg("Failed to read request body")
+			h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+			return
+		}
+
+		var request PageViewRequest
+		if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&request); err != nil {
+			h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode page view request body")
+			h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+			return
+		}
+
+		path, query, err := parsePageViewPath(request.Path)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		if (request.ContentType == nil) != (request.ContentID == nil) {
+			h.responder.WriteError(w, errs.NewBadRequestError("contentType and contentId must be given together"))
+			return
+		}
+		if request.ContentType != nil && *request.ContentType != models.ContentTypeBlogPost && *request.ContentType != models.ContentTypeProject {
+			h.responder.WriteError(w, errs.NewInvalidFieldError("contentType", "must be blogPost or project"))
+			return
+		}
+
+		if h.forwarder != nil {
+			h.forwarder.pageView(r, path, query, request.Referrer)
+			w.WriteHeader(http.StatusAccepted)
+			h.responder.WriteJSON(w, map[string]string{
+				"status":  "success",
+				"message": "page view forwarded",
+			})
+			return
+		}
+
+		now := time.Now()
+		pageView := models.PageView{
+			Path:          path,
+			ContentType:   request.ContentType,
+			ContentID:     request.ContentID,
+			Referrer:      referrerHost(request.Referrer),
+			UTMSource:     utmValue(query, "utm_source"),
+			UTMMedium:     utmValue(query, "utm_medium"),
+			UTMCampaign:   utmValue(query, "utm_campaign"),
+			UserAgentHash: hashUserAgent(r.UserAgent()),
+			VisitorHash:   h.visitors.hash(r, now),
+			DateAdded:     now,
+		}
+		if h.geo != nil {
+			pageView.Country, pageView.Region = h.geo.locate(clientIP(r))
+		}
+		if err := h.pageViewRepo.Add(&pageView); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create page view", "page_view", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "page view recorded",
+		})
+	}
+}
+
+// parsePageViewPath checks that path is a site-relative path and splits off its query string
+// Only the path is stored: query strings can carry personal data (emails, tokens) and would split one page across many rows
+func parsePageViewPath(path string) (string, url.Values, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return "", nil, errs.NewInvalidFieldError("path", "is required")
+	}
+	if len(path) > maxPageViewPathLength {
+		return "", nil, errs.NewInvalidFieldError("path", "must be at most 2048 characters")
+	}
+
+	parsed, err := url.Parse(path)
+	if err != nil || parsed.IsAbs() || parsed.Host != "" || !strings.HasPrefix(parsed.Path, "/") {
+		return "", nil, errs.NewInvalidFieldError("path", "must be a site-relative path starting with /")
+	}
+	return parsed.Path, parsed.Query(), nil
+}
+
+// utmValue returns the lowercased UTM parameter from query, or nil when it is missing or too long to be a real tag
+func utmValue(query url.Values, name string) *string {
+	value := strings.ToLower(strings.TrimSpace(query.Get(name)))
+	if value == "" || len(value) > maxUTMValueLength {
+		return nil
+	}
+	return &value
+}
+
+// referrerHost returns the lowercased host of an absolute http(s) referrer, or nil when there is none
+func referrerHost(referrer *string) *string {
+	if referrer == nil {
+		return nil
+	}
+	parsed, err := url.Parse(strings.TrimSpace(*referrer))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+		return nil
+	}
+	host := strings.ToLower(parsed.Hostname())
+	return &host
+}
+
+// hashUserAgent returns the hex SHA-256 of a user agent so browsers can be told apart without storing the string
+func hashUserAgent(userAgent string) string {
+	if userAgent == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(userAgent))
+	return hex.EncodeToString(sum[:])
+}
+
+// SourceViews is how many views came from one traffic source
+type SourceViews struct {
+	Source string `json:"source"`
+	Views  int64  `json:"views"`
+}
+
+// ContentSources lists where the views of one blog post or project came from
+type ContentSources struct {
+	ContentType string        `json:"contentType" enums:"blogPost,project"`
+	ContentID   uuid.UUID     `json:"contentId"`
+	Title       string        `json:"title,omitempty"`
+	Views       int64         `json:"views"`
+	Referrers   []SourceViews `json:"referrers"`
+	UTMSources  []SourceViews `json:"utmSources"`
+}
+
+// ReferrerReport is the top referrers and UTM sources per blog post and project over a date range
+type ReferrerReport struct {
+	From string           `json:"from" example:"2026-01-01"`
+	To   string           `json:"to" example:"2026-01-31"`
+	Data []ContentSources `json:"data"`
+}
+
+// getReferrers reports where the views of each blog post and project came from
+// @Summary Get referrers and UTM sources
+// @Description Returns the top referring hosts and utm_source values for each blog post and project over a date range, most viewed content first. Views without a referrer are counted as "(direct)". Raw page views are kept for 90 days, so older ranges come back empty
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "First day to include (YYYY-MM-DD, UTC). Defaults to 29 days before to"
+// @Param to query string false "Last day to include (YYYY-MM-DD, UTC). Defaults to today"
+// @Param contentType query string false "Only include this content type" Enums(blogPost, project)
+// @Param contentId query string false "Only include this blog post or project"
+// @Param limit query int false "Maximum referrers and UTM sources listed per entry (max 100)" default(10)
+// @Success 200 {object} ReferrerReport "Referrers and UTM sources per blog post and project"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid date range, content filter or limit"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching page views"
+// @Router /admin/analytics/referrers [get]
+func (h analyticsHandler) getReferrers() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		from, to, err := parseDateRange(r, defaultAnalyticsDays)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		filter, err := parsePageViewFilter(r, from, to)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		limit := defaultSourcesPerEntry
+		if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil || limit < 1 || limit > maxSourcesPerEntry {
+				h.responder.WriteError(w, errs.NewInvalidFieldError("limit", "must be between 1 and "+strconv.Itoa(maxSourcesPerEntry)))
+				return
+			}
+		}
+
+		referrers, err := h.pageViewRepo.CountReferrers(filter)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("count referrers", "page_views", err))
+			return
+		}
+		utmSources, err := h.pageViewRepo.CountUTMSources(filter)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("count utm sources", "page_views", err))
+			return
+		}
+
+		data, err := h.groupSources(referrers, utmSources, limit)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find content titles", "content", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, ReferrerReport{
+			From: from.Format(time.DateOnly),
+			To:   to.Format(time.DateOnly),
+			Data: data,
+		})
+	}
+}
+
+// RegionViews is the page views and unique visitors from one region of a country
+type RegionViews struct {
+	Region   string `json:"region" example:"CA"`
+	Views    int64  `json:"views"`
+	Visitors int64  `json:"visitors"`
+}
+
+// CountryViews is the page views and unique visitors from one country, broken down by region
+// Country is "" for views that couldn't be located. Views whose region is unknown are in the country totals only
+type CountryViews struct {
+	Country  string        `json:"country" example:"US"`
+	Views    int64         `json:"views"`
+	Visitors int64         `json:"visitors"`
+	Regions  []RegionViews `json:"regions"`
+}
+
+// GeoReport is the page views per country and region over a date range
+type GeoReport struct {
+	From string         `json:"from" example:"2026-01-01"`
+	To   string         `json:"to" example:"2026-01-31"`
+	Data []CountryViews `json:"data"`
+}
+
+// getGeo reports where page views came from geographically
+// @Summary Get geographic breakdown
+// @Description Returns page views and unique visitors per country (ISO 3166-1 alpha-2) and region (ISO 3166-2 subdivision code) over a date range, most viewed first. Locations are only recorded when GEOIP_DB_PATH points at a MaxMind database; views without one are counted under country "". Unique visitors are counted once per day they visited. Raw page views are kept for 90 days, so older ranges come back empty
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "First day to include (YYYY-MM-DD, UTC). Defaults to 29 days before to"
+// @Param to query string false "Last day to include (YYYY-MM-DD, UTC). Defaults to today"
+// @Param contentType query string false "Only include this content type" Enums(blogPost, project)
+// @Param contentId query string false "Only include this blog post or project"
+// @Success 200 {object} GeoReport "Page views per country and region"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid date range or content filter"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching page views"
+// @Router /admin/analytics/geo [get]
+func (h analyticsHandler) getGeo() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		from, to, err := parseDateRange(r, defaultAnalyticsDays)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		filter, err := parsePageViewFilter(r, from, to)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		counts, err := h.pageViewRepo.CountGeo(filter)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("count page views by location", "page_views", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, GeoReport{
+			From: from.Format(time.DateOnly),
+			To:   to.Format(time.DateOnly),
+			Data: groupGeo(counts),
+		})
+	}
+}
+
+// groupGeo folds per-region counts into one entry per country, most views first
+// Country visitors are summed from their regions; a visitor seen in two regions on one day is counted twice
+func groupGeo(counts []database.GeoCount) []CountryViews {
+	countries := []CountryViews{}
+	indexByCountry := map[string]int{}
+	for _, count := range counts {
+		i, ok := indexByCountry[count.Country]
+		if !ok {
+			i = len(countries)
+			indexByCountry[count.Country] = i
+			countries = append(countries, CountryViews{Country: count.Country, Regions: []RegionViews{}})
+		}
+
+		countries[i].Views += count.Views
+		countries[i].Visitors += count.Visitors
+		if count.Region != "" {
+			countries[i].Regions = append(countries[i].Regions, RegionViews{
+				Region:   count.Region,
+				Views:    count.Views,
+				Visitors: count.Visitors,
+			})
+		}
+	}
+
+	sort.SliceStable(countries, func(a, b int) bool {
+		return countries[a].Views > countries[b].Views
+	})
+	return countries
+}
+
+// parsePageViewFilter builds the filter for the inclusive day range from-to and the optional contentType and contentId query parameters
+func parsePageViewFilter(r *http.Request, from, to time.Time) (database.PageViewFilter, error) {
+	filter := database.PageViewFilter{From: from, To: to.AddDate(0, 0, 1)}
+	switch contentType := r.URL.Query().Get("contentType"); contentType {
+	case "", models.ContentTypeBlogPost, models.ContentTypeProject:
+		filter.ContentType = contentType
+	default:
+		return filter, errs.NewInvalidFieldError("contentType", "must be blogPost or project")
+	}
+	if contentIDStr := r.URL.Query().Get("contentId"); contentIDStr != "" {
+		contentID, err := uuid.Parse(contentIDStr)
+		if err != nil {
+			return filter, errs.NewInvalidFieldError("contentId", "must be a UUID")
+		}
+		filter.ContentID = &contentID
+	}
+	return filter, nil
+}
+
+// groupSources folds per-source counts, which arrive most views first, into one entry per blog post or project
+// Every view has exactly one referrer bucket, so an entry's views are the sum of its referrer counts
+func (h analyticsHandler) groupSources(referrers, utmSources []database.SourceCount, limit int) ([]ContentSources, error) {
+	byContent := map[uuid.UUID]*ContentSources{}
+	var order []uuid.UUID
+	entry := func(count database.SourceCount) *ContentSources {
+		content, ok := byContent[count.ContentID]
+		if !ok {
+			content = &ContentSources{
+				ContentType: count.ContentType,
+				ContentID:   count.ContentID,
+				Referrers:   []SourceViews{},
+				UTMSources:  []SourceViews{},
+			}
+			byContent[count.ContentID] = content
+			order = append(order, count.ContentID)
+		}
+		return content
+	}
+
+	for _, count := range referrers {
+		content := entry(count)
+		content.Views += count.Views
+		if len(content.Referrers) < limit {
+			content.Referrers = append(content.Referrers, SourceViews{Source: count.Source, Views: count.Views})
+		}
+	}
+	for _, count := range utmSources {
+		content := entry(count)
+		if len(content.UTMSources) < limit {
+			content.UTMSources = append(content.UTMSources, SourceViews{Source: count.Source, Views: count.Views})
+		}
+	}
+
+	var blogPostIDs, projectIDs []uuid.UUID
+	for _, id := range order {
+		if byContent[id].ContentType == models.ContentTypeBlogPost {
+			blogPostIDs = append(blogPostIDs, id)
+		} else {
+			projectIDs = append(projectIDs, id)
+		}
+	}
+	blogPostTitles, err := h.blogPostRepo.FindTitles(blogPostIDs)
+	if err != nil {
+		return nil, err
+	}
+	projectTitles, err := h.projectRepo.FindTitles(projectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]ContentSources, 0, len(order))
+	for _, id := range order {
+		content := byContent[id]
+		if content.ContentType == models.ContentTypeBlogPost {
+			content.Title = blogPostTitles[id]
+		} else {
+			content.Title = projectTitles[id]
+		}
+		data = append(data, *content)
+	}
+	sort.SliceStable(data, func(i, j int) bool {
+		return data[i].Views > data[j].Views
+	})
+	return data, nil
+}
+
+// DailyViews is the number of page views and unique visitors on one day
+type DailyViews struct {
+	Day      string `json:"day" example:"2026-01-31"`
+	Views    int64  `json:"views"`
+	Visitors int64  `json:"visitors"`
+}
+
+// ContentViews is the number of page views one blog post or project got
+type ContentViews struct {
+	ID    uuid.UUID `json:"id"`
+	Title string    `json:"title,omitempty"`
+	Views int64     `json:"views"`
+}
+
+// PlatformSuccess is how often sharing to one social platform worked
+// SuccessRate is Succeeded / Attempts, from 0 to 1
+type PlatformSuccess struct {
+	Platform    string  `json:"platform"`
+	Attempts    int64   `json:"attempts"`
+	Succeeded   int64   `json:"succeeded"`
+	Failed      int64   `json:"failed"`
+	SuccessRate float64 `json:"successRate"`
+}
+
+// AnalyticsOverview is everything the admin dashboard shows for a date range
+// Visitor hashes rotate daily, so TotalVisitors counts a visitor once for every day they came back
+type AnalyticsOverview struct {
+	From          string            `json:"from" example:"2026-01-01"`
+	To            string            `json:"to" example:"2026-01-31"`
+	TotalViews    int64             `json:"totalViews"`
+	TotalVisitors int64             `json:"totalVisitors"`
+	ViewsOverTime []DailyViews      `json:"viewsOverTime"`
+	TopPosts      []ContentViews    `json:"topPosts"`
+	TopProjects   []ContentViews    `json:"topProjects"`
+	SocialPosting []PlatformSuccess `json:"socialPosting"`
+}
+
+// getOverview returns the admin dashboard numbers for a date range
+// @Summary Get analytics overview
+// @Description Returns page views and unique visitors per day (every day in the range, including zeros), the 10 most viewed blog posts and projects, and per-platform social posting success rates, for one date range
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "First day to include (YYYY-MM-DD, UTC). Defaults to 29 days before to"
+// @Param to query string false "Last day to include (YYYY-MM-DD, UTC). Defaults to today"
+// @Success 200 {object} AnalyticsOverview "Dashboard numbers for the range"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid date range"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching analytics"
+// @Router /admin/analytics/overview [get]
+func (h analyticsHandler) getOverview() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		from, to, err := parseDateRange(r, defaultAnalyticsDays)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		end := to.AddDate(0, 0, 1)
+
+		overview := AnalyticsOverview{
+			From: from.Format(time.DateOnly),
+			To:   to.Format(time.DateOnly),
+		}
+
+		dailyCounts, err := h.pageViewRepo.CountDaily(from, end)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("count daily page views", "page_view_dailies", err))
+			return
+		}
+		overview.ViewsOverTime, overview.TotalViews, overview.TotalVisitors = fillDailyViews(dailyCounts, from, to)
+
+		filter := database.PageViewFilter{From: from, To: end, ContentType: models.ContentTypeBlogPost}
+		blogPostCounts, err := h.pageViewRepo.CountTopContent(filter, overviewTopContent)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("count blog post views", "page_views", err))
+			return
+		}
+		filter.ContentType = models.ContentTypeProject
+		projectCounts, err := h.pageViewRepo.CountTopContent(filter, overviewTopContent)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("count project views", "page_views", err))
+			return
+		}
+
+		overview.TopPosts, err = withTitles(blogPostCounts, h.blogPostRepo.FindTitles)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post titles", "blog_posts", err))
+			return
+		}
+		overview.TopProjects, err = withTitles(projectCounts, h.projectRepo.FindTitles)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find project titles", "projects", err))
+			return
+		}
+
+		platformCounts, err := h.socialPostRepo.CountByPlatform(from, end)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("count social posts", "social_posts", err))
+			return
+		}
+		overview.SocialPosting = make([]PlatformSuccess, 0, len(platformCounts))
+		for _, count := range platformCounts {
+			success := PlatformSuccess{
+				Platform:  count.Platform,
+				Attempts:  count.Succeeded + count.Failed,
+				Succeeded: count.Succeeded,
+				Failed:    count.Failed,
+			}
+			if success.Attempts > 0 {
+				success.SuccessRate = math.Round(float64(success.Succeeded)/float64(success.Attempts)*1000) / 1000
+			}
+			overview.SocialPosting = append(overview.SocialPosting, success)
+		}
+
+		h.responder.WriteJSON(w, overview)
+	}
+}
+
+// fillDailyViews returns one entry per day from from to to inclusive, using zero for days missing from counts,
+// along with the total views and visitors
+func fillDailyViews(counts []database.DailyCount, from, to time.Time) ([]DailyViews, int64, int64) {
+	byDay := make(map[string]database.DailyCount, len(counts))
+	for _, count := range counts {
+		byDay[count.Day.Format(time.DateOnly)] = count
+	}
+
+	var totalViews, totalVisitors int64
+	days := []DailyViews{}
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		key := day.Format(time.DateOnly)
+		count := byDay[key]
+		days = append(days, DailyViews{Day: key, Views: count.Views, Visitors: count.Visitors})
+		totalViews += count.Views
+		totalVisitors += count.Visitors
+	}
+	return days, totalViews, totalVisitors
+}
+
+// withTitles pairs view counts with titles looked up by findTitles, keeping the order of counts
+// Content deleted since it was viewed keeps its counts but has no title
+func withTitles(counts []database.ContentCount, findTitles func([]uuid.UUID) (map[uuid.UUID]string, error)) ([]ContentViews, error) {
+	ids := make([]uuid.UUID, len(counts))
+	for i, count := range counts {
+		ids[i] = count.ContentID
+	}
+	titles, err := findTitles(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]ContentViews, 0, len(counts))
+	for _, count := range counts {
+		views = append(views, ContentViews{ID: count.ContentID, Title: titles[count.ContentID], Views: count.Views})
+	}
+	return views, nil
+}
+
+// parseDateRange reads the from and to query parameters as inclusive UTC days
+// to defaults to today and from to defaultDays-1 days before to
+func parseDateRange(r *http.Request, defaultDays int) (time.Time, time.Time, error) {
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	if toStr := r.URL.Query().Get("to"); toStr != "" {
+		parsed, err := time.Parse(time.DateOnly, toStr)
+		if err != nil {
+			return time.Time{}, time.Time{}, errs.NewInvalidFieldError("to", "must be a date in YYYY-MM-DD format")
+		}
+		to = parsed
+	}
+
+	from := to.AddDate(0, 0, -(defaultDays - 1))
+	if fromStr := r.URL.Query().Get("from"); fromStr != "" {
+		parsed, err := time.Parse(time.DateOnly, fromStr)
+		if err != nil {
+			return time.Time{}, time.Time{}, errs.NewInvalidFieldError("from", "must be a date in YYYY-MM-DD format")
+		}
+		from = parsed
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, errs.NewInvalidFieldError("from", "must not be after to")
+	}
+	return from, to, nil
+}
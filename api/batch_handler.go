@@ -0,0 +1,388 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// maxBatchOperations caps how many operations a single batch may contain
+const maxBatchOperations = 100
+
+// Batch operation types
+const (
+	BatchOpCreateBlogPost = "createBlogPost"
+	BatchOpCreateProject  = "createProject"
+	BatchOpAttachTags     = "attachTags"
+)
+
+// Batch result statuses
+const (
+	BatchStatusOK      = "ok"
+	BatchStatusError   = "error"
+	BatchStatusSkipped = "skipped"
+)
+
+type batchHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+	database  database.Database
+	webhooks  *webhookDispatcher
+}
+
+func newBatchHandler(database database.Database, webhooks *webhookDispatcher) batchHandler {
+	logger := log.With().Str("handlerName", "batchHandler").Logger()
+
+	return batchHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+		database:  database,
+		webhooks:  webhooks,
+	}
+}
+
+// BatchOperation represents a single operation within a batch
+// createBlogPost uses BlogPost, createProject uses Project, and attachTags uses Tags with one of
+// BlogPostID, ProjectID or Ref (the index of an earlier create operation in the same batch)
+type BatchOperation struct {
+	Op         string           `json:"op" enums:"createBlogPost,createProject,attachTags"`
+	BlogPost   *models.BlogPost `json:"blogPost,omitempty"`
+	Project    *models.Project  `json:"project,omitempty"`
+	BlogPostID *uuid.UUID       `json:"blogPostId,omitempty"`
+	ProjectID  *uuid.UUID       `json:"projectId,omitempty"`
+	Ref        *int             `json:"ref,omitempty"`
+	Tags       []string         `json:"tags,omitempty"`
+}
+
+// BatchRequest represents a list of operations to run in one transaction
+type BatchRequest struct {
+	Operations []BatchOperation `json:"operations"`
+}
+
+// BatchResult represents the outcome of a single batch operation
+type BatchResult struct {
+	Index  int        `json:"index"`
+	Op     string     `json:"op"`
+	Status string     `json:"status" enums:"ok,error,skipped"`
+	ID     *uuid.UUID `json:"id,omitempty"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// BatchResponse reports whether the batch was committed along with per-operation results
+type BatchResponse struct {
+	Committed bool          `json:"committed"`
+	Results   []BatchResult `json:"results"`
+}
+
+// batchCreated records what a create operation produced so later attachTags operations can refer to it
+type batchCreated struct {
+	op string
+	id uuid.UUID
+}
+
+// runBatch executes a batch of create and tag operations
+// @Summary Run batch
+// @Description Runs a list of createBlogPost, createProject and attachTags operations in a single transaction. If any operation fails the whole batch is rolled back; the results show which operation failed and which were skipped. Blog posts created here are not cross-posted to social platforms
+// @Tags Batch
+// @Accept json
+// @Produce json
+// @Param batch body BatchRequest true "Operations to run"
+// @Param Idempotency-Key header string false "Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)"
+// @Success 200 {object} BatchResponse "All operations succeeded and were committed"
+// @Failure 400 {object} BatchResponse "An operation failed and the batch was rolled back"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error running batch"
+// @Router /batch [post]
+func (h batchHandler) runBatch() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			h.logger.Error().Err(err).Msg("Failed to read request body")
+			h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+			return
+		}
+
+		var request BatchRequest
+		if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&request); err != nil {
+			h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode batch request body")
+			h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+			return
+		}
+
+		if len(request.Operations) == 0 {
+			h.responder.WriteError(w, errs.NewBadRequestError("operations is required"))
+			return
+		}
+
+		if len(request.Operations) > maxBatchOperations {
+			h.responder.WriteError(w, errs.NewInvalidFieldError("operations", fmt.Sprintf("must contain at most %d operations", maxBatchOperations)))
+			return
+		}
+
+		results := make([]BatchResult, len(request.Operations))
+		for i, operation := range request.Operations {
+			results[i] = BatchResult{Index: i, Op: operation.Op, Status: BatchStatusSkipped}
+		}
+
+		var failed *batchOperationError
+		txErr := h.database.Transaction(func(tx database.Database) error {
+			created := make([]batchCreated, len(request.Operations))
+			for i, operation := range request.Operations {
+				id, err := h.runOperation(tx, operation, i, created)
+				if err != nil {
+					failed = &batchOperationError{index: i, err: err}
+					return failed
+				}
+				created[i] = batchCreated{op: operation.Op, id: id}
+				results[i].Status = BatchStatusOK
+				results[i].ID = &id
+			}
+			return nil
+		})
+
+		if txErr != nil && !errors.As(txErr, &failed) {
+			h.responder.WriteError(w, wrapDatabaseError("run batch", "batch", txErr))
+			return
+		}
+
+		if failed != nil {
+			h.logger.Warn().Err(failed.err).Int("index", failed.index).Msg("Batch operation failed, rolling back batch")
+			// Everything before the failure was rolled back along with it
+			for i := 0; i < failed.index; i++ {
+				results[i].Status = BatchStatusSkipped
+				results[i].ID = nil
+			}
+			results[failed.index].Status = BatchStatusError
+			results[failed.index].Error = failed.err.Error()
+
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.WriteHeader(http.StatusBadRequest)
+			h.responder.WriteJSON(w, BatchResponse{Committed: false, Results: results})
+			return
+		}
+
+		h.emitCreated(request.Operations, results)
+		h.responder.WriteJSON(w, BatchResponse{Committed: true, Results: results})
+	}
+}
+
+// emitCreated sends the same webhook events the single-item create endpoints do for everything the batch created
+func (h batchHandler) emitCreated(operations []BatchOperation, results []BatchResult) {
+	for i, operation := range operations {
+		switch operation.Op {
+		case BatchOpCreateBlogPost:
+			blogPost, err := h.database.BlogPostRepo().FindByID(*results[i].ID)
+			if err != nil {
+				h.logger.Error().Err(err).Msg("Failed to load created blog post for webhooks")
+				continue
+			}
+			if blogPost.Status != models.BlogPostStatusPublished {
+				continue
+			}
+			h.webhooks.emit(models.WebhookEventPostPublished, BlogPostWithTags{BlogPost: *blogPost, Tags: blogPost.Tags})
+		case BatchOpCreateProject:
+			project, err := h.database.ProjectRepo().FindByID(*results[i].ID)
+			if err != nil {
+				h.logger.Error().Err(err).Msg("Failed to load created project for webhooks")
+				continue
+			}
+			h.webhooks.emit(models.WebhookEventProjectCreated, ProjectWithTags{Project: *project, Tags: project.Tags})
+		}
+	}
+}
+
+// batchOperationError identifies which operation made the batch fail
+type batchOperationError struct {
+	index int
+	err   error
+}
+
+func (e *batchOperationError) Error() string {
+	return fmt.Sprintf("operation %d: %v", e.index, e.err)
+}
+
+// runOperation executes one batch operation against the transaction and returns the ID of the affected item
+func (h batchHandler) runOperation(tx database.Database, operation BatchOperation, index int, created []batchCreated) (uuid.UUID, error) {
+	switch operation.Op {
+	case BatchOpCreateBlogPost:
+		return addBlogPost(tx, operation.BlogPost)
+	case BatchOpCreateProject:
+		return h.createProject(tx, operation.Project)
+	case BatchOpAttachTags:
+		return h.attachTags(tx, operation, index, created)
+	default:
+		return uuid.Nil, fmt.Errorf("op must be one of: %s, %s, %s", BatchOpCreateBlogPost, BatchOpCreateProject, BatchOpAttachTags)
+	}
+}
+
+// addBlogPost creates a blog post and its tags, applying the same defaults as POST /blog-post
+// Batches and imports share it, so run it in a transaction to keep a post from being saved without its tags
+func addBlogPost(tx database.Database, blogPost *models.BlogPost) (uuid.UUID, error) {
+	if blogPost == nil {
+		return uuid.Nil, errors.New("blogPost is required")
+	}
+	if blogPost.Title == "" {
+		return uuid.Nil, errors.New("title is required")
+	}
+	if blogPost.Content == "" {
+		return uuid.Nil, errors.New("content is required")
+	}
+
+	if blogPost.DateAdded.IsZero() {
+		blogPost.DateAdded = time.Now()
+	}
+	if err := services.RenderBlogPost(blogPost); err != nil {
+		return uuid.Nil, err
+	}
+	if err := applyBlogPostStatus(blogPost, nil); err != nil {
+		return uuid.Nil, err
+	}
+
+	tags := blogPost.Tags
+	blogPost.ID = uuid.Nil
+	blogPost.Tags = nil
+
+	if err := tx.BlogPostRepo().Add(blogPost); err != nil {
+		return uuid.Nil, wrapDatabaseError("create blog post", "blog_post", err)
+	}
+
+	values := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		values = append(values, tag.Value)
+	}
+	if err := tx.BlogTagRepo().AddAll(newBlogTags(blogPost.ID, values)); err != nil {
+		return uuid.Nil, wrapDatabaseError("create blog tags", "blog_tags", err)
+	}
+
+	return blogPost.ID, nil
+}
+
+// createProject creates a project and its tags, applying the same defaults as POST /project
+func (h batchHandler) createProject(tx database.Database, project *models.Project) (uuid.UUID, error) {
+	if project == nil {
+		return uuid.Nil, errors.New("project is required")
+	}
+	if project.Title == "" {
+		return uuid.Nil, errors.New("title is required")
+	}
+
+	if project.DateAdded.IsZero() {
+		project.DateAdded = time.Now()
+	}
+
+	tags := project.Tags
+	project.ID = uuid.Nil
+	project.Tags = nil
+
+	if err := services.RenderProject(project); err != nil {
+		return uuid.Nil, err
+	}
+	if err := tx.ProjectRepo().Add(project); err != nil {
+		return uuid.Nil, wrapDatabaseError("create project", "project", err)
+	}
+
+	values := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		values = append(values, tag.Value)
+	}
+	if err := tx.ProjectTagRepo().AddAll(newProjectTags(project.ID, values)); err != nil {
+		return uuid.Nil, wrapDatabaseError("create project tags", "project_tags", err)
+	}
+
+	return project.ID, nil
+}
+
+// attachTags adds tags to an existing blog post or project, or to one created earlier in the batch
+// Tags the item already has are left alone
+func (h batchHandler) attachTags(tx database.Database, operation BatchOperation, index int, created []batchCreated) (uuid.UUID, error) {
+	targets := 0
+	for _, set := range []bool{operation.BlogPostID != nil, operation.ProjectID != nil, operation.Ref != nil} {
+		if set {
+			targets++
+		}
+	}
+	if targets != 1 {
+		return uuid.Nil, errors.New("exactly one of blogPostId, projectId or ref is required")
+	}
+	if len(operation.Tags) == 0 {
+		return uuid.Nil, errors.New("tags is required")
+	}
+
+	blogPostID, projectID := operation.BlogPostID, operation.ProjectID
+	if operation.Ref != nil {
+		ref := *operation.Ref
+		if ref < 0 || ref >= index {
+			return uuid.Nil, errors.New("ref must be the index of an earlier operation")
+		}
+		switch created[ref].op {
+		case BatchOpCreateBlogPost:
+			blogPostID = &created[ref].id
+		case BatchOpCreateProject:
+			projectID = &created[ref].id
+		default:
+			return uuid.Nil, errors.New("ref must point to a createBlogPost or createProject operation")
+		}
+	}
+
+	if blogPostID != nil {
+		if _, err := tx.BlogPostRepo().FindByID(*blogPostID); err != nil {
+			return uuid.Nil, wrapDatabaseError("find blog post", "blog_post", err)
+		}
+		if err := tx.BlogTagRepo().AddAll(newBlogTags(*blogPostID, operation.Tags)); err != nil {
+			return uuid.Nil, wrapDatabaseError("create blog tags", "blog_tags", err)
+		}
+		return *blogPostID, nil
+	}
+
+	if _, err := tx.ProjectRepo().FindByID(*projectID); err != nil {
+		return uuid.Nil, wrapDatabaseError("find project", "project", err)
+	}
+	if err := tx.ProjectTagRepo().AddAll(newProjectTags(*projectID, operation.Tags)); err != nil {
+		return uuid.Nil, wrapDatabaseError("create project tags", "project_tags", err)
+	}
+	return *projectID, nil
+}
+
+// newBlogTags builds tag rows for a blog post, trimming values and dropping blanks and duplicates
+func newBlogTags(blogPostID uuid.UUID, values []string) []models.BlogTag {
+	var tags []models.BlogTag
+	for _, value := range uniqueTagValues(values) {
+		tags = append(tags, models.BlogTag{ID: uuid.New(), BlogPostID: blogPostID, Value: value})
+	}
+	return tags
+}
+
+// newProjectTags builds tag rows for a project, trimming values and dropping blanks and duplicates
+func newProjectTags(projectID uuid.UUID, values []string) []models.ProjectTag {
+	var tags []models.ProjectTag
+	for _, value := range uniqueTagValues(values) {
+		tags = append(tags, models.ProjectTag{ID: uuid.New(), ProjectID: projectID, Value: value})
+	}
+	return tags
+}
+
+func uniqueTagValues(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var unique []string
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		unique = append(unique, value)
+	}
+	return unique
+}
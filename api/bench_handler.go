@@ -0,0 +1,350 @@
+package api
+
+import (
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultBenchRequests    = 100
+	maxBenchRequests        = 1000
+	defaultBenchConcurrency = 4
+	maxBenchConcurrency     = 32
+	defaultBenchSeed        = 50
+	maxBenchSeed            = 1000
+	defaultBenchQuery       = "benchmark"
+	// benchDetailIDs is how many existing posts and projects are fetched in turn when nothing is seeded
+	benchDetailIDs = 20
+)
+
+type benchHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+	database  database.Database
+	target    http.Handler
+}
+
+// newBenchHandler benchmarks the routes served by target, the public router, with database holding the seed data
+func newBenchHandler(database database.Database, target http.Handler) benchHandler {
+	logger := log.With().Str("handlerName", "benchHandler").Logger()
+
+	return benchHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+		database:  database,
+		target:    target,
+	}
+}
+
+// BenchEndpointReport is how one endpoint performed during a benchmark; latencies are in milliseconds
+type BenchEndpointReport struct {
+	Name              string  `json:"name" example:"blog posts page"`
+	Path              string  `json:"path" example:"/blog-posts"`
+	Requests          int     `json:"requests"`
+	Errors            int     `json:"errors"`
+	CacheHits         int     `json:"cacheHits"`
+	AvgBytes          int     `json:"avgBytes"`
+	MinMs             float64 `json:"minMs"`
+	MeanMs            float64 `json:"meanMs"`
+	P50Ms             float64 `json:"p50Ms"`
+	P90Ms             float64 `json:"p90Ms"`
+	P99Ms             float64 `json:"p99Ms"`
+	MaxMs             float64 `json:"maxMs"`
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+}
+
+// BenchReport is the result of a benchmark run
+type BenchReport struct {
+	Seeded      int                   `json:"seeded" example:"50"`
+	Requests    int                   `json:"requests" example:"100"`
+	Concurrency int                   `json:"concurrency" example:"4"`
+	DurationMs  float64               `json:"durationMs"`
+	Endpoints   []BenchEndpointReport `json:"endpoints"`
+}
+
+// benchEndpoint is an endpoint a benchmark exercises; path is called with the index of each request, so detail
+// endpoints can cycle through ids
+type benchEndpoint struct {
+	name    string
+	pattern string
+	path    func(i int) string
+}
+
+// runBench benchmarks the list, detail and search endpoints
+// @Summary Run benchmark
+// @Description Seeds published blog posts and projects, sends each list, detail and search endpoint the given number of GET requests through the router with the given concurrency, then removes the seed and reports latencies per endpoint. With seed=0 the existing content is used instead. Endpoints run one after another, and cacheHits shows how many responses came from the response cache. Benchmark requests aren't counted as views. Not available when APP_ENV=production
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param requests query int false "Requests per endpoint (default 100, at most 1000)"
+// @Param concurrency query int false "Requests in flight at once (default 4, at most 32)"
+// @Param seed query int false "Blog posts and projects to seed (default 50, at most 1000)"
+// @Param q query string false "Search query (default benchmark, which matches the seeded content)"
+// @Success 200 {object} BenchReport "Latencies per endpoint"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid requests, concurrency or seed"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error seeding content"
+// @Router /admin/bench [post]
+func (h benchHandler) runBench() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requests, err := parseBenchParam(r, "requests", defaultBenchRequests, 1, maxBenchRequests)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		concurrency, err := parseBenchParam(r, "concurrency", defaultBenchConcurrency, 1, maxBenchConcurrency)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		seedCount, err := parseBenchParam(r, "seed", defaultBenchSeed, 0, maxBenchSeed)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		query := r.URL.Query().Get("q")
+		if query == "" {
+			query = defaultBenchQuery
+		}
+
+		var blogPostIDs, projectIDs []uuid.UUID
+		if seedCount > 0 {
+			seed, err := h.database.SeedBenchmark(seedCount)
+			if err != nil {
+				h.responder.WriteError(w, errs.NewInternalErrorWithCause("failed to seed benchmark content", err))
+				return
+			}
+			defer func() {
+				if err := h.database.RemoveBenchmarkSeed(seed); err != nil {
+					h.logger.Error().Err(err).Msg("Failed to remove benchmark seed")
+				}
+			}()
+			blogPostIDs, projectIDs = seed.BlogPostIDs, seed.ProjectIDs
+		} else {
+			if blogPostIDs, err = h.existingBlogPostIDs(); err != nil {
+				h.responder.WriteError(w, wrapDatabaseError("find blog posts", "blog_posts", err))
+				return
+			}
+			if projectIDs, err = h.existingProjectIDs(); err != nil {
+				h.responder.WriteError(w, wrapDatabaseError("find projects", "projects", err))
+				return
+			}
+		}
+
+		report := BenchReport{Seeded: seedCount, Requests: requests, Concurrency: concurrency}
+		started := time.Now()
+		for _, endpoint := range benchEndpoints(blogPostIDs, projectIDs, query) {
+			if r.Context().Err() != nil {
+				break
+			}
+			report.Endpoints = append(report.Endpoints, h.run(r, endpoint, requests, concurrency))
+		}
+		report.DurationMs = milliseconds(time.Since(started))
+
+		h.logger.Info().Int("seeded", seedCount).Int("requests", requests).Int("concurrency", concurrency).Float64("durationMs", report.DurationMs).Msg("Benchmark finished")
+		h.responder.WriteJSON(w, report)
+	}
+}
+
+func parseBenchParam(r *http.Request, name string, defaultValue, minValue, maxValue int) (int, error) {
+	valueStr := r.URL.Query().Get(name)
+	if valueStr == "" {
+		return defaultValue, nil
+	}
+	value, err := strconv.Atoi(valueStr)
+	if err != nil || value < minValue || value > maxValue {
+		return 0, errs.NewInvalidFieldError(name, "must be between "+strconv.Itoa(minValue)+" and "+strconv.Itoa(maxValue))
+	}
+	return value, nil
+}
+
+func (h benchHandler) existingBlogPostIDs() ([]uuid.UUID, error) {
+	blogPosts, _, err := h.database.BlogPostRepo().FindSummaryPage(database.TagFilter{}, 0, benchDetailIDs)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, len(blogPosts))
+	for i, blogPost := range blogPosts {
+		ids[i] = blogPost.ID
+	}
+	return ids, nil
+}
+
+func (h benchHandler) existingProjectIDs() ([]uuid.UUID, error) {
+	projects, _, err := h.database.ProjectRepo().FindSummaryPage(0, benchDetailIDs)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, len(projects))
+	for i, project := range projects {
+		ids[i] = project.ID
+	}
+	return ids, nil
+}
+
+// benchEndpoints lists the endpoints to exercise; detail endpoints are left out when there's nothing to fetch
+func benchEndpoints(blogPostIDs, projectIDs []uuid.UUID, query string) []benchEndpoint {
+	fixed := func(path string) func(int) string {
+		return func(int) string { return path }
+	}
+	search := "?q=" + url.QueryEscape(query)
+
+	endpoints := []benchEndpoint{
+		{"blog posts page", "/blog-posts", fixed("/blog-posts")},
+		{"blog post summaries", "/blog-posts/summaries", fixed("/blog-posts/summaries")},
+		{"blog post archive", "/blog-posts/archive", fixed("/blog-posts/archive")},
+	}
+	if len(blogPostIDs) > 0 {
+		endpoints = append(endpoints, benchEndpoint{"blog post", "/blog-post/{blogPostID}", func(i int) string {
+			return "/blog-post/" + blogPostIDs[i%len(blogPostIDs)].String()
+		}})
+	}
+	endpoints = append(endpoints,
+		benchEndpoint{"blog post search", "/blog-posts/search", fixed("/blog-posts/search" + search)},
+		benchEndpoint{"projects page", "/projects", fixed("/projects")},
+		benchEndpoint{"project summaries", "/projects/summaries", fixed("/projects/summaries")},
+	)
+	if len(projectIDs) > 0 {
+		endpoints = append(endpoints, benchEndpoint{"project", "/project/{projectID}", func(i int) string {
+			return "/project/" + projectIDs[i%len(projectIDs)].String()
+		}})
+	}
+	return append(endpoints,
+		benchEndpoint{"project search", "/projects/search", fixed("/projects/search" + search)},
+		benchEndpoint{"search", "/search", fixed("/search" + search)},
+		benchEndpoint{"JSON feed", "/feed.json", fixed("/feed.json")},
+	)
+}
+
+// benchResult is the outcome of one benchmark request
+type benchResult struct {
+	latency  time.Duration
+	status   int
+	bytes    int
+	cacheHit bool
+}
+
+// run sends endpoint requests GETs, concurrency at a time, straight to the router
+func (h benchHandler) run(r *http.Request, endpoint benchEndpoint, requests, concurrency int) BenchEndpointReport {
+	results := make([]benchResult, requests)
+	next := make(chan int)
+	ctx := ctxWithBenchmark(r.Context())
+
+	var wg sync.WaitGroup
+	started := time.Now()
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.path(i), nil)
+				if err != nil {
+					results[i] = benchResult{status: http.StatusInternalServerError}
+					continue
+				}
+				request.Host = r.Host
+				request.RemoteAddr = r.RemoteAddr
+				request.Header.Set("Accept", "application/json")
+
+				recorder := &benchRecorder{header: make(http.Header), status: http.StatusOK}
+				requestStarted := time.Now()
+				h.target.ServeHTTP(recorder, request)
+				results[i] = benchResult{
+					latency:  time.Since(requestStarted),
+					status:   recorder.status,
+					bytes:    recorder.bytes,
+					cacheHit: recorder.header.Get("X-Cache") == "HIT",
+				}
+			}
+		}()
+	}
+	for i := range requests {
+		if ctx.Err() != nil {
+			results = results[:i]
+			break
+		}
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+
+	return summarizeBench(endpoint, results, time.Since(started))
+}
+
+// summarizeBench turns the results of an endpoint's requests into its report
+func summarizeBench(endpoint benchEndpoint, results []benchResult, elapsed time.Duration) BenchEndpointReport {
+	report := BenchEndpointReport{Name: endpoint.name, Path: endpoint.pattern, Requests: len(results)}
+	if len(results) == 0 {
+		return report
+	}
+
+	latencies := make([]time.Duration, len(results))
+	var total time.Duration
+	var bytes int
+	for i, result := range results {
+		latencies[i] = result.latency
+		total += result.latency
+		bytes += result.bytes
+		if result.status >= http.StatusBadRequest {
+			report.Errors++
+		}
+		if result.cacheHit {
+			report.CacheHits++
+		}
+	}
+	slices.Sort(latencies)
+
+	report.AvgBytes = bytes / len(results)
+	report.MinMs = milliseconds(latencies[0])
+	report.MeanMs = milliseconds(total / time.Duration(len(results)))
+	report.P50Ms = milliseconds(percentile(latencies, 0.50))
+	report.P90Ms = milliseconds(percentile(latencies, 0.90))
+	report.P99Ms = milliseconds(percentile(latencies, 0.99))
+	report.MaxMs = milliseconds(latencies[len(latencies)-1])
+	if elapsed > 0 {
+		report.RequestsPerSecond = float64(len(results)) / elapsed.Seconds()
+	}
+	return report
+}
+
+// percentile returns the latency p of sorted falls at or under, by the nearest-rank method
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(float64(len(sorted))*p)) - 1
+	return sorted[max(0, min(rank, len(sorted)-1))]
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+// benchRecorder keeps the status, size and headers of a benchmark response and throws its body away
+type benchRecorder struct {
+	header http.Header
+	status int
+	bytes  int
+}
+
+func (b *benchRecorder) Header() http.Header {
+	return b.header
+}
+
+func (b *benchRecorder) Write(data []byte) (int, error) {
+	b.bytes += len(data)
+	return len(data), nil
+}
+
+func (b *benchRecorder) WriteHeader(status int) {
+	b.status = status
+}
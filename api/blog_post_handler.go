@@ -2,9 +2,13 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"io"
+	"mime"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,21 +22,46 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	defaultBlogPostsPerPage = 50
+	maxBlogPostsPerPage     = database.MaxPageSize
+	// exportBatchSize is how many blog posts an export reads from the database at a time
+	exportBatchSize = 100
+	// maxFilterTags is how many tags a list can be filtered by at once
+	maxFilterTags = 10
+)
+
 type blogPostHandler struct {
-	responder    Responder
-	logger       zerolog.Logger
-	blogPostRepo *database.BlogPostRepo
-	blogTagRepo  *database.BlogTagRepo
+	responder       Responder
+	logger          zerolog.Logger
+	blogPostRepo    *database.BlogPostRepo
+	blogTagRepo     *database.BlogTagRepo
+	contentViewRepo *database.ContentViewRepo
+	socialPostRepo  *database.SocialPostRepo
+	snippetRepo     *database.SnippetRepo
+	shareLinks      *shareLinker
+	shortLinks      *shortLinker
+	previews        *previewLinker
+	locales         *contentLocales
+	webhooks        *webhookDispatcher
 }
 
-func newBlogPostHandler(blogPostRepo *database.BlogPostRepo, blogTagRepo *database.BlogTagRepo) blogPostHandler {
+func newBlogPostHandler(blogPostRepo *database.BlogPostRepo, blogTagRepo *database.BlogTagRepo, contentViewRepo *database.ContentViewRepo, socialPostRepo *database.SocialPostRepo, snippetRepo *database.SnippetRepo, shareLinks *shareLinker, shortLinks *shortLinker, previews *previewLinker, locales *contentLocales, webhooks *webhookDispatcher) blogPostHandler {
 	logger := log.With().Str("handlerName", "blogPostHandler").Logger()
 
 	return blogPostHandler{
-		responder:    NewResponder(logger),
-		logger:       logger,
-		blogPostRepo: blogPostRepo,
-		blogTagRepo:  blogTagRepo,
+		responder:       NewResponder(logger),
+		logger:          logger,
+		blogPostRepo:    blogPostRepo,
+		blogTagRepo:     blogTagRepo,
+		contentViewRepo: contentViewRepo,
+		socialPostRepo:  socialPostRepo,
+		snippetRepo:     snippetRepo,
+		shareLinks:      shareLinks,
+		shortLinks:      shortLinks,
+		previews:        previews,
+		locales:         locales,
+		webhooks:        webhooks,
 	}
 }
 
@@ -42,58 +71,459 @@ type BlogPostWithTags struct {
 	Tags     []models.BlogTag `json:"tags"`
 }
 
-// BlogPostCollectionWithTags represents multiple blog posts with their tags
+// BlogPostCollectionWithTags represents one page of blog posts with their tags
 type BlogPostCollectionWithTags struct {
-	BlogPosts []BlogPostWithTags `json:"blogPosts"`
-	Total     int                `json:"total,omitempty"`
+	Data  []BlogPostWithTags `json:"data"`
+	Meta  ListMeta           `json:"meta"`
+	Links ListLinks          `json:"links"`
+}
+
+// BlogPostSummary is a blog post as list views show it: everything but its content, with its tag values
+type BlogPostSummary struct {
+	ID                 uuid.UUID  `json:"id"`
+	Title              string     `json:"title"`
+	Summary            *string    `json:"summary,omitempty"`
+	DateAdded          time.Time  `json:"dateAdded"`
+	DateEdited         *time.Time `json:"dateEdited,omitempty"`
+	WordCount          int        `json:"wordCount"`
+	ReadingTimeMinutes int        `json:"readingTimeMinutes"`
+	URL                *string    `json:"url,omitempty"`
+	Tags               []string   `json:"tags"`
+}
+
+// BlogPostSummaryCollection represents one page of blog post summaries
+type BlogPostSummaryCollection struct {
+	Data  []BlogPostSummary `json:"data"`
+	Meta  ListMeta          `json:"meta"`
+	Links ListLinks         `json:"links"`
+}
+
+// BlogPostSearchResult is a blog post search match with its tags and relevance score
+// Scores above 1 are full-text matches; scores from 0 to 1 are fuzzy trigram matches
+type BlogPostSearchResult struct {
+	BlogPost models.BlogPost  `json:"blogPost"`
+	Tags     []models.BlogTag `json:"tags"`
+	Score    float64          `json:"score"`
+}
+
+// BlogPostSearchResults is the response of a blog post search
+type BlogPostSearchResults struct {
+	Query string                 `json:"query"`
+	Data  []BlogPostSearchResult `json:"data"`
+}
+
+// BlogPostStub is the minimal view of a blog post used in listings like the archive
+type BlogPostStub struct {
+	ID        uuid.UUID `json:"id"`
+	Title     string    `json:"title"`
+	DateAdded time.Time `json:"dateAdded"`
+}
+
+// BlogPostArchiveMonth holds the blog posts published in one month
+type BlogPostArchiveMonth struct {
+	Month int            `json:"month"`
+	Name  string         `json:"name"`
+	Count int            `json:"count"`
+	Posts []BlogPostStub `json:"posts"`
 }
 
-// getAllBlogPosts retrieves all blog posts with their tags
+// BlogPostArchiveYear holds the months of one year that have blog posts, newest first
+type BlogPostArchiveYear struct {
+	Year   int                    `json:"year"`
+	Count  int                    `json:"count"`
+	Months []BlogPostArchiveMonth `json:"months"`
+}
+
+// BlogPostArchive groups every blog post by year and month
+type BlogPostArchive struct {
+	Total int                   `json:"total"`
+	Years []BlogPostArchiveYear `json:"years"`
+}
+
+// getAllBlogPosts retrieves one page of blog posts with their tags
 // @Summary Get all blog posts
-// @Description Retrieves all blog posts from the database with their associated tags
+// @Description Retrieves one page of published blog posts from the database with their associated tags, newest first unless sort is given. Posts translated into the negotiated locale are served in it. Pages are numbered, or taken by cursor when cursor is given (empty for the first page): cursor pages are newest first by dateAdded and id, stay fast however deep they go and don't shift when posts are published, and give the next page's cursor as meta.nextCursor
 // @Tags Blog Posts
 // @Accept json
-// @Produce json
-// @Success 200 {object} BlogPostCollectionWithTags "List of blog posts with tags"
+// @Produce json,application/vnd.api+json
+// @Param sort query string false "Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, wordCount (snake_case spellings such as date_added work too). Not allowed with cursor"
+// @Param page query int false "Page number (starts at 1). Not allowed with cursor" default(1)
+// @Param cursor query string false "meta.nextCursor of the previous page, or empty for the first page, to page by cursor"
+// @Param perPage query int false "Blog posts per page (max 100)" default(50)
+// @Param locale query string false "Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given"
+// @Param tag query string false "Only blog posts with this tag, e.g. golang"
+// @Param tags query string false "Comma-separated tags to filter by, e.g. golang,postgres (max 10 together with tag)"
+// @Param tagMode query string false "Whether blog posts need any of the tags or all of them" Enums(any, all) default(any)
+// @Param format query string false "Whether content is served as the Markdown it's written in or rendered as sanitized HTML" Enums(markdown, html) default(markdown)
+// @Success 200 {object} BlogPostCollectionWithTags "Page of blog posts with tags"
+// @Header 200 {string} Link "links.next and links.prev as rel=\"next\" and rel=\"prev\" links, when there are such pages"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid sort, pagination, cursor, tag, locale or format parameters"
 // @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog posts"
 // @Router /blog-posts [get]
 func (h blogPostHandler) getAllBlogPosts() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Authentication handled by middleware
 
-		blogPosts, err := h.blogPostRepo.FindAll()
+		format, err := parseContentFormat(r)
 		if err != nil {
-			h.responder.WriteError(w, wrapDatabaseError("find blog posts", "blog_posts", err))
+			h.responder.WriteError(w, err)
+			return
+		}
+		blogPosts, meta, links, err := h.findBlogPostPage(r)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		if _, err := h.locales.translate(w, r, blogPosts, true); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post translations", "blog_post_translations", err))
 			return
 		}
 
-		// Convert to BlogPostWithTags format
-		var blogPostsWithTags []BlogPostWithTags
+		setLinkHeader(w, links)
+		response := BlogPostCollectionWithTags{
+			Data:  make([]BlogPostWithTags, 0, len(blogPosts)),
+			Meta:  meta,
+			Links: links,
+		}
 		for _, blogPost := range blogPosts {
-			blogPostsWithTags = append(blogPostsWithTags, BlogPostWithTags{
+			formatContent(blogPost, format)
+			response.Data = append(response.Data, BlogPostWithTags{
 				BlogPost: *blogPost,
 				Tags:     blogPost.Tags,
 			})
 		}
 
-		response := BlogPostCollectionWithTags{
-			BlogPosts: blogPostsWithTags,
-			Total:     len(blogPostsWithTags),
+		if wantsJSONAPI(r) {
+			h.responder.WriteJSONAPI(w, http.StatusOK, newBlogPostCollectionDocument(response))
+			return
 		}
 
 		h.responder.WriteJSON(w, response)
 	}
 }
 
+// findBlogPostPage finds the page of published blog posts r asks for, by cursor or by page number
+func (h blogPostHandler) findBlogPostPage(r *http.Request) ([]*models.BlogPost, ListMeta, ListLinks, error) {
+	tags, err := parseTagFilter(r)
+	if err != nil {
+		return nil, ListMeta{}, ListLinks{}, err
+	}
+	cursorPagination, byCursor, err := parseCursorPagination(r, defaultBlogPostsPerPage, maxBlogPostsPerPage)
+	if err != nil {
+		return nil, ListMeta{}, ListLinks{}, err
+	}
+	if byCursor {
+		blogPosts, next, total, err := h.blogPostRepo.FindPageBefore(tags, cursorPagination.Cursor, cursorPagination.PerPage)
+		if err != nil {
+			return nil, ListMeta{}, ListLinks{}, wrapDatabaseError("find blog posts", "blog_posts", err)
+		}
+		meta, links := newCursorListMetaAndLinks(r, cursorPagination, total, next)
+		return blogPosts, meta, links, nil
+	}
+
+	sort, err := database.ParseSort(r.URL.Query().Get("sort"), database.BlogPostSortColumns)
+	if err != nil {
+		return nil, ListMeta{}, ListLinks{}, errs.NewInvalidFieldError("sort", err.Error())
+	}
+	pagination, err := parsePagination(r, defaultBlogPostsPerPage, maxBlogPostsPerPage)
+	if err != nil {
+		return nil, ListMeta{}, ListLinks{}, err
+	}
+	blogPosts, total, err := h.blogPostRepo.FindPage(tags, pagination.Offset(), pagination.PerPage, sort...)
+	if err != nil {
+		return nil, ListMeta{}, ListLinks{}, wrapDatabaseError("find blog posts", "blog_posts", err)
+	}
+	meta, links := newListMetaAndLinks(r, pagination, total)
+	return blogPosts, meta, links, nil
+}
+
+// parseTagFilter reads the tags a list is filtered by: tag, a single one, and tags, a comma-separated list, both
+// count. tagMode all asks for content with every tag instead of any of them
+func parseTagFilter(r *http.Request) (database.TagFilter, error) {
+	query := r.URL.Query()
+	var filter database.TagFilter
+	for _, tag := range append([]string{query.Get("tag")}, strings.Split(query.Get("tags"), ",")...) {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && !slices.Contains(filter.Tags, tag) {
+			filter.Tags = append(filter.Tags, tag)
+		}
+	}
+	if len(filter.Tags) > maxFilterTags {
+		return database.TagFilter{}, errs.NewInvalidFieldError("tags", fmt.Sprintf("at most %d tags can be given", maxFilterTags))
+	}
+
+	switch mode := query.Get("tagMode"); mode {
+	case "", "any":
+	case "all":
+		filter.MatchAll = true
+	default:
+		return database.TagFilter{}, errs.NewInvalidFieldError("tagMode", "must be any or all")
+	}
+	return filter, nil
+}
+
+// Formats blog post content is served in, chosen with ?format=
+const (
+	contentFormatMarkdown = "markdown"
+	contentFormatHTML     = "html"
+)
+
+// parseContentFormat reads the format r wants blog post content in: markdown, as it's written, unless format asks
+// for html, as it's rendered
+func parseContentFormat(r *http.Request) (string, error) {
+	switch format := r.URL.Query().Get("format"); format {
+	case "", contentFormatMarkdown:
+		return contentFormatMarkdown, nil
+	case contentFormatHTML:
+		return format, nil
+	default:
+		return "", errs.NewInvalidFieldError("format", "must be markdown or html")
+	}
+}
+
+// formatContent puts blogPost's rendered content in place of its Markdown when format is html
+func formatContent(blogPost *models.BlogPost, format string) {
+	if format == contentFormatHTML {
+		blogPost.Content = blogPost.ContentHTML
+	}
+}
+
+// getBlogPostSummaries retrieves one page of blog posts without their content
+// @Summary Get blog post summaries
+// @Description Retrieves one page of published blog posts for list views such as the homepage. Content is left out and tags are plain values, so the page is much smaller than GET /blog-posts. Newest first unless sort is given. Posts translated into the negotiated locale are served in it
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Param sort query string false "Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, wordCount (snake_case spellings such as date_added work too)"
+// @Param page query int false "Page number (starts at 1)" default(1)
+// @Param perPage query int false "Blog posts per page (max 100)" default(50)
+// @Param locale query string false "Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given"
+// @Param tag query string false "Only blog posts with this tag, e.g. golang"
+// @Param tags query string false "Comma-separated tags to filter by, e.g. golang,postgres (max 10 together with tag)"
+// @Param tagMode query string false "Whether blog posts need any of the tags or all of them" Enums(any, all) default(any)
+// @Success 200 {object} BlogPostSummaryCollection "Page of blog post summaries"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid sort, pagination, tag or locale parameters"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog posts"
+// @Router /blog-posts/summaries [get]
+func (h blogPostHandler) getBlogPostSummaries() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sort, err := database.ParseSort(r.URL.Query().Get("sort"), database.BlogPostSortColumns)
+		if err != nil {
+			h.responder.WriteError(w, errs.NewInvalidFieldError("sort", err.Error()))
+			return
+		}
+
+		tagFilter, err := parseTagFilter(r)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		pagination, err := parsePagination(r, defaultBlogPostsPerPage, maxBlogPostsPerPage)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		blogPosts, total, err := h.blogPostRepo.FindSummaryPage(tagFilter, pagination.Offset(), pagination.PerPage, sort...)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog posts", "blog_posts", err))
+			return
+		}
+		if _, err := h.locales.translate(w, r, blogPosts, false); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post translations", "blog_post_translations", err))
+			return
+		}
+
+		ids := make([]uuid.UUID, 0, len(blogPosts))
+		for _, blogPost := range blogPosts {
+			ids = append(ids, blogPost.ID)
+		}
+		tags, err := h.blogTagRepo.FindValues(ids)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog tags", "blog_tags", err))
+			return
+		}
+
+		meta, links := newListMetaAndLinks(r, pagination, total)
+		response := BlogPostSummaryCollection{
+			Data:  make([]BlogPostSummary, 0, len(blogPosts)),
+			Meta:  meta,
+			Links: links,
+		}
+		for _, blogPost := range blogPosts {
+			response.Data = append(response.Data, newBlogPostSummary(blogPost, tags[blogPost.ID]))
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// newBlogPostSummary is the summary of blogPost with its tag values
+func newBlogPostSummary(blogPost *models.BlogPost, tags []string) BlogPostSummary {
+	if tags == nil {
+		tags = []string{}
+	}
+	return BlogPostSummary{
+		ID:                 blogPost.ID,
+		Title:              blogPost.Title,
+		Summary:            blogPost.Summary,
+		DateAdded:          blogPost.DateAdded,
+		DateEdited:         blogPost.DateEdited,
+		WordCount:          blogPost.WordCount,
+		ReadingTimeMinutes: blogPost.ReadingTimeMinutes,
+		URL:                blogPost.URL,
+		Tags:               tags,
+	}
+}
+
+// BlogPostExport is the streamed response of GET /admin/export/blog-posts
+type BlogPostExport struct {
+	Data  []BlogPostWithTags `json:"data"`
+	Count int                `json:"count"`
+}
+
+// exportBlogPosts streams every blog post with its full content and tags
+// @Summary Export all blog posts
+// @Description Streams every blog post, including drafts and scheduled posts, with its full content and tags, in ID order. The response is sent in chunks as posts are read, so it isn't limited in size. If an error happens partway, the response is cut off, leaving invalid JSON, so a truncated export can't pass as complete
+// @Tags Blog Posts
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} BlogPostExport "Every blog post with its tags"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog posts"
+// @Router /admin/export/blog-posts [get]
+func (h blogPostHandler) exportBlogPosts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		stream := h.responder.StreamJSONArray(w)
+		err := h.blogPostRepo.FindInBatches(exportBatchSize, func(blogPosts []*models.BlogPost) error {
+			if err := r.Context().Err(); err != nil {
+				return err
+			}
+			for _, blogPost := range blogPosts {
+				if err := stream.Write(BlogPostWithTags{BlogPost: *blogPost, Tags: blogPost.Tags}); err != nil {
+					return err
+				}
+			}
+			return stream.Flush()
+		})
+		if err != nil {
+			stream.Fail(wrapDatabaseError("export blog posts", "blog_posts", err))
+			return
+		}
+		if err := stream.Close(); err != nil {
+			h.logger.Error().Err(err).Msg("Failed to finish blog post export")
+		}
+	}
+}
+
+// searchBlogPosts searches blog posts by title and content, tolerating typos
+// @Summary Search blog posts
+// @Description Full-text search over blog posts, merged with trigram similarity matches so misspelled queries (e.g. "postgers") still find results. Full-text matches rank first.
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Param q query string true "Search query (max 200 characters)"
+// @Param limit query int false "Maximum number of results (max 50)" default(20)
+// @Success 200 {object} BlogPostSearchResults "Matching blog posts, best first"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Missing or invalid q or limit"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error searching blog posts"
+// @Router /blog-posts/search [get]
+func (h blogPostHandler) searchBlogPosts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query, limit, err := parseSearchParams(r)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		blogPosts, scores, err := h.blogPostRepo.Search(query, limit)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("search blog posts", "blog_posts", err))
+			return
+		}
+
+		response := BlogPostSearchResults{
+			Query: query,
+			Data:  make([]BlogPostSearchResult, 0, len(blogPosts)),
+		}
+		for i, blogPost := range blogPosts {
+			response.Data = append(response.Data, BlogPostSearchResult{
+				BlogPost: *blogPost,
+				Tags:     blogPost.Tags,
+				Score:    scores[i],
+			})
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// getBlogPostArchive returns every blog post grouped by year and month
+// @Summary Get blog post archive
+// @Description Returns post counts and post stubs (id, title, date) grouped by year and month, newest first, for an archive sidebar
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Success 200 {object} BlogPostArchive "Blog posts grouped by year and month"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog posts"
+// @Router /blog-posts/archive [get]
+func (h blogPostHandler) getBlogPostArchive() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPosts, err := h.blogPostRepo.FindArchiveStubs()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post archive", "blog_posts", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, newBlogPostArchive(blogPosts))
+	}
+}
+
+// newBlogPostArchive groups blog posts, which must be sorted newest first, by year and month
+func newBlogPostArchive(blogPosts []*models.BlogPost) BlogPostArchive {
+	archive := BlogPostArchive{
+		Total: len(blogPosts),
+		Years: []BlogPostArchiveYear{},
+	}
+
+	for _, blogPost := range blogPosts {
+		year, month := blogPost.DateAdded.Year(), blogPost.DateAdded.Month()
+
+		if len(archive.Years) == 0 || archive.Years[len(archive.Years)-1].Year != year {
+			archive.Years = append(archive.Years, BlogPostArchiveYear{Year: year})
+		}
+		archiveYear := &archive.Years[len(archive.Years)-1]
+
+		if len(archiveYear.Months) == 0 || archiveYear.Months[len(archiveYear.Months)-1].Month != int(month) {
+			archiveYear.Months = append(archiveYear.Months, BlogPostArchiveMonth{Month: int(month), Name: month.String()})
+		}
+		archiveMonth := &archiveYear.Months[len(archiveYear.Months)-1]
+
+		archiveYear.Count++
+		archiveMonth.Count++
+		archiveMonth.Posts = append(archiveMonth.Posts, BlogPostStub{
+			ID:        blogPost.ID,
+			Title:     blogPost.Title,
+			DateAdded: blogPost.DateAdded,
+		})
+	}
+
+	return archive
+}
+
 // getBlogPost retrieves a specific blog post by ID with its tags
 // @Summary Get blog post
-// @Description Retrieves detailed information about a specific blog post by ID with its tags
+// @Description Retrieves detailed information about a specific blog post by ID with its tags. Drafts and scheduled posts are only returned with the backend password, or a preview token from POST /blog-post/{blogPostID}/preview-link. The post's translation into the negotiated locale is served in its place when there is one, and Content-Language says which was served
 // @Tags Blog Posts
 // @Accept json
-// @Produce json
+// @Produce json,application/vnd.api+json
 // @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Param preview query string false "Preview token, which lets anyone read the draft until it expires"
+// @Param locale query string false "Locale to serve the post's translation in, e.g. pt; Accept-Language is used when not given"
+// @Param format query string false "Whether content is served as the Markdown it's written in or rendered as sanitized HTML" Enums(markdown, html) default(markdown)
 // @Success 200 {object} BlogPostWithTags "Blog post details with tags"
-// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID, locale or format"
 // @Failure 404 {object} api.ErrorResponse "Not Found - Blog post not found"
 // @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog post"
 // @Router /blog-post/{blogPostID} [get]
@@ -113,34 +543,267 @@ func (h blogPostHandler) getBlogPost() http.HandlerFunc {
 			return
 		}
 
+		format, err := parseContentFormat(r)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
 		blogPost, err := h.blogPostRepo.FindByID(blogPostID)
 		if err != nil {
 			h.responder.WriteError(w, wrapDatabaseError("find blog post", "blog_post", err))
 			return
 		}
 
-		if blogPost == nil {
-			h.responder.WriteError(w, errs.NewNotFoundError("blog post not found"))
+		if blogPost.Status != models.BlogPostStatusPublished {
+			if !h.canReadUnpublished(w, r, blogPost.ID) {
+				h.responder.WriteError(w, errs.NewNotFoundError("blog post not found").WithCode(errs.EntityCode("blog_post", errs.CodeSuffixNotFound)))
+				return
+			}
+		} else {
+			recordContentView(h.logger, h.contentViewRepo, r, models.ContentTypeBlogPost, blogPost.ID)
+		}
+
+		if _, err := h.locales.translate(w, r, []*models.BlogPost{blogPost}, true); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post translations", "blog_post_translations", err))
 			return
 		}
+		formatContent(blogPost, format)
 
 		response := BlogPostWithTags{
 			BlogPost: *blogPost,
 			Tags:     blogPost.Tags,
 		}
 
+		if wantsJSONAPI(r) {
+			h.responder.WriteJSONAPI(w, http.StatusOK, newBlogPostDocument(response))
+			return
+		}
+
 		h.responder.WriteJSON(w, response)
 	}
 }
 
+// canReadUnpublished reports whether r may read the draft or scheduled post blogPostID: admins can, and so can anyone
+// with a preview token for it. Previews are marked so they're neither cached nor indexed
+func (h blogPostHandler) canReadUnpublished(w http.ResponseWriter, r *http.Request, blogPostID uuid.UUID) bool {
+	if ctxIsAdmin(r.Context()) {
+		return true
+	}
+	if !h.previews.verify(blogPostID, r.URL.Query().Get("preview")) {
+		return false
+	}
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.Header().Set("X-Robots-Tag", "noindex")
+	return true
+}
+
+// PreviewLink is a link that opens a draft blog post without the backend password until it expires
+type PreviewLink struct {
+	URL       string    `json:"url"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// createPreviewLink signs a time-limited preview link for a blog post
+// @Summary Create blog post preview link
+// @Description Signs a link that lets anyone holding it read a draft or scheduled post, with GET /blog-post/{blogPostID} or its export.md, until it expires. Give the token to the frontend as ?preview= to render the post for reviewers. Nothing is stored, so a link can't be revoked on its own; changing PREVIEW_LINK_SECRET revokes them all
+// @Tags Blog Posts
+// @Produce json
+// @Security BearerAuth
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Param expiresIn query string false "How long the link works, as a duration such as 24h (defaults to PREVIEW_LINK_TTL, 72h unless set; at most 720h)"
+// @Success 200 {object} PreviewLink "Preview link"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID or expiresIn"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Blog post not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog post"
+// @Router /blog-post/{blogPostID}/preview-link [post]
+func (h blogPostHandler) createPreviewLink() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPostID, expiresAt, ok := h.previewExpiry(w, r)
+		if !ok {
+			return
+		}
+		token, err := h.previews.sign(blogPostID, expiresAt)
+		if err != nil {
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("failed to sign preview link", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, PreviewLink{
+			URL:       h.previews.url(r, blogPostID, token),
+			Token:     token,
+			ExpiresAt: expiresAt,
+		})
+	}
+}
+
+// createPreviewToken signs a time-limited preview token for a blog post that opens it at GET /preview/{token}
+// @Summary Create blog post preview token
+// @Description Signs a token that lets anyone holding it read a draft or scheduled post at GET /preview/{token}, without knowing the post's ID, until it expires. The token also works as ?preview= on GET /blog-post/{blogPostID} and its export.md, like one from /blog-post/{blogPostID}/preview-link. Nothing is stored, so a token can't be revoked on its own; changing PREVIEW_LINK_SECRET revokes them all
+// @Tags Blog Posts
+// @Produce json
+// @Security BearerAuth
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Param expiresIn query string false "How long the token works, as a duration such as 24h (defaults to PREVIEW_LINK_TTL, 72h unless set; at most 720h)"
+// @Success 200 {object} PreviewLink "Preview token, with the GET /preview/{token} URL"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID or expiresIn"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Blog post not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog post"
+// @Router /blog-post/{blogPostID}/preview-token [post]
+func (h blogPostHandler) createPreviewToken() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPostID, expiresAt, ok := h.previewExpiry(w, r)
+		if !ok {
+			return
+		}
+		token, err := h.previews.signWithID(blogPostID, expiresAt)
+		if err != nil {
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("failed to sign preview token", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, PreviewLink{
+			URL:       h.previews.previewURL(r, token),
+			Token:     token,
+			ExpiresAt: expiresAt,
+		})
+	}
+}
+
+// previewExpiry reads the post a preview is being signed for, which must exist, and works out when the preview
+// expires from expiresIn. It writes the error response itself and returns false when either is invalid
+func (h blogPostHandler) previewExpiry(w http.ResponseWriter, r *http.Request) (uuid.UUID, time.Time, bool) {
+	blogPostID, err := uuid.Parse(chi.URLParam(r, "blogPostID"))
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid blogPostID"))
+		return uuid.Nil, time.Time{}, false
+	}
+
+	ttl := h.previews.ttl
+	if expiresIn := r.URL.Query().Get("expiresIn"); expiresIn != "" {
+		ttl, err = time.ParseDuration(expiresIn)
+		if err != nil || ttl <= 0 || ttl > maxPreviewLinkTTL {
+			h.responder.WriteError(w, errs.NewInvalidFieldError("expiresIn", "expiresIn must be a duration such as 24h, up to 720h"))
+			return uuid.Nil, time.Time{}, false
+		}
+	}
+
+	if _, err := h.blogPostRepo.FindByID(blogPostID); err != nil {
+		h.responder.WriteError(w, wrapDatabaseError("find blog post", "blog_post", err))
+		return uuid.Nil, time.Time{}, false
+	}
+	return blogPostID, time.Now().Add(ttl).Truncate(time.Second).UTC(), true
+}
+
+// getPreview serves the blog post a preview token was signed for, whatever its status
+// @Summary Preview blog post
+// @Description Returns the draft, scheduled or published post a token from POST /blog-post/{blogPostID}/preview-token was signed for, with its tags, so reviewers can read a draft without the backend password. Previews are sent with Cache-Control private, no-store and X-Robots-Tag noindex, and aren't counted as views. Tokens that have expired, were tampered with or were signed with an old PREVIEW_LINK_SECRET are treated as unknown
+// @Tags Blog Posts
+// @Produce json
+// @Param token path string true "Preview token"
+// @Param format query string false "Whether content is served as the Markdown it's written in or rendered as sanitized HTML" Enums(markdown, html) default(markdown)
+// @Success 200 {object} BlogPostWithTags "Blog post with tags"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid format"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Unknown or expired preview token, or the post is gone"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog post"
+// @Router /preview/{token} [get]
+func (h blogPostHandler) getPreview() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		format, err := parseContentFormat(r)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		blogPostID, ok := h.previews.open(chi.URLParam(r, "token"))
+		if !ok {
+			h.responder.WriteError(w, errs.NewNotFoundError("preview not found or expired").WithCode(errs.EntityCode("preview", errs.CodeSuffixNotFound)))
+			return
+		}
+
+		blogPost, err := h.blogPostRepo.FindByID(blogPostID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post", "blog_post", err))
+			return
+		}
+		formatContent(blogPost, format)
+
+		w.Header().Set("Cache-Control", "private, no-store")
+		w.Header().Set("X-Robots-Tag", "noindex")
+		h.responder.WriteJSON(w, BlogPostWithTags{
+			BlogPost: *blogPost,
+			Tags:     blogPost.Tags,
+		})
+	}
+}
+
+// exportBlogPost returns a blog post as a markdown file with YAML frontmatter
+// @Summary Export blog post as markdown
+// @Description Returns the post as markdown with YAML frontmatter holding its title, slug, summary, tags, dates and canonical URL, ready to be dropped into a static site or kept as a backup. Snippet shortcodes on a line of their own are replaced with the snippet's code as a fenced code block. Drafts and scheduled posts are only returned with the backend password or a preview token, and are marked draft
+// @Tags Blog Posts
+// @Produce text/markdown
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Param preview query string false "Preview token from POST /blog-post/{blogPostID}/preview-link"
+// @Success 200 {string} string "Markdown file with YAML frontmatter"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Blog post not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog post"
+// @Router /blog-post/{blogPostID}/export.md [get]
+func (h blogPostHandler) exportBlogPost() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPostID, err := uuid.Parse(chi.URLParam(r, "blogPostID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid blogPostID"))
+			return
+		}
+
+		blogPost, err := h.blogPostRepo.FindByID(blogPostID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post", "blog_post", err))
+			return
+		}
+		if blogPost.Status != models.BlogPostStatusPublished && !h.canReadUnpublished(w, r, blogPost.ID) {
+			h.responder.WriteError(w, errs.NewNotFoundError("blog post not found").WithCode(errs.EntityCode("blog_post", errs.CodeSuffixNotFound)))
+			return
+		}
+
+		snippets, err := h.snippetRepo.FindByIDs(services.SnippetShortcodeIDs(blogPost.Content))
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find snippets", "snippets", err))
+			return
+		}
+
+		// Render into a buffer first so a rendering failure can still produce a JSON error
+		var buf bytes.Buffer
+		if err := services.RenderBlogPostMarkdown(*blogPost, snippets, &buf); err != nil {
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("failed to render blog post", err))
+			return
+		}
+
+		filename := services.BlogPostSlug(blogPost.Title)
+		if filename == "" {
+			filename = blogPost.ID.String()
+		}
+		w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename + ".md"}))
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			h.logger.Error().Err(err).Msg("error writing blog post markdown")
+		}
+	}
+}
+
 // createBlogPost creates a new blog post
 // @Summary Create blog post
-// @Description Creates a new blog post in the database and posts it to all configured social media platforms
+// @Description Creates a new blog post in the database. Published posts (the default) are posted to all configured social media platforms right away; status draft keeps the post hidden, and status scheduled with publishAt has the scheduler publish it at that time. Images can be given with the post, in gallery order, and at most one of them featured; the featured image is the post's main image on Substack and the other platforms
 // @Tags Blog Posts
 // @Accept json
-// @Produce json
+// @Produce json,application/vnd.api+json
 // @Param blogPost body models.BlogPost true "Blog post data"
-// @Param mainImageURL query string false "Main image URL for Substack posting"
+// @Param mainImageURL query string false "Deprecated: feature one of the post's images instead. Main image URL for Substack posting, used when the post has no featured image"
+// @Param Idempotency-Key header string false "Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)"
 // @Success 201 {object} BlogPostWithTags "Created blog post with tags"
 // @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blog post data"
 // @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating blog post"
@@ -163,13 +826,12 @@ func (h blogPostHandler) createBlogPost() http.HandlerFunc {
 			return
 		}
 
-		if blogPost.Title == "" {
-			h.responder.WriteError(w, errs.NewBadRequestError("title is required"))
+		if err := validateRequest(&blogPost); err != nil {
+			h.responder.WriteError(w, err)
 			return
 		}
-
-		if blogPost.Content == "" {
-			h.responder.WriteError(w, errs.NewBadRequestError("content is required"))
+		if err := prepareBlogPostImages(blogPost.Images); err != nil {
+			h.responder.WriteError(w, err)
 			return
 		}
 
@@ -178,84 +840,126 @@ func (h blogPostHandler) createBlogPost() http.HandlerFunc {
 			blogPost.DateAdded = time.Now()
 		}
 
-		// Calculate length if not provided
-		if blogPost.Length == 0 {
-			blogPost.Length = len(blogPost.Content)
-		}
-
-		// Extract tags before creating the blog post
-		tags := blogPost.Tags
-		blogPost.Tags = nil // Clear tags to avoid issues during creation
-
-		if err := h.blogPostRepo.Add(&blogPost); err != nil {
-			h.responder.WriteError(w, wrapDatabaseError("create blog post", "blog_post", err))
+		if err := services.RenderBlogPost(&blogPost); err != nil {
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("Failed to render blog post", err))
 			return
 		}
 
-		// Create tags if provided
-		if len(tags) > 0 {
-			for i := range tags {
-				tags[i].BlogPostID = blogPost.ID
-				if tags[i].ID == uuid.Nil {
-					tags[i].ID = uuid.New()
-				}
-				if err := h.blogTagRepo.Add(&tags[i]); err != nil {
-					h.logger.Error().Err(err).Str("tag_value", tags[i].Value).Msg("Failed to create blog tag")
-					// Continue creating other tags even if one fails
-				}
-			}
+		if err := applyBlogPostStatus(&blogPost, nil); err != nil {
+			h.responder.WriteError(w, err)
+			return
 		}
 
-		// Reload blog post to get tags
-		createdBlogPost, err := h.blogPostRepo.FindByID(blogPost.ID)
+		createdBlogPost, err := h.add(&blogPost)
 		if err != nil {
-			h.responder.WriteError(w, wrapDatabaseError("find created blog post", "blog_post", err))
+			h.responder.WriteError(w, err)
 			return
 		}
 
-		// Get mainImageURL from query parameter (optional, for Substack posting)
-		mainImageURL := r.URL.Query().Get("mainImageURL")
+		// Drafts and scheduled posts are neither cross-posted nor announced until they are published
+		if createdBlogPost.Status == models.BlogPostStatusPublished {
+			// The deprecated mainImageURL query parameter is only used for posts without a featured image
+			mainImageURL := r.URL.Query().Get("mainImageURL")
 
-		// Get platforms to post to from query parameter (optional, comma-separated)
-		// Valid values: substack, medium, twitter, linkedin
-		// If not provided, defaults to all platforms for backward compatibility
-		platformsParam := r.URL.Query().Get("platforms")
-		var platformsToPost []string
-		if platformsParam != "" {
-			platformsToPost = strings.Split(platformsParam, ",")
-			// Trim whitespace from each platform name
-			for i := range platformsToPost {
-				platformsToPost[i] = strings.TrimSpace(platformsToPost[i])
+			// Get platforms to post to from query parameter (optional, comma-separated)
+			// Valid values: substack, medium, twitter, linkedin
+			// If not provided, defaults to all platforms for backward compatibility
+			platformsParam := r.URL.Query().Get("platforms")
+			var platformsToPost []string
+			if platformsParam != "" {
+				platformsToPost = strings.Split(platformsParam, ",")
+				// Trim whitespace from each platform name
+				for i := range platformsToPost {
+					platformsToPost[i] = strings.TrimSpace(platformsToPost[i])
+				}
+				h.logger.Info().Strs("platforms", platformsToPost).Msg("Posting blog post to selected social media platforms")
+			} else {
+				// Default to all platforms for backward compatibility
+				platformsToPost = services.BlogPostPlatforms
+				h.logger.Info().Msg("Posting blog post to all social media platforms")
 			}
-			h.logger.Info().Strs("platforms", platformsToPost).Msg("Posting blog post to selected social media platforms")
-		} else {
-			// Default to all platforms for backward compatibility
-			platformsToPost = []string{"substack", "medium", "twitter", "linkedin"}
-			h.logger.Info().Msg("Posting blog post to all social media platforms")
-		}
 
-		if err := services.PostEverywhere(*createdBlogPost, createdBlogPost.Tags, mainImageURL, platformsToPost); err != nil {
-			// Log the error but don't fail the request - the blog post was created successfully
-			// The client can check logs or retry posting separately if needed
-			h.logger.Error().Err(err).Msg("Failed to post to some social media platforms, but blog post was created successfully")
+			h.crossPost(r.Context(), *createdBlogPost, mainImageURL, platformsToPost)
 		}
 
 		response := BlogPostWithTags{
 			BlogPost: *createdBlogPost,
 			Tags:     createdBlogPost.Tags,
 		}
+		if createdBlogPost.Status == models.BlogPostStatusPublished {
+			h.webhooks.emit(models.WebhookEventPostPublished, response)
+		}
+
+		if wantsJSONAPI(r) {
+			h.responder.WriteJSONAPI(w, http.StatusCreated, newBlogPostDocument(response))
+			return
+		}
 
 		w.WriteHeader(http.StatusCreated)
 		h.responder.WriteJSON(w, response)
 	}
 }
 
+// add saves blogPost, already validated and given its status, along with its tags, and returns it reloaded with
+// them. A tag that fails to save is logged and left out rather than failing the post
+func (h blogPostHandler) add(blogPost *models.BlogPost) (*models.BlogPost, error) {
+	// Extract tags before creating the blog post
+	tags := blogPost.Tags
+	blogPost.Tags = nil // Clear tags to avoid issues during creation
+
+	if err := h.blogPostRepo.Add(blogPost); err != nil {
+		return nil, wrapDatabaseError("create blog post", "blog_post", err)
+	}
+
+	for i := range tags {
+		tags[i].BlogPostID = blogPost.ID
+		if tags[i].ID == uuid.Nil {
+			tags[i].ID = uuid.New()
+		}
+		if err := h.blogTagRepo.Add(&tags[i]); err != nil {
+			h.logger.Error().Err(err).Str("tag_value", tags[i].Value).Msg("Failed to create blog tag")
+			// Continue creating other tags even if one fails
+		}
+	}
+
+	// Reload blog post to get tags
+	createdBlogPost, err := h.blogPostRepo.FindByID(blogPost.ID)
+	if err != nil {
+		return nil, wrapDatabaseError("find created blog post", "blog_post", err)
+	}
+	return createdBlogPost, nil
+}
+
+// crossPost posts a newly published blogPost to platformsToPost and records how each went, with its featured image
+// as the main image, or mainImageURL when it has none
+// Failures are logged and announced to webhooks rather than returned, since the post itself was saved
+func (h blogPostHandler) crossPost(ctx context.Context, blogPost models.BlogPost, mainImageURL string, platformsToPost []string) {
+	if featured := blogPost.FeaturedImage(); featured != nil {
+		mainImageURL = featured.URL
+	}
+	shareLinks, links := h.shareLinks.forBlogPost(blogPost, platformsToPost)
+	h.shortLinks.addToLinks(links, blogPost, platformsToPost)
+	// The post is already saved, so posts in flight finish even if the client goes away
+	results, err := services.PostEverywhere(context.WithoutCancel(ctx), blogPost, blogPost.Tags, mainImageURL, platformsToPost, links)
+	recordSocialPosts(h.logger, h.socialPostRepo, models.ContentTypeBlogPost, blogPost.ID, results, shareLinks)
+	if err != nil {
+		// The client can check logs or retry posting separately if needed
+		h.logger.Error().Err(err).Msg("Failed to post to some social media platforms, but blog post was created successfully")
+		h.webhooks.emit(models.WebhookEventSocialPostFailed, SocialPostFailure{
+			ContentType: models.ContentTypeBlogPost,
+			ContentID:   blogPost.ID,
+			Platforms:   platformsToPost,
+			Error:       err.Error(),
+		})
+	}
+}
+
 // updateBlogPost updates an existing blog post
 // @Summary Update blog post
-// @Description Updates an existing blog post in the database
+// @Description Updates an existing blog post in the database. Status and publishAt are kept when left out; changing the status to published publishes the post now, dated now unless dateAdded is given. Images are left as they are; they're changed under /blog-post/{blogPostID}/images
 // @Tags Blog Posts
 // @Accept json
-// @Produce json
+// @Produce json,application/vnd.api+json
 // @Param blogPostID path string true "Blog Post ID" format(uuid)
 // @Param blogPost body models.BlogPost true "Updated blog post data"
 // @Success 200 {object} BlogPostWithTags "Updated blog post with tags"
@@ -286,11 +990,6 @@ func (h blogPostHandler) updateBlogPost() http.HandlerFunc {
 			return
 		}
 
-		if existingBlogPost == nil {
-			h.responder.WriteError(w, errs.NewNotFoundError("blog post not found"))
-			return
-		}
-
 		bodyBytes, err := io.ReadAll(r.Body)
 		if err != nil {
 			h.logger.Error().Err(err).Msg("Failed to read request body")
@@ -305,16 +1004,37 @@ func (h blogPostHandler) updateBlogPost() http.HandlerFunc {
 			return
 		}
 
+		if err := validateRequest(&blogPost); err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
 		// Ensure ID matches
 		blogPost.ID = blogPostID
+		// Images are managed under /blog-post/{blogPostID}/images once the post exists
+		blogPost.Images = nil
 
-		// Update DateEdited
+		if err := applyBlogPostStatus(&blogPost, existingBlogPost); err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		publishing := blogPost.Status == models.BlogPostStatusPublished && existingBlogPost.Status != models.BlogPostStatusPublished
+
+		// Keep the original DateAdded if not provided, unless the post is being published now
 		now := time.Now()
+		if blogPost.DateAdded.IsZero() {
+			blogPost.DateAdded = existingBlogPost.DateAdded
+			if publishing {
+				blogPost.DateAdded = now
+			}
+		}
+
+		// Update DateEdited
 		blogPost.DateEdited = &now
 
-		// Update length if content changed
-		if blogPost.Content != "" {
-			blogPost.Length = len(blogPost.Content)
+		if err := services.RenderBlogPost(&blogPost); err != nil {
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("Failed to render blog post", err))
+			return
 		}
 
 		if err := h.blogPostRepo.Update(&blogPost); err != nil {
@@ -333,20 +1053,93 @@ func (h blogPostHandler) updateBlogPost() http.HandlerFunc {
 			BlogPost: *updatedBlogPost,
 			Tags:     updatedBlogPost.Tags,
 		}
+		if publishing {
+			h.webhooks.emit(models.WebhookEventPostPublished, response)
+		}
+
+		if wantsJSONAPI(r) {
+			h.responder.WriteJSONAPI(w, http.StatusOK, newBlogPostDocument(response))
+			return
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// applyBlogPostStatus checks blogPost's status and publish time and fills in their defaults
+// The status defaults to existing's when updating and to published when creating. Scheduled posts need a
+// publish time, kept from existing if not given; other statuses have theirs cleared
+func applyBlogPostStatus(blogPost *models.BlogPost, existing *models.BlogPost) error {
+	if blogPost.Status == "" {
+		blogPost.Status = models.BlogPostStatusPublished
+		if existing != nil {
+			blogPost.Status = existing.Status
+		}
+	}
+	if !slices.Contains(models.BlogPostStatuses, blogPost.Status) {
+		return errs.NewInvalidFieldError("status", "must be one of: "+strings.Join(models.BlogPostStatuses, ", "))
+	}
+
+	if blogPost.Status != models.BlogPostStatusScheduled {
+		blogPost.PublishAt = nil
+		return nil
+	}
+	if blogPost.PublishAt == nil && existing != nil {
+		blogPost.PublishAt = existing.PublishAt
+	}
+	if blogPost.PublishAt == nil {
+		return errs.NewInvalidFieldError("publishAt", "is required when status is scheduled")
+	}
+	return nil
+}
+
+// UnpublishedBlogPosts lists every draft and scheduled blog post
+type UnpublishedBlogPosts struct {
+	Data []BlogPostWithTags `json:"data"`
+}
+
+// getUnpublishedBlogPosts retrieves every draft and scheduled blog post
+// @Summary Get unpublished blog posts
+// @Description Retrieves every draft and scheduled blog post with its tags, scheduled posts first in publishing order, then drafts newest first
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} UnpublishedBlogPosts "Draft and scheduled blog posts"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog posts"
+// @Router /admin/blog-posts/unpublished [get]
+func (h blogPostHandler) getUnpublishedBlogPosts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPosts, err := h.blogPostRepo.FindUnpublished()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find unpublished blog posts", "blog_posts", err))
+			return
+		}
+
+		response := UnpublishedBlogPosts{Data: make([]BlogPostWithTags, 0, len(blogPosts))}
+		for _, blogPost := range blogPosts {
+			response.Data = append(response.Data, BlogPostWithTags{
+				BlogPost: *blogPost,
+				Tags:     blogPost.Tags,
+			})
+		}
 
 		h.responder.WriteJSON(w, response)
 	}
 }
 
-// deleteBlogPost deletes a blog post by ID
+// deleteBlogPost moves a blog post to the trash, or deletes it permanently, by ID
 // @Summary Delete blog post
-// @Description Deletes a blog post from the database by ID
+// @Description Moves a blog post to the trash by ID, from where POST /blog-post/{blogPostID}/restore brings it back. With permanent=true, which requires the backend password, the blog post and its tags are removed from the database instead, whether or not it's in the trash
 // @Tags Blog Posts
 // @Accept json
 // @Produce json
 // @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Param permanent query bool false "Delete permanently instead of moving to the trash" default(false)
 // @Success 200 {object} map[string]string "Success message"
-// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID or permanent"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required to delete permanently"
 // @Failure 404 {object} api.ErrorResponse "Not Found - Blog post not found"
 // @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting blog post"
 // @Router /blog-post/{blogPostID} [delete]
@@ -366,6 +1159,23 @@ func (h blogPostHandler) deleteBlogPost() http.HandlerFunc {
 			return
 		}
 
+		permanent, err := parsePermanentDelete(r)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		if permanent {
+			if err := h.blogPostRepo.DeletePermanently(blogPostID); err != nil {
+				h.responder.WriteError(w, wrapDatabaseError("delete blog post", "blog_post", err))
+				return
+			}
+			h.responder.WriteJSON(w, map[string]string{
+				"status":  "success",
+				"message": "blog post deleted permanently",
+			})
+			return
+		}
+
 		// Verify blog post exists
 		_, err = h.blogPostRepo.FindByID(blogPostID)
 		if err != nil {
@@ -380,7 +1190,237 @@ func (h blogPostHandler) deleteBlogPost() http.HandlerFunc {
 
 		h.responder.WriteJSON(w, map[string]string{
 			"status":  "success",
-			"message": "blog post deleted successfully",
+			"message": "blog post moved to the trash",
+		})
+	}
+}
+
+// restoreBlogPost takes a blog post out of the trash
+// @Summary Restore blog post
+// @Description Takes a blog post moved to the trash by DELETE /blog-post/{blogPostID} out of it again, with its tags
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Success 200 {object} BlogPostWithTags "Restored blog post"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Blog post not in the trash"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error restoring blog post"
+// @Router /blog-post/{blogPostID}/restore [post]
+func (h blogPostHandler) restoreBlogPost() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPostID, err := uuid.Parse(chi.URLParam(r, "blogPostID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid blogPostID"))
+			return
+		}
+
+		if err := h.blogPostRepo.Restore(blogPostID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("restore blog post", "blog_post", err))
+			return
+		}
+
+		blogPost, err := h.blogPostRepo.FindByID(blogPostID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post", "blog_post", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, BlogPostWithTags{
+			BlogPost: *blogPost,
+			Tags:     blogPost.Tags,
 		})
 	}
 }
+
+// duplicateBlogPost copies a blog post into a new draft
+// @Summary Duplicate blog post
+// @Description Copies a blog post's content, summary, tags, images and SEO metadata into a new draft, for reusing the format of a recurring post. Drafts and scheduled posts can be copied too, which is why this needs the backend password. The copy is titled after the original with " (copy)" after it, numbered when that's taken, e.g. "Weekly Notes (copy 2)", so its slug is one no other post has. The original's url, translations and series aren't copied. Duplicating doesn't cross-post or announce anything
+// @Tags Blog Posts
+// @Accept json
+// @Produce json,application/vnd.api+json
+// @Security BearerAuth
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Success 201 {object} BlogPostWithTags "The new draft"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Blog post not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error copying blog post"
+// @Router /blog-post/{blogPostID}/duplicate [post]
+func (h blogPostHandler) duplicateBlogPost() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPostID, err := uuid.Parse(chi.URLParam(r, "blogPostID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid blogPostID"))
+			return
+		}
+
+		original, err := h.blogPostRepo.FindByID(blogPostID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post", "blog_post", err))
+			return
+		}
+		titles, err := h.blogPostRepo.FindTakenTitles()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog posts", "blog_post", err))
+			return
+		}
+
+		blogPost := models.BlogPost{
+			Title:           copyTitle(original.Title, titles),
+			Summary:         original.Summary,
+			Content:         original.Content,
+			DateAdded:       time.Now(),
+			MetaTitle:       original.MetaTitle,
+			MetaDescription: original.MetaDescription,
+			OGImageURL:      original.OGImageURL,
+			Status:          models.BlogPostStatusDraft,
+		}
+		for _, tag := range original.Tags {
+			blogPost.Tags = append(blogPost.Tags, models.BlogTag{Value: tag.Value})
+		}
+		for _, image := range original.Images {
+			blogPost.Images = append(blogPost.Images, models.BlogPostImage{
+				URL:        image.URL,
+				AltText:    image.AltText,
+				Caption:    image.Caption,
+				Position:   image.Position,
+				IsFeatured: image.IsFeatured,
+			})
+		}
+
+		if err := services.RenderBlogPost(&blogPost); err != nil {
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("Failed to render blog post", err))
+			return
+		}
+		if err := applyBlogPostStatus(&blogPost, nil); err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		duplicate, err := h.add(&blogPost)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		h.logger.Info().Str("blogPostID", original.ID.String()).Str("duplicateID", duplicate.ID.String()).Msg("Duplicated blog post")
+
+		response := BlogPostWithTags{
+			BlogPost: *duplicate,
+			Tags:     duplicate.Tags,
+		}
+		if wantsJSONAPI(r) {
+			h.responder.WriteJSONAPI(w, http.StatusCreated, newBlogPostDocument(response))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// copyTitle returns the title a copy of the post titled title gets: title followed by "(copy)", numbered from 2
+// until neither it nor its slug is among taken. Matching slugs too keeps the copy from sharing a URL with another post
+func copyTitle(title string, taken []string) string {
+	titles := make(map[string]bool, len(taken))
+	slugs := make(map[string]bool, len(taken))
+	for _, existing := range taken {
+		titles[existing] = true
+		slugs[services.BlogPostSlug(existing)] = true
+	}
+
+	for n := 1; ; n++ {
+		candidate := title + " (copy)"
+		if n > 1 {
+			candidate = fmt.Sprintf("%s (copy %d)", title, n)
+		}
+		if !titles[candidate] && !slugs[services.BlogPostSlug(candidate)] {
+			return candidate
+		}
+	}
+}
+
+// TrashedBlogPost is a blog post in the trash, with when it was moved there
+type TrashedBlogPost struct {
+	BlogPostWithTags
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+// TrashedBlogPostCollection represents one page of the trash
+type TrashedBlogPostCollection struct {
+	Data  []TrashedBlogPost `json:"data"`
+	Meta  ListMeta          `json:"meta"`
+	Links ListLinks         `json:"links"`
+}
+
+// getTrashedBlogPosts retrieves one page of the blog posts in the trash
+// @Summary Get trashed blog posts
+// @Description Retrieves one page of the blog posts moved to the trash, most recently deleted first, with their tags
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number (starts at 1)" default(1)
+// @Param perPage query int false "Blog posts per page (max 100)" default(50)
+// @Success 200 {object} TrashedBlogPostCollection "Page of trashed blog posts"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid pagination parameters"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog posts"
+// @Router /blog-posts/trash [get]
+func (h blogPostHandler) getTrashedBlogPosts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pagination, err := parsePagination(r, defaultBlogPostsPerPage, maxBlogPostsPerPage)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		blogPosts, total, err := h.blogPostRepo.FindTrashPage(pagination.Offset(), pagination.PerPage)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find trashed blog posts", "blog_posts", err))
+			return
+		}
+
+		response := TrashedBlogPostCollection{Data: make([]TrashedBlogPost, 0, len(blogPosts))}
+		for _, blogPost := range blogPosts {
+			response.Data = append(response.Data, TrashedBlogPost{
+				BlogPostWithTags: BlogPostWithTags{BlogPost: *blogPost, Tags: blogPost.Tags},
+				DeletedAt:        blogPost.DeletedAt.Time,
+			})
+		}
+		response.Meta, response.Links = newListMetaAndLinks(r, pagination, total)
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// deleteBlogPosts deletes every blog post matching the request in one transaction
+// @Summary Bulk delete blog posts
+// @Description Moves blog posts to the trash by ID and/or filter (tag, addedBefore, addedAfter) in a single transaction. All given criteria must match and at least one is required. Requires the backend password
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body BulkDeleteRequest true "IDs and/or filter selecting the blog posts to delete"
+// @Success 200 {object} BulkDeleteResult "Summary of deleted blog posts"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Malformed or empty selection"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting blog posts"
+// @Router /blog-posts [delete]
+func (h blogPostHandler) deleteBlogPosts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter, ok := decodeBulkDelete(w, r, h.responder, h.logger)
+		if !ok {
+			return
+		}
+
+		deleted, err := h.blogPostRepo.DeleteMatching(filter)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete blog posts", "blog_posts", err))
+			return
+		}
+
+		h.logger.Info().Int("deleted", len(deleted)).Msg("Bulk deleted blog posts")
+		h.responder.WriteJSON(w, newBulkDeleteResult(filter.IDs, deleted))
+	}
+}
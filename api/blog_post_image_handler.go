@@ -0,0 +1,280 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// BlogPostImageCollection is a blog post's gallery, in order
+type BlogPostImageCollection struct {
+	Images []models.BlogPostImage `json:"images"`
+}
+
+type blogPostImageHandler struct {
+	responder         Responder
+	logger            zerolog.Logger
+	blogPostImageRepo *database.BlogPostImageRepo
+	blogPostRepo      *database.BlogPostRepo
+}
+
+func newBlogPostImageHandler(blogPostImageRepo *database.BlogPostImageRepo, blogPostRepo *database.BlogPostRepo) blogPostImageHandler {
+	logger := log.With().Str("handlerName", "blogPostImageHandler").Logger()
+
+	return blogPostImageHandler{
+		responder:         NewResponder(logger),
+		logger:            logger,
+		blogPostImageRepo: blogPostImageRepo,
+		blogPostRepo:      blogPostRepo,
+	}
+}
+
+// getBlogPostImages lists a blog post's images
+// @Summary Get blog post images
+// @Description Lists a blog post's images in gallery order, by position. The featured image, if any, is marked isFeatured. Only admins can see the images of drafts and scheduled posts
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Success 200 {object} BlogPostImageCollection "Blog post images"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Blog post not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching images"
+// @Router /blog-post/{blogPostID}/images [get]
+func (h blogPostImageHandler) getBlogPostImages() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPost, ok := h.findBlogPost(w, r)
+		if !ok {
+			return
+		}
+		if blogPost.Status != models.BlogPostStatusPublished && !ctxIsAdmin(r.Context()) {
+			h.responder.WriteError(w, errs.NewNotFoundError("blog post not found").WithCode(errs.EntityCode("blog_post", errs.CodeSuffixNotFound)))
+			return
+		}
+
+		images, err := h.blogPostImageRepo.FindForBlogPost(blogPost.ID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post images", "blog_post_images", err))
+			return
+		}
+
+		response := BlogPostImageCollection{Images: make([]models.BlogPostImage, 0, len(images))}
+		for _, image := range images {
+			response.Images = append(response.Images, *image)
+		}
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// createBlogPostImage adds an image to a blog post's gallery
+// @Summary Add blog post image
+// @Description Adds an image to a blog post's gallery, after its last image unless a position is given. Featuring it makes it the post's main image in feeds, link previews and cross-posts, in place of the post's previous featured image
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Param image body models.BlogPostImage true "Image data"
+// @Success 201 {object} models.BlogPostImage "Created image"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid image data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Blog post not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating image"
+// @Router /blog-post/{blogPostID}/images [post]
+func (h blogPostImageHandler) createBlogPostImage() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPost, ok := h.findBlogPost(w, r)
+		if !ok {
+			return
+		}
+
+		image, ok := h.decodeBlogPostImage(w, r)
+		if !ok {
+			return
+		}
+		image.ID = uuid.Nil
+		image.BlogPostID = blogPost.ID
+
+		if err := h.blogPostImageRepo.Add(image); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create blog post image", "blog_post_image", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, image)
+	}
+}
+
+// updateBlogPostImage updates one of a blog post's images
+// @Summary Update blog post image
+// @Description Replaces an image's URL, alt text, caption, position and whether it's featured. Featuring it takes over from the post's previous featured image
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Param imageID path string true "Image ID" format(uuid)
+// @Param image body models.BlogPostImage true "Updated image data"
+// @Success 200 {object} models.BlogPostImage "Updated image"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid image data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Blog post or image not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error updating image"
+// @Router /blog-post/{blogPostID}/image/{imageID} [put]
+func (h blogPostImageHandler) updateBlogPostImage() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		existing, ok := h.findBlogPostImage(w, r)
+		if !ok {
+			return
+		}
+
+		image, ok := h.decodeBlogPostImage(w, r)
+		if !ok {
+			return
+		}
+		image.ID = existing.ID
+		image.BlogPostID = existing.BlogPostID
+		image.DateAdded = existing.DateAdded
+
+		if err := h.blogPostImageRepo.Update(image); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update blog post image", "blog_post_image", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, image)
+	}
+}
+
+// deleteBlogPostImage removes an image from a blog post's gallery
+// @Summary Delete blog post image
+// @Description Removes an image from a blog post's gallery. The other images keep their positions, and a post whose featured image is deleted has none until another is featured
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Param imageID path string true "Image ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID or imageID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Image not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting image"
+// @Router /blog-post/{blogPostID}/image/{imageID} [delete]
+func (h blogPostImageHandler) deleteBlogPostImage() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPostID, err := uuid.Parse(chi.URLParam(r, "blogPostID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid blogPostID"))
+			return
+		}
+		imageID, err := uuid.Parse(chi.URLParam(r, "imageID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid imageID"))
+			return
+		}
+
+		deleted, err := h.blogPostImageRepo.Delete(blogPostID, imageID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete blog post image", "blog_post_image", err))
+			return
+		}
+		if !deleted {
+			h.responder.WriteError(w, errs.NewNotFoundError("image not found").WithCode(errs.EntityCode("blog_post_image", errs.CodeSuffixNotFound)))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "image deleted successfully",
+		})
+	}
+}
+
+// findBlogPost finds the blog post named by the blogPostID path parameter, writing the error response itself and
+// returning false when it's invalid or there's no such post
+func (h blogPostImageHandler) findBlogPost(w http.ResponseWriter, r *http.Request) (*models.BlogPost, bool) {
+	blogPostID, err := uuid.Parse(chi.URLParam(r, "blogPostID"))
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid blogPostID"))
+		return nil, false
+	}
+
+	blogPost, err := h.blogPostRepo.FindByID(blogPostID)
+	if err != nil {
+		h.responder.WriteError(w, wrapDatabaseError("find blog post", "blog_post", err))
+		return nil, false
+	}
+	return blogPost, true
+}
+
+// findBlogPostImage finds the image named by the blogPostID and imageID path parameters, writing the error response
+// itself and returning false when either is invalid or there's no such image
+func (h blogPostImageHandler) findBlogPostImage(w http.ResponseWriter, r *http.Request) (*models.BlogPostImage, bool) {
+	blogPostID, err := uuid.Parse(chi.URLParam(r, "blogPostID"))
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid blogPostID"))
+		return nil, false
+	}
+	imageID, err := uuid.Parse(chi.URLParam(r, "imageID"))
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid imageID"))
+		return nil, false
+	}
+
+	image, err := h.blogPostImageRepo.FindByID(blogPostID, imageID)
+	if err != nil {
+		h.responder.WriteError(w, wrapDatabaseError("find blog post image", "blog_post_image", err))
+		return nil, false
+	}
+	return image, true
+}
+
+// decodeBlogPostImage reads and validates an image from the request body
+// It writes the error response itself and returns false when the body is invalid
+func (h blogPostImageHandler) decodeBlogPostImage(w http.ResponseWriter, r *http.Request) (*models.BlogPostImage, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var image models.BlogPostImage
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&image); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode blog post image request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	image.URL = strings.TrimSpace(image.URL)
+	image.AltText = strings.TrimSpace(image.AltText)
+
+	if err := validateRequest(&image); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+	return &image, true
+}
+
+// prepareBlogPostImages checks the images a blog post is created with, which are already validated, and places
+// those without a position in the order they were given
+func prepareBlogPostImages(images []models.BlogPostImage) error {
+	featured := 0
+	for i := range images {
+		images[i].ID = uuid.Nil
+		if images[i].Position == 0 {
+			images[i].Position = i + 1
+		}
+		if images[i].IsFeatured {
+			featured++
+		}
+	}
+	if featured > 1 {
+		return errs.NewInvalidFieldError("images", "can have at most one featured image")
+	}
+	return nil
+}
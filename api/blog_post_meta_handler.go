@@ -0,0 +1,160 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// PageMeta is what a page's head needs for search engines and link previews
+// OpenGraph and Twitter are the meta tags to render as they are, in order
+type PageMeta struct {
+	Title        string    `json:"title" example:"Building a Personal Site Backend in Go"`
+	Description  string    `json:"description,omitempty"`
+	CanonicalURL string    `json:"canonicalUrl,omitempty" example:"https://example.com/blog/personal-site-backend"`
+	Image        string    `json:"image,omitempty"`
+	OpenGraph    []MetaTag `json:"openGraph"`
+	Twitter      []MetaTag `json:"twitter"`
+}
+
+// MetaTag is one meta tag: OpenGraph tags are set by property and Twitter card tags by name
+type MetaTag struct {
+	Property string `json:"property,omitempty" example:"og:title"`
+	Name     string `json:"name,omitempty" example:"twitter:title"`
+	Content  string `json:"content"`
+}
+
+type blogPostMetaHandler struct {
+	responder    Responder
+	logger       zerolog.Logger
+	blogPostRepo *database.BlogPostRepo
+	locales      *contentLocales
+	siteName     string
+	twitterSite  string
+}
+
+func newBlogPostMetaHandler(blogPostRepo *database.BlogPostRepo, locales *contentLocales, siteName, twitterSite string) blogPostMetaHandler {
+	logger := log.With().Str("handlerName", "blogPostMetaHandler").Logger()
+
+	return blogPostMetaHandler{
+		responder:    NewResponder(logger),
+		logger:       logger,
+		blogPostRepo: blogPostRepo,
+		locales:      locales,
+		siteName:     siteName,
+		twitterSite:  twitterSite,
+	}
+}
+
+// getBlogPostMeta returns the SEO metadata of a published blog post
+// @Summary Get blog post metadata
+// @Description Returns a published blog post's title, description, canonical URL and image for search engines, along with the OpenGraph and Twitter card meta tags for link previews, ready to render. The post's metaTitle, metaDescription and ogImageUrl are used when set, and its title, summary and featured image, or first image in its content, otherwise. The canonical URL is the post's url, or BASE_URL/blog/{id}. Posts translated into the negotiated locale are described in it, by their translated title and summary, with the canonical URL of the translation
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Param locale query string false "Locale to describe a translated post in, e.g. pt; Accept-Language is used when not given"
+// @Success 200 {object} PageMeta "Blog post metadata"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID or locale"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Blog post not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog post"
+// @Router /blog-post/{blogPostID}/meta [get]
+func (h blogPostMetaHandler) getBlogPostMeta() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPostID, err := uuid.Parse(chi.URLParam(r, "blogPostID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid blogPostID"))
+			return
+		}
+
+		blogPost, err := h.blogPostRepo.FindByID(blogPostID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post", "blog_post", err))
+			return
+		}
+		if blogPost.Status != models.BlogPostStatusPublished {
+			h.responder.WriteError(w, errs.NewNotFoundError("blog post not found").WithCode(errs.EntityCode("blog_post", errs.CodeSuffixNotFound)))
+			return
+		}
+
+		translated, err := h.locales.translate(w, r, []*models.BlogPost{blogPost}, true)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post translations", "blog_post_translations", err))
+			return
+		}
+		canonicalURL := services.BlogPostLink(*blogPost, "")
+		if translated[blogPost.ID] {
+			canonicalURL = h.locales.localizedURL(canonicalURL, h.locales.locale(r))
+		}
+
+		h.responder.WriteJSON(w, h.blogPostMeta(blogPost, canonicalURL))
+	}
+}
+
+// blogPostMeta describes blogPost, which lives at canonicalURL
+func (h blogPostMetaHandler) blogPostMeta(blogPost *models.BlogPost, canonicalURL string) PageMeta {
+	meta := PageMeta{
+		Title:        blogPost.Title,
+		CanonicalURL: canonicalURL,
+		Image:        firstImageURL(blogPost.Content),
+	}
+	if blogPost.MetaTitle != nil && *blogPost.MetaTitle != "" {
+		meta.Title = *blogPost.MetaTitle
+	}
+	if blogPost.Summary != nil {
+		meta.Description = *blogPost.Summary
+	}
+	if blogPost.MetaDescription != nil && *blogPost.MetaDescription != "" {
+		meta.Description = *blogPost.MetaDescription
+	}
+	if featured := blogPost.FeaturedImage(); featured != nil {
+		meta.Image = featured.URL
+	}
+	if blogPost.OGImageURL != nil && *blogPost.OGImageURL != "" {
+		meta.Image = *blogPost.OGImageURL
+	}
+
+	openGraph := []MetaTag{{Property: "og:type", Content: "article"}, {Property: "og:title", Content: meta.Title}}
+	twitterCard := "summary"
+	if meta.Image != "" {
+		twitterCard = "summary_large_image"
+	}
+	twitter := []MetaTag{{Name: "twitter:card", Content: twitterCard}, {Name: "twitter:title", Content: meta.Title}}
+	if meta.Description != "" {
+		openGraph = append(openGraph, MetaTag{Property: "og:description", Content: meta.Description})
+		twitter = append(twitter, MetaTag{Name: "twitter:description", Content: meta.Description})
+	}
+	if meta.CanonicalURL != "" {
+		openGraph = append(openGraph, MetaTag{Property: "og:url", Content: meta.CanonicalURL})
+	}
+	if meta.Image != "" {
+		openGraph = append(openGraph, MetaTag{Property: "og:image", Content: meta.Image})
+		twitter = append(twitter, MetaTag{Name: "twitter:image", Content: meta.Image})
+	}
+	if h.siteName != "" {
+		openGraph = append(openGraph, MetaTag{Property: "og:site_name", Content: h.siteName})
+	}
+	if h.twitterSite != "" {
+		twitter = append(twitter, MetaTag{Name: "twitter:site", Content: h.twitterSite})
+	}
+
+	openGraph = append(openGraph, MetaTag{Property: "article:published_time", Content: blogPost.DateAdded.UTC().Format(time.RFC3339)})
+	if blogPost.DateEdited != nil {
+		openGraph = append(openGraph, MetaTag{Property: "article:modified_time", Content: blogPost.DateEdited.UTC().Format(time.RFC3339)})
+	}
+	for _, tag := range blogPost.Tags {
+		openGraph = append(openGraph, MetaTag{Property: "article:tag", Content: tag.Value})
+	}
+
+	meta.OpenGraph = openGraph
+	meta.Twitter = twitter
+	return meta
+}
@@ -0,0 +1,226 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"gorm.io/gorm"
+)
+
+// BlogPostTranslations lists the locales a blog post can be read in: DefaultLocale, which it's written in, and those
+// of its translations
+type BlogPostTranslations struct {
+	DefaultLocale string                       `json:"defaultLocale" example:"en"`
+	Data          []models.BlogPostTranslation `json:"data"`
+}
+
+// getBlogPostTranslations lists a blog post's translations
+// @Summary Get blog post translations
+// @Description Lists a blog post's translations, ordered by locale, e.g. to link to each with hreflang. Those of drafts and scheduled posts are only returned with the backend password or a preview token
+// @Tags Blog Posts
+// @Produce json
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Param preview query string false "Preview token from POST /blog-post/{blogPostID}/preview-link"
+// @Success 200 {object} BlogPostTranslations "The post's translations"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Blog post not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching translations"
+// @Router /blog-post/{blogPostID}/translations [get]
+func (h blogPostHandler) getBlogPostTranslations() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPostID, err := uuid.Parse(chi.URLParam(r, "blogPostID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid blogPostID"))
+			return
+		}
+
+		blogPost, err := h.blogPostRepo.FindByID(blogPostID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post", "blog_post", err))
+			return
+		}
+		if blogPost.Status != models.BlogPostStatusPublished && !h.canReadUnpublished(w, r, blogPost.ID) {
+			h.responder.WriteError(w, errs.NewNotFoundError("blog post not found").WithCode(errs.EntityCode("blog_post", errs.CodeSuffixNotFound)))
+			return
+		}
+
+		translations, err := h.locales.blogPostTranslationRepo.FindForBlogPost(blogPostID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post translations", "blog_post_translations", err))
+			return
+		}
+
+		response := BlogPostTranslations{
+			DefaultLocale: h.locales.defaultLocale(),
+			Data:          make([]models.BlogPostTranslation, 0, len(translations)),
+		}
+		for _, translation := range translations {
+			response.Data = append(response.Data, *translation)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// putBlogPostTranslation adds or replaces a blog post's translation into one locale
+// @Summary Put blog post translation
+// @Description Adds the blog post's translation into locale, or replaces the one it has. The locale must be one of CONTENT_LOCALES other than the first, which posts are written in. Tags, dates and status are the post's own
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Param locale path string true "Locale, e.g. pt"
+// @Param translation body models.BlogPostTranslation true "Translated title, summary and content"
+// @Success 200 {object} models.BlogPostTranslation "Replaced translation"
+// @Success 201 {object} models.BlogPostTranslation "Added translation"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID, locale or translation data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Blog post not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error saving translation"
+// @Router /blog-post/{blogPostID}/translation/{locale} [put]
+func (h blogPostHandler) putBlogPostTranslation() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPostID, locale, ok := h.parseTranslationPath(w, r)
+		if !ok {
+			return
+		}
+
+		if _, err := h.blogPostRepo.FindByID(blogPostID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post", "blog_post", err))
+			return
+		}
+
+		translation, ok := h.decodeBlogPostTranslation(w, r)
+		if !ok {
+			return
+		}
+
+		now := time.Now()
+		translation.ID = uuid.Nil
+		translation.BlogPostID = blogPostID
+		translation.Locale = locale
+		translation.DateAdded = now
+		translation.DateEdited = nil
+		contentHTML, err := services.RenderMarkdownHTML(translation.Content)
+		if err != nil {
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("Failed to render translation", err))
+			return
+		}
+		translation.ContentHTML = contentHTML
+
+		status := http.StatusCreated
+		existing, err := h.locales.blogPostTranslationRepo.FindByLocale(blogPostID, locale)
+		switch {
+		case err == nil:
+			status = http.StatusOK
+			translation.DateAdded = existing.DateAdded
+			translation.DateEdited = &now
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			h.responder.WriteError(w, wrapDatabaseError("find blog post translation", "blog_post_translation", err))
+			return
+		}
+
+		if err := h.locales.blogPostTranslationRepo.Save(translation); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("save blog post translation", "blog_post_translation", err))
+			return
+		}
+
+		w.WriteHeader(status)
+		h.responder.WriteJSON(w, translation)
+	}
+}
+
+// deleteBlogPostTranslation deletes a blog post's translation into one locale
+// @Summary Delete blog post translation
+// @Description Deletes the blog post's translation into locale, so the post is served as written in that locale
+// @Tags Blog Posts
+// @Produce json
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Param locale path string true "Locale, e.g. pt"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID or locale"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Translation not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting translation"
+// @Router /blog-post/{blogPostID}/translation/{locale} [delete]
+func (h blogPostHandler) deleteBlogPostTranslation() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPostID, locale, ok := h.parseTranslationPath(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify translation exists
+		if _, err := h.locales.blogPostTranslationRepo.FindByLocale(blogPostID, locale); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post translation", "blog_post_translation", err))
+			return
+		}
+
+		if err := h.locales.blogPostTranslationRepo.Delete(blogPostID, locale); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete blog post translation", "blog_post_translation", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "blog post translation deleted successfully",
+		})
+	}
+}
+
+// parseTranslationPath reads the blogPostID and locale path parameters, writing a 400 if either is invalid or the
+// locale isn't one posts are translated into
+func (h blogPostHandler) parseTranslationPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, string, bool) {
+	blogPostID, err := uuid.Parse(chi.URLParam(r, "blogPostID"))
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid blogPostID"))
+		return uuid.Nil, "", false
+	}
+
+	locale, ok := h.locales.translationLocale(chi.URLParam(r, "locale"))
+	if !ok {
+		translatable := "none are configured; set CONTENT_LOCALES"
+		if len(h.locales.locales) > 1 {
+			translatable = strings.Join(h.locales.locales[1:], ", ")
+		}
+		h.responder.WriteError(w, errs.NewInvalidFieldError("locale", "posts can only be translated into "+translatable))
+		return uuid.Nil, "", false
+	}
+
+	return blogPostID, locale, true
+}
+
+// decodeBlogPostTranslation reads and validates a translation from the request body
+// It writes the error response itself and returns false when the body is invalid
+func (h blogPostHandler) decodeBlogPostTranslation(w http.ResponseWriter, r *http.Request) (*models.BlogPostTranslation, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var translation models.BlogPostTranslation
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&translation); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode blog post translation request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	translation.Title = strings.TrimSpace(translation.Title)
+
+	if err := validateRequest(&translation); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+
+	return &translation, true
+}
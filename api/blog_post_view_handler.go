@@ -0,0 +1,215 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// defaultBlogPostViewWindow is how long a visitor's view of a post keeps their next ones from being counted
+	defaultBlogPostViewWindow = 30 * time.Minute
+
+	defaultPopularPeriod = "7d"
+	// maxPopularPeriod is how far back GET /blog-posts/popular can count views
+	maxPopularPeriod    = 365 * 24 * time.Hour
+	defaultPopularLimit = 10
+	maxPopularLimit     = 50
+)
+
+// BlogPostViewResponse is the view count of a blog post after a view was recorded
+type BlogPostViewResponse struct {
+	// Counted is false when the visitor's view had already been counted within the window
+	Counted bool  `json:"counted"`
+	Views   int64 `json:"views"`
+}
+
+// PopularBlogPost is a blog post summary with how many views it got in the period asked for
+type PopularBlogPost struct {
+	BlogPostSummary
+	Views int64 `json:"views"`
+}
+
+// PopularBlogPostCollection lists the most viewed blog posts in a period, most viewed first
+type PopularBlogPostCollection struct {
+	Period string            `json:"period" example:"7d"`
+	Data   []PopularBlogPost `json:"data"`
+}
+
+type blogPostViewHandler struct {
+	responder        Responder
+	logger           zerolog.Logger
+	blogPostViewRepo *database.BlogPostViewRepo
+	blogPostRepo     *database.BlogPostRepo
+	blogTagRepo      *database.BlogTagRepo
+	visitors         *visitorHasher
+	locales          *contentLocales
+	window           time.Duration
+}
+
+func newBlogPostViewHandler(blogPostViewRepo *database.BlogPostViewRepo, blogPostRepo *database.BlogPostRepo, blogTagRepo *database.BlogTagRepo, visitors *visitorHasher, locales *contentLocales, window time.Duration) blogPostViewHandler {
+	logger := log.With().Str("handlerName", "blogPostViewHandler").Logger()
+
+	return blogPostViewHandler{
+		responder:        NewResponder(logger),
+		logger:           logger,
+		blogPostViewRepo: blogPostViewRepo,
+		blogPostRepo:     blogPostRepo,
+		blogTagRepo:      blogTagRepo,
+		visitors:         visitors,
+		locales:          locales,
+		window:           window,
+	}
+}
+
+// recordBlogPostView counts a view of a published blog post, once per visitor within the window
+// @Summary Record blog post view
+// @Description Counts a view of a published blog post for GET /blog-posts/popular. Visitors are told apart by the same daily salted hash of IP and user agent page views use, and a visitor's views are only counted once per BLOG_POST_VIEW_WINDOW (defaults to 30 minutes), so reloads don't inflate the count
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Success 200 {object} BlogPostViewResponse "Whether the view was counted, and the post's views"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Blog post not found"
+// @Failure 429 {object} api.ErrorResponse "Too Many Requests - Rate limit exceeded"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error recording view"
+// @Router /blog-post/{blogPostID}/view [post]
+func (h blogPostViewHandler) recordBlogPostView() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPostID, err := uuid.Parse(chi.URLParam(r, "blogPostID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid blogPostID"))
+			return
+		}
+
+		blogPost, err := h.blogPostRepo.FindByID(blogPostID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post", "blog_post", err))
+			return
+		}
+		if blogPost.Status != models.BlogPostStatusPublished {
+			h.responder.WriteError(w, errs.NewNotFoundError("blog post not found").WithCode(errs.EntityCode("blog_post", errs.CodeSuffixNotFound)))
+			return
+		}
+
+		now := time.Now()
+		counted, err := h.blogPostViewRepo.AddUnlessSeen(blogPostID, h.visitors.hash(r, now), now, h.window)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create blog post view", "blog_post_view", err))
+			return
+		}
+		views, err := h.blogPostViewRepo.Count(blogPostID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("count blog post views", "blog_post_views", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, BlogPostViewResponse{Counted: counted, Views: views})
+	}
+}
+
+// getPopularBlogPosts lists the published blog posts with the most views in a recent period
+// @Summary Get popular blog posts
+// @Description Lists the published blog posts with the most views recorded by POST /blog-post/{blogPostID}/view in the period, most viewed first, as summaries with their view counts. Posts without views in the period are left out. Posts translated into the negotiated locale are served in it
+// @Tags Blog Posts
+// @Accept json
+// @Produce json
+// @Param period query string false "How far back views count, in days or hours, e.g. 7d or 24h (max 365d)" default(7d)
+// @Param limit query int false "Maximum number of blog posts (max 50)" default(10)
+// @Param locale query string false "Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given"
+// @Success 200 {object} PopularBlogPostCollection "Most viewed blog posts"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid period, limit or locale"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog posts"
+// @Router /blog-posts/popular [get]
+func (h blogPostViewHandler) getPopularBlogPosts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		period := r.URL.Query().Get("period")
+		if period == "" {
+			period = defaultPopularPeriod
+		}
+		span, err := parsePopularPeriod(period)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		limit := defaultPopularLimit
+		if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil || limit < 1 || limit > maxPopularLimit {
+				h.responder.WriteError(w, errs.NewInvalidFieldError("limit", "must be between 1 and "+strconv.Itoa(maxPopularLimit)))
+				return
+			}
+		}
+
+		counts, err := h.blogPostViewRepo.FindMostViewedSince(time.Now().Add(-span), limit)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find popular blog posts", "blog_post_views", err))
+			return
+		}
+		ids := make([]uuid.UUID, 0, len(counts))
+		for _, count := range counts {
+			ids = append(ids, count.BlogPostID)
+		}
+
+		blogPosts, err := h.blogPostRepo.FindSummaries(ids)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog posts", "blog_posts", err))
+			return
+		}
+		if _, err := h.locales.translate(w, r, blogPosts, false); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post translations", "blog_post_translations", err))
+			return
+		}
+		tags, err := h.blogTagRepo.FindValues(ids)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog tags", "blog_tags", err))
+			return
+		}
+
+		byID := make(map[uuid.UUID]*models.BlogPost, len(blogPosts))
+		for _, blogPost := range blogPosts {
+			byID[blogPost.ID] = blogPost
+		}
+		response := PopularBlogPostCollection{Period: period, Data: make([]PopularBlogPost, 0, len(counts))}
+		for _, count := range counts {
+			// A post unpublished since its views were counted is left out
+			blogPost, ok := byID[count.BlogPostID]
+			if !ok {
+				continue
+			}
+			response.Data = append(response.Data, PopularBlogPost{
+				BlogPostSummary: newBlogPostSummary(blogPost, tags[blogPost.ID]),
+				Views:           count.Views,
+			})
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// parsePopularPeriod reads a period such as 7d or 24h as the duration it covers
+func parsePopularPeriod(period string) (time.Duration, error) {
+	invalid := errs.NewInvalidFieldError("period", "must be a number of days or hours, e.g. 7d or 24h, of at most 365d")
+	unit := time.Hour
+	number, ok := strings.CutSuffix(period, "h")
+	if !ok {
+		number, ok = strings.CutSuffix(period, "d")
+		unit = 24 * time.Hour
+	}
+	count, err := strconv.Atoi(number)
+	if !ok || err != nil || count < 1 || count > int(maxPopularPeriod/unit) {
+		return 0, invalid
+	}
+	return time.Duration(count) * unit, nil
+}
@@ -0,0 +1,372 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type bookHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+	bookRepo  *database.BookRepo
+}
+
+func newBookHandler(bookRepo *database.BookRepo) bookHandler {
+	logger := log.With().Str("handlerName", "bookHandler").Logger()
+
+	return bookHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+		bookRepo:  bookRepo,
+	}
+}
+
+// ReadingList represents the public reading feed
+type ReadingList struct {
+	CurrentlyReading []models.Book `json:"currentlyReading"`
+	Finished         []models.Book `json:"finished"`
+}
+
+// BookCollection represents multiple books
+type BookCollection struct {
+	Books []models.Book `json:"books"`
+	Total int           `json:"total,omitempty"`
+}
+
+// getReadingList retrieves the books currently being read and those already finished
+// @Summary Get reading list
+// @Description Retrieves books currently being read and finished books (most recently finished first)
+// @Tags Books
+// @Accept json
+// @Produce json
+// @Success 200 {object} ReadingList "Reading list"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching books"
+// @Router /reading-list [get]
+func (h bookHandler) getReadingList() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		reading, err := h.bookRepo.FindByStatus(models.BookStatusReading)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find books", "books", err))
+			return
+		}
+
+		finished, err := h.bookRepo.FindByStatus(models.BookStatusFinished)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find books", "books", err))
+			return
+		}
+
+		response := ReadingList{
+			CurrentlyReading: make([]models.Book, 0, len(reading)),
+			Finished:         make([]models.Book, 0, len(finished)),
+		}
+		for _, book := range reading {
+			response.CurrentlyReading = append(response.CurrentlyReading, *book)
+		}
+		for _, book := range finished {
+			response.Finished = append(response.Finished, *book)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// getAllBooks retrieves all books, optionally filtered by status
+// @Summary Get books
+// @Description Retrieves all books in the reading list, optionally filtered by reading status
+// @Tags Books
+// @Accept json
+// @Produce json
+// @Param status query string false "Reading status" Enums(want_to_read, reading, finished)
+// @Success 200 {object} BookCollection "List of books"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid status"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching books"
+// @Router /books [get]
+func (h bookHandler) getAllBooks() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var books []*models.Book
+		var err error
+
+		if status := r.URL.Query().Get("status"); status != "" {
+			if !slices.Contains(models.BookStatuses, status) {
+				h.responder.WriteError(w, errs.NewInvalidFieldError("status", "must be one of: "+strings.Join(models.BookStatuses, ", ")))
+				return
+			}
+			books, err = h.bookRepo.FindByStatus(status)
+		} else {
+			books, err = h.bookRepo.FindAll()
+		}
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find books", "books", err))
+			return
+		}
+
+		response := BookCollection{
+			Books: make([]models.Book, 0, len(books)),
+			Total: len(books),
+		}
+		for _, book := range books {
+			response.Books = append(response.Books, *book)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// getBook retrieves a specific book by ID
+// @Summary Get book
+// @Description Retrieves a specific book by ID
+// @Tags Books
+// @Accept json
+// @Produce json
+// @Param bookID path string true "Book ID" format(uuid)
+// @Success 200 {object} models.Book "Book details"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid bookID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Book not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching book"
+// @Router /book/{bookID} [get]
+func (h bookHandler) getBook() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bookID, ok := h.parseBookID(w, r)
+		if !ok {
+			return
+		}
+
+		book, err := h.bookRepo.FindByID(bookID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find book", "book", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, book)
+	}
+}
+
+// createBook adds a new book to the reading list, enriching it with OpenLibrary metadata
+// @Summary Create book
+// @Description Adds a new book. Cover, ISBN, OpenLibrary key and first publish year are looked up on OpenLibrary unless provided
+// @Tags Books
+// @Accept json
+// @Produce json
+// @Param book body models.Book true "Book data"
+// @Success 201 {object} models.Book "Created book"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid book data"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating book"
+// @Router /book [post]
+func (h bookHandler) createBook() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		book, ok := h.decodeBook(w, r)
+		if !ok {
+			return
+		}
+
+		h.enrichBook(r.Context(), book)
+
+		book.ID = uuid.Nil
+		book.DateAdded = time.Now()
+
+		if err := h.bookRepo.Add(book); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create book", "book", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, book)
+	}
+}
+
+// updateBook updates an existing book
+// @Summary Update book
+// @Description Updates an existing book in the reading list. OpenLibrary metadata is kept unless provided
+// @Tags Books
+// @Accept json
+// @Produce json
+// @Param bookID path string true "Book ID" format(uuid)
+// @Param book body models.Book true "Updated book data"
+// @Success 200 {object} models.Book "Updated book"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid book data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Book not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error updating book"
+// @Router /book/{bookID} [put]
+func (h bookHandler) updateBook() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bookID, ok := h.parseBookID(w, r)
+		if !ok {
+			return
+		}
+
+		existingBook, err := h.bookRepo.FindByID(bookID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find book", "book", err))
+			return
+		}
+
+		book, ok := h.decodeBook(w, r)
+		if !ok {
+			return
+		}
+
+		// Ensure ID matches and keep fields that came from OpenLibrary
+		book.ID = bookID
+		book.DateAdded = existingBook.DateAdded
+		if book.ISBN == nil {
+			book.ISBN = existingBook.ISBN
+		}
+		if book.CoverURL == nil {
+			book.CoverURL = existingBook.CoverURL
+		}
+		if book.OpenLibraryKey == nil {
+			book.OpenLibraryKey = existingBook.OpenLibraryKey
+		}
+		if book.FirstPublishYear == nil {
+			book.FirstPublishYear = existingBook.FirstPublishYear
+		}
+
+		if err := h.bookRepo.Update(book); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update book", "book", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, book)
+	}
+}
+
+// deleteBook deletes a book by ID
+// @Summary Delete book
+// @Description Deletes a book from the reading list by ID
+// @Tags Books
+// @Accept json
+// @Produce json
+// @Param bookID path string true "Book ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid bookID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Book not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting book"
+// @Router /book/{bookID} [delete]
+func (h bookHandler) deleteBook() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bookID, ok := h.parseBookID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify book exists
+		if _, err := h.bookRepo.FindByID(bookID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find book", "book", err))
+			return
+		}
+
+		if err := h.bookRepo.Delete(bookID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete book", "book", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "book deleted successfully",
+		})
+	}
+}
+
+// enrichBook fills in cover, ISBN, OpenLibrary key and publish year from OpenLibrary where the client left them out
+// Lookup failures are logged rather than returned so books can still be added while OpenLibrary is unavailable
+func (h bookHandler) enrichBook(ctx context.Context, book *models.Book) {
+	if book.CoverURL != nil && book.ISBN != nil && book.OpenLibraryKey != nil && book.FirstPublishYear != nil {
+		return
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	isbn := ""
+	if book.ISBN != nil {
+		isbn = *book.ISBN
+	}
+
+	match, err := services.LookupOpenLibraryBook(lookupCtx, book.Title, book.Author, isbn)
+	if err != nil {
+		h.logger.Warn().Err(err).Str("title", book.Title).Msg("Failed to look up book on OpenLibrary")
+		return
+	}
+	if match == nil {
+		h.logger.Info().Str("title", book.Title).Str("author", book.Author).Msg("No OpenLibrary match for book")
+		return
+	}
+
+	if book.ISBN == nil && match.ISBN != "" {
+		book.ISBN = &match.ISBN
+	}
+	if book.CoverURL == nil && match.CoverURL != "" {
+		book.CoverURL = &match.CoverURL
+	}
+	if book.OpenLibraryKey == nil && match.Key != "" {
+		book.OpenLibraryKey = &match.Key
+	}
+	if book.FirstPublishYear == nil && match.FirstPublishYear > 0 {
+		book.FirstPublishYear = &match.FirstPublishYear
+	}
+}
+
+// parseBookID reads the bookID path parameter, writing a 400 if it is missing or invalid
+func (h bookHandler) parseBookID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	bookIDStr := chi.URLParam(r, "bookID")
+	if bookIDStr == "" {
+		h.responder.WriteError(w, errs.NewBadRequestError("missing bookID"))
+		return uuid.Nil, false
+	}
+
+	bookID, err := uuid.Parse(bookIDStr)
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid bookID"))
+		return uuid.Nil, false
+	}
+
+	return bookID, true
+}
+
+// decodeBook reads and validates a book from the request body
+// It writes the error response itself and returns false when the body is invalid
+func (h bookHandler) decodeBook(w http.ResponseWriter, r *http.Request) (*models.Book, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var book models.Book
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&book); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode book request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	book.Title = strings.TrimSpace(book.Title)
+	book.Author = strings.TrimSpace(book.Author)
+
+	if book.Status == "" {
+		book.Status = models.BookStatusWantToRead
+	}
+
+	if err := validateRequest(&book); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+
+	return &book, true
+}
@@ -0,0 +1,341 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultBookmarksPerPage = 20
+	maxBookmarksPerPage     = database.MaxPageSize
+)
+
+type bookmarkHandler struct {
+	responder    Responder
+	logger       zerolog.Logger
+	bookmarkRepo *database.BookmarkRepo
+}
+
+func newBookmarkHandler(bookmarkRepo *database.BookmarkRepo) bookmarkHandler {
+	logger := log.With().Str("handlerName", "bookmarkHandler").Logger()
+
+	return bookmarkHandler{
+		responder:    NewResponder(logger),
+		logger:       logger,
+		bookmarkRepo: bookmarkRepo,
+	}
+}
+
+// BookmarkCollection represents one page of the bookmark feed
+type BookmarkCollection struct {
+	Data  []models.Bookmark `json:"data"`
+	Meta  ListMeta          `json:"meta"`
+	Links ListLinks         `json:"links"`
+}
+
+// getBookmarks retrieves one page of bookmarks
+// @Summary Get bookmarks
+// @Description Retrieves the bookmark feed with tags, newest first
+// @Tags Bookmarks
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number (starts at 1)" default(1)
+// @Param perPage query int false "Bookmarks per page (max 100)" default(20)
+// @Success 200 {object} BookmarkCollection "Page of bookmarks"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid pagination parameters"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching bookmarks"
+// @Router /bookmarks [get]
+func (h bookmarkHandler) getBookmarks() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pagination, err := parsePagination(r, defaultBookmarksPerPage, maxBookmarksPerPage)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		bookmarks, total, err := h.bookmarkRepo.FindPage(pagination.Offset(), pagination.PerPage)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find bookmarks", "bookmarks", err))
+			return
+		}
+
+		meta, links := newListMetaAndLinks(r, pagination, total)
+		response := BookmarkCollection{
+			Data:  make([]models.Bookmark, 0, len(bookmarks)),
+			Meta:  meta,
+			Links: links,
+		}
+		for _, bookmark := range bookmarks {
+			response.Data = append(response.Data, *bookmark)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// getBookmark retrieves a specific bookmark by ID
+// @Summary Get bookmark
+// @Description Retrieves a specific bookmark by ID with its tags
+// @Tags Bookmarks
+// @Accept json
+// @Produce json
+// @Param bookmarkID path string true "Bookmark ID" format(uuid)
+// @Success 200 {object} models.Bookmark "Bookmark details"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid bookmarkID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Bookmark not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching bookmark"
+// @Router /bookmark/{bookmarkID} [get]
+func (h bookmarkHandler) getBookmark() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bookmarkID, ok := h.parseBookmarkID(w, r)
+		if !ok {
+			return
+		}
+
+		bookmark, err := h.bookmarkRepo.FindByID(bookmarkID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find bookmark", "bookmark", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, bookmark)
+	}
+}
+
+// createBookmark saves a new bookmark, fetching the page's metadata server-side
+// @Summary Create bookmark
+// @Description Saves a new bookmark. Title, description and image are fetched from the target page's OpenGraph and meta tags unless provided in the request
+// @Tags Bookmarks
+// @Accept json
+// @Produce json
+// @Param bookmark body models.Bookmark true "Bookmark data (only url is required)"
+// @Param Idempotency-Key header string false "Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)"
+// @Success 201 {object} models.Bookmark "Created bookmark"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid bookmark data"
+// @Failure 409 {object} api.ErrorResponse "Conflict - URL already bookmarked"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating bookmark"
+// @Router /bookmark [post]
+func (h bookmarkHandler) createBookmark() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bookmark, ok := h.decodeBookmark(w, r)
+		if !ok {
+			return
+		}
+
+		h.fillMetadata(r.Context(), bookmark)
+
+		bookmark.ID = uuid.Nil
+		bookmark.DateAdded = time.Now()
+
+		if err := h.bookmarkRepo.Add(bookmark); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create bookmark", "bookmark", err))
+			return
+		}
+
+		createdBookmark, err := h.bookmarkRepo.FindByID(bookmark.ID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find created bookmark", "bookmark", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, createdBookmark)
+	}
+}
+
+// updateBookmark updates an existing bookmark
+// @Summary Update bookmark
+// @Description Updates an existing bookmark and replaces its tags. Omitted title, description and image keep their current values
+// @Tags Bookmarks
+// @Accept json
+// @Produce json
+// @Param bookmarkID path string true "Bookmark ID" format(uuid)
+// @Param bookmark body models.Bookmark true "Updated bookmark data"
+// @Success 200 {object} models.Bookmark "Updated bookmark"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid bookmark data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Bookmark not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error updating bookmark"
+// @Router /bookmark/{bookmarkID} [put]
+func (h bookmarkHandler) updateBookmark() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bookmarkID, ok := h.parseBookmarkID(w, r)
+		if !ok {
+			return
+		}
+
+		existingBookmark, err := h.bookmarkRepo.FindByID(bookmarkID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find bookmark", "bookmark", err))
+			return
+		}
+
+		bookmark, ok := h.decodeBookmark(w, r)
+		if !ok {
+			return
+		}
+
+		// Ensure ID matches and keep fields the client did not send
+		bookmark.ID = bookmarkID
+		bookmark.DateAdded = existingBookmark.DateAdded
+		if bookmark.Title == "" {
+			bookmark.Title = existingBookmark.Title
+		}
+		if bookmark.Description == nil {
+			bookmark.Description = existingBookmark.Description
+		}
+		if bookmark.ImageURL == nil {
+			bookmark.ImageURL = existingBookmark.ImageURL
+		}
+
+		if err := h.bookmarkRepo.Update(bookmark); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update bookmark", "bookmark", err))
+			return
+		}
+
+		updatedBookmark, err := h.bookmarkRepo.FindByID(bookmarkID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find updated bookmark", "bookmark", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, updatedBookmark)
+	}
+}
+
+// deleteBookmark deletes a bookmark by ID
+// @Summary Delete bookmark
+// @Description Deletes a bookmark and its tags from the database by ID
+// @Tags Bookmarks
+// @Accept json
+// @Produce json
+// @Param bookmarkID path string true "Bookmark ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid bookmarkID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Bookmark not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting bookmark"
+// @Router /bookmark/{bookmarkID} [delete]
+func (h bookmarkHandler) deleteBookmark() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bookmarkID, ok := h.parseBookmarkID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify bookmark exists
+		if _, err := h.bookmarkRepo.FindByID(bookmarkID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find bookmark", "bookmark", err))
+			return
+		}
+
+		if err := h.bookmarkRepo.Delete(bookmarkID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete bookmark", "bookmark", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "bookmark deleted successfully",
+		})
+	}
+}
+
+// fillMetadata fetches the bookmarked page and fills in any title, description or image the client left out
+// Fetch failures are logged rather than returned so a slow or broken site can still be bookmarked
+func (h bookmarkHandler) fillMetadata(ctx context.Context, bookmark *models.Bookmark) {
+	if bookmark.Title != "" && bookmark.Description != nil && bookmark.ImageURL != nil {
+		return
+	}
+
+	fetchCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	metadata, err := services.FetchLinkMetadata(fetchCtx, bookmark.URL)
+	if err != nil {
+		h.logger.Warn().Err(err).Str("url", bookmark.URL).Msg("Failed to fetch bookmark metadata")
+	}
+
+	if bookmark.Title == "" {
+		bookmark.Title = metadata.Title
+	}
+	if bookmark.Title == "" {
+		// Fall back to the URL itself so the feed always has something to show
+		bookmark.Title = bookmark.URL
+	}
+	if bookmark.Description == nil && metadata.Description != "" {
+		bookmark.Description = &metadata.Description
+	}
+	if bookmark.ImageURL == nil && metadata.ImageURL != "" {
+		bookmark.ImageURL = &metadata.ImageURL
+	}
+}
+
+// parseBookmarkID reads the bookmarkID path parameter, writing a 400 if it is missing or invalid
+func (h bookmarkHandler) parseBookmarkID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	bookmarkIDStr := chi.URLParam(r, "bookmarkID")
+	if bookmarkIDStr == "" {
+		h.responder.WriteError(w, errs.NewBadRequestError("missing bookmarkID"))
+		return uuid.Nil, false
+	}
+
+	bookmarkID, err := uuid.Parse(bookmarkIDStr)
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid bookmarkID"))
+		return uuid.Nil, false
+	}
+
+	return bookmarkID, true
+}
+
+// decodeBookmark reads and validates a bookmark from the request body
+// Tags are trimmed and de-duplicated. It writes the error response itself and returns false when the body is invalid
+func (h bookmarkHandler) decodeBookmark(w http.ResponseWriter, r *http.Request) (*models.Bookmark, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var bookmark models.Bookmark
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&bookmark); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode bookmark request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	bookmark.URL = strings.TrimSpace(bookmark.URL)
+	bookmark.Title = strings.TrimSpace(bookmark.Title)
+
+	if err := validateRequest(&bookmark); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+
+	seen := make(map[string]bool, len(bookmark.Tags))
+	tags := make([]models.BookmarkTag, 0, len(bookmark.Tags))
+	for _, tag := range bookmark.Tags {
+		value := strings.TrimSpace(tag.Value)
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		tags = append(tags, models.BookmarkTag{ID: uuid.New(), Value: value})
+	}
+	bookmark.Tags = tags
+
+	return &bookmark, true
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rs/zerolog"
+)
+
+// maxBulkDeleteIDs caps how many IDs a single bulk delete may list
+const maxBulkDeleteIDs = 500
+
+// BulkDeleteRequest selects the items to delete, either by ID or by filter
+// All set criteria must match, and at least one is required
+type BulkDeleteRequest struct {
+	IDs         []uuid.UUID `json:"ids,omitempty"`
+	Tag         string      `json:"tag,omitempty"`
+	AddedBefore *time.Time  `json:"addedBefore,omitempty"`
+	AddedAfter  *time.Time  `json:"addedAfter,omitempty"`
+}
+
+// BulkDeleteResult summarizes a bulk delete
+type BulkDeleteResult struct {
+	Deleted    int         `json:"deleted"`
+	DeletedIDs []uuid.UUID `json:"deletedIds"`
+	// NotFound lists requested IDs that didn't exist or didn't match the rest of the filter
+	NotFound []uuid.UUID `json:"notFound,omitempty"`
+}
+
+// decodeBulkDelete reads a BulkDeleteRequest from the body and converts it to a repository filter
+// It writes the error response and returns false if the request is malformed or selects nothing
+func decodeBulkDelete(w http.ResponseWriter, r *http.Request, responder Responder, logger zerolog.Logger) (database.ContentFilter, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to read request body")
+		responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return database.ContentFilter{}, false
+	}
+
+	var request BulkDeleteRequest
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&request); err != nil {
+		logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode bulk delete request body")
+		responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return database.ContentFilter{}, false
+	}
+
+	if len(request.IDs) > maxBulkDeleteIDs {
+		responder.WriteError(w, errs.NewInvalidFieldError("ids", fmt.Sprintf("must contain at most %d IDs", maxBulkDeleteIDs)))
+		return database.ContentFilter{}, false
+	}
+
+	if request.AddedBefore != nil && request.AddedAfter != nil && !request.AddedAfter.Before(*request.AddedBefore) {
+		responder.WriteError(w, errs.NewInvalidFieldError("addedAfter", "must be before addedBefore"))
+		return database.ContentFilter{}, false
+	}
+
+	filter := database.ContentFilter{
+		IDs:         request.IDs,
+		Tag:         strings.TrimSpace(request.Tag),
+		AddedBefore: request.AddedBefore,
+		AddedAfter:  request.AddedAfter,
+	}
+	if filter.IsEmpty() {
+		responder.WriteError(w, errs.NewBadRequestError("at least one of ids, tag, addedBefore or addedAfter is required"))
+		return database.ContentFilter{}, false
+	}
+
+	return filter, true
+}
+
+// newBulkDeleteResult builds the summary, listing requested IDs that weren't deleted
+func newBulkDeleteResult(requested, deleted []uuid.UUID) BulkDeleteResult {
+	result := BulkDeleteResult{Deleted: len(deleted), DeletedIDs: deleted}
+	if result.DeletedIDs == nil {
+		result.DeletedIDs = []uuid.UUID{}
+	}
+
+	wasDeleted := make(map[uuid.UUID]bool, len(deleted))
+	for _, id := range deleted {
+		wasDeleted[id] = true
+	}
+	for _, id := range requested {
+		if !wasDeleted[id] {
+			result.NotFound = append(result.NotFound, id)
+			wasDeleted[id] = true
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,322 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// defaultExpiringWithinDays is how far ahead the expiring-soon report looks when no days parameter is given
+const defaultExpiringWithinDays = 90
+
+type certificationHandler struct {
+	responder         Responder
+	logger            zerolog.Logger
+	certificationRepo *database.CertificationRepo
+}
+
+func newCertificationHandler(certificationRepo *database.CertificationRepo) certificationHandler {
+	logger := log.With().Str("handlerName", "certificationHandler").Logger()
+
+	return certificationHandler{
+		responder:         NewResponder(logger),
+		logger:            logger,
+		certificationRepo: certificationRepo,
+	}
+}
+
+// CertificationCollection represents multiple certifications
+type CertificationCollection struct {
+	Certifications []models.Certification `json:"certifications"`
+	Total          int                    `json:"total,omitempty"`
+}
+
+// ExpiringCertification represents a certification in the expiring-soon report
+type ExpiringCertification struct {
+	models.Certification
+	DaysUntilExpiry int  `json:"daysUntilExpiry"`
+	Expired         bool `json:"expired"`
+}
+
+// ExpiringCertificationReport lists certifications that have expired or will expire within WithinDays
+type ExpiringCertificationReport struct {
+	WithinDays     int                     `json:"withinDays"`
+	Certifications []ExpiringCertification `json:"certifications"`
+}
+
+// getAllCertifications retrieves all certifications
+// @Summary Get certifications
+// @Description Retrieves all certifications, most recently issued first
+// @Tags Certifications
+// @Accept json
+// @Produce json
+// @Success 200 {object} CertificationCollection "List of certifications"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching certifications"
+// @Router /certifications [get]
+func (h certificationHandler) getAllCertifications() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		certifications, err := h.certificationRepo.FindAll()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find certifications", "certifications", err))
+			return
+		}
+
+		response := CertificationCollection{
+			Certifications: make([]models.Certification, 0, len(certifications)),
+			Total:          len(certifications),
+		}
+		for _, certification := range certifications {
+			response.Certifications = append(response.Certifications, *certification)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// getCertification retrieves a specific certification by ID
+// @Summary Get certification
+// @Description Retrieves a specific certification by ID
+// @Tags Certifications
+// @Accept json
+// @Produce json
+// @Param certificationID path string true "Certification ID" format(uuid)
+// @Success 200 {object} models.Certification "Certification details"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid certificationID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Certification not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching certification"
+// @Router /certification/{certificationID} [get]
+func (h certificationHandler) getCertification() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		certificationID, ok := h.parseCertificationID(w, r)
+		if !ok {
+			return
+		}
+
+		certification, err := h.certificationRepo.FindByID(certificationID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find certification", "certification", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, certification)
+	}
+}
+
+// createCertification creates a new certification
+// @Summary Create certification
+// @Description Creates a new certification. Omit expiryDate for certifications that never expire
+// @Tags Certifications
+// @Accept json
+// @Produce json
+// @Param certification body models.Certification true "Certification data"
+// @Success 201 {object} models.Certification "Created certification"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid certification data"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating certification"
+// @Router /certification [post]
+func (h certificationHandler) createCertification() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		certification, ok := h.decodeCertification(w, r)
+		if !ok {
+			return
+		}
+
+		certification.ID = uuid.Nil
+
+		if err := h.certificationRepo.Add(certification); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create certification", "certification", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, certification)
+	}
+}
+
+// updateCertification updates an existing certification
+// @Summary Update certification
+// @Description Updates an existing certification in the database
+// @Tags Certifications
+// @Accept json
+// @Produce json
+// @Param certificationID path string true "Certification ID" format(uuid)
+// @Param certification body models.Certification true "Updated certification data"
+// @Success 200 {object} models.Certification "Updated certification"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid certification data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Certification not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error updating certification"
+// @Router /certification/{certificationID} [put]
+func (h certificationHandler) updateCertification() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		certificationID, ok := h.parseCertificationID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify certification exists
+		if _, err := h.certificationRepo.FindByID(certificationID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find certification", "certification", err))
+			return
+		}
+
+		certification, ok := h.decodeCertification(w, r)
+		if !ok {
+			return
+		}
+
+		// Ensure ID matches
+		certification.ID = certificationID
+
+		if err := h.certificationRepo.Update(certification); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update certification", "certification", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, certification)
+	}
+}
+
+// deleteCertification deletes a certification by ID
+// @Summary Delete certification
+// @Description Deletes a certification from the database by ID
+// @Tags Certifications
+// @Accept json
+// @Produce json
+// @Param certificationID path string true "Certification ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid certificationID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Certification not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting certification"
+// @Router /certification/{certificationID} [delete]
+func (h certificationHandler) deleteCertification() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		certificationID, ok := h.parseCertificationID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify certification exists
+		if _, err := h.certificationRepo.FindByID(certificationID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find certification", "certification", err))
+			return
+		}
+
+		if err := h.certificationRepo.Delete(certificationID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete certification", "certification", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "certification deleted successfully",
+		})
+	}
+}
+
+// getExpiringCertifications reports certifications that have expired or are about to
+// @Summary Get expiring certifications
+// @Description Lists certifications that have already expired or will expire within the given number of days, soonest first
+// @Tags Certifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param days query int false "Look-ahead window in days" default(90)
+// @Success 200 {object} ExpiringCertificationReport "Expiring certifications"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid days"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching certifications"
+// @Router /admin/certifications/expiring [get]
+func (h certificationHandler) getExpiringCertifications() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		days := defaultExpiringWithinDays
+		if daysStr := r.URL.Query().Get("days"); daysStr != "" {
+			var err error
+			days, err = strconv.Atoi(daysStr)
+			if err != nil || days < 0 {
+				h.responder.WriteError(w, errs.NewInvalidFieldError("days", "must be a non-negative integer"))
+				return
+			}
+		}
+
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+		certifications, err := h.certificationRepo.FindExpiringBefore(today.AddDate(0, 0, days))
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find expiring certifications", "certifications", err))
+			return
+		}
+
+		report := ExpiringCertificationReport{
+			WithinDays:     days,
+			Certifications: make([]ExpiringCertification, 0, len(certifications)),
+		}
+		for _, certification := range certifications {
+			daysUntilExpiry := int(math.Round(certification.ExpiryDate.Sub(today).Hours() / 24))
+			report.Certifications = append(report.Certifications, ExpiringCertification{
+				Certification:   *certification,
+				DaysUntilExpiry: daysUntilExpiry,
+				Expired:         daysUntilExpiry < 0,
+			})
+		}
+
+		h.responder.WriteJSON(w, report)
+	}
+}
+
+// parseCertificationID reads the certificationID path parameter, writing a 400 if it is missing or invalid
+func (h certificationHandler) parseCertificationID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	certificationIDStr := chi.URLParam(r, "certificationID")
+	if certificationIDStr == "" {
+		h.responder.WriteError(w, errs.NewBadRequestError("missing certificationID"))
+		return uuid.Nil, false
+	}
+
+	certificationID, err := uuid.Parse(certificationIDStr)
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid certificationID"))
+		return uuid.Nil, false
+	}
+
+	return certificationID, true
+}
+
+// decodeCertification reads and validates a certification from the request body
+// It writes the error response itself and returns false when the body is invalid
+func (h certificationHandler) decodeCertification(w http.ResponseWriter, r *http.Request) (*models.Certification, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var certification models.Certification
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&certification); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode certification request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	certification.Name = strings.TrimSpace(certification.Name)
+	certification.Issuer = strings.TrimSpace(certification.Issuer)
+
+	if err := validateRequest(&certification); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+
+	return &certification, true
+}
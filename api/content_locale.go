@@ -0,0 +1,173 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/text/language"
+)
+
+// defaultContentLocales is CONTENT_LOCALES when it isn't set: posts are only served as written
+const defaultContentLocales = "en"
+
+// contentLocales negotiates the locale blog posts are served in and swaps in their translations
+// The first locale is the one posts are written in; the others are the ones they can be translated into
+type contentLocales struct {
+	responder               Responder
+	logger                  zerolog.Logger
+	blogPostTranslationRepo *database.BlogPostTranslationRepo
+	locales                 []string
+	matcher                 language.Matcher
+}
+
+// newContentLocales serves the comma-separated locales, CONTENT_LOCALES, such as en,pt. Ones that aren't BCP 47
+// language tags are skipped
+func newContentLocales(blogPostTranslationRepo *database.BlogPostTranslationRepo, locales string) *contentLocales {
+	logger := log.With().Str("handlerName", "contentLocales").Logger()
+
+	var tags []language.Tag
+	var names []string
+	for _, locale := range strings.Split(locales, ",") {
+		if locale = strings.TrimSpace(locale); locale == "" {
+			continue
+		}
+		tag, err := language.Parse(locale)
+		if err != nil {
+			logger.Warn().Str("locale", locale).Msg("Skipping CONTENT_LOCALES entry that isn't a language tag")
+			continue
+		}
+		if containsFold(names, tag.String()) {
+			continue
+		}
+		tags = append(tags, tag)
+		names = append(names, tag.String())
+	}
+	if len(tags) == 0 {
+		tags, names = []language.Tag{language.English}, []string{defaultContentLocales}
+	}
+
+	return &contentLocales{
+		responder:               NewResponder(logger),
+		logger:                  logger,
+		blogPostTranslationRepo: blogPostTranslationRepo,
+		locales:                 names,
+		matcher:                 language.NewMatcher(tags),
+	}
+}
+
+// defaultLocale is the locale posts are written in
+func (c *contentLocales) defaultLocale() string {
+	return c.locales[0]
+}
+
+// translationLocale returns the configured locale locale matches, as long as posts can be translated into it
+func (c *contentLocales) translationLocale(locale string) (string, bool) {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return "", false
+	}
+	for _, name := range c.locales[1:] {
+		if strings.EqualFold(name, tag.String()) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// negotiate picks the locale a route's posts are served in: the locale query parameter when given, which must match
+// a configured locale, otherwise the best match for Accept-Language, falling back to the default locale
+func (c *contentLocales) negotiate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locale := c.defaultLocale()
+		if requested := r.URL.Query().Get("locale"); requested != "" {
+			tag, err := language.Parse(requested)
+			_, index, confidence := c.matcher.Match(tag)
+			if err != nil || confidence == language.No {
+				c.responder.WriteError(w, errs.NewInvalidFieldError("locale", "locale must be one of "+strings.Join(c.locales, ", ")))
+				return
+			}
+			locale = c.locales[index]
+		} else if header := r.Header.Get("Accept-Language"); header != "" {
+			tags, _, _ := language.ParseAcceptLanguage(header)
+			if _, index, confidence := c.matcher.Match(tags...); confidence != language.No {
+				locale = c.locales[index]
+			}
+		}
+
+		w.Header().Add("Vary", "Accept-Language")
+		next.ServeHTTP(w, r.WithContext(ctxWithLocale(r.Context(), locale)))
+	})
+}
+
+// locale returns the locale negotiated for r, or the default one on routes that don't negotiate
+func (c *contentLocales) locale(r *http.Request) string {
+	if locale := ctxLocale(r.Context()); locale != "" {
+		return locale
+	}
+	return c.defaultLocale()
+}
+
+// translate replaces the title and summary of blogPosts, and their content withContent, with their translations into
+// the locale negotiated for r, leaving posts that aren't translated as written, and returns the IDs of those that are.
+// Content-Language is set to that locale, or to the default one for a single post that isn't translated
+func (c *contentLocales) translate(w http.ResponseWriter, r *http.Request, blogPosts []*models.BlogPost, withContent bool) (map[uuid.UUID]bool, error) {
+	translated := make(map[uuid.UUID]bool)
+	locale := c.locale(r)
+	if locale == c.defaultLocale() || len(blogPosts) == 0 {
+		w.Header().Set("Content-Language", c.defaultLocale())
+		return translated, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(blogPosts))
+	for _, blogPost := range blogPosts {
+		ids = append(ids, blogPost.ID)
+	}
+	translations, err := c.blogPostTranslationRepo.FindForBlogPosts(ids, locale, withContent)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, blogPost := range blogPosts {
+		translation, ok := translations[blogPost.ID]
+		if !ok {
+			continue
+		}
+		blogPost.Title = translation.Title
+		blogPost.Summary = translation.Summary
+		// The post's own meta title and description are in the language it's written in
+		blogPost.MetaTitle = nil
+		blogPost.MetaDescription = nil
+		if withContent {
+			blogPost.Content = translation.Content
+			blogPost.ContentHTML = translation.ContentHTML
+			blogPost.Measure()
+		}
+		translated[blogPost.ID] = true
+	}
+
+	if len(blogPosts) == 1 && len(translated) == 0 {
+		locale = c.defaultLocale()
+	}
+	w.Header().Set("Content-Language", locale)
+	return translated, nil
+}
+
+// localizedURL returns the address of pageURL in locale, with the locale as the first segment of its path, e.g.
+// https://example.com/pt/blog/{id}. The default locale's address is pageURL itself
+func (c *contentLocales) localizedURL(pageURL, locale string) string {
+	if locale == c.defaultLocale() {
+		return pageURL
+	}
+	scheme, rest, ok := strings.Cut(pageURL, "://")
+	if !ok {
+		return pageURL
+	}
+	host, path, _ := strings.Cut(rest, "/")
+	return scheme + "://" + host + "/" + strings.ToLower(locale) + "/" + path
+}
@@ -10,6 +10,9 @@ const (
 	userIDKey         keyType = "userID"
 	organizationIDKey keyType = "organizationID"
 	userKey           keyType = "user"
+	adminKey          keyType = "admin"
+	benchmarkKey      keyType = "benchmark"
+	localeKey         keyType = "locale"
 )
 
 // ctxWithUserID adds a user ID to the context
@@ -17,6 +20,39 @@ func ctxWithUserID(ctx context.Context, userID string) context.Context {
 	return context.WithValue(ctx, userIDKey, userID)
 }
 
+// ctxWithAdmin marks the request as made with the backend password
+func ctxWithAdmin(ctx context.Context) context.Context {
+	return context.WithValue(ctx, adminKey, true)
+}
+
+// ctxIsAdmin reports whether the request was made with the backend password
+func ctxIsAdmin(ctx context.Context) bool {
+	isAdmin, _ := ctx.Value(adminKey).(bool)
+	return isAdmin
+}
+
+// ctxWithBenchmark marks the request as sent by the benchmark, so it isn't counted as a view
+func ctxWithBenchmark(ctx context.Context) context.Context {
+	return context.WithValue(ctx, benchmarkKey, true)
+}
+
+// ctxIsBenchmark reports whether the request was sent by the benchmark
+func ctxIsBenchmark(ctx context.Context) bool {
+	isBenchmark, _ := ctx.Value(benchmarkKey).(bool)
+	return isBenchmark
+}
+
+// ctxWithLocale records the locale negotiated for the request's content
+func ctxWithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey, locale)
+}
+
+// ctxLocale returns the locale negotiated for the request's content, or "" on routes that don't negotiate one
+func ctxLocale(ctx context.Context) string {
+	locale, _ := ctx.Value(localeKey).(string)
+	return locale
+}
+
 /*
 // ctxWithOrganizationID adds an organization ID to the context
 func ctxWithOrganizationID(ctx context.Context, organizationID string) context.Context {
@@ -0,0 +1,201 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// Kinds of problem the doctor reports besides those database.CheckConsistency finds
+const (
+	IssueMissingSlug   = "missingSlug"   // A blog post whose title has no letters or digits to make a slug from
+	IssueDuplicateSlug = "duplicateSlug" // A blog post sharing its slug with another, so markdown imports can't tell them apart
+	IssueBrokenMedia   = "brokenMedia"   // An image URL that doesn't load
+)
+
+type doctorHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+	database  database.Database
+}
+
+func newDoctorHandler(database database.Database) doctorHandler {
+	logger := log.With().Str("handlerName", "doctorHandler").Logger()
+
+	return doctorHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+		database:  database,
+	}
+}
+
+// DoctorIssue is one inconsistency in the stored data
+type DoctorIssue struct {
+	Kind    string    `json:"kind" enums:"orphanedTag,wordCountMismatch,danglingSocialPost,missingSlug,duplicateSlug,brokenMedia" example:"orphanedTag"`
+	Table   string    `json:"table" example:"blog_tags"`
+	ID      uuid.UUID `json:"id"`
+	Detail  string    `json:"detail" example:"tag \"go\" points at a missing row in blog_posts"`
+	Fixable bool      `json:"fixable"`
+}
+
+// DoctorReport is what the doctor found and, when asked to fix, how many rows it repaired
+type DoctorReport struct {
+	Issues []DoctorIssue `json:"issues"`
+	Fixed  int           `json:"fixed"`
+}
+
+// RunDoctor checks the stored data for orphaned tags, blog post word counts that don't match their content, titles
+// with missing or shared slugs, social post records for deleted content and, with checkMedia, images that don't load
+// With fix it then repairs the fixable issues and reports only the ones left. Slug and media issues always need a
+// person to decide, so they're never fixed
+func RunDoctor(ctx context.Context, db database.Database, checkMedia, fix bool) (DoctorReport, error) {
+	inconsistencies, err := db.CheckConsistency()
+	if err != nil {
+		return DoctorReport{}, err
+	}
+
+	report := DoctorReport{Issues: make([]DoctorIssue, 0, len(inconsistencies))}
+	if fix {
+		if report.Fixed, err = db.FixConsistency(inconsistencies); err != nil {
+			return DoctorReport{}, err
+		}
+		// Anything fixing missed, e.g. a row written in the meantime, is reported as still there
+		if inconsistencies, err = db.CheckConsistency(); err != nil {
+			return DoctorReport{}, err
+		}
+	}
+	for _, issue := range inconsistencies {
+		report.Issues = append(report.Issues, DoctorIssue(issue))
+	}
+
+	slugIssues, err := findSlugIssues(db.BlogPostRepo())
+	if err != nil {
+		return DoctorReport{}, err
+	}
+	report.Issues = append(report.Issues, slugIssues...)
+
+	if checkMedia {
+		references, err := db.MediaReferences()
+		if err != nil {
+			return DoctorReport{}, err
+		}
+		urls := make([]string, 0, len(references))
+		for _, reference := range references {
+			urls = append(urls, reference.URL)
+		}
+		broken := services.CheckMediaURLs(ctx, urls)
+		for _, reference := range references {
+			if err, ok := broken[reference.URL]; ok {
+				report.Issues = append(report.Issues, DoctorIssue{
+					Kind:   IssueBrokenMedia,
+					Table:  reference.Table,
+					ID:     reference.ID,
+					Detail: fmt.Sprintf("%s %s: %v", reference.Field, reference.URL, err),
+				})
+			}
+		}
+	}
+	return report, nil
+}
+
+// findSlugIssues reports the blog posts markdown exports and imports can't name by their slug
+// The oldest of the posts sharing a slug keeps it; the others are reported
+func findSlugIssues(blogPostRepo *database.BlogPostRepo) ([]DoctorIssue, error) {
+	blogPosts, err := blogPostRepo.FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blog posts: %w", err)
+	}
+	sort.SliceStable(blogPosts, func(i, j int) bool {
+		return blogPosts[i].DateAdded.Before(blogPosts[j].DateAdded)
+	})
+
+	var issues []DoctorIssue
+	firstTitle := make(map[string]string, len(blogPosts))
+	for _, blogPost := range blogPosts {
+		slug := services.BlogPostSlug(blogPost.Title)
+		if slug == "" {
+			issues = append(issues, DoctorIssue{
+				Kind:   IssueMissingSlug,
+				Table:  "blog_posts",
+				ID:     blogPost.ID,
+				Detail: fmt.Sprintf("%q has no letters or digits to make a slug from; rename it", blogPost.Title),
+			})
+			continue
+		}
+		if title, taken := firstTitle[slug]; taken {
+			issues = append(issues, DoctorIssue{
+				Kind:   IssueDuplicateSlug,
+				Table:  "blog_posts",
+				ID:     blogPost.ID,
+				Detail: fmt.Sprintf("%q has the slug %q, like %q; rename one of them", blogPost.Title, slug, title),
+			})
+			continue
+		}
+		firstTitle[slug] = blogPost.Title
+	}
+	return issues, nil
+}
+
+// getDoctorReport checks the stored data for inconsistencies without changing anything
+// @Summary Check data consistency
+// @Description Looks for tags whose blog post, project or bookmark is gone, blog posts whose word count or reading time doesn't match their content, titles with no slug or one shared with another post, social post records for deleted blog posts or notes, and note, bookmark, project and blog post images that don't load. Nothing is changed; POST /admin/doctor/fix repairs the issues marked fixable
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param media query bool false "Whether to request every image URL to check it loads, which is the slow part" default(true)
+// @Success 200 {object} DoctorReport "Issues found"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid media parameter"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error checking the data"
+// @Router /admin/doctor [get]
+func (h doctorHandler) getDoctorReport() http.HandlerFunc {
+	return h.run(false)
+}
+
+// fixDoctorIssues repairs the fixable inconsistencies and reports what's left
+// @Summary Fix data consistency issues
+// @Description Deletes orphaned tags and social post records for deleted content and recounts blog post words, then reports the issues that are left, as GET /admin/doctor does. Slug and media issues are only reported
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param media query bool false "Whether to request every image URL to check it loads, which is the slow part" default(true)
+// @Success 200 {object} DoctorReport "Rows fixed and the issues left"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid media parameter"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error checking or fixing the data"
+// @Router /admin/doctor/fix [post]
+func (h doctorHandler) fixDoctorIssues() http.HandlerFunc {
+	return h.run(true)
+}
+
+func (h doctorHandler) run(fix bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		checkMedia := true
+		if value := r.URL.Query().Get("media"); value != "" {
+			var err error
+			if checkMedia, err = strconv.ParseBool(value); err != nil {
+				h.responder.WriteError(w, errs.NewInvalidFieldError("media", "must be true or false"))
+				return
+			}
+		}
+
+		report, err := RunDoctor(r.Context(), h.database, checkMedia, fix)
+		if err != nil {
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("failed to check data consistency", err))
+			return
+		}
+		if fix {
+			h.logger.Info().Int("fixed", report.Fixed).Int("issuesLeft", len(report.Issues)).Msg("Fixed data consistency issues")
+		}
+		h.responder.WriteJSON(w, report)
+	}
+}
@@ -0,0 +1,252 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type educationHandler struct {
+	responder     Responder
+	logger        zerolog.Logger
+	educationRepo *database.EducationRepo
+}
+
+func newEducationHandler(educationRepo *database.EducationRepo) educationHandler {
+	logger := log.With().Str("handlerName", "educationHandler").Logger()
+
+	return educationHandler{
+		responder:     NewResponder(logger),
+		logger:        logger,
+		educationRepo: educationRepo,
+	}
+}
+
+// EducationCollection represents multiple education entries
+type EducationCollection struct {
+	Education []models.Education `json:"education"`
+	Total     int                `json:"total,omitempty"`
+}
+
+// getAllEducation retrieves all education entries
+// @Summary Get education
+// @Description Retrieves all education entries, most recent first
+// @Tags Education
+// @Accept json
+// @Produce json
+// @Success 200 {object} EducationCollection "List of education entries"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching education"
+// @Router /education [get]
+func (h educationHandler) getAllEducation() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		educations, err := h.educationRepo.FindAll()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find education", "educations", err))
+			return
+		}
+
+		response := EducationCollection{
+			Education: make([]models.Education, 0, len(educations)),
+			Total:     len(educations),
+		}
+		for _, education := range educations {
+			response.Education = append(response.Education, *education)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// getEducation retrieves a specific education entry by ID
+// @Summary Get education entry
+// @Description Retrieves a specific education entry by ID
+// @Tags Education
+// @Accept json
+// @Produce json
+// @Param educationID path string true "Education ID" format(uuid)
+// @Success 200 {object} models.Education "Education details"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid educationID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Education not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching education"
+// @Router /education/{educationID} [get]
+func (h educationHandler) getEducation() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		educationID, ok := h.parseEducationID(w, r)
+		if !ok {
+			return
+		}
+
+		education, err := h.educationRepo.FindByID(educationID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find education", "education", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, education)
+	}
+}
+
+// createEducation creates a new education entry
+// @Summary Create education entry
+// @Description Creates a new education entry. The end date may not be before the start date
+// @Tags Education
+// @Accept json
+// @Produce json
+// @Param education body models.Education true "Education data"
+// @Success 201 {object} models.Education "Created education"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid education data"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating education"
+// @Router /education [post]
+func (h educationHandler) createEducation() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		education, ok := h.decodeEducation(w, r)
+		if !ok {
+			return
+		}
+
+		education.ID = uuid.Nil
+
+		if err := h.educationRepo.Add(education); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create education", "education", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, education)
+	}
+}
+
+// updateEducation updates an existing education entry
+// @Summary Update education entry
+// @Description Updates an existing education entry. The end date may not be before the start date
+// @Tags Education
+// @Accept json
+// @Produce json
+// @Param educationID path string true "Education ID" format(uuid)
+// @Param education body models.Education true "Updated education data"
+// @Success 200 {object} models.Education "Updated education"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid education data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Education not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error updating education"
+// @Router /education/{educationID} [put]
+func (h educationHandler) updateEducation() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		educationID, ok := h.parseEducationID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify education exists
+		if _, err := h.educationRepo.FindByID(educationID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find education", "education", err))
+			return
+		}
+
+		education, ok := h.decodeEducation(w, r)
+		if !ok {
+			return
+		}
+
+		// Ensure ID matches
+		education.ID = educationID
+
+		if err := h.educationRepo.Update(education); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update education", "education", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, education)
+	}
+}
+
+// deleteEducation deletes a education entry by ID
+// @Summary Delete education entry
+// @Description Deletes a education entry from the database by ID
+// @Tags Education
+// @Accept json
+// @Produce json
+// @Param educationID path string true "Education ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid educationID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Education not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting education"
+// @Router /education/{educationID} [delete]
+func (h educationHandler) deleteEducation() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		educationID, ok := h.parseEducationID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify education exists
+		if _, err := h.educationRepo.FindByID(educationID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find education", "education", err))
+			return
+		}
+
+		if err := h.educationRepo.Delete(educationID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete education", "education", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "education deleted successfully",
+		})
+	}
+}
+
+// parseEducationID reads the educationID path parameter, writing a 400 if it is missing or invalid
+func (h educationHandler) parseEducationID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	educationIDStr := chi.URLParam(r, "educationID")
+	if educationIDStr == "" {
+		h.responder.WriteError(w, errs.NewBadRequestError("missing educationID"))
+		return uuid.Nil, false
+	}
+
+	educationID, err := uuid.Parse(educationIDStr)
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid educationID"))
+		return uuid.Nil, false
+	}
+
+	return educationID, true
+}
+
+// decodeEducation reads and validates a education entry from the request body
+// It writes the error response itself and returns false when the body is invalid
+func (h educationHandler) decodeEducation(w http.ResponseWriter, r *http.Request) (*models.Education, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var education models.Education
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&education); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode education request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	education.Institution = strings.TrimSpace(education.Institution)
+	education.Degree = strings.TrimSpace(education.Degree)
+
+	if err := validateRequest(&education); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+
+	return &education, true
+}
@@ -0,0 +1,218 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/config"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// errorMetrics counts the error responses written by every Responder on this instance
+var errorMetrics = newErrorCounter()
+
+type errorCountKey struct {
+	code   string
+	status int
+}
+
+// errorCounter counts error responses by code and status since the process started
+type errorCounter struct {
+	mu      sync.Mutex
+	since   time.Time
+	counts  map[errorCountKey]int64
+	alerter *errorAlerter
+}
+
+func newErrorCounter() *errorCounter {
+	return &errorCounter{since: time.Now(), counts: map[errorCountKey]int64{}}
+}
+
+// setAlerter makes server errors counted from now on feed alerter, or stops alerting when it's nil
+func (c *errorCounter) setAlerter(alerter *errorAlerter) {
+	c.mu.Lock()
+	c.alerter = alerter
+	c.mu.Unlock()
+}
+
+// record counts one error response
+func (c *errorCounter) record(status int, code string) {
+	c.mu.Lock()
+	c.counts[errorCountKey{code, status}]++
+	alerter := c.alerter
+	c.mu.Unlock()
+
+	if alerter != nil && status >= http.StatusInternalServerError {
+		alerter.observe(time.Now(), code)
+	}
+}
+
+// ErrorCount is how many error responses went out with one code and status
+type ErrorCount struct {
+	Code   string `json:"code" example:"BLOG_POST_NOT_FOUND"`
+	Status int    `json:"status" example:"404"`
+	Count  int64  `json:"count" example:"3"`
+}
+
+// ErrorMetrics summarizes the error responses sent since Since
+type ErrorMetrics struct {
+	Since        time.Time    `json:"since"`
+	ClientErrors int64        `json:"clientErrors" example:"12"`
+	ServerErrors int64        `json:"serverErrors" example:"1"`
+	Errors       []ErrorCount `json:"errors"`
+}
+
+// snapshot returns the counts so far, most frequent first
+func (c *errorCounter) snapshot() ErrorMetrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	metrics := ErrorMetrics{Since: c.since, Errors: make([]ErrorCount, 0, len(c.counts))}
+	for key, count := range c.counts {
+		metrics.Errors = append(metrics.Errors, ErrorCount{Code: key.code, Status: key.status, Count: count})
+		if key.status >= http.StatusInternalServerError {
+			metrics.ServerErrors += count
+		} else {
+			metrics.ClientErrors += count
+		}
+	}
+	slices.SortFunc(metrics.Errors, func(a, b ErrorCount) int {
+		if a.Count != b.Count {
+			return cmp.Compare(b.Count, a.Count)
+		}
+		return strings.Compare(a.Code, b.Code)
+	})
+	return metrics
+}
+
+// errorAlerter warns by email and/or Discord when server errors spike
+// It alerts once ERROR_ALERT_THRESHOLD server errors happen within ERROR_ALERT_WINDOW, then stays quiet for
+// ERROR_ALERT_COOLDOWN so a long outage doesn't flood the channels
+type errorAlerter struct {
+	logger     zerolog.Logger
+	jobs       *lifecycle
+	threshold  int
+	window     time.Duration
+	cooldown   time.Duration
+	emails     []string
+	discordURL string
+
+	mu        sync.Mutex
+	recent    []time.Time // Times of the latest server errors, at most threshold of them
+	lastAlert time.Time
+}
+
+// newErrorAlerter returns the alerter configured by cfg, or nil when alerts are off
+// They are off unless ERROR_ALERT_THRESHOLD is positive and ERROR_ALERT_EMAILS or ERROR_ALERT_DISCORD_WEBHOOK_URL is set
+func newErrorAlerter(cfg map[string]string, jobs *lifecycle) *errorAlerter {
+	logger := log.With().Str("handlerName", "errorAlerter").Logger()
+
+	threshold := config.GetInt(cfg, "ERROR_ALERT_THRESHOLD", 0)
+	var emails []string
+	for _, email := range strings.Split(config.GetString(cfg, "ERROR_ALERT_EMAILS", ""), ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			emails = append(emails, email)
+		}
+	}
+	discordURL := strings.TrimSpace(config.GetString(cfg, "ERROR_ALERT_DISCORD_WEBHOOK_URL", ""))
+
+	if threshold <= 0 {
+		return nil
+	}
+	if len(emails) == 0 && discordURL == "" {
+		logger.Warn().Msg("ERROR_ALERT_THRESHOLD is set but no ERROR_ALERT_EMAILS or ERROR_ALERT_DISCORD_WEBHOOK_URL, error alerts are off")
+		return nil
+	}
+
+	return &errorAlerter{
+		logger:     logger,
+		jobs:       jobs,
+		threshold:  threshold,
+		window:     config.GetDuration(cfg, "ERROR_ALERT_WINDOW", 5*time.Minute),
+		cooldown:   config.GetDuration(cfg, "ERROR_ALERT_COOLDOWN", time.Hour),
+		emails:     emails,
+		discordURL: discordURL,
+	}
+}
+
+// observe notes a server error with code at now and sends an alert if that makes the threshold
+func (a *errorAlerter) observe(now time.Time, code string) {
+	a.mu.Lock()
+	a.recent = append(a.recent, now)
+	if len(a.recent) > a.threshold {
+		a.recent = a.recent[len(a.recent)-a.threshold:]
+	}
+	spiking := len(a.recent) == a.threshold && now.Sub(a.recent[0]) <= a.window
+	alerting := spiking && (a.lastAlert.IsZero() || now.Sub(a.lastAlert) >= a.cooldown)
+	if alerting {
+		a.lastAlert = now
+	}
+	a.mu.Unlock()
+
+	if !alerting {
+		return
+	}
+	message := fmt.Sprintf("%d server errors in the last %s, the latest with code %s. See GET /admin/metrics for the counts by code.",
+		a.threshold, a.window, code)
+	a.jobs.goJob(func(work context.Context) {
+		a.send(work, message)
+	})
+}
+
+// send delivers message to every configured channel
+func (a *errorAlerter) send(ctx context.Context, message string) {
+	a.logger.Warn().Msg("Sending error alert: " + message)
+
+	if len(a.emails) > 0 {
+		if err := services.SendEmail("Server errors are spiking", "<p>"+message+"</p>", a.emails); err != nil {
+			a.logger.Error().Err(err).Msg("Failed to email error alert")
+		}
+	}
+	if a.discordURL != "" {
+		ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		defer cancel()
+		if err := services.SendDiscordMessage(ctx, a.discordURL, "**Server errors are spiking**\n"+message); err != nil {
+			a.logger.Error().Err(err).Msg("Failed to post error alert to Discord")
+		}
+	}
+}
+
+type metricsHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+	errors    *errorCounter
+}
+
+func newMetricsHandler(errors *errorCounter) metricsHandler {
+	logger := log.With().Str("handlerName", "metricsHandler").Logger()
+
+	return metricsHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+		errors:    errors,
+	}
+}
+
+// getMetrics reports the error responses this instance has sent
+// @Summary Get error metrics
+// @Description Counts the error responses sent by this instance since it started, by error code and HTTP status, most frequent first. Counts are kept in memory, so they start over after a restart and only cover this instance
+// @Tags Metrics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} ErrorMetrics "Error metrics"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Router /admin/metrics [get]
+func (h metricsHandler) getMetrics() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.responder.WriteJSON(w, h.errors.snapshot())
+	}
+}
@@ -0,0 +1,247 @@
+package api
+
+import (
+	"archive/zip"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// Formats GET /export can write the site's content in
+const (
+	exportFormatZip  = "zip"
+	exportFormatJSON = "json"
+)
+
+// ContentExport is the JSON bundle GET /export returns with format=json
+// BlogPosts and Projects are the markdown files the zip holds, named as they are there; BlogPosts can be sent back to
+// POST /blog-posts/import as they are
+type ContentExport struct {
+	ExportedAt time.Time          `json:"exportedAt"`
+	BlogPosts  []MarkdownDocument `json:"blogPosts"`
+	Projects   []MarkdownDocument `json:"projects"`
+	Tags       ExportedTags       `json:"tags"`
+}
+
+// ExportedTags lists the tags in use on blog posts and on projects, most used first
+type ExportedTags struct {
+	BlogPosts []TagCount `json:"blogPosts"`
+	Projects  []TagCount `json:"projects"`
+}
+
+// TagCount is a tag and how many blog posts or projects carry it
+type TagCount struct {
+	Value string `json:"value" example:"go"`
+	Count int    `json:"count" example:"12"`
+}
+
+type exportHandler struct {
+	responder    Responder
+	logger       zerolog.Logger
+	blogPostRepo *database.BlogPostRepo
+	projectRepo  *database.ProjectRepo
+	snippetRepo  *database.SnippetRepo
+}
+
+func newExportHandler(blogPostRepo *database.BlogPostRepo, projectRepo *database.ProjectRepo, snippetRepo *database.SnippetRepo) exportHandler {
+	logger := log.With().Str("handlerName", "exportHandler").Logger()
+
+	return exportHandler{
+		responder:    NewResponder(logger),
+		logger:       logger,
+		blogPostRepo: blogPostRepo,
+		projectRepo:  projectRepo,
+		snippetRepo:  snippetRepo,
+	}
+}
+
+// exportContent writes every blog post, project and tag as a zip of markdown files, or as a JSON bundle of them
+// @Summary Export all content
+// @Description Exports every blog post, including drafts and scheduled posts, and every project as markdown files with YAML frontmatter, for backups and moving the site elsewhere. Posts are written like /blog-post/{blogPostID}/export.md to blog-posts/{slug}.md, which POST /import/markdown and POST /blog-posts/import read back. Projects are written to projects/{slug}.md, with their description as the body and their type, links, dates and tags in the frontmatter. tags.json lists the tags in use on each, with how many carry them. The zip is streamed as it's written, so if an error happens partway it's cut off, leaving an invalid archive. With format=json the same files are returned as one JSON bundle instead. Items in the trash are left out
+// @Tags Import
+// @Produce application/zip,json
+// @Security BearerAuth
+// @Param format query string false "Whether to return a zip of markdown files or a JSON bundle of them" Enums(zip, json) default(zip)
+// @Success 200 {object} ContentExport "Zip of markdown files, or with format=json the bundle"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid format"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error reading content"
+// @Router /export [get]
+func (h exportHandler) exportContent() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		format := r.URL.Query().Get("format")
+		switch format {
+		case "":
+			format = exportFormatZip
+		case exportFormatZip, exportFormatJSON:
+		default:
+			h.responder.WriteError(w, errs.NewInvalidFieldError("format", "must be zip or json"))
+			return
+		}
+
+		now := time.Now().UTC()
+		if format == exportFormatJSON {
+			export := ContentExport{ExportedAt: now, BlogPosts: []MarkdownDocument{}, Projects: []MarkdownDocument{}}
+			tags, err := h.writeContent(r, func(folder string, document MarkdownDocument) error {
+				if folder == "blog-posts" {
+					export.BlogPosts = append(export.BlogPosts, document)
+				} else {
+					export.Projects = append(export.Projects, document)
+				}
+				return nil
+			})
+			if err != nil {
+				h.responder.WriteError(w, err)
+				return
+			}
+			export.Tags = tags
+			h.responder.WriteJSON(w, export)
+			return
+		}
+
+		archive := &zipStream{w: w, filename: "site-export-" + now.Format(time.DateOnly) + ".zip"}
+		tags, err := h.writeContent(r, func(_ string, document MarkdownDocument) error {
+			return archive.add(document.FileName, []byte(document.Content), now)
+		})
+		if err == nil {
+			var tagsJSON []byte
+			if tagsJSON, err = json.MarshalIndent(tags, "", "  "); err == nil {
+				err = archive.add("tags.json", append(tagsJSON, '\n'), now)
+			}
+		}
+		if err == nil {
+			err = archive.close()
+		}
+		if err != nil {
+			if !archive.started {
+				h.responder.WriteError(w, err)
+				return
+			}
+			h.logger.Error().Err(err).Msg("Content export failed partway, leaving the zip truncated")
+		}
+	}
+}
+
+// writeContent renders every blog post and then every project as markdown, handing each to add with the folder it
+// goes in, and returns the tags they carry. Blog posts are read exportBatchSize at a time, so only one batch is held
+// in memory besides what add keeps
+func (h exportHandler) writeContent(r *http.Request, add func(folder string, document MarkdownDocument) error) (ExportedTags, error) {
+	blogPostTags := make(map[string]int)
+	names := make(map[string]bool)
+	err := h.blogPostRepo.FindInBatches(exportBatchSize, func(blogPosts []*models.BlogPost) error {
+		if err := r.Context().Err(); err != nil {
+			return err
+		}
+		for _, blogPost := range blogPosts {
+			snippets, err := h.snippetRepo.FindByIDs(services.SnippetShortcodeIDs(blogPost.Content))
+			if err != nil {
+				return wrapDatabaseError("find snippets", "snippets", err)
+			}
+			var content strings.Builder
+			if err := services.RenderBlogPostMarkdown(*blogPost, snippets, &content); err != nil {
+				return errs.NewInternalErrorWithCause("failed to render blog post", err)
+			}
+			name := "blog-posts/" + services.ExportFileName(blogPost.Title, blogPost.ID, names) + ".md"
+			if err := add("blog-posts", MarkdownDocument{FileName: name, Content: content.String()}); err != nil {
+				return err
+			}
+			for _, tag := range blogPost.Tags {
+				blogPostTags[tag.Value]++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return ExportedTags{}, exportError("export blog posts", "blog_posts", err)
+	}
+
+	projects, err := h.projectRepo.FindAll()
+	if err != nil {
+		return ExportedTags{}, wrapDatabaseError("find projects", "projects", err)
+	}
+	projectTags := make(map[string]int)
+	names = make(map[string]bool)
+	for _, project := range projects {
+		var content strings.Builder
+		if err := services.RenderProjectMarkdown(*project, &content); err != nil {
+			return ExportedTags{}, errs.NewInternalErrorWithCause("failed to render project", err)
+		}
+		name := "projects/" + services.ExportFileName(project.Title, project.ID, names) + ".md"
+		if err := add("projects", MarkdownDocument{FileName: name, Content: content.String()}); err != nil {
+			return ExportedTags{}, err
+		}
+		for _, tag := range project.Tags {
+			projectTags[tag.Value]++
+		}
+	}
+
+	return ExportedTags{BlogPosts: tagCounts(blogPostTags), Projects: tagCounts(projectTags)}, nil
+}
+
+// exportError passes on errors that are already API errors, such as a snippet lookup failing, and treats the rest as
+// the database failing op
+func exportError(op, entity string, err error) error {
+	var apiErr *errs.ApiErr
+	if errors.As(err, &apiErr) {
+		return err
+	}
+	return wrapDatabaseError(op, entity, err)
+}
+
+// tagCounts lists counts by tag, most used first and then alphabetically
+func tagCounts(counts map[string]int) []TagCount {
+	tags := make([]TagCount, 0, len(counts))
+	for value, count := range counts {
+		tags = append(tags, TagCount{Value: value, Count: count})
+	}
+	slices.SortFunc(tags, func(a, b TagCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Value, b.Value))
+	})
+	return tags
+}
+
+// zipStream writes a zip archive straight to the response as files are added to it
+// Nothing is written until the first file, so an error before then still gets a normal error response
+type zipStream struct {
+	w        http.ResponseWriter
+	filename string
+	archive  *zip.Writer
+	started  bool
+}
+
+// add writes the file name, holding data and last modified at modified, to the archive
+func (s *zipStream) add(name string, data []byte, modified time.Time) error {
+	if !s.started {
+		s.started = true
+		s.w.Header().Set("Content-Type", "application/zip")
+		s.w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": s.filename}))
+		s.w.WriteHeader(http.StatusOK)
+		s.archive = zip.NewWriter(s.w)
+	}
+	file, err := s.archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(data)
+	return err
+}
+
+// close writes the archive's directory, which ends it
+func (s *zipStream) close() error {
+	if !s.started {
+		return nil
+	}
+	return s.archive.Close()
+}
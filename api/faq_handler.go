@@ -0,0 +1,278 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type faqHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+	faqRepo   *database.FAQRepo
+}
+
+func newFAQHandler(faqRepo *database.FAQRepo) faqHandler {
+	logger := log.With().Str("handlerName", "faqHandler").Logger()
+
+	return faqHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+		faqRepo:   faqRepo,
+	}
+}
+
+// FAQCollection represents multiple FAQs
+type FAQCollection struct {
+	FAQs  []models.FAQ `json:"faqs"`
+	Total int          `json:"total,omitempty"`
+}
+
+// getPublishedFAQs retrieves all published FAQs
+// @Summary Get FAQs
+// @Description Retrieves all published FAQs ordered by display order
+// @Tags FAQs
+// @Accept json
+// @Produce json
+// @Success 200 {object} FAQCollection "List of published FAQs"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching FAQs"
+// @Router /faqs [get]
+func (h faqHandler) getPublishedFAQs() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		faqs, err := h.faqRepo.FindPublished()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find faqs", "faqs", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, newFAQCollection(faqs))
+	}
+}
+
+// getAllFAQs retrieves every FAQ, including unpublished drafts
+// @Summary Get all FAQs
+// @Description Retrieves all FAQs, published or not, ordered by display order
+// @Tags FAQs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} FAQCollection "List of FAQs"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching FAQs"
+// @Router /admin/faqs [get]
+func (h faqHandler) getAllFAQs() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		faqs, err := h.faqRepo.FindAll()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find faqs", "faqs", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, newFAQCollection(faqs))
+	}
+}
+
+// getFAQ retrieves a specific FAQ by ID
+// @Summary Get FAQ
+// @Description Retrieves a specific FAQ by ID
+// @Tags FAQs
+// @Accept json
+// @Produce json
+// @Param faqID path string true "FAQ ID" format(uuid)
+// @Success 200 {object} models.FAQ "FAQ details"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid faqID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - FAQ not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching FAQ"
+// @Router /faq/{faqID} [get]
+func (h faqHandler) getFAQ() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		faqID, ok := h.parseFAQID(w, r)
+		if !ok {
+			return
+		}
+
+		faq, err := h.faqRepo.FindByID(faqID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find faq", "faq", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, faq)
+	}
+}
+
+// createFAQ creates a new FAQ
+// @Summary Create FAQ
+// @Description Creates a new FAQ. The answer is stored as markdown
+// @Tags FAQs
+// @Accept json
+// @Produce json
+// @Param faq body models.FAQ true "FAQ data"
+// @Success 201 {object} models.FAQ "Created FAQ"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid FAQ data"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating FAQ"
+// @Router /faq [post]
+func (h faqHandler) createFAQ() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		faq, ok := h.decodeFAQ(w, r)
+		if !ok {
+			return
+		}
+
+		faq.ID = uuid.Nil
+
+		if err := h.faqRepo.Add(faq); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create faq", "faq", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, faq)
+	}
+}
+
+// updateFAQ updates an existing FAQ
+// @Summary Update FAQ
+// @Description Updates an existing FAQ in the database
+// @Tags FAQs
+// @Accept json
+// @Produce json
+// @Param faqID path string true "FAQ ID" format(uuid)
+// @Param faq body models.FAQ true "Updated FAQ data"
+// @Success 200 {object} models.FAQ "Updated FAQ"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid FAQ data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - FAQ not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error updating FAQ"
+// @Router /faq/{faqID} [put]
+func (h faqHandler) updateFAQ() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		faqID, ok := h.parseFAQID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify FAQ exists
+		if _, err := h.faqRepo.FindByID(faqID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find faq", "faq", err))
+			return
+		}
+
+		faq, ok := h.decodeFAQ(w, r)
+		if !ok {
+			return
+		}
+
+		// Ensure ID matches
+		faq.ID = faqID
+
+		if err := h.faqRepo.Update(faq); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update faq", "faq", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, faq)
+	}
+}
+
+// deleteFAQ deletes a FAQ by ID
+// @Summary Delete FAQ
+// @Description Deletes a FAQ from the database by ID
+// @Tags FAQs
+// @Accept json
+// @Produce json
+// @Param faqID path string true "FAQ ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid faqID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - FAQ not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting FAQ"
+// @Router /faq/{faqID} [delete]
+func (h faqHandler) deleteFAQ() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		faqID, ok := h.parseFAQID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify FAQ exists
+		if _, err := h.faqRepo.FindByID(faqID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find faq", "faq", err))
+			return
+		}
+
+		if err := h.faqRepo.Delete(faqID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete faq", "faq", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "faq deleted successfully",
+		})
+	}
+}
+
+// parseFAQID reads the faqID path parameter, writing a 400 if it is missing or invalid
+func (h faqHandler) parseFAQID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	faqIDStr := chi.URLParam(r, "faqID")
+	if faqIDStr == "" {
+		h.responder.WriteError(w, errs.NewBadRequestError("missing faqID"))
+		return uuid.Nil, false
+	}
+
+	faqID, err := uuid.Parse(faqIDStr)
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid faqID"))
+		return uuid.Nil, false
+	}
+
+	return faqID, true
+}
+
+// decodeFAQ reads and validates a FAQ from the request body
+// It writes the error response itself and returns false when the body is invalid
+func (h faqHandler) decodeFAQ(w http.ResponseWriter, r *http.Request) (*models.FAQ, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var faq models.FAQ
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&faq); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode faq request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	faq.Question = strings.TrimSpace(faq.Question)
+	faq.Answer = strings.TrimSpace(faq.Answer)
+
+	if err := validateRequest(&faq); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+
+	return &faq, true
+}
+
+func newFAQCollection(faqs []*models.FAQ) FAQCollection {
+	collection := FAQCollection{
+		FAQs:  make([]models.FAQ, 0, len(faqs)),
+		Total: len(faqs),
+	}
+	for _, faq := range faqs {
+		collection.FAQs = append(collection.FAQs, *faq)
+	}
+	return collection
+}
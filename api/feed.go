@@ -0,0 +1,224 @@
+package api
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"mime"
+	"strings"
+	"time"
+)
+
+// feed is what every feed format is built from: the newest published blog posts, in the locale negotiated for the
+// request, and what the feed says about itself. Each format only arranges it differently
+type feed struct {
+	title       string
+	description string
+	author      string
+	homePageURL string
+	locale      string
+	// selfURL returns the URL of the format served at path, for the same locale
+	selfURL func(path string) string
+	// updated is when the newest change to items was made, or the zero time for none
+	updated time.Time
+	items   []feedItem
+}
+
+// feedItem is one blog post in a feed
+type feedItem struct {
+	id      string
+	url     string
+	title   string
+	content string
+	// contentHTML is content rendered, or "" for posts saved before content was rendered
+	contentHTML string
+	summary     string
+	image       string
+	language    string
+	published   time.Time
+	modified    *time.Time
+	tags        []string
+}
+
+// Paths each feed format is served at
+const (
+	rssFeedPath  = "/feed.xml"
+	atomFeedPath = "/feed.atom"
+	jsonFeedPath = "/feed.json"
+)
+
+// feedFormat is one of the formats feeds are served in, at its own path or to requests accepting its media type
+type feedFormat struct {
+	name      string
+	mediaType string
+	encode    func(f feed) ([]byte, error)
+}
+
+var (
+	rssFeedFormat = feedFormat{name: "RSS", mediaType: rssMediaType, encode: func(f feed) ([]byte, error) {
+		return encodeFeedXML(f.rss())
+	}}
+	atomFeedFormat = feedFormat{name: "Atom", mediaType: atomMediaType, encode: func(f feed) ([]byte, error) {
+		return encodeFeedXML(f.atom())
+	}}
+	jsonFeedFormat = feedFormat{name: "JSON", mediaType: jsonFeedMediaType, encode: func(f feed) ([]byte, error) {
+		return json.Marshal(f.jsonFeed())
+	}}
+)
+
+// encodeFeedXML marshals an XML feed document with its declaration
+func encodeFeedXML(document any) ([]byte, error) {
+	data, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// negotiateFeedFormat picks the format for an Accept header: the first media type in it that's a feed format, with
+// plain XML and JSON standing for RSS and JSON Feed. Anything else gets RSS, which every feed reader understands
+func negotiateFeedFormat(accept string) feedFormat {
+	for _, accepted := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case rssMediaType, "application/xml", "text/xml":
+			return rssFeedFormat
+		case atomMediaType:
+			return atomFeedFormat
+		case jsonFeedMediaType, "application/json":
+			return jsonFeedFormat
+		}
+	}
+	return rssFeedFormat
+}
+
+// jsonFeed arranges f as a JSON Feed
+func (f feed) jsonFeed() JSONFeed {
+	document := JSONFeed{
+		Version:     jsonFeedVersion,
+		Title:       f.title,
+		HomePageURL: f.homePageURL,
+		FeedURL:     f.selfURL(jsonFeedPath),
+		Description: f.description,
+		Language:    f.locale,
+		Items:       make([]JSONFeedItem, 0, len(f.items)),
+	}
+	if f.author != "" {
+		document.Authors = []JSONFeedAuthor{{Name: f.author, URL: f.homePageURL}}
+	}
+	for _, item := range f.items {
+		jsonItem := JSONFeedItem{
+			ID:            item.id,
+			URL:           item.url,
+			Title:         item.title,
+			ContentText:   item.content,
+			ContentHTML:   item.contentHTML,
+			Summary:       item.summary,
+			Image:         item.image,
+			DatePublished: item.published,
+			DateModified:  item.modified,
+			Tags:          item.tags,
+			Language:      item.language,
+		}
+		if item.image != "" {
+			if mimeType := imageMimeType(item.image); mimeType != "" {
+				jsonItem.Attachments = []JSONFeedAttachment{{URL: item.image, MimeType: mimeType}}
+			}
+		}
+		document.Items = append(document.Items, jsonItem)
+	}
+	return document
+}
+
+// rss arranges f as an RSS feed. RSS needs a channel link and description, so the feed's own URL and the title
+// stand in for a missing home page and description
+func (f feed) rss() RSSFeed {
+	selfURL := f.selfURL(rssFeedPath)
+	link := f.homePageURL
+	if link == "" {
+		link = selfURL
+	}
+	description := f.description
+	if description == "" {
+		description = f.title
+	}
+
+	document := RSSFeed{
+		Version: "2.0",
+		Atom:    atomNamespace,
+		Channel: RSSChannel{
+			Title:       f.title,
+			Link:        link,
+			Description: description,
+			Language:    f.locale,
+			Self:        RSSAtomLink{Href: selfURL, Rel: "self", Type: rssMediaType},
+			Items:       make([]RSSItem, 0, len(f.items)),
+		},
+	}
+	if !f.updated.IsZero() {
+		document.Channel.LastBuildDate = f.updated.UTC().Format(time.RFC1123Z)
+	}
+	for _, item := range f.items {
+		document.Channel.Items = append(document.Channel.Items, RSSItem{
+			Title:       item.title,
+			Link:        item.url,
+			Description: item.summary,
+			GUID:        RSSGUID{Value: item.id},
+			PubDate:     item.published.Format(time.RFC1123Z),
+			Categories:  item.tags,
+		})
+	}
+	return document
+}
+
+// atom arranges f as an Atom feed. Atom IDs must be IRIs, so posts are identified by urn:uuid: URNs, and entries
+// and the feed need an author, which falls back to the feed's title
+func (f feed) atom() AtomFeed {
+	selfURL := f.selfURL(atomFeedPath)
+	author := f.author
+	if author == "" {
+		author = f.title
+	}
+
+	document := AtomFeed{
+		Lang:     f.locale,
+		ID:       selfURL,
+		Title:    f.title,
+		Subtitle: f.description,
+		Updated:  f.updated.UTC().Format(time.RFC3339),
+		Author:   AtomAuthor{Name: author, URI: f.homePageURL},
+		Links:    []AtomLink{{Href: selfURL, Rel: "self", Type: atomMediaType}},
+		Entries:  make([]AtomEntry, 0, len(f.items)),
+	}
+	if f.homePageURL != "" {
+		document.Links = append(document.Links, AtomLink{Href: f.homePageURL, Rel: "alternate", Type: "text/html"})
+	}
+	for _, item := range f.items {
+		updated := item.published
+		if item.modified != nil {
+			updated = item.modified.UTC()
+		}
+		entry := AtomEntry{
+			Lang:      item.language,
+			ID:        "urn:uuid:" + item.id,
+			Title:     item.title,
+			Published: item.published.Format(time.RFC3339),
+			Updated:   updated.Format(time.RFC3339),
+			Summary:   item.summary,
+			Content:   AtomContent{Type: "text", Value: item.content},
+		}
+		if item.contentHTML != "" {
+			entry.Content = AtomContent{Type: "html", Value: item.contentHTML}
+		}
+		if item.url != "" {
+			entry.Links = []AtomLink{{Href: item.url, Rel: "alternate", Type: "text/html"}}
+		}
+		for _, tag := range item.tags {
+			entry.Categories = append(entry.Categories, AtomCategory{Term: tag})
+		}
+		document.Entries = append(document.Entries, entry)
+	}
+	return document
+}
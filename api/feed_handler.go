@@ -0,0 +1,404 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jsonFeedVersion   = "https://jsonfeed.org/version/1.1"
+	jsonFeedMediaType = "application/feed+json"
+	rssMediaType      = "application/rss+xml"
+	atomMediaType     = "application/atom+xml"
+	atomNamespace     = "http://www.w3.org/2005/Atom"
+	// feedItemLimit is how many of the newest published posts a feed lists
+	feedItemLimit = 50
+	// feedMaxAge is how long feed readers may use a feed before polling it again
+	feedMaxAge = 15 * time.Minute
+)
+
+// JSONFeed is a JSON Feed 1.1 document (https://jsonfeed.org/version/1.1) listing the newest published posts
+type JSONFeed struct {
+	Version     string           `json:"version" example:"https://jsonfeed.org/version/1.1"`
+	Title       string           `json:"title" example:"Blog"`
+	HomePageURL string           `json:"home_page_url,omitempty" example:"https://example.com"`
+	FeedURL     string           `json:"feed_url" example:"https://api.example.com/feed.json"`
+	Description string           `json:"description,omitempty"`
+	Language    string           `json:"language,omitempty" example:"en"`
+	Authors     []JSONFeedAuthor `json:"authors,omitempty"`
+	Items       []JSONFeedItem   `json:"items"`
+}
+
+// JSONFeedAuthor is who writes the feed's posts, from FEED_AUTHOR
+type JSONFeedAuthor struct {
+	Name string `json:"name" example:"Jane Doe"`
+	URL  string `json:"url,omitempty" example:"https://example.com"`
+}
+
+// JSONFeedItem is one blog post in a JSON Feed
+// The post's markdown is sent as content_text and its rendered HTML as content_html
+type JSONFeedItem struct {
+	ID            string               `json:"id"`
+	URL           string               `json:"url,omitempty"`
+	Title         string               `json:"title"`
+	ContentText   string               `json:"content_text"`
+	ContentHTML   string               `json:"content_html,omitempty"`
+	Summary       string               `json:"summary,omitempty"`
+	Image         string               `json:"image,omitempty"`
+	DatePublished time.Time            `json:"date_published"`
+	DateModified  *time.Time           `json:"date_modified,omitempty"`
+	Tags          []string             `json:"tags,omitempty"`
+	Language      string               `json:"language,omitempty" example:"pt"`
+	Attachments   []JSONFeedAttachment `json:"attachments,omitempty"`
+}
+
+// JSONFeedAttachment is a file related to a feed item, here its main image
+type JSONFeedAttachment struct {
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type" example:"image/png"`
+}
+
+// RSSFeed is an RSS 2.0 document (https://www.rssboard.org/rss-specification) listing the newest published posts
+type RSSFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Channel RSSChannel `xml:"channel"`
+}
+
+// RSSChannel describes the blog an RSS feed is for, and carries its items
+type RSSChannel struct {
+	Title         string      `xml:"title"`
+	Link          string      `xml:"link"`
+	Description   string      `xml:"description"`
+	Language      string      `xml:"language,omitempty"`
+	LastBuildDate string      `xml:"lastBuildDate,omitempty"`
+	Self          RSSAtomLink `xml:"atom:link"`
+	Items         []RSSItem   `xml:"item"`
+}
+
+// RSSAtomLink is the atom:link a feed points at itself with, as feed validators expect
+type RSSAtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+// RSSItem is one blog post in an RSS feed, with its summary as its description and its tags as categories
+type RSSItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link,omitempty"`
+	Description string   `xml:"description,omitempty"`
+	GUID        RSSGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Categories  []string `xml:"category"`
+}
+
+// RSSGUID identifies an item; it's the post's ID, which isn't a link
+type RSSGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// AtomFeed is an Atom document (RFC 4287) listing the newest published posts
+type AtomFeed struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Lang     string      `xml:"xml:lang,attr,omitempty"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Author   AtomAuthor  `xml:"author"`
+	Links    []AtomLink  `xml:"link"`
+	Entries  []AtomEntry `xml:"entry"`
+}
+
+// AtomAuthor is who writes the feed's posts
+type AtomAuthor struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+// AtomLink is a link from a feed or entry: to itself, or to the page it's the feed of
+type AtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+// AtomEntry is one blog post in an Atom feed, with its markdown as text content and its tags as categories
+type AtomEntry struct {
+	Lang       string         `xml:"xml:lang,attr,omitempty"`
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Links      []AtomLink     `xml:"link"`
+	Summary    string         `xml:"summary,omitempty"`
+	Content    AtomContent    `xml:"content"`
+	Categories []AtomCategory `xml:"category"`
+}
+
+// AtomContent is an entry's content and how it's written
+type AtomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// AtomCategory is one of an entry's tags
+type AtomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type feedHandler struct {
+	responder         Responder
+	logger            zerolog.Logger
+	blogPostRepo      *database.BlogPostRepo
+	blogPostImageRepo *database.BlogPostImageRepo
+	locales           *contentLocales
+	title             string
+	description       string
+	author            string
+	homePageURL       string
+	apiBaseURL        string
+}
+
+// newFeedHandler serves feeds titled title, written by author and linking to homePageURL, the site's BASE_URL
+// apiBaseURL is API_BASE_URL, which the feed's own URL is built from; when empty the request's host is used
+func newFeedHandler(blogPostRepo *database.BlogPostRepo, blogPostImageRepo *database.BlogPostImageRepo, locales *contentLocales, title, description, author, homePageURL, apiBaseURL string) feedHandler {
+	logger := log.With().Str("handlerName", "feedHandler").Logger()
+
+	return feedHandler{
+		responder:         NewResponder(logger),
+		logger:            logger,
+		blogPostRepo:      blogPostRepo,
+		blogPostImageRepo: blogPostImageRepo,
+		locales:           locales,
+		title:             title,
+		description:       description,
+		author:            author,
+		homePageURL:       homePageURL,
+		apiBaseURL:        strings.TrimSuffix(apiBaseURL, "/"),
+	}
+}
+
+// getJSONFeed lists the newest published blog posts as a JSON Feed
+// @Summary Get JSON Feed
+// @Description Lists the 50 newest published blog posts as a JSON Feed 1.1 document. Each item's content_text is the post's markdown, and the first image in the post is its image and an attachment. Each locale has its own feed, at ?locale=, listing posts in their translation into it when they have one and as written otherwise; each item's language says which. Responses carry an ETag and Last-Modified and may be kept for 15 minutes, so readers polling with If-None-Match or If-Modified-Since get a 304 when nothing changed
+// @Tags Feeds
+// @Produce application/feed+json
+// @Param locale query string false "Locale of the feed, e.g. pt; Accept-Language is used when not given"
+// @Success 200 {object} JSONFeed "JSON Feed of the newest posts"
+// @Success 304 "Not Modified - The feed hasn't changed since the reader's copy"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Unsupported locale"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog posts"
+// @Router /feed.json [get]
+func (h feedHandler) getJSONFeed() http.HandlerFunc {
+	return h.serveFeed(func(*http.Request) feedFormat { return jsonFeedFormat })
+}
+
+// getRSSFeed lists the newest published blog posts as an RSS feed
+// @Summary Get RSS feed
+// @Description Lists the 50 newest published blog posts as an RSS 2.0 feed, each with its title, summary, link, publication date and tags as categories. Like /feed.json, each locale has its own feed at ?locale=. Responses carry an ETag and Last-Modified and may be kept for 15 minutes, so readers polling with If-None-Match or If-Modified-Since get a 304 when nothing changed
+// @Tags Feeds
+// @Produce application/rss+xml
+// @Param locale query string false "Locale of the feed, e.g. pt; Accept-Language is used when not given"
+// @Success 200 {string} string "RSS feed of the newest posts"
+// @Success 304 "Not Modified - The feed hasn't changed since the reader's copy"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Unsupported locale"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog posts"
+// @Router /feed.xml [get]
+func (h feedHandler) getRSSFeed() http.HandlerFunc {
+	return h.serveFeed(func(*http.Request) feedFormat { return rssFeedFormat })
+}
+
+// getAtomFeed lists the newest published blog posts as an Atom feed
+// @Summary Get Atom feed
+// @Description Lists the 50 newest published blog posts as an Atom (RFC 4287) feed, each entry with its title, summary, markdown content, link, publication and update dates and tags as categories. Like /feed.json, each locale has its own feed at ?locale=, and responses carry an ETag and Last-Modified for conditional requests
+// @Tags Feeds
+// @Produce application/atom+xml
+// @Param locale query string false "Locale of the feed, e.g. pt; Accept-Language is used when not given"
+// @Success 200 {string} string "Atom feed of the newest posts"
+// @Success 304 "Not Modified - The feed hasn't changed since the reader's copy"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Unsupported locale"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog posts"
+// @Router /feed.atom [get]
+func (h feedHandler) getAtomFeed() http.HandlerFunc {
+	return h.serveFeed(func(*http.Request) feedFormat { return atomFeedFormat })
+}
+
+// getFeed lists the newest published blog posts in the feed format the request accepts
+// @Summary Get feed
+// @Description Serves the feed in the format the Accept header asks for: application/rss+xml (or application/xml) for /feed.xml, application/atom+xml for /feed.atom and application/feed+json (or application/json) for /feed.json. RSS is served when none of them is accepted. The feed is the same as at the format's own path
+// @Tags Feeds
+// @Produce application/rss+xml,application/atom+xml,application/feed+json
+// @Param locale query string false "Locale of the feed, e.g. pt; Accept-Language is used when not given"
+// @Success 200 {string} string "Feed of the newest posts"
+// @Success 304 "Not Modified - The feed hasn't changed since the reader's copy"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Unsupported locale"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog posts"
+// @Router /feed [get]
+func (h feedHandler) getFeed() http.HandlerFunc {
+	return h.serveFeed(func(r *http.Request) feedFormat {
+		return negotiateFeedFormat(r.Header.Get("Accept"))
+	})
+}
+
+// serveFeed serves the feed in the format chooseFormat picks for each request
+func (h feedHandler) serveFeed(chooseFormat func(r *http.Request) feedFormat) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		format := chooseFormat(r)
+		f, err := h.buildFeed(w, r)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		data, err := format.encode(f)
+		if err != nil {
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("failed to encode "+format.name+" feed", err))
+			return
+		}
+		w.Header().Add("Vary", "Accept")
+		writeFeed(w, r, format.mediaType, data, f.updated)
+	}
+}
+
+// buildFeed builds the feed of the newest published blog posts, in their translation into the negotiated locale
+// where they have one. Each locale has a feed of its own, whose URLs carry the locale
+func (h feedHandler) buildFeed(w http.ResponseWriter, r *http.Request) (feed, error) {
+	blogPosts, _, err := h.blogPostRepo.FindPage(database.TagFilter{}, 0, feedItemLimit)
+	if err != nil {
+		return feed{}, wrapDatabaseError("find blog posts", "blog_posts", err)
+	}
+	translated, err := h.locales.translate(w, r, blogPosts, true)
+	if err != nil {
+		return feed{}, wrapDatabaseError("find blog post translations", "blog_post_translations", err)
+	}
+	ids := make([]uuid.UUID, 0, len(blogPosts))
+	for _, blogPost := range blogPosts {
+		ids = append(ids, blogPost.ID)
+	}
+	featuredImages, err := h.blogPostImageRepo.FindFeaturedURLs(ids)
+	if err != nil {
+		return feed{}, wrapDatabaseError("find featured images", "blog_post_images", err)
+	}
+
+	locale := h.locales.locale(r)
+	baseURL := h.apiBaseURL
+	if baseURL == "" {
+		baseURL = requestScheme(r) + "://" + r.Host
+	}
+	localeQuery := ""
+	homePageURL := h.homePageURL
+	if locale != h.locales.defaultLocale() {
+		localeQuery = "?locale=" + url.QueryEscape(locale)
+		if homePageURL != "" {
+			homePageURL = h.locales.localizedURL(homePageURL, locale)
+		}
+	}
+
+	f := feed{
+		title:       h.title,
+		description: h.description,
+		author:      h.author,
+		homePageURL: homePageURL,
+		locale:      locale,
+		selfURL: func(path string) string {
+			return baseURL + path + localeQuery
+		},
+		items: make([]feedItem, 0, len(blogPosts)),
+	}
+	for _, blogPost := range blogPosts {
+		item := feedItem{
+			id:          blogPost.ID.String(),
+			url:         services.BlogPostLink(*blogPost, ""),
+			title:       blogPost.Title,
+			content:     blogPost.Content,
+			contentHTML: blogPost.ContentHTML,
+			image:       firstImageURL(blogPost.Content),
+			language:    h.locales.defaultLocale(),
+			published:   blogPost.DateAdded.UTC(),
+			modified:    blogPost.DateEdited,
+		}
+		if image, ok := featuredImages[blogPost.ID]; ok {
+			item.image = image
+		}
+		if translated[blogPost.ID] {
+			item.language = locale
+			if item.url != "" {
+				item.url = h.locales.localizedURL(item.url, locale)
+			}
+		}
+		if blogPost.Summary != nil {
+			item.summary = *blogPost.Summary
+		}
+		for _, tag := range blogPost.Tags {
+			item.tags = append(item.tags, tag.Value)
+		}
+		if blogPost.DateAdded.After(f.updated) {
+			f.updated = blogPost.DateAdded
+		}
+		if blogPost.DateEdited != nil && blogPost.DateEdited.After(f.updated) {
+			f.updated = *blogPost.DateEdited
+		}
+		f.items = append(f.items, item)
+	}
+	return f, nil
+}
+
+// writeFeed sends a feed with an ETag, Last-Modified (unless modified is zero) and a max-age, answering conditional
+// requests with 304 so feed readers that poll often don't download or rebuild a feed that hasn't changed
+func writeFeed(w http.ResponseWriter, r *http.Request, mediaType string, data []byte, modified time.Time) {
+	sum := sha256.Sum256(data)
+	w.Header().Set("Content-Type", mediaType+"; charset=utf-8")
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(feedMaxAge.Seconds())))
+	http.ServeContent(w, r, "", modified, bytes.NewReader(data))
+}
+
+// markdownImage matches a markdown image, ![alt](url "title"), or an HTML img tag, capturing the URL
+var markdownImage = regexp.MustCompile(`!\[[^\]]*\]\(\s*<?([^)\s>]+)>?|<img\s[^>]*src\s*=\s*["']([^"']+)["']`)
+
+// firstImageURL returns the URL of the first image in content, the post's main image, if it's absolute
+func firstImageURL(content string) string {
+	for _, match := range markdownImage.FindAllStringSubmatch(content, -1) {
+		image := match[1]
+		if image == "" {
+			image = match[2]
+		}
+		if parsedURL, err := url.Parse(image); err == nil && (parsedURL.Scheme == "http" || parsedURL.Scheme == "https") {
+			return image
+		}
+	}
+	return ""
+}
+
+// imageMimeType guesses an image's type from the extension of its URL, or returns "" if it can't
+func imageMimeType(image string) string {
+	parsedURL, err := url.Parse(image)
+	if err != nil {
+		return ""
+	}
+	mimeType := mime.TypeByExtension(strings.ToLower(path.Ext(parsedURL.Path)))
+	if !strings.HasPrefix(mimeType, "image/") {
+		return ""
+	}
+	return mimeType
+}
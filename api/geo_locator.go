@@ -0,0 +1,72 @@
+package api
+
+import (
+	"net"
+	"strings"
+
+	"github.com/oschwald/maxminddb-golang"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// geoLocator looks up the country and region of a client IP in a MaxMind GeoIP2/GeoLite2 database
+// Works with both the Country and City editions; the Country edition has no regions
+type geoLocator struct {
+	logger zerolog.Logger
+	reader *maxminddb.Reader
+}
+
+// geoRecord is the part of a MaxMind record that is kept; city and coordinates are never read
+type geoRecord struct {
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	Subdivisions []struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"subdivisions"`
+}
+
+// newGeoLocator opens the database at path, returning nil when path is empty or the database can't be opened
+func newGeoLocator(path string) *geoLocator {
+	logger := log.With().Str("handlerName", "geoLocator").Logger()
+	if path == "" {
+		return nil
+	}
+
+	reader, err := maxminddb.Open(path)
+	if err != nil {
+		logger.Error().Err(err).Str("path", path).Msg("Failed to open GeoIP database, page views will be stored without location")
+		return nil
+	}
+	logger.Info().Str("path", path).Str("edition", reader.Metadata.DatabaseType).Msg("Loaded GeoIP database")
+
+	return &geoLocator{
+		logger: logger,
+		reader: reader,
+	}
+}
+
+// locate returns the ISO 3166-1 country code and ISO 3166-2 region code (without the country prefix) of ip
+// Either is nil when the IP is private, unknown, or the database has no such detail
+func (g *geoLocator) locate(ip string) (*string, *string) {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return nil, nil
+	}
+
+	var record geoRecord
+	if err := g.reader.Lookup(parsed, &record); err != nil {
+		g.logger.Error().Err(err).Msg("Failed to look up IP location")
+		return nil, nil
+	}
+	if record.Country.ISOCode == "" {
+		return nil, nil
+	}
+
+	country := strings.ToUpper(record.Country.ISOCode)
+	if len(record.Subdivisions) == 0 || record.Subdivisions[0].ISOCode == "" {
+		return &country, nil
+	}
+	region := strings.ToUpper(record.Subdivisions[0].ISOCode)
+	return &country, &region
+}
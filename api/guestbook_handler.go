@@ -0,0 +1,310 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type guestbookHandler struct {
+	responder          Responder
+	logger             zerolog.Logger
+	guestbookEntryRepo *database.GuestbookEntryRepo
+}
+
+func newGuestbookHandler(guestbookEntryRepo *database.GuestbookEntryRepo) guestbookHandler {
+	logger := log.With().Str("handlerName", "guestbookHandler").Logger()
+
+	return guestbookHandler{
+		responder:          NewResponder(logger),
+		logger:             logger,
+		guestbookEntryRepo: guestbookEntryRepo,
+	}
+}
+
+// GuestbookSubmission represents the fields a visitor may provide when signing the guestbook
+type GuestbookSubmission struct {
+	Name    string  `json:"name" validate:"notblank,max=100" example:"Jane Doe"`
+	Website *string `json:"website,omitempty" validate:"omitempty,httpurl" example:"https://janedoe.dev"`
+	Message string  `json:"message" validate:"notblank,max=1000" example:"Cool site!"`
+	// Email is a honeypot: the form hides it from people, so anything filled in came from a bot
+	Email string `json:"email,omitempty"`
+}
+
+// GuestbookEntryCollection represents multiple guestbook entries
+type GuestbookEntryCollection struct {
+	Entries []models.GuestbookEntry `json:"entries"`
+	Total   int                     `json:"total,omitempty"`
+}
+
+// getApprovedEntries retrieves all approved guestbook entries
+// @Summary Get guestbook
+// @Description Retrieves all guestbook entries that have been approved for public display, newest first
+// @Tags Guestbook
+// @Accept json
+// @Produce json
+// @Success 200 {object} GuestbookEntryCollection "List of approved guestbook entries"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching guestbook entries"
+// @Router /guestbook [get]
+func (h guestbookHandler) getApprovedEntries() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		entries, err := h.guestbookEntryRepo.FindByApproved(true)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find guestbook entries", "guestbook_entries", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, newGuestbookEntryCollection(entries))
+	}
+}
+
+// submitEntry stores a visitor-submitted guestbook entry pending approval
+// @Summary Sign guestbook
+// @Description Submits a new guestbook entry. Entries are hidden until approved by an admin. Submissions are rate limited per IP address
+// @Tags Guestbook
+// @Accept json
+// @Produce json
+// @Param entry body GuestbookSubmission true "Guestbook entry data"
+// @Success 201 {object} models.GuestbookEntry "Submitted guestbook entry"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid guestbook entry data"
+// @Failure 429 {object} api.ErrorResponse "Too Many Requests - Rate limit exceeded"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error submitting guestbook entry"
+// @Router /guestbook-entry [post]
+func (h guestbookHandler) submitEntry() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			h.logger.Error().Err(err).Msg("Failed to read request body")
+			h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+			return
+		}
+
+		var submission GuestbookSubmission
+		if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&submission); err != nil {
+			h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode guestbook request body")
+			h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+			return
+		}
+
+		submission.Name = strings.TrimSpace(submission.Name)
+		submission.Message = strings.TrimSpace(submission.Message)
+
+		entry := models.GuestbookEntry{
+			Name:          submission.Name,
+			Message:       submission.Message,
+			Approved:      false,
+			DateSubmitted: time.Now(),
+		}
+
+		// Pretend the honeypot submission worked so bots get no signal to adapt to
+		if submission.Email != "" {
+			h.logger.Warn().Str("ip", clientIP(r)).Msg("Discarding guestbook submission that filled the honeypot field")
+			w.WriteHeader(http.StatusCreated)
+			h.responder.WriteJSON(w, entry)
+			return
+		}
+
+		if submission.Website != nil {
+			website := strings.TrimSpace(*submission.Website)
+			submission.Website = nil
+			if website != "" {
+				submission.Website = &website
+			}
+		}
+
+		if err := validateRequest(&submission); err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		entry.Website = submission.Website
+
+		if err := h.guestbookEntryRepo.Add(&entry); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create guestbook entry", "guestbook_entry", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, entry)
+	}
+}
+
+// getAllEntries retrieves guestbook entries for moderation
+// @Summary Get guestbook entries for moderation
+// @Description Retrieves guestbook entries filtered by moderation status (pending, approved or all). Defaults to pending
+// @Tags Guestbook
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Moderation status" Enums(pending, approved, all)
+// @Success 200 {object} GuestbookEntryCollection "List of guestbook entries"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid status"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching guestbook entries"
+// @Router /admin/guestbook [get]
+func (h guestbookHandler) getAllEntries() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var entries []*models.GuestbookEntry
+		var err error
+
+		switch status := r.URL.Query().Get("status"); status {
+		case "", "pending":
+			entries, err = h.guestbookEntryRepo.FindByApproved(false)
+		case "approved":
+			entries, err = h.guestbookEntryRepo.FindByApproved(true)
+		case "all":
+			entries, err = h.guestbookEntryRepo.FindAll()
+		default:
+			h.responder.WriteError(w, errs.NewBadRequestError("status must be one of: pending, approved, all"))
+			return
+		}
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find guestbook entries", "guestbook_entries", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, newGuestbookEntryCollection(entries))
+	}
+}
+
+// approveEntry marks a guestbook entry as approved
+// @Summary Approve guestbook entry
+// @Description Approves a guestbook entry so it is shown publicly
+// @Tags Guestbook
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entryID path string true "Guestbook entry ID" format(uuid)
+// @Success 200 {object} models.GuestbookEntry "Approved guestbook entry"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid entryID"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Guestbook entry not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error approving guestbook entry"
+// @Router /admin/guestbook-entry/{entryID}/approve [post]
+func (h guestbookHandler) approveEntry() http.HandlerFunc {
+	return h.setApproved(true)
+}
+
+// rejectEntry withdraws approval from a guestbook entry
+// @Summary Reject guestbook entry
+// @Description Marks a guestbook entry as not approved, hiding it from the public guestbook
+// @Tags Guestbook
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entryID path string true "Guestbook entry ID" format(uuid)
+// @Success 200 {object} models.GuestbookEntry "Rejected guestbook entry"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid entryID"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Guestbook entry not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error rejecting guestbook entry"
+// @Router /admin/guestbook-entry/{entryID}/reject [post]
+func (h guestbookHandler) rejectEntry() http.HandlerFunc {
+	return h.setApproved(false)
+}
+
+// setApproved returns a handler that updates the approval state of the guestbook entry in the path
+func (h guestbookHandler) setApproved(approved bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		entryID, ok := h.parseEntryID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify guestbook entry exists
+		if _, err := h.guestbookEntryRepo.FindByID(entryID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find guestbook entry", "guestbook_entry", err))
+			return
+		}
+
+		if err := h.guestbookEntryRepo.SetApproved(entryID, approved); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update guestbook entry", "guestbook_entry", err))
+			return
+		}
+
+		updatedEntry, err := h.guestbookEntryRepo.FindByID(entryID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find updated guestbook entry", "guestbook_entry", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, updatedEntry)
+	}
+}
+
+// deleteEntry deletes a guestbook entry by ID
+// @Summary Delete guestbook entry
+// @Description Deletes a guestbook entry from the database by ID
+// @Tags Guestbook
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entryID path string true "Guestbook entry ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid entryID"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Guestbook entry not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting guestbook entry"
+// @Router /admin/guestbook-entry/{entryID} [delete]
+func (h guestbookHandler) deleteEntry() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		entryID, ok := h.parseEntryID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify guestbook entry exists
+		if _, err := h.guestbookEntryRepo.FindByID(entryID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find guestbook entry", "guestbook_entry", err))
+			return
+		}
+
+		if err := h.guestbookEntryRepo.Delete(entryID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete guestbook entry", "guestbook_entry", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "guestbook entry deleted successfully",
+		})
+	}
+}
+
+// parseEntryID reads the entryID path parameter, writing a 400 if it is missing or invalid
+func (h guestbookHandler) parseEntryID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	entryIDStr := chi.URLParam(r, "entryID")
+	if entryIDStr == "" {
+		h.responder.WriteError(w, errs.NewBadRequestError("missing entryID"))
+		return uuid.Nil, false
+	}
+
+	entryID, err := uuid.Parse(entryIDStr)
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid entryID"))
+		return uuid.Nil, false
+	}
+
+	return entryID, true
+}
+
+func newGuestbookEntryCollection(entries []*models.GuestbookEntry) GuestbookEntryCollection {
+	collection := GuestbookEntryCollection{
+		Entries: make([]models.GuestbookEntry, 0, len(entries)),
+		Total:   len(entries),
+	}
+	for _, entry := range entries {
+		collection.Entries = append(collection.Entries, *entry)
+	}
+	return collection
+}
@@ -1,13 +1,123 @@
 package api
 
 import (
+	"context"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/config"
 	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog/log"
 )
 
 // initializeHandlers creates and returns all handlers organized in a routeHandlers struct
-func initializeHandlers(database database.Database, backendPassword string) *routeHandlers {
+func initializeHandlers(database database.Database, backendPassword string, cfg map[string]string, jobs *lifecycle) *routeHandlers {
+	// Pending deliveries are stored, so the worker can stop between sends and pick up where it left off after a restart
+	webhooks := newWebhookDispatcher(database.WebhookRepo(), database.WebhookDeliveryRepo())
+	jobs.run("webhooks", func(stopping context.Context) {
+		webhooks.run(stopping, jobs.work)
+	})
+
+	trending := newTrendingCache(database.ContentViewRepo(), database.BlogPostRepo(), database.ProjectRepo())
+	aggregator := newPageViewAggregator(database.PageViewRepo(), database.VisitorSaltRepo())
+	publisher := newScheduledPublisher(database.BlogPostRepo(), webhooks)
+
+	sched := newScheduler(cfg)
+	sched.add("publishScheduledPosts", scheduledPublishInterval, 5*time.Second, publisher.publishDue)
+	sched.add("refreshTrending", trendingRefreshInterval, time.Minute, func(_ context.Context, now time.Time) error {
+		return trending.refresh(now)
+	})
+	sched.add("aggregatePageViews", pageViewAggregateInterval, time.Minute, aggregator.aggregate)
+	// Drafting from Notion only runs once an integration and the database it can read are configured
+	notionToken := config.GetString(cfg, "NOTION_TOKEN", "")
+	notionDatabaseID := config.GetString(cfg, "NOTION_DATABASE_ID", "")
+	if notionToken != "" && notionDatabaseID != "" {
+		notion := newNotionSyncer(database, notionToken, notionDatabaseID)
+		sched.add("syncNotion", config.GetDuration(cfg, "NOTION_SYNC_INTERVAL", defaultNotionSyncInterval), 30*time.Second, notion.sync)
+	}
+	sched.start(jobs)
+
+	// Hot public lists are cached in memory and dropped whenever this instance writes to a table they read
+	responses := newResponseCache(config.GetInt(cfg, "RESPONSE_CACHE_SIZE", defaultResponseCacheSize), config.GetDuration(cfg, "RESPONSE_CACHE_TTL", defaultResponseCacheTTL))
+	if err := database.OnWrite("responseCache", responses.invalidate); err != nil {
+		log.Error().Err(err).Msg("Failed to register response cache invalidation, turning the cache off")
+		responses = newResponseCache(0, 0)
+	}
+
+	shareLinks := newShareLinker(database.ShareLinkRepo(), config.GetString(cfg, "SHARE_LINK_BASE_URL", ""))
+	shortLinkBaseURL := config.GetString(cfg, "SHORT_LINK_BASE_URL", "")
+	shortLinks := newShortLinker(database.ShortLinkRepo(), shortLinkBaseURL)
+	locales := newContentLocales(database.BlogPostTranslationRepo(), config.GetString(cfg, "CONTENT_LOCALES", defaultContentLocales))
+	previews := newPreviewLinker(config.GetString(cfg, "PREVIEW_LINK_SECRET", backendPassword), config.GetDuration(cfg, "PREVIEW_LINK_TTL", defaultPreviewLinkTTL), config.GetString(cfg, "API_BASE_URL", ""))
+	useIP := config.GetBool(cfg, "ANALYTICS_USE_IP", true)
+	visitors := newVisitorHasher(database.VisitorSaltRepo(), useIP)
+	geo := newGeoLocator(config.GetString(cfg, "GEOIP_DB_PATH", ""))
+	forwarder := newAnalyticsForwarder(config.GetString(cfg, "ANALYTICS_PROVIDER", ""), config.GetString(cfg, "ANALYTICS_PROVIDER_URL", ""), config.GetString(cfg, "ANALYTICS_SITE_ID", ""), config.GetString(cfg, "BASE_URL", ""), useIP, jobs)
+
+	blogPosts := newBlogPostHandler(database.BlogPostRepo(), database.BlogTagRepo(), database.ContentViewRepo(), database.SocialPostRepo(), database.SnippetRepo(), shareLinks, shortLinks, previews, locales, webhooks)
+	notes := newNoteHandler(database.NoteRepo(), database.SocialPostRepo(), webhooks)
+	// Micropub clients sign in with IndieAuth as the site's owner, whose profile URL is the site itself unless set
+	indieAuth := newIndieAuthVerifier(config.GetString(cfg, "INDIEAUTH_TOKEN_ENDPOINT", ""), config.GetString(cfg, "INDIEAUTH_ME", config.GetString(cfg, "BASE_URL", "")))
+
+	// Uploaded images get web-sized copies and a thumbnail for srcset
+	mediaVariants := services.ImageVariantOptions{
+		Widths:        parseVariantWidths(config.GetString(cfg, "MEDIA_VARIANT_WIDTHS", defaultMediaVariantWidths)),
+		ThumbnailSize: config.GetInt(cfg, "MEDIA_THUMBNAIL_SIZE", defaultMediaThumbnailSize),
+		Quality:       config.GetInt(cfg, "MEDIA_VARIANT_QUALITY", defaultMediaVariantQuality),
+	}
+
+	// Every Responder counts its errors in errorMetrics, which may also alert when server errors spike
+	errorMetrics.setAlerter(newErrorAlerter(cfg, jobs))
+
+	// Client IPs, which rate limits go by, are only taken from these proxies' headers
+	trustedProxies = parseTrustedProxies(config.GetString(cfg, "TRUSTED_PROXIES", ""))
+
 	return &routeHandlers{
-		projectHandler:  newProjectHandler(database.ProjectRepo(), database.ProjectTagRepo()),
-		blogPostHandler: newBlogPostHandler(database.BlogPostRepo(), database.BlogTagRepo()),
+		projectHandler:        newProjectHandler(database.ProjectRepo(), database.ProjectTagRepo(), database.ContentViewRepo(), webhooks, config.GetString(cfg, "GITHUB_TOKEN", "")),
+		blogPostHandler:       blogPosts,
+		resumeHandler:         newResumeHandler(database.WorkExperienceRepo(), database.EducationRepo(), database.CertificationRepo(), config.GetString(cfg, "RESUME_NAME", "")),
+		workExperienceHandler: newWorkExperienceHandler(database.WorkExperienceRepo()),
+		educationHandler:      newEducationHandler(database.EducationRepo()),
+		skillHandler:          newSkillHandler(database.SkillRepo(), database.ProjectRepo()),
+		testimonialHandler:    newTestimonialHandler(database.TestimonialRepo()),
+		usesItemHandler:       newUsesItemHandler(database.UsesItemRepo()),
+		snippetHandler:        newSnippetHandler(database.SnippetRepo()),
+		bookmarkHandler:       newBookmarkHandler(database.BookmarkRepo()),
+		noteHandler:           notes,
+		timelineHandler:       newTimelineHandler(database.BlogPostRepo(), database.ProjectRepo(), database.NoteRepo()),
+		guestbookHandler:      newGuestbookHandler(database.GuestbookEntryRepo()),
+		bookHandler:           newBookHandler(database.BookRepo()),
+		certificationHandler:  newCertificationHandler(database.CertificationRepo()),
+		faqHandler:            newFAQHandler(database.FAQRepo()),
+		batchHandler:          newBatchHandler(database, webhooks),
+		webhookHandler:        newWebhookHandler(database.WebhookRepo(), database.WebhookDeliveryRepo()),
+		idempotency:           newIdempotencyMiddleware(database.IdempotencyKeyRepo()),
+		trendingHandler:       newTrendingHandler(trending),
+		blogPostViewHandler:   newBlogPostViewHandler(database.BlogPostViewRepo(), database.BlogPostRepo(), database.BlogTagRepo(), visitors, locales, config.GetDuration(cfg, "BLOG_POST_VIEW_WINDOW", defaultBlogPostViewWindow)),
+		seriesHandler:         newSeriesHandler(database.SeriesRepo(), database.BlogPostRepo(), database.BlogTagRepo(), locales),
+		blogPostMetaHandler:   newBlogPostMetaHandler(database.BlogPostRepo(), locales, config.GetString(cfg, "SITE_NAME", ""), config.GetString(cfg, "TWITTER_SITE", "")),
+		blogPostImageHandler:  newBlogPostImageHandler(database.BlogPostImageRepo(), database.BlogPostRepo()),
+		projectImageHandler:   newProjectImageHandler(database.ProjectImageRepo(), database.ProjectRepo()),
+		recentChangesHandler:  newRecentChangesHandler(database.BlogPostRepo(), database.ProjectRepo(), database.NoteRepo()),
+		analyticsHandler:      newAnalyticsHandler(database.PageViewRepo(), database.BlogPostRepo(), database.ProjectRepo(), database.SocialPostRepo(), visitors, forwarder, geo),
+		socialPostHandler:     newSocialPostHandler(database.SocialPostRepo()),
+		shareLinkHandler:      newShareLinkHandler(database.ShareLinkRepo()),
+		shortLinkHandler:      newShortLinkHandler(database.ShortLinkRepo(), database.BlogPostRepo(), database.ProjectRepo(), shortLinkBaseURL),
+		schedulerHandler:      newSchedulerHandler(sched),
+		metricsHandler:        newMetricsHandler(errorMetrics),
+		schemaHandler:         newSchemaHandler(),
+		importHandler:         newImportHandler(database),
+		exportHandler:         newExportHandler(database.BlogPostRepo(), database.ProjectRepo(), database.SnippetRepo()),
+		responseCache:         responses,
+		indexAuditHandler:     newIndexAuditHandler(database),
+		schemaDriftHandler:    newSchemaDriftHandler(database),
+		doctorHandler:         newDoctorHandler(database),
+		searchHandler:         newSearchHandler(database),
+		tagHandler:            newTagHandler(database),
+		mediaHandler:          newMediaHandler(database.MediaFileRepo(), database.MediaObjectRepo(), newMediaStorage(cfg), config.GetInt(cfg, "MEDIA_UPLOAD_MAX_MB", services.MaxMediaUploadSize>>20)<<20, mediaVariants),
+		micropubHandler:       newMicropubHandler(blogPosts, notes, database.MediaFileRepo(), indieAuth, config.GetString(cfg, "BASE_URL", ""), config.GetString(cfg, "API_BASE_URL", "")),
+		contentLocales:        locales,
+		sitemapHandler:        newSitemapHandler(database.BlogPostRepo(), database.BlogPostTranslationRepo(), locales),
+		feedHandler:           newFeedHandler(database.BlogPostRepo(), database.BlogPostImageRepo(), locales, config.GetString(cfg, "FEED_TITLE", "Blog"), config.GetString(cfg, "FEED_DESCRIPTION", ""), config.GetString(cfg, "FEED_AUTHOR", ""), config.GetString(cfg, "BASE_URL", ""), config.GetString(cfg, "API_BASE_URL", "")),
 	}
 }
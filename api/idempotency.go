@@ -0,0 +1,208 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+const (
+	// idempotencyKeyTTL is how long a stored response is replayed for
+	idempotencyKeyTTL = 24 * time.Hour
+	// maxIdempotencyKeyLength caps the length of the Idempotency-Key header
+	maxIdempotencyKeyLength = 255
+	// maxIdempotentBodySize caps the bodies buffered to fingerprint a request, which is read before any handler
+	// can cap it, as large as the largest body a public route takes, a Micropub upload
+	maxIdempotentBodySize = maxMicropubRequestSize
+	// maxAdminIdempotentBodySize is the cap for admins, as large as the largest body an admin route takes, an
+	// import archive
+	maxAdminIdempotentBodySize = maxImportArchiveSize
+)
+
+// idempotencyMiddleware makes POST requests carrying an Idempotency-Key header safe to retry
+// The first request with a key runs normally and its response is stored; retries with the same key
+// and body get that response back with an Idempotent-Replayed header instead of running again
+// Keys are scoped to the caller, so one client can't replay, or use up, another's
+type idempotencyMiddleware struct {
+	responder Responder
+	logger    zerolog.Logger
+	repo      *database.IdempotencyKeyRepo
+}
+
+func newIdempotencyMiddleware(repo *database.IdempotencyKeyRepo) idempotencyMiddleware {
+	logger := log.With().Str("handlerName", "idempotencyMiddleware").Logger()
+	return idempotencyMiddleware{
+		responder: NewResponder(logger),
+		logger:    logger,
+		repo:      repo,
+	}
+}
+
+func (m idempotencyMiddleware) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Idempotency-Key")
+		if r.Method != http.MethodPost || key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			m.responder.WriteError(w, errs.NewInvalidFieldError("Idempotency-Key", "must be at most 255 characters"))
+			return
+		}
+
+		maxSize := int64(maxIdempotentBodySize)
+		if ctxIsAdmin(r.Context()) {
+			maxSize = maxAdminIdempotentBodySize
+		}
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				m.responder.WriteError(w, errs.NewMaxBodySizeExceededError(maxSize))
+				return
+			}
+			m.logger.Error().Err(err).Msg("Failed to read request body")
+			m.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		scope := idempotencyScope(r)
+		key = scope + ":" + key
+		requestHash := fingerprintRequest(r, scope, body)
+		now := time.Now()
+		reserved, err := m.repo.Reserve(&models.IdempotencyKey{
+			Key:         key,
+			RequestHash: requestHash,
+			DateAdded:   now,
+			ExpiresAt:   now.Add(idempotencyKeyTTL),
+		})
+		if err != nil {
+			m.responder.WriteError(w, wrapDatabaseError("reserve idempotency key", "idempotency_key", err))
+			return
+		}
+
+		if !reserved {
+			m.replay(w, key, requestHash)
+			return
+		}
+
+		// A panicking handler never gets to complete the key, which would leave retries told it's in progress until it
+		// expires, so release it before the recoverer upstream takes over
+		defer func() {
+			if p := recover(); p != nil {
+				if err := m.repo.Delete(key); err != nil {
+					m.logger.Error().Err(err).Str("key", key).Msg("Failed to release idempotency key")
+				}
+				panic(p)
+			}
+		}()
+
+		recorder := &idempotencyRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		// Server errors aren't worth replaying, and nor are auth failures, which the caller fixes by retrying with the
+		// right credentials, so release the key and let the client retry for real
+		if recorder.status >= http.StatusInternalServerError || recorder.status == http.StatusUnauthorized || recorder.status == http.StatusForbidden {
+			if err := m.repo.Delete(key); err != nil {
+				m.logger.Error().Err(err).Str("key", key).Msg("Failed to release idempotency key")
+			}
+			return
+		}
+
+		if err := m.repo.Complete(key, recorder.status, recorder.Header().Get("Content-Type"), recorder.body.Bytes()); err != nil {
+			m.logger.Error().Err(err).Str("key", key).Msg("Failed to store idempotent response")
+		}
+	})
+}
+
+// replay writes the stored response for key, or an error if the key can't be replayed for this request
+func (m idempotencyMiddleware) replay(w http.ResponseWriter, key, requestHash string) {
+	record, err := m.repo.FindByKey(key)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// The original request failed and released the key between our insert and lookup
+		m.responder.WriteError(w, errs.NewConflictError("a request with this Idempotency-Key just failed, retry it").WithCode(errs.CodeIdempotencyKeyFailed))
+		return
+	}
+	if err != nil {
+		m.responder.WriteError(w, wrapDatabaseError("find idempotency key", "idempotency_key", err))
+		return
+	}
+
+	if record.RequestHash != requestHash {
+		m.responder.WriteError(w, errs.NewApiErr(http.StatusUnprocessableEntity, "Idempotency-Key was already used for a different request").WithCode(errs.CodeIdempotencyKeyReused))
+		return
+	}
+
+	if !record.Completed {
+		m.responder.WriteError(w, errs.NewConflictError("a request with this Idempotency-Key is still in progress").WithCode(errs.CodeIdempotencyKeyInProgress))
+		return
+	}
+
+	m.logger.Info().Str("key", key).Int("status", record.StatusCode).Msg("Replaying idempotent response")
+	if record.ContentType != "" {
+		w.Header().Set("Content-Type", record.ContentType)
+	}
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(record.StatusCode)
+	if _, err := w.Write(record.ResponseBody); err != nil {
+		m.logger.Error().Err(err).Msg("Failed to write replayed response")
+	}
+}
+
+// fingerprintRequest hashes what makes two requests the same: who made them, method, path, query and body
+func fingerprintRequest(r *http.Request, scope string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(scope + "\n" + r.Method + " " + r.URL.Path + "?" + r.URL.RawQuery + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// idempotencyScope names the caller r's key belongs to: callers are told apart by a hash of their credentials or,
+// without any, by their IP
+func idempotencyScope(r *http.Request) string {
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		sum := sha256.Sum256([]byte(authorization))
+		return "auth-" + hex.EncodeToString(sum[:])
+	}
+	return "ip-" + clientIP(r)
+}
+
+// idempotencyRecorder passes a response through to the client while keeping a copy to store
+type idempotencyRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *idempotencyRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *idempotencyRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (r *idempotencyRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,610 @@
+package api
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// maxImportArchiveSize caps the size of an uploaded export archive
+const maxImportArchiveSize = 100 << 20
+
+// Import result statuses
+const (
+	ImportStatusCreated = "created"
+	ImportStatusUpdated = "updated"
+	ImportStatusSkipped = "skipped"
+	ImportStatusFailed  = "failed"
+)
+
+// ImportResult is what happened to one post in an export archive
+type ImportResult struct {
+	File   string     `json:"file" example:"posts/2024-01-15_Hello-World-1a2b3c4d5e6f.html"`
+	Title  string     `json:"title,omitempty" example:"Hello World"`
+	Status string     `json:"status" enums:"created,updated,skipped,failed"`
+	ID     *uuid.UUID `json:"id,omitempty"`
+	Error  string     `json:"error,omitempty"`
+	// Attachments lists the URLs of the media attached to the post on its old platform, which aren't copied
+	Attachments []string `json:"attachments,omitempty" example:"https://example.com/wp-content/uploads/2024/01/cover.jpg"`
+}
+
+// ImportReport summarizes an import, with one result per post found in the archive
+// After a dry run nothing has been saved, and the statuses say what would have happened
+type ImportReport struct {
+	DryRun  bool           `json:"dryRun,omitempty"`
+	Created int            `json:"created" example:"12"`
+	Updated int            `json:"updated" example:"0"`
+	Skipped int            `json:"skipped" example:"1"`
+	Failed  int            `json:"failed" example:"0"`
+	Posts   []ImportResult `json:"posts"`
+}
+
+func (r *ImportReport) add(result ImportResult) {
+	switch result.Status {
+	case ImportStatusCreated:
+		r.Created++
+	case ImportStatusUpdated:
+		r.Updated++
+	case ImportStatusSkipped:
+		r.Skipped++
+	default:
+		r.Failed++
+	}
+	r.Posts = append(r.Posts, result)
+}
+
+type importHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+	database  database.Database
+}
+
+func newImportHandler(database database.Database) importHandler {
+	logger := log.With().Str("handlerName", "importHandler").Logger()
+
+	return importHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+		database:  database,
+	}
+}
+
+// importMedium creates a draft for every post in a Medium export archive
+// @Summary Import from Medium
+// @Description Imports the posts in a Medium export archive (the zip Medium emails from Settings > Security and apps > Download your information) as drafts. Each post's body is converted from HTML to markdown, its canonical link becomes the url and, for published posts, its publish date becomes dateAdded. Medium's export has no tags, so the tags given here are added to every post. Posts whose title is already taken are skipped. The report says what happened to each post
+// @Tags Import
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Medium export archive (.zip)"
+// @Param tags formData string false "Comma-separated tags to add to every imported post"
+// @Success 200 {object} ImportReport "What happened to each post"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Missing archive, or not a zip with Medium posts in it"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 413 {object} api.ErrorResponse "Request Entity Too Large - Archive over 100 MB"
+// @Router /import/medium [post]
+func (h importHandler) importMedium() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.importArchive(w, r, "Medium", func(archive *zip.Reader, tags []string, report *ImportReport) error {
+			for _, file := range archive.File {
+				if !services.IsMediumPostFile(file.Name) {
+					continue
+				}
+				post, err := parseArchivedFile(file, services.ParseMediumPost)
+				if err != nil {
+					report.add(ImportResult{File: file.Name, Status: ImportStatusFailed, Error: err.Error()})
+					continue
+				}
+				report.add(h.importPost(file.Name, post, tags, models.BlogPostStatusDraft, false))
+			}
+			return nil
+		})
+	}
+}
+
+// importSubstack creates a blog post for every post in a Substack export archive
+// @Summary Import from Substack
+// @Description Imports the posts in a Substack export archive (the zip from Settings > Exports), which lists the posts in posts.csv and keeps each body as HTML. Bodies are converted to markdown. Published posts are imported as published, keeping their publish date, and aren't cross-posted or announced; unpublished ones become drafts. Images keep pointing at Substack's CDN. Substack's export has no tags, so the tags given here are added to every post. Posts without an HTML body, such as threads, and posts whose title is already taken are skipped. The report says what happened to each post
+// @Tags Import
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Substack export archive (.zip)"
+// @Param tags formData string false "Comma-separated tags to add to every imported post"
+// @Param publicationURL formData string false "Address of the publication, e.g. https://example.substack.com, to link each post to its page there as its url"
+// @Success 200 {object} ImportReport "What happened to each post"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Missing archive, or not a zip with Substack posts in it"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 413 {object} api.ErrorResponse "Request Entity Too Large - Archive over 100 MB"
+// @Router /import/substack [post]
+func (h importHandler) importSubstack() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.importArchive(w, r, "Substack", func(archive *zip.Reader, tags []string, report *ImportReport) error {
+			publicationURL := strings.TrimSpace(r.FormValue("publicationURL"))
+			if parsedURL, err := url.Parse(publicationURL); publicationURL != "" && (err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "") {
+				return errs.NewInvalidFieldError("publicationURL", "must be an absolute http(s) URL")
+			}
+
+			files := make(map[string]*zip.File, len(archive.File))
+			var postList *zip.File
+			for _, file := range archive.File {
+				files[file.Name] = file
+				if postList == nil && services.IsSubstackPostList(file.Name) {
+					postList = file
+				}
+			}
+			if postList == nil {
+				return nil
+			}
+
+			reader, err := openArchivedFile(postList)
+			if err != nil {
+				return errs.NewInvalidFieldError("file", "failed to open posts.csv: "+err.Error())
+			}
+			posts, err := services.ParseSubstackPosts(reader)
+			reader.Close()
+			if err != nil {
+				return errs.NewInvalidFieldError("file", err.Error())
+			}
+
+			for _, post := range posts {
+				name := services.SubstackPostFile(postList.Name, post)
+				file, ok := files[name]
+				if !ok {
+					report.add(ImportResult{File: name, Title: post.Title, Status: ImportStatusSkipped, Error: "the export has no HTML body for this post"})
+					continue
+				}
+				imported, err := parseArchivedFile(file, func(body io.Reader) (services.ImportedPost, error) {
+					return services.ParseSubstackPost(post, body, publicationURL)
+				})
+				if err != nil {
+					report.add(ImportResult{File: name, Title: post.Title, Status: ImportStatusFailed, Error: err.Error()})
+					continue
+				}
+
+				status := models.BlogPostStatusDraft
+				if imported.PublishedAt != nil {
+					status = models.BlogPostStatusPublished
+				}
+				report.add(h.importPost(name, imported, tags, status, false))
+			}
+			return nil
+		})
+	}
+}
+
+// importWordPress creates a blog post for every post in a WordPress export file
+// @Summary Import from WordPress
+// @Description Imports the posts in a WordPress export (WXR) file, from Tools > Export. Bodies are converted to markdown. Published posts are imported as published, keeping their publish date, and aren't cross-posted or announced; scheduled posts stay scheduled and everything else becomes a draft. Tags and categories become tags, and the tags given here are added to every post. Media isn't copied: images keep pointing at the WordPress site, and each post lists its attachments. Posts whose title is already taken are skipped. With dryRun nothing is saved, and the report says what would have happened to each post
+// @Tags Import
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "WordPress export file (.xml)"
+// @Param tags formData string false "Comma-separated tags to add to every imported post"
+// @Param dryRun formData boolean false "Report what would be created without saving anything"
+// @Success 200 {object} ImportReport "What happened, or would happen, to each post"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Missing file, or not a WordPress export with posts in it"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 413 {object} api.ErrorResponse "Request Entity Too Large - File over 100 MB"
+// @Router /import/wordpress [post]
+func (h importHandler) importWordPress() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		file, header, ok := h.openUpload(w, r)
+		if !ok {
+			return
+		}
+		defer file.Close()
+
+		dryRun := false
+		if value := r.FormValue("dryRun"); value != "" {
+			var err error
+			if dryRun, err = strconv.ParseBool(value); err != nil {
+				h.responder.WriteError(w, errs.NewInvalidFieldError("dryRun", "must be true or false"))
+				return
+			}
+		}
+
+		posts, err := services.ParseWordPressExport(file)
+		if err != nil {
+			h.responder.WriteError(w, errs.NewInvalidFieldError("file", err.Error()))
+			return
+		}
+
+		tags := uniqueTagValues(strings.Split(r.FormValue("tags"), ","))
+		report := ImportReport{DryRun: dryRun, Posts: []ImportResult{}}
+		for _, post := range posts {
+			status := models.BlogPostStatusDraft
+			switch {
+			case post.PublishedAt != nil:
+				status = models.BlogPostStatusPublished
+			case post.ScheduledAt != nil:
+				status = models.BlogPostStatusScheduled
+			}
+			result := h.importPost(fmt.Sprintf("%s#%s", header.Filename, post.ID), post.ImportedPost, tags, status, dryRun)
+			result.Attachments = post.Attachments
+			report.add(result)
+		}
+		h.writeReport(w, "WordPress", report)
+	}
+}
+
+// importMarkdown creates or updates a blog post for every markdown file in a zipped folder
+// @Summary Import markdown files
+// @Description Imports every markdown file in a zipped folder, such as an Obsidian vault or posts from /blog-post/{blogPostID}/export.md. YAML frontmatter can set title (otherwise the file name), slug, summary, date, publishAt, draft, tags and canonicalURL. A file whose slug matches an existing post's updates that post; slugs are derived from titles unless the frontmatter sets one. Files are published unless they're marked draft or scheduled with a future publishAt, and publishing here doesn't cross-post or announce them. Files with publish: false are skipped, as are hidden folders like .obsidian. The tags given here are added to every post. The report says what happened to each file
+// @Tags Import
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Zipped folder of markdown files (.zip)"
+// @Param tags formData string false "Comma-separated tags to add to every imported post"
+// @Success 200 {object} ImportReport "What happened to each file"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Missing archive, or not a zip with markdown files in it"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 413 {object} api.ErrorResponse "Request Entity Too Large - Archive over 100 MB"
+// @Router /import/markdown [post]
+func (h importHandler) importMarkdown() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.importArchive(w, r, "markdown", h.importMarkdownArchive)
+	}
+}
+
+// MarkdownDocument is a markdown file sent to POST /blog-posts/import, frontmatter and all
+// FileName names it in the report, and gives it its title when the frontmatter has none
+type MarkdownDocument struct {
+	FileName string `json:"fileName,omitempty" example:"hello-world.md"`
+	Content  string `json:"content" example:"---\ntitle: Hello World\ntags: [go]\n---\nFirst post."`
+}
+
+// importBlogPosts creates or updates a blog post for every markdown document sent, or every markdown file in a
+// zipped folder
+// @Summary Import blog posts
+// @Description Imports markdown documents with YAML frontmatter as blog posts, either sent as a JSON array of documents or uploaded as a zipped folder in the file form field, which is read like it is by /import/markdown. Frontmatter can set title (otherwise the document's fileName), slug, summary, date, publishAt, draft, tags and canonicalURL. A document whose slug matches an existing post's updates that post. Documents are published unless they're marked draft or scheduled with a future publishAt, and publishing here doesn't cross-post or announce them. Documents with publish: false are skipped. The tags given here are added to every post. Each document is saved on its own, so one failing doesn't stop the rest, and the report says what happened to each
+// @Tags Import
+// @Accept json,multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param documents body []MarkdownDocument false "Markdown documents, when not uploading a zip"
+// @Param tags query string false "Comma-separated tags to add to every imported post; for a zip it can also be a form field"
+// @Success 200 {object} ImportReport "What happened to each document"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Malformed body, no documents, or not a zip with markdown files in it"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 413 {object} api.ErrorResponse "Request Entity Too Large - Body over 100 MB"
+// @Router /blog-posts/import [post]
+func (h importHandler) importBlogPosts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "multipart/form-data" {
+			h.importArchive(w, r, "markdown", h.importMarkdownArchive)
+			return
+		}
+
+		var documents []MarkdownDocument
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxImportArchiveSize)).Decode(&documents); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				h.responder.WriteError(w, errs.NewMaxBodySizeExceededError(maxImportArchiveSize))
+				return
+			}
+			h.logger.Error().Err(err).Msg("Failed to decode markdown documents")
+			h.responder.WriteError(w, errs.NewBadRequestError("malformed request body: expected an array of markdown documents"))
+			return
+		}
+		if len(documents) == 0 {
+			h.responder.WriteError(w, errs.NewBadRequestError("no markdown documents to import"))
+			return
+		}
+
+		markdownImport, err := h.newMarkdownImport(uniqueTagValues(strings.Split(r.URL.Query().Get("tags"), ",")))
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		report := ImportReport{Posts: []ImportResult{}}
+		for i, document := range documents {
+			name := document.FileName
+			if name == "" {
+				name = fmt.Sprintf("documents[%d]", i)
+			}
+			note, err := services.ParseMarkdownNote(document.FileName, strings.NewReader(document.Content))
+			markdownImport.add(&report, name, note, err)
+		}
+		h.writeReport(w, "markdown", report)
+	}
+}
+
+// importMarkdownArchive imports every markdown file in archive, adding tags to each
+func (h importHandler) importMarkdownArchive(archive *zip.Reader, tags []string, report *ImportReport) error {
+	markdownImport, err := h.newMarkdownImport(tags)
+	if err != nil {
+		return err
+	}
+	for _, file := range archive.File {
+		if !services.IsMarkdownFile(file.Name) {
+			continue
+		}
+		note, err := parseArchivedFile(file, func(body io.Reader) (services.MarkdownNote, error) {
+			return services.ParseMarkdownNote(file.Name, body)
+		})
+		markdownImport.add(report, file.Name, note, err)
+	}
+	return nil
+}
+
+// markdownImport saves markdown notes as blog posts, updating the existing post with the same slug
+type markdownImport struct {
+	h           importHandler
+	tags        []string
+	blogPostIDs map[string]uuid.UUID
+	now         time.Time
+}
+
+// newMarkdownImport starts an import that adds tags to every post, looking up the slugs of the posts there already
+// are
+func (h importHandler) newMarkdownImport(tags []string) (*markdownImport, error) {
+	titles, err := h.database.BlogPostRepo().FindAllTitles()
+	if err != nil {
+		return nil, wrapDatabaseError("find blog posts", "blog_post", err)
+	}
+	blogPostIDs := make(map[string]uuid.UUID, len(titles))
+	for id, title := range titles {
+		blogPostIDs[services.BlogPostSlug(title)] = id
+	}
+	return &markdownImport{h: h, tags: tags, blogPostIDs: blogPostIDs, now: time.Now()}, nil
+}
+
+// add saves note, read from file, and adds what happened to report; err is the error reading note, if any
+func (m *markdownImport) add(report *ImportReport, file string, note services.MarkdownNote, err error) {
+	if err != nil {
+		report.add(ImportResult{File: file, Status: ImportStatusFailed, Error: err.Error()})
+		return
+	}
+	if note.Publish != nil && !*note.Publish {
+		report.add(ImportResult{File: file, Title: note.Title, Status: ImportStatusSkipped, Error: "publish is false"})
+		return
+	}
+
+	status := models.BlogPostStatusPublished
+	switch {
+	case note.Draft:
+		status = models.BlogPostStatusDraft
+	case note.ScheduledAt != nil && note.ScheduledAt.After(m.now):
+		status = models.BlogPostStatusScheduled
+	}
+
+	// A renamed note still matches its post through the slug set in its frontmatter
+	id, found := m.blogPostIDs[note.Slug]
+	if !found {
+		id, found = m.blogPostIDs[services.BlogPostSlug(note.Title)]
+	}
+	if found {
+		report.add(m.h.updatePost(file, id, note.ImportedPost, m.tags, status))
+		return
+	}
+
+	result := m.h.importPost(file, note.ImportedPost, m.tags, status, false)
+	if result.ID != nil {
+		m.blogPostIDs[note.Slug] = *result.ID
+	}
+	report.add(result)
+}
+
+// importArchive reads the export archive uploaded as the file form field and the tags field, then has importPosts
+// fill in the report. It responds with an error if the upload is missing, isn't a zip, has no posts from platform
+// in it or importPosts fails
+func (h importHandler) importArchive(w http.ResponseWriter, r *http.Request, platform string, importPosts func(archive *zip.Reader, tags []string, report *ImportReport) error) {
+	file, header, ok := h.openUpload(w, r)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	archive, err := zip.NewReader(file, header.Size)
+	if err != nil {
+		h.responder.WriteError(w, errs.NewInvalidFieldError("file", "must be a zip archive"))
+		return
+	}
+
+	tags := uniqueTagValues(strings.Split(r.FormValue("tags"), ","))
+
+	report := ImportReport{Posts: []ImportResult{}}
+	if err := importPosts(archive, tags, &report); err != nil {
+		h.responder.WriteError(w, err)
+		return
+	}
+	h.writeReport(w, platform, report)
+}
+
+// openUpload opens the export uploaded as the file form field, responding with an error if it's missing or too large
+func (h importHandler) openUpload(w http.ResponseWriter, r *http.Request) (multipart.File, *multipart.FileHeader, bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportArchiveSize)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.responder.WriteError(w, errs.NewMaxBodySizeExceededError(maxImportArchiveSize))
+			return nil, nil, false
+		}
+		h.responder.WriteError(w, errs.NewMissingRequiredFieldError("file"))
+		return nil, nil, false
+	}
+	return file, header, true
+}
+
+// writeReport responds with report, or with an error if the export had no posts from platform in it
+func (h importHandler) writeReport(w http.ResponseWriter, platform string, report ImportReport) {
+	if len(report.Posts) == 0 {
+		h.responder.WriteError(w, errs.NewInvalidFieldError("file", fmt.Sprintf("has no %s posts in it", platform)))
+		return
+	}
+
+	h.logger.Info().Str("platform", platform).Bool("dryRun", report.DryRun).Int("created", report.Created).Int("updated", report.Updated).Int("skipped", report.Skipped).Int("failed", report.Failed).Msg("Imported posts")
+	h.responder.WriteJSON(w, report)
+}
+
+// parseArchivedFile opens file and has parse read a post from it
+func parseArchivedFile[T any](file *zip.File, parse func(io.Reader) (T, error)) (T, error) {
+	reader, err := openArchivedFile(file)
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer reader.Close()
+	return parse(reader)
+}
+
+// openArchivedFile opens file, refusing one that decompresses to more than services.MaxImportFileSize before
+// decompressing any of it, since a small archive can hold files far larger than itself. The parsers stop reading at
+// that size too, in case the archive's directory lies
+func openArchivedFile(file *zip.File) (io.ReadCloser, error) {
+	if file.UncompressedSize64 > services.MaxImportFileSize {
+		return nil, fmt.Errorf("decompresses to more than %d MB", services.MaxImportFileSize>>20)
+	}
+	return file.Open()
+}
+
+// errImportDryRun rolls back the transaction of a post imported in a dry run, once it's known it would be created
+var errImportDryRun = errors.New("dry run")
+
+// importPost saves post with status along with its tags and extraTags
+// With dryRun the post is saved in a transaction that's rolled back, so the result is what a real import would do
+func (h importHandler) importPost(file string, post services.ImportedPost, extraTags []string, status string, dryRun bool) ImportResult {
+	result := ImportResult{File: file, Title: post.Title, Status: ImportStatusFailed}
+
+	blogPost := models.BlogPost{
+		Title:   post.Title,
+		Content: post.Content,
+		Status:  status,
+	}
+	if post.Summary != "" {
+		blogPost.Summary = &post.Summary
+	}
+	if post.CanonicalURL != "" {
+		blogPost.URL = &post.CanonicalURL
+	}
+	if post.PublishedAt != nil {
+		blogPost.DateAdded = *post.PublishedAt
+	}
+	if status == models.BlogPostStatusScheduled {
+		blogPost.PublishAt = post.ScheduledAt
+	}
+	for _, tag := range uniqueTagValues(append(post.Tags, extraTags...)) {
+		blogPost.Tags = append(blogPost.Tags, models.BlogTag{Value: tag})
+	}
+
+	if err := validateRequest(&blogPost); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	var id uuid.UUID
+	err := h.database.Transaction(func(tx database.Database) error {
+		var err error
+		id, err = addBlogPost(tx, &blogPost)
+		if err == nil && dryRun {
+			return errImportDryRun
+		}
+		return err
+	})
+
+	if errors.Is(err, errImportDryRun) {
+		result.Status = ImportStatusCreated
+		return result
+	}
+	h.recordOutcome(&result, ImportStatusCreated, id, err)
+	return result
+}
+
+// updatePost overwrites the blog post with id with post, saved with status along with its tags and extraTags
+// The post keeps its date unless post has one
+func (h importHandler) updatePost(file string, id uuid.UUID, post services.ImportedPost, extraTags []string, status string) ImportResult {
+	result := ImportResult{File: file, Title: post.Title, Status: ImportStatusFailed}
+
+	err := h.database.Transaction(func(tx database.Database) error {
+		existing, err := tx.BlogPostRepo().FindByID(id)
+		if err != nil {
+			return wrapDatabaseError("find blog post", "blog_post", err)
+		}
+
+		now := time.Now()
+		blogPost := *existing
+		blogPost.Title = post.Title
+		blogPost.Content = post.Content
+		if err := services.RenderBlogPost(&blogPost); err != nil {
+			return errs.NewInternalErrorWithCause("Failed to render blog post", err)
+		}
+		blogPost.Summary = nil
+		if post.Summary != "" {
+			blogPost.Summary = &post.Summary
+		}
+		blogPost.URL = nil
+		if post.CanonicalURL != "" {
+			blogPost.URL = &post.CanonicalURL
+		}
+		if post.PublishedAt != nil {
+			blogPost.DateAdded = *post.PublishedAt
+		}
+		blogPost.DateEdited = &now
+		blogPost.Status = status
+		blogPost.PublishAt = nil
+		if status == models.BlogPostStatusScheduled {
+			blogPost.PublishAt = post.ScheduledAt
+		}
+		// Tags are replaced below rather than saved through the association
+		blogPost.Tags = nil
+
+		if err := validateRequest(&blogPost); err != nil {
+			return err
+		}
+		if err := applyBlogPostStatus(&blogPost, existing); err != nil {
+			return err
+		}
+		if err := tx.BlogPostRepo().Update(&blogPost); err != nil {
+			return wrapDatabaseError("update blog post", "blog_post", err)
+		}
+		if err := tx.BlogTagRepo().DeleteAllFor(id); err != nil {
+			return wrapDatabaseError("delete blog tags", "blog_tags", err)
+		}
+		if err := tx.BlogTagRepo().AddAll(newBlogTags(id, append(post.Tags, extraTags...))); err != nil {
+			return wrapDatabaseError("create blog tags", "blog_tags", err)
+		}
+		return nil
+	})
+
+	h.recordOutcome(&result, ImportStatusUpdated, id, err)
+	return result
+}
+
+// recordOutcome fills in result once its post has been saved, which gave it status and id unless err is set
+func (h importHandler) recordOutcome(result *ImportResult, status string, id uuid.UUID, err error) {
+	var apiErr *errs.ApiErr
+	switch {
+	case err == nil:
+		result.Status = status
+		result.ID = &id
+	case errors.As(err, &apiErr) && apiErr.Code == errs.EntityCode("blog_post", errs.CodeSuffixDuplicate):
+		result.Status = ImportStatusSkipped
+		result.Error = "a blog post with this title already exists"
+	case errors.As(err, &apiErr) && apiErr.StatusCode < http.StatusInternalServerError:
+		result.Error = apiErr.Error()
+	default:
+		h.logger.Error().Err(err).Str("file", result.File).Msg("Failed to save imported post")
+		result.Error = "failed to save the post"
+	}
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type indexAuditHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+	database  database.Database
+}
+
+func newIndexAuditHandler(database database.Database) indexAuditHandler {
+	logger := log.With().Str("handlerName", "indexAuditHandler").Logger()
+
+	return indexAuditHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+		database:  database,
+	}
+}
+
+// QueryPlanReport is how Postgres plans one of the main list queries
+type QueryPlanReport struct {
+	Name        string   `json:"name" example:"blog posts page"`
+	SQL         string   `json:"sql"`
+	TotalCost   float64  `json:"totalCost"`
+	SeqScans    []string `json:"seqScans"`
+	IndexesUsed []string `json:"indexesUsed"`
+	Warnings    []string `json:"warnings"`
+}
+
+// IndexAuditReport is the result of explaining the main list queries
+type IndexAuditReport struct {
+	MissingIndexes []string          `json:"missingIndexes" example:"idx_blog_post_date_added"`
+	Plans          []QueryPlanReport `json:"plans"`
+}
+
+// getIndexAudit explains the main list queries and reports missing indexes
+// @Summary Audit indexes
+// @Description Runs EXPLAIN on the queries behind the blog post and project lists, archive, tags and scheduled publishing, and lists the indexes they rely on that don't exist. A sequential scan on a small table is expected; one on a table missing an index is flagged with how to create it
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} IndexAuditReport "Query plans and missing indexes"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error explaining queries"
+// @Router /admin/indexes [get]
+func (h indexAuditHandler) getIndexAudit() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		audit, err := h.database.AuditIndexes()
+		if err != nil {
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("failed to audit indexes", err))
+			return
+		}
+
+		report := IndexAuditReport{
+			MissingIndexes: nonNil(audit.MissingIndexes),
+			Plans:          make([]QueryPlanReport, 0, len(audit.Plans)),
+		}
+		for _, plan := range audit.Plans {
+			report.Plans = append(report.Plans, QueryPlanReport{
+				Name:        plan.Name,
+				SQL:         plan.SQL,
+				TotalCost:   plan.TotalCost,
+				SeqScans:    nonNil(plan.SeqScans),
+				IndexesUsed: nonNil(plan.IndexesUsed),
+				Warnings:    nonNil(plan.Warnings),
+			})
+		}
+		h.responder.WriteJSON(w, report)
+	}
+}
+
+// nonNil returns values, or an empty slice in its place so it's encoded as [] rather than null
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
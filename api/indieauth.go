@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/services"
+)
+
+// indieAuthTokenCacheTTL is how long a verified token is trusted before its endpoint is asked again, so a client
+// posting a photo and then the entry using it costs one verification, not two
+const indieAuthTokenCacheTTL = 2 * time.Minute
+
+// maxCachedIndieAuthTokens bounds the cache; tokens are few, so reaching it means someone is sending made-up ones
+const maxCachedIndieAuthTokens = 256
+
+// Errors verify returns for tokens that aren't accepted
+var (
+	errIndieAuthDisabled = errors.New("INDIEAUTH_TOKEN_ENDPOINT and INDIEAUTH_ME (or BASE_URL) must be set to use Micropub")
+	errIndieAuthOtherMe  = errors.New("token was issued for another site")
+)
+
+// indieAuthVerifier checks IndieAuth access tokens against the token endpoint that issued them and makes sure they
+// were issued to the site's owner. Tokens are only ever kept as hashes
+type indieAuthVerifier struct {
+	tokenEndpoint string
+	me            string
+
+	mu       sync.Mutex
+	verified map[[sha256.Size]byte]verifiedIndieAuthToken
+}
+
+type verifiedIndieAuthToken struct {
+	token     services.IndieAuthToken
+	expiresAt time.Time
+}
+
+// newIndieAuthVerifier returns a verifier for tokens tokenEndpoint issues to me, the profile URL of the site's owner.
+// Without either, every token is rejected
+func newIndieAuthVerifier(tokenEndpoint, me string) *indieAuthVerifier {
+	return &indieAuthVerifier{
+		tokenEndpoint: tokenEndpoint,
+		me:            normalizeProfileURL(me),
+		verified:      make(map[[sha256.Size]byte]verifiedIndieAuthToken),
+	}
+}
+
+// verify returns what token was granted, or services.ErrIndieAuthTokenInvalid, errIndieAuthOtherMe or
+// errIndieAuthDisabled when it can't be used here. Any other error means the token endpoint couldn't be asked
+func (v *indieAuthVerifier) verify(ctx context.Context, token string) (*services.IndieAuthToken, error) {
+	if v.tokenEndpoint == "" || v.me == "" {
+		return nil, errIndieAuthDisabled
+	}
+	if token == "" {
+		return nil, services.ErrIndieAuthTokenInvalid
+	}
+
+	key := sha256.Sum256([]byte(token))
+	now := time.Now()
+	v.mu.Lock()
+	cached, ok := v.verified[key]
+	v.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return &cached.token, nil
+	}
+
+	verified, err := services.VerifyIndieAuthToken(ctx, v.tokenEndpoint, token)
+	if err != nil {
+		return nil, err
+	}
+	if normalizeProfileURL(verified.Me) != v.me {
+		return nil, errIndieAuthOtherMe
+	}
+
+	v.mu.Lock()
+	if len(v.verified) >= maxCachedIndieAuthTokens {
+		for cachedKey, entry := range v.verified {
+			if !now.Before(entry.expiresAt) {
+				delete(v.verified, cachedKey)
+			}
+		}
+		if len(v.verified) >= maxCachedIndieAuthTokens {
+			clear(v.verified)
+		}
+	}
+	v.verified[key] = verifiedIndieAuthToken{token: *verified, expiresAt: now.Add(indieAuthTokenCacheTTL)}
+	v.mu.Unlock()
+	return verified, nil
+}
+
+// normalizeProfileURL puts an IndieAuth profile URL in the form the spec compares them in: scheme and host lowercased,
+// and a path of at least /. Anything that isn't an http(s) URL normalizes to ""
+func normalizeProfileURL(profile string) string {
+	parsed, err := url.Parse(strings.TrimSpace(profile))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ""
+	}
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	parsed.Host = strings.ToLower(parsed.Host)
+	if parsed.Path == "" {
+		parsed.Path = "/"
+	}
+	parsed.RawQuery = ""
+	parsed.Fragment = ""
+	return parsed.String()
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/rpupo63/unified-personal-site-backend/models"
+)
+
+// jsonAPIMediaType is the media type clients send in Accept to get JSON:API documents instead of plain JSON
+const jsonAPIMediaType = "application/vnd.api+json"
+
+// JSONAPIDocument is a top-level JSON:API document
+// Data is a single JSONAPIResource or a slice of them
+type JSONAPIDocument struct {
+	Data     any               `json:"data"`
+	Included []JSONAPIResource `json:"included,omitempty"`
+	Meta     *ListMeta         `json:"meta,omitempty"`
+	Links    *ListLinks        `json:"links,omitempty"`
+}
+
+// JSONAPIResource is a JSON:API resource object
+type JSONAPIResource struct {
+	Type          string                         `json:"type"`
+	ID            string                         `json:"id"`
+	Attributes    map[string]any                 `json:"attributes"`
+	Relationships map[string]JSONAPIRelationship `json:"relationships,omitempty"`
+	Links         map[string]string              `json:"links,omitempty"`
+}
+
+// JSONAPIRelationship links a resource to the resources it has, such as its tags
+type JSONAPIRelationship struct {
+	Data []JSONAPIResourceIdentifier `json:"data"`
+}
+
+// JSONAPIResourceIdentifier points at a resource in a relationship
+type JSONAPIResourceIdentifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// wantsJSONAPI reports whether the client asked for JSON:API in its Accept header
+func wantsJSONAPI(r *http.Request) bool {
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err == nil && mediaType == jsonAPIMediaType {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteJSONAPI writes a JSON:API document with its own content type and the given status
+func (r Responder) WriteJSONAPI(w http.ResponseWriter, status int, document JSONAPIDocument) {
+	jsonData, err := json.Marshal(document)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("error marshaling JSON:API document")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", jsonAPIMediaType)
+	w.WriteHeader(status)
+	if _, err := w.Write(jsonData); err != nil {
+		r.logger.Error().Err(err).Msg("error writing response")
+	}
+}
+
+// jsonAPIAttributes turns a model into JSON:API attributes using its normal JSON field names
+// id and any relationship fields are left out since JSON:API carries them elsewhere
+func jsonAPIAttributes(model any, omit ...string) map[string]any {
+	attributes := map[string]any{}
+	encoded, err := json.Marshal(model)
+	if err != nil {
+		return attributes
+	}
+	if err := json.Unmarshal(encoded, &attributes); err != nil {
+		return attributes
+	}
+
+	delete(attributes, "id")
+	for _, field := range omit {
+		delete(attributes, field)
+	}
+	return attributes
+}
+
+// newBlogPostResource converts a blog post to a resource and its tags to included resources
+func newBlogPostResource(blogPost models.BlogPost, tags []models.BlogTag) (JSONAPIResource, []JSONAPIResource) {
+	resource := JSONAPIResource{
+		Type:       "blog-posts",
+		ID:         blogPost.ID.String(),
+		Attributes: jsonAPIAttributes(blogPost, "tags"),
+		Relationships: map[string]JSONAPIRelationship{
+			"tags": {Data: []JSONAPIResourceIdentifier{}},
+		},
+		Links: map[string]string{"self": "/blog-post/" + blogPost.ID.String()},
+	}
+
+	included := make([]JSONAPIResource, 0, len(tags))
+	for _, tag := range tags {
+		identifier := JSONAPIResourceIdentifier{Type: "blog-tags", ID: tag.ID.String()}
+		resource.Relationships["tags"] = JSONAPIRelationship{Data: append(resource.Relationships["tags"].Data, identifier)}
+		included = append(included, JSONAPIResource{
+			Type:       identifier.Type,
+			ID:         identifier.ID,
+			Attributes: map[string]any{"value": tag.Value},
+		})
+	}
+
+	return resource, included
+}
+
+// newProjectResource converts a project to a resource and its tags to included resources
+func newProjectResource(project models.Project, tags []models.ProjectTag) (JSONAPIResource, []JSONAPIResource) {
+	resource := JSONAPIResource{
+		Type:       "projects",
+		ID:         project.ID.String(),
+		Attributes: jsonAPIAttributes(project, "tags"),
+		Relationships: map[string]JSONAPIRelationship{
+			"tags": {Data: []JSONAPIResourceIdentifier{}},
+		},
+		Links: map[string]string{"self": "/project/" + project.ID.String()},
+	}
+
+	included := make([]JSONAPIResource, 0, len(tags))
+	for _, tag := range tags {
+		identifier := JSONAPIResourceIdentifier{Type: "project-tags", ID: tag.ID.String()}
+		resource.Relationships["tags"] = JSONAPIRelationship{Data: append(resource.Relationships["tags"].Data, identifier)}
+		included = append(included, JSONAPIResource{
+			Type:       identifier.Type,
+			ID:         identifier.ID,
+			Attributes: map[string]any{"value": tag.Value},
+		})
+	}
+
+	return resource, included
+}
+
+// newBlogPostDocument builds a single-resource document for a blog post
+func newBlogPostDocument(blogPost BlogPostWithTags) JSONAPIDocument {
+	resource, included := newBlogPostResource(blogPost.BlogPost, blogPost.Tags)
+	return JSONAPIDocument{Data: resource, Included: included}
+}
+
+// newBlogPostCollectionDocument builds a document for a page of blog posts, sharing the list meta and links
+func newBlogPostCollectionDocument(collection BlogPostCollectionWithTags) JSONAPIDocument {
+	document := JSONAPIDocument{Meta: &collection.Meta, Links: &collection.Links}
+	resources := make([]JSONAPIResource, 0, len(collection.Data))
+	for _, blogPost := range collection.Data {
+		resource, included := newBlogPostResource(blogPost.BlogPost, blogPost.Tags)
+		resources = append(resources, resource)
+		document.Included = append(document.Included, included...)
+	}
+	document.Data = resources
+	return document
+}
+
+// newProjectDocument builds a single-resource document for a project
+func newProjectDocument(project ProjectWithTags) JSONAPIDocument {
+	resource, included := newProjectResource(project.Project, project.Tags)
+	return JSONAPIDocument{Data: resource, Included: included}
+}
+
+// newProjectCollectionDocument builds a document for a page of projects, sharing the list meta and links
+func newProjectCollectionDocument(collection ProjectCollectionWithTags) JSONAPIDocument {
+	document := JSONAPIDocument{Meta: &collection.Meta, Links: &collection.Links}
+	resources := make([]JSONAPIResource, 0, len(collection.Data))
+	for _, project := range collection.Data {
+		resource, included := newProjectResource(project.Project, project.Tags)
+		resources = append(resources, resource)
+		document.Included = append(document.Included, included...)
+	}
+	document.Data = resources
+	return document
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// lifecycleAbortGrace is how long drain waits, after its deadline, for aborted jobs to record that they were interrupted
+const lifecycleAbortGrace = 5 * time.Second
+
+// lifecycle tracks the background workers and jobs started by the handlers so shutdown can drain them
+// Shutdown happens in two steps. First stopping is cancelled: workers finish the job in hand, hand back anything
+// they claimed but haven't started, and return. If they are still running at the drain deadline, work is cancelled
+// so in-flight jobs abort and can mark themselves for a retry after restart
+type lifecycle struct {
+	logger zerolog.Logger
+	wg     sync.WaitGroup
+
+	stopping context.Context
+	stop     context.CancelFunc
+	work     context.Context
+	abort    context.CancelFunc
+}
+
+func newLifecycle() *lifecycle {
+	logger := log.With().Str("handlerName", "lifecycle").Logger()
+	stopping, stop := context.WithCancel(context.Background())
+	work, abort := context.WithCancel(context.Background())
+	return &lifecycle{
+		logger:   logger,
+		stopping: stopping,
+		stop:     stop,
+		work:     work,
+		abort:    abort,
+	}
+}
+
+// run starts a long-running worker that should return soon after its context is done
+func (l *lifecycle) run(name string, worker func(stopping context.Context)) {
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		worker(l.stopping)
+		l.logger.Debug().Str("worker", name).Msg("Worker stopped")
+	}()
+}
+
+// goJob runs a one-off job in the background; its context is only cancelled if draining times out
+func (l *lifecycle) goJob(job func(work context.Context)) {
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		job(l.work)
+	}()
+}
+
+// drain stops every worker and waits for them and any running jobs until ctx is done
+// It must be called after the HTTP server has shut down, so no request can start a new job meanwhile
+func (l *lifecycle) drain(ctx context.Context) {
+	l.stop()
+
+	done := make(chan struct{})
+	go func() {
+		l.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		l.logger.Info().Msg("Background jobs drained")
+		return
+	case <-ctx.Done():
+	}
+
+	l.logger.Warn().Msg("Background jobs still running at shutdown deadline, aborting them")
+	l.abort()
+	select {
+	case <-done:
+	case <-time.After(lifecycleAbortGrace):
+		l.logger.Error().Msg("Background jobs did not stop after being aborted")
+	}
+}
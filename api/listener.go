@@ -0,0 +1,63 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/rpupo63/unified-personal-site-backend/restart"
+)
+
+// unixListenPrefix marks a LISTEN value as a Unix domain socket path rather than a TCP address
+const unixListenPrefix = "unix:"
+
+// parseListenAddress splits a LISTEN value into the network and address to listen on
+// "unix:/run/app.sock" listens on that socket; anything else is a TCP host:port
+func parseListenAddress(listen string) (string, string, error) {
+	if path, ok := strings.CutPrefix(listen, unixListenPrefix); ok {
+		if path == "" {
+			return "", "", errors.New("LISTEN must include a socket path after unix:")
+		}
+		return "unix", path, nil
+	}
+	if _, _, err := net.SplitHostPort(listen); err != nil {
+		return "", "", fmt.Errorf("LISTEN must be host:port or unix:/path: %w", err)
+	}
+	return "tcp", listen, nil
+}
+
+// parseSocketMode reads LISTEN_SOCKET_MODE as octal file permissions
+func parseSocketMode(mode string) (fs.FileMode, error) {
+	parsed, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || parsed > 0o777 {
+		return 0, fmt.Errorf("LISTEN_SOCKET_MODE must be octal permissions like 0660, got %q", mode)
+	}
+	return fs.FileMode(parsed), nil
+}
+
+// listen opens the listener for network and address through listenFunc
+// A Unix socket gets socketMode so a proxy running as another user can connect to it
+func listen(listenFunc restart.ListenFunc, network, address string, socketMode fs.FileMode) (net.Listener, error) {
+	listener, err := listenFunc(network, address)
+	if err != nil {
+		return nil, err
+	}
+	if network == "unix" {
+		if err := os.Chmod(address, socketMode); err != nil {
+			listener.Close()
+			return nil, err
+		}
+	}
+	return listener, nil
+}
+
+// serverListeners holds the sockets opened by Server.Listen until Start serves on them
+type serverListeners struct {
+	public   net.Listener
+	admin    net.Listener
+	redirect net.Listener
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"golang.org/x/text/language"
+)
+
+// errorLanguages are the languages error messages are available in, English first as the fallback
+var errorLanguages = []language.Tag{language.English, language.Spanish, language.Portuguese}
+
+var errorLanguageMatcher = language.NewMatcher(errorLanguages)
+
+// localizedErrorMessages translates error messages by error code
+// Entity codes without their own entry fall back to the generic code for their suffix, so PROJECT_TAGS_DUPLICATE
+// uses the CONFLICT message. English needs no entry since the errors are already written in it
+var localizedErrorMessages = map[string]map[string]string{
+	"es": {
+		errs.CodeBadRequest:           "Solicitud no válida",
+		errs.CodeInvalidField:         "Uno de los campos no es válido",
+		errs.CodeMissingRequiredField: "Falta un campo obligatorio",
+		errs.CodeValidationFailed:     "Algunos campos no son válidos",
+		errs.CodeMalformedPayload:     "El cuerpo de la solicitud no es válido",
+		errs.CodeInvalidJSON:          "El JSON de la solicitud no es válido",
+		errs.CodeMaxBodySizeExceeded:  "La solicitud es demasiado grande",
+		errs.CodePageTooLarge:         "Se pidieron demasiados resultados a la vez",
+		errs.CodeUnsupportedMediaType: "Tipo de contenido no admitido",
+		errs.CodeUnauthorized:         "No autorizado",
+		errs.CodeForbidden:            "Operación no permitida",
+		errs.CodeCORSBlocked:          "Origen no permitido",
+		errs.CodeNotFound:             "No se encontró el recurso",
+		errs.CodeRouteNotFound:        "No se encontró la ruta",
+		errs.CodeMethodNotAllowed:     "Método no permitido",
+		errs.CodeConflict:             "El recurso ya existe o está en conflicto",
+		errs.CodeRateLimitExceeded:    "Demasiadas solicitudes, inténtalo de nuevo más tarde",
+		errs.CodeRequestTimeout:       "La solicitud tardó demasiado",
+		errs.CodeDatabaseConnection:   "El servicio no está disponible en este momento",
+		errs.CodeDatabaseQuery:        "Ocurrió un error inesperado",
+		errs.CodeInternal:             "Ocurrió un error inesperado",
+
+		"BLOG_POST_NOT_FOUND":             "No se encontró el artículo",
+		"PROJECT_NOT_FOUND":               "No se encontró el proyecto",
+		"NOTE_NOT_FOUND":                  "No se encontró la nota",
+		"BOOK_NOT_FOUND":                  "No se encontró el libro",
+		"BOOKMARK_NOT_FOUND":              "No se encontró el enlace",
+		"TESTIMONIAL_NOT_FOUND":           "No se encontró el testimonio",
+		"GUESTBOOK_ENTRY_NOT_FOUND":       "No se encontró la entrada del libro de visitas",
+		"SHARE_LINK_NOT_FOUND":            "No se encontró el enlace compartido",
+		"SHORT_LINK_NOT_FOUND":            "No se encontró el enlace corto",
+		"SNIPPET_NOT_FOUND":               "No se encontró el fragmento de código",
+		"BLOG_POST_TRANSLATION_NOT_FOUND": "No se encontró la traducción del artículo",
+	},
+	"pt": {
+		errs.CodeBadRequest:           "Solicitação inválida",
+		errs.CodeInvalidField:         "Um dos campos é inválido",
+		errs.CodeMissingRequiredField: "Falta um campo obrigatório",
+		errs.CodeValidationFailed:     "Alguns campos são inválidos",
+		errs.CodeMalformedPayload:     "O corpo da solicitação é inválido",
+		errs.CodeInvalidJSON:          "O JSON da solicitação é inválido",
+		errs.CodeMaxBodySizeExceeded:  "A solicitação é grande demais",
+		errs.CodePageTooLarge:         "Foram pedidos resultados demais de uma vez",
+		errs.CodeUnsupportedMediaType: "Tipo de conteúdo não suportado",
+		errs.CodeUnauthorized:         "Não autorizado",
+		errs.CodeForbidden:            "Operação não permitida",
+		errs.CodeCORSBlocked:          "Origem não permitida",
+		errs.CodeNotFound:             "Recurso não encontrado",
+		errs.CodeRouteNotFound:        "Rota não encontrada",
+		errs.CodeMethodNotAllowed:     "Método não permitido",
+		errs.CodeConflict:             "O recurso já existe ou está em conflito",
+		errs.CodeRateLimitExceeded:    "Muitas solicitações, tente novamente mais tarde",
+		errs.CodeRequestTimeout:       "A solicitação demorou demais",
+		errs.CodeDatabaseConnection:   "O serviço está indisponível no momento",
+		errs.CodeDatabaseQuery:        "Ocorreu um erro inesperado",
+		errs.CodeInternal:             "Ocorreu um erro inesperado",
+
+		"BLOG_POST_NOT_FOUND":             "Artigo não encontrado",
+		"PROJECT_NOT_FOUND":               "Projeto não encontrado",
+		"NOTE_NOT_FOUND":                  "Nota não encontrada",
+		"BOOK_NOT_FOUND":                  "Livro não encontrado",
+		"BOOKMARK_NOT_FOUND":              "Link não encontrado",
+		"TESTIMONIAL_NOT_FOUND":           "Depoimento não encontrado",
+		"GUESTBOOK_ENTRY_NOT_FOUND":       "Entrada do livro de visitas não encontrada",
+		"SHARE_LINK_NOT_FOUND":            "Link compartilhado não encontrado",
+		"SHORT_LINK_NOT_FOUND":            "Link curto não encontrado",
+		"SNIPPET_NOT_FOUND":               "Trecho de código não encontrado",
+		"BLOG_POST_TRANSLATION_NOT_FOUND": "Tradução do artigo não encontrada",
+	},
+}
+
+// entityCodeFallbacks maps the suffixes of entity codes to the generic code whose message they fall back to
+var entityCodeFallbacks = map[string]string{
+	"_" + errs.CodeSuffixNotFound:         errs.CodeNotFound,
+	"_" + errs.CodeSuffixDuplicate:        errs.CodeConflict,
+	"_" + errs.CodeSuffixInvalidReference: errs.CodeBadRequest,
+}
+
+// localizedErrorMessage returns the message for code in lang, or false if there's no translation
+func localizedErrorMessage(lang, code string) (string, bool) {
+	messages, ok := localizedErrorMessages[lang]
+	if !ok {
+		return "", false
+	}
+	if message, ok := messages[code]; ok {
+		return message, true
+	}
+	for suffix, fallback := range entityCodeFallbacks {
+		if strings.HasSuffix(code, suffix) {
+			message, ok := messages[fallback]
+			return message, ok
+		}
+	}
+	return "", false
+}
+
+// languageResponseWriter carries the language negotiated for a request down to Responder.WriteError,
+// which only sees the response writer
+type languageResponseWriter struct {
+	http.ResponseWriter
+	lang string
+}
+
+func (w *languageResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// negotiateErrorLanguage picks the error message language from the Accept-Language header
+// The language travels with the response writer, so every handler's errors are localized without changes
+func negotiateErrorLanguage(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Accept-Language")
+		if header == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		tags, _, _ := language.ParseAcceptLanguage(header)
+		_, index, confidence := errorLanguageMatcher.Match(tags...)
+		if confidence == language.No || index == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		base, _ := errorLanguages[index].Base()
+		next.ServeHTTP(&languageResponseWriter{ResponseWriter: w, lang: base.String()}, r)
+	})
+}
+
+// errorLanguage returns the language negotiated for the response written to w, or "" for English
+// It looks through the writers wrapped around it by later middleware
+func errorLanguage(w http.ResponseWriter) string {
+	for {
+		switch writer := w.(type) {
+		case *languageResponseWriter:
+			return writer.lang
+		case interface{ Unwrap() http.ResponseWriter }:
+			w = writer.Unwrap()
+		default:
+			return ""
+		}
+	}
+}
@@ -0,0 +1,407 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/config"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultMediaPerPage = 50
+	maxMediaPerPage     = database.MaxPageSize
+
+	// mediaObjectKeyPrefix is the folder of the bucket uploads are stored in
+	mediaObjectKeyPrefix = "uploads/"
+
+	defaultMediaVariantWidths  = "480,960,1600"
+	defaultMediaThumbnailSize  = 200
+	defaultMediaVariantQuality = 80
+)
+
+type mediaHandler struct {
+	responder       Responder
+	logger          zerolog.Logger
+	mediaFileRepo   *database.MediaFileRepo
+	mediaObjectRepo *database.MediaObjectRepo
+	// storage is nil when no object store is configured, which turns POST /media off
+	storage       *services.MediaStorage
+	maxUploadSize int
+	variants      services.ImageVariantOptions
+}
+
+func newMediaHandler(mediaFileRepo *database.MediaFileRepo, mediaObjectRepo *database.MediaObjectRepo, storage *services.MediaStorage, maxUploadSize int, variants services.ImageVariantOptions) mediaHandler {
+	logger := log.With().Str("handlerName", "mediaHandler").Logger()
+
+	return mediaHandler{
+		responder:       NewResponder(logger),
+		logger:          logger,
+		mediaFileRepo:   mediaFileRepo,
+		mediaObjectRepo: mediaObjectRepo,
+		storage:         storage,
+		maxUploadSize:   maxUploadSize,
+		variants:        variants,
+	}
+}
+
+// newMediaStorage returns the bucket MEDIA_STORAGE names in cfg, or nil when it's unset or not fully configured
+// Supabase needs SUPABASE_URL and SUPABASE_SERVICE_ROLE_KEY, and S3 S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY, with
+// S3_ENDPOINT defaulting to AWS in S3_REGION (us-east-1 unless set). Both need MEDIA_BUCKET
+func newMediaStorage(cfg map[string]string) *services.MediaStorage {
+	logger := log.With().Str("handlerName", "mediaHandler").Logger()
+
+	storage := services.MediaStorage{
+		Provider:      strings.ToLower(strings.TrimSpace(config.GetString(cfg, "MEDIA_STORAGE", ""))),
+		Bucket:        config.GetString(cfg, "MEDIA_BUCKET", ""),
+		PublicBaseURL: config.GetString(cfg, "MEDIA_PUBLIC_BASE_URL", ""),
+	}
+	switch storage.Provider {
+	case "":
+		return nil
+	case services.MediaStorageSupabase:
+		storage.Endpoint = config.GetString(cfg, "SUPABASE_URL", "")
+		storage.SecretKey = config.GetString(cfg, "SUPABASE_SERVICE_ROLE_KEY", "")
+	case services.MediaStorageS3:
+		storage.Region = config.GetString(cfg, "S3_REGION", "us-east-1")
+		storage.Endpoint = config.GetString(cfg, "S3_ENDPOINT", "https://s3."+storage.Region+".amazonaws.com")
+		storage.AccessKeyID = config.GetString(cfg, "S3_ACCESS_KEY_ID", "")
+		storage.SecretKey = config.GetString(cfg, "S3_SECRET_ACCESS_KEY", "")
+	default:
+		logger.Warn().Str("provider", storage.Provider).Msg("Unknown media storage, turning media uploads off")
+		return nil
+	}
+	if storage.Endpoint == "" || storage.Bucket == "" || storage.SecretKey == "" || (storage.Provider == services.MediaStorageS3 && storage.AccessKeyID == "") {
+		logger.Warn().Str("provider", storage.Provider).Msg("MEDIA_BUCKET and the storage's URL and keys are required to upload media, turning media uploads off")
+		return nil
+	}
+	return &storage
+}
+
+// MediaObjectCollection represents one page of uploaded media
+type MediaObjectCollection struct {
+	Data  []models.MediaObject `json:"data"`
+	Meta  ListMeta             `json:"meta"`
+	Links ListLinks            `json:"links"`
+}
+
+// uploadMedia stores a file in the object store bucket
+// @Summary Upload media
+// @Description Stores a file, sent as the file field of a multipart form, in the MEDIA_STORAGE bucket (Supabase Storage or S3) and returns the public URL it's served from, also sent as Location. PNG, JPEG, GIF and WebP images, PDFs, MP4 and WebM videos and MP3s are accepted, told apart by their content rather than their name or the type they're sent as, up to MEDIA_UPLOAD_MAX_MB (defaults to 25). PNG, JPEG and GIF images also get resized variants, stored alongside: one for each of MEDIA_VARIANT_WIDTHS narrower than the image and a square thumbnail, returned with a srcset of the image and its web-sized variants
+// @Tags Media
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "File to upload"
+// @Success 201 {object} models.MediaObject "Uploaded file"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Missing file, or a type that isn't accepted"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 413 {object} api.ErrorResponse "Request Entity Too Large - File over MEDIA_UPLOAD_MAX_MB"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error storing file"
+// @Failure 503 {object} api.ErrorResponse "Service Unavailable - No media storage configured"
+// @Router /media [post]
+func (h mediaHandler) uploadMedia() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if h.storage == nil {
+			h.responder.WriteError(w, errs.NewApiErr(http.StatusServiceUnavailable, "media storage is not configured; set MEDIA_STORAGE and MEDIA_BUCKET"))
+			return
+		}
+
+		// The form's other fields and boundaries get a megabyte on top of the file
+		maxRequestSize := int64(h.maxUploadSize) + 1<<20
+		r.Body = http.MaxBytesReader(w, r.Body, maxRequestSize)
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				h.responder.WriteError(w, errs.NewMaxBodySizeExceededError(maxRequestSize))
+				return
+			}
+			h.responder.WriteError(w, errs.NewMissingRequiredFieldError("file"))
+			return
+		}
+		defer file.Close()
+
+		data, err := io.ReadAll(io.LimitReader(file, int64(h.maxUploadSize)+1))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("failed to read uploaded file"))
+			return
+		}
+		fileName := path.Base(header.Filename)
+		contentType, extension, err := services.CheckMediaUpload(fileName, data, h.maxUploadSize)
+		if err != nil {
+			h.responder.WriteError(w, errs.NewInvalidFieldError("file", err.Error()))
+			return
+		}
+
+		id := uuid.New()
+		mediaObject := models.MediaObject{
+			ID:          id,
+			Key:         mediaObjectKeyPrefix + id.String() + extension,
+			FileName:    fileName,
+			ContentType: contentType,
+			Size:        len(data),
+			Variants:    []models.MediaVariant{},
+			DateAdded:   time.Now(),
+		}
+		if err := h.store(r.Context(), &mediaObject, data); err != nil {
+			h.logger.Error().Err(err).Str("key", mediaObject.Key).Msg("Failed to upload media")
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("Failed to store file", err))
+			return
+		}
+
+		if err := h.mediaObjectRepo.Add(&mediaObject); err != nil {
+			// Without its record the object could never be listed or deleted, so it's taken back out
+			if deleteErr := h.deleteStored(context.WithoutCancel(r.Context()), mediaObject); deleteErr != nil {
+				h.logger.Error().Err(deleteErr).Str("key", mediaObject.Key).Msg("Failed to delete unrecorded media")
+			}
+			h.responder.WriteError(w, wrapDatabaseError("create media object", "media_object", err))
+			return
+		}
+
+		w.Header().Set("Location", mediaObject.URL)
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, mediaObject)
+	}
+}
+
+// getMediaObjects lists uploaded media
+// @Summary Get uploaded media
+// @Description Lists the files uploaded with POST /media, newest first, with the URLs they're served from. Images stored with blog posts, such as by the new-post command, aren't listed
+// @Tags Media
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number (starts at 1)" default(1)
+// @Param perPage query int false "Files per page (max 100)" default(50)
+// @Success 200 {object} MediaObjectCollection "Page of uploaded media"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid pagination parameters"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching media"
+// @Router /media [get]
+func (h mediaHandler) getMediaObjects() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pagination, err := parsePagination(r, defaultMediaPerPage, maxMediaPerPage)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		mediaObjects, total, err := h.mediaObjectRepo.FindPage(pagination.Offset(), pagination.PerPage)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find media objects", "media_objects", err))
+			return
+		}
+
+		meta, links := newListMetaAndLinks(r, pagination, total)
+		response := MediaObjectCollection{
+			Data:  make([]models.MediaObject, 0, len(mediaObjects)),
+			Meta:  meta,
+			Links: links,
+		}
+		for _, mediaObject := range mediaObjects {
+			response.Data = append(response.Data, *mediaObject)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// deleteMediaObject removes an uploaded file from the bucket
+// @Summary Delete uploaded media
+// @Description Deletes a file uploaded with POST /media, and its variants, from the bucket, after which their URLs stop working. Posts linking to it aren't changed
+// @Tags Media
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param mediaID path string true "Uploaded file ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid mediaID"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Uploaded file not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting file"
+// @Failure 503 {object} api.ErrorResponse "Service Unavailable - No media storage configured"
+// @Router /media/{mediaID} [delete]
+func (h mediaHandler) deleteMediaObject() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		mediaID, err := uuid.Parse(chi.URLParam(r, "mediaID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid mediaID"))
+			return
+		}
+		if h.storage == nil {
+			h.responder.WriteError(w, errs.NewApiErr(http.StatusServiceUnavailable, "media storage is not configured; set MEDIA_STORAGE and MEDIA_BUCKET"))
+			return
+		}
+
+		mediaObject, err := h.mediaObjectRepo.FindByID(mediaID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find media object", "media_object", err))
+			return
+		}
+
+		// The objects go first, so a failed delete leaves the record to retry it with
+		if err := h.deleteStored(r.Context(), *mediaObject); err != nil {
+			h.logger.Error().Err(err).Str("key", mediaObject.Key).Msg("Failed to delete media")
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("Failed to delete file", err))
+			return
+		}
+		if err := h.mediaObjectRepo.Delete(mediaID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete media object", "media_object", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "media deleted successfully",
+		})
+	}
+}
+
+// getMedia serves an uploaded image
+// @Summary Get media file
+// @Description Serves an image uploaded with a blog post, such as by the new-post command. A stored file never changes, so it's cached for a year
+// @Tags Media
+// @Produce image/png,image/jpeg,image/gif,image/webp
+// @Param mediaID path string true "Media file ID" format(uuid)
+// @Success 200 {file} file "The image"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid mediaID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Media file not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching media file"
+// @Router /media/{mediaID} [get]
+func (h mediaHandler) getMedia() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		mediaID, err := uuid.Parse(chi.URLParam(r, "mediaID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid mediaID"))
+			return
+		}
+
+		etag := `"` + mediaID.String() + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		mediaFile, err := h.mediaFileRepo.FindByID(mediaID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find media file", "media_file", err))
+			return
+		}
+
+		w.Header().Set("Content-Type", mediaFile.ContentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(mediaFile.Data)))
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		w.Header().Set("ETag", etag)
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if _, err := w.Write(mediaFile.Data); err != nil {
+			h.logger.Error().Err(err).Msg("error writing media file")
+		}
+	}
+}
+
+// store uploads data, the file mediaObject describes, to the bucket, along with the variants made of it if it's an
+// image, and fills in their URLs and the image's size and srcset. An image that can't be resized is stored without
+// variants. When any upload fails, those already made are deleted again
+func (h mediaHandler) store(ctx context.Context, mediaObject *models.MediaObject, data []byte) error {
+	if err := services.PutMediaObject(ctx, *h.storage, mediaObject.Key, mediaObject.ContentType, data); err != nil {
+		return err
+	}
+	mediaObject.URL = h.storage.PublicURL(mediaObject.Key)
+	if !resizableMediaTypes[mediaObject.ContentType] {
+		return nil
+	}
+
+	width, height, err := services.ImageSize(data)
+	if err != nil {
+		h.logger.Warn().Err(err).Str("key", mediaObject.Key).Msg("Failed to read uploaded image's size, storing it without variants")
+		return nil
+	}
+	mediaObject.Width, mediaObject.Height = width, height
+	variants, err := services.ResizeImage(data, h.variants)
+	if err != nil {
+		h.logger.Warn().Err(err).Str("key", mediaObject.Key).Msg("Failed to resize uploaded image, storing it without variants")
+		return nil
+	}
+
+	for _, variant := range variants {
+		key := strings.TrimSuffix(mediaObject.Key, path.Ext(mediaObject.Key)) + "-" + variant.Name + variant.Extension
+		if err := services.PutMediaObject(ctx, *h.storage, key, variant.ContentType, variant.Data); err != nil {
+			if deleteErr := h.deleteStored(context.WithoutCancel(ctx), *mediaObject); deleteErr != nil {
+				h.logger.Error().Err(deleteErr).Str("key", mediaObject.Key).Msg("Failed to delete partly uploaded media")
+			}
+			return err
+		}
+		mediaObject.Variants = append(mediaObject.Variants, models.MediaVariant{
+			Name:        variant.Name,
+			Key:         key,
+			URL:         h.storage.PublicURL(key),
+			ContentType: variant.ContentType,
+			Size:        len(variant.Data),
+			Width:       variant.Width,
+			Height:      variant.Height,
+		})
+	}
+	mediaObject.Srcset = mediaSrcset(*mediaObject)
+	return nil
+}
+
+// deleteStored removes mediaObject and its variants from the bucket, variants first
+func (h mediaHandler) deleteStored(ctx context.Context, mediaObject models.MediaObject) error {
+	for _, variant := range mediaObject.Variants {
+		if err := services.DeleteMediaObject(ctx, *h.storage, variant.Key); err != nil {
+			return err
+		}
+	}
+	return services.DeleteMediaObject(ctx, *h.storage, mediaObject.Key)
+}
+
+// resizableMediaTypes are the uploads variants are made of. WebP images can be uploaded but not decoded, so they're
+// stored as they are
+var resizableMediaTypes = map[string]bool{"image/png": true, "image/jpeg": true, "image/gif": true}
+
+// mediaSrcset lists mediaObject's web-sized variants and the image itself by width, narrowest first
+func mediaSrcset(mediaObject models.MediaObject) string {
+	var candidates []string
+	for _, variant := range mediaObject.Variants {
+		if variant.Name != services.ThumbnailVariant {
+			candidates = append(candidates, variant.URL+" "+strconv.Itoa(variant.Width)+"w")
+		}
+	}
+	candidates = append(candidates, mediaObject.URL+" "+strconv.Itoa(mediaObject.Width)+"w")
+	return strings.Join(candidates, ", ")
+}
+
+// parseVariantWidths reads MEDIA_VARIANT_WIDTHS, a comma-separated list of widths, leaving out any that aren't a
+// positive number
+func parseVariantWidths(widths string) []int {
+	var parsed []int
+	for _, field := range strings.Split(widths, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		width, err := strconv.Atoi(field)
+		if err != nil || width <= 0 {
+			log.Warn().Str("width", field).Msg("Ignoring invalid MEDIA_VARIANT_WIDTHS entry")
+			continue
+		}
+		parsed = append(parsed, width)
+	}
+	slices.Sort(parsed)
+	return slices.Compact(parsed)
+}
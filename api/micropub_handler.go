@@ -0,0 +1,997 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"maps"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// maxMicropubRequestSize leaves room for a photo of the largest size stored alongside the post's own fields
+const maxMicropubRequestSize = services.MaxMediaFileSize + 1<<20
+
+// micropubFormMemory is how much of a multipart request is kept in memory; the rest of its files go to disk
+const micropubFormMemory = 1 << 20
+
+// Error codes the Micropub spec defines, plus OAuth's for a token endpoint that can't be reached
+const (
+	micropubInvalidRequest         = "invalid_request"
+	micropubUnauthorized           = "unauthorized"
+	micropubForbidden              = "forbidden"
+	micropubInsufficientScope      = "insufficient_scope"
+	micropubTemporarilyUnavailable = "temporarily_unavailable"
+)
+
+// micropubPlatformNames are the names Micropub clients show for the platforms posts can be syndicated to
+var micropubPlatformNames = map[string]string{
+	"substack": "Substack",
+	"medium":   "Medium",
+	"twitter":  "Twitter",
+	"linkedin": "LinkedIn",
+	"mastodon": "Mastodon",
+}
+
+type micropubHandler struct {
+	responder     Responder
+	logger        zerolog.Logger
+	blogPosts     blogPostHandler
+	notes         noteHandler
+	mediaFileRepo *database.MediaFileRepo
+	indieAuth     *indieAuthVerifier
+	baseURL       string
+	apiBaseURL    string
+}
+
+// newMicropubHandler returns a handler that saves posts through blogPosts and notes, so they're cross-posted and
+// announced just as posts created through the rest of the API are
+func newMicropubHandler(blogPosts blogPostHandler, notes noteHandler, mediaFileRepo *database.MediaFileRepo, indieAuth *indieAuthVerifier, baseURL, apiBaseURL string) micropubHandler {
+	logger := log.With().Str("handlerName", "micropubHandler").Logger()
+
+	return micropubHandler{
+		responder:     NewResponder(logger),
+		logger:        logger,
+		blogPosts:     blogPosts,
+		notes:         notes,
+		mediaFileRepo: mediaFileRepo,
+		indieAuth:     indieAuth,
+		baseURL:       strings.TrimSuffix(baseURL, "/"),
+		apiBaseURL:    strings.TrimSuffix(apiBaseURL, "/"),
+	}
+}
+
+// MicropubError is the error body Micropub clients expect
+type MicropubError struct {
+	Error       string `json:"error" example:"insufficient_scope"`
+	Description string `json:"error_description,omitempty" example:"token needs the create scope"`
+}
+
+// MicropubSyndicationTarget is a platform a Micropub client can offer to cross-post to
+type MicropubSyndicationTarget struct {
+	UID  string `json:"uid" example:"mastodon"`
+	Name string `json:"name" example:"Mastodon"`
+}
+
+// MicropubConfig tells Micropub clients where to upload media and which platforms posts can be syndicated to
+type MicropubConfig struct {
+	MediaEndpoint string                      `json:"media-endpoint,omitempty" example:"https://api.example.com/micropub/media"`
+	SyndicateTo   []MicropubSyndicationTarget `json:"syndicate-to"`
+}
+
+// MicropubSyndicateTo lists the platforms posts can be syndicated to
+type MicropubSyndicateTo struct {
+	SyndicateTo []MicropubSyndicationTarget `json:"syndicate-to"`
+}
+
+// MicropubSource is a post's properties, as a Micropub client loads them for editing
+type MicropubSource struct {
+	Type       []string         `json:"type,omitempty" example:"h-entry"`
+	Properties map[string][]any `json:"properties" swaggertype:"object"`
+}
+
+// micropubRequest is a Micropub request, decoded from whichever encoding the client sent it in
+type micropubRequest struct {
+	entryType    string // Without the h- prefix
+	action       string
+	url          string
+	properties   map[string][]any
+	replace      map[string][]any
+	add          map[string][]any
+	deleteNames  []string         // Properties to remove entirely
+	deleteValues map[string][]any // Values to remove from properties
+	photos       []*multipart.FileHeader
+	accessToken  string
+}
+
+// micropubJSONRequest is the JSON encoding of a Micropub request
+type micropubJSONRequest struct {
+	Type       []string         `json:"type"`
+	Action     string           `json:"action"`
+	URL        string           `json:"url"`
+	Properties map[string][]any `json:"properties"`
+	Replace    map[string][]any `json:"replace"`
+	Add        map[string][]any `json:"add"`
+	Delete     json.RawMessage  `json:"delete"`
+}
+
+// micropubPhoto is a photo property: a URL and, from JSON requests, its alt text
+type micropubPhoto struct {
+	URL string
+	Alt string
+}
+
+// query answers a Micropub client's questions about the endpoint and the posts on it
+// @Summary Micropub query
+// @Description Answers Micropub queries. q=config returns the media endpoint and the platforms posts can be syndicated to, q=syndicate-to only the platforms, and q=source the properties of the blog post or note at url, limited to the ones named by properties[] if given. Takes an IndieAuth access token with any scope, in the Authorization header or an access_token parameter, issued to INDIEAUTH_ME by INDIEAUTH_TOKEN_ENDPOINT
+// @Tags Micropub
+// @Produce json
+// @Security IndieAuth
+// @Param q query string true "What to return" Enums(config, syndicate-to, source)
+// @Param url query string false "URL of the post, for q=source. Its last path segment must be the post's ID"
+// @Success 200 {object} MicropubConfig "Endpoint configuration, for q=config; q=syndicate-to returns just syndicate-to, q=source a MicropubSource"
+// @Failure 400 {object} MicropubError "Bad Request - Unknown query or invalid url"
+// @Failure 401 {object} MicropubError "Unauthorized - Missing or invalid access token, or IndieAuth isn't configured"
+// @Failure 403 {object} MicropubError "Forbidden - Token was issued for another site"
+// @Failure 404 {object} MicropubError "Not Found - No blog post or note has that URL"
+// @Router /micropub [get]
+func (h micropubHandler) query() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := h.authorize(w, r, r.URL.Query().Get("access_token")); !ok {
+			return
+		}
+
+		switch q := r.URL.Query().Get("q"); q {
+		case "config":
+			config := MicropubConfig{SyndicateTo: micropubSyndicationTargets()}
+			if h.apiBaseURL != "" {
+				config.MediaEndpoint = h.apiBaseURL + "/micropub/media"
+			}
+			h.responder.WriteJSON(w, config)
+		case "syndicate-to":
+			h.responder.WriteJSON(w, MicropubSyndicateTo{SyndicateTo: micropubSyndicationTargets()})
+		case "source":
+			blogPost, note, err := h.findPost(r.URL.Query().Get("url"))
+			if err != nil {
+				h.writeAPIError(w, err)
+				return
+			}
+			source := h.source(blogPost, note)
+			if names := append(r.URL.Query()["properties[]"], r.URL.Query()["properties"]...); len(names) > 0 {
+				// Only the properties asked for are returned, and without the type, as the spec has it
+				for name := range source.Properties {
+					if !slices.Contains(names, name) {
+						delete(source.Properties, name)
+					}
+				}
+				source.Type = nil
+			}
+			h.responder.WriteJSON(w, source)
+		default:
+			h.writeError(w, http.StatusBadRequest, micropubInvalidRequest, "unsupported query "+q+"; q must be config, syndicate-to or source")
+		}
+	}
+}
+
+// post creates, updates or deletes a post
+// @Summary Micropub post
+// @Description Creates, updates or deletes a post from a Micropub client such as Quill. Bodies are form-encoded, multipart (with photo files) or JSON, as the Micropub spec has them. An h-entry with a name becomes a blog post and one without a note; category becomes tags, photo is added to the post, published dates it (a blog post dated in the future is scheduled) and post-status draft saves a blog post as a draft. New published posts are cross-posted to the platforms named by mp-syndicate-to, through the same pipeline as POST /blog-post and POST /note, and created posts are answered with 201 and a Location header. action=update (JSON only) replaces name, content, summary, category or post-status of a blog post, or content or photo of a note, adds or deletes categories and deletes summary or photo; action=delete moves the blog post at url to the trash, or deletes the note at it, and action=undelete takes a blog post out of the trash. Takes an IndieAuth access token with the create, update, delete or undelete scope to match, in the Authorization header or an access_token field
+// @Tags Micropub
+// @Accept x-www-form-urlencoded,mpfd,json
+// @Produce json
+// @Security IndieAuth
+// @Success 201 "Post created, at the URL in the Location header"
+// @Success 204 "Post updated or deleted"
+// @Failure 400 {object} MicropubError "Bad Request - Invalid post or unsupported action"
+// @Failure 401 {object} MicropubError "Unauthorized - Missing or invalid access token, or IndieAuth isn't configured"
+// @Failure 403 {object} MicropubError "Forbidden - Token was issued for another site, or lacks the scope the action needs"
+// @Failure 404 {object} MicropubError "Not Found - No blog post or note has that URL"
+// @Failure 413 {object} MicropubError "Request Entity Too Large - Request is over 11 MB"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error saving post"
+// @Router /micropub [post]
+func (h micropubHandler) post() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := h.decodeRequest(w, r)
+		if err != nil {
+			h.writeAPIError(w, err)
+			return
+		}
+
+		switch req.action {
+		case "", "create":
+			if _, ok := h.authorize(w, r, req.accessToken, "create", "post"); !ok {
+				return
+			}
+			h.create(w, r, req)
+		case "update":
+			if _, ok := h.authorize(w, r, req.accessToken, "update"); !ok {
+				return
+			}
+			if err := h.update(req); err != nil {
+				h.writeAPIError(w, err)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case "delete":
+			if _, ok := h.authorize(w, r, req.accessToken, "delete"); !ok {
+				return
+			}
+			if err := h.delete(req.url); err != nil {
+				h.writeAPIError(w, err)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case "undelete":
+			if _, ok := h.authorize(w, r, req.accessToken, "undelete", "delete"); !ok {
+				return
+			}
+			if err := h.undelete(req.url); err != nil {
+				h.writeAPIError(w, err)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			h.writeError(w, http.StatusBadRequest, micropubInvalidRequest, "unsupported action "+req.action)
+		}
+	}
+}
+
+// uploadMedia stores a file from a Micropub client's media endpoint upload
+// @Summary Micropub media upload
+// @Description Stores the PNG, JPEG, GIF or WebP image sent as the multipart file field, of up to 10 MB, and answers with 201 and the URL it's served at, under /media, in the Location header. Uploading an image already stored links to that copy. Takes an IndieAuth access token with the media or create scope, in the Authorization header or an access_token field
+// @Tags Micropub
+// @Accept mpfd
+// @Produce json
+// @Security IndieAuth
+// @Success 201 "Image stored, at the URL in the Location header"
+// @Failure 400 {object} MicropubError "Bad Request - No file, or not a supported image"
+// @Failure 401 {object} MicropubError "Unauthorized - Missing or invalid access token, or IndieAuth isn't configured"
+// @Failure 403 {object} MicropubError "Forbidden - Token was issued for another site, or lacks the media scope"
+// @Failure 413 {object} MicropubError "Request Entity Too Large - Request is over 11 MB"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error storing image"
+// @Router /micropub/media [post]
+func (h micropubHandler) uploadMedia() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, maxMicropubRequestSize)
+		if err := r.ParseMultipartForm(micropubFormMemory); err != nil {
+			h.writeAPIError(w, micropubBodyError(err))
+			return
+		}
+		defer r.MultipartForm.RemoveAll()
+
+		if _, ok := h.authorize(w, r, r.PostForm.Get("access_token"), "media", "create"); !ok {
+			return
+		}
+
+		files := r.MultipartForm.File["file"]
+		if len(files) != 1 {
+			h.writeError(w, http.StatusBadRequest, micropubInvalidRequest, "the image must be sent as the one file field")
+			return
+		}
+		mediaURL, err := h.storeMedia(files[0])
+		if err != nil {
+			h.writeAPIError(w, err)
+			return
+		}
+
+		w.Header().Set("Location", mediaURL)
+		w.WriteHeader(http.StatusCreated)
+	}
+}
+
+// authorize checks the access token in r's Authorization header, or if there's none formToken, and that it was
+// granted one of scopes if any are given, answering the client itself when it wasn't
+func (h micropubHandler) authorize(w http.ResponseWriter, r *http.Request, formToken string, scopes ...string) (*services.IndieAuthToken, bool) {
+	token := formToken
+	if authHeader := r.Header.Get("Authorization"); strings.HasPrefix(authHeader, "Bearer ") {
+		token = strings.TrimPrefix(authHeader, "Bearer ")
+	}
+
+	verified, err := h.indieAuth.verify(r.Context(), token)
+	switch {
+	case errors.Is(err, errIndieAuthDisabled):
+		h.writeError(w, http.StatusUnauthorized, micropubUnauthorized, err.Error())
+		return nil, false
+	case errors.Is(err, services.ErrIndieAuthTokenInvalid):
+		h.writeError(w, http.StatusUnauthorized, micropubUnauthorized, "a valid IndieAuth access token is required")
+		return nil, false
+	case errors.Is(err, errIndieAuthOtherMe):
+		h.writeError(w, http.StatusForbidden, micropubForbidden, err.Error())
+		return nil, false
+	case err != nil:
+		h.logger.Error().Err(err).Msg("Failed to verify IndieAuth token")
+		h.writeError(w, http.StatusServiceUnavailable, micropubTemporarilyUnavailable, "the token endpoint couldn't be reached")
+		return nil, false
+	}
+
+	if len(scopes) > 0 && !slices.ContainsFunc(scopes, verified.HasScope) {
+		h.writeError(w, http.StatusForbidden, micropubInsufficientScope, "token needs the "+scopes[0]+" scope")
+		return nil, false
+	}
+	return verified, true
+}
+
+// decodeRequest reads a form-encoded, multipart or JSON Micropub request
+func (h micropubHandler) decodeRequest(w http.ResponseWriter, r *http.Request) (*micropubRequest, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxMicropubRequestSize)
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	req := &micropubRequest{properties: make(map[string][]any)}
+
+	var form url.Values
+	switch mediaType {
+	case "application/json":
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, micropubBodyError(err)
+		}
+		var decoded micropubJSONRequest
+		if err := json.Unmarshal(body, &decoded); err != nil {
+			return nil, errs.NewInvalidJSONError(err)
+		}
+		if len(decoded.Type) > 0 {
+			req.entryType = strings.TrimPrefix(decoded.Type[0], "h-")
+		}
+		req.action = decoded.Action
+		req.url = decoded.URL
+		if decoded.Properties != nil {
+			req.properties = decoded.Properties
+		}
+		req.replace = decoded.Replace
+		req.add = decoded.Add
+		if len(decoded.Delete) > 0 {
+			// A list of property names removes them, an object of lists removes those values
+			if err := json.Unmarshal(decoded.Delete, &req.deleteNames); err != nil {
+				if err := json.Unmarshal(decoded.Delete, &req.deleteValues); err != nil {
+					return nil, errs.NewInvalidFieldError("delete", "must be a list of property names or an object of values to remove")
+				}
+			}
+		}
+		return req, nil
+	case "multipart/form-data":
+		if err := r.ParseMultipartForm(micropubFormMemory); err != nil {
+			return nil, micropubBodyError(err)
+		}
+		form = r.PostForm
+		req.photos = append(r.MultipartForm.File["photo"], r.MultipartForm.File["photo[]"]...)
+	case "application/x-www-form-urlencoded", "":
+		if err := r.ParseForm(); err != nil {
+			return nil, micropubBodyError(err)
+		}
+		form = r.PostForm
+	default:
+		return nil, errs.NewUnsupportedMediaTypeError(mediaType, []string{"application/x-www-form-urlencoded", "multipart/form-data", "application/json"})
+	}
+
+	for key, values := range form {
+		switch name := strings.TrimSuffix(key, "[]"); name {
+		case "h":
+			req.entryType = strings.TrimSpace(form.Get(key))
+		case "action":
+			req.action = strings.TrimSpace(form.Get(key))
+		case "url":
+			req.url = strings.TrimSpace(form.Get(key))
+		case "access_token":
+			req.accessToken = form.Get(key)
+		default:
+			for _, value := range values {
+				req.properties[name] = append(req.properties[name], value)
+			}
+		}
+	}
+	return req, nil
+}
+
+// create saves a new h-entry as a blog post if it has a name, or as a note
+func (h micropubHandler) create(w http.ResponseWriter, r *http.Request, req *micropubRequest) {
+	if req.entryType != "" && req.entryType != "entry" {
+		h.writeError(w, http.StatusBadRequest, micropubInvalidRequest, "only h-entry posts are supported")
+		return
+	}
+	published, err := micropubPublished(req.properties)
+	if err != nil {
+		h.writeAPIError(w, err)
+		return
+	}
+
+	photos := micropubPhotos(req.properties["photo"])
+	for _, file := range req.photos {
+		mediaURL, err := h.storeMedia(file)
+		if err != nil {
+			h.writeAPIError(w, err)
+			return
+		}
+		photos = append(photos, micropubPhoto{URL: mediaURL})
+	}
+	syndicateTo := micropubTexts(req.properties["mp-syndicate-to"])
+
+	var location string
+	if firstMicropubText(req.properties, "name") != "" {
+		location, err = h.createBlogPost(r, req.properties, published, photos, syndicateTo)
+	} else {
+		location, err = h.createNote(r, req.properties, published, photos, syndicateTo)
+	}
+	if err != nil {
+		h.writeAPIError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", location)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// createBlogPost saves a named h-entry as a blog post and returns its URL
+func (h micropubHandler) createBlogPost(r *http.Request, properties map[string][]any, published *time.Time, photos []micropubPhoto, syndicateTo []string) (string, error) {
+	now := time.Now()
+	blogPost := models.BlogPost{
+		Title:     firstMicropubText(properties, "name"),
+		Content:   firstMicropubText(properties, "content"),
+		DateAdded: now,
+	}
+	if summary := firstMicropubText(properties, "summary"); summary != "" {
+		blogPost.Summary = &summary
+	}
+	for _, photo := range photos {
+		blogPost.Content = strings.TrimSpace(blogPost.Content + "\n\n![" + photo.Alt + "](" + photo.URL + ")")
+	}
+	for _, category := range uniqueMicropubTexts(micropubTexts(properties["category"])) {
+		blogPost.Tags = append(blogPost.Tags, models.BlogTag{Value: category})
+	}
+
+	status, err := micropubPostStatus(firstMicropubText(properties, "post-status"))
+	if err != nil {
+		return "", err
+	}
+	blogPost.Status = status
+	if published != nil {
+		// Micropub has no scheduling of its own, so a post dated in the future waits for that date
+		if status == models.BlogPostStatusPublished && published.After(now) {
+			blogPost.Status = models.BlogPostStatusScheduled
+			blogPost.PublishAt = published
+		} else {
+			blogPost.DateAdded = *published
+		}
+	}
+
+	if err := validateRequest(&blogPost); err != nil {
+		return "", err
+	}
+	if err := services.RenderBlogPost(&blogPost); err != nil {
+		return "", errs.NewInternalErrorWithCause("Failed to render blog post", err)
+	}
+	if err := applyBlogPostStatus(&blogPost, nil); err != nil {
+		return "", err
+	}
+	createdBlogPost, err := h.blogPosts.add(&blogPost)
+	if err != nil {
+		return "", err
+	}
+
+	if createdBlogPost.Status == models.BlogPostStatusPublished {
+		if platformsToPost := micropubPlatforms(syndicateTo, services.BlogPostPlatforms); len(platformsToPost) > 0 {
+			h.logger.Info().Strs("platforms", platformsToPost).Msg("Syndicating Micropub blog post")
+			mainImageURL := ""
+			if len(photos) > 0 {
+				mainImageURL = photos[0].URL
+			}
+			h.blogPosts.crossPost(r.Context(), *createdBlogPost, mainImageURL, platformsToPost)
+		}
+		h.blogPosts.webhooks.emit(models.WebhookEventPostPublished, BlogPostWithTags{
+			BlogPost: *createdBlogPost,
+			Tags:     createdBlogPost.Tags,
+		})
+	}
+	return h.blogPostURL(*createdBlogPost), nil
+}
+
+// createNote saves an h-entry without a name as a note and returns its URL
+func (h micropubHandler) createNote(r *http.Request, properties map[string][]any, published *time.Time, photos []micropubPhoto, syndicateTo []string) (string, error) {
+	if firstMicropubText(properties, "post-status") == models.BlogPostStatusDraft {
+		return "", errs.NewInvalidFieldError("post-status", "notes can't be drafts; give the post a name to save it as a draft blog post")
+	}
+
+	note := models.Note{
+		Content:   firstMicropubText(properties, "content"),
+		DateAdded: time.Now(),
+	}
+	if published != nil {
+		note.DateAdded = *published
+	}
+	if len(photos) > 0 {
+		note.ImageURL = &photos[0].URL
+	}
+	if err := validateRequest(&note); err != nil {
+		return "", err
+	}
+	if err := h.notes.noteRepo.Add(&note); err != nil {
+		return "", wrapDatabaseError("create note", "note", err)
+	}
+
+	if platformsToPost := micropubPlatforms(syndicateTo, services.NotePlatforms); len(platformsToPost) > 0 {
+		h.logger.Info().Strs("platforms", platformsToPost).Msg("Syndicating Micropub note")
+		h.notes.crossPost(r.Context(), note, platformsToPost)
+	}
+	return h.noteURL(note), nil
+}
+
+// update applies a Micropub update's replace, add and delete to the blog post or note at req's URL
+func (h micropubHandler) update(req *micropubRequest) error {
+	blogPost, note, err := h.findPost(req.url)
+	if err != nil {
+		return err
+	}
+	if blogPost != nil {
+		return h.updateBlogPost(blogPost, req)
+	}
+	return h.updateNote(note, req)
+}
+
+func (h micropubHandler) updateBlogPost(existing *models.BlogPost, req *micropubRequest) error {
+	blogPost := *existing
+	blogPost.Tags = nil // Tags are replaced below, once the post is saved
+	tags := make([]string, 0, len(existing.Tags))
+	for _, tag := range existing.Tags {
+		tags = append(tags, tag.Value)
+	}
+
+	for name, values := range req.replace {
+		value := ""
+		if texts := micropubTexts(values); len(texts) > 0 {
+			value = texts[0]
+		}
+		switch name {
+		case "name":
+			blogPost.Title = value
+		case "content":
+			blogPost.Content = value
+		case "summary":
+			blogPost.Summary = nil
+			if value != "" {
+				blogPost.Summary = &value
+			}
+		case "category":
+			tags = micropubTexts(values)
+		case "post-status":
+			status, err := micropubPostStatus(value)
+			if err != nil {
+				return err
+			}
+			blogPost.Status = status
+		default:
+			return errs.NewInvalidFieldError(name, "can't be replaced on a blog post")
+		}
+	}
+	for name, values := range req.add {
+		if name != "category" {
+			return errs.NewInvalidFieldError(name, "can't be added to a blog post; only category can")
+		}
+		tags = append(tags, micropubTexts(values)...)
+	}
+	for _, name := range req.deleteNames {
+		switch name {
+		case "category":
+			tags = nil
+		case "summary":
+			blogPost.Summary = nil
+		default:
+			return errs.NewInvalidFieldError(name, "can't be deleted from a blog post")
+		}
+	}
+	for name, values := range req.deleteValues {
+		if name != "category" {
+			return errs.NewInvalidFieldError(name, "can't have values deleted from a blog post; only category can")
+		}
+		removed := micropubTexts(values)
+		tags = slices.DeleteFunc(tags, func(tag string) bool {
+			return slices.Contains(removed, tag)
+		})
+	}
+
+	if err := validateRequest(&blogPost); err != nil {
+		return err
+	}
+	if err := applyBlogPostStatus(&blogPost, existing); err != nil {
+		return err
+	}
+	publishing := blogPost.Status == models.BlogPostStatusPublished && existing.Status != models.BlogPostStatusPublished
+
+	now := time.Now()
+	if publishing {
+		blogPost.DateAdded = now
+	}
+	blogPost.DateEdited = &now
+	if err := services.RenderBlogPost(&blogPost); err != nil {
+		return errs.NewInternalErrorWithCause("Failed to render blog post", err)
+	}
+	if err := h.blogPosts.blogPostRepo.Update(&blogPost); err != nil {
+		return wrapDatabaseError("update blog post", "blog_post", err)
+	}
+
+	if err := h.blogPosts.blogTagRepo.DeleteAllFor(blogPost.ID); err != nil {
+		return wrapDatabaseError("update blog tags", "blog_tag", err)
+	}
+	blogTags := make([]models.BlogTag, 0, len(tags))
+	for _, tag := range uniqueMicropubTexts(tags) {
+		blogTags = append(blogTags, models.BlogTag{ID: uuid.New(), BlogPostID: blogPost.ID, Value: tag})
+	}
+	if err := h.blogPosts.blogTagRepo.AddAll(blogTags); err != nil {
+		return wrapDatabaseError("update blog tags", "blog_tag", err)
+	}
+
+	if publishing {
+		updatedBlogPost, err := h.blogPosts.blogPostRepo.FindByID(blogPost.ID)
+		if err != nil {
+			return wrapDatabaseError("find updated blog post", "blog_post", err)
+		}
+		h.blogPosts.webhooks.emit(models.WebhookEventPostPublished, BlogPostWithTags{
+			BlogPost: *updatedBlogPost,
+			Tags:     updatedBlogPost.Tags,
+		})
+	}
+	return nil
+}
+
+func (h micropubHandler) updateNote(existing *models.Note, req *micropubRequest) error {
+	note := *existing
+	for name, values := range req.replace {
+		switch name {
+		case "content":
+			note.Content = ""
+			if texts := micropubTexts(values); len(texts) > 0 {
+				note.Content = texts[0]
+			}
+		case "photo":
+			note.ImageURL = nil
+			if photos := micropubPhotos(values); len(photos) > 0 {
+				note.ImageURL = &photos[0].URL
+			}
+		default:
+			return errs.NewInvalidFieldError(name, "can't be replaced on a note")
+		}
+	}
+	if len(req.add) > 0 {
+		return errs.NewInvalidFieldError(slices.Sorted(maps.Keys(req.add))[0], "can't be added to a note")
+	}
+	for _, name := range req.deleteNames {
+		if name != "photo" {
+			return errs.NewInvalidFieldError(name, "can't be deleted from a note; only photo can")
+		}
+		note.ImageURL = nil
+	}
+	if len(req.deleteValues) > 0 {
+		return errs.NewInvalidFieldError(slices.Sorted(maps.Keys(req.deleteValues))[0], "can't have values deleted from a note")
+	}
+
+	if err := validateRequest(&note); err != nil {
+		return err
+	}
+	now := time.Now()
+	note.DateEdited = &now
+	if err := h.notes.noteRepo.Update(&note); err != nil {
+		return wrapDatabaseError("update note", "note", err)
+	}
+	return nil
+}
+
+// delete moves the blog post at postURL to the trash, or deletes the note at it
+func (h micropubHandler) delete(postURL string) error {
+	blogPost, note, err := h.findPost(postURL)
+	if err != nil {
+		return err
+	}
+	if blogPost != nil {
+		if err := h.blogPosts.blogPostRepo.Delete(blogPost.ID); err != nil {
+			return wrapDatabaseError("delete blog post", "blog_post", err)
+		}
+		return nil
+	}
+	if err := h.notes.noteRepo.Delete(note.ID); err != nil {
+		return wrapDatabaseError("delete note", "note", err)
+	}
+	return nil
+}
+
+// undelete takes the blog post at postURL out of the trash. Notes aren't kept in one, so they can't be undeleted
+func (h micropubHandler) undelete(postURL string) error {
+	id, err := micropubPostID(postURL)
+	if err != nil {
+		return err
+	}
+	if err := h.blogPosts.blogPostRepo.Restore(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("no blog post in the trash has that URL")
+		}
+		return wrapDatabaseError("restore blog post", "blog_post", err)
+	}
+	return nil
+}
+
+// micropubPostID returns the ID postURL's path ends with, as the URLs Micropub creates posts with do
+func micropubPostID(postURL string) (uuid.UUID, error) {
+	if postURL == "" {
+		return uuid.Nil, errs.NewInvalidFieldError("url", "is required")
+	}
+	parsed, err := url.Parse(postURL)
+	if err != nil {
+		return uuid.Nil, errs.NewInvalidFieldError("url", "must be a URL")
+	}
+	id, err := uuid.Parse(path.Base(strings.TrimSuffix(parsed.Path, "/")))
+	if err != nil {
+		return uuid.Nil, errs.NewInvalidFieldError("url", "must end with the ID of a blog post or note")
+	}
+	return id, nil
+}
+
+// findPost returns the blog post or note postURL points at, found by its micropubPostID
+func (h micropubHandler) findPost(postURL string) (*models.BlogPost, *models.Note, error) {
+	id, err := micropubPostID(postURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blogPost, err := h.blogPosts.blogPostRepo.FindByID(id)
+	if err == nil {
+		return blogPost, nil, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, wrapDatabaseError("find blog post", "blog_post", err)
+	}
+	note, err := h.notes.noteRepo.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, errs.NewNotFoundError("no blog post or note has that URL")
+	}
+	if err != nil {
+		return nil, nil, wrapDatabaseError("find note", "note", err)
+	}
+	return nil, note, nil
+}
+
+// source returns the Micropub properties of blogPost, or of note if blogPost is nil
+func (h micropubHandler) source(blogPost *models.BlogPost, note *models.Note) MicropubSource {
+	source := MicropubSource{Type: []string{"h-entry"}}
+	if blogPost != nil {
+		status := models.BlogPostStatusPublished
+		if blogPost.Status != models.BlogPostStatusPublished {
+			status = models.BlogPostStatusDraft
+		}
+		source.Properties = map[string][]any{
+			"name":        {blogPost.Title},
+			"content":     {blogPost.Content},
+			"published":   {blogPost.DateAdded.Format(time.RFC3339)},
+			"post-status": {status},
+			"url":         {h.blogPostURL(*blogPost)},
+		}
+		if blogPost.Summary != nil {
+			source.Properties["summary"] = []any{*blogPost.Summary}
+		}
+		if len(blogPost.Tags) > 0 {
+			categories := make([]any, 0, len(blogPost.Tags))
+			for _, tag := range blogPost.Tags {
+				categories = append(categories, tag.Value)
+			}
+			source.Properties["category"] = categories
+		}
+		return source
+	}
+
+	source.Properties = map[string][]any{
+		"content":   {note.Content},
+		"published": {note.DateAdded.Format(time.RFC3339)},
+		"url":       {h.noteURL(*note)},
+	}
+	if note.ImageURL != nil {
+		source.Properties["photo"] = []any{*note.ImageURL}
+	}
+	return source
+}
+
+// storeMedia stores an uploaded image and returns the URL it's served at
+func (h micropubHandler) storeMedia(file *multipart.FileHeader) (string, error) {
+	if h.apiBaseURL == "" {
+		return "", errs.NewBadRequestError("API_BASE_URL must be set to link to uploaded images")
+	}
+	opened, err := file.Open()
+	if err != nil {
+		return "", errs.NewBadRequestError("failed to read uploaded file")
+	}
+	defer opened.Close()
+	data, err := io.ReadAll(io.LimitReader(opened, services.MaxMediaFileSize+1))
+	if err != nil {
+		return "", errs.NewBadRequestError("failed to read uploaded file")
+	}
+
+	fileName := path.Base(file.Filename)
+	contentType, err := services.CheckMediaFile(fileName, data)
+	if err != nil {
+		return "", errs.NewInvalidFieldError("file", err.Error())
+	}
+	sum := sha256.Sum256(data)
+	mediaFile := models.MediaFile{
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        len(data),
+		SHA256:      hex.EncodeToString(sum[:]),
+		Data:        data,
+		DateAdded:   time.Now(),
+	}
+	if err := h.mediaFileRepo.AddOrFind(&mediaFile); err != nil {
+		return "", wrapDatabaseError("store media file", "media_file", err)
+	}
+	return h.apiBaseURL + "/media/" + mediaFile.ID.String(), nil
+}
+
+// blogPostURL returns the URL a blog post is known by to Micropub clients: its public URL, or its API URL when
+// there's no BASE_URL to build one from
+func (h micropubHandler) blogPostURL(blogPost models.BlogPost) string {
+	if link := services.BlogPostLink(blogPost, ""); link != "" {
+		return link
+	}
+	return h.apiBaseURL + "/blog-post/" + blogPost.ID.String()
+}
+
+// noteURL returns the URL a note is known by to Micropub clients, as blogPostURL does for blog posts
+func (h micropubHandler) noteURL(note models.Note) string {
+	if h.baseURL != "" {
+		return services.BuildNoteURL(h.baseURL, note.ID.String())
+	}
+	return h.apiBaseURL + "/note/" + note.ID.String()
+}
+
+// writeError answers a Micropub client with an error in the form the spec gives
+func (h micropubHandler) writeError(w http.ResponseWriter, status int, code, description string) {
+	errorMetrics.record(status, code)
+	if status == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	h.responder.WriteJSON(w, MicropubError{Error: code, Description: description})
+}
+
+// writeAPIError answers with err in Micropub's form when the client is at fault. Server errors are logged and
+// answered as everywhere else in the API
+func (h micropubHandler) writeAPIError(w http.ResponseWriter, err error) {
+	var apiErr *errs.ApiErr
+	if !errors.As(err, &apiErr) || apiErr.StatusCode >= http.StatusInternalServerError {
+		h.responder.WriteError(w, err)
+		return
+	}
+	description := apiErr.Error()
+	if apiErr.Details != "" {
+		description = apiErr.Details
+	}
+	h.writeError(w, apiErr.StatusCode, micropubInvalidRequest, description)
+}
+
+// micropubBodyError reports why a Micropub request body couldn't be read
+func micropubBodyError(err error) error {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return errs.NewMaxBodySizeExceededError(maxMicropubRequestSize)
+	}
+	return errs.NewBadRequestError("malformed request body")
+}
+
+// micropubSyndicationTargets lists every platform a blog post or a note can be cross-posted to
+func micropubSyndicationTargets() []MicropubSyndicationTarget {
+	var targets []MicropubSyndicationTarget
+	for _, platform := range append(slices.Clone(services.BlogPostPlatforms), services.NotePlatforms...) {
+		if slices.ContainsFunc(targets, func(target MicropubSyndicationTarget) bool { return target.UID == platform }) {
+			continue
+		}
+		targets = append(targets, MicropubSyndicationTarget{UID: platform, Name: micropubPlatformNames[platform]})
+	}
+	return targets
+}
+
+// micropubPlatforms returns the platforms of supported a post was asked to be syndicated to. Targets that kind of
+// post can't be posted to, such as Medium for a note, are left out
+func micropubPlatforms(syndicateTo []string, supported []string) []string {
+	var platforms []string
+	for _, platform := range supported {
+		if slices.ContainsFunc(syndicateTo, func(target string) bool { return strings.EqualFold(target, platform) }) {
+			platforms = append(platforms, platform)
+		}
+	}
+	return platforms
+}
+
+// micropubPostStatus maps a post-status property to a blog post status
+func micropubPostStatus(postStatus string) (string, error) {
+	switch postStatus {
+	case "", models.BlogPostStatusPublished:
+		return models.BlogPostStatusPublished, nil
+	case models.BlogPostStatusDraft:
+		return models.BlogPostStatusDraft, nil
+	}
+	return "", errs.NewInvalidFieldError("post-status", "must be published or draft")
+}
+
+// micropubPublished parses the published property, if given
+func micropubPublished(properties map[string][]any) (*time.Time, error) {
+	value := firstMicropubText(properties, "published")
+	if value == "" {
+		return nil, nil
+	}
+	published, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errs.NewInvalidFieldError("published", "must be an RFC 3339 date")
+	}
+	return &published, nil
+}
+
+// micropubText returns a property value as text: a string as it is, and an object such as {"html": ...} by its
+// html or value
+func micropubText(value any) string {
+	switch value := value.(type) {
+	case string:
+		return strings.TrimSpace(value)
+	case map[string]any:
+		for _, key := range []string{"html", "value"} {
+			if text, ok := value[key].(string); ok {
+				return strings.TrimSpace(text)
+			}
+		}
+	}
+	return ""
+}
+
+// micropubTexts returns every value of a property as text, leaving out empty ones
+func micropubTexts(values []any) []string {
+	var texts []string
+	for _, value := range values {
+		if text := micropubText(value); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return texts
+}
+
+func firstMicropubText(properties map[string][]any, name string) string {
+	if texts := micropubTexts(properties[name]); len(texts) > 0 {
+		return texts[0]
+	}
+	return ""
+}
+
+// uniqueMicropubTexts returns texts without repeats, in the order they're first given
+func uniqueMicropubTexts(texts []string) []string {
+	var unique []string
+	for _, text := range texts {
+		if !slices.Contains(unique, text) {
+			unique = append(unique, text)
+		}
+	}
+	return unique
+}
+
+// micropubPhotos returns the photos of a photo property, given as URLs or as {"value": url, "alt": text}
+func micropubPhotos(values []any) []micropubPhoto {
+	var photos []micropubPhoto
+	for _, value := range values {
+		photo := micropubPhoto{URL: micropubText(value)}
+		if object, ok := value.(map[string]any); ok {
+			photo.Alt, _ = object["alt"].(string)
+		}
+		if photo.URL != "" {
+			photos = append(photos, photo)
+		}
+	}
+	return photos
+}
@@ -1,25 +1,32 @@
 package api
 
 import (
+	"context"
+	"crypto/subtle"
 	"net/http"
 	"os"
 	"runtime/debug"
 	"strings"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/rpupo63/unified-personal-site-backend/errs"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
 type authMiddleware struct {
-	responder Responder
+	responder       Responder
+	logger          zerolog.Logger
+	backendPassword string
 }
 
-func newAuthMiddleware() authMiddleware {
+func newAuthMiddleware(backendPassword string) authMiddleware {
 	logger := log.With().Str("handlerName", "authMiddleware").Logger()
 	return authMiddleware{
-		responder: NewResponder(logger),
+		responder:       NewResponder(logger),
+		logger:          logger,
+		backendPassword: backendPassword,
 	}
 }
 
@@ -44,6 +51,56 @@ func (m authMiddleware) authenticate(next http.Handler) http.Handler {
 	})
 }
 
+// requireAdmin only lets requests through whose bearer token matches BACKEND_PASSWORD
+// If no password is configured every request is rejected, so admin routes never end up open by accident
+func (m authMiddleware) requireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m.backendPassword == "" {
+			m.logger.Warn().Str("path", r.URL.Path).Msg("BACKEND_PASSWORD is not set, rejecting admin request")
+			m.responder.WriteError(w, errs.Unauthorized)
+			return
+		}
+
+		if !m.isAdmin(r) {
+			m.responder.WriteError(w, errs.Unauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, ctxRequestWithAdmin(r))
+	})
+}
+
+// identifyAdmin marks requests carrying the backend password as admin requests, without rejecting anyone else
+// Public handlers use it to show admins what others can't see, such as unpublished blog posts
+func (m authMiddleware) identifyAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m.isAdmin(r) {
+			r = ctxRequestWithAdmin(r)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isAdmin reports whether r's bearer token matches BACKEND_PASSWORD; it never does when no password is configured
+func (m authMiddleware) isAdmin(r *http.Request) bool {
+	if m.backendPassword == "" {
+		return false
+	}
+
+	authHeader := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return false
+	}
+
+	token := strings.TrimPrefix(authHeader, "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(m.backendPassword)) == 1
+}
+
+// ctxRequestWithAdmin returns r with its context marked as an admin request
+func ctxRequestWithAdmin(r *http.Request) *http.Request {
+	return r.WithContext(ctxWithAdmin(r.Context()))
+}
+
 type statusResponseWriter struct {
 	http.ResponseWriter
 	status      int
@@ -57,6 +114,32 @@ func (w *statusResponseWriter) WriteHeader(statusCode int) {
 		w.ResponseWriter.WriteHeader(statusCode)
 	}
 }
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (w *statusResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// withRequestDeadline gives the requests it wraps timeout to finish instead of the server's read and write timeouts,
+// and sets the same deadline on the request context. Use it for endpoints that wait on slow third parties
+func withRequestDeadline(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			deadline := time.Now().Add(timeout)
+			controller := http.NewResponseController(w)
+			if err := controller.SetReadDeadline(deadline); err != nil {
+				log.Warn().Err(err).Str("path", r.URL.Path).Msg("Failed to extend read deadline")
+			}
+			if err := controller.SetWriteDeadline(deadline); err != nil {
+				log.Warn().Err(err).Str("path", r.URL.Path).Msg("Failed to extend write deadline")
+			}
+
+			ctx, cancel := context.WithDeadline(r.Context(), deadline)
+			defer cancel()
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
 func LogInternalServerErrors(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		srw := &statusResponseWriter{ResponseWriter: w, status: 200}
@@ -122,6 +205,72 @@ func CORSCheckMiddleware(allowedOrigins []string) func(http.Handler) http.Handle
 	}
 }
 
+// routableMethods are the methods checked when working out which ones a path supports
+var routableMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// allowedMethods returns the methods registered for path, or nil if no route matches
+// HEAD is included wherever GET is, since GET routes also answer HEAD requests
+func allowedMethods(routes chi.Routes, path string) []string {
+	var methods []string
+	for _, method := range routableMethods {
+		if !routes.Match(chi.NewRouteContext(), method, path) {
+			continue
+		}
+		methods = append(methods, method)
+		if method == http.MethodGet {
+			methods = append(methods, http.MethodHead)
+		}
+	}
+
+	if len(methods) == 0 {
+		return nil
+	}
+	return append(methods, http.MethodOptions)
+}
+
+// allowHeaderMiddleware answers OPTIONS requests with an Allow header listing the methods the route supports
+// Unknown paths get a 404 instead of the blanket 200 preflight response
+// It has to run before corsMiddleware, which reuses the Allow header for Access-Control-Allow-Methods
+func allowHeaderMiddleware(routes chi.Routes) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			path := r.URL.RawPath
+			if path == "" {
+				path = r.URL.Path
+			}
+
+			methods := allowedMethods(routes, path)
+			if methods == nil {
+				responder := NewResponder(log.Logger)
+				responder.WriteError(w, errs.NewNotFoundError("route not found").WithCode(errs.CodeRouteNotFound))
+				return
+			}
+
+			w.Header().Set("Allow", strings.Join(methods, ", "))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// methodNotAllowedHandler answers 405s with the full Allow header, including the implicit HEAD and OPTIONS
+func methodNotAllowedHandler(routes chi.Routes) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.RawPath
+		if path == "" {
+			path = r.URL.Path
+		}
+
+		w.Header().Set("Allow", strings.Join(allowedMethods(routes, path), ", "))
+		responder := NewResponder(log.Logger)
+		responder.WriteError(w, errs.NewApiErr(http.StatusMethodNotAllowed, "method not allowed"))
+	}
+}
+
 // corsMiddleware handles CORS headers for allowed origins
 func corsMiddleware(allowedOrigins []string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -139,8 +288,13 @@ func corsMiddleware(allowedOrigins []string) func(http.Handler) http.Handler {
 
 			if allowed {
 				w.Header().Set("Access-Control-Allow-Origin", origin)
-				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+				// Preflight requests advertise exactly what the route supports when allowHeaderMiddleware has worked it out
+				allowMethods := w.Header().Get("Allow")
+				if allowMethods == "" {
+					allowMethods = "GET, POST, PUT, DELETE, OPTIONS"
+				}
+				w.Header().Set("Access-Control-Allow-Methods", allowMethods)
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Idempotency-Key")
 				w.Header().Set("Access-Control-Allow-Credentials", "true")
 			}
 
@@ -0,0 +1,313 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultNotesPerPage = 20
+	maxNotesPerPage     = database.MaxPageSize
+)
+
+type noteHandler struct {
+	responder      Responder
+	logger         zerolog.Logger
+	noteRepo       *database.NoteRepo
+	socialPostRepo *database.SocialPostRepo
+	webhooks       *webhookDispatcher
+}
+
+func newNoteHandler(noteRepo *database.NoteRepo, socialPostRepo *database.SocialPostRepo, webhooks *webhookDispatcher) noteHandler {
+	logger := log.With().Str("handlerName", "noteHandler").Logger()
+
+	return noteHandler{
+		responder:      NewResponder(logger),
+		logger:         logger,
+		noteRepo:       noteRepo,
+		socialPostRepo: socialPostRepo,
+		webhooks:       webhooks,
+	}
+}
+
+// NoteCollection represents one page of the notes feed
+type NoteCollection struct {
+	Data  []models.Note `json:"data"`
+	Meta  ListMeta      `json:"meta"`
+	Links ListLinks     `json:"links"`
+}
+
+// getNotes retrieves one page of notes
+// @Summary Get notes
+// @Description Retrieves the notes feed, newest first
+// @Tags Notes
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number (starts at 1)" default(1)
+// @Param perPage query int false "Notes per page (max 100)" default(20)
+// @Success 200 {object} NoteCollection "Page of notes"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid pagination parameters"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching notes"
+// @Router /notes [get]
+func (h noteHandler) getNotes() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pagination, err := parsePagination(r, defaultNotesPerPage, maxNotesPerPage)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		notes, total, err := h.noteRepo.FindPage(pagination.Offset(), pagination.PerPage)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find notes", "notes", err))
+			return
+		}
+
+		meta, links := newListMetaAndLinks(r, pagination, total)
+		response := NoteCollection{
+			Data:  make([]models.Note, 0, len(notes)),
+			Meta:  meta,
+			Links: links,
+		}
+		for _, note := range notes {
+			response.Data = append(response.Data, *note)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// getNote retrieves a specific note by ID
+// @Summary Get note
+// @Description Retrieves a specific note by ID
+// @Tags Notes
+// @Accept json
+// @Produce json
+// @Param noteID path string true "Note ID" format(uuid)
+// @Success 200 {object} models.Note "Note details"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid noteID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Note not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching note"
+// @Router /note/{noteID} [get]
+func (h noteHandler) getNote() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		noteID, ok := h.parseNoteID(w, r)
+		if !ok {
+			return
+		}
+
+		note, err := h.noteRepo.FindByID(noteID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find note", "note", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, note)
+	}
+}
+
+// createNote creates a new note and optionally cross-posts it
+// @Summary Create note
+// @Description Creates a new note. Pass platforms to cross-post it to Twitter and/or Mastodon; nothing is cross-posted by default
+// @Tags Notes
+// @Accept json
+// @Produce json
+// @Param note body models.Note true "Note data"
+// @Param platforms query string false "Comma-separated list of platforms to cross-post to. Valid values: twitter, mastodon"
+// @Param Idempotency-Key header string false "Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)"
+// @Success 201 {object} models.Note "Created note"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid note data"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating note"
+// @Router /note [post]
+func (h noteHandler) createNote() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		note, ok := h.decodeNote(w, r)
+		if !ok {
+			return
+		}
+
+		note.ID = uuid.Nil
+		note.DateAdded = time.Now()
+		note.DateEdited = nil
+
+		if err := h.noteRepo.Add(note); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create note", "note", err))
+			return
+		}
+
+		// Cross-posting is opt-in for notes, unlike blog posts
+		if platformsParam := r.URL.Query().Get("platforms"); platformsParam != "" {
+			platformsToPost := strings.Split(platformsParam, ",")
+			for i := range platformsToPost {
+				platformsToPost[i] = strings.TrimSpace(platformsToPost[i])
+			}
+			h.logger.Info().Strs("platforms", platformsToPost).Msg("Cross-posting note to selected platforms")
+
+			h.crossPost(r.Context(), *note, platformsToPost)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, note)
+	}
+}
+
+// crossPost posts a newly created note to platformsToPost and records how each went
+// Failures are logged and announced to webhooks rather than returned, since the note itself was saved
+func (h noteHandler) crossPost(ctx context.Context, note models.Note, platformsToPost []string) {
+	// The note is already saved, so posts in flight finish even if the client goes away
+	results, err := services.PostNoteEverywhere(context.WithoutCancel(ctx), note, platformsToPost)
+	recordSocialPosts(h.logger, h.socialPostRepo, models.ContentTypeNote, note.ID, results, nil)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to cross-post note to some platforms, but note was created successfully")
+		h.webhooks.emit(models.WebhookEventSocialPostFailed, SocialPostFailure{
+			ContentType: models.ContentTypeNote,
+			ContentID:   note.ID,
+			Platforms:   platformsToPost,
+			Error:       err.Error(),
+		})
+	}
+}
+
+// updateNote updates an existing note
+// @Summary Update note
+// @Description Updates an existing note. Cross-posted copies are not edited
+// @Tags Notes
+// @Accept json
+// @Produce json
+// @Param noteID path string true "Note ID" format(uuid)
+// @Param note body models.Note true "Updated note data"
+// @Success 200 {object} models.Note "Updated note"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid note data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Note not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error updating note"
+// @Router /note/{noteID} [put]
+func (h noteHandler) updateNote() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		noteID, ok := h.parseNoteID(w, r)
+		if !ok {
+			return
+		}
+
+		existingNote, err := h.noteRepo.FindByID(noteID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find note", "note", err))
+			return
+		}
+
+		note, ok := h.decodeNote(w, r)
+		if !ok {
+			return
+		}
+
+		// Ensure ID matches and keep the original creation date
+		now := time.Now()
+		note.ID = noteID
+		note.DateAdded = existingNote.DateAdded
+		note.DateEdited = &now
+
+		if err := h.noteRepo.Update(note); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update note", "note", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, note)
+	}
+}
+
+// deleteNote deletes a note by ID
+// @Summary Delete note
+// @Description Deletes a note from the database by ID. Cross-posted copies are not deleted
+// @Tags Notes
+// @Accept json
+// @Produce json
+// @Param noteID path string true "Note ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid noteID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Note not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting note"
+// @Router /note/{noteID} [delete]
+func (h noteHandler) deleteNote() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		noteID, ok := h.parseNoteID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify note exists
+		if _, err := h.noteRepo.FindByID(noteID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find note", "note", err))
+			return
+		}
+
+		if err := h.noteRepo.Delete(noteID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete note", "note", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "note deleted successfully",
+		})
+	}
+}
+
+// parseNoteID reads the noteID path parameter, writing a 400 if it is missing or invalid
+func (h noteHandler) parseNoteID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	noteIDStr := chi.URLParam(r, "noteID")
+	if noteIDStr == "" {
+		h.responder.WriteError(w, errs.NewBadRequestError("missing noteID"))
+		return uuid.Nil, false
+	}
+
+	noteID, err := uuid.Parse(noteIDStr)
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid noteID"))
+		return uuid.Nil, false
+	}
+
+	return noteID, true
+}
+
+// decodeNote reads and validates a note from the request body
+// It writes the error response itself and returns false when the body is invalid
+func (h noteHandler) decodeNote(w http.ResponseWriter, r *http.Request) (*models.Note, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var note models.Note
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&note); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode note request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	note.Content = strings.TrimSpace(note.Content)
+	if err := validateRequest(&note); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+
+	if note.ImageURL != nil && strings.TrimSpace(*note.ImageURL) == "" {
+		note.ImageURL = nil
+	}
+
+	return &note, true
+}
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// defaultNotionSyncInterval is how often the Notion database is checked for new and edited pages
+const defaultNotionSyncInterval = 5 * time.Minute
+
+// notionSyncer drafts blog posts from the pages of a Notion database
+// Each page becomes a draft titled after the page, with its Summary and Tags properties when the database has them.
+// Edits in Notion update the draft until it's published or scheduled here; after that the post is left alone, so
+// Notion can't change a live post
+type notionSyncer struct {
+	logger     zerolog.Logger
+	database   database.Database
+	token      string
+	databaseID string
+}
+
+func newNotionSyncer(database database.Database, token, databaseID string) *notionSyncer {
+	logger := log.With().Str("handlerName", "notionSyncer").Logger()
+	return &notionSyncer{
+		logger:     logger,
+		database:   database,
+		token:      token,
+		databaseID: databaseID,
+	}
+}
+
+// sync drafts every new page and updates the drafts of pages edited since they were last synced
+// A page that fails to sync is retried on the next run without holding up the others
+func (s *notionSyncer) sync(ctx context.Context, now time.Time) error {
+	pages, err := services.QueryNotionDatabase(ctx, s.token, s.databaseID)
+	if err != nil {
+		return fmt.Errorf("failed to query Notion database: %w", err)
+	}
+
+	synced, err := s.database.NotionPageRepo().FindAll()
+	if err != nil {
+		return fmt.Errorf("failed to find synced Notion pages: %w", err)
+	}
+	syncedByPageID := make(map[string]*models.NotionPage, len(synced))
+	for _, notionPage := range synced {
+		syncedByPageID[notionPage.PageID] = notionPage
+	}
+
+	failed := 0
+	for _, page := range pages {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		previous := syncedByPageID[page.ID]
+		if page.Archived || page.Title == "" || (previous != nil && !page.LastEditedAt.After(previous.LastEditedAt)) {
+			continue
+		}
+		if err := s.syncPage(ctx, page, previous, now); err != nil {
+			failed++
+			s.logger.Error().Err(err).Str("notionPageID", page.ID).Str("title", page.Title).Msg("Failed to sync Notion page")
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to sync %d of %d Notion pages", failed, len(pages))
+	}
+	return nil
+}
+
+// syncPage creates or updates the draft of page, which was last synced as previous unless it's new
+func (s *notionSyncer) syncPage(ctx context.Context, page services.NotionPage, previous *models.NotionPage, now time.Time) error {
+	synced := models.NotionPage{PageID: page.ID, LastEditedAt: page.LastEditedAt}
+
+	var draft *models.BlogPost
+	if previous != nil {
+		blogPost, err := s.database.BlogPostRepo().FindByID(previous.BlogPostID)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			// The draft was deleted here, so it's drafted again
+		case err != nil:
+			return fmt.Errorf("failed to find blog post: %w", err)
+		case blogPost.Status != models.BlogPostStatusDraft:
+			synced.BlogPostID = blogPost.ID
+			return s.database.NotionPageRepo().Save(&synced)
+		default:
+			draft = blogPost
+		}
+	}
+
+	content, err := services.FetchNotionPageMarkdown(ctx, s.token, page.ID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch page content: %w", err)
+	}
+	if content == "" {
+		// Nothing has been written yet; the page is picked up once it has a body
+		return nil
+	}
+
+	var summary *string
+	if page.Summary != "" {
+		summary = &page.Summary
+	}
+
+	return s.database.Transaction(func(tx database.Database) error {
+		if draft == nil {
+			blogPost := models.BlogPost{
+				Title:   page.Title,
+				Summary: summary,
+				Content: content,
+				Status:  models.BlogPostStatusDraft,
+			}
+			for _, tag := range uniqueTagValues(page.Tags) {
+				blogPost.Tags = append(blogPost.Tags, models.BlogTag{Value: tag})
+			}
+			id, err := addBlogPost(tx, &blogPost)
+			if err != nil {
+				return err
+			}
+			synced.BlogPostID = id
+			s.logger.Info().Str("notionPageID", page.ID).Str("blogPostID", id.String()).Str("title", page.Title).Msg("Drafted blog post from Notion")
+			return tx.NotionPageRepo().Save(&synced)
+		}
+
+		draft.Title = page.Title
+		draft.Summary = summary
+		draft.Content = content
+		if err := services.RenderBlogPost(draft); err != nil {
+			return err
+		}
+		draft.DateEdited = &now
+		// Tags are replaced below rather than saved through the association
+		draft.Tags = nil
+		if err := tx.BlogPostRepo().Update(draft); err != nil {
+			return wrapDatabaseError("update blog post", "blog_post", err)
+		}
+		if err := tx.BlogTagRepo().DeleteAllFor(draft.ID); err != nil {
+			return wrapDatabaseError("delete blog tags", "blog_tags", err)
+		}
+		if err := tx.BlogTagRepo().AddAll(newBlogTags(draft.ID, page.Tags)); err != nil {
+			return wrapDatabaseError("create blog tags", "blog_tags", err)
+		}
+		synced.BlogPostID = draft.ID
+		s.logger.Info().Str("notionPageID", page.ID).Str("blogPostID", draft.ID.String()).Str("title", page.Title).Msg("Updated blog post draft from Notion")
+		return tx.NotionPageRepo().Save(&synced)
+	})
+}
@@ -0,0 +1,173 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/rpupo63/unified-personal-site-backend/docs"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type apiSpecHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+	baseURL   *url.URL // Public URL of the API, nil to take it from each request
+}
+
+// newAPISpecHandler serves the generated Swagger spec pointed at the API's public address
+// baseURL is API_BASE_URL, e.g. https://api.example.com; when empty the host and scheme the request came in on are used
+func newAPISpecHandler(baseURL string) apiSpecHandler {
+	logger := log.With().Str("handlerName", "apiSpecHandler").Logger()
+
+	handler := apiSpecHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+	}
+	if baseURL == "" {
+		return handler
+	}
+
+	parsedURL, err := url.Parse(baseURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		logger.Warn().Str("baseURL", baseURL).Msg("API_BASE_URL must be an absolute http(s) URL, taking the spec's host from each request")
+		return handler
+	}
+	handler.baseURL = parsedURL
+	return handler
+}
+
+// getSpec returns the Swagger 2.0 spec with its host and scheme set to where the API is being reached
+// @Summary Get API spec
+// @Description Exports the Swagger 2.0 spec of this API. Its host and schemes are API_BASE_URL's when set, otherwise the ones the request came in on
+// @Tags Documentation
+// @Produce json
+// @Success 200 {object} object "Swagger spec"
+// @Router /openapi.json [get]
+func (h apiSpecHandler) getSpec() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Render from a copy, since SwaggerInfo is shared by every request
+		spec := *docs.SwaggerInfo
+		spec.Host = r.Host
+		spec.Schemes = []string{requestScheme(r)}
+		if h.baseURL != nil {
+			spec.Host = h.baseURL.Host
+			spec.Schemes = []string{h.baseURL.Scheme}
+			if path := strings.TrimSuffix(h.baseURL.Path, "/"); path != "" {
+				spec.BasePath = path
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if _, err := w.Write([]byte(spec.ReadDoc())); err != nil {
+			h.logger.Error().Err(err).Msg("Failed to write API spec")
+		}
+	}
+}
+
+// requestScheme returns the scheme the client used, which the reverse proxy reports in X-Forwarded-Proto
+func requestScheme(r *http.Request) string {
+	if r.TLS != nil {
+		return "https"
+	}
+	if proto, _, _ := strings.Cut(r.Header.Get("X-Forwarded-Proto"), ","); strings.TrimSpace(proto) == "https" {
+		return "https"
+	}
+	return "http"
+}
+
+// apiChangelog lists the changes to the API surface, newest first
+// Add an entry and bump @version in main.go whenever a release changes what clients can send or get back
+var apiChangelog = []APIChange{
+	{
+		Version: "1.1",
+		Changes: []string{
+			"Validation errors list every failing field in fields",
+			"GET /openapi.json exports the spec with the host it was requested on",
+			"Requests are validated against the spec before reaching handlers",
+			"GET /admin/metrics counts error responses by code",
+			"GET /version returns the spec version and this changelog; Go and TypeScript clients are generated from the spec",
+			"GET /schema/{entity}/example returns example request bodies for blog posts, projects and scheduled posts",
+			"POST /import/medium imports a Medium export archive as drafts",
+			"POST /import/substack imports a Substack export archive, keeping publish dates",
+			"POST /import/wordpress imports a WordPress export file, with a dry run mode",
+			"GET /blog-post/{blogPostID}/export.md exports a post as markdown with YAML frontmatter",
+			"POST /import/markdown creates or updates posts from a zipped folder of markdown files; import reports count updated posts",
+			"GET /feed.json serves the newest published blog posts as a JSON Feed 1.1, with each post's first image as an attachment",
+			"GET /blog-posts/summaries and GET /projects/summaries list posts and projects for list views, without post content and with tags as plain values",
+			"GET /blog-posts, /projects, their summaries and /feed.json are cached in memory; an X-Cache header says whether a response was a HIT or a MISS",
+			"GET /admin/export/blog-posts streams every blog post with its full content and tags",
+			"GET /admin/indexes explains the main list queries and reports missing indexes",
+			"A perPage above 100 is rejected with PAGE_TOO_LARGE; responses are no longer replaced with RESPONSE_TOO_LARGE past 10MB",
+			"POST /admin/bench seeds content, benchmarks the list, detail and search endpoints and reports their latencies, outside production",
+			"GET /admin/social/health checks each social platform's credentials and reports whether they're valid and when they expire",
+			"GET /admin/doctor reports orphaned tags, wrong blog post lengths, missing or shared slugs, social posts for deleted content and broken images, and POST /admin/doctor/fix repairs what it can",
+			"GET /media/{mediaID} serves images uploaded with blog posts, such as by the new-post command",
+			"GET /admin/schema/drift reports, per table, the columns that differ from the models and columns whose types don't match",
+			"GET /s/{code} redirects short links to blog posts, projects and other URLs, managed with GET /admin/short-links, POST /admin/short-link and DELETE /admin/short-link/{shortLinkID}",
+			"GET /snippets lists code snippets, managed with POST /snippet, PUT /snippet/{snippetID} and DELETE /snippet/{snippetID}, and GET /blog-post/{blogPostID}/export.md replaces {{< snippet id >}} shortcodes with their code",
+			"POST /blog-post/{blogPostID}/preview-link signs a time-limited link, and GET /blog-post/{blogPostID} and its export.md take its token as ?preview= to show drafts without the backend password",
+			"Blog post translations, managed with GET /blog-post/{blogPostID}/translations and PUT and DELETE /blog-post/{blogPostID}/translation/{locale}, are served by GET /blog-posts, /blog-posts/summaries, /blog-post/{blogPostID} and /feed.json in the locale negotiated from ?locale= or Accept-Language, and GET /sitemap.xml lists each post with hreflang alternates",
+			"GET and POST /micropub and POST /micropub/media let Micropub clients create, edit and delete blog posts and notes and upload images, authenticated with IndieAuth access tokens",
+			"GET /blog-posts repeats links.next and links.prev in a Link header",
+			"GET /blog-posts and GET /projects page by cursor when given cursor, returning meta.nextCursor; meta.page is left out of cursor pages",
+			"GET /blog-posts and GET /blog-posts/summaries filter by tag, tags and tagMode",
+			"DELETE /blog-post/{blogPostID} and DELETE /project/{projectID} move to the trash, listed by GET /blog-posts/trash and GET /projects/trash and undone by POST /blog-post/{blogPostID}/restore and POST /project/{projectID}/restore, unless given permanent=true",
+			"GET /feed.xml is an RSS 2.0 feed of the newest published blog posts, and it and GET /feed.json send ETag and Last-Modified and answer conditional requests with 304",
+			"GET /feed.atom is an Atom feed of the same posts, GET /feed serves RSS, Atom or JSON Feed by Accept, and JSON Feed names FEED_AUTHOR as its author",
+			"Blog posts and their summaries have wordCount and readingTimeMinutes, worked out from the content whenever it's saved, in place of length; sort by wordCount instead of length, and the doctor reports wordCountMismatch in place of lengthMismatch",
+			"Blog post and translation content is rendered from Markdown to sanitized HTML when saved; GET /blog-posts and GET /blog-post/{blogPostID} serve it with format=html, JSON Feed items have content_html and Atom entries html content",
+			"Added POST /blog-post/{blogPostID}/view, counting a visitor's views of a published post once per BLOG_POST_VIEW_WINDOW, and GET /blog-posts/popular listing the most viewed posts in a period",
+			"Added series of blog posts: GET and POST /series, GET, PUT and DELETE /series/{slug}, with GET returning the series' published posts in order, and PUT and DELETE /series/{slug}/post/{blogPostID} to place posts in them",
+			"Blog posts have metaTitle, metaDescription and ogImageUrl, and projects meta_title, meta_description, og_image_url and canonical_url; PUT /blog-post/{blogPostID} and PUT /project/{projectID} now validate their body like the POSTs do, and GET /blog-post/{blogPostID}/meta returns a post's OpenGraph and Twitter card tags",
+			"Blog posts have an image gallery under /blog-post/{blogPostID}/images, and POST /blog-post takes images; a post's featured image is its main image in feeds, meta and cross-posts, and the mainImageURL query parameter is deprecated",
+			"POST /media uploads files to Supabase Storage or S3 and returns their public URL, GET /media lists them and DELETE /media/{mediaID} removes them",
+			"Images uploaded with POST /media get resized variants, including a thumbnail, and a srcset",
+			"POST /blog-posts/import imports markdown documents sent as a JSON array, or a zipped folder, as blog posts with a per-document report",
+			"GET /export returns every blog post and project as markdown with YAML frontmatter, plus the tags in use, as a zip or a JSON bundle",
+			"POST /blog-post/{blogPostID}/duplicate copies a blog post into a new draft titled with (copy), with the backend password",
+			"POST /blog-post/{blogPostID}/preview-token signs a preview token that opens the draft at GET /preview/{token} without auth",
+			"POST /projects/import-github creates a project from a GitHub repository's name, README, homepage and topics",
+			"Projects have galleries of ordered, captioned screenshots, managed under /project/{projectID}/images and included in project responses",
+			"Projects have a markdown case_study, rendered like blog posts and served as HTML by GET /project/{slug}/case-study",
+			"GET /search searches blog posts, projects and tags in one query, returning typed results ranked together",
+			"GET /tags lists tags with usage counts, and PUT /tag/{id}, DELETE /tag/{id} and POST /tags/merge rename, delete and merge them",
+			"GET /projects and GET /projects/summaries sort by camelCase field names, such as dateAdded, like blog posts; snake_case spellings still work on every list",
+		},
+	},
+	{
+		Version: "1.0",
+		Changes: []string{"First versioned spec"},
+	},
+}
+
+// APIChange is what changed in one version of the API
+type APIChange struct {
+	Version string   `json:"version" example:"1.1"`
+	Changes []string `json:"changes"`
+}
+
+// APIVersion is the current version of the API spec and the changes that led to it
+type APIVersion struct {
+	Version   string      `json:"version" example:"1.1"`
+	Spec      string      `json:"spec" example:"/openapi.json"`
+	Changelog []APIChange `json:"changelog"`
+}
+
+// getVersion returns the spec version, so generated clients can tell when they're out of date, and the changelog
+// @Summary Get API version
+// @Description Returns the version of the API spec served at /openapi.json and the changelog of every version, newest first. The generated clients export the version they were built from as SpecVersion (Go) and specVersion (TypeScript)
+// @Tags Documentation
+// @Produce json
+// @Success 200 {object} APIVersion "API version and changelog"
+// @Router /version [get]
+func (h apiSpecHandler) getVersion() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.responder.WriteJSON(w, APIVersion{
+			Version:   docs.SwaggerInfo.Version,
+			Spec:      "/openapi.json",
+			Changelog: apiChangelog,
+		})
+	}
+}
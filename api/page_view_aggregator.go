@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// pageViewAggregateInterval is how often raw page views are rolled up into daily counts
+	pageViewAggregateInterval = 10 * time.Minute
+	// pageViewRetention is how long raw page view events are kept; daily counts are kept forever
+	pageViewRetention = 90 * 24 * time.Hour
+)
+
+// pageViewAggregator keeps the daily page view counts up to date and prunes old raw events and visitor salts
+type pageViewAggregator struct {
+	logger          zerolog.Logger
+	pageViewRepo    *database.PageViewRepo
+	visitorSaltRepo *database.VisitorSaltRepo
+}
+
+func newPageViewAggregator(pageViewRepo *database.PageViewRepo, visitorSaltRepo *database.VisitorSaltRepo) *pageViewAggregator {
+	logger := log.With().Str("handlerName", "pageViewAggregator").Logger()
+	return &pageViewAggregator{
+		logger:          logger,
+		pageViewRepo:    pageViewRepo,
+		visitorSaltRepo: visitorSaltRepo,
+	}
+}
+
+// aggregate recomputes yesterday's and today's daily counts, then prunes expired raw events and past visitor salts
+// Yesterday is included so views recorded just before midnight are counted once the day is over
+func (a *pageViewAggregator) aggregate(ctx context.Context, now time.Time) error {
+	// Past salts are never needed again, and deleting them is what keeps old visitor hashes from being reversed
+	if err := a.visitorSaltRepo.DeleteBefore(now); err != nil {
+		a.logger.Error().Err(err).Msg("Failed to delete past visitor salts")
+	}
+
+	if err := a.pageViewRepo.AggregateDays(now.AddDate(0, 0, -1), now.AddDate(0, 0, 1)); err != nil {
+		return fmt.Errorf("failed to aggregate daily page views: %w", err)
+	}
+
+	deleted, err := a.pageViewRepo.DeleteBefore(now.Add(-pageViewRetention))
+	if err != nil {
+		return fmt.Errorf("failed to prune old page views: %w", err)
+	}
+	if deleted > 0 {
+		a.logger.Info().Int64("deleted", deleted).Msg("Pruned old page views")
+	}
+	return nil
+}
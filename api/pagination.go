@@ -0,0 +1,198 @@
+package api
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+)
+
+// Pagination describes the page a client asked for on a list endpoint
+type Pagination struct {
+	Page    int
+	PerPage int
+}
+
+// Offset returns the number of rows to skip to reach the requested page
+func (p Pagination) Offset() int {
+	return (p.Page - 1) * p.PerPage
+}
+
+// ListMeta describes the full result set a page of a list response was taken from
+// Page is left out of pages taken by cursor, which give the cursor the next one starts at instead
+type ListMeta struct {
+	Total      int64  `json:"total"`
+	Page       int    `json:"page,omitempty"`
+	PerPage    int    `json:"perPage"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// ListLinks holds relative URLs for the current, next and previous pages of a list response
+// Next is omitted on the last page and Prev on the first
+type ListLinks struct {
+	Self string `json:"self"`
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+}
+
+// parsePagination reads the page and perPage query parameters
+// Missing values fall back to the first page and defaultPerPage; perPage may not exceed maxPerPage
+func parsePagination(r *http.Request, defaultPerPage, maxPerPage int) (Pagination, error) {
+	pagination := Pagination{Page: 1, PerPage: defaultPerPage}
+
+	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+		page, err := strconv.Atoi(pageStr)
+		if err != nil || page < 1 {
+			return Pagination{}, errs.NewInvalidFieldError("page", "must be a positive integer")
+		}
+		pagination.Page = page
+	}
+
+	if perPageStr := r.URL.Query().Get("perPage"); perPageStr != "" {
+		perPage, err := strconv.Atoi(perPageStr)
+		if err != nil || perPage < 1 {
+			return Pagination{}, errs.NewInvalidFieldError("perPage", "must be a positive integer")
+		}
+		if perPage > maxPerPage {
+			return Pagination{}, errs.NewInvalidFieldError("perPage", "must be at most "+strconv.Itoa(maxPerPage)+"; follow links.next or raise page to get the rest").WithCode(errs.CodePageTooLarge)
+		}
+		pagination.PerPage = perPage
+	}
+
+	return pagination, nil
+}
+
+// newListMetaAndLinks builds the meta and links blocks for a page of results
+// Links keep the request's other query parameters, such as sort, so following them preserves the view
+func newListMetaAndLinks(r *http.Request, pagination Pagination, total int64) (ListMeta, ListLinks) {
+	meta := ListMeta{Total: total, Page: pagination.Page, PerPage: pagination.PerPage}
+
+	links := ListLinks{Self: pageLink(r, pagination.Page, pagination.PerPage)}
+	if int64(pagination.Offset()+pagination.PerPage) < total {
+		links.Next = pageLink(r, pagination.Page+1, pagination.PerPage)
+	}
+	if pagination.Page > 1 {
+		links.Prev = pageLink(r, pagination.Page-1, pagination.PerPage)
+	}
+
+	return meta, links
+}
+
+// setLinkHeader repeats the next and previous links of a list response in a Link header, so clients can page
+// through a list without reading its body
+func setLinkHeader(w http.ResponseWriter, links ListLinks) {
+	var values []string
+	if links.Next != "" {
+		values = append(values, "<"+links.Next+`>; rel="next"`)
+	}
+	if links.Prev != "" {
+		values = append(values, "<"+links.Prev+`>; rel="prev"`)
+	}
+	if len(values) > 0 {
+		w.Header().Set("Link", strings.Join(values, ", "))
+	}
+}
+
+// pageLink returns the request's path and query with page and perPage replaced
+func pageLink(r *http.Request, page, perPage int) string {
+	query := r.URL.Query()
+	query.Set("page", strconv.Itoa(page))
+	query.Set("perPage", strconv.Itoa(perPage))
+	return r.URL.Path + "?" + query.Encode()
+}
+
+// CursorPagination describes a page a client asked for by cursor rather than by number
+// Cursor is nil for the first page
+type CursorPagination struct {
+	Cursor  *database.Cursor
+	PerPage int
+}
+
+// parseCursorPagination reads the cursor and perPage query parameters when r pages by cursor, which it does when it
+// has a cursor parameter at all: an empty one asks for the first page. ok is false when r pages by number instead
+// Cursor pages are newest first, so they can't be combined with page or sort
+func parseCursorPagination(r *http.Request, defaultPerPage, maxPerPage int) (CursorPagination, bool, error) {
+	query := r.URL.Query()
+	if !query.Has("cursor") {
+		return CursorPagination{}, false, nil
+	}
+	if query.Get("page") != "" {
+		return CursorPagination{}, false, errs.NewInvalidFieldError("page", "can't be combined with cursor")
+	}
+	if query.Get("sort") != "" {
+		return CursorPagination{}, false, errs.NewInvalidFieldError("sort", "can't be combined with cursor, which pages newest first")
+	}
+
+	cursor, err := parseCursor(r)
+	if err != nil {
+		return CursorPagination{}, false, err
+	}
+	pagination, err := parsePagination(r, defaultPerPage, maxPerPage)
+	if err != nil {
+		return CursorPagination{}, false, err
+	}
+	return CursorPagination{Cursor: cursor, PerPage: pagination.PerPage}, true, nil
+}
+
+// newCursorListMetaAndLinks builds the meta and links blocks for a page taken by cursor, where next is the position
+// the following page starts at, or nil on the last page
+func newCursorListMetaAndLinks(r *http.Request, pagination CursorPagination, total int64, next *database.Cursor) (ListMeta, ListLinks) {
+	meta := ListMeta{Total: total, PerPage: pagination.PerPage}
+	links := ListLinks{Self: cursorLink(r, r.URL.Query().Get("cursor"), pagination.PerPage)}
+	if next != nil {
+		meta.NextCursor = encodeCursor(*next)
+		links.Next = cursorLink(r, meta.NextCursor, pagination.PerPage)
+	}
+	return meta, links
+}
+
+// cursorLink returns the request's path and query with cursor and perPage replaced
+func cursorLink(r *http.Request, cursor string, perPage int) string {
+	query := r.URL.Query()
+	query.Set("cursor", cursor)
+	query.Set("perPage", strconv.Itoa(perPage))
+	return r.URL.Path + "?" + query.Encode()
+}
+
+// encodeCursor turns a feed position into the opaque cursor string handed to clients
+func encodeCursor(cursor database.Cursor) string {
+	raw := strconv.FormatInt(cursor.Time.UnixNano(), 10) + "_" + cursor.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// parseCursor reads the cursor query parameter, returning nil when the client wants the first page
+func parseCursor(r *http.Request) (*database.Cursor, error) {
+	cursorStr := r.URL.Query().Get("cursor")
+	if cursorStr == "" {
+		return nil, nil
+	}
+
+	invalidCursor := errs.NewInvalidFieldError("cursor", "must be a cursor returned by a previous request")
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return nil, invalidCursor
+	}
+
+	nanosStr, idStr, found := strings.Cut(string(raw), "_")
+	if !found {
+		return nil, invalidCursor
+	}
+
+	nanos, err := strconv.ParseInt(nanosStr, 10, 64)
+	if err != nil {
+		return nil, invalidCursor
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return nil, invalidCursor
+	}
+
+	return &database.Cursor{Time: time.Unix(0, nanos).UTC(), ID: id}, nil
+}
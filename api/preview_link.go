@@ -0,0 +1,129 @@
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultPreviewLinkTTL is how long a preview link works when PREVIEW_LINK_TTL isn't set
+	defaultPreviewLinkTTL = 72 * time.Hour
+	// maxPreviewLinkTTL is the longest a preview link can be asked to work for
+	maxPreviewLinkTTL = 30 * 24 * time.Hour
+)
+
+// errPreviewLinksDisabled is returned when there's no key to sign preview links with
+var errPreviewLinksDisabled = errors.New("preview links need PREVIEW_LINK_SECRET or BACKEND_PASSWORD to be set")
+
+// previewLinker signs and checks the tokens that let anyone holding one read a draft blog post until it expires
+// Nothing is stored: a token is the expiry and an HMAC-SHA256 of the post ID and expiry, optionally preceded by the
+// post ID, so changing the key revokes every link handed out with it
+type previewLinker struct {
+	key        []byte
+	ttl        time.Duration
+	apiBaseURL string
+}
+
+// newPreviewLinker signs with secret, which is PREVIEW_LINK_SECRET or else the backend password. apiBaseURL is
+// API_BASE_URL, which links are built from; when empty the request's host is used
+func newPreviewLinker(secret string, ttl time.Duration, apiBaseURL string) *previewLinker {
+	if ttl <= 0 || ttl > maxPreviewLinkTTL {
+		ttl = defaultPreviewLinkTTL
+	}
+	return &previewLinker{
+		key:        []byte(secret),
+		ttl:        ttl,
+		apiBaseURL: strings.TrimSuffix(apiBaseURL, "/"),
+	}
+}
+
+// sign returns a token for blogPostID that works until expiresAt
+func (p *previewLinker) sign(blogPostID uuid.UUID, expiresAt time.Time) (string, error) {
+	if len(p.key) == 0 {
+		return "", errPreviewLinksDisabled
+	}
+	expiry := strconv.FormatInt(expiresAt.Unix(), 10)
+	return expiry + "." + base64.RawURLEncoding.EncodeToString(p.signature(blogPostID, expiry)), nil
+}
+
+// signWithID returns a token for blogPostID that works until expiresAt and names the post itself, prefixed with its
+// ID, so GET /preview/{token} can find it. It also works wherever a token from sign does
+func (p *previewLinker) signWithID(blogPostID uuid.UUID, expiresAt time.Time) (string, error) {
+	token, err := p.sign(blogPostID, expiresAt)
+	if err != nil {
+		return "", err
+	}
+	return blogPostID.String() + "." + token, nil
+}
+
+// open returns the blog post a token from signWithID was signed for, reporting false if it wasn't signed by this key
+// or has expired
+func (p *previewLinker) open(token string) (uuid.UUID, bool) {
+	id, rest, ok := strings.Cut(token, ".")
+	if !ok {
+		return uuid.Nil, false
+	}
+	blogPostID, err := uuid.Parse(id)
+	if err != nil || !p.verify(blogPostID, rest) {
+		return uuid.Nil, false
+	}
+	return blogPostID, true
+}
+
+// verify reports whether token was signed for blogPostID and hasn't expired. token can come from sign or, with the
+// ID it starts with, from signWithID
+func (p *previewLinker) verify(blogPostID uuid.UUID, token string) bool {
+	if len(p.key) == 0 || token == "" {
+		return false
+	}
+	if id, rest, ok := strings.Cut(token, "."); ok && strings.Contains(rest, ".") {
+		if id != blogPostID.String() {
+			return false
+		}
+		token = rest
+	}
+	expiry, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil || time.Now().Unix() >= expiresAt {
+		return false
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(decoded, p.signature(blogPostID, expiry))
+}
+
+func (p *previewLinker) signature(blogPostID uuid.UUID, expiry string) []byte {
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write([]byte("blog-post-preview:" + blogPostID.String() + ":" + expiry))
+	return mac.Sum(nil)
+}
+
+// url returns the GET /blog-post/{id} URL that opens the draft with token
+func (p *previewLinker) url(r *http.Request, blogPostID uuid.UUID, token string) string {
+	return p.baseURL(r) + "/blog-post/" + blogPostID.String() + "?preview=" + token
+}
+
+// previewURL returns the GET /preview/{token} URL that opens the draft a token from signWithID is for
+func (p *previewLinker) previewURL(r *http.Request, token string) string {
+	return p.baseURL(r) + "/preview/" + token
+}
+
+func (p *previewLinker) baseURL(r *http.Request) string {
+	if p.apiBaseURL != "" {
+		return p.apiBaseURL
+	}
+	return requestScheme(r) + "://" + r.Host
+}
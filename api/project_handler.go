@@ -3,79 +3,289 @@ package api
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
+	"mime"
 	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/rpupo63/unified-personal-site-backend/database"
 	"github.com/rpupo63/unified-personal-site-backend/errs"
 	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	defaultProjectsPerPage = 50
+	maxProjectsPerPage     = database.MaxPageSize
+)
+
 type projectHandler struct {
-	responder      Responder
-	logger         zerolog.Logger
-	projectRepo    *database.ProjectRepo
-	projectTagRepo *database.ProjectTagRepo
+	responder       Responder
+	logger          zerolog.Logger
+	projectRepo     *database.ProjectRepo
+	projectTagRepo  *database.ProjectTagRepo
+	contentViewRepo *database.ContentViewRepo
+	webhooks        *webhookDispatcher
+	githubToken     string
 }
 
-func newProjectHandler(projectRepo *database.ProjectRepo, projectTagRepo *database.ProjectTagRepo) projectHandler {
+func newProjectHandler(projectRepo *database.ProjectRepo, projectTagRepo *database.ProjectTagRepo, contentViewRepo *database.ContentViewRepo, webhooks *webhookDispatcher, githubToken string) projectHandler {
 	logger := log.With().Str("handlerName", "projectHandler").Logger()
 
 	return projectHandler{
-		responder:      NewResponder(logger),
-		logger:         logger,
-		projectRepo:    projectRepo,
-		projectTagRepo: projectTagRepo,
+		responder:       NewResponder(logger),
+		logger:          logger,
+		projectRepo:     projectRepo,
+		projectTagRepo:  projectTagRepo,
+		contentViewRepo: contentViewRepo,
+		webhooks:        webhooks,
+		githubToken:     githubToken,
 	}
 }
 
-// ProjectWithTags represents a project with its tags
+// ProjectWithTags represents a project with its tags. Its gallery is in the project's images, in order
 type ProjectWithTags struct {
 	Project models.Project      `json:"project"`
 	Tags    []models.ProjectTag `json:"tags"`
 }
 
-// ProjectCollectionWithTags represents multiple projects with their tags
+// ProjectCollectionWithTags represents one page of projects with their tags
 type ProjectCollectionWithTags struct {
-	Projects []ProjectWithTags `json:"projects"`
-	Total    int               `json:"total,omitempty"`
+	Data  []ProjectWithTags `json:"data"`
+	Meta  ListMeta          `json:"meta"`
+	Links ListLinks         `json:"links"`
 }
 
-// getAllProjects retrieves all projects with their tags
+// ProjectSummary is a project as list views show it, with its tag values rather than full tag rows
+type ProjectSummary struct {
+	ID          uuid.UUID  `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	GithubLink  string     `json:"github_link"`
+	DemoLink    string     `json:"demo_link"`
+	Type        string     `json:"type"`
+	GifLink     *string    `json:"gif_link,omitempty"`
+	DateAdded   time.Time  `json:"date_added"`
+	DateEdited  *time.Time `json:"date_edited,omitempty"`
+	Tags        []string   `json:"tags"`
+}
+
+// ProjectSummaryCollection represents one page of project summaries
+type ProjectSummaryCollection struct {
+	Data  []ProjectSummary `json:"data"`
+	Meta  ListMeta         `json:"meta"`
+	Links ListLinks        `json:"links"`
+}
+
+// ProjectSearchResult is a project search match with its tags and relevance score
+// Scores above 1 are full-text matches; scores from 0 to 1 are fuzzy trigram matches
+type ProjectSearchResult struct {
+	Project models.Project      `json:"project"`
+	Tags    []models.ProjectTag `json:"tags"`
+	Score   float64             `json:"score"`
+}
+
+// ProjectSearchResults is the response of a project search
+type ProjectSearchResults struct {
+	Query string                `json:"query"`
+	Data  []ProjectSearchResult `json:"data"`
+}
+
+// getAllProjects retrieves one page of projects with their tags
 // @Summary Get all projects
-// @Description Retrieves all projects from the database with their associated tags
+// @Description Retrieves one page of projects from the database with their associated tags, newest first unless sort is given. Pages are numbered, or taken by cursor when cursor is given (empty for the first page): cursor pages are newest first by date_added and id, stay fast however deep they go and don't shift when projects are added, and give the next page's cursor as meta.nextCursor
 // @Tags Projects
 // @Accept json
-// @Produce json
-// @Success 200 {object} ProjectCollectionWithTags "List of projects with tags"
+// @Produce json,application/vnd.api+json
+// @Param sort query string false "Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, type (snake_case spellings such as date_added work too). Not allowed with cursor"
+// @Param page query int false "Page number (starts at 1). Not allowed with cursor" default(1)
+// @Param cursor query string false "meta.nextCursor of the previous page, or empty for the first page, to page by cursor"
+// @Param perPage query int false "Projects per page (max 100)" default(50)
+// @Success 200 {object} ProjectCollectionWithTags "Page of projects with tags"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid sort, pagination or cursor parameters"
 // @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching projects"
 // @Router /projects [get]
 func (h projectHandler) getAllProjects() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Authentication handled by middleware
 
-		projects, err := h.projectRepo.FindAll()
+		projects, meta, links, err := h.findProjectPage(r)
 		if err != nil {
-			h.responder.WriteError(w, wrapDatabaseError("find projects", "projects", err))
+			h.responder.WriteError(w, err)
 			return
 		}
 
-		// Convert to ProjectWithTags format
-		var projectsWithTags []ProjectWithTags
+		response := ProjectCollectionWithTags{
+			Data:  make([]ProjectWithTags, 0, len(projects)),
+			Meta:  meta,
+			Links: links,
+		}
 		for _, project := range projects {
-			projectsWithTags = append(projectsWithTags, ProjectWithTags{
+			response.Data = append(response.Data, ProjectWithTags{
 				Project: *project,
 				Tags:    project.Tags,
 			})
 		}
 
-		response := ProjectCollectionWithTags{
-			Projects: projectsWithTags,
-			Total:    len(projectsWithTags),
+		if wantsJSONAPI(r) {
+			h.responder.WriteJSONAPI(w, http.StatusOK, newProjectCollectionDocument(response))
+			return
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// findProjectPage finds the page of projects r asks for, by cursor or by page number
+func (h projectHandler) findProjectPage(r *http.Request) ([]*models.Project, ListMeta, ListLinks, error) {
+	cursorPagination, byCursor, err := parseCursorPagination(r, defaultProjectsPerPage, maxProjectsPerPage)
+	if err != nil {
+		return nil, ListMeta{}, ListLinks{}, err
+	}
+	if byCursor {
+		projects, next, total, err := h.projectRepo.FindPageBefore(cursorPagination.Cursor, cursorPagination.PerPage)
+		if err != nil {
+			return nil, ListMeta{}, ListLinks{}, wrapDatabaseError("find projects", "projects", err)
+		}
+		meta, links := newCursorListMetaAndLinks(r, cursorPagination, total, next)
+		return projects, meta, links, nil
+	}
+
+	sort, err := database.ParseSort(r.URL.Query().Get("sort"), database.ProjectSortColumns)
+	if err != nil {
+		return nil, ListMeta{}, ListLinks{}, errs.NewInvalidFieldError("sort", err.Error())
+	}
+	pagination, err := parsePagination(r, defaultProjectsPerPage, maxProjectsPerPage)
+	if err != nil {
+		return nil, ListMeta{}, ListLinks{}, err
+	}
+	projects, total, err := h.projectRepo.FindPage(pagination.Offset(), pagination.PerPage, sort...)
+	if err != nil {
+		return nil, ListMeta{}, ListLinks{}, wrapDatabaseError("find projects", "projects", err)
+	}
+	meta, links := newListMetaAndLinks(r, pagination, total)
+	return projects, meta, links, nil
+}
+
+// getProjectSummaries retrieves one page of projects with their tag values
+// @Summary Get project summaries
+// @Description Retrieves one page of projects for list views such as the homepage. Tags are plain values rather than full tag rows, so the page is smaller than GET /projects. Newest first unless sort is given
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param sort query string false "Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, type (snake_case spellings such as date_added work too)"
+// @Param page query int false "Page number (starts at 1)" default(1)
+// @Param perPage query int false "Projects per page (max 100)" default(50)
+// @Success 200 {object} ProjectSummaryCollection "Page of project summaries"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid sort or pagination parameters"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching projects"
+// @Router /projects/summaries [get]
+func (h projectHandler) getProjectSummaries() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sort, err := database.ParseSort(r.URL.Query().Get("sort"), database.ProjectSortColumns)
+		if err != nil {
+			h.responder.WriteError(w, errs.NewInvalidFieldError("sort", err.Error()))
+			return
+		}
+
+		pagination, err := parsePagination(r, defaultProjectsPerPage, maxProjectsPerPage)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		projects, total, err := h.projectRepo.FindSummaryPage(pagination.Offset(), pagination.PerPage, sort...)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find projects", "projects", err))
+			return
+		}
+
+		ids := make([]uuid.UUID, 0, len(projects))
+		for _, project := range projects {
+			ids = append(ids, project.ID)
+		}
+		tags, err := h.projectTagRepo.FindValues(ids)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find project tags", "project_tags", err))
+			return
+		}
+
+		meta, links := newListMetaAndLinks(r, pagination, total)
+		response := ProjectSummaryCollection{
+			Data:  make([]ProjectSummary, 0, len(projects)),
+			Meta:  meta,
+			Links: links,
+		}
+		for _, project := range projects {
+			response.Data = append(response.Data, newProjectSummary(project, tags[project.ID]))
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// newProjectSummary is the summary of project with its tag values
+func newProjectSummary(project *models.Project, tags []string) ProjectSummary {
+	if tags == nil {
+		tags = []string{}
+	}
+	return ProjectSummary{
+		ID:          project.ID,
+		Title:       project.Title,
+		Description: project.Description,
+		GithubLink:  project.GithubLink,
+		DemoLink:    project.DemoLink,
+		Type:        project.Type,
+		GifLink:     project.GifLink,
+		DateAdded:   project.DateAdded,
+		DateEdited:  project.DateEdited,
+		Tags:        tags,
+	}
+}
+
+// searchProjects searches projects by title and content, tolerating typos
+// @Summary Search projects
+// @Description Full-text search over projects, merged with trigram similarity matches so misspelled queries (e.g. "postgers") still find results. Full-text matches rank first.
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param q query string true "Search query (max 200 characters)"
+// @Param limit query int false "Maximum number of results (max 50)" default(20)
+// @Success 200 {object} ProjectSearchResults "Matching projects, best first"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Missing or invalid q or limit"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error searching projects"
+// @Router /projects/search [get]
+func (h projectHandler) searchProjects() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query, limit, err := parseSearchParams(r)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		projects, scores, err := h.projectRepo.Search(query, limit)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("search projects", "projects", err))
+			return
+		}
+
+		response := ProjectSearchResults{
+			Query: query,
+			Data:  make([]ProjectSearchResult, 0, len(projects)),
+		}
+		for i, project := range projects {
+			response.Data = append(response.Data, ProjectSearchResult{
+				Project: *project,
+				Tags:    project.Tags,
+				Score:   scores[i],
+			})
 		}
 
 		h.responder.WriteJSON(w, response)
@@ -87,7 +297,7 @@ func (h projectHandler) getAllProjects() http.HandlerFunc {
 // @Description Retrieves detailed information about a specific project by ID with its tags
 // @Tags Projects
 // @Accept json
-// @Produce json
+// @Produce json,application/vnd.api+json
 // @Param projectID path string true "Project ID" format(uuid)
 // @Success 200 {object} ProjectWithTags "Project details with tags"
 // @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid projectID"
@@ -116,27 +326,98 @@ func (h projectHandler) getProject() http.HandlerFunc {
 			return
 		}
 
-		if project == nil {
-			h.responder.WriteError(w, errs.NewNotFoundError("project not found"))
-			return
-		}
+		recordContentView(h.logger, h.contentViewRepo, r, models.ContentTypeProject, project.ID)
 
 		response := ProjectWithTags{
 			Project: *project,
 			Tags:    project.Tags,
 		}
 
+		if wantsJSONAPI(r) {
+			h.responder.WriteJSONAPI(w, http.StatusOK, newProjectDocument(response))
+			return
+		}
+
 		h.responder.WriteJSON(w, response)
 	}
 }
 
+// ProjectCaseStudy is a project's case study, rendered as HTML, as GET /project/{slug}/case-study serves it to clients
+// asking for JSON
+type ProjectCaseStudy struct {
+	ProjectID uuid.UUID `json:"project_id"`
+	Title     string    `json:"title" example:"Personal Site Backend"`
+	Slug      string    `json:"slug" example:"personal-site-backend"`
+	HTML      string    `json:"html" example:"<h2 id=\"why\">Why</h2>\n<p>The site needed an API.</p>\n"`
+}
+
+// getProjectCaseStudy serves a project's case study rendered as HTML
+// @Summary Get project case study
+// @Description Serves a project's long-form case study, written in Markdown as case_study, rendered as sanitized HTML the same way blog posts are: GitHub-flavored Markdown with heading IDs, and without raw HTML or script links. The HTML fragment is the response body, as text/html, unless the Accept header asks for application/json, which gets it in a JSON object with the project's ID, title and slug. The project is named by its slug, made from its title like a blog post's (e.g. "Personal Site Backend" is personal-site-backend), or by its ID
+// @Tags Projects
+// @Accept json
+// @Produce html,json
+// @Param slug path string true "Project slug or ID"
+// @Success 200 {object} ProjectCaseStudy "Rendered case study, as an HTML fragment or, with Accept: application/json, this object"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Project not found, or it has no case study"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching project"
+// @Router /project/{slug}/case-study [get]
+func (h projectHandler) getProjectCaseStudy() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		slug := chi.URLParam(r, "slug")
+
+		var project *models.Project
+		var err error
+		if projectID, parseErr := uuid.Parse(slug); parseErr == nil {
+			project, err = h.projectRepo.FindByID(projectID)
+		} else {
+			project, err = h.projectRepo.FindBySlug(slug, services.BlogPostSlug)
+		}
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find project", "project", err))
+			return
+		}
+		if strings.TrimSpace(project.CaseStudy) == "" {
+			h.responder.WriteError(w, errs.NewNotFoundError("project has no case study").WithCode(errs.EntityCode("case_study", errs.CodeSuffixNotFound)))
+			return
+		}
+
+		if wantsJSON(r) {
+			h.responder.WriteJSON(w, ProjectCaseStudy{
+				ProjectID: project.ID,
+				Title:     project.Title,
+				Slug:      services.BlogPostSlug(project.Title),
+				HTML:      project.CaseStudyHTML,
+			})
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if _, err := io.WriteString(w, project.CaseStudyHTML); err != nil {
+			h.logger.Error().Err(err).Msg("error writing project case study")
+		}
+	}
+}
+
+// wantsJSON reports whether the client asked for JSON in its Accept header
+func wantsJSON(r *http.Request) bool {
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err == nil && mediaType == "application/json" {
+			return true
+		}
+	}
+	return false
+}
+
 // createProject creates a new project
 // @Summary Create project
-// @Description Creates a new project in the database
+// @Description Creates a new project in the database. Images can be given with the project, in gallery order
 // @Tags Projects
 // @Accept json
-// @Produce json
+// @Produce json,application/vnd.api+json
 // @Param project body models.Project true "Project data"
+// @Param Idempotency-Key header string false "Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)"
 // @Success 201 {object} ProjectWithTags "Created project with tags"
 // @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid project data"
 // @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating project"
@@ -159,57 +440,188 @@ func (h projectHandler) createProject() http.HandlerFunc {
 			return
 		}
 
-		if project.Title == "" {
-			h.responder.WriteError(w, errs.NewBadRequestError("title is required"))
+		if err := validateRequest(&project); err != nil {
+			h.responder.WriteError(w, err)
 			return
 		}
+		prepareProjectImages(project.Images)
 
-		// Extract tags before creating the project
-		tags := project.Tags
-		project.Tags = nil // Clear tags to avoid issues during creation
+		// Set DateAdded if not provided
+		if project.DateAdded.IsZero() {
+			project.DateAdded = time.Now()
+		}
 
-		if err := h.projectRepo.Add(&project); err != nil {
-			h.responder.WriteError(w, wrapDatabaseError("create project", "project", err))
+		createdProject, err := h.add(&project)
+		if err != nil {
+			h.responder.WriteError(w, err)
 			return
 		}
 
-		// Create tags if provided
-		if len(tags) > 0 {
-			for i := range tags {
-				tags[i].ProjectID = project.ID
-				if tags[i].ID == uuid.Nil {
-					tags[i].ID = uuid.New()
-				}
-				if err := h.projectTagRepo.Add(&tags[i]); err != nil {
-					h.logger.Error().Err(err).Str("tag_value", tags[i].Value).Msg("Failed to create project tag")
-					// Continue creating other tags even if one fails
-				}
-			}
+		response := ProjectWithTags{
+			Project: *createdProject,
+			Tags:    createdProject.Tags,
+		}
+		h.webhooks.emit(models.WebhookEventProjectCreated, response)
+
+		if wantsJSONAPI(r) {
+			h.responder.WriteJSONAPI(w, http.StatusCreated, newProjectDocument(response))
+			return
 		}
 
-		// Reload project to get tags
-		createdProject, err := h.projectRepo.FindByID(project.ID)
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// add saves project, already validated, along with its tags, and returns it reloaded with them. A tag that fails to
+// save is logged and left out rather than failing the project
+func (h projectHandler) add(project *models.Project) (*models.Project, error) {
+	// Extract tags before creating the project
+	tags := project.Tags
+	project.Tags = nil // Clear tags to avoid issues during creation
+
+	if err := services.RenderProject(project); err != nil {
+		return nil, errs.NewInternalErrorWithCause("Failed to render project", err)
+	}
+	if err := h.projectRepo.Add(project); err != nil {
+		return nil, wrapDatabaseError("create project", "project", err)
+	}
+
+	// Create tags if provided
+	for i := range tags {
+		tags[i].ProjectID = project.ID
+		if tags[i].ID == uuid.Nil {
+			tags[i].ID = uuid.New()
+		}
+		if err := h.projectTagRepo.Add(&tags[i]); err != nil {
+			h.logger.Error().Err(err).Str("tag_value", tags[i].Value).Msg("Failed to create project tag")
+			// Continue creating other tags even if one fails
+		}
+	}
+
+	// Reload project to get tags
+	createdProject, err := h.projectRepo.FindByID(project.ID)
+	if err != nil {
+		return nil, wrapDatabaseError("find created project", "project", err)
+	}
+	return createdProject, nil
+}
+
+// defaultGitHubProjectType is the type of projects imported from GitHub when the import doesn't give one
+const defaultGitHubProjectType = "open-source"
+
+// GitHubProjectImport is the body of POST /projects/import-github
+type GitHubProjectImport struct {
+	URL string `json:"url" validate:"required" example:"https://github.com/example/personal-site-backend"`
+	// Type is the project's type, open-source unless given
+	Type string `json:"type,omitempty" example:"web"`
+	// DryRun returns the project as it would be created without saving it, e.g. to prefill a form
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// importGitHubProject creates a project from a GitHub repository
+// @Summary Import project from GitHub
+// @Description Creates a project from a GitHub repository, read from the GitHub API. The repository's name becomes the title, its README the description (or its description when it has no README), its homepage the demo link and its topics the tags. Its description is also the meta_description when it fits in 160 characters. Without GITHUB_TOKEN only public repositories can be imported, at GitHub's lower rate limit. With dryRun the project is returned as it would be created, without saving it, so it can be edited first and sent to POST /project
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param import body GitHubProjectImport true "Repository to import"
+// @Success 201 {object} ProjectWithTags "Created project with tags"
+// @Success 200 {object} ProjectWithTags "With dryRun, the project as it would be created"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Missing url, or not a GitHub repository URL"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 404 {object} api.ErrorResponse "Not Found - No such repository, or it's private"
+// @Failure 409 {object} api.ErrorResponse "Conflict - A project already has the repository's name as its title"
+// @Failure 502 {object} api.ErrorResponse "Bad Gateway - GitHub API error, such as its rate limit"
+// @Router /projects/import-github [post]
+func (h projectHandler) importGitHubProject() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var request GitHubProjectImport
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+			return
+		}
+		if err := validateRequest(&request); err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		owner, name, err := services.ParseGitHubRepoURL(request.URL)
 		if err != nil {
-			h.responder.WriteError(w, wrapDatabaseError("find created project", "project", err))
+			h.responder.WriteError(w, errs.NewInvalidFieldError("url", err.Error()))
 			return
 		}
 
+		repo, err := services.FetchGitHubRepo(r.Context(), h.githubToken, owner, name)
+		if errors.Is(err, services.ErrGitHubRepoNotFound) {
+			h.responder.WriteError(w, errs.NewNotFoundError("GitHub repository not found").WithCode(errs.EntityCode("github_repo", errs.CodeSuffixNotFound)))
+			return
+		}
+		if err != nil {
+			h.logger.Error().Err(err).Str("repo", owner+"/"+name).Msg("Failed to read GitHub repository")
+			h.responder.WriteError(w, errs.NewApiErr(http.StatusBadGateway, "failed to read the repository from GitHub: "+err.Error()))
+			return
+		}
+
+		project := githubProject(repo, request.Type)
+		if err := validateRequest(&project); err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		if request.DryRun {
+			h.responder.WriteJSON(w, ProjectWithTags{Project: project, Tags: project.Tags})
+			return
+		}
+
+		createdProject, err := h.add(&project)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		h.logger.Info().Str("repo", repo.Owner+"/"+repo.Name).Str("projectID", createdProject.ID.String()).Msg("Imported project from GitHub")
+
 		response := ProjectWithTags{
 			Project: *createdProject,
 			Tags:    createdProject.Tags,
 		}
+		h.webhooks.emit(models.WebhookEventProjectCreated, response)
 
 		w.WriteHeader(http.StatusCreated)
 		h.responder.WriteJSON(w, response)
 	}
 }
 
+// githubProject fills in a project of projectType, or defaultGitHubProjectType, from repo
+func githubProject(repo services.GitHubRepo, projectType string) models.Project {
+	project := models.Project{
+		Title:       repo.Name,
+		Description: repo.Readme,
+		GithubLink:  repo.HTMLURL,
+		DemoLink:    repo.Homepage,
+		Type:        strings.TrimSpace(projectType),
+		DateAdded:   time.Now(),
+	}
+	if project.Type == "" {
+		project.Type = defaultGitHubProjectType
+	}
+	if project.Description == "" {
+		project.Description = repo.Description
+	}
+	if repo.Description != "" && utf8.RuneCountInString(repo.Description) <= 160 {
+		project.MetaDescription = &repo.Description
+	}
+	for _, topic := range uniqueTagValues(repo.Topics) {
+		project.Tags = append(project.Tags, models.ProjectTag{Value: topic})
+	}
+	return project
+}
+
 // updateProject updates an existing project
 // @Summary Update project
-// @Description Updates an existing project in the database
+// @Description Updates an existing project in the database. Images are left as they are; they're changed under /project/{projectID}/images
 // @Tags Projects
 // @Accept json
-// @Produce json
+// @Produce json,application/vnd.api+json
 // @Param projectID path string true "Project ID" format(uuid)
 // @Param project body models.Project true "Updated project data"
 // @Success 200 {object} ProjectWithTags "Updated project with tags"
@@ -240,11 +652,6 @@ func (h projectHandler) updateProject() http.HandlerFunc {
 			return
 		}
 
-		if existingProject == nil {
-			h.responder.WriteError(w, errs.NewNotFoundError("project not found"))
-			return
-		}
-
 		bodyBytes, err := io.ReadAll(r.Body)
 		if err != nil {
 			h.logger.Error().Err(err).Msg("Failed to read request body")
@@ -259,8 +666,29 @@ func (h projectHandler) updateProject() http.HandlerFunc {
 			return
 		}
 
+		if err := validateRequest(&project); err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
 		// Ensure ID matches
 		project.ID = projectID
+		// Images are managed under /project/{projectID}/images once the project exists
+		project.Images = nil
+
+		// Keep the original DateAdded if not provided
+		if project.DateAdded.IsZero() {
+			project.DateAdded = existingProject.DateAdded
+		}
+
+		// Update DateEdited
+		now := time.Now()
+		project.DateEdited = &now
+
+		if err := services.RenderProject(&project); err != nil {
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("Failed to render project", err))
+			return
+		}
 
 		if err := h.projectRepo.Update(&project); err != nil {
 			h.responder.WriteError(w, wrapDatabaseError("update project", "project", err))
@@ -279,19 +707,26 @@ func (h projectHandler) updateProject() http.HandlerFunc {
 			Tags:    updatedProject.Tags,
 		}
 
+		if wantsJSONAPI(r) {
+			h.responder.WriteJSONAPI(w, http.StatusOK, newProjectDocument(response))
+			return
+		}
+
 		h.responder.WriteJSON(w, response)
 	}
 }
 
-// deleteProject deletes a project by ID
+// deleteProject moves a project to the trash, or deletes it permanently, by ID
 // @Summary Delete project
-// @Description Deletes a project from the database by ID
+// @Description Moves a project to the trash by ID, from where POST /project/{projectID}/restore brings it back. With permanent=true, which requires the backend password, the project and its tags are removed from the database instead, whether or not it's in the trash
 // @Tags Projects
 // @Accept json
 // @Produce json
 // @Param projectID path string true "Project ID" format(uuid)
+// @Param permanent query bool false "Delete permanently instead of moving to the trash" default(false)
 // @Success 200 {object} map[string]string "Success message"
-// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid projectID"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid projectID or permanent"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required to delete permanently"
 // @Failure 404 {object} api.ErrorResponse "Not Found - Project not found"
 // @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting project"
 // @Router /project/{projectID} [delete]
@@ -311,6 +746,23 @@ func (h projectHandler) deleteProject() http.HandlerFunc {
 			return
 		}
 
+		permanent, err := parsePermanentDelete(r)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		if permanent {
+			if err := h.projectRepo.DeletePermanently(projectID); err != nil {
+				h.responder.WriteError(w, wrapDatabaseError("delete project", "project", err))
+				return
+			}
+			h.responder.WriteJSON(w, map[string]string{
+				"status":  "success",
+				"message": "project deleted permanently",
+			})
+			return
+		}
+
 		// Verify project exists
 		_, err = h.projectRepo.FindByID(projectID)
 		if err != nil {
@@ -325,7 +777,130 @@ func (h projectHandler) deleteProject() http.HandlerFunc {
 
 		h.responder.WriteJSON(w, map[string]string{
 			"status":  "success",
-			"message": "project deleted successfully",
+			"message": "project moved to the trash",
+		})
+	}
+}
+
+// restoreProject takes a project out of the trash
+// @Summary Restore project
+// @Description Takes a project moved to the trash by DELETE /project/{projectID} out of it again, with its tags
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param projectID path string true "Project ID" format(uuid)
+// @Success 200 {object} ProjectWithTags "Restored project"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid projectID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Project not in the trash"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error restoring project"
+// @Router /project/{projectID}/restore [post]
+func (h projectHandler) restoreProject() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		projectID, err := uuid.Parse(chi.URLParam(r, "projectID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid projectID"))
+			return
+		}
+
+		if err := h.projectRepo.Restore(projectID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("restore project", "project", err))
+			return
+		}
+
+		project, err := h.projectRepo.FindByID(projectID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find project", "project", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, ProjectWithTags{
+			Project: *project,
+			Tags:    project.Tags,
 		})
 	}
 }
+
+// TrashedProject is a project in the trash, with when it was moved there
+type TrashedProject struct {
+	ProjectWithTags
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+// TrashedProjectCollection represents one page of the trash
+type TrashedProjectCollection struct {
+	Data  []TrashedProject `json:"data"`
+	Meta  ListMeta         `json:"meta"`
+	Links ListLinks        `json:"links"`
+}
+
+// getTrashedProjects retrieves one page of the projects in the trash
+// @Summary Get trashed projects
+// @Description Retrieves one page of the projects moved to the trash, most recently deleted first, with their tags
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number (starts at 1)" default(1)
+// @Param perPage query int false "Projects per page (max 100)" default(50)
+// @Success 200 {object} TrashedProjectCollection "Page of trashed projects"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid pagination parameters"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching projects"
+// @Router /projects/trash [get]
+func (h projectHandler) getTrashedProjects() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pagination, err := parsePagination(r, defaultProjectsPerPage, maxProjectsPerPage)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		projects, total, err := h.projectRepo.FindTrashPage(pagination.Offset(), pagination.PerPage)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find trashed projects", "projects", err))
+			return
+		}
+
+		response := TrashedProjectCollection{Data: make([]TrashedProject, 0, len(projects))}
+		for _, project := range projects {
+			response.Data = append(response.Data, TrashedProject{
+				ProjectWithTags: ProjectWithTags{Project: *project, Tags: project.Tags},
+				DeletedAt:       project.DeletedAt.Time,
+			})
+		}
+		response.Meta, response.Links = newListMetaAndLinks(r, pagination, total)
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// deleteProjects deletes every blog post matching the request in one transaction
+// @Summary Bulk delete projects
+// @Description Moves projects to the trash by ID and/or filter (tag, addedBefore, addedAfter) in a single transaction. All given criteria must match and at least one is required. Requires the backend password
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body BulkDeleteRequest true "IDs and/or filter selecting the projects to delete"
+// @Success 200 {object} BulkDeleteResult "Summary of deleted projects"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Malformed or empty selection"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting projects"
+// @Router /projects [delete]
+func (h projectHandler) deleteProjects() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter, ok := decodeBulkDelete(w, r, h.responder, h.logger)
+		if !ok {
+			return
+		}
+
+		deleted, err := h.projectRepo.DeleteMatching(filter)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete projects", "projects", err))
+			return
+		}
+
+		h.logger.Info().Int("deleted", len(deleted)).Msg("Bulk deleted projects")
+		h.responder.WriteJSON(w, newBulkDeleteResult(filter.IDs, deleted))
+	}
+}
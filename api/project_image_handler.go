@@ -0,0 +1,268 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// ProjectImageCollection is a project's gallery, in order
+type ProjectImageCollection struct {
+	Images []models.ProjectImage `json:"images"`
+}
+
+type projectImageHandler struct {
+	responder        Responder
+	logger           zerolog.Logger
+	projectImageRepo *database.ProjectImageRepo
+	projectRepo      *database.ProjectRepo
+}
+
+func newProjectImageHandler(projectImageRepo *database.ProjectImageRepo, projectRepo *database.ProjectRepo) projectImageHandler {
+	logger := log.With().Str("handlerName", "projectImageHandler").Logger()
+
+	return projectImageHandler{
+		responder:        NewResponder(logger),
+		logger:           logger,
+		projectImageRepo: projectImageRepo,
+		projectRepo:      projectRepo,
+	}
+}
+
+// getProjectImages lists a project's images
+// @Summary Get project images
+// @Description Lists a project's screenshots in gallery order, by position, for showing as a carousel. Projects in the trash have none to show
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param projectID path string true "Project ID" format(uuid)
+// @Success 200 {object} ProjectImageCollection "Project images"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid projectID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Project not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching images"
+// @Router /project/{projectID}/images [get]
+func (h projectImageHandler) getProjectImages() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		project, ok := h.findProject(w, r)
+		if !ok {
+			return
+		}
+
+		images, err := h.projectImageRepo.FindForProject(project.ID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find project images", "project_images", err))
+			return
+		}
+
+		response := ProjectImageCollection{Images: make([]models.ProjectImage, 0, len(images))}
+		for _, image := range images {
+			response.Images = append(response.Images, *image)
+		}
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// createProjectImage adds an image to a project's gallery
+// @Summary Add project image
+// @Description Adds a screenshot to a project's gallery, after its last image unless a position is given
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param projectID path string true "Project ID" format(uuid)
+// @Param image body models.ProjectImage true "Image data"
+// @Success 201 {object} models.ProjectImage "Created image"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid image data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Project not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating image"
+// @Router /project/{projectID}/images [post]
+func (h projectImageHandler) createProjectImage() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		project, ok := h.findProject(w, r)
+		if !ok {
+			return
+		}
+
+		image, ok := h.decodeProjectImage(w, r)
+		if !ok {
+			return
+		}
+		image.ID = uuid.Nil
+		image.ProjectID = project.ID
+
+		if err := h.projectImageRepo.Add(image); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create project image", "project_image", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, image)
+	}
+}
+
+// updateProjectImage updates one of a project's images
+// @Summary Update project image
+// @Description Replaces an image's URL, alt text, caption and position
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param projectID path string true "Project ID" format(uuid)
+// @Param imageID path string true "Image ID" format(uuid)
+// @Param image body models.ProjectImage true "Updated image data"
+// @Success 200 {object} models.ProjectImage "Updated image"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid image data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Project or image not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error updating image"
+// @Router /project/{projectID}/image/{imageID} [put]
+func (h projectImageHandler) updateProjectImage() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		existing, ok := h.findProjectImage(w, r)
+		if !ok {
+			return
+		}
+
+		image, ok := h.decodeProjectImage(w, r)
+		if !ok {
+			return
+		}
+		image.ID = existing.ID
+		image.ProjectID = existing.ProjectID
+		image.DateAdded = existing.DateAdded
+
+		if err := h.projectImageRepo.Update(image); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update project image", "project_image", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, image)
+	}
+}
+
+// deleteProjectImage removes an image from a project's gallery
+// @Summary Delete project image
+// @Description Removes an image from a project's gallery. The other images keep their positions
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param projectID path string true "Project ID" format(uuid)
+// @Param imageID path string true "Image ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid projectID or imageID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Image not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting image"
+// @Router /project/{projectID}/image/{imageID} [delete]
+func (h projectImageHandler) deleteProjectImage() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		projectID, err := uuid.Parse(chi.URLParam(r, "projectID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid projectID"))
+			return
+		}
+		imageID, err := uuid.Parse(chi.URLParam(r, "imageID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid imageID"))
+			return
+		}
+
+		deleted, err := h.projectImageRepo.Delete(projectID, imageID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete project image", "project_image", err))
+			return
+		}
+		if !deleted {
+			h.responder.WriteError(w, errs.NewNotFoundError("image not found").WithCode(errs.EntityCode("project_image", errs.CodeSuffixNotFound)))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "image deleted successfully",
+		})
+	}
+}
+
+// findProject finds the project named by the projectID path parameter, writing the error response itself and
+// returning false when it's invalid or there's no such project
+func (h projectImageHandler) findProject(w http.ResponseWriter, r *http.Request) (*models.Project, bool) {
+	projectID, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid projectID"))
+		return nil, false
+	}
+
+	project, err := h.projectRepo.FindByID(projectID)
+	if err != nil {
+		h.responder.WriteError(w, wrapDatabaseError("find project", "project", err))
+		return nil, false
+	}
+	return project, true
+}
+
+// findProjectImage finds the image named by the projectID and imageID path parameters, writing the error response
+// itself and returning false when either is invalid or there's no such image
+func (h projectImageHandler) findProjectImage(w http.ResponseWriter, r *http.Request) (*models.ProjectImage, bool) {
+	projectID, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid projectID"))
+		return nil, false
+	}
+	imageID, err := uuid.Parse(chi.URLParam(r, "imageID"))
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid imageID"))
+		return nil, false
+	}
+
+	image, err := h.projectImageRepo.FindByID(projectID, imageID)
+	if err != nil {
+		h.responder.WriteError(w, wrapDatabaseError("find project image", "project_image", err))
+		return nil, false
+	}
+	return image, true
+}
+
+// decodeProjectImage reads and validates an image from the request body
+// It writes the error response itself and returns false when the body is invalid
+func (h projectImageHandler) decodeProjectImage(w http.ResponseWriter, r *http.Request) (*models.ProjectImage, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var image models.ProjectImage
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&image); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode project image request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	image.URL = strings.TrimSpace(image.URL)
+	image.AltText = strings.TrimSpace(image.AltText)
+
+	if err := validateRequest(&image); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+	return &image, true
+}
+
+// prepareProjectImages places the images a project is created with, which are already validated, without a
+// position in the order they were given
+func prepareProjectImages(images []models.ProjectImage) {
+	for i := range images {
+		images[i].ID = uuid.Nil
+		if images[i].Position == 0 {
+			images[i].Position = i + 1
+		}
+	}
+}
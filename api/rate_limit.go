@@ -0,0 +1,163 @@
+package api
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// rateLimiter allows each client IP at most limit requests per fixed window
+// Counts are kept in memory, so they reset on restart and are per instance
+type rateLimiter struct {
+	responder Responder
+	logger    zerolog.Logger
+	name      string
+	limit     int
+	window    time.Duration
+
+	mu        sync.Mutex
+	clients   map[string]*rateLimitWindow
+	lastSweep time.Time
+}
+
+type rateLimitWindow struct {
+	start time.Time
+	count int
+}
+
+func newRateLimiter(name string, limit int, window time.Duration) *rateLimiter {
+	logger := log.With().Str("handlerName", "rateLimiter").Str("limiter", name).Logger()
+	return &rateLimiter{
+		responder: NewResponder(logger),
+		logger:    logger,
+		name:      name,
+		limit:     limit,
+		window:    window,
+		clients:   make(map[string]*rateLimitWindow),
+		lastSweep: time.Now(),
+	}
+}
+
+// middleware rejects requests with 429 once the client has used up its allowance for the current window
+func (l *rateLimiter) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := clientIP(r)
+
+		allowed, retryAfter := l.allow(ip, time.Now())
+		if !allowed {
+			l.logger.Warn().Str("ip", ip).Str("path", r.URL.Path).Msg("Rate limit exceeded")
+			w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+			l.responder.WriteError(w, errs.NewRateLimitError(l.name, retryAfter))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allow records a request from key and reports whether it is within the limit
+// When it is not, it also returns how long until the client's window resets
+func (l *rateLimiter) allow(key string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Drop expired windows now and then so the map doesn't grow without bound
+	if now.Sub(l.lastSweep) > l.window {
+		for k, client := range l.clients {
+			if now.Sub(client.start) >= l.window {
+				delete(l.clients, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	client, ok := l.clients[key]
+	if !ok || now.Sub(client.start) >= l.window {
+		l.clients[key] = &rateLimitWindow{start: now, count: 1}
+		return true, 0
+	}
+
+	if client.count >= l.limit {
+		return false, client.start.Add(l.window).Sub(now)
+	}
+
+	client.count++
+	return true, 0
+}
+
+// trustedProxies are the reverse proxies whose X-Forwarded-For headers clientIP believes, set from TRUSTED_PROXIES
+var trustedProxies []*net.IPNet
+
+// parseTrustedProxies parses a comma-separated list of IP addresses and CIDR ranges, skipping invalid entries
+func parseTrustedProxies(value string) []*net.IPNet {
+	var proxies []*net.IPNet
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		cidr := entry
+		if !strings.Contains(cidr, "/") {
+			if ip := net.ParseIP(cidr); ip != nil && ip.To4() != nil {
+				cidr += "/32"
+			} else {
+				cidr += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			log.Warn().Str("entry", entry).Msg("Ignoring invalid TRUSTED_PROXIES entry")
+			continue
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies
+}
+
+// isTrustedProxy reports whether ip is one of trustedProxies
+func isTrustedProxy(ip net.IP) bool {
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the address of the client that made the request
+// X-Forwarded-For is only read when the peer is a trusted proxy, or a Unix socket, which only a proxy on the same
+// host can connect to. Entries are then read from the right, each added by the proxy before it, and the first one
+// that isn't a trusted proxy is the client; anything left of it is client-supplied
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	peer := net.ParseIP(host)
+	if peer != nil && !isTrustedProxy(peer) {
+		return host
+	}
+
+	client := host
+	if forwardedFor := r.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+		parts := strings.Split(forwardedFor, ",")
+		for i := len(parts) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(parts[i]))
+			if ip == nil {
+				break
+			}
+			client = ip.String()
+			if !isTrustedProxy(ip) {
+				break
+			}
+		}
+	}
+	return client
+}
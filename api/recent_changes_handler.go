@@ -0,0 +1,144 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type recentChangesHandler struct {
+	responder    Responder
+	logger       zerolog.Logger
+	blogPostRepo *database.BlogPostRepo
+	projectRepo  *database.ProjectRepo
+	noteRepo     *database.NoteRepo
+}
+
+func newRecentChangesHandler(blogPostRepo *database.BlogPostRepo, projectRepo *database.ProjectRepo, noteRepo *database.NoteRepo) recentChangesHandler {
+	logger := log.With().Str("handlerName", "recentChangesHandler").Logger()
+
+	return recentChangesHandler{
+		responder:    NewResponder(logger),
+		logger:       logger,
+		blogPostRepo: blogPostRepo,
+		projectRepo:  projectRepo,
+		noteRepo:     noteRepo,
+	}
+}
+
+// RecentChange represents one edited piece of content
+// Type says which of BlogPost, Project or Note is set; it uses the same values as TimelineItem
+type RecentChange struct {
+	Type       string           `json:"type" enums:"blog_post,project,note"`
+	ID         uuid.UUID        `json:"id"`
+	DateAdded  time.Time        `json:"dateAdded"`
+	DateEdited time.Time        `json:"dateEdited"`
+	BlogPost   *models.BlogPost `json:"blogPost,omitempty"`
+	Project    *models.Project  `json:"project,omitempty"`
+	Note       *models.Note     `json:"note,omitempty"`
+}
+
+// RecentChanges represents one page of the recently-updated feed
+type RecentChanges struct {
+	Items      []RecentChange `json:"items"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+}
+
+// getRecentChanges retrieves recently edited content
+// @Summary Get recent changes
+// @Description Retrieves blog posts, projects and notes that have been edited, most recently edited first. Content that was never edited is left out; see /timeline for new content. Pass nextCursor from the previous response as cursor to get the next page
+// @Tags Timeline
+// @Accept json
+// @Produce json
+// @Param cursor query string false "Cursor returned as nextCursor by the previous page"
+// @Param limit query int false "Items per page (max 50)" default(20)
+// @Success 200 {object} RecentChanges "Page of recently edited content"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid cursor or limit"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching recent changes"
+// @Router /recent-changes [get]
+func (h recentChangesHandler) getRecentChanges() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cursor, err := parseCursor(r)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		limit := defaultTimelineLimit
+		if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil || limit < 1 || limit > maxTimelineLimit {
+				h.responder.WriteError(w, errs.NewInvalidFieldError("limit", "must be between 1 and "+strconv.Itoa(maxTimelineLimit)))
+				return
+			}
+		}
+
+		// Fetch one extra change so we know whether another page exists
+		changes, err := h.collectChanges(cursor, limit+1)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find recent changes", "recent_changes", err))
+			return
+		}
+
+		response := RecentChanges{Items: changes}
+		if len(changes) > limit {
+			response.Items = changes[:limit]
+			last := response.Items[limit-1]
+			response.NextCursor = encodeCursor(database.Cursor{Time: last.DateEdited, ID: last.ID})
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// collectChanges fetches up to limit edited items from each source and merges them most recently edited first
+// The merge uses the same (date_edited DESC, id DESC) order the repos use so cursors stay consistent
+func (h recentChangesHandler) collectChanges(cursor *database.Cursor, limit int) ([]RecentChange, error) {
+	blogPosts, err := h.blogPostRepo.FindEditedBefore(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := h.projectRepo.FindEditedBefore(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := h.noteRepo.FindEditedBefore(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]RecentChange, 0, len(blogPosts)+len(projects)+len(notes))
+	for _, blogPost := range blogPosts {
+		changes = append(changes, RecentChange{Type: TimelineItemTypeBlogPost, ID: blogPost.ID, DateAdded: blogPost.DateAdded, DateEdited: *blogPost.DateEdited, BlogPost: blogPost})
+	}
+	for _, project := range projects {
+		changes = append(changes, RecentChange{Type: TimelineItemTypeProject, ID: project.ID, DateAdded: project.DateAdded, DateEdited: *project.DateEdited, Project: project})
+	}
+	for _, note := range notes {
+		changes = append(changes, RecentChange{Type: TimelineItemTypeNote, ID: note.ID, DateAdded: note.DateAdded, DateEdited: *note.DateEdited, Note: note})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if !changes[i].DateEdited.Equal(changes[j].DateEdited) {
+			return changes[i].DateEdited.After(changes[j].DateEdited)
+		}
+		return bytes.Compare(changes[i].ID[:], changes[j].ID[:]) > 0
+	})
+
+	if len(changes) > limit {
+		changes = changes[:limit]
+	}
+
+	return changes, nil
+}
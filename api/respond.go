@@ -6,12 +6,23 @@ import (
 	"errors"
 	"net/http"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/rpupo63/unified-personal-site-backend/errs"
 	"github.com/rs/zerolog"
 )
 
+// isProduction reports whether APP_ENV=production
+var isProduction = sync.OnceValue(func() bool {
+	return strings.EqualFold(os.Getenv("APP_ENV"), "production")
+})
+
+// redactErrors reports whether error responses hide internal details from clients, which production turns on
+// The full error is logged instead, and clients only get its code and a message that's safe to show
+var redactErrors = isProduction
+
 type Responder struct {
 	logger zerolog.Logger
 }
@@ -23,7 +34,7 @@ func NewResponder(logger zerolog.Logger) Responder {
 func (r Responder) WriteJSON(w http.ResponseWriter, data any) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 
-	// Marshal the data first to check size and handle errors
+	// Marshal the data first so an error can still change the status
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		r.logger.Error().Err(err).Msg("error marshaling response data")
@@ -31,33 +42,6 @@ func (r Responder) WriteJSON(w http.ResponseWriter, data any) {
 		return
 	}
 
-	// Check if response is too large (e.g., > 10MB)
-	const maxResponseSize = 10 * 1024 * 1024 // 10MB
-	if len(jsonData) > maxResponseSize {
-		r.logger.Error().
-			Int("responseSize", len(jsonData)).
-			Int("maxSize", maxResponseSize).
-			Msg("response too large, truncating")
-
-		// Return a truncated response with error info
-		truncatedResponse := map[string]interface{}{
-			"error":        "Response too large",
-			"message":      "The requested data exceeds the maximum response size",
-			"maxSizeMB":    maxResponseSize / (1024 * 1024),
-			"actualSizeMB": len(jsonData) / (1024 * 1024),
-		}
-
-		truncatedJSON, err := json.Marshal(truncatedResponse)
-		if err != nil {
-			r.logger.Error().Err(err).Msg("error marshaling truncated response")
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-		w.WriteHeader(http.StatusRequestEntityTooLarge)
-		w.Write(truncatedJSON)
-		return
-	}
-
 	// Write the response
 	if _, err := w.Write(jsonData); err != nil {
 		r.logger.Error().Err(err).Msg("error writing response")
@@ -103,19 +87,28 @@ func (r Responder) WriteError(w http.ResponseWriter, err error) {
 		// Send error notification for unexpected errors
 		r.SendErrorNotification(err.Error())
 		// Set status and write JSON without re-calling WriteHeader inside writeJSON
-		w.WriteHeader(http.StatusInternalServerError)
-		r.WriteJSON(w, map[string]interface{}{
+		response := map[string]interface{}{
 			"error":   "Internal Server Error",
+			"code":    errs.CodeInternal,
 			"message": "An unexpected error occurred",
-			"details": err.Error(), // Include actual error in development
 			"status":  "error",
-		})
+		}
+		if !redactErrors() {
+			response["details"] = err.Error() // Include actual error in development
+		}
+		errorMetrics.record(http.StatusInternalServerError, errs.CodeInternal)
+		w.WriteHeader(http.StatusInternalServerError)
+		r.WriteJSON(w, response)
 		return
 	}
 
+	// Messages vary with Accept-Language, see negotiateErrorLanguage
+	w.Header().Add("Vary", "Accept-Language")
+
 	// Build response based on error details
 	response := map[string]interface{}{
 		"error":  apiErr.Error(),
+		"code":   apiErr.Code,
 		"status": "error",
 	}
 
@@ -124,6 +117,11 @@ func (r Responder) WriteError(w http.ResponseWriter, err error) {
 		response["field"] = apiErr.Field
 	}
 
+	// List every failing field when validation reported several at once
+	if len(apiErr.Fields) > 0 {
+		response["fields"] = apiErr.Fields
+	}
+
 	// Add details if present
 	if apiErr.Details != "" {
 		response["details"] = apiErr.Details
@@ -134,16 +132,67 @@ func (r Responder) WriteError(w http.ResponseWriter, err error) {
 		response["cause"] = apiErr.GetFullError()
 	}
 
+	if redactErrors() {
+		r.redactError(response, apiErr)
+	}
+
+	// Translate the message when the client asked for another language; details and cause stay in English
+	if lang := errorLanguage(w); lang != "" {
+		if message, ok := localizedErrorMessage(lang, apiErr.Code); ok {
+			response["error"] = message
+			w.Header().Set("Content-Language", lang)
+		}
+	}
+
 	// For expected errors, set the status code from apiErr
+	errorMetrics.record(apiErr.StatusCode, apiErr.Code)
 	w.WriteHeader(apiErr.StatusCode)
 	r.WriteJSON(w, response)
 }
 
+// redactError strips what a client shouldn't see from the response for apiErr, after logging it in full
+// Cause chains are never sent. Server errors also lose their message, details and field, since those describe
+// internals rather than the request; client errors keep them so callers can fix their input
+func (r Responder) redactError(response map[string]interface{}, apiErr *errs.ApiErr) {
+	serverError := apiErr.StatusCode >= http.StatusInternalServerError
+	if !serverError && apiErr.Cause == nil {
+		return
+	}
+
+	event := r.logger.Warn()
+	if serverError {
+		event = r.logger.Error()
+	}
+	event.Int("status", apiErr.StatusCode).Str("code", apiErr.Code).Msg(apiErr.GetFullError())
+
+	delete(response, "cause")
+	if !serverError {
+		return
+	}
+	delete(response, "details")
+	delete(response, "field")
+	delete(response, "fields")
+	response["error"] = safeErrorMessage(apiErr.StatusCode)
+}
+
+// safeErrorMessage is the message sent for a server error in place of its own
+func safeErrorMessage(status int) string {
+	switch status {
+	case http.StatusServiceUnavailable:
+		return "The service is temporarily unavailable"
+	case http.StatusGatewayTimeout:
+		return "The request took too long to process"
+	}
+	return "An unexpected error occurred"
+}
+
 // writeTimeoutError writes a standardized timeout error response
 func (r Responder) WriteTimeoutError(w http.ResponseWriter, timeout time.Duration, endpoint string) {
+	errorMetrics.record(http.StatusRequestTimeout, errs.CodeRequestTimeout)
 	w.WriteHeader(http.StatusRequestTimeout)
 	r.WriteJSON(w, map[string]interface{}{
 		"error":           "Request timeout",
+		"code":            errs.CodeRequestTimeout,
 		"message":         "The request took too long to process",
 		"timeout_seconds": int(timeout.Seconds()),
 		"status":          "timeout",
@@ -153,9 +202,11 @@ func (r Responder) WriteTimeoutError(w http.ResponseWriter, timeout time.Duratio
 
 // writeValidationError writes a standardized validation error response
 func (r Responder) WriteValidationError(w http.ResponseWriter, field string, message string) {
+	errorMetrics.record(http.StatusBadRequest, errs.CodeInvalidField)
 	w.WriteHeader(http.StatusBadRequest)
 	r.WriteJSON(w, map[string]interface{}{
 		"error":   "Validation error",
+		"code":    errs.CodeInvalidField,
 		"message": message,
 		"field":   field,
 		"status":  "validation_error",
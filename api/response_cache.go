@@ -0,0 +1,188 @@
+package api
+
+import (
+	"container/list"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// defaultResponseCacheSize is how many responses are kept; the least recently used is dropped first
+	defaultResponseCacheSize = 256
+	// defaultResponseCacheTTL bounds how stale a response can get when another instance writes to the database,
+	// since only this instance's writes invalidate its cache
+	defaultResponseCacheTTL = time.Minute
+)
+
+// responseCache keeps the responses of hot public GETs in memory, so a traffic spike doesn't become a database
+// query per request. Responses are keyed by URL and Accept header, and a write to any table a route reads drops that
+// route's responses. Only 200s are cached
+type responseCache struct {
+	logger  zerolog.Logger
+	maxSize int
+	ttl     time.Duration
+
+	mu      sync.Mutex
+	order   *list.List // Most recently used first
+	entries map[string]*list.Element
+	// generations counts the writes to each table, so a response computed while one of its tables was written to
+	// isn't stored
+	generations map[string]uint64
+}
+
+type cachedResponse struct {
+	key      string
+	tables   []string
+	status   int
+	header   http.Header
+	body     []byte
+	storedAt time.Time
+}
+
+// newResponseCache keeps up to maxSize responses for at most ttl; a maxSize of 0 turns caching off
+func newResponseCache(maxSize int, ttl time.Duration) *responseCache {
+	logger := log.With().Str("handlerName", "responseCache").Logger()
+	return &responseCache{
+		logger:      logger,
+		maxSize:     maxSize,
+		ttl:         ttl,
+		order:       list.New(),
+		entries:     make(map[string]*list.Element),
+		generations: make(map[string]uint64),
+	}
+}
+
+// cached serves a route's GETs from the cache; tables are the ones the route reads, and writes to them invalidate it
+func (c *responseCache) cached(tables ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if c.maxSize <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			key := responseCacheKey(r)
+			if entry, ok := c.get(key, time.Now()); ok {
+				for name, values := range entry.header {
+					w.Header()[name] = values
+				}
+				w.Header().Set("X-Cache", "HIT")
+				// Feeds carry an ETag, which readers that already have the response send back
+				if etag := entry.header.Get("ETag"); etag != "" && r.Header.Get("If-None-Match") == etag {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				w.WriteHeader(entry.status)
+				if _, err := w.Write(entry.body); err != nil {
+					c.logger.Error().Err(err).Msg("Failed to write cached response")
+				}
+				return
+			}
+
+			generation := c.generationOf(tables)
+			w.Header().Set("X-Cache", "MISS")
+			recorder := &idempotencyRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(recorder, r)
+			if recorder.status != http.StatusOK {
+				return
+			}
+			header := recorder.Header().Clone()
+			header.Del("X-Cache")
+			c.put(&cachedResponse{
+				key:      key,
+				tables:   tables,
+				status:   recorder.status,
+				header:   header,
+				body:     slices.Clone(recorder.body.Bytes()),
+				storedAt: time.Now(),
+			}, generation)
+		})
+	}
+}
+
+// responseCacheKey identifies a response by everything the cached routes vary on: scheme and host (feeds link to
+// themselves), path, query, Accept (JSON:API is negotiated) and the content locale negotiated from Accept-Language.
+// Query parameters are sorted so their order doesn't matter
+func responseCacheKey(r *http.Request) string {
+	return requestScheme(r) + "://" + r.Host + r.URL.Path + "?" + r.URL.Query().Encode() + "\n" + r.Header.Get("Accept") + "\n" + ctxLocale(r.Context())
+}
+
+func (c *responseCache) get(key string, now time.Time) (*cachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*cachedResponse)
+	if now.Sub(entry.storedAt) > c.ttl {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return entry, true
+}
+
+// generationOf sums the write counts of tables, which changes whenever any of them is written to
+func (c *responseCache) generationOf(tables []string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.sumGenerations(tables)
+}
+
+func (c *responseCache) sumGenerations(tables []string) uint64 {
+	var sum uint64
+	for _, table := range tables {
+		sum += c.generations[table]
+	}
+	return sum
+}
+
+// put stores entry unless its tables were written to since generation, evicting the least recently used response
+// when full
+func (c *responseCache) put(entry *cachedResponse, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.sumGenerations(entry.tables) != generation {
+		return
+	}
+	if element, ok := c.entries[entry.key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[entry.key] = c.order.PushFront(entry)
+	for c.order.Len() > c.maxSize {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedResponse).key)
+	}
+}
+
+// invalidate drops every response of a route that reads table; it's registered as a database write hook
+func (c *responseCache) invalidate(table string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[table]++
+	for element := c.order.Front(); element != nil; {
+		next := element.Next()
+		entry := element.Value.(*cachedResponse)
+		if slices.Contains(entry.tables, table) {
+			c.order.Remove(element)
+			delete(c.entries, entry.key)
+		}
+		element = next
+	}
+}
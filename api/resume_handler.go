@@ -0,0 +1,124 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type resumeHandler struct {
+	responder          Responder
+	logger             zerolog.Logger
+	workExperienceRepo *database.WorkExperienceRepo
+	educationRepo      *database.EducationRepo
+	certificationRepo  *database.CertificationRepo
+	resumeName         string
+}
+
+func newResumeHandler(workExperienceRepo *database.WorkExperienceRepo, educationRepo *database.EducationRepo, certificationRepo *database.CertificationRepo, resumeName string) resumeHandler {
+	logger := log.With().Str("handlerName", "resumeHandler").Logger()
+
+	return resumeHandler{
+		responder:          NewResponder(logger),
+		logger:             logger,
+		workExperienceRepo: workExperienceRepo,
+		educationRepo:      educationRepo,
+		certificationRepo:  certificationRepo,
+		resumeName:         resumeName,
+	}
+}
+
+// Resume represents the structured CV data composed from work experience, education and certifications
+type Resume struct {
+	Name           string                  `json:"name,omitempty"`
+	WorkExperience []models.WorkExperience `json:"workExperience"`
+	Education      []models.Education      `json:"education"`
+	Certifications []models.Certification  `json:"certifications"`
+}
+
+// getResume retrieves the composed resume
+// @Summary Get resume
+// @Description Retrieves structured CV data (work experience, education and unexpired certifications). Pass format=pdf to receive a rendered PDF instead of JSON
+// @Tags Resume
+// @Accept json
+// @Produce json
+// @Produce application/pdf
+// @Param format query string false "Response format" Enums(json, pdf)
+// @Success 200 {object} Resume "Structured resume data"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid format"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching resume"
+// @Router /resume [get]
+func (h resumeHandler) getResume() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		format := r.URL.Query().Get("format")
+		if format != "" && format != "json" && format != "pdf" {
+			h.responder.WriteError(w, errs.NewBadRequestError("format must be one of: json, pdf"))
+			return
+		}
+
+		workExperiences, err := h.workExperienceRepo.FindAll()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find work experience", "work_experiences", err))
+			return
+		}
+
+		educations, err := h.educationRepo.FindAll()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find education", "educations", err))
+			return
+		}
+
+		// Expired certifications are left off the resume
+		certifications, err := h.certificationRepo.FindValidAt(time.Now())
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find certifications", "certifications", err))
+			return
+		}
+
+		resume := Resume{
+			Name:           h.resumeName,
+			WorkExperience: make([]models.WorkExperience, 0, len(workExperiences)),
+			Education:      make([]models.Education, 0, len(educations)),
+			Certifications: make([]models.Certification, 0, len(certifications)),
+		}
+		for _, workExperience := range workExperiences {
+			resume.WorkExperience = append(resume.WorkExperience, *workExperience)
+		}
+		for _, education := range educations {
+			resume.Education = append(resume.Education, *education)
+		}
+		for _, certification := range certifications {
+			resume.Certifications = append(resume.Certifications, *certification)
+		}
+
+		if format == "pdf" {
+			// Render into a buffer first so a rendering failure can still produce a JSON error
+			var buf bytes.Buffer
+			if err := services.RenderResumePDF(services.ResumeContent{
+				Name:           resume.Name,
+				WorkExperience: resume.WorkExperience,
+				Education:      resume.Education,
+				Certifications: resume.Certifications,
+			}, &buf); err != nil {
+				h.responder.WriteError(w, errs.NewInternalErrorWithCause("failed to render resume", err))
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/pdf")
+			w.Header().Set("Content-Disposition", `inline; filename="resume.pdf"`)
+			if _, err := w.Write(buf.Bytes()); err != nil {
+				h.logger.Error().Err(err).Msg("error writing resume PDF")
+			}
+			return
+		}
+
+		h.responder.WriteJSON(w, resume)
+	}
+}
@@ -1,28 +1,318 @@
 package api
 
 import (
+	"time"
+
 	"github.com/go-chi/chi/v5"
 )
 
+// crossPostTimeout is how long creating a blog post or note may take, since it waits on the social platforms, each of
+// which gets up to PLATFORM_POST_TIMEOUT
+const crossPostTimeout = 3 * time.Minute
+
+// exportTimeout is how long a streamed export may take to send, well past the server's write timeout
+const exportTimeout = 10 * time.Minute
+
+// doctorTimeout is how long a consistency check may take, most of it spent requesting every stored image URL
+const doctorTimeout = 5 * time.Minute
+
+// mediaUploadTimeout is how long an upload to POST /media may take, long enough for a large file on a slow link
+const mediaUploadTimeout = 5 * time.Minute
+
+// benchTimeout is how long a benchmark run may take, enough for the largest one to finish against a remote database
+const benchTimeout = 10 * time.Minute
+
 // setupFrontendRoutes sets up all routes with authentication
 func setupFrontendRoutes(r chi.Router, handlers *routeHandlers, authMiddleware authMiddleware) {
 	// Authenticated routes
 	r.Group(func(r chi.Router) {
 		//r.Use(authMiddleware.authenticate)
 		r.Use(ColoredHTTPLoggingMiddleware)
+		r.Use(authMiddleware.identifyAdmin)
+		// After identifyAdmin, which decides how large a body it buffers
+		r.Use(handlers.idempotency.middleware)
+
+		// Public lists are served from the response cache until a write to the tables they read
+		cacheProjects := handlers.responseCache.cached("projects", "project_tags", "project_images")
+		cacheBlogPosts := handlers.responseCache.cached("blog_posts", "blog_tags", "blog_post_translations", "blog_post_images")
+		// Blog post routes serve translations in the locale negotiated here, which cached responses are keyed by
+		localized := handlers.contentLocales.negotiate
 
 		// Project Handler endpoints
-		r.Get("/projects", handlers.projectHandler.getAllProjects())
+		r.With(cacheProjects).Get("/projects", handlers.projectHandler.getAllProjects())
+		r.Get("/projects/search", handlers.projectHandler.searchProjects())
+		r.With(cacheProjects).Get("/projects/summaries", handlers.projectHandler.getProjectSummaries())
 		r.Get("/project/{projectID}", handlers.projectHandler.getProject())
+		r.With(cacheProjects).Get("/project/{slug}/case-study", handlers.projectHandler.getProjectCaseStudy())
 		r.Post("/project", handlers.projectHandler.createProject())
 		r.Put("/project/{projectID}", handlers.projectHandler.updateProject())
 		r.Delete("/project/{projectID}", handlers.projectHandler.deleteProject())
+		r.With(authMiddleware.requireAdmin).Delete("/projects", handlers.projectHandler.deleteProjects())
+		r.With(authMiddleware.requireAdmin).Get("/projects/trash", handlers.projectHandler.getTrashedProjects())
+		r.Post("/project/{projectID}/restore", handlers.projectHandler.restoreProject())
+		r.Get("/project/{projectID}/images", handlers.projectImageHandler.getProjectImages())
+		r.Post("/project/{projectID}/images", handlers.projectImageHandler.createProjectImage())
+		r.Put("/project/{projectID}/image/{imageID}", handlers.projectImageHandler.updateProjectImage())
+		r.Delete("/project/{projectID}/image/{imageID}", handlers.projectImageHandler.deleteProjectImage())
+		r.With(authMiddleware.requireAdmin).Post("/projects/import-github", handlers.projectHandler.importGitHubProject())
+
+		// Tag Handler endpoints; tags span blog posts and projects
+		r.With(authMiddleware.requireAdmin).Get("/tags", handlers.tagHandler.getTags())
+		r.With(authMiddleware.requireAdmin).Put("/tag/{id}", handlers.tagHandler.renameTag())
+		r.With(authMiddleware.requireAdmin).Delete("/tag/{id}", handlers.tagHandler.deleteTag())
+		r.With(authMiddleware.requireAdmin).Post("/tags/merge", handlers.tagHandler.mergeTags())
 
 		// Blog Post Handler endpoints
-		r.Get("/blog-posts", handlers.blogPostHandler.getAllBlogPosts())
-		r.Get("/blog-post/{blogPostID}", handlers.blogPostHandler.getBlogPost())
-		r.Post("/blog-post", handlers.blogPostHandler.createBlogPost())
+		r.With(localized, cacheBlogPosts).Get("/blog-posts", handlers.blogPostHandler.getAllBlogPosts())
+		r.Get("/blog-posts/search", handlers.blogPostHandler.searchBlogPosts())
+		r.Get("/search", handlers.searchHandler.search())
+		r.With(localized, cacheBlogPosts).Get("/blog-posts/summaries", handlers.blogPostHandler.getBlogPostSummaries())
+		r.Get("/blog-posts/archive", handlers.blogPostHandler.getBlogPostArchive())
+		r.With(localized).Get("/blog-post/{blogPostID}", handlers.blogPostHandler.getBlogPost())
+		r.With(localized).Get("/blog-post/{blogPostID}/meta", handlers.blogPostMetaHandler.getBlogPostMeta())
+		r.Get("/blog-post/{blogPostID}/export.md", handlers.blogPostHandler.exportBlogPost())
+		r.Get("/blog-post/{blogPostID}/images", handlers.blogPostImageHandler.getBlogPostImages())
+		r.Post("/blog-post/{blogPostID}/images", handlers.blogPostImageHandler.createBlogPostImage())
+		r.Put("/blog-post/{blogPostID}/image/{imageID}", handlers.blogPostImageHandler.updateBlogPostImage())
+		r.Delete("/blog-post/{blogPostID}/image/{imageID}", handlers.blogPostImageHandler.deleteBlogPostImage())
+		r.With(authMiddleware.requireAdmin).Post("/blog-post/{blogPostID}/preview-link", handlers.blogPostHandler.createPreviewLink())
+		r.With(authMiddleware.requireAdmin).Post("/blog-post/{blogPostID}/preview-token", handlers.blogPostHandler.createPreviewToken())
+		r.Get("/preview/{token}", handlers.blogPostHandler.getPreview())
+		r.Get("/blog-post/{blogPostID}/translations", handlers.blogPostHandler.getBlogPostTranslations())
+		r.Put("/blog-post/{blogPostID}/translation/{locale}", handlers.blogPostHandler.putBlogPostTranslation())
+		r.Delete("/blog-post/{blogPostID}/translation/{locale}", handlers.blogPostHandler.deleteBlogPostTranslation())
+		r.With(withRequestDeadline(crossPostTimeout)).Post("/blog-post", handlers.blogPostHandler.createBlogPost())
 		r.Put("/blog-post/{blogPostID}", handlers.blogPostHandler.updateBlogPost())
 		r.Delete("/blog-post/{blogPostID}", handlers.blogPostHandler.deleteBlogPost())
+		r.With(authMiddleware.requireAdmin).Delete("/blog-posts", handlers.blogPostHandler.deleteBlogPosts())
+		r.With(authMiddleware.requireAdmin).Get("/blog-posts/trash", handlers.blogPostHandler.getTrashedBlogPosts())
+		r.Post("/blog-post/{blogPostID}/restore", handlers.blogPostHandler.restoreBlogPost())
+		r.With(authMiddleware.requireAdmin).Post("/blog-post/{blogPostID}/duplicate", handlers.blogPostHandler.duplicateBlogPost())
+
+		// Blog Post View Handler endpoints
+		blogPostViewLimiter := newRateLimiter("blogPostView", 60, time.Minute)
+		r.With(blogPostViewLimiter.middleware).Post("/blog-post/{blogPostID}/view", handlers.blogPostViewHandler.recordBlogPostView())
+		r.With(localized).Get("/blog-posts/popular", handlers.blogPostViewHandler.getPopularBlogPosts())
+
+		// Series Handler endpoints
+		cacheSeries := handlers.responseCache.cached("series", "series_posts", "blog_posts", "blog_tags", "blog_post_translations")
+		r.Get("/series", handlers.seriesHandler.getAllSeries())
+		r.With(localized, cacheSeries).Get("/series/{slug}", handlers.seriesHandler.getSeries())
+		r.Post("/series", handlers.seriesHandler.createSeries())
+		r.Put("/series/{slug}", handlers.seriesHandler.updateSeries())
+		r.Delete("/series/{slug}", handlers.seriesHandler.deleteSeries())
+		r.Put("/series/{slug}/post/{blogPostID}", handlers.seriesHandler.attachBlogPost())
+		r.Delete("/series/{slug}/post/{blogPostID}", handlers.seriesHandler.detachBlogPost())
+
+		// Resume Handler endpoints
+		r.Get("/resume", handlers.resumeHandler.getResume())
+
+		// Work Experience Handler endpoints
+		r.Get("/work-experience", handlers.workExperienceHandler.getAllWorkExperience())
+		r.Get("/work-experience/{workExperienceID}", handlers.workExperienceHandler.getWorkExperience())
+		r.Post("/work-experience", handlers.workExperienceHandler.createWorkExperience())
+		r.Put("/work-experience/{workExperienceID}", handlers.workExperienceHandler.updateWorkExperience())
+		r.Delete("/work-experience/{workExperienceID}", handlers.workExperienceHandler.deleteWorkExperience())
+
+		// Education Handler endpoints
+		r.Get("/education", handlers.educationHandler.getAllEducation())
+		r.Get("/education/{educationID}", handlers.educationHandler.getEducation())
+		r.Post("/education", handlers.educationHandler.createEducation())
+		r.Put("/education/{educationID}", handlers.educationHandler.updateEducation())
+		r.Delete("/education/{educationID}", handlers.educationHandler.deleteEducation())
+
+		// Certification Handler endpoints
+		r.Get("/certifications", handlers.certificationHandler.getAllCertifications())
+		r.Get("/certification/{certificationID}", handlers.certificationHandler.getCertification())
+		r.Post("/certification", handlers.certificationHandler.createCertification())
+		r.Put("/certification/{certificationID}", handlers.certificationHandler.updateCertification())
+		r.Delete("/certification/{certificationID}", handlers.certificationHandler.deleteCertification())
+
+		// Skill Handler endpoints
+		r.Get("/skills", handlers.skillHandler.getAllSkills())
+		r.Get("/skill/{skillID}", handlers.skillHandler.getSkill())
+		r.Post("/skill", handlers.skillHandler.createSkill())
+		r.Put("/skill/{skillID}", handlers.skillHandler.updateSkill())
+		r.Delete("/skill/{skillID}", handlers.skillHandler.deleteSkill())
+
+		// Testimonial Handler endpoints
+		r.Get("/testimonials", handlers.testimonialHandler.getApprovedTestimonials())
+		r.Post("/testimonial", handlers.testimonialHandler.submitTestimonial())
+
+		// Uses Item Handler endpoints
+		r.Get("/uses", handlers.usesItemHandler.getUsesPage())
+		r.Get("/uses-item/{usesItemID}", handlers.usesItemHandler.getUsesItem())
+		r.Post("/uses-item", handlers.usesItemHandler.createUsesItem())
+		r.Put("/uses-item/{usesItemID}", handlers.usesItemHandler.updateUsesItem())
+		r.Delete("/uses-item/{usesItemID}", handlers.usesItemHandler.deleteUsesItem())
+
+		// Snippet Handler endpoints
+		r.Get("/snippets", handlers.snippetHandler.getSnippets())
+		r.Get("/snippet/{snippetID}", handlers.snippetHandler.getSnippet())
+		r.Post("/snippet", handlers.snippetHandler.createSnippet())
+		r.Put("/snippet/{snippetID}", handlers.snippetHandler.updateSnippet())
+		r.Delete("/snippet/{snippetID}", handlers.snippetHandler.deleteSnippet())
+
+		// Bookmark Handler endpoints
+		r.Get("/bookmarks", handlers.bookmarkHandler.getBookmarks())
+		r.Get("/bookmark/{bookmarkID}", handlers.bookmarkHandler.getBookmark())
+		r.Post("/bookmark", handlers.bookmarkHandler.createBookmark())
+		r.Put("/bookmark/{bookmarkID}", handlers.bookmarkHandler.updateBookmark())
+		r.Delete("/bookmark/{bookmarkID}", handlers.bookmarkHandler.deleteBookmark())
+
+		// Note Handler endpoints
+		r.Get("/notes", handlers.noteHandler.getNotes())
+		r.Get("/note/{noteID}", handlers.noteHandler.getNote())
+		r.With(withRequestDeadline(crossPostTimeout)).Post("/note", handlers.noteHandler.createNote())
+		r.Put("/note/{noteID}", handlers.noteHandler.updateNote())
+		r.Delete("/note/{noteID}", handlers.noteHandler.deleteNote())
+
+		// Feed Handler endpoints
+		r.With(localized, cacheBlogPosts).Get("/feed.json", handlers.feedHandler.getJSONFeed())
+		r.With(localized, cacheBlogPosts).Get("/feed.xml", handlers.feedHandler.getRSSFeed())
+		r.With(localized, cacheBlogPosts).Get("/feed.atom", handlers.feedHandler.getAtomFeed())
+		r.With(localized, cacheBlogPosts).Get("/feed", handlers.feedHandler.getFeed())
+		r.With(cacheBlogPosts).Get("/sitemap.xml", handlers.sitemapHandler.getSitemap())
+
+		// Media Handler endpoints
+		r.Get("/media/{mediaID}", handlers.mediaHandler.getMedia())
+		r.With(authMiddleware.requireAdmin, withRequestDeadline(mediaUploadTimeout)).Post("/media", handlers.mediaHandler.uploadMedia())
+		r.With(authMiddleware.requireAdmin).Get("/media", handlers.mediaHandler.getMediaObjects())
+		r.With(authMiddleware.requireAdmin).Delete("/media/{mediaID}", handlers.mediaHandler.deleteMediaObject())
+
+		// Micropub Handler endpoints, authenticated with IndieAuth access tokens rather than the backend password
+		r.Get("/micropub", handlers.micropubHandler.query())
+		r.With(withRequestDeadline(crossPostTimeout)).Post("/micropub", handlers.micropubHandler.post())
+		r.Post("/micropub/media", handlers.micropubHandler.uploadMedia())
+
+		// Timeline Handler endpoints
+		r.Get("/timeline", handlers.timelineHandler.getTimeline())
+		r.Get("/recent-changes", handlers.recentChangesHandler.getRecentChanges())
+
+		// Trending Handler endpoints
+		r.Get("/trending", handlers.trendingHandler.getTrending())
+
+		// Analytics Handler endpoints
+		pageViewLimiter := newRateLimiter("pageview", 60, time.Minute)
+		r.With(pageViewLimiter.middleware).Post("/analytics/pageview", handlers.analyticsHandler.recordPageView())
+
+		// Share Link Handler endpoints
+		r.Get("/r/{token}", handlers.shareLinkHandler.followShareLink())
+
+		// Short Link Handler endpoints
+		r.Get("/s/{code}", handlers.shortLinkHandler.followShortLink())
+
+		// Guestbook Handler endpoints
+		guestbookLimiter := newRateLimiter("guestbook", 3, 10*time.Minute)
+		r.Get("/guestbook", handlers.guestbookHandler.getApprovedEntries())
+		r.With(guestbookLimiter.middleware).Post("/guestbook-entry", handlers.guestbookHandler.submitEntry())
+
+		// Book Handler endpoints
+		r.Get("/reading-list", handlers.bookHandler.getReadingList())
+		r.Get("/books", handlers.bookHandler.getAllBooks())
+		r.Get("/book/{bookID}", handlers.bookHandler.getBook())
+		r.Post("/book", handlers.bookHandler.createBook())
+		r.Put("/book/{bookID}", handlers.bookHandler.updateBook())
+		r.Delete("/book/{bookID}", handlers.bookHandler.deleteBook())
+
+		// FAQ Handler endpoints
+		r.Get("/faqs", handlers.faqHandler.getPublishedFAQs())
+		r.Get("/faq/{faqID}", handlers.faqHandler.getFAQ())
+		r.Post("/faq", handlers.faqHandler.createFAQ())
+		r.Put("/faq/{faqID}", handlers.faqHandler.updateFAQ())
+		r.Delete("/faq/{faqID}", handlers.faqHandler.deleteFAQ())
+
+		// Batch Handler endpoints
+		r.Post("/batch", handlers.batchHandler.runBatch())
+
+		// Import Handler endpoints
+		r.With(authMiddleware.requireAdmin).Post("/import/medium", handlers.importHandler.importMedium())
+		r.With(authMiddleware.requireAdmin).Post("/import/substack", handlers.importHandler.importSubstack())
+		r.With(authMiddleware.requireAdmin).Post("/import/wordpress", handlers.importHandler.importWordPress())
+		r.With(authMiddleware.requireAdmin).Post("/import/markdown", handlers.importHandler.importMarkdown())
+		r.With(authMiddleware.requireAdmin).Post("/blog-posts/import", handlers.importHandler.importBlogPosts())
+
+		// Export Handler endpoints
+		r.With(authMiddleware.requireAdmin, withRequestDeadline(exportTimeout)).Get("/export", handlers.exportHandler.exportContent())
+
+		// Schema Handler endpoints
+		r.Get("/schema/{entity}/example", handlers.schemaHandler.getExample())
+	})
+}
+
+// setupAdminRoutes sets up moderation and management routes that require the backend password
+func setupAdminRoutes(r chi.Router, handlers *routeHandlers, authMiddleware authMiddleware) {
+	r.Route("/admin", func(r chi.Router) {
+		r.Use(ColoredHTTPLoggingMiddleware)
+		r.Use(authMiddleware.requireAdmin)
+		r.Use(handlers.idempotency.middleware)
+
+		// Testimonial moderation endpoints
+		r.Get("/testimonials", handlers.testimonialHandler.getAllTestimonials())
+		r.Post("/testimonial/{testimonialID}/approve", handlers.testimonialHandler.approveTestimonial())
+		r.Post("/testimonial/{testimonialID}/reject", handlers.testimonialHandler.rejectTestimonial())
+		r.Delete("/testimonial/{testimonialID}", handlers.testimonialHandler.deleteTestimonial())
+
+		// Guestbook moderation endpoints
+		r.Get("/guestbook", handlers.guestbookHandler.getAllEntries())
+		r.Post("/guestbook-entry/{entryID}/approve", handlers.guestbookHandler.approveEntry())
+		r.Post("/guestbook-entry/{entryID}/reject", handlers.guestbookHandler.rejectEntry())
+		r.Delete("/guestbook-entry/{entryID}", handlers.guestbookHandler.deleteEntry())
+
+		// Certification reports
+		r.Get("/certifications/expiring", handlers.certificationHandler.getExpiringCertifications())
+
+		// FAQ management endpoints
+		r.Get("/faqs", handlers.faqHandler.getAllFAQs())
+
+		// Webhook management endpoints
+		r.Get("/webhooks", handlers.webhookHandler.getWebhooks())
+		r.Get("/webhook/{webhookID}", handlers.webhookHandler.getWebhook())
+		r.Post("/webhook", handlers.webhookHandler.createWebhook())
+		r.Put("/webhook/{webhookID}", handlers.webhookHandler.updateWebhook())
+		r.Delete("/webhook/{webhookID}", handlers.webhookHandler.deleteWebhook())
+		r.Get("/webhook/{webhookID}/deliveries", handlers.webhookHandler.getWebhookDeliveries())
+		r.Post("/webhook-delivery/{deliveryID}/retry", handlers.webhookHandler.retryWebhookDelivery())
+
+		// Analytics reports
+		r.Get("/analytics/overview", handlers.analyticsHandler.getOverview())
+		r.Get("/analytics/referrers", handlers.analyticsHandler.getReferrers())
+		r.Get("/analytics/geo", handlers.analyticsHandler.getGeo())
+
+		// Social Post Handler endpoints
+		r.Get("/social-posts", handlers.socialPostHandler.getSocialPosts())
+		r.Get("/social/health", handlers.socialPostHandler.getCredentialHealth())
+
+		// Short link management endpoints
+		r.Get("/short-links", handlers.shortLinkHandler.getShortLinks())
+		r.Post("/short-link", handlers.shortLinkHandler.createShortLink())
+		r.Delete("/short-link/{shortLinkID}", handlers.shortLinkHandler.deleteShortLink())
+
+		// Unpublished blog posts
+		r.Get("/blog-posts/unpublished", handlers.blogPostHandler.getUnpublishedBlogPosts())
+
+		// Exports
+		r.With(withRequestDeadline(exportTimeout)).Get("/export/blog-posts", handlers.blogPostHandler.exportBlogPosts())
+
+		// Query plan and index audit
+		r.Get("/indexes", handlers.indexAuditHandler.getIndexAudit())
+
+		// Differences between the database's tables and the models
+		r.Get("/schema/drift", handlers.schemaDriftHandler.getSchemaDrift())
+
+		// Data consistency checks
+		r.With(withRequestDeadline(doctorTimeout)).Get("/doctor", handlers.doctorHandler.getDoctorReport())
+		r.With(withRequestDeadline(doctorTimeout)).Post("/doctor/fix", handlers.doctorHandler.fixDoctorIssues())
+
+		// Scheduled background jobs
+		r.Get("/jobs", handlers.schedulerHandler.getJobs())
+
+		// Error response counts
+		r.Get("/metrics", handlers.metricsHandler.getMetrics())
+
+		// Benchmarks write seed content and load the database, so they're left out of production
+		if !isProduction() {
+			r.With(withRequestDeadline(benchTimeout)).Post("/bench", handlers.benchHandler.runBench())
+		}
 	})
 }
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Duplicating copies drafts and returns the copy, so it must never reach the handler without the backend password
+func TestDuplicateBlogPostRequiresAdmin(t *testing.T) {
+	router := chi.NewRouter()
+	handlers := &routeHandlers{responseCache: newResponseCache(0, 0), contentLocales: &contentLocales{}}
+	setupFrontendRoutes(router, handlers, newAuthMiddleware("secret"))
+
+	request := httptest.NewRequest(http.MethodPost, "/blog-post/3f2b1c4e-8a6d-4f0e-9b7a-2c5d8e1f4a3b/duplicate", nil)
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, request)
+
+	if response.Code != http.StatusUnauthorized {
+		t.Fatalf("unauthenticated duplicate returned %d, want %d", response.Code, http.StatusUnauthorized)
+	}
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// scheduledPublishInterval is how often scheduled blog posts are checked, so a post goes live at most this late
+const scheduledPublishInterval = time.Minute
+
+// scheduledPublisher publishes scheduled blog posts once their publish time has passed
+// Publishing emits post.published like creating a published post does. It doesn't cross-post to social platforms,
+// since which platforms to use is only known at creation time
+type scheduledPublisher struct {
+	logger       zerolog.Logger
+	blogPostRepo *database.BlogPostRepo
+	webhooks     *webhookDispatcher
+}
+
+func newScheduledPublisher(blogPostRepo *database.BlogPostRepo, webhooks *webhookDispatcher) *scheduledPublisher {
+	logger := log.With().Str("handlerName", "scheduledPublisher").Logger()
+	return &scheduledPublisher{
+		logger:       logger,
+		blogPostRepo: blogPostRepo,
+		webhooks:     webhooks,
+	}
+}
+
+// publishDue publishes every scheduled blog post that is due at now
+func (p *scheduledPublisher) publishDue(ctx context.Context, now time.Time) error {
+	blogPosts, err := p.blogPostRepo.PublishDue(now)
+	if err != nil {
+		return fmt.Errorf("failed to publish scheduled blog posts: %w", err)
+	}
+
+	for _, blogPost := range blogPosts {
+		p.logger.Info().Str("blogPostID", blogPost.ID.String()).Str("title", blogPost.Title).Msg("Published scheduled blog post")
+		p.webhooks.emit(models.WebhookEventPostPublished, BlogPostWithTags{BlogPost: *blogPost, Tags: blogPost.Tags})
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package api
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/config"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// scheduledTask is the work one scheduled job does on each run
+type scheduledTask func(ctx context.Context, now time.Time) error
+
+// scheduledJob is a recurring task and the outcome of its last run
+type scheduledJob struct {
+	name     string
+	interval time.Duration
+	jitter   time.Duration
+	enabled  bool
+	task     scheduledTask
+
+	mu     sync.Mutex
+	status ScheduledJobStatus
+}
+
+// ScheduledJobStatus is the state of one scheduled job as reported to the admin
+type ScheduledJobStatus struct {
+	Name           string     `json:"name" example:"publishScheduledPosts"`
+	Enabled        bool       `json:"enabled"`
+	Interval       string     `json:"interval" example:"1m0s"`
+	Jitter         string     `json:"jitter" example:"5s"`
+	Running        bool       `json:"running"`
+	Runs           int64      `json:"runs"`
+	Failures       int64      `json:"failures"`
+	LastStartedAt  *time.Time `json:"lastStartedAt,omitempty"`
+	LastFinishedAt *time.Time `json:"lastFinishedAt,omitempty"`
+	LastDurationMs *int64     `json:"lastDurationMs,omitempty"`
+	LastError      *string    `json:"lastError,omitempty"`
+	NextRunAt      *time.Time `json:"nextRunAt,omitempty"`
+}
+
+// scheduler hosts the recurring background tasks: each job runs once at startup, then every interval plus a
+// random delay of up to its jitter, so several instances don't all hit the database at the same moment.
+// A job can be turned off with JOB_<NAME>_ENABLED=false, where NAME is the job name in upper snake case
+type scheduler struct {
+	logger zerolog.Logger
+	cfg    map[string]string
+	jobs   []*scheduledJob
+}
+
+func newScheduler(cfg map[string]string) *scheduler {
+	logger := log.With().Str("handlerName", "scheduler").Logger()
+	return &scheduler{
+		logger: logger,
+		cfg:    cfg,
+	}
+}
+
+// add registers a job; it must be called before start
+func (s *scheduler) add(name string, interval, jitter time.Duration, task scheduledTask) {
+	job := &scheduledJob{
+		name:     name,
+		interval: interval,
+		jitter:   jitter,
+		enabled:  config.GetBool(s.cfg, jobEnabledKey(name), true),
+		task:     task,
+	}
+	job.status = ScheduledJobStatus{
+		Name:     name,
+		Enabled:  job.enabled,
+		Interval: interval.String(),
+		Jitter:   jitter.String(),
+	}
+	s.jobs = append(s.jobs, job)
+}
+
+// start runs every enabled job as a worker of jobs, so shutdown waits for a run in progress to finish
+func (s *scheduler) start(jobs *lifecycle) {
+	for _, job := range s.jobs {
+		if !job.enabled {
+			s.logger.Info().Str("job", job.name).Msg("Scheduled job disabled")
+			continue
+		}
+		jobs.run(job.name, func(stopping context.Context) {
+			s.loop(stopping, jobs.work, job)
+		})
+	}
+}
+
+// loop runs job now and then after every interval plus jitter until stopping is done
+func (s *scheduler) loop(stopping, work context.Context, job *scheduledJob) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-stopping.Done():
+			return
+		case <-timer.C:
+		}
+
+		s.runOnce(work, job)
+
+		delay := job.interval
+		if job.jitter > 0 {
+			delay += rand.N(job.jitter)
+		}
+		next := time.Now().Add(delay)
+		job.mu.Lock()
+		job.status.NextRunAt = &next
+		job.mu.Unlock()
+		timer.Reset(delay)
+	}
+}
+
+// runOnce runs job's task and records the outcome
+func (s *scheduler) runOnce(ctx context.Context, job *scheduledJob) {
+	started := time.Now()
+	job.mu.Lock()
+	job.status.Running = true
+	job.status.LastStartedAt = &started
+	job.status.NextRunAt = nil
+	job.mu.Unlock()
+
+	err := job.task(ctx, started)
+
+	finished := time.Now()
+	duration := finished.Sub(started).Milliseconds()
+	job.mu.Lock()
+	job.status.Running = false
+	job.status.Runs++
+	job.status.LastFinishedAt = &finished
+	job.status.LastDurationMs = &duration
+	job.status.LastError = nil
+	if err != nil {
+		message := err.Error()
+		job.status.Failures++
+		job.status.LastError = &message
+	}
+	job.mu.Unlock()
+
+	if err != nil {
+		s.logger.Error().Err(err).Str("job", job.name).Msg("Scheduled job failed")
+	}
+}
+
+// statuses returns a snapshot of every job's status, in the order they were added
+func (s *scheduler) statuses() []ScheduledJobStatus {
+	statuses := make([]ScheduledJobStatus, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		job.mu.Lock()
+		statuses = append(statuses, job.status)
+		job.mu.Unlock()
+	}
+	return statuses
+}
+
+// jobEnabledKey returns the config key that turns the named job on or off, e.g. JOB_PUBLISH_SCHEDULED_POSTS_ENABLED
+func jobEnabledKey(name string) string {
+	var key strings.Builder
+	key.WriteString("JOB_")
+	for i, c := range name {
+		if c >= 'A' && c <= 'Z' && i > 0 {
+			key.WriteByte('_')
+		}
+		key.WriteRune(c)
+	}
+	key.WriteString("_ENABLED")
+	return strings.ToUpper(key.String())
+}
+
+type schedulerHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+	scheduler *scheduler
+}
+
+func newSchedulerHandler(scheduler *scheduler) schedulerHandler {
+	logger := log.With().Str("handlerName", "schedulerHandler").Logger()
+
+	return schedulerHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+		scheduler: scheduler,
+	}
+}
+
+// ScheduledJobList is the status of every scheduled job
+type ScheduledJobList struct {
+	Data []ScheduledJobStatus `json:"data"`
+}
+
+// getJobs reports the status of every scheduled background job
+// @Summary Get scheduled jobs
+// @Description Lists the recurring background jobs hosted by this instance with their schedule, whether they are enabled, and the outcome of their last run. Statuses are kept in memory, so they start empty after a restart and only cover this instance
+// @Tags Scheduler
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} ScheduledJobList "Scheduled jobs"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Router /admin/jobs [get]
+func (h schedulerHandler) getJobs() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.responder.WriteJSON(w, ScheduledJobList{Data: h.scheduler.statuses()})
+	}
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type schemaDriftHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+	database  database.Database
+}
+
+func newSchemaDriftHandler(database database.Database) schemaDriftHandler {
+	logger := log.With().Str("handlerName", "schemaDriftHandler").Logger()
+
+	return schemaDriftHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+		database:  database,
+	}
+}
+
+// ColumnTypeMismatchReport is a column whose type in the database isn't the one its model declares
+type ColumnTypeMismatchReport struct {
+	Column       string `json:"column" example:"reading_time"`
+	ModelType    string `json:"modelType" example:"integer"`
+	DatabaseType string `json:"databaseType" example:"text"`
+}
+
+// TableDriftReport is how one table in the database differs from its model
+type TableDriftReport struct {
+	Table          string                     `json:"table" example:"blog_posts"`
+	Exists         bool                       `json:"exists"`
+	Drifted        bool                       `json:"drifted"`
+	ExtraColumns   []string                   `json:"extraColumns" example:"legacy_slug"`
+	MissingColumns []string                   `json:"missingColumns" example:"canonical_url"`
+	TypeMismatches []ColumnTypeMismatchReport `json:"typeMismatches"`
+}
+
+// SchemaDriftReport compares every table in the database with its model
+type SchemaDriftReport struct {
+	Drifted bool               `json:"drifted"`
+	Tables  []TableDriftReport `json:"tables"`
+}
+
+// getSchemaDrift compares the database's tables with the models
+// @Summary Report schema drift
+// @Description Compares each table the models are migrated to with its model, in order of table name: columns the table has that the model doesn't (extraColumns), model columns the table is missing (missingColumns, every column when the table doesn't exist) and columns whose type differs from the one the model's gorm tag declares. It's the column-report command's comparison, for checking a deployed instance. Running migrate fixes missing tables and columns; extra columns and type changes have to be dealt with by hand
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SchemaDriftReport "Drift per table"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error reading the database schema"
+// @Router /admin/schema/drift [get]
+func (h schemaDriftHandler) getSchemaDrift() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		drifts, err := h.database.SchemaDrift()
+		if err != nil {
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("failed to compare the schema with the models", err))
+			return
+		}
+
+		report := SchemaDriftReport{Tables: make([]TableDriftReport, 0, len(drifts))}
+		for _, drift := range drifts {
+			mismatches := make([]ColumnTypeMismatchReport, 0, len(drift.TypeMismatches))
+			for _, mismatch := range drift.TypeMismatches {
+				mismatches = append(mismatches, ColumnTypeMismatchReport{
+					Column:       mismatch.Column,
+					ModelType:    mismatch.ModelType,
+					DatabaseType: mismatch.DatabaseType,
+				})
+			}
+			report.Tables = append(report.Tables, TableDriftReport{
+				Table:          drift.Table,
+				Exists:         drift.Exists,
+				Drifted:        drift.Drifted(),
+				ExtraColumns:   nonNil(drift.ExtraColumns),
+				MissingColumns: nonNil(drift.MissingColumns),
+				TypeMismatches: mismatches,
+			})
+			report.Drifted = report.Drifted || drift.Drifted()
+		}
+		h.responder.WriteJSON(w, report)
+	}
+}
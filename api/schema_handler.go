@@ -0,0 +1,61 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// entityExamples maps the entities of GET /schema/{entity}/example to their canonical request bodies
+var entityExamples = map[string]string{
+	"blog-post": models.BlogPostExample,
+	"project":   models.ProjectExample,
+	"publish":   models.BlogPostPublishExample,
+}
+
+type schemaHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+}
+
+func newSchemaHandler() schemaHandler {
+	logger := log.With().Str("handlerName", "schemaHandler").Logger()
+
+	return schemaHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+	}
+}
+
+// getExample returns a canonical example request body for an entity
+// @Summary Get example payload
+// @Description Returns a canonical example request body for an entity, which the admin UI uses to prefill its forms. Entities are blog-post (POST /blog-post), project (POST /project) and publish (POST /blog-post scheduled for later)
+// @Tags Documentation
+// @Produce json
+// @Param entity path string true "Entity to get an example of" Enums(blog-post, project, publish)
+// @Success 200 {object} object "Example request body"
+// @Failure 404 {object} api.ErrorResponse "Not Found - No example for the entity"
+// @Router /schema/{entity}/example [get]
+func (h schemaHandler) getExample() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		entity := chi.URLParam(r, "entity")
+		example, ok := entityExamples[entity]
+		if !ok {
+			entities := make([]string, 0, len(entityExamples))
+			for name := range entityExamples {
+				entities = append(entities, name)
+			}
+			slices.Sort(entities)
+			h.responder.WriteError(w, errs.NewNotFoundError("no example for entity "+entity+", expected one of: "+strings.Join(entities, ", ")))
+			return
+		}
+		h.responder.WriteJSON(w, json.RawMessage(example))
+	}
+}
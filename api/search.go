@@ -0,0 +1,37 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+)
+
+const (
+	defaultSearchLimit  = 20
+	maxSearchLimit      = 50
+	maxSearchQueryRunes = 200
+)
+
+// parseSearchParams reads the q and limit query parameters shared by the search endpoints
+func parseSearchParams(r *http.Request) (string, int, error) {
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		return "", 0, errs.NewInvalidFieldError("q", "is required")
+	}
+	if len([]rune(query)) > maxSearchQueryRunes {
+		return "", 0, errs.NewInvalidFieldError("q", "must be at most "+strconv.Itoa(maxSearchQueryRunes)+" characters")
+	}
+
+	limit := defaultSearchLimit
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > maxSearchLimit {
+			return "", 0, errs.NewInvalidFieldError("limit", "must be between 1 and "+strconv.Itoa(maxSearchLimit))
+		}
+	}
+
+	return query, limit, nil
+}
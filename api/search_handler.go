@@ -0,0 +1,162 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// SearchResult is one match of GET /search: a blog post, a project or a tag, as type says, with its relevance score
+// Only the field matching type is set
+type SearchResult struct {
+	Type     string           `json:"type" enums:"blog_post,project,tag" example:"blog_post"`
+	Score    float64          `json:"score" example:"1.06"`
+	BlogPost *BlogPostSummary `json:"blogPost,omitempty"`
+	Project  *ProjectSummary  `json:"project,omitempty"`
+	Tag      *SearchTag       `json:"tag,omitempty"`
+}
+
+// SearchTag is a matching tag, with how many published blog posts and projects carry it
+type SearchTag struct {
+	Value     string `json:"value" example:"go"`
+	BlogPosts int    `json:"blogPosts" example:"12"`
+	Projects  int    `json:"projects" example:"3"`
+}
+
+// SearchResults is the response of GET /search
+type SearchResults struct {
+	Query string         `json:"query"`
+	Data  []SearchResult `json:"data"`
+}
+
+type searchHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+	database  database.Database
+}
+
+func newSearchHandler(database database.Database) searchHandler {
+	logger := log.With().Str("handlerName", "searchHandler").Logger()
+
+	return searchHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+		database:  database,
+	}
+}
+
+// search searches blog posts, projects and tags at once
+// @Summary Search everything
+// @Description Searches published blog posts, projects and the tags on them in one query, for a site-wide search box. Blog posts and projects are matched as /blog-posts/search and /projects/search match them, and tags by their value: a tag that is the query, ignoring case, scores 2, and one resembling a word of it scores its trigram similarity (0-1). All three are ranked together, best first, so scores above 1 are full-text or exact tag matches. Results say their type and carry the matching blog post or project summary, or the tag with how many posts and projects have it
+// @Tags Search
+// @Accept json
+// @Produce json
+// @Param q query string true "Search query (max 200 characters)"
+// @Param limit query int false "Maximum number of results (max 50)" default(20)
+// @Success 200 {object} SearchResults "Matching blog posts, projects and tags, best first"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Missing or invalid q or limit"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error searching"
+// @Router /search [get]
+func (h searchHandler) search() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query, limit, err := parseSearchParams(r)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		hits, err := h.database.Search(query, limit)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("search content", "content", err))
+			return
+		}
+
+		var blogPostIDs, projectIDs []uuid.UUID
+		for _, hit := range hits {
+			id, err := uuid.Parse(hit.Key)
+			if err != nil {
+				continue
+			}
+			switch hit.Type {
+			case database.SearchHitBlogPost:
+				blogPostIDs = append(blogPostIDs, id)
+			case database.SearchHitProject:
+				projectIDs = append(projectIDs, id)
+			}
+		}
+		blogPosts, err := h.findBlogPosts(blogPostIDs)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		projects, err := h.findProjects(projectIDs)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		response := SearchResults{Query: query, Data: make([]SearchResult, 0, len(hits))}
+		for _, hit := range hits {
+			result := SearchResult{Type: hit.Type, Score: hit.Score}
+			switch hit.Type {
+			case database.SearchHitBlogPost:
+				// A post unpublished between the two queries is left out
+				summary, ok := blogPosts[hit.Key]
+				if !ok {
+					continue
+				}
+				result.BlogPost = &summary
+			case database.SearchHitProject:
+				summary, ok := projects[hit.Key]
+				if !ok {
+					continue
+				}
+				result.Project = &summary
+			default:
+				result.Tag = &SearchTag{Value: hit.Key, BlogPosts: hit.BlogPosts, Projects: hit.Projects}
+			}
+			response.Data = append(response.Data, result)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// findBlogPosts returns the summaries of the published blog posts among ids, keyed by ID as search hits give it
+func (h searchHandler) findBlogPosts(ids []uuid.UUID) (map[string]BlogPostSummary, error) {
+	blogPosts, err := h.database.BlogPostRepo().FindSummaries(ids)
+	if err != nil {
+		return nil, wrapDatabaseError("find blog posts", "blog_posts", err)
+	}
+	tags, err := h.database.BlogTagRepo().FindValues(ids)
+	if err != nil {
+		return nil, wrapDatabaseError("find blog tags", "blog_tags", err)
+	}
+
+	summaries := make(map[string]BlogPostSummary, len(blogPosts))
+	for _, blogPost := range blogPosts {
+		summaries[blogPost.ID.String()] = newBlogPostSummary(blogPost, tags[blogPost.ID])
+	}
+	return summaries, nil
+}
+
+// findProjects returns the summaries of the projects among ids, keyed by ID as search hits give it
+func (h searchHandler) findProjects(ids []uuid.UUID) (map[string]ProjectSummary, error) {
+	projects, err := h.database.ProjectRepo().FindSummaries(ids)
+	if err != nil {
+		return nil, wrapDatabaseError("find projects", "projects", err)
+	}
+	tags, err := h.database.ProjectTagRepo().FindValues(ids)
+	if err != nil {
+		return nil, wrapDatabaseError("find project tags", "project_tags", err)
+	}
+
+	summaries := make(map[string]ProjectSummary, len(projects))
+	for _, project := range projects {
+		summaries[project.ID.String()] = newProjectSummary(project, tags[project.ID])
+	}
+	return summaries, nil
+}
@@ -0,0 +1,422 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type seriesHandler struct {
+	responder    Responder
+	logger       zerolog.Logger
+	seriesRepo   *database.SeriesRepo
+	blogPostRepo *database.BlogPostRepo
+	blogTagRepo  *database.BlogTagRepo
+	locales      *contentLocales
+}
+
+func newSeriesHandler(seriesRepo *database.SeriesRepo, blogPostRepo *database.BlogPostRepo, blogTagRepo *database.BlogTagRepo, locales *contentLocales) seriesHandler {
+	logger := log.With().Str("handlerName", "seriesHandler").Logger()
+
+	return seriesHandler{
+		responder:    NewResponder(logger),
+		logger:       logger,
+		seriesRepo:   seriesRepo,
+		blogPostRepo: blogPostRepo,
+		blogTagRepo:  blogTagRepo,
+		locales:      locales,
+	}
+}
+
+// SeriesCollection represents multiple series
+type SeriesCollection struct {
+	Series []models.Series `json:"series"`
+	Total  int             `json:"total,omitempty"`
+}
+
+// SeriesResponse is a series with its published posts in order
+type SeriesResponse struct {
+	models.Series
+	Posts []SeriesPostSummary `json:"posts"`
+}
+
+// SeriesPostSummary is a blog post summary with its position in a series
+type SeriesPostSummary struct {
+	BlogPostSummary
+	Position int `json:"position" example:"1"`
+}
+
+// SeriesPostRequest places a blog post in a series
+type SeriesPostRequest struct {
+	// Position is where the post goes in the series; it's put after the last post when not given
+	Position *int `json:"position,omitempty" validate:"omitempty,min=1" example:"2"`
+}
+
+// getAllSeries retrieves every series
+// @Summary Get series
+// @Description Retrieves every series, newest first, without their posts
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Success 200 {object} SeriesCollection "List of series"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching series"
+// @Router /series [get]
+func (h seriesHandler) getAllSeries() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		series, err := h.seriesRepo.FindAll()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find series", "series", err))
+			return
+		}
+
+		collection := SeriesCollection{Series: make([]models.Series, 0, len(series)), Total: len(series)}
+		for _, s := range series {
+			collection.Series = append(collection.Series, *s)
+		}
+		h.responder.WriteJSON(w, collection)
+	}
+}
+
+// getSeries retrieves a series by slug with its posts in order
+// @Summary Get series by slug
+// @Description Retrieves a series with the summaries of its published posts, ordered by their position in it. Drafts and scheduled posts can be placed in a series ahead of time, and are listed once they're published. Posts translated into the negotiated locale are served in it
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param slug path string true "Series slug"
+// @Param locale query string false "Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given"
+// @Success 200 {object} SeriesResponse "Series and its posts"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid locale"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Series not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching series"
+// @Router /series/{slug} [get]
+func (h seriesHandler) getSeries() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		series, err := h.seriesRepo.FindBySlug(chi.URLParam(r, "slug"))
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find series", "series", err))
+			return
+		}
+
+		posts, err := h.seriesRepo.FindPosts(series.ID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find series posts", "series_posts", err))
+			return
+		}
+		ids := make([]uuid.UUID, 0, len(posts))
+		for _, post := range posts {
+			ids = append(ids, post.BlogPostID)
+		}
+
+		blogPosts, err := h.blogPostRepo.FindSummaries(ids)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog posts", "blog_posts", err))
+			return
+		}
+		if _, err := h.locales.translate(w, r, blogPosts, false); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post translations", "blog_post_translations", err))
+			return
+		}
+		tags, err := h.blogTagRepo.FindValues(ids)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog tags", "blog_tags", err))
+			return
+		}
+
+		byID := make(map[uuid.UUID]*models.BlogPost, len(blogPosts))
+		for _, blogPost := range blogPosts {
+			byID[blogPost.ID] = blogPost
+		}
+		response := SeriesResponse{Series: *series, Posts: make([]SeriesPostSummary, 0, len(posts))}
+		for _, post := range posts {
+			// Unpublished posts and those in the trash aren't found, and are left out
+			blogPost, ok := byID[post.BlogPostID]
+			if !ok {
+				continue
+			}
+			response.Posts = append(response.Posts, SeriesPostSummary{
+				BlogPostSummary: newBlogPostSummary(blogPost, tags[blogPost.ID]),
+				Position:        post.Position,
+			})
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// createSeries creates a new series
+// @Summary Create series
+// @Description Creates a new series. Its slug is made from the slug given, or the title when there isn't one, by lowercasing it and joining its words with hyphens
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param series body models.Series true "Series data"
+// @Success 201 {object} models.Series "Created series"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid series data"
+// @Failure 409 {object} api.ErrorResponse "Conflict - Slug already taken"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating series"
+// @Router /series [post]
+func (h seriesHandler) createSeries() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		series, ok := h.decodeSeries(w, r, "")
+		if !ok {
+			return
+		}
+
+		series.ID = uuid.Nil
+		series.DateAdded = time.Now()
+		series.DateEdited = nil
+
+		if err := h.seriesRepo.Add(series); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create series", "series", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, series)
+	}
+}
+
+// updateSeries updates an existing series
+// @Summary Update series
+// @Description Updates an existing series' title and description, and its slug when one is given. Its posts stay where they are
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param slug path string true "Series slug"
+// @Param series body models.Series true "Updated series data"
+// @Success 200 {object} models.Series "Updated series"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid series data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Series not found"
+// @Failure 409 {object} api.ErrorResponse "Conflict - Slug already taken"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error updating series"
+// @Router /series/{slug} [put]
+func (h seriesHandler) updateSeries() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		existingSeries, err := h.seriesRepo.FindBySlug(chi.URLParam(r, "slug"))
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find series", "series", err))
+			return
+		}
+
+		series, ok := h.decodeSeries(w, r, existingSeries.Slug)
+		if !ok {
+			return
+		}
+
+		// Ensure ID matches and keep the original creation date
+		now := time.Now()
+		series.ID = existingSeries.ID
+		series.DateAdded = existingSeries.DateAdded
+		series.DateEdited = &now
+
+		if err := h.seriesRepo.Update(series); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update series", "series", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, series)
+	}
+}
+
+// deleteSeries deletes a series by slug
+// @Summary Delete series
+// @Description Deletes a series by slug. Its posts are taken out of it, not deleted
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param slug path string true "Series slug"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Series not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting series"
+// @Router /series/{slug} [delete]
+func (h seriesHandler) deleteSeries() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		series, err := h.seriesRepo.FindBySlug(chi.URLParam(r, "slug"))
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find series", "series", err))
+			return
+		}
+
+		if err := h.seriesRepo.Delete(series.ID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete series", "series", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "series deleted successfully",
+		})
+	}
+}
+
+// attachBlogPost places a blog post in a series
+// @Summary Add blog post to series
+// @Description Places a blog post at a position in a series, or after its last post when no position is given. A post is in at most one series, so a post already in a series is moved, and putting it at a position another post holds is a conflict
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param slug path string true "Series slug"
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Param position body SeriesPostRequest false "Position in the series"
+// @Success 200 {object} models.SeriesPost "Post's place in the series"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID or position"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Series or blog post not found"
+// @Failure 409 {object} api.ErrorResponse "Conflict - Position already taken"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error adding blog post"
+// @Router /series/{slug}/post/{blogPostID} [put]
+func (h seriesHandler) attachBlogPost() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		series, blogPostID, ok := h.parseSeriesPost(w, r)
+		if !ok {
+			return
+		}
+		if _, err := h.blogPostRepo.FindByID(blogPostID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post", "blog_post", err))
+			return
+		}
+
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			h.logger.Error().Err(err).Msg("Failed to read request body")
+			h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+			return
+		}
+		var request SeriesPostRequest
+		if len(bytes.TrimSpace(bodyBytes)) > 0 {
+			if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&request); err != nil {
+				h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode series post request body")
+				h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+				return
+			}
+			if err := validateRequest(&request); err != nil {
+				h.responder.WriteError(w, err)
+				return
+			}
+		}
+
+		post := models.SeriesPost{BlogPostID: blogPostID, SeriesID: series.ID}
+		if request.Position != nil {
+			post.Position = *request.Position
+		} else if post.Position, err = h.seriesRepo.NextPosition(series.ID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find series position", "series_posts", err))
+			return
+		}
+
+		if err := h.seriesRepo.SavePost(&post); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("add blog post to", "series", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, post)
+	}
+}
+
+// detachBlogPost takes a blog post out of a series
+// @Summary Remove blog post from series
+// @Description Takes a blog post out of a series. The post itself is left as it is, and the other posts keep their positions
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param slug path string true "Series slug"
+// @Param blogPostID path string true "Blog Post ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid blogPostID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Series not found, or blog post not in it"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error removing blog post"
+// @Router /series/{slug}/post/{blogPostID} [delete]
+func (h seriesHandler) detachBlogPost() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		series, blogPostID, ok := h.parseSeriesPost(w, r)
+		if !ok {
+			return
+		}
+
+		removed, err := h.seriesRepo.DeletePost(series.ID, blogPostID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("remove blog post from", "series", err))
+			return
+		}
+		if !removed {
+			h.responder.WriteError(w, errs.NewNotFoundError("blog post not in series").WithCode(errs.EntityCode("series_post", errs.CodeSuffixNotFound)))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "blog post removed from series",
+		})
+	}
+}
+
+// parseSeriesPost finds the series named by the slug path parameter and reads the blogPostID one, writing the error
+// response itself and returning false when either is missing or invalid
+func (h seriesHandler) parseSeriesPost(w http.ResponseWriter, r *http.Request) (*models.Series, uuid.UUID, bool) {
+	blogPostID, err := uuid.Parse(chi.URLParam(r, "blogPostID"))
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid blogPostID"))
+		return nil, uuid.Nil, false
+	}
+
+	series, err := h.seriesRepo.FindBySlug(chi.URLParam(r, "slug"))
+	if err != nil {
+		h.responder.WriteError(w, wrapDatabaseError("find series", "series", err))
+		return nil, uuid.Nil, false
+	}
+
+	return series, blogPostID, true
+}
+
+// decodeSeries reads and validates a series from the request body, making its slug from the one given. Without one,
+// the series keeps slug, or gets one made from its title when slug is ""
+// It writes the error response itself and returns false when the body is invalid
+func (h seriesHandler) decodeSeries(w http.ResponseWriter, r *http.Request, slug string) (*models.Series, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var series models.Series
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&series); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode series request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	series.Title = strings.TrimSpace(series.Title)
+	series.Posts = nil
+
+	if err := validateRequest(&series); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+
+	switch {
+	case series.Slug != "":
+		series.Slug = services.BlogPostSlug(series.Slug)
+	case slug != "":
+		series.Slug = slug
+	default:
+		series.Slug = services.BlogPostSlug(series.Title)
+	}
+	if series.Slug == "" {
+		h.responder.WriteError(w, errs.NewInvalidFieldError("slug", "must contain a letter or digit"))
+		return nil, false
+	}
+
+	return &series, true
+}
@@ -3,24 +3,53 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io/fs"
 	"net/http"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"github.com/rpupo63/unified-personal-site-backend/config"
 	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/restart"
 	"github.com/rs/zerolog/log"
 )
 
+// Default HTTP server timeouts, each overridable with the environment variable of the same name
+const (
+	defaultReadHeaderTimeout = 10 * time.Second
+	defaultReadTimeout       = 30 * time.Second
+	defaultWriteTimeout      = 60 * time.Second
+	defaultIdleTimeout       = 120 * time.Second
+
+	defaultHTTP2MaxConcurrentStreams = 250
+)
+
 type Server struct {
 	*http.Server
-	startupTime time.Time
+	startupTime  time.Time
+	jobs         *lifecycle
+	redirect     *http.Server // Plain HTTP listener when serving TLS directly, nil otherwise
+	network      string       // "tcp", or "unix" when Addr is a socket path
+	socketMode   fs.FileMode
+	admin        *http.Server // Separate listener for admin and debug routes, nil when they share the public one
+	adminNetwork string
+	listenFunc   restart.ListenFunc
+	listeners    *serverListeners
 }
 
-func NewServer(database database.Database) (Server, error) {
+// WithListenFunc opens the server's sockets with listen, e.g. one that inherits them across a restart
+func WithListenFunc(listen restart.ListenFunc) func(*Server) {
+	return func(s *Server) {
+		s.listenFunc = listen
+	}
+}
+
+func NewServer(database database.Database, opts ...func(*Server)) (Server, error) {
 	c := config.New()
 
 	// Get port from environment variable, default to 8080
@@ -33,27 +62,93 @@ func NewServer(database database.Database) (Server, error) {
 	// Capture startup time
 	startupTime := time.Now()
 
-	router := newRouter(database, withConfig(c), withStartupTime(startupTime))
+	// Background workers started by the handlers, drained on shutdown
+	jobs := newLifecycle()
+
+	// ADMIN_LISTEN moves the admin and debug routes off the public listener, so they can be bound to
+	// a private interface or socket and firewalled separately
+	routerOpts := []func(*router){withConfig(c), withStartupTime(startupTime), withLifecycle(jobs)}
+	adminListen := config.GetString(c, "ADMIN_LISTEN", "")
+	var adminRouter *chi.Mux
+	if adminListen != "" {
+		adminRouter = chi.NewRouter()
+		routerOpts = append(routerOpts, withAdminRouter(adminRouter))
+	}
 
-	// Hardcoded timeout values
-	readTimeout := 180 * time.Second
-	writeTimeout := 180 * time.Second
-	idleTimeout := 180 * time.Second
+	router := newRouter(database, routerOpts...)
 
+	// Timeouts apply to every request, so keep them short; endpoints that legitimately take longer,
+	// like cross-posting to social platforms, extend their own deadlines with withRequestDeadline
 	server := &http.Server{
-		Addr:         address,
-		Handler:      router,
-		ReadTimeout:  readTimeout,  // Timeout for reading the entire request
-		WriteTimeout: writeTimeout, // Timeout for writing the response
-		IdleTimeout:  idleTimeout,  // Timeout for idle connections
+		Addr:              address,
+		Handler:           router,
+		ReadHeaderTimeout: config.GetDuration(c, "HTTP_READ_HEADER_TIMEOUT", defaultReadHeaderTimeout), // Timeout for reading request headers
+		ReadTimeout:       config.GetDuration(c, "HTTP_READ_TIMEOUT", defaultReadTimeout),              // Timeout for reading the entire request
+		WriteTimeout:      config.GetDuration(c, "HTTP_WRITE_TIMEOUT", defaultWriteTimeout),            // Timeout for writing the response
+		IdleTimeout:       config.GetDuration(c, "HTTP_IDLE_TIMEOUT", defaultIdleTimeout),              // Timeout for idle connections
+		// HTTP/2 is only negotiated over TLS, so this matters when serving TLS directly
+		HTTP2: &http.HTTP2Config{
+			MaxConcurrentStreams: config.GetInt(c, "HTTP2_MAX_CONCURRENT_STREAMS", defaultHTTP2MaxConcurrentStreams),
+		},
+	}
+	// Disabling keep-alives closes every connection after its response, e.g. behind a proxy that pools its own
+	server.SetKeepAlivesEnabled(config.GetBool(c, "HTTP_KEEP_ALIVES", true))
+
+	// Terminate TLS in the server itself when certificates are configured; PORT then serves
+	// ACME challenges and redirects to HTTPS on TLS_PORT
+	tlsConfig, certManager, err := newTLSConfig(c)
+	if err != nil {
+		return Server{}, err
+	}
+	var redirect *http.Server
+	if tlsConfig != nil {
+		tlsPort := config.GetString(c, "TLS_PORT", "443")
+		server.Addr = "0.0.0.0:" + tlsPort
+		server.TLSConfig = tlsConfig
+		redirect = newRedirectServer(address, tlsPort, certManager, healthcheckHandler(startupTime))
+	}
+
+	socketMode, err := parseSocketMode(config.GetString(c, "LISTEN_SOCKET_MODE", "0660"))
+	if err != nil {
+		return Server{}, err
+	}
+
+	// LISTEN replaces the address above, e.g. unix:/run/app.sock to sit behind a proxy on the same host
+	network := "tcp"
+	if listen := config.GetString(c, "LISTEN", ""); listen != "" {
+		if network, server.Addr, err = parseListenAddress(listen); err != nil {
+			return Server{}, err
+		}
+	}
+
+	// The admin listener is meant for a private network, so it serves plain HTTP even when TLS is on
+	var admin *http.Server
+	adminNetwork := "tcp"
+	if adminRouter != nil {
+		admin = &http.Server{
+			Handler:           adminRouter,
+			ReadHeaderTimeout: server.ReadHeaderTimeout,
+			ReadTimeout:       server.ReadTimeout,
+			WriteTimeout:      server.WriteTimeout,
+			IdleTimeout:       server.IdleTimeout,
+		}
+		if adminNetwork, admin.Addr, err = parseListenAddress(adminListen); err != nil {
+			return Server{}, fmt.Errorf("ADMIN_LISTEN: %w", err)
+		}
 	}
 
-	return Server{server, startupTime}, nil
+	s := Server{server, startupTime, jobs, redirect, network, socketMode, admin, adminNetwork, restart.Listen, &serverListeners{}}
+	for _, opt := range opts {
+		opt(&s)
+	}
+	return s, nil
 }
 
 type router struct {
 	config      map[string]string
 	startupTime time.Time
+	jobs        *lifecycle
+	admin       *chi.Mux
 }
 
 func withConfig(c map[string]string) func(*router) {
@@ -68,6 +163,19 @@ func withStartupTime(startupTime time.Time) func(*router) {
 	}
 }
 
+func withLifecycle(jobs *lifecycle) func(*router) {
+	return func(r *router) {
+		r.jobs = jobs
+	}
+}
+
+// withAdminRouter serves the admin and debug routes on admin instead of the public router
+func withAdminRouter(admin *chi.Mux) func(*router) {
+	return func(r *router) {
+		r.admin = admin
+	}
+}
+
 func newRouter(database database.Database, opts ...func(*router)) *chi.Mux {
 	var router router
 	for _, opt := range opts {
@@ -75,45 +183,132 @@ func newRouter(database database.Database, opts ...func(*router)) *chi.Mux {
 	}
 
 	chiRouter := chi.NewRouter()
-	chiRouter.Use(LogInternalServerErrors)
-
-	// Healthcheck endpoint - accessible from any origin
-	chiRouter.Get("/healthcheck", healthcheckHandler(router.startupTime))
 
 	// Get backend password from config
 	backendPassword := config.GetString(router.config, "BACKEND_PASSWORD", "")
 
 	// Initialize all handlers
-	handlers := initializeHandlers(database, backendPassword)
+	handlers := initializeHandlers(database, backendPassword, router.config, router.jobs)
+	// The benchmark sends its requests through the public routes, the way clients reach them
+	handlers.benchHandler = newBenchHandler(database, chiRouter)
 
 	// Initialize auth middleware
-	authMiddleware := newAuthMiddleware()
+	authMiddleware := newAuthMiddleware(backendPassword)
+
+	// Requests are checked against the Swagger spec unless OPENAPI_VALIDATION=false
+	var validator *specValidator
+	if config.GetBool(router.config, "OPENAPI_VALIDATION", true) {
+		var err error
+		if validator, err = newSpecValidator(); err != nil {
+			log.Error().Err(err).Msg("Failed to load the API spec, requests won't be validated against it")
+		}
+	}
 
-	// Apply CORS middleware
 	acceptedOrigins := strings.Split(os.Getenv("ACCEPTED_ORIGINS"), ",")
-	chiRouter.Use(CORSCheckMiddleware(acceptedOrigins))
-	chiRouter.Use(corsMiddleware(acceptedOrigins))
+	useBaseMiddleware(chiRouter, acceptedOrigins, validator)
 
-	// Swagger documentation route
-	// Get port from environment variable, default to 8080
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
+	// Healthcheck endpoint - accessible from any origin
+	// Registered after all middleware since chi rejects Use() once a route exists
+	chiRouter.Get("/healthcheck", healthcheckHandler(router.startupTime))
+
+	// Admin routes stay on the public router unless they have a listener of their own
+	adminRouter := chiRouter
+	if router.admin != nil {
+		adminRouter = router.admin
+		useBaseMiddleware(adminRouter, acceptedOrigins, validator)
+		adminRouter.Get("/healthcheck", healthcheckHandler(router.startupTime))
+
+		// Profiling is only exposed on the separate admin listener, never on the public one
+		adminRouter.With(authMiddleware.requireAdmin).Mount("/debug", middleware.Profiler())
 	}
-	swaggerURL := "http://localhost:" + port + "/swagger/doc.json"
+
+	// Swagger documentation routes
+	// The spec is rendered per request so it names the host clients reached it on, and the UI loads it relative to its own page
+	apiSpec := newAPISpecHandler(config.GetString(router.config, "API_BASE_URL", ""))
+	chiRouter.Get("/openapi.json", apiSpec.getSpec())
+	chiRouter.Get("/version", apiSpec.getVersion())
+	chiRouter.Get("/swagger/doc.json", apiSpec.getSpec())
 	chiRouter.Get("/swagger/*", httpSwagger.Handler(
-		httpSwagger.URL(swaggerURL), // The url pointing to API definition
+		httpSwagger.URL("doc.json"), // The url pointing to API definition
 	))
 
 	// Setup all route types
 	setupFrontendRoutes(chiRouter, handlers, authMiddleware)
+	setupAdminRoutes(adminRouter, handlers, authMiddleware)
 
 	return chiRouter
 }
 
+// useBaseMiddleware applies the middleware every listener shares: error logging, error languages, CORS, HEAD support
+// and, when validator isn't nil, checking requests against the API spec
+func useBaseMiddleware(chiRouter *chi.Mux, acceptedOrigins []string, validator *specValidator) {
+	chiRouter.Use(LogInternalServerErrors)
+	chiRouter.Use(negotiateErrorLanguage)
+
+	// Apply CORS middleware
+	chiRouter.Use(CORSCheckMiddleware(acceptedOrigins))
+	chiRouter.Use(allowHeaderMiddleware(chiRouter))
+	chiRouter.Use(corsMiddleware(acceptedOrigins))
+
+	// Answer HEAD on every GET route with the same headers and no body
+	chiRouter.Use(middleware.GetHead)
+	chiRouter.MethodNotAllowed(methodNotAllowedHandler(chiRouter))
+
+	if validator != nil {
+		chiRouter.Use(validator.middleware)
+	}
+}
+
+// Listen opens every listener the server needs without serving on them yet
+// Calling it before Start lets the caller know the sockets are open, e.g. before reporting a restart as ready
+func (s Server) Listen() error {
+	if s.listeners.public != nil {
+		return nil
+	}
+
+	var err error
+	if s.listeners.public, err = listen(s.listenFunc, s.network, s.Addr, s.socketMode); err != nil {
+		return err
+	}
+	if s.admin != nil {
+		if s.listeners.admin, err = listen(s.listenFunc, s.adminNetwork, s.admin.Addr, s.socketMode); err != nil {
+			return err
+		}
+	}
+	if s.redirect != nil {
+		if s.listeners.redirect, err = listen(s.listenFunc, "tcp", s.redirect.Addr, s.socketMode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s Server) Start(errChannel chan<- error) {
-	log.Info().Msgf("Server started on: %s", s.Addr)
-	errChannel <- s.ListenAndServe()
+	if err := s.Listen(); err != nil {
+		errChannel <- err
+		return
+	}
+
+	if s.admin != nil {
+		go func() {
+			log.Info().Msgf("Admin server started on: %s", s.listeners.admin.Addr())
+			errChannel <- s.admin.Serve(s.listeners.admin)
+		}()
+	}
+
+	if s.TLSConfig == nil {
+		log.Info().Msgf("Server started on: %s", s.listeners.public.Addr())
+		errChannel <- s.Serve(s.listeners.public)
+		return
+	}
+
+	go func() {
+		log.Info().Msgf("HTTP redirect server started on: %s", s.listeners.redirect.Addr())
+		errChannel <- s.redirect.Serve(s.listeners.redirect)
+	}()
+	log.Info().Msgf("Server started with TLS on: %s", s.listeners.public.Addr())
+	// Certificates come from TLSConfig, so no files are passed here
+	errChannel <- s.ServeTLS(s.listeners.public, "", "")
 }
 
 func (s Server) ShutdownGracefully(timeout time.Duration) {
@@ -127,6 +322,21 @@ func (s Server) ShutdownGracefully(timeout time.Duration) {
 	} else {
 		log.Info().Msg("HttpServer gracefully shut down")
 	}
+
+	if s.redirect != nil {
+		if err := s.redirect.Shutdown(gracefullCtx); err != nil {
+			log.Error().Msgf("Error shutting down the redirect server: %v", err)
+		}
+	}
+
+	if s.admin != nil {
+		if err := s.admin.Shutdown(gracefullCtx); err != nil {
+			log.Error().Msgf("Error shutting down the admin server: %v", err)
+		}
+	}
+
+	// Requests are done now, so no new jobs can be queued while the workers drain
+	s.jobs.drain(gracefullCtx)
 }
 
 // healthcheckHandler returns a handler function for the healthcheck endpoint
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// trackedPlatforms are the platforms whose posts link back to the blog post, so a share link can stand in for the URL
+// Medium and Substack republish the whole post with a canonical URL, which must stay the real one
+var trackedPlatforms = []string{"twitter", "linkedin"}
+
+// shareLinker creates tracked /r/{token} links for social posts
+// It does nothing unless baseURL, this API's public URL, is configured
+type shareLinker struct {
+	logger        zerolog.Logger
+	shareLinkRepo *database.ShareLinkRepo
+	baseURL       string
+}
+
+func newShareLinker(shareLinkRepo *database.ShareLinkRepo, baseURL string) *shareLinker {
+	logger := log.With().Str("handlerName", "shareLinker").Logger()
+	return &shareLinker{
+		logger:        logger,
+		shareLinkRepo: shareLinkRepo,
+		baseURL:       strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// forBlogPost creates a share link for each tracked platform in platforms
+// It returns the stored links and the redirect URL to post on each platform, both keyed by platform.
+// A platform whose link can't be created falls back to the post's own URL
+func (s *shareLinker) forBlogPost(blogPost models.BlogPost, platforms []string) (map[string]models.ShareLink, map[string]string) {
+	shareLinks := map[string]models.ShareLink{}
+	urls := map[string]string{}
+	if s.baseURL == "" {
+		return shareLinks, urls
+	}
+
+	for _, platform := range trackedPlatforms {
+		if !containsFold(platforms, platform) {
+			continue
+		}
+		targetURL := services.BlogPostLink(blogPost, platform)
+		if targetURL == "" {
+			continue
+		}
+
+		token, err := newShareLinkToken()
+		if err != nil {
+			s.logger.Error().Err(err).Str("platform", platform).Msg("Failed to generate share link token")
+			continue
+		}
+		shareLink := models.ShareLink{
+			Token:       token,
+			ContentType: models.ContentTypeBlogPost,
+			ContentID:   blogPost.ID,
+			Platform:    platform,
+			TargetURL:   targetURL,
+			DateAdded:   time.Now(),
+		}
+		if err := s.shareLinkRepo.Add(&shareLink); err != nil {
+			s.logger.Error().Err(err).Str("platform", platform).Msg("Failed to create share link")
+			continue
+		}
+
+		shareLinks[platform] = shareLink
+		urls[platform] = s.baseURL + "/r/" + token
+	}
+	return shareLinks, urls
+}
+
+// newShareLinkToken returns a random URL-safe token short enough not to eat into a tweet
+func newShareLinkToken() (string, error) {
+	raw := make([]byte, 9)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// containsFold reports whether values contains value, ignoring case
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), value) {
+			return true
+		}
+	}
+	return false
+}
+
+type shareLinkHandler struct {
+	responder     Responder
+	logger        zerolog.Logger
+	shareLinkRepo *database.ShareLinkRepo
+}
+
+func newShareLinkHandler(shareLinkRepo *database.ShareLinkRepo) shareLinkHandler {
+	logger := log.With().Str("handlerName", "shareLinkHandler").Logger()
+
+	return shareLinkHandler{
+		responder:     NewResponder(logger),
+		logger:        logger,
+		shareLinkRepo: shareLinkRepo,
+	}
+}
+
+// followShareLink counts a click on a share link and redirects to its target
+// @Summary Follow share link
+// @Description Counts one click on a tracked share link from a social post and redirects to the content it points to
+// @Tags Social Posts
+// @Param token path string true "Share link token"
+// @Success 302 "Redirect to the shared content"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Unknown share link"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error recording click"
+// @Router /r/{token} [get]
+func (h shareLinkHandler) followShareLink() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := chi.URLParam(r, "token")
+
+		shareLink, err := h.shareLinkRepo.RecordClick(token)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("record share link click", "share_link", err))
+			return
+		}
+
+		// Crawlers fetching link previews follow the redirect too, so don't let caches hide repeat clicks
+		w.Header().Set("Cache-Control", "no-store")
+		http.Redirect(w, r, shareLink.TargetURL, http.StatusFound)
+	}
+}
@@ -0,0 +1,348 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/config"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultShortLinksPerPage = 20
+	maxShortLinksPerPage     = database.MaxPageSize
+)
+
+// shortLinkCode is what a custom short link code may be made of, so it needs no escaping in a URL
+var shortLinkCode = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// BlogPostShortLink returns the /s/{code} URL of blogPost's short link under baseURL, creating the link if the post
+// doesn't have one yet. It returns "" when baseURL isn't set or the post has no URL to link to
+func BlogPostShortLink(shortLinkRepo *database.ShortLinkRepo, baseURL string, blogPost models.BlogPost) (string, error) {
+	if baseURL == "" {
+		return "", nil
+	}
+	targetURL := services.BlogPostLink(blogPost, "")
+	if targetURL == "" {
+		return "", nil
+	}
+	shortLink, err := shortLinkRepo.ForContent(models.ContentTypeBlogPost, blogPost.ID, targetURL)
+	if err != nil {
+		return "", err
+	}
+	return ShortLinkURL(baseURL, shortLink.Code), nil
+}
+
+// ShortLinkURL returns the public URL of the short link with code
+func ShortLinkURL(baseURL, code string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/s/" + code
+}
+
+// shortLinker gives tweets a blog post's short link in place of its URL
+// It does nothing unless baseURL, the public URL /s/{code} is served under, is configured
+type shortLinker struct {
+	logger        zerolog.Logger
+	shortLinkRepo *database.ShortLinkRepo
+	baseURL       string
+}
+
+func newShortLinker(shortLinkRepo *database.ShortLinkRepo, baseURL string) *shortLinker {
+	logger := log.With().Str("handlerName", "shortLinker").Logger()
+	return &shortLinker{
+		logger:        logger,
+		shortLinkRepo: shortLinkRepo,
+		baseURL:       baseURL,
+	}
+}
+
+// addToLinks sets links["twitter"] to blogPost's short link when Twitter is among platforms and no tracked share
+// link is already standing in for the URL. A link that can't be made leaves the post's own URL
+func (s *shortLinker) addToLinks(links map[string]string, blogPost models.BlogPost, platforms []string) {
+	if s.baseURL == "" || !containsFold(platforms, "twitter") || links["twitter"] != "" {
+		return
+	}
+	link, err := BlogPostShortLink(s.shortLinkRepo, s.baseURL, blogPost)
+	if err != nil {
+		s.logger.Error().Err(err).Str("blogPostID", blogPost.ID.String()).Msg("Failed to create short link")
+		return
+	}
+	if link != "" {
+		links["twitter"] = link
+	}
+}
+
+type shortLinkHandler struct {
+	responder     Responder
+	logger        zerolog.Logger
+	shortLinkRepo *database.ShortLinkRepo
+	blogPostRepo  *database.BlogPostRepo
+	projectRepo   *database.ProjectRepo
+	baseURL       string
+}
+
+func newShortLinkHandler(shortLinkRepo *database.ShortLinkRepo, blogPostRepo *database.BlogPostRepo, projectRepo *database.ProjectRepo, baseURL string) shortLinkHandler {
+	logger := log.With().Str("handlerName", "shortLinkHandler").Logger()
+
+	return shortLinkHandler{
+		responder:     NewResponder(logger),
+		logger:        logger,
+		shortLinkRepo: shortLinkRepo,
+		blogPostRepo:  blogPostRepo,
+		projectRepo:   projectRepo,
+		baseURL:       baseURL,
+	}
+}
+
+// ShortLinkRequest is the body of POST /admin/short-link
+type ShortLinkRequest struct {
+	ContentType string     `json:"contentType" validate:"oneof=blogPost project url" example:"blogPost"`
+	ContentID   *uuid.UUID `json:"contentId,omitempty" format:"uuid"`
+	URL         string     `json:"url,omitempty" validate:"omitempty,httpurl" example:"https://github.com/rpupo63"`
+	Code        string     `json:"code,omitempty" example:"gh"`
+}
+
+// ShortLinkResponse is a short link with the URL it's shared as
+type ShortLinkResponse struct {
+	models.ShortLink
+	ShortURL string `json:"shortUrl,omitempty" example:"https://rp.dev/s/aZ3kQ9"`
+}
+
+// ShortLinkCollection is one page of short links
+type ShortLinkCollection struct {
+	Data  []ShortLinkResponse `json:"data"`
+	Meta  ListMeta            `json:"meta"`
+	Links ListLinks           `json:"links"`
+}
+
+// followShortLink counts a click on a short link and redirects to its target
+// @Summary Follow short link
+// @Description Counts one click on a short link and redirects to the blog post, project or URL it points to
+// @Tags Short Links
+// @Param code path string true "Short link code"
+// @Success 302 "Redirect to the linked content"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Unknown short link"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error recording click"
+// @Router /s/{code} [get]
+func (h shortLinkHandler) followShortLink() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		shortLink, err := h.shortLinkRepo.RecordClick(chi.URLParam(r, "code"))
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("record short link click", "short_link", err))
+			return
+		}
+
+		// Caches would hide repeat clicks
+		w.Header().Set("Cache-Control", "no-store")
+		http.Redirect(w, r, shortLink.TargetURL, http.StatusFound)
+	}
+}
+
+// getShortLinks retrieves one page of short links
+// @Summary Get short links
+// @Description Retrieves every short link, newest first, with its click count and, when SHORT_LINK_BASE_URL is set, the URL it's shared as
+// @Tags Short Links
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number (starts at 1)" default(1)
+// @Param perPage query int false "Short links per page (max 100)" default(20)
+// @Success 200 {object} ShortLinkCollection "Page of short links"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid pagination parameters"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching short links"
+// @Router /admin/short-links [get]
+func (h shortLinkHandler) getShortLinks() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pagination, err := parsePagination(r, defaultShortLinksPerPage, maxShortLinksPerPage)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		shortLinks, total, err := h.shortLinkRepo.FindPage(pagination.Offset(), pagination.PerPage)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find short links", "short_links", err))
+			return
+		}
+
+		meta, links := newListMetaAndLinks(r, pagination, total)
+		response := ShortLinkCollection{
+			Data:  make([]ShortLinkResponse, 0, len(shortLinks)),
+			Meta:  meta,
+			Links: links,
+		}
+		for _, shortLink := range shortLinks {
+			response.Data = append(response.Data, h.toResponse(*shortLink))
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// createShortLink creates a short link to a blog post, project or URL
+// @Summary Create short link
+// @Description Creates a short link, served at /s/{code}. A blog post or project gets at most one short link, so asking for one it already has returns that link with 200 instead; its target is updated if the content's URL has changed. Blog post links point at the post's URL, or BASE_URL/blog/{id}, and project links at BASE_URL/projects/{id}. code is generated unless given
+// @Tags Short Links
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param shortLink body ShortLinkRequest true "What to link to"
+// @Success 200 {object} ShortLinkResponse "Existing short link"
+// @Success 201 {object} ShortLinkResponse "Created short link"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid short link data, or no BASE_URL to link content to"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Blog post or project not found"
+// @Failure 409 {object} api.ErrorResponse "Conflict - Code already taken"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating short link"
+// @Router /admin/short-link [post]
+func (h shortLinkHandler) createShortLink() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			h.logger.Error().Err(err).Msg("Failed to read request body")
+			h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+			return
+		}
+
+		var request ShortLinkRequest
+		if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&request); err != nil {
+			h.logger.Error().Err(err).Msg("Failed to decode short link request body")
+			h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+			return
+		}
+		request.URL = strings.TrimSpace(request.URL)
+		request.Code = strings.TrimSpace(request.Code)
+		if err := validateRequest(&request); err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+		if request.Code != "" && !shortLinkCode.MatchString(request.Code) {
+			h.responder.WriteError(w, errs.NewInvalidFieldError("code", "must be up to 64 letters, digits, - or _"))
+			return
+		}
+
+		shortLink := models.ShortLink{Code: request.Code, ContentType: request.ContentType, DateAdded: time.Now()}
+		if request.ContentType == models.ContentTypeURL {
+			if request.URL == "" {
+				h.responder.WriteError(w, errs.NewInvalidFieldError("url", "is required for a url short link"))
+				return
+			}
+			shortLink.TargetURL = request.URL
+		} else {
+			if request.ContentID == nil {
+				h.responder.WriteError(w, errs.NewInvalidFieldError("contentId", "is required for a blogPost or project short link"))
+				return
+			}
+			targetURL, err := h.contentURL(request.ContentType, *request.ContentID)
+			if err != nil {
+				h.responder.WriteError(w, err)
+				return
+			}
+
+			_, err = h.shortLinkRepo.FindForContent(request.ContentType, *request.ContentID)
+			if err == nil {
+				existing, err := h.shortLinkRepo.ForContent(request.ContentType, *request.ContentID, targetURL)
+				if err != nil {
+					h.responder.WriteError(w, wrapDatabaseError("update short link", "short_link", err))
+					return
+				}
+				h.responder.WriteJSON(w, h.toResponse(*existing))
+				return
+			}
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				h.responder.WriteError(w, wrapDatabaseError("find short link", "short_link", err))
+				return
+			}
+			shortLink.ContentID = request.ContentID
+			shortLink.TargetURL = targetURL
+		}
+
+		if err := h.shortLinkRepo.Add(&shortLink); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create short link", "short_link", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, h.toResponse(shortLink))
+	}
+}
+
+// deleteShortLink deletes a short link
+// @Summary Delete short link
+// @Description Deletes a short link by ID, so its code stops redirecting. A blog post or project whose link is deleted gets a new code the next time one is made for it
+// @Tags Short Links
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param shortLinkID path string true "Short link ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid shortLinkID"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Short link not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting short link"
+// @Router /admin/short-link/{shortLinkID} [delete]
+func (h shortLinkHandler) deleteShortLink() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		shortLinkID, err := uuid.Parse(chi.URLParam(r, "shortLinkID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid shortLinkID"))
+			return
+		}
+
+		if err := h.shortLinkRepo.Delete(shortLinkID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete short link", "short_link", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "short link deleted successfully",
+		})
+	}
+}
+
+// contentURL returns the URL a short link to the blog post or project with id points at
+func (h shortLinkHandler) contentURL(contentType string, id uuid.UUID) (string, error) {
+	baseURL := services.GetBaseURL(config.New(), "")
+	switch contentType {
+	case models.ContentTypeBlogPost:
+		blogPost, err := h.blogPostRepo.FindByID(id)
+		if err != nil {
+			return "", wrapDatabaseError("find blog post", "blog_post", err)
+		}
+		if link := services.BlogPostLink(*blogPost, ""); link != "" {
+			return link, nil
+		}
+	default:
+		if _, err := h.projectRepo.FindByID(id); err != nil {
+			return "", wrapDatabaseError("find project", "project", err)
+		}
+		if link := services.BuildProjectURL(baseURL, id.String()); link != "" {
+			return link, nil
+		}
+	}
+	return "", errs.NewBadRequestError("BASE_URL must be set to link to a " + contentType)
+}
+
+// toResponse adds the URL a short link is shared as, when SHORT_LINK_BASE_URL is set
+func (h shortLinkHandler) toResponse(shortLink models.ShortLink) ShortLinkResponse {
+	response := ShortLinkResponse{ShortLink: shortLink}
+	if h.baseURL != "" {
+		response.ShortURL = ShortLinkURL(h.baseURL, shortLink.Code)
+	}
+	return response
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"encoding/xml"
+	"net/http"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// Sitemap is a sitemaps.org urlset, with an xhtml:link alternate for each locale a page is in
+type Sitemap struct {
+	XMLName xml.Name     `xml:"urlset"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	XHTML   string       `xml:"xmlns:xhtml,attr"`
+	URLs    []SitemapURL `xml:"url"`
+}
+
+// SitemapURL is one page of a sitemap
+type SitemapURL struct {
+	Loc        string             `xml:"loc"`
+	LastMod    string             `xml:"lastmod,omitempty"`
+	Alternates []SitemapAlternate `xml:"xhtml:link"`
+}
+
+// SitemapAlternate links a page to its version in another locale, as hreflang
+type SitemapAlternate struct {
+	Rel      string `xml:"rel,attr"`
+	Hreflang string `xml:"hreflang,attr"`
+	Href     string `xml:"href,attr"`
+}
+
+type sitemapHandler struct {
+	responder               Responder
+	logger                  zerolog.Logger
+	blogPostRepo            *database.BlogPostRepo
+	blogPostTranslationRepo *database.BlogPostTranslationRepo
+	locales                 *contentLocales
+}
+
+func newSitemapHandler(blogPostRepo *database.BlogPostRepo, blogPostTranslationRepo *database.BlogPostTranslationRepo, locales *contentLocales) sitemapHandler {
+	logger := log.With().Str("handlerName", "sitemapHandler").Logger()
+
+	return sitemapHandler{
+		responder:               NewResponder(logger),
+		logger:                  logger,
+		blogPostRepo:            blogPostRepo,
+		blogPostTranslationRepo: blogPostTranslationRepo,
+		locales:                 locales,
+	}
+}
+
+// getSitemap lists every published blog post in a sitemap
+// @Summary Get sitemap
+// @Description Lists every published blog post's page as a sitemaps.org XML sitemap. A translated post is listed once per locale, each with hreflang alternates for all of them and x-default for the original. Translations are linked with the locale as the first segment of the post's path, e.g. https://example.com/pt/blog/{id}. Posts without a url or BASE_URL to link to are left out
+// @Tags Feeds
+// @Produce xml
+// @Success 200 {string} string "XML sitemap"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching blog posts"
+// @Router /sitemap.xml [get]
+func (h sitemapHandler) getSitemap() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blogPosts, err := h.blogPostRepo.FindSitemapEntries()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog posts", "blog_posts", err))
+			return
+		}
+		translations, err := h.blogPostTranslationRepo.FindLocales()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find blog post translations", "blog_post_translations", err))
+			return
+		}
+
+		sitemap := Sitemap{
+			XMLNS: "http://www.sitemaps.org/schemas/sitemap/0.9",
+			XHTML: "http://www.w3.org/1999/xhtml",
+			URLs:  make([]SitemapURL, 0, len(blogPosts)),
+		}
+		for _, blogPost := range blogPosts {
+			pageURL := services.BlogPostLink(*blogPost, "")
+			if pageURL == "" {
+				continue
+			}
+			lastMod := blogPost.DateAdded
+			if blogPost.DateEdited != nil {
+				lastMod = *blogPost.DateEdited
+			}
+
+			// Only locales that are still configured are linked, so removing one from CONTENT_LOCALES unlists it
+			locales := []string{h.locales.defaultLocale()}
+			for _, locale := range translations[blogPost.ID] {
+				if configured, ok := h.locales.translationLocale(locale); ok {
+					locales = append(locales, configured)
+				}
+			}
+
+			var alternates []SitemapAlternate
+			if len(locales) > 1 {
+				for _, locale := range locales {
+					alternates = append(alternates, SitemapAlternate{Rel: "alternate", Hreflang: locale, Href: h.locales.localizedURL(pageURL, locale)})
+				}
+				alternates = append(alternates, SitemapAlternate{Rel: "alternate", Hreflang: "x-default", Href: pageURL})
+			}
+			for _, locale := range locales {
+				sitemap.URLs = append(sitemap.URLs, SitemapURL{
+					Loc:        h.locales.localizedURL(pageURL, locale),
+					LastMod:    lastMod.UTC().Format(time.RFC3339),
+					Alternates: alternates,
+				})
+			}
+		}
+
+		data, err := xml.MarshalIndent(sitemap, "", "  ")
+		if err != nil {
+			h.responder.WriteError(w, errs.NewInternalErrorWithCause("failed to encode sitemap", err))
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		if _, err := w.Write(append([]byte(xml.Header), data...)); err != nil {
+			h.logger.Error().Err(err).Msg("error writing sitemap")
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"gorm.io/datatypes"
+)
+
+type skillHandler struct {
+	responder   Responder
+	logger      zerolog.Logger
+	skillRepo   *database.SkillRepo
+	projectRepo *database.ProjectRepo
+}
+
+func newSkillHandler(skillRepo *database.SkillRepo, projectRepo *database.ProjectRepo) skillHandler {
+	logger := log.With().Str("handlerName", "skillHandler").Logger()
+
+	return skillHandler{
+		responder:   NewResponder(logger),
+		logger:      logger,
+		skillRepo:   skillRepo,
+		projectRepo: projectRepo,
+	}
+}
+
+// SkillCollection represents multiple skills
+type SkillCollection struct {
+	Skills []models.Skill `json:"skills"`
+	Total  int            `json:"total,omitempty"`
+}
+
+// getAllSkills retrieves all skills, optionally filtered by category
+// @Summary Get all skills
+// @Description Retrieves all skills ordered by category and name. Pass category to only return skills in that category
+// @Tags Skills
+// @Accept json
+// @Produce json
+// @Param category query string false "Only return skills in this category"
+// @Success 200 {object} SkillCollection "List of skills"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching skills"
+// @Router /skills [get]
+func (h skillHandler) getAllSkills() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var skills []*models.Skill
+		var err error
+		if category := r.URL.Query().Get("category"); category != "" {
+			skills, err = h.skillRepo.FindByCategory(category)
+		} else {
+			skills, err = h.skillRepo.FindAll()
+		}
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find skills", "skills", err))
+			return
+		}
+
+		response := SkillCollection{
+			Skills: make([]models.Skill, 0, len(skills)),
+			Total:  len(skills),
+		}
+		for _, skill := range skills {
+			response.Skills = append(response.Skills, *skill)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// getSkill retrieves a specific skill by ID
+// @Summary Get skill
+// @Description Retrieves a specific skill by ID
+// @Tags Skills
+// @Accept json
+// @Produce json
+// @Param skillID path string true "Skill ID" format(uuid)
+// @Success 200 {object} models.Skill "Skill details"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid skillID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Skill not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching skill"
+// @Router /skill/{skillID} [get]
+func (h skillHandler) getSkill() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		skillIDStr := chi.URLParam(r, "skillID")
+		if skillIDStr == "" {
+			h.responder.WriteError(w, errs.NewBadRequestError("missing skillID"))
+			return
+		}
+
+		skillID, err := uuid.Parse(skillIDStr)
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid skillID"))
+			return
+		}
+
+		skill, err := h.skillRepo.FindByID(skillID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find skill", "skill", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, skill)
+	}
+}
+
+// createSkill creates a new skill
+// @Summary Create skill
+// @Description Creates a new skill in the database
+// @Tags Skills
+// @Accept json
+// @Produce json
+// @Param skill body models.Skill true "Skill data"
+// @Success 201 {object} models.Skill "Created skill"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid skill data"
+// @Failure 409 {object} api.ErrorResponse "Conflict - Skill already exists"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating skill"
+// @Router /skill [post]
+func (h skillHandler) createSkill() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		skill, ok := h.decodeSkill(w, r)
+		if !ok {
+			return
+		}
+
+		if err := h.skillRepo.Add(skill); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create skill", "skill", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, skill)
+	}
+}
+
+// updateSkill updates an existing skill
+// @Summary Update skill
+// @Description Updates an existing skill in the database
+// @Tags Skills
+// @Accept json
+// @Produce json
+// @Param skillID path string true "Skill ID" format(uuid)
+// @Param skill body models.Skill true "Updated skill data"
+// @Success 200 {object} models.Skill "Updated skill"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid skill data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Skill not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error updating skill"
+// @Router /skill/{skillID} [put]
+func (h skillHandler) updateSkill() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		skillIDStr := chi.URLParam(r, "skillID")
+		if skillIDStr == "" {
+			h.responder.WriteError(w, errs.NewBadRequestError("missing skillID"))
+			return
+		}
+
+		skillID, err := uuid.Parse(skillIDStr)
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid skillID"))
+			return
+		}
+
+		// Verify skill exists
+		if _, err := h.skillRepo.FindByID(skillID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find skill", "skill", err))
+			return
+		}
+
+		skill, ok := h.decodeSkill(w, r)
+		if !ok {
+			return
+		}
+
+		// Ensure ID matches
+		skill.ID = skillID
+
+		if err := h.skillRepo.Update(skill); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update skill", "skill", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, skill)
+	}
+}
+
+// deleteSkill deletes a skill by ID
+// @Summary Delete skill
+// @Description Deletes a skill from the database by ID
+// @Tags Skills
+// @Accept json
+// @Produce json
+// @Param skillID path string true "Skill ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid skillID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Skill not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting skill"
+// @Router /skill/{skillID} [delete]
+func (h skillHandler) deleteSkill() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		skillIDStr := chi.URLParam(r, "skillID")
+		if skillIDStr == "" {
+			h.responder.WriteError(w, errs.NewBadRequestError("missing skillID"))
+			return
+		}
+
+		skillID, err := uuid.Parse(skillIDStr)
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid skillID"))
+			return
+		}
+
+		// Verify skill exists
+		if _, err := h.skillRepo.FindByID(skillID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find skill", "skill", err))
+			return
+		}
+
+		if err := h.skillRepo.Delete(skillID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete skill", "skill", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "skill deleted successfully",
+		})
+	}
+}
+
+// decodeSkill reads and validates a skill from the request body
+// It writes the error response itself and returns false when the body is invalid
+func (h skillHandler) decodeSkill(w http.ResponseWriter, r *http.Request) (*models.Skill, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var skill models.Skill
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&skill); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode skill request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	if err := validateRequest(&skill); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+
+	if skill.ProjectIDs == nil {
+		skill.ProjectIDs = datatypes.JSONSlice[uuid.UUID]{}
+	}
+
+	// Every related project must exist so the frontend can link to it
+	if len(skill.ProjectIDs) > 0 {
+		seen := make(map[uuid.UUID]bool, len(skill.ProjectIDs))
+		uniqueIDs := datatypes.JSONSlice[uuid.UUID]{}
+		for _, id := range skill.ProjectIDs {
+			if !seen[id] {
+				seen[id] = true
+				uniqueIDs = append(uniqueIDs, id)
+			}
+		}
+
+		count, err := h.projectRepo.CountByIDs(uniqueIDs)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find related projects", "projects", err))
+			return nil, false
+		}
+		if int(count) != len(uniqueIDs) {
+			h.responder.WriteError(w, errs.NewInvalidFieldError("projectIds", "one or more projects do not exist"))
+			return nil, false
+		}
+		skill.ProjectIDs = uniqueIDs
+	}
+
+	return &skill, true
+}
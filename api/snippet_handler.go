@@ -0,0 +1,286 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultSnippetsPerPage = 20
+	maxSnippetsPerPage     = database.MaxPageSize
+)
+
+type snippetHandler struct {
+	responder   Responder
+	logger      zerolog.Logger
+	snippetRepo *database.SnippetRepo
+}
+
+func newSnippetHandler(snippetRepo *database.SnippetRepo) snippetHandler {
+	logger := log.With().Str("handlerName", "snippetHandler").Logger()
+
+	return snippetHandler{
+		responder:   NewResponder(logger),
+		logger:      logger,
+		snippetRepo: snippetRepo,
+	}
+}
+
+// SnippetCollection represents one page of the snippets gallery
+type SnippetCollection struct {
+	Data  []models.Snippet `json:"data"`
+	Meta  ListMeta         `json:"meta"`
+	Links ListLinks        `json:"links"`
+}
+
+// getSnippets retrieves one page of snippets
+// @Summary Get snippets
+// @Description Retrieves the code snippets gallery, newest first, optionally only those in one language or with one tag
+// @Tags Snippets
+// @Accept json
+// @Produce json
+// @Param language query string false "Only snippets in this language, e.g. go (case-insensitive)"
+// @Param tag query string false "Only snippets with this tag"
+// @Param page query int false "Page number (starts at 1)" default(1)
+// @Param perPage query int false "Snippets per page (max 100)" default(20)
+// @Success 200 {object} SnippetCollection "Page of snippets"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid pagination parameters"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching snippets"
+// @Router /snippets [get]
+func (h snippetHandler) getSnippets() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pagination, err := parsePagination(r, defaultSnippetsPerPage, maxSnippetsPerPage)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		language := strings.TrimSpace(r.URL.Query().Get("language"))
+		tag := strings.TrimSpace(r.URL.Query().Get("tag"))
+		snippets, total, err := h.snippetRepo.FindPage(language, tag, pagination.Offset(), pagination.PerPage)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find snippets", "snippets", err))
+			return
+		}
+
+		meta, links := newListMetaAndLinks(r, pagination, total)
+		response := SnippetCollection{
+			Data:  make([]models.Snippet, 0, len(snippets)),
+			Meta:  meta,
+			Links: links,
+		}
+		for _, snippet := range snippets {
+			response.Data = append(response.Data, *snippet)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// getSnippet retrieves a specific snippet by ID
+// @Summary Get snippet
+// @Description Retrieves a specific code snippet by ID
+// @Tags Snippets
+// @Accept json
+// @Produce json
+// @Param snippetID path string true "Snippet ID" format(uuid)
+// @Success 200 {object} models.Snippet "Snippet details"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid snippetID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Snippet not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching snippet"
+// @Router /snippet/{snippetID} [get]
+func (h snippetHandler) getSnippet() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		snippetID, ok := h.parseSnippetID(w, r)
+		if !ok {
+			return
+		}
+
+		snippet, err := h.snippetRepo.FindByID(snippetID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find snippet", "snippet", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, snippet)
+	}
+}
+
+// createSnippet creates a new snippet
+// @Summary Create snippet
+// @Description Creates a new code snippet. Blog posts embed it with a snippet shortcode holding its ID on a line of its own (see the README), which the markdown export replaces with a fenced code block
+// @Tags Snippets
+// @Accept json
+// @Produce json
+// @Param snippet body models.Snippet true "Snippet data"
+// @Success 201 {object} models.Snippet "Created snippet"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid snippet data"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating snippet"
+// @Router /snippet [post]
+func (h snippetHandler) createSnippet() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		snippet, ok := h.decodeSnippet(w, r)
+		if !ok {
+			return
+		}
+
+		snippet.ID = uuid.Nil
+		snippet.DateAdded = time.Now()
+		snippet.DateEdited = nil
+
+		if err := h.snippetRepo.Add(snippet); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create snippet", "snippet", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, snippet)
+	}
+}
+
+// updateSnippet updates an existing snippet
+// @Summary Update snippet
+// @Description Updates an existing code snippet. Posts embedding it show the new code the next time they're exported
+// @Tags Snippets
+// @Accept json
+// @Produce json
+// @Param snippetID path string true "Snippet ID" format(uuid)
+// @Param snippet body models.Snippet true "Updated snippet data"
+// @Success 200 {object} models.Snippet "Updated snippet"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid snippet data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Snippet not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error updating snippet"
+// @Router /snippet/{snippetID} [put]
+func (h snippetHandler) updateSnippet() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		snippetID, ok := h.parseSnippetID(w, r)
+		if !ok {
+			return
+		}
+
+		existingSnippet, err := h.snippetRepo.FindByID(snippetID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find snippet", "snippet", err))
+			return
+		}
+
+		snippet, ok := h.decodeSnippet(w, r)
+		if !ok {
+			return
+		}
+
+		// Ensure ID matches and keep the original creation date
+		now := time.Now()
+		snippet.ID = snippetID
+		snippet.DateAdded = existingSnippet.DateAdded
+		snippet.DateEdited = &now
+
+		if err := h.snippetRepo.Update(snippet); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update snippet", "snippet", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, snippet)
+	}
+}
+
+// deleteSnippet deletes a snippet by ID
+// @Summary Delete snippet
+// @Description Deletes a code snippet by ID. Shortcodes embedding it are left in posts as they are
+// @Tags Snippets
+// @Accept json
+// @Produce json
+// @Param snippetID path string true "Snippet ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid snippetID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Snippet not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting snippet"
+// @Router /snippet/{snippetID} [delete]
+func (h snippetHandler) deleteSnippet() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		snippetID, ok := h.parseSnippetID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify snippet exists
+		if _, err := h.snippetRepo.FindByID(snippetID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find snippet", "snippet", err))
+			return
+		}
+
+		if err := h.snippetRepo.Delete(snippetID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete snippet", "snippet", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "snippet deleted successfully",
+		})
+	}
+}
+
+// parseSnippetID reads the snippetID path parameter, writing a 400 if it is missing or invalid
+func (h snippetHandler) parseSnippetID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	snippetIDStr := chi.URLParam(r, "snippetID")
+	if snippetIDStr == "" {
+		h.responder.WriteError(w, errs.NewBadRequestError("missing snippetID"))
+		return uuid.Nil, false
+	}
+
+	snippetID, err := uuid.Parse(snippetIDStr)
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid snippetID"))
+		return uuid.Nil, false
+	}
+
+	return snippetID, true
+}
+
+// decodeSnippet reads and validates a snippet from the request body
+// Tags are trimmed and empty ones dropped. It writes the error response itself and returns false when the body is invalid
+func (h snippetHandler) decodeSnippet(w http.ResponseWriter, r *http.Request) (*models.Snippet, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var snippet models.Snippet
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&snippet); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode snippet request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	snippet.Title = strings.TrimSpace(snippet.Title)
+	snippet.Language = strings.ToLower(strings.TrimSpace(snippet.Language))
+
+	if err := validateRequest(&snippet); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+
+	// A space in the language would end the fence's info string
+	if strings.ContainsAny(snippet.Language, " \t\n`") {
+		h.responder.WriteError(w, errs.NewInvalidFieldError("language", "language can't contain spaces or backticks"))
+		return nil, false
+	}
+	snippet.Tags = trimStrings(snippet.Tags)
+
+	return &snippet, true
+}
@@ -0,0 +1,192 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultSocialPostsPerPage = 20
+	maxSocialPostsPerPage     = database.MaxPageSize
+)
+
+type socialPostHandler struct {
+	responder      Responder
+	logger         zerolog.Logger
+	socialPostRepo *database.SocialPostRepo
+}
+
+func newSocialPostHandler(socialPostRepo *database.SocialPostRepo) socialPostHandler {
+	logger := log.With().Str("handlerName", "socialPostHandler").Logger()
+
+	return socialPostHandler{
+		responder:      NewResponder(logger),
+		logger:         logger,
+		socialPostRepo: socialPostRepo,
+	}
+}
+
+// SocialPostCollection represents one page of social post records
+type SocialPostCollection struct {
+	Data  []models.SocialPost `json:"data"`
+	Meta  ListMeta            `json:"meta"`
+	Links ListLinks           `json:"links"`
+}
+
+// getSocialPosts retrieves one page of social post records
+// @Summary Get social posts
+// @Description Retrieves the record of every attempt to share a blog post or note on a social platform, newest first, with the tracked share link and its click count when one was used
+// @Tags Social Posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param contentType query string false "Only include this content type" Enums(blogPost, note)
+// @Param contentId query string false "Only include this blog post or note" format(uuid)
+// @Param page query int false "Page number (starts at 1)" default(1)
+// @Param perPage query int false "Social posts per page (max 100)" default(20)
+// @Success 200 {object} SocialPostCollection "Page of social posts"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid filter or pagination parameters"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching social posts"
+// @Router /admin/social-posts [get]
+func (h socialPostHandler) getSocialPosts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		contentType := r.URL.Query().Get("contentType")
+		if contentType != "" && contentType != models.ContentTypeBlogPost && contentType != models.ContentTypeNote {
+			h.responder.WriteError(w, errs.NewInvalidFieldError("contentType", "must be blogPost or note"))
+			return
+		}
+
+		var contentID *uuid.UUID
+		if contentIDStr := r.URL.Query().Get("contentId"); contentIDStr != "" {
+			parsed, err := uuid.Parse(contentIDStr)
+			if err != nil {
+				h.responder.WriteError(w, errs.NewInvalidFieldError("contentId", "must be a UUID"))
+				return
+			}
+			contentID = &parsed
+		}
+
+		pagination, err := parsePagination(r, defaultSocialPostsPerPage, maxSocialPostsPerPage)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		socialPosts, total, err := h.socialPostRepo.FindPage(contentType, contentID, pagination.Offset(), pagination.PerPage)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find social posts", "social_posts", err))
+			return
+		}
+
+		meta, links := newListMetaAndLinks(r, pagination, total)
+		response := SocialPostCollection{
+			Data:  make([]models.SocialPost, 0, len(socialPosts)),
+			Meta:  meta,
+			Links: links,
+		}
+		for _, socialPost := range socialPosts {
+			response.Data = append(response.Data, *socialPost)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// CredentialHealth is the outcome of checking one platform's credentials
+type CredentialHealth struct {
+	Platform  string     `json:"platform" example:"linkedin"`
+	Status    string     `json:"status" enums:"ok,expiring,invalid,unconfigured,unreachable" example:"expiring"`
+	Account   string     `json:"account,omitempty" example:"urn:li:person:abc123"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// CredentialHealthReport is the outcome of checking every requested platform's credentials
+type CredentialHealthReport struct {
+	Healthy   bool               `json:"healthy"`
+	Platforms []CredentialHealth `json:"platforms"`
+}
+
+// getCredentialHealth checks that each platform's credentials still work
+// @Summary Check social platform credentials
+// @Description Verifies each configured platform's credentials with a lightweight authenticated call that posts nothing, reporting the account they belong to and, where the platform says, when the token expires. Tokens expiring within 14 days are reported as expiring. healthy is false when any configured platform's credentials are invalid, expiring or couldn't be checked; unconfigured platforms don't count against it
+// @Tags Social Posts
+// @Produce json
+// @Security BearerAuth
+// @Param platforms query string false "Comma-separated platforms to check (default all). Valid values: substack, medium, twitter, linkedin, mastodon"
+// @Success 200 {object} CredentialHealthReport "Credential status of each platform"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Router /admin/social/health [get]
+func (h socialPostHandler) getCredentialHealth() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		platforms := services.CredentialPlatforms
+		if platformsParam := r.URL.Query().Get("platforms"); platformsParam != "" {
+			platforms = strings.Split(platformsParam, ",")
+			for i := range platforms {
+				platforms[i] = strings.TrimSpace(platforms[i])
+			}
+		}
+
+		checks := services.CheckCredentials(r.Context(), platforms)
+		report := CredentialHealthReport{
+			Healthy:   services.CredentialsHealthy(checks),
+			Platforms: make([]CredentialHealth, 0, len(checks)),
+		}
+		for _, check := range checks {
+			health := CredentialHealth{
+				Platform:  check.Platform,
+				Status:    check.Status,
+				Account:   check.Account,
+				ExpiresAt: check.ExpiresAt,
+			}
+			if check.Err != nil {
+				health.Error = check.Err.Error()
+			}
+			report.Platforms = append(report.Platforms, health)
+		}
+		if !report.Healthy {
+			h.logger.Warn().Interface("platforms", report.Platforms).Msg("Some social platform credentials need attention")
+		}
+
+		h.responder.WriteJSON(w, report)
+	}
+}
+
+// recordSocialPosts stores the outcome of each platform a blog post or note was shared to
+// shareLinks holds the tracked link used on each platform, if any. Failures are only logged, since the content itself was already saved
+func recordSocialPosts(logger zerolog.Logger, socialPostRepo *database.SocialPostRepo, contentType string, contentID uuid.UUID, results []services.PlatformResult, shareLinks map[string]models.ShareLink) {
+	now := time.Now()
+	socialPosts := make([]models.SocialPost, 0, len(results))
+	for _, result := range results {
+		socialPost := models.SocialPost{
+			ContentType: contentType,
+			ContentID:   contentID,
+			Platform:    result.Platform,
+			Status:      models.SocialPostStatusSucceeded,
+			DateAdded:   now,
+		}
+		if result.Err != nil {
+			message := result.Err.Error()
+			socialPost.Status = models.SocialPostStatusFailed
+			socialPost.Error = &message
+		}
+		if shareLink, ok := shareLinks[result.Platform]; ok {
+			socialPost.ShareLinkID = &shareLink.ID
+		}
+		socialPosts = append(socialPosts, socialPost)
+	}
+
+	if err := socialPostRepo.AddAll(socialPosts); err != nil {
+		logger.Error().Err(err).Str("contentType", contentType).Str("contentId", contentID.String()).Msg("Failed to record social posts")
+	}
+}
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/rpupo63/unified-personal-site-backend/docs"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// specValidator checks requests against the generated Swagger spec before they reach a handler, so the
+// parameters and bodies the docs describe are the ones the API accepts
+type specValidator struct {
+	responder Responder
+	logger    zerolog.Logger
+	router    routers.Router
+}
+
+// newSpecValidator loads the spec registered by the docs package
+func newSpecValidator() (*specValidator, error) {
+	logger := log.With().Str("handlerName", "specValidator").Logger()
+
+	var spec openapi2.T
+	if err := json.Unmarshal([]byte(docs.SwaggerInfo.ReadDoc()), &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse API spec: %w", err)
+	}
+	specV3, err := openapi2conv.ToV3(&spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert API spec to OpenAPI 3: %w", err)
+	}
+
+	// Match paths alone, whatever host and scheme the request came in on
+	specV3.Servers = openapi3.Servers{{URL: "/"}}
+
+	router, err := gorillamux.NewRouter(specV3)
+	if err != nil {
+		return nil, fmt.Errorf("failed to route API spec: %w", err)
+	}
+
+	return &specValidator{
+		responder: NewResponder(logger),
+		logger:    logger,
+		router:    router,
+	}, nil
+}
+
+// middleware rejects requests whose parameters or body don't match their operation in the spec
+// Every problem is reported at once as a validation error. Requests to routes the spec doesn't document pass through
+func (v *specValidator) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, err := v.router.FindRoute(r)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		validated := withDocumentedContentType(r, route)
+		input := &openapi3filter.RequestValidationInput{
+			Request:    validated,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				// Authentication is checked by the routes themselves
+				AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+				MultiError:          true,
+				SkipSettingDefaults: true,
+				ExcludeRequestBody:  isUndocumentedUpload(r, route),
+			},
+		}
+		if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+			v.responder.WriteError(w, errs.NewValidationError(specFieldErrors(err)))
+			return
+		}
+
+		// Validating read the body, leaving a copy of it in its place
+		r.Body = validated.Body
+		next.ServeHTTP(w, r)
+	})
+}
+
+// withDocumentedContentType returns r, or a copy of it labeled as JSON when the operation takes a JSON body but the
+// request says it's something the spec doesn't list
+// Handlers decode bodies as JSON whatever their Content-Type, e.g. text/plain from navigator.sendBeacon, so they're
+// validated the same way
+func withDocumentedContentType(r *http.Request, route *routers.Route) *http.Request {
+	requestBody := route.Operation.RequestBody
+	if requestBody == nil || requestBody.Value == nil {
+		return r
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if requestBody.Value.GetMediaType(mediaType) != nil || requestBody.Value.GetMediaType("application/json") == nil {
+		return r
+	}
+	clone := r.Clone(r.Context())
+	clone.Header.Set("Content-Type", "application/json")
+	return clone
+}
+
+// isUndocumentedUpload reports whether r uploads a form to an operation whose spec only describes its JSON body, like
+// POST /blog-posts/import taking a zip in place of its documents
+// Swagger 2 can't give one operation both body and form parameters, so such uploads are left to the handler to check
+func isUndocumentedUpload(r *http.Request, route *routers.Route) bool {
+	requestBody := route.Operation.RequestBody
+	if requestBody == nil || requestBody.Value == nil {
+		return false
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		return false
+	}
+	form := requestBody.Value.GetMediaType(mediaType)
+	return form != nil && form.Schema != nil && form.Schema.Value != nil && !form.Schema.Value.Type.Is(openapi3.TypeObject)
+}
+
+// specFieldErrors turns the errors from openapi3filter into one entry per failing parameter or body field
+func specFieldErrors(err error) []errs.FieldError {
+	var multi openapi3.MultiError
+	if !errors.As(err, &multi) {
+		multi = openapi3.MultiError{err}
+	}
+
+	var fields []errs.FieldError
+	for _, err := range multi {
+		var requestErr *openapi3filter.RequestError
+		if !errors.As(err, &requestErr) {
+			fields = append(fields, errs.FieldError{Field: "request", Message: err.Error()})
+			continue
+		}
+
+		field := "body"
+		if requestErr.Parameter != nil {
+			field = requestErr.Parameter.Name
+		}
+
+		// Body errors may hold several schema errors, one per field
+		var schemaErrs openapi3.MultiError
+		if !errors.As(requestErr.Err, &schemaErrs) {
+			schemaErrs = openapi3.MultiError{requestErr.Err}
+		}
+		for _, err := range schemaErrs {
+			var schemaErr *openapi3.SchemaError
+			switch {
+			case errors.As(err, &schemaErr):
+				path := field
+				if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+					path = strings.Join(pointer, ".")
+				}
+				fields = append(fields, errs.FieldError{Field: path, Message: schemaErr.Reason})
+			case err != nil:
+				fields = append(fields, errs.FieldError{Field: field, Message: err.Error()})
+			default:
+				fields = append(fields, errs.FieldError{Field: field, Message: requestErr.Reason})
+			}
+		}
+	}
+	return fields
+}
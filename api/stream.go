@@ -0,0 +1,86 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// JSONArrayStream writes a {"data":[...],"count":n} response one item at a time, so large exports are sent in chunks
+// as they're read instead of being marshaled into memory first, as WriteJSON does
+// Nothing is written until the first item, so an error before then still gets a normal error response
+type JSONArrayStream struct {
+	responder  Responder
+	w          http.ResponseWriter
+	controller *http.ResponseController
+	encoder    *json.Encoder
+	count      int
+	started    bool
+}
+
+// StreamJSONArray starts a streamed response; call Write for each item, then Close, or Fail on error
+func (r Responder) StreamJSONArray(w http.ResponseWriter) *JSONArrayStream {
+	return &JSONArrayStream{
+		responder:  r,
+		w:          w,
+		controller: http.NewResponseController(w),
+		encoder:    json.NewEncoder(w),
+	}
+}
+
+func (s *JSONArrayStream) start() error {
+	if s.started {
+		return nil
+	}
+	s.started = true
+	s.w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	s.w.WriteHeader(http.StatusOK)
+	_, err := s.w.Write([]byte(`{"data":[`))
+	return err
+}
+
+// Write appends item to the array
+func (s *JSONArrayStream) Write(item any) error {
+	if err := s.start(); err != nil {
+		return err
+	}
+	if s.count > 0 {
+		if _, err := s.w.Write([]byte(",")); err != nil {
+			return err
+		}
+	}
+	// Encode writes straight to w, followed by a newline that's valid JSON whitespace
+	if err := s.encoder.Encode(item); err != nil {
+		return err
+	}
+	s.count++
+	return nil
+}
+
+// Flush sends what's been written so far to the client, e.g. after each batch read from the database
+func (s *JSONArrayStream) Flush() error {
+	if err := s.controller.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
+
+// Close ends the array and the response
+func (s *JSONArrayStream) Close() error {
+	if err := s.start(); err != nil {
+		return err
+	}
+	_, err := s.w.Write([]byte(`],"count":` + strconv.Itoa(s.count) + "}\n"))
+	return err
+}
+
+// Fail reports err to the client if nothing has been sent yet. Otherwise the status is already out, so the response
+// is left unterminated: the client gets invalid JSON rather than a partial export that looks complete
+func (s *JSONArrayStream) Fail(err error) {
+	if !s.started {
+		s.responder.WriteError(s.w, err)
+		return
+	}
+	s.responder.logger.Error().Err(err).Int("itemsSent", s.count).Msg("Streamed response failed partway, leaving it truncated")
+}
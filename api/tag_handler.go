@@ -0,0 +1,282 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// Tag is a tag and how many blog posts and projects carry it, drafts and the trash included
+// Tags are values on each post and project rather than rows of their own, so a tag's value is its ID
+type Tag struct {
+	Value     string `json:"value" example:"go"`
+	BlogPosts int    `json:"blogPosts" example:"12"`
+	Projects  int    `json:"projects" example:"3"`
+	Total     int    `json:"total" example:"15"`
+}
+
+// TagCollection is every tag in use, most used first
+type TagCollection struct {
+	Data []Tag `json:"data"`
+}
+
+// TagRename is the body of PUT /tag/{id}
+type TagRename struct {
+	Value string `json:"value" example:"golang"`
+}
+
+// TagMerge is the body of POST /tags/merge: the tag from is moved onto into
+type TagMerge struct {
+	From string `json:"from" example:"golang"`
+	Into string `json:"into" example:"go"`
+}
+
+type tagHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+	database  database.Database
+}
+
+func newTagHandler(database database.Database) tagHandler {
+	logger := log.With().Str("handlerName", "tagHandler").Logger()
+
+	return tagHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+		database:  database,
+	}
+}
+
+// getTags lists every tag with its usage
+// @Summary Get tags
+// @Description Lists every tag on a blog post or project, with how many of each carry it, most used first and then alphabetically. Drafts, scheduled posts and the trash are counted, since renaming, merging and deleting tags changes them too
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} TagCollection "Tags with their usage"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching tags"
+// @Router /tags [get]
+func (h tagHandler) getTags() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		usage, err := h.database.FindTagUsage()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find tags", "tags", err))
+			return
+		}
+
+		response := TagCollection{Data: make([]Tag, 0, len(usage))}
+		for _, tag := range usage {
+			response.Data = append(response.Data, newTag(tag))
+		}
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// renameTag renames a tag on every blog post and project carrying it
+// @Summary Rename tag
+// @Description Renames a tag on every blog post and project carrying it. The tag is named by its value, URL-escaped. Renaming to a tag that's already in use is refused; merge into it with POST /tags/merge instead
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Tag value"
+// @Param tag body TagRename true "New value"
+// @Success 200 {object} Tag "Renamed tag"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Missing or invalid value"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Tag not found"
+// @Failure 409 {object} api.ErrorResponse "Conflict - The new value is already a tag"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error renaming tag"
+// @Router /tag/{id} [put]
+func (h tagHandler) renameTag() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		value, ok := h.tagValue(w, r)
+		if !ok {
+			return
+		}
+
+		var request TagRename
+		if !h.decode(w, r, &request) {
+			return
+		}
+		request.Value = strings.TrimSpace(request.Value)
+		if request.Value == "" {
+			h.responder.WriteError(w, errs.NewMissingRequiredFieldError("value"))
+			return
+		}
+
+		tag, err := h.database.FindTag(value)
+		if err != nil {
+			h.responder.WriteError(w, tagError("find tag", err))
+			return
+		}
+		if request.Value == value {
+			h.responder.WriteJSON(w, newTag(tag))
+			return
+		}
+		if _, err := h.database.FindTag(request.Value); err == nil {
+			h.responder.WriteError(w, errs.NewConflictError(strconv.Quote(request.Value)+" is already a tag; merge into it with POST /tags/merge").WithCode(errs.EntityCode("tag", errs.CodeSuffixDuplicate)))
+			return
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			h.responder.WriteError(w, wrapDatabaseError("find tag", "tags", err))
+			return
+		}
+
+		renamed, err := h.database.MergeTag(value, request.Value)
+		if err != nil {
+			h.responder.WriteError(w, tagError("rename tag", err))
+			return
+		}
+		h.responder.WriteJSON(w, newTag(renamed))
+	}
+}
+
+// deleteTag removes a tag from every blog post and project carrying it
+// @Summary Delete tag
+// @Description Removes a tag from every blog post and project carrying it, including drafts and the trash. The posts and projects themselves are kept. The tag is named by its value, URL-escaped
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Tag value"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Tag not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting tag"
+// @Router /tag/{id} [delete]
+func (h tagHandler) deleteTag() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		value, ok := h.tagValue(w, r)
+		if !ok {
+			return
+		}
+
+		removed, err := h.database.DeleteTag(value)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete tag", "tags", err))
+			return
+		}
+		if removed == 0 {
+			h.responder.WriteError(w, tagError("delete tag", gorm.ErrRecordNotFound))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "tag removed from " + strconv.FormatInt(removed, 10) + " blog posts and projects",
+		})
+	}
+}
+
+// mergeTags moves one tag's blog posts and projects onto another
+// @Summary Merge tags
+// @Description Moves the tag from onto the tag into on every blog post and project carrying it, then from is gone. Posts and projects carrying both keep into once. into needn't be in use yet, though renaming with PUT /tag/{id} says so more plainly
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param merge body TagMerge true "Tags to merge"
+// @Success 200 {object} Tag "The merged tag"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Missing from or into, or they're the same"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized - Admin authentication required"
+// @Failure 404 {object} api.ErrorResponse "Not Found - The from tag isn't in use"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error merging tags"
+// @Router /tags/merge [post]
+func (h tagHandler) mergeTags() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var request TagMerge
+		if !h.decode(w, r, &request) {
+			return
+		}
+		request.From = strings.TrimSpace(request.From)
+		request.Into = strings.TrimSpace(request.Into)
+		if request.From == "" {
+			h.responder.WriteError(w, errs.NewMissingRequiredFieldError("from"))
+			return
+		}
+		if request.Into == "" {
+			h.responder.WriteError(w, errs.NewMissingRequiredFieldError("into"))
+			return
+		}
+		if request.From == request.Into {
+			h.responder.WriteError(w, errs.NewInvalidFieldError("into", "must differ from from"))
+			return
+		}
+
+		if _, err := h.database.FindTag(request.From); err != nil {
+			h.responder.WriteError(w, tagError("find tag", err))
+			return
+		}
+		merged, err := h.database.MergeTag(request.From, request.Into)
+		if err != nil {
+			h.responder.WriteError(w, tagError("merge tags", err))
+			return
+		}
+		h.responder.WriteJSON(w, newTag(merged))
+	}
+}
+
+// tagValue reads the tag named by the id path parameter, writing the error response itself and returning false
+// when it's blank or badly escaped
+func (h tagHandler) tagValue(w http.ResponseWriter, r *http.Request) (string, bool) {
+	value := chi.URLParam(r, "id")
+	var err error
+	// chi routes on the escaped path when the request has one, as it does for a value with a slash in it
+	if r.URL.RawPath != "" {
+		value, err = url.PathUnescape(value)
+	}
+	if err != nil || strings.TrimSpace(value) == "" {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid tag"))
+		return "", false
+	}
+	return value, true
+}
+
+// decode reads a JSON request body into request, writing the error response itself and returning false when it's
+// malformed
+func (h tagHandler) decode(w http.ResponseWriter, r *http.Request, request any) bool {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return false
+	}
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(request); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode tag request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return false
+	}
+	return true
+}
+
+// tagError reports a tag nothing carries as not found, and other errors as the database failing op
+func tagError(op string, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errs.NewNotFoundError("tag not found").WithCode(errs.EntityCode("tag", errs.CodeSuffixNotFound))
+	}
+	return wrapDatabaseError(op, "tags", err)
+}
+
+// newTag is the API view of a tag's usage
+func newTag(usage database.TagUsage) Tag {
+	return Tag{
+		Value:     usage.Value,
+		BlogPosts: usage.BlogPosts,
+		Projects:  usage.Projects,
+		Total:     usage.BlogPosts + usage.Projects,
+	}
+}
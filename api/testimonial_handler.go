@@ -0,0 +1,291 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type testimonialHandler struct {
+	responder       Responder
+	logger          zerolog.Logger
+	testimonialRepo *database.TestimonialRepo
+}
+
+func newTestimonialHandler(testimonialRepo *database.TestimonialRepo) testimonialHandler {
+	logger := log.With().Str("handlerName", "testimonialHandler").Logger()
+
+	return testimonialHandler{
+		responder:       NewResponder(logger),
+		logger:          logger,
+		testimonialRepo: testimonialRepo,
+	}
+}
+
+// TestimonialSubmission represents the fields a visitor may provide when submitting a testimonial
+type TestimonialSubmission struct {
+	AuthorName    string  `json:"authorName" validate:"notblank" example:"Jane Doe"`
+	AuthorRole    *string `json:"authorRole,omitempty" example:"Engineering Manager"`
+	AuthorCompany *string `json:"authorCompany,omitempty" example:"Acme Corp"`
+	Text          string  `json:"text" validate:"notblank,max=2000" example:"A pleasure to work with."`
+}
+
+// TestimonialCollection represents multiple testimonials
+type TestimonialCollection struct {
+	Testimonials []models.Testimonial `json:"testimonials"`
+	Total        int                  `json:"total,omitempty"`
+}
+
+// getApprovedTestimonials retrieves all approved testimonials
+// @Summary Get approved testimonials
+// @Description Retrieves all testimonials that have been approved for public display, newest first
+// @Tags Testimonials
+// @Accept json
+// @Produce json
+// @Success 200 {object} TestimonialCollection "List of approved testimonials"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching testimonials"
+// @Router /testimonials [get]
+func (h testimonialHandler) getApprovedTestimonials() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		testimonials, err := h.testimonialRepo.FindByApproved(true)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find testimonials", "testimonials", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, newTestimonialCollection(testimonials))
+	}
+}
+
+// submitTestimonial stores a visitor-submitted testimonial pending approval
+// @Summary Submit testimonial
+// @Description Submits a new testimonial. Submitted testimonials are hidden until approved by an admin
+// @Tags Testimonials
+// @Accept json
+// @Produce json
+// @Param testimonial body TestimonialSubmission true "Testimonial data"
+// @Success 201 {object} models.Testimonial "Submitted testimonial"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid testimonial data"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error submitting testimonial"
+// @Router /testimonial [post]
+func (h testimonialHandler) submitTestimonial() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			h.logger.Error().Err(err).Msg("Failed to read request body")
+			h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+			return
+		}
+
+		var submission TestimonialSubmission
+		if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&submission); err != nil {
+			h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode testimonial request body")
+			h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+			return
+		}
+
+		submission.AuthorName = strings.TrimSpace(submission.AuthorName)
+		submission.Text = strings.TrimSpace(submission.Text)
+
+		if err := validateRequest(&submission); err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		// Approval is never taken from the request; new testimonials always start pending
+		testimonial := models.Testimonial{
+			AuthorName:    submission.AuthorName,
+			AuthorRole:    submission.AuthorRole,
+			AuthorCompany: submission.AuthorCompany,
+			Text:          submission.Text,
+			Approved:      false,
+			DateSubmitted: time.Now(),
+		}
+
+		if err := h.testimonialRepo.Add(&testimonial); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create testimonial", "testimonial", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, testimonial)
+	}
+}
+
+// getAllTestimonials retrieves testimonials for moderation
+// @Summary Get testimonials for moderation
+// @Description Retrieves testimonials filtered by moderation status (pending, approved or all). Defaults to pending
+// @Tags Testimonials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Moderation status" Enums(pending, approved, all)
+// @Success 200 {object} TestimonialCollection "List of testimonials"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid status"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching testimonials"
+// @Router /admin/testimonials [get]
+func (h testimonialHandler) getAllTestimonials() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var testimonials []*models.Testimonial
+		var err error
+
+		switch status := r.URL.Query().Get("status"); status {
+		case "", "pending":
+			testimonials, err = h.testimonialRepo.FindByApproved(false)
+		case "approved":
+			testimonials, err = h.testimonialRepo.FindByApproved(true)
+		case "all":
+			testimonials, err = h.testimonialRepo.FindAll()
+		default:
+			h.responder.WriteError(w, errs.NewBadRequestError("status must be one of: pending, approved, all"))
+			return
+		}
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find testimonials", "testimonials", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, newTestimonialCollection(testimonials))
+	}
+}
+
+// approveTestimonial marks a testimonial as approved
+// @Summary Approve testimonial
+// @Description Approves a testimonial so it is shown publicly
+// @Tags Testimonials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param testimonialID path string true "Testimonial ID" format(uuid)
+// @Success 200 {object} models.Testimonial "Approved testimonial"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid testimonialID"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Testimonial not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error approving testimonial"
+// @Router /admin/testimonial/{testimonialID}/approve [post]
+func (h testimonialHandler) approveTestimonial() http.HandlerFunc {
+	return h.setApproved(true)
+}
+
+// rejectTestimonial withdraws approval from a testimonial
+// @Summary Reject testimonial
+// @Description Marks a testimonial as not approved, hiding it from the public listing
+// @Tags Testimonials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param testimonialID path string true "Testimonial ID" format(uuid)
+// @Success 200 {object} models.Testimonial "Rejected testimonial"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid testimonialID"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Testimonial not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error rejecting testimonial"
+// @Router /admin/testimonial/{testimonialID}/reject [post]
+func (h testimonialHandler) rejectTestimonial() http.HandlerFunc {
+	return h.setApproved(false)
+}
+
+// setApproved returns a handler that updates the approval state of the testimonial in the path
+func (h testimonialHandler) setApproved(approved bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		testimonialIDStr := chi.URLParam(r, "testimonialID")
+		if testimonialIDStr == "" {
+			h.responder.WriteError(w, errs.NewBadRequestError("missing testimonialID"))
+			return
+		}
+
+		testimonialID, err := uuid.Parse(testimonialIDStr)
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid testimonialID"))
+			return
+		}
+
+		// Verify testimonial exists
+		if _, err := h.testimonialRepo.FindByID(testimonialID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find testimonial", "testimonial", err))
+			return
+		}
+
+		if err := h.testimonialRepo.SetApproved(testimonialID, approved); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update testimonial", "testimonial", err))
+			return
+		}
+
+		updatedTestimonial, err := h.testimonialRepo.FindByID(testimonialID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find updated testimonial", "testimonial", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, updatedTestimonial)
+	}
+}
+
+// deleteTestimonial deletes a testimonial by ID
+// @Summary Delete testimonial
+// @Description Deletes a testimonial from the database by ID
+// @Tags Testimonials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param testimonialID path string true "Testimonial ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid testimonialID"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Testimonial not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting testimonial"
+// @Router /admin/testimonial/{testimonialID} [delete]
+func (h testimonialHandler) deleteTestimonial() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		testimonialIDStr := chi.URLParam(r, "testimonialID")
+		if testimonialIDStr == "" {
+			h.responder.WriteError(w, errs.NewBadRequestError("missing testimonialID"))
+			return
+		}
+
+		testimonialID, err := uuid.Parse(testimonialIDStr)
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid testimonialID"))
+			return
+		}
+
+		// Verify testimonial exists
+		if _, err := h.testimonialRepo.FindByID(testimonialID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find testimonial", "testimonial", err))
+			return
+		}
+
+		if err := h.testimonialRepo.Delete(testimonialID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete testimonial", "testimonial", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "testimonial deleted successfully",
+		})
+	}
+}
+
+func newTestimonialCollection(testimonials []*models.Testimonial) TestimonialCollection {
+	collection := TestimonialCollection{
+		Testimonials: make([]models.Testimonial, 0, len(testimonials)),
+		Total:        len(testimonials),
+	}
+	for _, testimonial := range testimonials {
+		collection.Testimonials = append(collection.Testimonials, *testimonial)
+	}
+	return collection
+}
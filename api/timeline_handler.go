@@ -0,0 +1,155 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultTimelineLimit = 20
+	maxTimelineLimit     = 50
+)
+
+// Timeline item types
+const (
+	TimelineItemTypeBlogPost = "blog_post"
+	TimelineItemTypeProject  = "project"
+	TimelineItemTypeNote     = "note"
+)
+
+type timelineHandler struct {
+	responder    Responder
+	logger       zerolog.Logger
+	blogPostRepo *database.BlogPostRepo
+	projectRepo  *database.ProjectRepo
+	noteRepo     *database.NoteRepo
+}
+
+func newTimelineHandler(blogPostRepo *database.BlogPostRepo, projectRepo *database.ProjectRepo, noteRepo *database.NoteRepo) timelineHandler {
+	logger := log.With().Str("handlerName", "timelineHandler").Logger()
+
+	return timelineHandler{
+		responder:    NewResponder(logger),
+		logger:       logger,
+		blogPostRepo: blogPostRepo,
+		projectRepo:  projectRepo,
+		noteRepo:     noteRepo,
+	}
+}
+
+// TimelineItem represents one entry in the activity feed
+// Type says which of BlogPost, Project or Note is set
+type TimelineItem struct {
+	Type     string           `json:"type" enums:"blog_post,project,note"`
+	ID       uuid.UUID        `json:"id"`
+	Date     time.Time        `json:"date"`
+	BlogPost *models.BlogPost `json:"blogPost,omitempty"`
+	Project  *models.Project  `json:"project,omitempty"`
+	Note     *models.Note     `json:"note,omitempty"`
+}
+
+// Timeline represents one page of the activity feed
+type Timeline struct {
+	Items      []TimelineItem `json:"items"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+}
+
+// getTimeline retrieves the merged activity feed
+// @Summary Get timeline
+// @Description Retrieves blog posts, projects and notes merged into one feed, newest first. Pass nextCursor from the previous response as cursor to get the next page
+// @Tags Timeline
+// @Accept json
+// @Produce json
+// @Param cursor query string false "Cursor returned as nextCursor by the previous page"
+// @Param limit query int false "Items per page (max 50)" default(20)
+// @Success 200 {object} Timeline "Page of timeline items"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid cursor or limit"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching timeline"
+// @Router /timeline [get]
+func (h timelineHandler) getTimeline() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cursor, err := parseCursor(r)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		limit := defaultTimelineLimit
+		if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil || limit < 1 || limit > maxTimelineLimit {
+				h.responder.WriteError(w, errs.NewInvalidFieldError("limit", "must be between 1 and "+strconv.Itoa(maxTimelineLimit)))
+				return
+			}
+		}
+
+		// Fetch one extra item so we know whether another page exists
+		items, err := h.collectItems(cursor, limit+1)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find timeline items", "timeline", err))
+			return
+		}
+
+		response := Timeline{Items: items}
+		if len(items) > limit {
+			response.Items = items[:limit]
+			last := response.Items[limit-1]
+			response.NextCursor = encodeCursor(database.Cursor{Time: last.Date, ID: last.ID})
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// collectItems fetches up to limit items from each source and merges them newest first
+// The merge uses the same (date DESC, id DESC) order the repos use so cursors stay consistent
+func (h timelineHandler) collectItems(cursor *database.Cursor, limit int) ([]TimelineItem, error) {
+	blogPosts, err := h.blogPostRepo.FindBefore(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := h.projectRepo.FindBefore(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := h.noteRepo.FindBefore(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]TimelineItem, 0, len(blogPosts)+len(projects)+len(notes))
+	for _, blogPost := range blogPosts {
+		items = append(items, TimelineItem{Type: TimelineItemTypeBlogPost, ID: blogPost.ID, Date: blogPost.DateAdded, BlogPost: blogPost})
+	}
+	for _, project := range projects {
+		items = append(items, TimelineItem{Type: TimelineItemTypeProject, ID: project.ID, Date: project.DateAdded, Project: project})
+	}
+	for _, note := range notes {
+		items = append(items, TimelineItem{Type: TimelineItemTypeNote, ID: note.ID, Date: note.DateAdded, Note: note})
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].Date.Equal(items[j].Date) {
+			return items[i].Date.After(items[j].Date)
+		}
+		return bytes.Compare(items[i].ID[:], items[j].ID[:]) > 0
+	})
+
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	return items, nil
+}
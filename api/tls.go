@@ -0,0 +1,92 @@
+package api
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/config"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// newTLSConfig returns the TLS settings for serving HTTPS directly, or nil when TLS isn't configured
+// Certificates come from TLS_CERT_FILE and TLS_KEY_FILE when both are set, otherwise from Let's Encrypt for the
+// comma-separated TLS_DOMAINS. The returned handler answers ACME HTTP challenges and must be served on port 80
+// for them to work; the TLS-ALPN challenge on the HTTPS port works without it
+func newTLSConfig(c map[string]string) (*tls.Config, *autocert.Manager, error) {
+	certFile := config.GetString(c, "TLS_CERT_FILE", "")
+	keyFile := config.GetString(c, "TLS_KEY_FILE", "")
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, nil, errors.New("TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+		}
+		certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		log.Info().Str("certFile", certFile).Msg("Serving TLS with the provided certificate")
+		return &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			Certificates: []tls.Certificate{certificate},
+		}, nil, nil
+	}
+
+	var domains []string
+	for _, domain := range strings.Split(config.GetString(c, "TLS_DOMAINS", ""), ",") {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+	if len(domains) == 0 {
+		return nil, nil, nil
+	}
+
+	manager := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		HostPolicy: autocert.HostWhitelist(domains...),
+		Cache:      autocert.DirCache(config.GetString(c, "TLS_CACHE_DIR", "certs")),
+		Email:      config.GetString(c, "TLS_EMAIL", ""),
+	}
+	log.Info().Strs("domains", domains).Msg("Serving TLS with Let's Encrypt certificates")
+
+	// TLSConfig already advertises the acme-tls/1 protocol, so certificates can be issued without port 80
+	tlsConfig := manager.TLSConfig()
+	tlsConfig.MinVersion = tls.VersionTLS12
+	return tlsConfig, manager, nil
+}
+
+// newRedirectServer returns the plain HTTP server that runs next to the HTTPS one
+// It answers ACME HTTP challenges when manager is set, serves /healthcheck so platform health checks keep
+// working over plain HTTP, and redirects everything else to httpsPort on the same host
+func newRedirectServer(address, httpsPort string, manager *autocert.Manager, healthcheck http.Handler) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/healthcheck", healthcheck)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if hostname, _, err := net.SplitHostPort(r.Host); err == nil {
+			host = hostname
+		}
+		if httpsPort != "443" {
+			host = net.JoinHostPort(host, httpsPort)
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusMovedPermanently)
+	})
+
+	var handler http.Handler = mux
+	if manager != nil {
+		handler = manager.HTTPHandler(mux)
+	}
+
+	return &http.Server{
+		Addr:              address,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+		IdleTimeout:       30 * time.Second,
+	}
+}
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+)
+
+// parsePermanentDelete reads whether a delete should skip the trash. Only admins may delete permanently, since
+// nothing deleted that way can be brought back
+func parsePermanentDelete(r *http.Request) (bool, error) {
+	value := r.URL.Query().Get("permanent")
+	if value == "" {
+		return false, nil
+	}
+	permanent, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errs.NewInvalidFieldError("permanent", "must be true or false")
+	}
+	if permanent && !ctxIsAdmin(r.Context()) {
+		return false, errs.Unauthorized
+	}
+	return permanent, nil
+}
@@ -0,0 +1,223 @@
+package api
+
+import (
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// trendingWindow is how far back views count towards trending
+	trendingWindow = 14 * 24 * time.Hour
+	// trendingHalfLife is how long it takes a day's views to count half as much
+	trendingHalfLife = 3 * 24 * time.Hour
+	// trendingRefreshInterval is how often the trending list is recomputed
+	trendingRefreshInterval = 15 * time.Minute
+
+	defaultTrendingLimit = 10
+	maxTrendingLimit     = 50
+)
+
+// TrendingItem is one blog post or project in the trending list
+type TrendingItem struct {
+	Type  string    `json:"type" enums:"blogPost,project"`
+	ID    uuid.UUID `json:"id"`
+	Title string    `json:"title"`
+	Score float64   `json:"score"`
+	Views int64     `json:"views"`
+}
+
+// TrendingResponse is the cached trending list and when it was computed
+type TrendingResponse struct {
+	GeneratedAt time.Time      `json:"generatedAt"`
+	Data        []TrendingItem `json:"data"`
+}
+
+// trendingCache recomputes the trending list in the background and serves the latest copy
+// Requests never touch the database, so the homepage module stays cheap however often it is loaded
+type trendingCache struct {
+	logger          zerolog.Logger
+	contentViewRepo *database.ContentViewRepo
+	blogPostRepo    *database.BlogPostRepo
+	projectRepo     *database.ProjectRepo
+
+	mu          sync.RWMutex
+	items       []TrendingItem
+	generatedAt time.Time
+}
+
+func newTrendingCache(contentViewRepo *database.ContentViewRepo, blogPostRepo *database.BlogPostRepo, projectRepo *database.ProjectRepo) *trendingCache {
+	logger := log.With().Str("handlerName", "trendingCache").Logger()
+	return &trendingCache{
+		logger:          logger,
+		contentViewRepo: contentViewRepo,
+		blogPostRepo:    blogPostRepo,
+		projectRepo:     projectRepo,
+		items:           []TrendingItem{},
+	}
+}
+
+// refresh recomputes the trending list from the views in the trending window
+// On error the previous list is kept
+func (c *trendingCache) refresh(now time.Time) error {
+	contentViews, err := c.contentViewRepo.FindSince(now.Add(-trendingWindow))
+	if err != nil {
+		return err
+	}
+
+	items := rankTrending(contentViews, now)
+
+	var blogPostIDs, projectIDs []uuid.UUID
+	for _, item := range items {
+		if item.Type == models.ContentTypeBlogPost {
+			blogPostIDs = append(blogPostIDs, item.ID)
+		} else {
+			projectIDs = append(projectIDs, item.ID)
+		}
+	}
+	blogPostTitles, err := c.blogPostRepo.FindTitles(blogPostIDs)
+	if err != nil {
+		return err
+	}
+	projectTitles, err := c.projectRepo.FindTitles(projectIDs)
+	if err != nil {
+		return err
+	}
+
+	// Deleted content still has views on record, so drop anything without a title
+	trending := make([]TrendingItem, 0, min(len(items), maxTrendingLimit))
+	for _, item := range items {
+		titles := projectTitles
+		if item.Type == models.ContentTypeBlogPost {
+			titles = blogPostTitles
+		}
+		title, ok := titles[item.ID]
+		if !ok {
+			continue
+		}
+		item.Title = title
+		trending = append(trending, item)
+		if len(trending) == maxTrendingLimit {
+			break
+		}
+	}
+
+	c.mu.Lock()
+	c.items = trending
+	c.generatedAt = now
+	c.mu.Unlock()
+	return nil
+}
+
+// snapshot returns up to limit items from the latest list and when it was computed
+func (c *trendingCache) snapshot(limit int) ([]TrendingItem, time.Time) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	items := make([]TrendingItem, min(limit, len(c.items)))
+	copy(items, c.items)
+	return items, c.generatedAt
+}
+
+// rankTrending scores each piece of content by its daily views, halving a day's weight every trendingHalfLife, best first
+func rankTrending(contentViews []*models.ContentView, now time.Time) []TrendingItem {
+	type contentKey struct {
+		contentType string
+		id          uuid.UUID
+	}
+
+	byContent := map[contentKey]*TrendingItem{}
+	for _, contentView := range contentViews {
+		key := contentKey{contentView.ContentType, contentView.ContentID}
+		item, ok := byContent[key]
+		if !ok {
+			item = &TrendingItem{Type: contentView.ContentType, ID: contentView.ContentID}
+			byContent[key] = item
+		}
+
+		// Measure age from the middle of the day so today's views aren't weighted as if they all happened just now
+		age := max(now.Sub(contentView.Day.Add(12*time.Hour)), 0)
+		item.Score += float64(contentView.Views) * math.Pow(0.5, float64(age)/float64(trendingHalfLife))
+		item.Views += contentView.Views
+	}
+
+	items := make([]TrendingItem, 0, len(byContent))
+	for _, item := range byContent {
+		item.Score = math.Round(item.Score*100) / 100
+		items = append(items, *item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Score != items[j].Score {
+			return items[i].Score > items[j].Score
+		}
+		return items[i].ID.String() < items[j].ID.String()
+	})
+	return items
+}
+
+// recordContentView counts a view of a blog post or project towards trending
+// HEAD requests reach the same handlers but aren't views. Failures are only logged so counting never breaks a page
+func recordContentView(logger zerolog.Logger, contentViewRepo *database.ContentViewRepo, r *http.Request, contentType string, contentID uuid.UUID) {
+	if r.Method != http.MethodGet || ctxIsBenchmark(r.Context()) {
+		return
+	}
+	if err := contentViewRepo.Increment(contentType, contentID, time.Now(), 1); err != nil {
+		logger.Error().Err(err).Str("contentType", contentType).Str("contentId", contentID.String()).Msg("Failed to record content view")
+	}
+}
+
+type trendingHandler struct {
+	responder Responder
+	logger    zerolog.Logger
+	cache     *trendingCache
+}
+
+func newTrendingHandler(cache *trendingCache) trendingHandler {
+	logger := log.With().Str("handlerName", "trendingHandler").Logger()
+
+	return trendingHandler{
+		responder: NewResponder(logger),
+		logger:    logger,
+		cache:     cache,
+	}
+}
+
+// getTrending returns the blog posts and projects that are popular right now
+// @Summary Get trending content
+// @Description Returns blog posts and projects ranked by recent views, with older views decaying (3-day half-life over a 14-day window). The list is recomputed every 15 minutes.
+// @Tags Trending
+// @Accept json
+// @Produce json
+// @Param limit query int false "Maximum number of items (max 50)" default(10)
+// @Success 200 {object} TrendingResponse "Trending blog posts and projects, most popular first"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid limit"
+// @Router /trending [get]
+func (h trendingHandler) getTrending() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit := defaultTrendingLimit
+		if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+			var err error
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil || limit < 1 || limit > maxTrendingLimit {
+				h.responder.WriteError(w, errs.NewInvalidFieldError("limit", "must be between 1 and "+strconv.Itoa(maxTrendingLimit)))
+				return
+			}
+		}
+
+		items, generatedAt := h.cache.snapshot(limit)
+		h.responder.WriteJSON(w, TrendingResponse{
+			GeneratedAt: generatedAt,
+			Data:        items,
+		})
+	}
+}
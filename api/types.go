@@ -1,17 +1,68 @@
 package api
 
+import "github.com/rpupo63/unified-personal-site-backend/errs"
+
 // routeHandlers contains all the handlers for different route types
 type routeHandlers struct {
-	projectHandler  projectHandler
-	blogPostHandler blogPostHandler
+	projectHandler        projectHandler
+	blogPostHandler       blogPostHandler
+	resumeHandler         resumeHandler
+	workExperienceHandler workExperienceHandler
+	educationHandler      educationHandler
+	skillHandler          skillHandler
+	testimonialHandler    testimonialHandler
+	usesItemHandler       usesItemHandler
+	snippetHandler        snippetHandler
+	bookmarkHandler       bookmarkHandler
+	noteHandler           noteHandler
+	timelineHandler       timelineHandler
+	guestbookHandler      guestbookHandler
+	bookHandler           bookHandler
+	certificationHandler  certificationHandler
+	faqHandler            faqHandler
+	batchHandler          batchHandler
+	webhookHandler        webhookHandler
+
+	// idempotency is shared by every route group so keys are unique across POST endpoints
+	idempotency          idempotencyMiddleware
+	trendingHandler      trendingHandler
+	blogPostViewHandler  blogPostViewHandler
+	seriesHandler        seriesHandler
+	blogPostMetaHandler  blogPostMetaHandler
+	blogPostImageHandler blogPostImageHandler
+	projectImageHandler  projectImageHandler
+	recentChangesHandler recentChangesHandler
+	analyticsHandler     analyticsHandler
+	socialPostHandler    socialPostHandler
+	shareLinkHandler     shareLinkHandler
+	shortLinkHandler     shortLinkHandler
+	schedulerHandler     schedulerHandler
+	metricsHandler       metricsHandler
+	schemaHandler        schemaHandler
+	importHandler        importHandler
+	exportHandler        exportHandler
+	responseCache        *responseCache
+	indexAuditHandler    indexAuditHandler
+	schemaDriftHandler   schemaDriftHandler
+	feedHandler          feedHandler
+	sitemapHandler       sitemapHandler
+	benchHandler         benchHandler
+	doctorHandler        doctorHandler
+	searchHandler        searchHandler
+	tagHandler           tagHandler
+	mediaHandler         mediaHandler
+	micropubHandler      micropubHandler
+	contentLocales       *contentLocales
 }
 
 // ErrorResponse represents an error response from the API
 // @Description Error response structure
 type ErrorResponse struct {
-	Error   string `json:"error" example:"Internal Server Error"`
-	Status  string `json:"status" example:"error"`
-	Field   string `json:"field,omitempty" example:"title"`
-	Details string `json:"details,omitempty" example:"Additional error details"`
-	Cause   string `json:"cause,omitempty" example:"Underlying error cause"`
+	Error   string            `json:"error" example:"Internal Server Error"`
+	Code    string            `json:"code" example:"BLOG_POST_NOT_FOUND"`
+	Status  string            `json:"status" example:"error"`
+	Field   string            `json:"field,omitempty" example:"title"`
+	Fields  []errs.FieldError `json:"fields,omitempty"`
+	Details string            `json:"details,omitempty" example:"Additional error details"`
+	Cause   string            `json:"cause,omitempty" example:"Underlying error cause"`
 }
@@ -0,0 +1,258 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type usesItemHandler struct {
+	responder    Responder
+	logger       zerolog.Logger
+	usesItemRepo *database.UsesItemRepo
+}
+
+func newUsesItemHandler(usesItemRepo *database.UsesItemRepo) usesItemHandler {
+	logger := log.With().Str("handlerName", "usesItemHandler").Logger()
+
+	return usesItemHandler{
+		responder:    NewResponder(logger),
+		logger:       logger,
+		usesItemRepo: usesItemRepo,
+	}
+}
+
+// UsesCategory represents the uses items within a single category
+type UsesCategory struct {
+	Category string            `json:"category"`
+	Items    []models.UsesItem `json:"items"`
+}
+
+// UsesPage represents every uses item grouped by category
+type UsesPage struct {
+	Categories []UsesCategory `json:"categories"`
+	Total      int            `json:"total,omitempty"`
+}
+
+// getUsesPage retrieves all uses items grouped by category
+// @Summary Get uses page
+// @Description Retrieves all uses items grouped by category, with items ordered by display order within each category
+// @Tags Uses
+// @Accept json
+// @Produce json
+// @Success 200 {object} UsesPage "Uses items grouped by category"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching uses items"
+// @Router /uses [get]
+func (h usesItemHandler) getUsesPage() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		usesItems, err := h.usesItemRepo.FindAll()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find uses items", "uses_items", err))
+			return
+		}
+
+		// Items arrive sorted by category, so each new category starts a new group
+		response := UsesPage{
+			Categories: []UsesCategory{},
+			Total:      len(usesItems),
+		}
+		for _, usesItem := range usesItems {
+			last := len(response.Categories) - 1
+			if last < 0 || response.Categories[last].Category != usesItem.Category {
+				response.Categories = append(response.Categories, UsesCategory{Category: usesItem.Category})
+				last++
+			}
+			response.Categories[last].Items = append(response.Categories[last].Items, *usesItem)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// getUsesItem retrieves a specific uses item by ID
+// @Summary Get uses item
+// @Description Retrieves a specific uses item by ID
+// @Tags Uses
+// @Accept json
+// @Produce json
+// @Param usesItemID path string true "Uses Item ID" format(uuid)
+// @Success 200 {object} models.UsesItem "Uses item details"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid usesItemID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Uses item not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching uses item"
+// @Router /uses-item/{usesItemID} [get]
+func (h usesItemHandler) getUsesItem() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		usesItemID, ok := h.parseUsesItemID(w, r)
+		if !ok {
+			return
+		}
+
+		usesItem, err := h.usesItemRepo.FindByID(usesItemID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find uses item", "uses_item", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, usesItem)
+	}
+}
+
+// createUsesItem creates a new uses item
+// @Summary Create uses item
+// @Description Creates a new uses item in the database
+// @Tags Uses
+// @Accept json
+// @Produce json
+// @Param usesItem body models.UsesItem true "Uses item data"
+// @Success 201 {object} models.UsesItem "Created uses item"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid uses item data"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating uses item"
+// @Router /uses-item [post]
+func (h usesItemHandler) createUsesItem() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		usesItem, ok := h.decodeUsesItem(w, r)
+		if !ok {
+			return
+		}
+
+		if err := h.usesItemRepo.Add(usesItem); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create uses item", "uses_item", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, usesItem)
+	}
+}
+
+// updateUsesItem updates an existing uses item
+// @Summary Update uses item
+// @Description Updates an existing uses item in the database
+// @Tags Uses
+// @Accept json
+// @Produce json
+// @Param usesItemID path string true "Uses Item ID" format(uuid)
+// @Param usesItem body models.UsesItem true "Updated uses item data"
+// @Success 200 {object} models.UsesItem "Updated uses item"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid uses item data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Uses item not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error updating uses item"
+// @Router /uses-item/{usesItemID} [put]
+func (h usesItemHandler) updateUsesItem() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		usesItemID, ok := h.parseUsesItemID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify uses item exists
+		if _, err := h.usesItemRepo.FindByID(usesItemID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find uses item", "uses_item", err))
+			return
+		}
+
+		usesItem, ok := h.decodeUsesItem(w, r)
+		if !ok {
+			return
+		}
+
+		// Ensure ID matches
+		usesItem.ID = usesItemID
+
+		if err := h.usesItemRepo.Update(usesItem); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update uses item", "uses_item", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, usesItem)
+	}
+}
+
+// deleteUsesItem deletes a uses item by ID
+// @Summary Delete uses item
+// @Description Deletes a uses item from the database by ID
+// @Tags Uses
+// @Accept json
+// @Produce json
+// @Param usesItemID path string true "Uses Item ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid usesItemID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Uses item not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting uses item"
+// @Router /uses-item/{usesItemID} [delete]
+func (h usesItemHandler) deleteUsesItem() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		usesItemID, ok := h.parseUsesItemID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify uses item exists
+		if _, err := h.usesItemRepo.FindByID(usesItemID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find uses item", "uses_item", err))
+			return
+		}
+
+		if err := h.usesItemRepo.Delete(usesItemID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete uses item", "uses_item", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "uses item deleted successfully",
+		})
+	}
+}
+
+// parseUsesItemID reads the usesItemID path parameter, writing a 400 if it is missing or invalid
+func (h usesItemHandler) parseUsesItemID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	usesItemIDStr := chi.URLParam(r, "usesItemID")
+	if usesItemIDStr == "" {
+		h.responder.WriteError(w, errs.NewBadRequestError("missing usesItemID"))
+		return uuid.Nil, false
+	}
+
+	usesItemID, err := uuid.Parse(usesItemIDStr)
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid usesItemID"))
+		return uuid.Nil, false
+	}
+
+	return usesItemID, true
+}
+
+// decodeUsesItem reads and validates a uses item from the request body
+// It writes the error response itself and returns false when the body is invalid
+func (h usesItemHandler) decodeUsesItem(w http.ResponseWriter, r *http.Request) (*models.UsesItem, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var usesItem models.UsesItem
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&usesItem); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode uses item request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	if err := validateRequest(&usesItem); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+
+	return &usesItem, true
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/non-standard/validators"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+)
+
+// requestValidator checks request bodies against their `validate` struct tags
+// Besides the standard rules it knows notblank, httpurl for absolute http(s) URLs and notbefore=Field for dates
+// that can't precede another one
+var requestValidator = newRequestValidator()
+
+func newRequestValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+
+	// Report fields by the names clients send them as
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	v.RegisterValidation("notblank", validators.NotBlank)
+	v.RegisterValidation("httpurl", isHTTPURL)
+	v.RegisterValidation("notbefore", isNotBefore)
+	return v
+}
+
+// validateRequest checks body against its validate tags and reports every failing field in one error
+func validateRequest(body any) error {
+	err := requestValidator.Struct(body)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return errs.NewInternalErrorWithCause("failed to validate request", err)
+	}
+
+	bodyType := reflect.Indirect(reflect.ValueOf(body)).Type()
+	fields := make([]errs.FieldError, len(validationErrors))
+	for i, fieldErr := range validationErrors {
+		// The namespace starts with the struct's name, e.g. Webhook.events[0]
+		_, field, _ := strings.Cut(fieldErr.Namespace(), ".")
+		fields[i] = errs.FieldError{Field: field, Message: fieldErrorMessage(bodyType, fieldErr)}
+	}
+	return errs.NewValidationError(fields)
+}
+
+// fieldErrorMessage describes the rule fieldErr broke, worded to follow the field name
+func fieldErrorMessage(bodyType reflect.Type, fieldErr validator.FieldError) string {
+	param := fieldErr.Param()
+	switch fieldErr.Tag() {
+	case "required", "notblank":
+		return "is required"
+	case "httpurl":
+		return "must be an absolute http(s) URL"
+	case "oneof":
+		return "must be one of: " + strings.Join(strings.Fields(param), ", ")
+	case "notbefore":
+		return "must not be before " + jsonFieldName(bodyType, param)
+	case "min", "gte":
+		switch fieldErr.Kind() {
+		case reflect.String:
+			return "must be at least " + param + " characters"
+		case reflect.Slice, reflect.Map:
+			return "must contain at least " + param + " " + pluralize(param, "item")
+		}
+		return "must be at least " + param
+	case "max", "lte":
+		switch fieldErr.Kind() {
+		case reflect.String:
+			return "must be at most " + param + " characters"
+		case reflect.Slice, reflect.Map:
+			return "must contain at most " + param + " " + pluralize(param, "item")
+		}
+		return "must be at most " + param
+	}
+	return "is invalid"
+}
+
+// jsonFieldName returns the JSON name of bodyType's Go field name, or name itself when it has none
+func jsonFieldName(bodyType reflect.Type, name string) string {
+	if bodyType.Kind() != reflect.Struct {
+		return name
+	}
+	field, ok := bodyType.FieldByName(name)
+	if !ok {
+		return name
+	}
+	if jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ","); jsonName != "" && jsonName != "-" {
+		return jsonName
+	}
+	return name
+}
+
+func pluralize(count, noun string) string {
+	if count == "1" {
+		return noun
+	}
+	return noun + "s"
+}
+
+// isHTTPURL reports whether the field is an absolute http or https URL
+func isHTTPURL(fl validator.FieldLevel) bool {
+	parsedURL, err := url.Parse(fl.Field().String())
+	return err == nil && (parsedURL.Scheme == "http" || parsedURL.Scheme == "https") && parsedURL.Host != ""
+}
+
+// isNotBefore reports whether the date field isn't before the date in the field named by the param
+// It passes when the other date is missing, since there's nothing to compare against
+func isNotBefore(fl validator.FieldLevel) bool {
+	other, kind, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), fl.Param())
+	if !ok || (kind == reflect.Pointer && other.IsNil()) {
+		return true
+	}
+	other = reflect.Indirect(other)
+	date, ok := fl.Field().Interface().(time.Time)
+	if !ok {
+		return false
+	}
+	otherDate, ok := other.Interface().(time.Time)
+	if !ok {
+		return false
+	}
+	return otherDate.IsZero() || !date.Before(otherDate)
+}
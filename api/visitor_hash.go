@@ -0,0 +1,81 @@
+package api
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// visitorHasher turns a request into an anonymous visitor ID that is only stable for one UTC day
+// The ID is SHA-256 of the day's random salt, the client IP and the user agent; neither input is stored.
+// With useIP off the IP is left out entirely, which undercounts visitors who share a browser version
+type visitorHasher struct {
+	logger          zerolog.Logger
+	visitorSaltRepo *database.VisitorSaltRepo
+	useIP           bool
+
+	mu   sync.Mutex
+	day  time.Time
+	salt []byte
+}
+
+func newVisitorHasher(visitorSaltRepo *database.VisitorSaltRepo, useIP bool) *visitorHasher {
+	logger := log.With().Str("handlerName", "visitorHasher").Logger()
+	return &visitorHasher{
+		logger:          logger,
+		visitorSaltRepo: visitorSaltRepo,
+		useIP:           useIP,
+	}
+}
+
+// hash returns the visitor hash for r, or "" when today's salt can't be loaded
+func (v *visitorHasher) hash(r *http.Request, now time.Time) string {
+	salt, err := v.saltFor(now)
+	if err != nil {
+		v.logger.Error().Err(err).Msg("Failed to load visitor salt")
+		return ""
+	}
+
+	hasher := sha256.New()
+	hasher.Write(salt)
+	if v.useIP {
+		hasher.Write([]byte(clientIP(r)))
+	}
+	// The separator keeps IP and user agent from running together ambiguously
+	hasher.Write([]byte{0})
+	hasher.Write([]byte(r.UserAgent()))
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// saltFor returns the salt of now's UTC day, loading or creating it when the day changes
+// The salt lives in the database so every instance, and a restarted one, hashes the same visitor the same way
+func (v *visitorHasher) saltFor(now time.Time) ([]byte, error) {
+	day := now.UTC().Truncate(24 * time.Hour)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.salt != nil && v.day.Equal(day) {
+		return v.salt, nil
+	}
+
+	candidate := make([]byte, 32)
+	if _, err := rand.Read(candidate); err != nil {
+		return nil, err
+	}
+	salt, err := v.visitorSaltRepo.FindOrCreate(day, candidate)
+	if err != nil {
+		return nil, err
+	}
+
+	v.day = day
+	v.salt = salt
+	return salt, nil
+}
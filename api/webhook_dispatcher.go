@@ -0,0 +1,182 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// webhookPollInterval is how often the worker looks for due deliveries
+	webhookPollInterval = 10 * time.Second
+	// webhookBatchSize caps how many deliveries are claimed per poll
+	webhookBatchSize = 20
+	// webhookLease is how long a claimed delivery is hidden from other workers while it is being sent
+	webhookLease = time.Minute
+	// webhookMaxAttempts is how many times a delivery is tried before it is marked failed
+	webhookMaxAttempts = 6
+	// webhookBaseBackoff is the wait before the first retry; it doubles after every failed attempt
+	webhookBaseBackoff = 30 * time.Second
+)
+
+// WebhookPayload is the JSON body sent to webhook receivers
+type WebhookPayload struct {
+	ID        uuid.UUID `json:"id"`
+	Event     string    `json:"event"`
+	CreatedAt time.Time `json:"createdAt"`
+	Data      any       `json:"data"`
+}
+
+// SocialPostFailure is the data sent with social.post.failed events
+type SocialPostFailure struct {
+	ContentType string    `json:"contentType" enums:"blogPost,note"`
+	ContentID   uuid.UUID `json:"contentId"`
+	Platforms   []string  `json:"platforms"`
+	Error       string    `json:"error"`
+}
+
+// webhookDispatcher queues events for subscribed webhooks and delivers them in the background
+// Deliveries are stored before sending, so they survive restarts and are retried with exponential backoff.
+// Delivery is at least once: receivers should deduplicate on the X-Webhook-Delivery header
+type webhookDispatcher struct {
+	logger       zerolog.Logger
+	webhookRepo  *database.WebhookRepo
+	deliveryRepo *database.WebhookDeliveryRepo
+}
+
+func newWebhookDispatcher(webhookRepo *database.WebhookRepo, deliveryRepo *database.WebhookDeliveryRepo) *webhookDispatcher {
+	logger := log.With().Str("handlerName", "webhookDispatcher").Logger()
+	return &webhookDispatcher{
+		logger:       logger,
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+	}
+}
+
+// emit queues event for every active webhook subscribed to it
+// Failures are logged rather than returned so a webhook problem never fails the request that triggered it
+func (d *webhookDispatcher) emit(event string, data any) {
+	webhooks, err := d.webhookRepo.FindSubscribed(event)
+	if err != nil {
+		d.logger.Error().Err(err).Str("event", event).Msg("Failed to find webhooks for event")
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	now := time.Now()
+	deliveries := make([]models.WebhookDelivery, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		id := uuid.New()
+		payload, err := json.Marshal(WebhookPayload{ID: id, Event: event, CreatedAt: now, Data: data})
+		if err != nil {
+			d.logger.Error().Err(err).Str("event", event).Msg("Failed to marshal webhook payload")
+			return
+		}
+
+		deliveries = append(deliveries, models.WebhookDelivery{
+			ID:            id,
+			WebhookID:     webhook.ID,
+			Event:         event,
+			Payload:       payload,
+			Status:        models.WebhookDeliveryPending,
+			NextAttemptAt: now,
+			DateAdded:     now,
+		})
+	}
+
+	if err := d.deliveryRepo.AddAll(deliveries); err != nil {
+		d.logger.Error().Err(err).Str("event", event).Msg("Failed to queue webhook deliveries")
+		return
+	}
+	d.logger.Info().Str("event", event).Int("webhooks", len(deliveries)).Msg("Queued webhook deliveries")
+}
+
+// run runs the delivery worker until stopping is done
+// Sends use work, so a delivery in flight when shutdown starts can finish; see lifecycle
+func (d *webhookDispatcher) run(stopping, work context.Context) {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.deliverDue(stopping, work)
+
+		select {
+		case <-stopping.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverDue claims the deliveries that are due and sends each one
+// Deliveries left unsent when stopping is done are released so they're sent right after restart, not once the lease runs out
+func (d *webhookDispatcher) deliverDue(stopping, work context.Context) {
+	deliveries, err := d.deliveryRepo.ClaimDue(time.Now(), webhookBatchSize, webhookLease)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Failed to claim due webhook deliveries")
+		return
+	}
+
+	for i, delivery := range deliveries {
+		if stopping.Err() != nil {
+			if err := d.deliveryRepo.Release(deliveries[i:]); err != nil {
+				d.logger.Error().Err(err).Msg("Failed to release unsent webhook deliveries")
+			}
+			return
+		}
+		d.deliver(work, delivery)
+	}
+}
+
+// deliver sends one delivery and records the outcome, scheduling a retry if it failed
+func (d *webhookDispatcher) deliver(ctx context.Context, delivery *models.WebhookDelivery) {
+	logger := d.logger.With().Str("deliveryID", delivery.ID.String()).Str("event", delivery.Event).Logger()
+
+	delivery.Attempts++
+	statusCode, err := services.DeliverWebhook(ctx, delivery.Webhook.URL, delivery.Webhook.Secret, delivery.Event, delivery.ID.String(), delivery.Payload)
+	if statusCode != 0 {
+		delivery.LastStatusCode = &statusCode
+	}
+
+	now := time.Now()
+	switch {
+	case err != nil && ctx.Err() != nil:
+		// Interrupted by shutdown: the receiver may or may not have it, so resend after restart without counting the attempt
+		delivery.Attempts--
+		delivery.NextAttemptAt = now
+		logger.Warn().Err(err).Msg("Webhook delivery interrupted by shutdown, will resend after restart")
+	case err == nil:
+		delivery.Status = models.WebhookDeliverySucceeded
+		delivery.DateDelivered = &now
+		delivery.LastError = nil
+		logger.Info().Int("status", statusCode).Msg("Delivered webhook")
+	case delivery.Attempts >= webhookMaxAttempts:
+		message := err.Error()
+		delivery.Status = models.WebhookDeliveryFailed
+		delivery.LastError = &message
+		logger.Error().Err(err).Int("attempts", delivery.Attempts).Msg("Giving up on webhook delivery")
+	default:
+		message := err.Error()
+		delivery.LastError = &message
+		delivery.NextAttemptAt = now.Add(webhookBackoff(delivery.Attempts))
+		logger.Warn().Err(err).Int("attempts", delivery.Attempts).Time("nextAttemptAt", delivery.NextAttemptAt).Msg("Webhook delivery failed, will retry")
+	}
+
+	if err := d.deliveryRepo.Update(delivery); err != nil {
+		logger.Error().Err(err).Msg("Failed to record webhook delivery result")
+	}
+}
+
+// webhookBackoff returns the wait before the next attempt after attempts failures: 30s, 1m, 2m, 4m, ...
+func webhookBackoff(attempts int) time.Duration {
+	return webhookBaseBackoff << (attempts - 1)
+}
@@ -0,0 +1,396 @@
+package api
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultWebhookDeliveriesPerPage = 20
+	maxWebhookDeliveriesPerPage     = database.MaxPageSize
+)
+
+type webhookHandler struct {
+	responder    Responder
+	logger       zerolog.Logger
+	webhookRepo  *database.WebhookRepo
+	deliveryRepo *database.WebhookDeliveryRepo
+}
+
+func newWebhookHandler(webhookRepo *database.WebhookRepo, deliveryRepo *database.WebhookDeliveryRepo) webhookHandler {
+	logger := log.With().Str("handlerName", "webhookHandler").Logger()
+
+	return webhookHandler{
+		responder:    NewResponder(logger),
+		logger:       logger,
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+	}
+}
+
+// WebhookCollection represents multiple webhooks
+type WebhookCollection struct {
+	Webhooks []models.Webhook `json:"webhooks"`
+	Total    int              `json:"total,omitempty"`
+}
+
+// WebhookDeliveryCollection represents one page of a webhook's delivery log
+type WebhookDeliveryCollection struct {
+	Data  []models.WebhookDelivery `json:"data"`
+	Meta  ListMeta                 `json:"meta"`
+	Links ListLinks                `json:"links"`
+}
+
+// getWebhooks retrieves all webhooks
+// @Summary Get webhooks
+// @Description Retrieves all webhook subscriptions, including their signing secrets
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} WebhookCollection "List of webhooks"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching webhooks"
+// @Router /admin/webhooks [get]
+func (h webhookHandler) getWebhooks() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		webhooks, err := h.webhookRepo.FindAll()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find webhooks", "webhooks", err))
+			return
+		}
+
+		response := WebhookCollection{
+			Webhooks: make([]models.Webhook, 0, len(webhooks)),
+			Total:    len(webhooks),
+		}
+		for _, webhook := range webhooks {
+			response.Webhooks = append(response.Webhooks, *webhook)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// getWebhook retrieves a specific webhook by ID
+// @Summary Get webhook
+// @Description Retrieves a specific webhook subscription by ID
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param webhookID path string true "Webhook ID" format(uuid)
+// @Success 200 {object} models.Webhook "Webhook details"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid webhookID"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Webhook not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching webhook"
+// @Router /admin/webhook/{webhookID} [get]
+func (h webhookHandler) getWebhook() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		webhookID, ok := h.parseWebhookID(w, r)
+		if !ok {
+			return
+		}
+
+		webhook, err := h.webhookRepo.FindByID(webhookID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find webhook", "webhook", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, webhook)
+	}
+}
+
+// createWebhook creates a new webhook subscription
+// @Summary Create webhook
+// @Description Subscribes a URL to one or more events (post.published, project.created, social.post.failed). A signing secret is generated when none is given. Deliveries are POSTed as JSON with X-Webhook-Event, X-Webhook-Delivery, X-Webhook-Timestamp and X-Webhook-Signature (sha256=HMAC-SHA256 of "<timestamp>.<body>") headers
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param webhook body models.Webhook true "Webhook data"
+// @Success 201 {object} models.Webhook "Created webhook, including its secret"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid webhook data"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating webhook"
+// @Router /admin/webhook [post]
+func (h webhookHandler) createWebhook() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		webhook, ok := h.decodeWebhook(w, r)
+		if !ok {
+			return
+		}
+
+		webhook.ID = uuid.Nil
+		webhook.DateAdded = time.Now()
+		if webhook.Secret == "" {
+			secret, err := newWebhookSecret()
+			if err != nil {
+				h.responder.WriteError(w, errs.NewInternalErrorWithCause("failed to generate webhook secret", err))
+				return
+			}
+			webhook.Secret = secret
+		}
+
+		if err := h.webhookRepo.Add(webhook); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create webhook", "webhook", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, webhook)
+	}
+}
+
+// updateWebhook updates an existing webhook subscription
+// @Summary Update webhook
+// @Description Updates a webhook's URL, events, description or active flag. The secret is kept when none is given
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param webhookID path string true "Webhook ID" format(uuid)
+// @Param webhook body models.Webhook true "Updated webhook data"
+// @Success 200 {object} models.Webhook "Updated webhook"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid webhook data"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Webhook not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error updating webhook"
+// @Router /admin/webhook/{webhookID} [put]
+func (h webhookHandler) updateWebhook() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		webhookID, ok := h.parseWebhookID(w, r)
+		if !ok {
+			return
+		}
+
+		existingWebhook, err := h.webhookRepo.FindByID(webhookID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find webhook", "webhook", err))
+			return
+		}
+
+		webhook, ok := h.decodeWebhook(w, r)
+		if !ok {
+			return
+		}
+
+		webhook.ID = webhookID
+		webhook.DateAdded = existingWebhook.DateAdded
+		if webhook.Secret == "" {
+			webhook.Secret = existingWebhook.Secret
+		}
+
+		if err := h.webhookRepo.Update(webhook); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update webhook", "webhook", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, webhook)
+	}
+}
+
+// deleteWebhook deletes a webhook subscription and its delivery log
+// @Summary Delete webhook
+// @Description Deletes a webhook subscription by ID along with its delivery log
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param webhookID path string true "Webhook ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid webhookID"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Webhook not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting webhook"
+// @Router /admin/webhook/{webhookID} [delete]
+func (h webhookHandler) deleteWebhook() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		webhookID, ok := h.parseWebhookID(w, r)
+		if !ok {
+			return
+		}
+
+		if _, err := h.webhookRepo.FindByID(webhookID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find webhook", "webhook", err))
+			return
+		}
+
+		if err := h.webhookRepo.Delete(webhookID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete webhook", "webhook", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "webhook deleted successfully",
+		})
+	}
+}
+
+// getWebhookDeliveries retrieves one page of a webhook's delivery log
+// @Summary Get webhook deliveries
+// @Description Retrieves the delivery log for a webhook, newest first, with status, attempt count and the last response or error
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param webhookID path string true "Webhook ID" format(uuid)
+// @Param page query int false "Page number (starts at 1)" default(1)
+// @Param perPage query int false "Deliveries per page (max 100)" default(20)
+// @Success 200 {object} WebhookDeliveryCollection "Page of deliveries"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid webhookID or pagination parameters"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Webhook not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching deliveries"
+// @Router /admin/webhook/{webhookID}/deliveries [get]
+func (h webhookHandler) getWebhookDeliveries() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		webhookID, ok := h.parseWebhookID(w, r)
+		if !ok {
+			return
+		}
+
+		pagination, err := parsePagination(r, defaultWebhookDeliveriesPerPage, maxWebhookDeliveriesPerPage)
+		if err != nil {
+			h.responder.WriteError(w, err)
+			return
+		}
+
+		if _, err := h.webhookRepo.FindByID(webhookID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find webhook", "webhook", err))
+			return
+		}
+
+		deliveries, total, err := h.deliveryRepo.FindPageByWebhook(webhookID, pagination.Offset(), pagination.PerPage)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find webhook deliveries", "webhook_deliveries", err))
+			return
+		}
+
+		meta, links := newListMetaAndLinks(r, pagination, total)
+		response := WebhookDeliveryCollection{
+			Data:  make([]models.WebhookDelivery, 0, len(deliveries)),
+			Meta:  meta,
+			Links: links,
+		}
+		for _, delivery := range deliveries {
+			response.Data = append(response.Data, *delivery)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// retryWebhookDelivery queues a delivery to be sent again
+// @Summary Retry webhook delivery
+// @Description Puts a delivery back in the queue with a fresh set of attempts, e.g. after fixing the receiving endpoint
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param deliveryID path string true "Delivery ID" format(uuid)
+// @Success 200 {object} models.WebhookDelivery "Requeued delivery"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid deliveryID"
+// @Failure 401 {object} api.ErrorResponse "Unauthorized"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Delivery not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error requeueing delivery"
+// @Router /admin/webhook-delivery/{deliveryID}/retry [post]
+func (h webhookHandler) retryWebhookDelivery() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		deliveryID, err := uuid.Parse(chi.URLParam(r, "deliveryID"))
+		if err != nil {
+			h.responder.WriteError(w, errs.NewBadRequestError("invalid deliveryID"))
+			return
+		}
+
+		delivery, err := h.deliveryRepo.FindByID(deliveryID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find webhook delivery", "webhook_delivery", err))
+			return
+		}
+
+		delivery.Status = models.WebhookDeliveryPending
+		delivery.Attempts = 0
+		delivery.NextAttemptAt = time.Now()
+
+		if err := h.deliveryRepo.Update(delivery); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update webhook delivery", "webhook_delivery", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, delivery)
+	}
+}
+
+// parseWebhookID reads the webhookID URL parameter
+// It writes the error response itself and returns false when the ID is missing or malformed
+func (h webhookHandler) parseWebhookID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	webhookIDStr := chi.URLParam(r, "webhookID")
+	if webhookIDStr == "" {
+		h.responder.WriteError(w, errs.NewBadRequestError("missing webhookID"))
+		return uuid.Nil, false
+	}
+
+	webhookID, err := uuid.Parse(webhookIDStr)
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid webhookID"))
+		return uuid.Nil, false
+	}
+
+	return webhookID, true
+}
+
+// decodeWebhook reads and validates a webhook from the request body
+// It writes the error response itself and returns false when the body is invalid
+func (h webhookHandler) decodeWebhook(w http.ResponseWriter, r *http.Request) (*models.Webhook, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var webhook models.Webhook
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&webhook); err != nil {
+		h.logger.Error().Err(err).Msg("Failed to decode webhook request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	webhook.URL = strings.TrimSpace(webhook.URL)
+	webhook.Events = trimStrings(webhook.Events)
+	webhook.Secret = strings.TrimSpace(webhook.Secret)
+
+	if err := validateRequest(&webhook); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+
+	return &webhook, true
+}
+
+// newWebhookSecret returns a random secret for signing deliveries
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(secret), nil
+}
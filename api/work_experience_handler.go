@@ -0,0 +1,292 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"gorm.io/datatypes"
+)
+
+type workExperienceHandler struct {
+	responder          Responder
+	logger             zerolog.Logger
+	workExperienceRepo *database.WorkExperienceRepo
+}
+
+func newWorkExperienceHandler(workExperienceRepo *database.WorkExperienceRepo) workExperienceHandler {
+	logger := log.With().Str("handlerName", "workExperienceHandler").Logger()
+
+	return workExperienceHandler{
+		responder:          NewResponder(logger),
+		logger:             logger,
+		workExperienceRepo: workExperienceRepo,
+	}
+}
+
+// WorkExperienceCollection represents multiple work experience entries
+type WorkExperienceCollection struct {
+	WorkExperience []models.WorkExperience `json:"workExperience"`
+	Total          int                     `json:"total,omitempty"`
+}
+
+// getAllWorkExperience retrieves all work experience entries
+// @Summary Get work experience
+// @Description Retrieves all work experience entries, current positions first and then by most recent start date
+// @Tags Work Experience
+// @Accept json
+// @Produce json
+// @Success 200 {object} WorkExperienceCollection "List of work experience entries"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching work experience"
+// @Router /work-experience [get]
+func (h workExperienceHandler) getAllWorkExperience() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		workExperiences, err := h.workExperienceRepo.FindAll()
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find work experience", "work_experiences", err))
+			return
+		}
+
+		response := WorkExperienceCollection{
+			WorkExperience: make([]models.WorkExperience, 0, len(workExperiences)),
+			Total:          len(workExperiences),
+		}
+		for _, workExperience := range workExperiences {
+			response.WorkExperience = append(response.WorkExperience, *workExperience)
+		}
+
+		h.responder.WriteJSON(w, response)
+	}
+}
+
+// getWorkExperience retrieves a specific work experience entry by ID
+// @Summary Get work experience entry
+// @Description Retrieves a specific work experience entry by ID
+// @Tags Work Experience
+// @Accept json
+// @Produce json
+// @Param workExperienceID path string true "Work Experience ID" format(uuid)
+// @Success 200 {object} models.WorkExperience "Work experience details"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid workExperienceID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Work experience not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error fetching work experience"
+// @Router /work-experience/{workExperienceID} [get]
+func (h workExperienceHandler) getWorkExperience() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		workExperienceID, ok := h.parseWorkExperienceID(w, r)
+		if !ok {
+			return
+		}
+
+		workExperience, err := h.workExperienceRepo.FindByID(workExperienceID)
+		if err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find work experience", "work_experience", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, workExperience)
+	}
+}
+
+// createWorkExperience creates a new work experience entry
+// @Summary Create work experience entry
+// @Description Creates a new work experience entry. Dates must form a valid range and may not overlap another entry at the same company
+// @Tags Work Experience
+// @Accept json
+// @Produce json
+// @Param workExperience body models.WorkExperience true "Work experience data"
+// @Success 201 {object} models.WorkExperience "Created work experience"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid work experience data"
+// @Failure 409 {object} api.ErrorResponse "Conflict - Overlaps another entry at the same company"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error creating work experience"
+// @Router /work-experience [post]
+func (h workExperienceHandler) createWorkExperience() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		workExperience, ok := h.decodeWorkExperience(w, r)
+		if !ok {
+			return
+		}
+
+		workExperience.ID = uuid.Nil
+		if !h.checkOverlap(w, workExperience) {
+			return
+		}
+
+		if err := h.workExperienceRepo.Add(workExperience); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("create work experience", "work_experience", err))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		h.responder.WriteJSON(w, workExperience)
+	}
+}
+
+// updateWorkExperience updates an existing work experience entry
+// @Summary Update work experience entry
+// @Description Updates an existing work experience entry. Dates must form a valid range and may not overlap another entry at the same company
+// @Tags Work Experience
+// @Accept json
+// @Produce json
+// @Param workExperienceID path string true "Work Experience ID" format(uuid)
+// @Param workExperience body models.WorkExperience true "Updated work experience data"
+// @Success 200 {object} models.WorkExperience "Updated work experience"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid work experience data"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Work experience not found"
+// @Failure 409 {object} api.ErrorResponse "Conflict - Overlaps another entry at the same company"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error updating work experience"
+// @Router /work-experience/{workExperienceID} [put]
+func (h workExperienceHandler) updateWorkExperience() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		workExperienceID, ok := h.parseWorkExperienceID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify work experience exists
+		if _, err := h.workExperienceRepo.FindByID(workExperienceID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find work experience", "work_experience", err))
+			return
+		}
+
+		workExperience, ok := h.decodeWorkExperience(w, r)
+		if !ok {
+			return
+		}
+
+		// Ensure ID matches
+		workExperience.ID = workExperienceID
+		if !h.checkOverlap(w, workExperience) {
+			return
+		}
+
+		if err := h.workExperienceRepo.Update(workExperience); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("update work experience", "work_experience", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, workExperience)
+	}
+}
+
+// deleteWorkExperience deletes a work experience entry by ID
+// @Summary Delete work experience entry
+// @Description Deletes a work experience entry from the database by ID
+// @Tags Work Experience
+// @Accept json
+// @Produce json
+// @Param workExperienceID path string true "Work Experience ID" format(uuid)
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} api.ErrorResponse "Bad Request - Invalid workExperienceID"
+// @Failure 404 {object} api.ErrorResponse "Not Found - Work experience not found"
+// @Failure 500 {object} api.ErrorResponse "Internal Server Error - Error deleting work experience"
+// @Router /work-experience/{workExperienceID} [delete]
+func (h workExperienceHandler) deleteWorkExperience() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		workExperienceID, ok := h.parseWorkExperienceID(w, r)
+		if !ok {
+			return
+		}
+
+		// Verify work experience exists
+		if _, err := h.workExperienceRepo.FindByID(workExperienceID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("find work experience", "work_experience", err))
+			return
+		}
+
+		if err := h.workExperienceRepo.Delete(workExperienceID); err != nil {
+			h.responder.WriteError(w, wrapDatabaseError("delete work experience", "work_experience", err))
+			return
+		}
+
+		h.responder.WriteJSON(w, map[string]string{
+			"status":  "success",
+			"message": "work experience deleted successfully",
+		})
+	}
+}
+
+// checkOverlap rejects entries whose dates overlap another entry at the same company
+// A promotion should end the previous role before the new one starts. It writes the error response itself and returns false on overlap
+func (h workExperienceHandler) checkOverlap(w http.ResponseWriter, workExperience *models.WorkExperience) bool {
+	overlapping, err := h.workExperienceRepo.FindOverlapping(workExperience.Company, workExperience.StartDate, workExperience.EndDate, workExperience.ID)
+	if err != nil {
+		h.responder.WriteError(w, wrapDatabaseError("find overlapping work experience", "work_experiences", err))
+		return false
+	}
+
+	if len(overlapping) > 0 {
+		h.responder.WriteError(w, errs.NewConflictError("dates overlap the "+overlapping[0].Role+" role at "+overlapping[0].Company).WithCode(errs.CodeWorkExperienceOverlap))
+		return false
+	}
+
+	return true
+}
+
+// parseWorkExperienceID reads the workExperienceID path parameter, writing a 400 if it is missing or invalid
+func (h workExperienceHandler) parseWorkExperienceID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	workExperienceIDStr := chi.URLParam(r, "workExperienceID")
+	if workExperienceIDStr == "" {
+		h.responder.WriteError(w, errs.NewBadRequestError("missing workExperienceID"))
+		return uuid.Nil, false
+	}
+
+	workExperienceID, err := uuid.Parse(workExperienceIDStr)
+	if err != nil {
+		h.responder.WriteError(w, errs.NewBadRequestError("invalid workExperienceID"))
+		return uuid.Nil, false
+	}
+
+	return workExperienceID, true
+}
+
+// decodeWorkExperience reads and validates a work experience entry from the request body
+// Highlights and tech tags are trimmed and empty values dropped. It writes the error response itself and returns false when the body is invalid
+func (h workExperienceHandler) decodeWorkExperience(w http.ResponseWriter, r *http.Request) (*models.WorkExperience, bool) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to read request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("failed to read request body"))
+		return nil, false
+	}
+
+	var workExperience models.WorkExperience
+	if err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&workExperience); err != nil {
+		h.logger.Error().Err(err).Str("body", string(bodyBytes)).Msg("Failed to decode work experience request body")
+		h.responder.WriteError(w, errs.NewBadRequestError("malformed request body"))
+		return nil, false
+	}
+
+	workExperience.Company = strings.TrimSpace(workExperience.Company)
+	workExperience.Role = strings.TrimSpace(workExperience.Role)
+
+	if err := validateRequest(&workExperience); err != nil {
+		h.responder.WriteError(w, err)
+		return nil, false
+	}
+
+	workExperience.Highlights = trimStrings(workExperience.Highlights)
+	workExperience.TechTags = trimStrings(workExperience.TechTags)
+
+	return &workExperience, true
+}
+
+// trimStrings trims each value and drops empty ones, always returning a non-nil slice so it is stored as []
+func trimStrings(values []string) datatypes.JSONSlice[string] {
+	trimmed := datatypes.JSONSlice[string]{}
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			trimmed = append(trimmed, value)
+		}
+	}
+	return trimmed
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// openDatabase connects to Postgres, enables the extensions the models rely on and sizes the connection pool
+// Every command that touches the database starts here
+func openDatabase() (*gorm.DB, error) {
+	// Priority 1: Full Connection String (Recommended for Pooler)
+	connStr := getEnv("DATABASE_URL", "")
+	if connStr == "" {
+		connStr = getEnv("SUPABASE_DB_URL", "")
+	}
+
+	// Priority 2: Individual Components
+	if connStr == "" {
+		host := getEnv("SUPABASE_DB_HOST", "")
+		// Note: Supabase Transaction Pooler uses port 6543
+		port := getEnv("SUPABASE_DB_PORT", "6543")
+		user := getEnv("SUPABASE_DB_USER", "")
+		password := getEnv("SUPABASE_DB_PASSWORD", "")
+		dbname := getEnv("SUPABASE_DB_NAME", "postgres")
+
+		if host == "" || user == "" || password == "" {
+			return nil, fmt.Errorf("missing required database configuration: set DATABASE_URL or (SUPABASE_DB_HOST, SUPABASE_DB_USER, SUPABASE_DB_PASSWORD)")
+		}
+
+		connStr = fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=require",
+			host, user, password, dbname, port)
+	} else {
+		// Validate provided string
+		normalized, err := normalizeConnectionString(connStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid connection string: %w", err)
+		}
+		connStr = normalized
+	}
+
+	fmt.Println("Connecting to Supabase (Transaction Pooler)...")
+	db, err := connectDatabase(connStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Enable required PostgreSQL extensions
+	// Note: 'vector' extension is required for Embeddings/AI features and 'pg_trgm' for fuzzy search
+	// Use a separate session with a higher slow threshold for extension creation
+	// to avoid warnings during startup (extension creation can be slow on first run)
+	extensionLogger := logger.New(
+		log.New(os.Stdout, "\r\n", log.LstdFlags),
+		logger.Config{
+			SlowThreshold:             1 * time.Second, // Higher threshold for extension creation
+			LogLevel:                  logger.Warn,
+			IgnoreRecordNotFoundError: true,
+			Colorful:                  true,
+		},
+	)
+	extensionDB := db.Session(&gorm.Session{
+		Logger: extensionLogger,
+	})
+
+	for _, extension := range []string{"uuid-ossp", "vector", "pg_trgm"} {
+		if err := extensionDB.Exec(fmt.Sprintf("CREATE EXTENSION IF NOT EXISTS %q", extension)).Error; err != nil {
+			return nil, fmt.Errorf("error enabling %s extension: %w", extension, err)
+		}
+	}
+
+	return db, nil
+}
+
+// connectDatabase opens connStr, which must already be normalized, sizes the connection pool and checks the database
+// answers. Unlike openDatabase it changes nothing, so it's safe to point at a database only read from
+func connectDatabase(connStr string) (*gorm.DB, error) {
+	newLogger := logger.New(
+		log.New(os.Stdout, "\r\n", log.LstdFlags),
+		logger.Config{
+			SlowThreshold:             200 * time.Millisecond,
+			LogLevel:                  logger.Warn,
+			IgnoreRecordNotFoundError: true,
+			Colorful:                  true,
+		},
+	)
+
+	// -------------------------------------------------------------------------
+	// CRITICAL SUPABASE POOLER CONFIGURATION
+	// -------------------------------------------------------------------------
+	db, err := gorm.Open(postgres.New(postgres.Config{
+		DSN: connStr,
+		// PreferSimpleProtocol is CRITICAL for the Transaction Pooler (port 6543).
+		// It disables the extended query protocol which creates prepared statements.
+		PreferSimpleProtocol: true,
+	}), &gorm.Config{
+		// PrepareStmt must be FALSE. The pooler does not support prepared statements
+		// in Transaction mode.
+		PrepareStmt: false,
+		Logger:      newLogger,
+	})
+
+	if err != nil {
+		if strings.Contains(err.Error(), "network is unreachable") {
+			return nil, fmt.Errorf("network unreachable, check IPv6 settings or host address: %w", err)
+		}
+		return nil, fmt.Errorf("error connecting to database: %w", err)
+	}
+
+	// Test connection
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("error getting generic database object: %w", err)
+	}
+
+	// Set connection pool settings to prevent opening too many connections
+	// in the container, though the Supabase Pooler handles the hard limit.
+	sqlDB.SetMaxIdleConns(5)
+	sqlDB.SetMaxOpenConns(20)
+	sqlDB.SetConnMaxLifetime(time.Hour)
+
+	if err := sqlDB.Ping(); err != nil {
+		return nil, fmt.Errorf("error pinging database: %w", err)
+	}
+
+	return db, nil
+}
+
+// getEnv returns the value or fallback
+func getEnv(key, fallback string) string {
+	if value, exists := os.LookupEnv(key); exists {
+		return value
+	}
+	return fallback
+}
+
+// normalizeConnectionString validates and standardizes the connection string
+func normalizeConnectionString(connStr string) (string, error) {
+	connStr = strings.TrimSpace(connStr)
+	if connStr == "" {
+		return "", fmt.Errorf("connection string is empty")
+	}
+
+	if strings.HasPrefix(connStr, "postgres://") || strings.HasPrefix(connStr, "postgresql://") {
+		return normalizeURLConnectionString(connStr)
+	}
+	return normalizeKeyValueConnectionString(connStr)
+}
+
+func normalizeURLConnectionString(urlStr string) (string, error) {
+	parsedURL, err := url.Parse(urlStr)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	host := parsedURL.Hostname()
+	if host == "" {
+		return "", fmt.Errorf("missing host")
+	}
+
+	port := parsedURL.Port()
+	if port == "" {
+		port = "5432"
+	} // Default fallback, though Pooler is 6543
+
+	user := parsedURL.User.Username()
+	if user == "" {
+		return "", fmt.Errorf("missing user")
+	}
+
+	password, hasPassword := parsedURL.User.Password()
+	if !hasPassword {
+		return "", fmt.Errorf("missing password")
+	}
+
+	dbname := strings.TrimPrefix(parsedURL.Path, "/")
+	if dbname == "" {
+		return "", fmt.Errorf("missing dbname")
+	}
+
+	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s",
+		host, port, user, password, dbname)
+
+	sslmode := parsedURL.Query().Get("sslmode")
+	if sslmode == "" {
+		sslmode = "require"
+	}
+	connStr += fmt.Sprintf(" sslmode=%s", sslmode)
+
+	return connStr, nil
+}
+
+func normalizeKeyValueConnectionString(connStr string) (string, error) {
+	if !strings.Contains(connStr, "host=") {
+		return "", fmt.Errorf("missing 'host='")
+	}
+	if !strings.Contains(connStr, "user=") {
+		return "", fmt.Errorf("missing 'user='")
+	}
+	if !strings.Contains(connStr, "dbname=") && !strings.Contains(connStr, "database=") {
+		return "", fmt.Errorf("missing 'dbname='")
+	}
+	if !strings.Contains(connStr, "sslmode=") {
+		connStr += " sslmode=require"
+	}
+	return connStr, nil
+}
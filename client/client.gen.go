@@ -0,0 +1,3965 @@
+// Code generated by generate.go from docs/swagger.json. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SpecVersion is the version of the API spec this client was generated from
+const SpecVersion = "1.1"
+
+type APIChange struct {
+	Changes []string `json:"changes,omitempty"`
+	Version string   `json:"version,omitempty"`
+}
+
+type APIVersion struct {
+	Changelog []APIChange `json:"changelog,omitempty"`
+	Spec      string      `json:"spec,omitempty"`
+	Version   string      `json:"version,omitempty"`
+}
+
+type AnalyticsOverview struct {
+	From          string            `json:"from,omitempty"`
+	SocialPosting []PlatformSuccess `json:"socialPosting,omitempty"`
+	To            string            `json:"to,omitempty"`
+	TopPosts      []ContentViews    `json:"topPosts,omitempty"`
+	TopProjects   []ContentViews    `json:"topProjects,omitempty"`
+	TotalViews    int               `json:"totalViews,omitempty"`
+	TotalVisitors int               `json:"totalVisitors,omitempty"`
+	ViewsOverTime []DailyViews      `json:"viewsOverTime,omitempty"`
+}
+
+type BatchOperation struct {
+	BlogPost   *BlogPost `json:"blogPost,omitempty"`
+	BlogPostID string    `json:"blogPostId,omitempty"`
+	Op         string    `json:"op,omitempty"`
+	Project    *Project  `json:"project,omitempty"`
+	ProjectID  string    `json:"projectId,omitempty"`
+	Ref        int       `json:"ref,omitempty"`
+	Tags       []string  `json:"tags,omitempty"`
+}
+
+type BatchRequest struct {
+	Operations []BatchOperation `json:"operations,omitempty"`
+}
+
+type BatchResponse struct {
+	Committed bool          `json:"committed,omitempty"`
+	Results   []BatchResult `json:"results,omitempty"`
+}
+
+type BatchResult struct {
+	Error  string `json:"error,omitempty"`
+	ID     string `json:"id,omitempty"`
+	Index  int    `json:"index,omitempty"`
+	Op     string `json:"op,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+type BenchEndpointReport struct {
+	AvgBytes          int     `json:"avgBytes,omitempty"`
+	CacheHits         int     `json:"cacheHits,omitempty"`
+	Errors            int     `json:"errors,omitempty"`
+	MaxMs             float64 `json:"maxMs,omitempty"`
+	MeanMs            float64 `json:"meanMs,omitempty"`
+	MinMs             float64 `json:"minMs,omitempty"`
+	Name              string  `json:"name,omitempty"`
+	P50Ms             float64 `json:"p50Ms,omitempty"`
+	P90Ms             float64 `json:"p90Ms,omitempty"`
+	P99Ms             float64 `json:"p99Ms,omitempty"`
+	Path              string  `json:"path,omitempty"`
+	Requests          int     `json:"requests,omitempty"`
+	RequestsPerSecond float64 `json:"requestsPerSecond,omitempty"`
+}
+
+type BenchReport struct {
+	Concurrency int                   `json:"concurrency,omitempty"`
+	DurationMs  float64               `json:"durationMs,omitempty"`
+	Endpoints   []BenchEndpointReport `json:"endpoints,omitempty"`
+	Requests    int                   `json:"requests,omitempty"`
+	Seeded      int                   `json:"seeded,omitempty"`
+}
+
+type BlogPostArchive struct {
+	Total int                   `json:"total,omitempty"`
+	Years []BlogPostArchiveYear `json:"years,omitempty"`
+}
+
+type BlogPostArchiveMonth struct {
+	Count int            `json:"count,omitempty"`
+	Month int            `json:"month,omitempty"`
+	Name  string         `json:"name,omitempty"`
+	Posts []BlogPostStub `json:"posts,omitempty"`
+}
+
+type BlogPostArchiveYear struct {
+	Count  int                    `json:"count,omitempty"`
+	Months []BlogPostArchiveMonth `json:"months,omitempty"`
+	Year   int                    `json:"year,omitempty"`
+}
+
+type BlogPostCollectionWithTags struct {
+	Data  []BlogPostWithTags `json:"data,omitempty"`
+	Links *ListLinks         `json:"links,omitempty"`
+	Meta  *ListMeta          `json:"meta,omitempty"`
+}
+
+type BlogPostExport struct {
+	Count int                `json:"count,omitempty"`
+	Data  []BlogPostWithTags `json:"data,omitempty"`
+}
+
+type BlogPostImageCollection struct {
+	Images []BlogPostImage `json:"images,omitempty"`
+}
+
+type BlogPostSearchResult struct {
+	BlogPost *BlogPost `json:"blogPost,omitempty"`
+	Score    float64   `json:"score,omitempty"`
+	Tags     []BlogTag `json:"tags,omitempty"`
+}
+
+type BlogPostSearchResults struct {
+	Data  []BlogPostSearchResult `json:"data,omitempty"`
+	Query string                 `json:"query,omitempty"`
+}
+
+type BlogPostStub struct {
+	DateAdded string `json:"dateAdded,omitempty"`
+	ID        string `json:"id,omitempty"`
+	Title     string `json:"title,omitempty"`
+}
+
+type BlogPostSummary struct {
+	DateAdded          string   `json:"dateAdded,omitempty"`
+	DateEdited         string   `json:"dateEdited,omitempty"`
+	ID                 string   `json:"id,omitempty"`
+	ReadingTimeMinutes int      `json:"readingTimeMinutes,omitempty"`
+	Summary            string   `json:"summary,omitempty"`
+	Tags               []string `json:"tags,omitempty"`
+	Title              string   `json:"title,omitempty"`
+	URL                string   `json:"url,omitempty"`
+	WordCount          int      `json:"wordCount,omitempty"`
+}
+
+type BlogPostSummaryCollection struct {
+	Data  []BlogPostSummary `json:"data,omitempty"`
+	Links *ListLinks        `json:"links,omitempty"`
+	Meta  *ListMeta         `json:"meta,omitempty"`
+}
+
+type BlogPostTranslations struct {
+	Data          []BlogPostTranslation `json:"data,omitempty"`
+	DefaultLocale string                `json:"defaultLocale,omitempty"`
+}
+
+type BlogPostViewResponse struct {
+	// Counted is false when the visitor's view had already been counted within the window
+	Counted bool `json:"counted,omitempty"`
+	Views   int  `json:"views,omitempty"`
+}
+
+type BlogPostWithTags struct {
+	BlogPost *BlogPost `json:"blogPost,omitempty"`
+	Tags     []BlogTag `json:"tags,omitempty"`
+}
+
+type BookCollection struct {
+	Books []Book `json:"books,omitempty"`
+	Total int    `json:"total,omitempty"`
+}
+
+type BookmarkCollection struct {
+	Data  []Bookmark `json:"data,omitempty"`
+	Links *ListLinks `json:"links,omitempty"`
+	Meta  *ListMeta  `json:"meta,omitempty"`
+}
+
+type BulkDeleteRequest struct {
+	AddedAfter  string   `json:"addedAfter,omitempty"`
+	AddedBefore string   `json:"addedBefore,omitempty"`
+	Ids         []string `json:"ids,omitempty"`
+	Tag         string   `json:"tag,omitempty"`
+}
+
+type BulkDeleteResult struct {
+	Deleted    int      `json:"deleted,omitempty"`
+	DeletedIds []string `json:"deletedIds,omitempty"`
+	// NotFound lists requested IDs that didn't exist or didn't match the rest of the filter
+	NotFound []string `json:"notFound,omitempty"`
+}
+
+type CertificationCollection struct {
+	Certifications []Certification `json:"certifications,omitempty"`
+	Total          int             `json:"total,omitempty"`
+}
+
+type ColumnTypeMismatchReport struct {
+	Column       string `json:"column,omitempty"`
+	DatabaseType string `json:"databaseType,omitempty"`
+	ModelType    string `json:"modelType,omitempty"`
+}
+
+type ContentExport struct {
+	BlogPosts  []MarkdownDocument `json:"blogPosts,omitempty"`
+	ExportedAt string             `json:"exportedAt,omitempty"`
+	Projects   []MarkdownDocument `json:"projects,omitempty"`
+	Tags       *ExportedTags      `json:"tags,omitempty"`
+}
+
+type ContentSources struct {
+	ContentID   string        `json:"contentId,omitempty"`
+	ContentType string        `json:"contentType,omitempty"`
+	Referrers   []SourceViews `json:"referrers,omitempty"`
+	Title       string        `json:"title,omitempty"`
+	UtmSources  []SourceViews `json:"utmSources,omitempty"`
+	Views       int           `json:"views,omitempty"`
+}
+
+type ContentViews struct {
+	ID    string `json:"id,omitempty"`
+	Title string `json:"title,omitempty"`
+	Views int    `json:"views,omitempty"`
+}
+
+type CountryViews struct {
+	Country  string        `json:"country,omitempty"`
+	Regions  []RegionViews `json:"regions,omitempty"`
+	Views    int           `json:"views,omitempty"`
+	Visitors int           `json:"visitors,omitempty"`
+}
+
+type CredentialHealth struct {
+	Account   string `json:"account,omitempty"`
+	Error     string `json:"error,omitempty"`
+	ExpiresAt string `json:"expiresAt,omitempty"`
+	Platform  string `json:"platform,omitempty"`
+	Status    string `json:"status,omitempty"`
+}
+
+type CredentialHealthReport struct {
+	Healthy   bool               `json:"healthy,omitempty"`
+	Platforms []CredentialHealth `json:"platforms,omitempty"`
+}
+
+type DailyViews struct {
+	Day      string `json:"day,omitempty"`
+	Views    int    `json:"views,omitempty"`
+	Visitors int    `json:"visitors,omitempty"`
+}
+
+type DoctorIssue struct {
+	Detail  string `json:"detail,omitempty"`
+	Fixable bool   `json:"fixable,omitempty"`
+	ID      string `json:"id,omitempty"`
+	Kind    string `json:"kind,omitempty"`
+	Table   string `json:"table,omitempty"`
+}
+
+type DoctorReport struct {
+	Fixed  int           `json:"fixed,omitempty"`
+	Issues []DoctorIssue `json:"issues,omitempty"`
+}
+
+type EducationCollection struct {
+	Education []Education `json:"education,omitempty"`
+	Total     int         `json:"total,omitempty"`
+}
+
+type ErrorCount struct {
+	Code   string `json:"code,omitempty"`
+	Count  int    `json:"count,omitempty"`
+	Status int    `json:"status,omitempty"`
+}
+
+type ErrorMetrics struct {
+	ClientErrors int          `json:"clientErrors,omitempty"`
+	Errors       []ErrorCount `json:"errors,omitempty"`
+	ServerErrors int          `json:"serverErrors,omitempty"`
+	Since        string       `json:"since,omitempty"`
+}
+
+// ErrorResponse error response structure
+type ErrorResponse struct {
+	Cause   string       `json:"cause,omitempty"`
+	Code    string       `json:"code,omitempty"`
+	Details string       `json:"details,omitempty"`
+	Error   string       `json:"error,omitempty"`
+	Field   string       `json:"field,omitempty"`
+	Fields  []FieldError `json:"fields,omitempty"`
+	Status  string       `json:"status,omitempty"`
+}
+
+type ExpiringCertification struct {
+	CredentialID    string `json:"credentialId,omitempty"`
+	CredentialURL   string `json:"credentialUrl,omitempty"`
+	DaysUntilExpiry int    `json:"daysUntilExpiry,omitempty"`
+	Expired         bool   `json:"expired,omitempty"`
+	ExpiryDate      string `json:"expiryDate,omitempty"`
+	ID              string `json:"id,omitempty"`
+	IssueDate       string `json:"issueDate,omitempty"`
+	Issuer          string `json:"issuer,omitempty"`
+	Name            string `json:"name,omitempty"`
+}
+
+type ExpiringCertificationReport struct {
+	Certifications []ExpiringCertification `json:"certifications,omitempty"`
+	WithinDays     int                     `json:"withinDays,omitempty"`
+}
+
+type ExportedTags struct {
+	BlogPosts []TagCount `json:"blogPosts,omitempty"`
+	Projects  []TagCount `json:"projects,omitempty"`
+}
+
+type FAQCollection struct {
+	Faqs  []FAQ `json:"faqs,omitempty"`
+	Total int   `json:"total,omitempty"`
+}
+
+type GeoReport struct {
+	Data []CountryViews `json:"data,omitempty"`
+	From string         `json:"from,omitempty"`
+	To   string         `json:"to,omitempty"`
+}
+
+type GitHubProjectImport struct {
+	// DryRun returns the project as it would be created without saving it, e.g. to prefill a form
+	DryRun bool `json:"dryRun,omitempty"`
+	// Type is the project's type, open-source unless given
+	Type string `json:"type,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+type GuestbookEntryCollection struct {
+	Entries []GuestbookEntry `json:"entries,omitempty"`
+	Total   int              `json:"total,omitempty"`
+}
+
+type GuestbookSubmission struct {
+	// Email is a honeypot: the form hides it from people, so anything filled in came from a bot
+	Email   string `json:"email,omitempty"`
+	Message string `json:"message,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Website string `json:"website,omitempty"`
+}
+
+type ImportReport struct {
+	Created int            `json:"created,omitempty"`
+	DryRun  bool           `json:"dryRun,omitempty"`
+	Failed  int            `json:"failed,omitempty"`
+	Posts   []ImportResult `json:"posts,omitempty"`
+	Skipped int            `json:"skipped,omitempty"`
+	Updated int            `json:"updated,omitempty"`
+}
+
+type ImportResult struct {
+	// Attachments lists the URLs of the media attached to the post on its old platform, which aren't copied
+	Attachments []string `json:"attachments,omitempty"`
+	Error       string   `json:"error,omitempty"`
+	File        string   `json:"file,omitempty"`
+	ID          string   `json:"id,omitempty"`
+	Status      string   `json:"status,omitempty"`
+	Title       string   `json:"title,omitempty"`
+}
+
+type IndexAuditReport struct {
+	MissingIndexes []string          `json:"missingIndexes,omitempty"`
+	Plans          []QueryPlanReport `json:"plans,omitempty"`
+}
+
+type JSONFeed struct {
+	Authors     []JSONFeedAuthor `json:"authors,omitempty"`
+	Description string           `json:"description,omitempty"`
+	FeedURL     string           `json:"feed_url,omitempty"`
+	HomePageURL string           `json:"home_page_url,omitempty"`
+	Items       []JSONFeedItem   `json:"items,omitempty"`
+	Language    string           `json:"language,omitempty"`
+	Title       string           `json:"title,omitempty"`
+	Version     string           `json:"version,omitempty"`
+}
+
+type JSONFeedAttachment struct {
+	MimeType string `json:"mime_type,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+type JSONFeedAuthor struct {
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+type JSONFeedItem struct {
+	Attachments   []JSONFeedAttachment `json:"attachments,omitempty"`
+	ContentHtml   string               `json:"content_html,omitempty"`
+	ContentText   string               `json:"content_text,omitempty"`
+	DateModified  string               `json:"date_modified,omitempty"`
+	DatePublished string               `json:"date_published,omitempty"`
+	ID            string               `json:"id,omitempty"`
+	Image         string               `json:"image,omitempty"`
+	Language      string               `json:"language,omitempty"`
+	Summary       string               `json:"summary,omitempty"`
+	Tags          []string             `json:"tags,omitempty"`
+	Title         string               `json:"title,omitempty"`
+	URL           string               `json:"url,omitempty"`
+}
+
+type ListLinks struct {
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+	Self string `json:"self,omitempty"`
+}
+
+type ListMeta struct {
+	NextCursor string `json:"nextCursor,omitempty"`
+	Page       int    `json:"page,omitempty"`
+	PerPage    int    `json:"perPage,omitempty"`
+	Total      int    `json:"total,omitempty"`
+}
+
+type MarkdownDocument struct {
+	Content  string `json:"content,omitempty"`
+	FileName string `json:"fileName,omitempty"`
+}
+
+type MediaObjectCollection struct {
+	Data  []MediaObject `json:"data,omitempty"`
+	Links *ListLinks    `json:"links,omitempty"`
+	Meta  *ListMeta     `json:"meta,omitempty"`
+}
+
+type MetaTag struct {
+	Content  string `json:"content,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Property string `json:"property,omitempty"`
+}
+
+type MicropubConfig struct {
+	MediaEndpoint string                      `json:"media-endpoint,omitempty"`
+	SyndicateTo   []MicropubSyndicationTarget `json:"syndicate-to,omitempty"`
+}
+
+type MicropubError struct {
+	Error            string `json:"error,omitempty"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+type MicropubSyndicationTarget struct {
+	Name string `json:"name,omitempty"`
+	Uid  string `json:"uid,omitempty"`
+}
+
+type NoteCollection struct {
+	Data  []Note     `json:"data,omitempty"`
+	Links *ListLinks `json:"links,omitempty"`
+	Meta  *ListMeta  `json:"meta,omitempty"`
+}
+
+type PageMeta struct {
+	CanonicalURL string    `json:"canonicalUrl,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	Image        string    `json:"image,omitempty"`
+	OpenGraph    []MetaTag `json:"openGraph,omitempty"`
+	Title        string    `json:"title,omitempty"`
+	Twitter      []MetaTag `json:"twitter,omitempty"`
+}
+
+type PageViewRequest struct {
+	ContentID   string `json:"contentId,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+	Path        string `json:"path,omitempty"`
+	Referrer    string `json:"referrer,omitempty"`
+}
+
+type PlatformSuccess struct {
+	Attempts    int     `json:"attempts,omitempty"`
+	Failed      int     `json:"failed,omitempty"`
+	Platform    string  `json:"platform,omitempty"`
+	Succeeded   int     `json:"succeeded,omitempty"`
+	SuccessRate float64 `json:"successRate,omitempty"`
+}
+
+type PopularBlogPost struct {
+	DateAdded          string   `json:"dateAdded,omitempty"`
+	DateEdited         string   `json:"dateEdited,omitempty"`
+	ID                 string   `json:"id,omitempty"`
+	ReadingTimeMinutes int      `json:"readingTimeMinutes,omitempty"`
+	Summary            string   `json:"summary,omitempty"`
+	Tags               []string `json:"tags,omitempty"`
+	Title              string   `json:"title,omitempty"`
+	URL                string   `json:"url,omitempty"`
+	Views              int      `json:"views,omitempty"`
+	WordCount          int      `json:"wordCount,omitempty"`
+}
+
+type PopularBlogPostCollection struct {
+	Data   []PopularBlogPost `json:"data,omitempty"`
+	Period string            `json:"period,omitempty"`
+}
+
+type PreviewLink struct {
+	ExpiresAt string `json:"expiresAt,omitempty"`
+	Token     string `json:"token,omitempty"`
+	URL       string `json:"url,omitempty"`
+}
+
+type ProjectCaseStudy struct {
+	Html      string `json:"html,omitempty"`
+	ProjectID string `json:"project_id,omitempty"`
+	Slug      string `json:"slug,omitempty"`
+	Title     string `json:"title,omitempty"`
+}
+
+type ProjectCollectionWithTags struct {
+	Data  []ProjectWithTags `json:"data,omitempty"`
+	Links *ListLinks        `json:"links,omitempty"`
+	Meta  *ListMeta         `json:"meta,omitempty"`
+}
+
+type ProjectImageCollection struct {
+	Images []ProjectImage `json:"images,omitempty"`
+}
+
+type ProjectSearchResult struct {
+	Project *Project     `json:"project,omitempty"`
+	Score   float64      `json:"score,omitempty"`
+	Tags    []ProjectTag `json:"tags,omitempty"`
+}
+
+type ProjectSearchResults struct {
+	Data  []ProjectSearchResult `json:"data,omitempty"`
+	Query string                `json:"query,omitempty"`
+}
+
+type ProjectSummary struct {
+	DateAdded   string   `json:"date_added,omitempty"`
+	DateEdited  string   `json:"date_edited,omitempty"`
+	DemoLink    string   `json:"demo_link,omitempty"`
+	Description string   `json:"description,omitempty"`
+	GifLink     string   `json:"gif_link,omitempty"`
+	GithubLink  string   `json:"github_link,omitempty"`
+	ID          string   `json:"id,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Title       string   `json:"title,omitempty"`
+	Type        string   `json:"type,omitempty"`
+}
+
+type ProjectSummaryCollection struct {
+	Data  []ProjectSummary `json:"data,omitempty"`
+	Links *ListLinks       `json:"links,omitempty"`
+	Meta  *ListMeta        `json:"meta,omitempty"`
+}
+
+type ProjectWithTags struct {
+	Project *Project     `json:"project,omitempty"`
+	Tags    []ProjectTag `json:"tags,omitempty"`
+}
+
+type QueryPlanReport struct {
+	IndexesUsed []string `json:"indexesUsed,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	SeqScans    []string `json:"seqScans,omitempty"`
+	Sql         string   `json:"sql,omitempty"`
+	TotalCost   float64  `json:"totalCost,omitempty"`
+	Warnings    []string `json:"warnings,omitempty"`
+}
+
+type ReadingList struct {
+	CurrentlyReading []Book `json:"currentlyReading,omitempty"`
+	Finished         []Book `json:"finished,omitempty"`
+}
+
+type RecentChange struct {
+	BlogPost   *BlogPost `json:"blogPost,omitempty"`
+	DateAdded  string    `json:"dateAdded,omitempty"`
+	DateEdited string    `json:"dateEdited,omitempty"`
+	ID         string    `json:"id,omitempty"`
+	Note       *Note     `json:"note,omitempty"`
+	Project    *Project  `json:"project,omitempty"`
+	Type       string    `json:"type,omitempty"`
+}
+
+type RecentChanges struct {
+	Items      []RecentChange `json:"items,omitempty"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+}
+
+type ReferrerReport struct {
+	Data []ContentSources `json:"data,omitempty"`
+	From string           `json:"from,omitempty"`
+	To   string           `json:"to,omitempty"`
+}
+
+type RegionViews struct {
+	Region   string `json:"region,omitempty"`
+	Views    int    `json:"views,omitempty"`
+	Visitors int    `json:"visitors,omitempty"`
+}
+
+type Resume struct {
+	Certifications []Certification  `json:"certifications,omitempty"`
+	Education      []Education      `json:"education,omitempty"`
+	Name           string           `json:"name,omitempty"`
+	WorkExperience []WorkExperience `json:"workExperience,omitempty"`
+}
+
+type ScheduledJobList struct {
+	Data []ScheduledJobStatus `json:"data,omitempty"`
+}
+
+type ScheduledJobStatus struct {
+	Enabled        bool   `json:"enabled,omitempty"`
+	Failures       int    `json:"failures,omitempty"`
+	Interval       string `json:"interval,omitempty"`
+	Jitter         string `json:"jitter,omitempty"`
+	LastDurationMs int    `json:"lastDurationMs,omitempty"`
+	LastError      string `json:"lastError,omitempty"`
+	LastFinishedAt string `json:"lastFinishedAt,omitempty"`
+	LastStartedAt  string `json:"lastStartedAt,omitempty"`
+	Name           string `json:"name,omitempty"`
+	NextRunAt      string `json:"nextRunAt,omitempty"`
+	Running        bool   `json:"running,omitempty"`
+	Runs           int    `json:"runs,omitempty"`
+}
+
+type SchemaDriftReport struct {
+	Drifted bool               `json:"drifted,omitempty"`
+	Tables  []TableDriftReport `json:"tables,omitempty"`
+}
+
+type SearchResult struct {
+	BlogPost *BlogPostSummary `json:"blogPost,omitempty"`
+	Project  *ProjectSummary  `json:"project,omitempty"`
+	Score    float64          `json:"score,omitempty"`
+	Tag      *SearchTag       `json:"tag,omitempty"`
+	Type     string           `json:"type,omitempty"`
+}
+
+type SearchResults struct {
+	Data  []SearchResult `json:"data,omitempty"`
+	Query string         `json:"query,omitempty"`
+}
+
+type SearchTag struct {
+	BlogPosts int    `json:"blogPosts,omitempty"`
+	Projects  int    `json:"projects,omitempty"`
+	Value     string `json:"value,omitempty"`
+}
+
+type SeriesCollection struct {
+	Series []Series `json:"series,omitempty"`
+	Total  int      `json:"total,omitempty"`
+}
+
+type SeriesPostRequest struct {
+	// Position is where the post goes in the series; it's put after the last post when not given
+	Position int `json:"position,omitempty"`
+}
+
+type SeriesPostSummary struct {
+	DateAdded          string   `json:"dateAdded,omitempty"`
+	DateEdited         string   `json:"dateEdited,omitempty"`
+	ID                 string   `json:"id,omitempty"`
+	Position           int      `json:"position,omitempty"`
+	ReadingTimeMinutes int      `json:"readingTimeMinutes,omitempty"`
+	Summary            string   `json:"summary,omitempty"`
+	Tags               []string `json:"tags,omitempty"`
+	Title              string   `json:"title,omitempty"`
+	URL                string   `json:"url,omitempty"`
+	WordCount          int      `json:"wordCount,omitempty"`
+}
+
+type SeriesResponse struct {
+	DateAdded   string              `json:"dateAdded,omitempty"`
+	DateEdited  string              `json:"dateEdited,omitempty"`
+	Description string              `json:"description,omitempty"`
+	ID          string              `json:"id,omitempty"`
+	Posts       []SeriesPostSummary `json:"posts,omitempty"`
+	Slug        string              `json:"slug,omitempty"`
+	Title       string              `json:"title,omitempty"`
+}
+
+type ShortLinkCollection struct {
+	Data  []ShortLinkResponse `json:"data,omitempty"`
+	Links *ListLinks          `json:"links,omitempty"`
+	Meta  *ListMeta           `json:"meta,omitempty"`
+}
+
+type ShortLinkRequest struct {
+	Code        string `json:"code,omitempty"`
+	ContentID   string `json:"contentId,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+	URL         string `json:"url,omitempty"`
+}
+
+type ShortLinkResponse struct {
+	Clicks      int    `json:"clicks,omitempty"`
+	Code        string `json:"code,omitempty"`
+	ContentID   string `json:"contentId,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+	DateAdded   string `json:"dateAdded,omitempty"`
+	ID          string `json:"id,omitempty"`
+	ShortURL    string `json:"shortUrl,omitempty"`
+	TargetURL   string `json:"targetUrl,omitempty"`
+}
+
+type SkillCollection struct {
+	Skills []Skill `json:"skills,omitempty"`
+	Total  int     `json:"total,omitempty"`
+}
+
+type SnippetCollection struct {
+	Data  []Snippet  `json:"data,omitempty"`
+	Links *ListLinks `json:"links,omitempty"`
+	Meta  *ListMeta  `json:"meta,omitempty"`
+}
+
+type SocialPostCollection struct {
+	Data  []SocialPost `json:"data,omitempty"`
+	Links *ListLinks   `json:"links,omitempty"`
+	Meta  *ListMeta    `json:"meta,omitempty"`
+}
+
+type SourceViews struct {
+	Source string `json:"source,omitempty"`
+	Views  int    `json:"views,omitempty"`
+}
+
+type TableDriftReport struct {
+	Drifted        bool                       `json:"drifted,omitempty"`
+	Exists         bool                       `json:"exists,omitempty"`
+	ExtraColumns   []string                   `json:"extraColumns,omitempty"`
+	MissingColumns []string                   `json:"missingColumns,omitempty"`
+	Table          string                     `json:"table,omitempty"`
+	TypeMismatches []ColumnTypeMismatchReport `json:"typeMismatches,omitempty"`
+}
+
+type Tag struct {
+	BlogPosts int    `json:"blogPosts,omitempty"`
+	Projects  int    `json:"projects,omitempty"`
+	Total     int    `json:"total,omitempty"`
+	Value     string `json:"value,omitempty"`
+}
+
+type TagCollection struct {
+	Data []Tag `json:"data,omitempty"`
+}
+
+type TagCount struct {
+	Count int    `json:"count,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+type TagMerge struct {
+	From string `json:"from,omitempty"`
+	Into string `json:"into,omitempty"`
+}
+
+type TagRename struct {
+	Value string `json:"value,omitempty"`
+}
+
+type TestimonialCollection struct {
+	Testimonials []Testimonial `json:"testimonials,omitempty"`
+	Total        int           `json:"total,omitempty"`
+}
+
+type TestimonialSubmission struct {
+	AuthorCompany string `json:"authorCompany,omitempty"`
+	AuthorName    string `json:"authorName,omitempty"`
+	AuthorRole    string `json:"authorRole,omitempty"`
+	Text          string `json:"text,omitempty"`
+}
+
+type Timeline struct {
+	Items      []TimelineItem `json:"items,omitempty"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+}
+
+type TimelineItem struct {
+	BlogPost *BlogPost `json:"blogPost,omitempty"`
+	Date     string    `json:"date,omitempty"`
+	ID       string    `json:"id,omitempty"`
+	Note     *Note     `json:"note,omitempty"`
+	Project  *Project  `json:"project,omitempty"`
+	Type     string    `json:"type,omitempty"`
+}
+
+type TrashedBlogPost struct {
+	BlogPost  *BlogPost `json:"blogPost,omitempty"`
+	DeletedAt string    `json:"deletedAt,omitempty"`
+	Tags      []BlogTag `json:"tags,omitempty"`
+}
+
+type TrashedBlogPostCollection struct {
+	Data  []TrashedBlogPost `json:"data,omitempty"`
+	Links *ListLinks        `json:"links,omitempty"`
+	Meta  *ListMeta         `json:"meta,omitempty"`
+}
+
+type TrashedProject struct {
+	DeletedAt string       `json:"deletedAt,omitempty"`
+	Project   *Project     `json:"project,omitempty"`
+	Tags      []ProjectTag `json:"tags,omitempty"`
+}
+
+type TrashedProjectCollection struct {
+	Data  []TrashedProject `json:"data,omitempty"`
+	Links *ListLinks       `json:"links,omitempty"`
+	Meta  *ListMeta        `json:"meta,omitempty"`
+}
+
+type TrendingItem struct {
+	ID    string  `json:"id,omitempty"`
+	Score float64 `json:"score,omitempty"`
+	Title string  `json:"title,omitempty"`
+	Type  string  `json:"type,omitempty"`
+	Views int     `json:"views,omitempty"`
+}
+
+type TrendingResponse struct {
+	Data        []TrendingItem `json:"data,omitempty"`
+	GeneratedAt string         `json:"generatedAt,omitempty"`
+}
+
+type UnpublishedBlogPosts struct {
+	Data []BlogPostWithTags `json:"data,omitempty"`
+}
+
+type UsesCategory struct {
+	Category string     `json:"category,omitempty"`
+	Items    []UsesItem `json:"items,omitempty"`
+}
+
+type UsesPage struct {
+	Categories []UsesCategory `json:"categories,omitempty"`
+	Total      int            `json:"total,omitempty"`
+}
+
+type WebhookCollection struct {
+	Total    int       `json:"total,omitempty"`
+	Webhooks []Webhook `json:"webhooks,omitempty"`
+}
+
+type WebhookDeliveryCollection struct {
+	Data  []WebhookDelivery `json:"data,omitempty"`
+	Links *ListLinks        `json:"links,omitempty"`
+	Meta  *ListMeta         `json:"meta,omitempty"`
+}
+
+type WorkExperienceCollection struct {
+	Total          int              `json:"total,omitempty"`
+	WorkExperience []WorkExperience `json:"workExperience,omitempty"`
+}
+
+type FieldError struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type BlogPost struct {
+	Content    string `json:"content,omitempty"`
+	DateAdded  string `json:"dateAdded,omitempty"`
+	DateEdited string `json:"dateEdited,omitempty"`
+	ID         string `json:"id,omitempty"`
+	// Images can be given when the post is created, and are managed under /blog-post/{blogPostID}/images after that
+	Images []BlogPostImage `json:"images,omitempty"`
+	// MetaDescription stands in for the summary in search results and link previews
+	MetaDescription string `json:"metaDescription,omitempty"`
+	// MetaTitle stands in for the title in search results and link previews
+	MetaTitle string `json:"metaTitle,omitempty"`
+	// OGImageURL stands in for the first image in the content in link previews
+	OgImageURL string `json:"ogImageUrl,omitempty"`
+	PublishAt  string `json:"publishAt,omitempty"`
+	// ReadingTimeMinutes is how long Content takes to read, rounded up to the minute
+	ReadingTimeMinutes int       `json:"readingTimeMinutes,omitempty"`
+	Status             string    `json:"status,omitempty"`
+	Summary            string    `json:"summary,omitempty"`
+	Tags               []BlogTag `json:"tags,omitempty"`
+	Title              string    `json:"title,omitempty"`
+	// URL is where the post lives, and its canonical URL wherever it's shared or cross-posted
+	URL string `json:"url,omitempty"`
+	// WordCount is how many words Content has, counted whenever it's saved
+	WordCount int `json:"wordCount,omitempty"`
+}
+
+type BlogPostImage struct {
+	AltText    string `json:"altText,omitempty"`
+	BlogPostID string `json:"blogPostId,omitempty"`
+	Caption    string `json:"caption,omitempty"`
+	DateAdded  string `json:"dateAdded,omitempty"`
+	ID         string `json:"id,omitempty"`
+	IsFeatured bool   `json:"isFeatured,omitempty"`
+	// Position orders the gallery, lowest first; images added without one go after the last
+	Position int    `json:"position,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+type BlogPostTranslation struct {
+	BlogPostID string `json:"blogPostId,omitempty"`
+	Content    string `json:"content,omitempty"`
+	DateAdded  string `json:"dateAdded,omitempty"`
+	DateEdited string `json:"dateEdited,omitempty"`
+	ID         string `json:"id,omitempty"`
+	Locale     string `json:"locale,omitempty"`
+	Summary    string `json:"summary,omitempty"`
+	Title      string `json:"title,omitempty"`
+}
+
+type BlogTag struct {
+	BlogPost   *BlogPost `json:"blog_post,omitempty"`
+	BlogPostID string    `json:"blog_post_id,omitempty"`
+	ID         string    `json:"id,omitempty"`
+	Value      string    `json:"value,omitempty"`
+}
+
+type Book struct {
+	Author           string `json:"author,omitempty"`
+	CoverURL         string `json:"coverUrl,omitempty"`
+	DateAdded        string `json:"dateAdded,omitempty"`
+	DateFinished     string `json:"dateFinished,omitempty"`
+	DateStarted      string `json:"dateStarted,omitempty"`
+	FirstPublishYear int    `json:"firstPublishYear,omitempty"`
+	ID               string `json:"id,omitempty"`
+	Isbn             string `json:"isbn,omitempty"`
+	Notes            string `json:"notes,omitempty"`
+	OpenLibraryKey   string `json:"openLibraryKey,omitempty"`
+	Rating           int    `json:"rating,omitempty"`
+	Status           string `json:"status,omitempty"`
+	Title            string `json:"title,omitempty"`
+}
+
+type Bookmark struct {
+	Comment     string        `json:"comment,omitempty"`
+	DateAdded   string        `json:"dateAdded,omitempty"`
+	Description string        `json:"description,omitempty"`
+	ID          string        `json:"id,omitempty"`
+	ImageURL    string        `json:"imageUrl,omitempty"`
+	Tags        []BookmarkTag `json:"tags,omitempty"`
+	Title       string        `json:"title,omitempty"`
+	URL         string        `json:"url,omitempty"`
+}
+
+type BookmarkTag struct {
+	BookmarkID string `json:"bookmark_id,omitempty"`
+	ID         string `json:"id,omitempty"`
+	Value      string `json:"value,omitempty"`
+}
+
+type Certification struct {
+	CredentialID  string `json:"credentialId,omitempty"`
+	CredentialURL string `json:"credentialUrl,omitempty"`
+	ExpiryDate    string `json:"expiryDate,omitempty"`
+	ID            string `json:"id,omitempty"`
+	IssueDate     string `json:"issueDate,omitempty"`
+	Issuer        string `json:"issuer,omitempty"`
+	Name          string `json:"name,omitempty"`
+}
+
+type Education struct {
+	Degree       string `json:"degree,omitempty"`
+	Description  string `json:"description,omitempty"`
+	EndDate      string `json:"endDate,omitempty"`
+	FieldOfStudy string `json:"fieldOfStudy,omitempty"`
+	ID           string `json:"id,omitempty"`
+	Institution  string `json:"institution,omitempty"`
+	StartDate    string `json:"startDate,omitempty"`
+}
+
+type FAQ struct {
+	Answer       string `json:"answer,omitempty"`
+	DisplayOrder int    `json:"displayOrder,omitempty"`
+	ID           string `json:"id,omitempty"`
+	Published    bool   `json:"published,omitempty"`
+	Question     string `json:"question,omitempty"`
+}
+
+type GuestbookEntry struct {
+	Approved      bool   `json:"approved,omitempty"`
+	DateApproved  string `json:"dateApproved,omitempty"`
+	DateSubmitted string `json:"dateSubmitted,omitempty"`
+	ID            string `json:"id,omitempty"`
+	Message       string `json:"message,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Website       string `json:"website,omitempty"`
+}
+
+type MediaObject struct {
+	ContentType string `json:"contentType,omitempty"`
+	DateAdded   string `json:"dateAdded,omitempty"`
+	FileName    string `json:"fileName,omitempty"`
+	Height      int    `json:"height,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Key         string `json:"key,omitempty"`
+	Size        int    `json:"size,omitempty"`
+	// Srcset lists the image and its web-sized variants by width, ready for an img tag's srcset
+	Srcset string `json:"srcset,omitempty"`
+	URL    string `json:"url,omitempty"`
+	// Variants are the resized copies made of an image, stored next to it in the bucket
+	Variants []MediaVariant `json:"variants,omitempty"`
+	// Width and Height are only set for images
+	Width int `json:"width,omitempty"`
+}
+
+type MediaVariant struct {
+	ContentType string `json:"contentType,omitempty"`
+	Height      int    `json:"height,omitempty"`
+	Key         string `json:"key,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Size        int    `json:"size,omitempty"`
+	URL         string `json:"url,omitempty"`
+	Width       int    `json:"width,omitempty"`
+}
+
+type Note struct {
+	Content    string `json:"content,omitempty"`
+	DateAdded  string `json:"dateAdded,omitempty"`
+	DateEdited string `json:"dateEdited,omitempty"`
+	ID         string `json:"id,omitempty"`
+	ImageURL   string `json:"imageUrl,omitempty"`
+}
+
+type Project struct {
+	// CanonicalURL is the project's page on the site, for search engines to index instead of its other addresses
+	CanonicalURL string `json:"canonical_url,omitempty"`
+	// CaseStudy is a long-form write-up of the project in Markdown, served as HTML by GET /project/{slug}/case-study
+	CaseStudy   string `json:"case_study,omitempty"`
+	DateAdded   string `json:"date_added,omitempty"`
+	DateEdited  string `json:"date_edited,omitempty"`
+	DemoLink    string `json:"demo_link,omitempty"`
+	Description string `json:"description,omitempty"`
+	GifLink     string `json:"gif_link,omitempty"`
+	GithubLink  string `json:"github_link,omitempty"`
+	ID          string `json:"id,omitempty"`
+	// Images can be given when the project is created, and are managed under /project/{projectID}/images after that
+	Images []ProjectImage `json:"images,omitempty"`
+	// MetaDescription stands in for the description in search results and link previews
+	MetaDescription string `json:"meta_description,omitempty"`
+	// MetaTitle stands in for the title in search results and link previews
+	MetaTitle string `json:"meta_title,omitempty"`
+	// OGImageURL is the image link previews show
+	OgImageURL string       `json:"og_image_url,omitempty"`
+	Tags       []ProjectTag `json:"tags,omitempty"`
+	Title      string       `json:"title,omitempty"`
+	Type       string       `json:"type,omitempty"`
+}
+
+type ProjectImage struct {
+	AltText   string `json:"alt_text,omitempty"`
+	Caption   string `json:"caption,omitempty"`
+	DateAdded string `json:"date_added,omitempty"`
+	ID        string `json:"id,omitempty"`
+	// Position orders the gallery, lowest first; images added without one go after the last
+	Position  int    `json:"position,omitempty"`
+	ProjectID string `json:"project_id,omitempty"`
+	URL       string `json:"url,omitempty"`
+}
+
+type ProjectTag struct {
+	ID        string   `json:"id,omitempty"`
+	Project   *Project `json:"project,omitempty"`
+	ProjectID string   `json:"project_id,omitempty"`
+	Value     string   `json:"value,omitempty"`
+}
+
+type Series struct {
+	DateAdded   string `json:"dateAdded,omitempty"`
+	DateEdited  string `json:"dateEdited,omitempty"`
+	Description string `json:"description,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Slug        string `json:"slug,omitempty"`
+	Title       string `json:"title,omitempty"`
+}
+
+type SeriesPost struct {
+	BlogPostID string `json:"blogPostId,omitempty"`
+	Position   int    `json:"position,omitempty"`
+	SeriesID   string `json:"seriesId,omitempty"`
+}
+
+type ShareLink struct {
+	Clicks      int    `json:"clicks,omitempty"`
+	ContentID   string `json:"contentId,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+	DateAdded   string `json:"dateAdded,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Platform    string `json:"platform,omitempty"`
+	TargetURL   string `json:"targetUrl,omitempty"`
+	Token       string `json:"token,omitempty"`
+}
+
+type Skill struct {
+	Category          string   `json:"category,omitempty"`
+	ID                string   `json:"id,omitempty"`
+	Name              string   `json:"name,omitempty"`
+	Proficiency       string   `json:"proficiency,omitempty"`
+	ProjectIds        []string `json:"projectIds,omitempty"`
+	YearsOfExperience float64  `json:"yearsOfExperience,omitempty"`
+}
+
+type Snippet struct {
+	Code        string   `json:"code,omitempty"`
+	DateAdded   string   `json:"dateAdded,omitempty"`
+	DateEdited  string   `json:"dateEdited,omitempty"`
+	Description string   `json:"description,omitempty"`
+	ID          string   `json:"id,omitempty"`
+	Language    string   `json:"language,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Title       string   `json:"title,omitempty"`
+}
+
+type SocialPost struct {
+	ContentID   string     `json:"contentId,omitempty"`
+	ContentType string     `json:"contentType,omitempty"`
+	DateAdded   string     `json:"dateAdded,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	ID          string     `json:"id,omitempty"`
+	Platform    string     `json:"platform,omitempty"`
+	ShareLink   *ShareLink `json:"shareLink,omitempty"`
+	ShareLinkID string     `json:"shareLinkId,omitempty"`
+	Status      string     `json:"status,omitempty"`
+}
+
+type Testimonial struct {
+	Approved      bool   `json:"approved,omitempty"`
+	AuthorCompany string `json:"authorCompany,omitempty"`
+	AuthorName    string `json:"authorName,omitempty"`
+	AuthorRole    string `json:"authorRole,omitempty"`
+	DateApproved  string `json:"dateApproved,omitempty"`
+	DateSubmitted string `json:"dateSubmitted,omitempty"`
+	ID            string `json:"id,omitempty"`
+	Text          string `json:"text,omitempty"`
+}
+
+type UsesItem struct {
+	Category     string `json:"category,omitempty"`
+	Description  string `json:"description,omitempty"`
+	DisplayOrder int    `json:"displayOrder,omitempty"`
+	ID           string `json:"id,omitempty"`
+	Link         string `json:"link,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+type Webhook struct {
+	Active      bool     `json:"active,omitempty"`
+	DateAdded   string   `json:"dateAdded,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Events      []string `json:"events,omitempty"`
+	ID          string   `json:"id,omitempty"`
+	Secret      string   `json:"secret,omitempty"`
+	URL         string   `json:"url,omitempty"`
+}
+
+type WebhookDelivery struct {
+	Attempts       int                   `json:"attempts,omitempty"`
+	DateAdded      string                `json:"dateAdded,omitempty"`
+	DateDelivered  string                `json:"dateDelivered,omitempty"`
+	Event          string                `json:"event,omitempty"`
+	ID             string                `json:"id,omitempty"`
+	LastError      string                `json:"lastError,omitempty"`
+	LastStatusCode int                   `json:"lastStatusCode,omitempty"`
+	NextAttemptAt  string                `json:"nextAttemptAt,omitempty"`
+	Payload        json.RawMessage       `json:"payload,omitempty"`
+	Status         WebhookDeliveryStatus `json:"status,omitempty"`
+	WebhookID      string                `json:"webhookId,omitempty"`
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+type WorkExperience struct {
+	Company     string   `json:"company,omitempty"`
+	Description string   `json:"description,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	Highlights  []string `json:"highlights,omitempty"`
+	ID          string   `json:"id,omitempty"`
+	Location    string   `json:"location,omitempty"`
+	Role        string   `json:"role,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	TechTags    []string `json:"techTags,omitempty"`
+}
+
+// GetGeographicBreakdownParams holds the optional parameters of GetGeographicBreakdown
+// Zero values are left out of the request
+type GetGeographicBreakdownParams struct {
+	// First day to include (YYYY-MM-DD, UTC). Defaults to 29 days before to
+	From string
+	// Last day to include (YYYY-MM-DD, UTC). Defaults to today
+	To string
+	// Only include this content type
+	ContentType string
+	// Only include this blog post or project
+	ContentID string
+}
+
+// GetGeographicBreakdown returns page views and unique visitors per country (ISO 3166-1 alpha-2) and region (ISO 3166-2 subdivision code) over a date range, most viewed first. Locations are only recorded when GEOIP_DB_PATH points at a MaxMind database; views without one are counted under country "". Unique visitors are counted once per day they visited. Raw page views are kept for 90 days, so older ranges come back empty
+//
+// GET /admin/analytics/geo (admin)
+func (c *Client) GetGeographicBreakdown(ctx context.Context, params *GetGeographicBreakdownParams) (*GeoReport, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.From != "" {
+			query.Set("from", params.From)
+		}
+		if params.To != "" {
+			query.Set("to", params.To)
+		}
+		if params.ContentType != "" {
+			query.Set("contentType", params.ContentType)
+		}
+		if params.ContentID != "" {
+			query.Set("contentId", params.ContentID)
+		}
+	}
+	var result GeoReport
+	if err := c.do(ctx, "GET", "/admin/analytics/geo", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAnalyticsOverviewParams holds the optional parameters of GetAnalyticsOverview
+// Zero values are left out of the request
+type GetAnalyticsOverviewParams struct {
+	// First day to include (YYYY-MM-DD, UTC). Defaults to 29 days before to
+	From string
+	// Last day to include (YYYY-MM-DD, UTC). Defaults to today
+	To string
+}
+
+// GetAnalyticsOverview returns page views and unique visitors per day (every day in the range, including zeros), the 10 most viewed blog posts and projects, and per-platform social posting success rates, for one date range
+//
+// GET /admin/analytics/overview (admin)
+func (c *Client) GetAnalyticsOverview(ctx context.Context, params *GetAnalyticsOverviewParams) (*AnalyticsOverview, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.From != "" {
+			query.Set("from", params.From)
+		}
+		if params.To != "" {
+			query.Set("to", params.To)
+		}
+	}
+	var result AnalyticsOverview
+	if err := c.do(ctx, "GET", "/admin/analytics/overview", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetReferrersAndUTMSourcesParams holds the optional parameters of GetReferrersAndUTMSources
+// Zero values are left out of the request
+type GetReferrersAndUTMSourcesParams struct {
+	// First day to include (YYYY-MM-DD, UTC). Defaults to 29 days before to
+	From string
+	// Last day to include (YYYY-MM-DD, UTC). Defaults to today
+	To string
+	// Only include this content type
+	ContentType string
+	// Only include this blog post or project
+	ContentID string
+	// Maximum referrers and UTM sources listed per entry (max 100)
+	Limit int
+}
+
+// GetReferrersAndUTMSources returns the top referring hosts and utm_source values for each blog post and project over a date range, most viewed content first. Views without a referrer are counted as "(direct)". Raw page views are kept for 90 days, so older ranges come back empty
+//
+// GET /admin/analytics/referrers (admin)
+func (c *Client) GetReferrersAndUTMSources(ctx context.Context, params *GetReferrersAndUTMSourcesParams) (*ReferrerReport, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.From != "" {
+			query.Set("from", params.From)
+		}
+		if params.To != "" {
+			query.Set("to", params.To)
+		}
+		if params.ContentType != "" {
+			query.Set("contentType", params.ContentType)
+		}
+		if params.ContentID != "" {
+			query.Set("contentId", params.ContentID)
+		}
+		if params.Limit != 0 {
+			query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var result ReferrerReport
+	if err := c.do(ctx, "GET", "/admin/analytics/referrers", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RunBenchmarkParams holds the optional parameters of RunBenchmark
+// Zero values are left out of the request
+type RunBenchmarkParams struct {
+	// Requests per endpoint (default 100, at most 1000)
+	Requests int
+	// Requests in flight at once (default 4, at most 32)
+	Concurrency int
+	// Blog posts and projects to seed (default 50, at most 1000)
+	Seed int
+	// Search query (default benchmark, which matches the seeded content)
+	Q string
+}
+
+// RunBenchmark seeds published blog posts and projects, sends each list, detail and search endpoint the given number of GET requests through the router with the given concurrency, then removes the seed and reports latencies per endpoint. With seed=0 the existing content is used instead. Endpoints run one after another, and cacheHits shows how many responses came from the response cache. Benchmark requests aren't counted as views. Not available when APP_ENV=production
+//
+// POST /admin/bench (admin)
+func (c *Client) RunBenchmark(ctx context.Context, params *RunBenchmarkParams) (*BenchReport, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Requests != 0 {
+			query.Set("requests", strconv.Itoa(params.Requests))
+		}
+		if params.Concurrency != 0 {
+			query.Set("concurrency", strconv.Itoa(params.Concurrency))
+		}
+		if params.Seed != 0 {
+			query.Set("seed", strconv.Itoa(params.Seed))
+		}
+		if params.Q != "" {
+			query.Set("q", params.Q)
+		}
+	}
+	var result BenchReport
+	if err := c.do(ctx, "POST", "/admin/bench", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetUnpublishedBlogPosts retrieves every draft and scheduled blog post with its tags, scheduled posts first in publishing order, then drafts newest first
+//
+// GET /admin/blog-posts/unpublished (admin)
+func (c *Client) GetUnpublishedBlogPosts(ctx context.Context) (*UnpublishedBlogPosts, error) {
+	var result UnpublishedBlogPosts
+	if err := c.do(ctx, "GET", "/admin/blog-posts/unpublished", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpiringCertificationsParams holds the optional parameters of GetExpiringCertifications
+// Zero values are left out of the request
+type GetExpiringCertificationsParams struct {
+	// Look-ahead window in days
+	Days int
+}
+
+// GetExpiringCertifications lists certifications that have already expired or will expire within the given number of days, soonest first
+//
+// GET /admin/certifications/expiring (admin)
+func (c *Client) GetExpiringCertifications(ctx context.Context, params *GetExpiringCertificationsParams) (*ExpiringCertificationReport, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Days != 0 {
+			query.Set("days", strconv.Itoa(params.Days))
+		}
+	}
+	var result ExpiringCertificationReport
+	if err := c.do(ctx, "GET", "/admin/certifications/expiring", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CheckDataConsistencyParams holds the optional parameters of CheckDataConsistency
+// Zero values are left out of the request
+type CheckDataConsistencyParams struct {
+	// Whether to request every image URL to check it loads, which is the slow part
+	Media bool
+}
+
+// CheckDataConsistency looks for tags whose blog post, project or bookmark is gone, blog posts whose word count or reading time doesn't match their content, titles with no slug or one shared with another post, social post records for deleted blog posts or notes, and note, bookmark, project and blog post images that don't load. Nothing is changed; POST /admin/doctor/fix repairs the issues marked fixable
+//
+// GET /admin/doctor (admin)
+func (c *Client) CheckDataConsistency(ctx context.Context, params *CheckDataConsistencyParams) (*DoctorReport, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Media {
+			query.Set("media", "true")
+		}
+	}
+	var result DoctorReport
+	if err := c.do(ctx, "GET", "/admin/doctor", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// FixDataConsistencyIssuesParams holds the optional parameters of FixDataConsistencyIssues
+// Zero values are left out of the request
+type FixDataConsistencyIssuesParams struct {
+	// Whether to request every image URL to check it loads, which is the slow part
+	Media bool
+}
+
+// FixDataConsistencyIssues deletes orphaned tags and social post records for deleted content and recounts blog post words, then reports the issues that are left, as GET /admin/doctor does. Slug and media issues are only reported
+//
+// POST /admin/doctor/fix (admin)
+func (c *Client) FixDataConsistencyIssues(ctx context.Context, params *FixDataConsistencyIssuesParams) (*DoctorReport, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Media {
+			query.Set("media", "true")
+		}
+	}
+	var result DoctorReport
+	if err := c.do(ctx, "POST", "/admin/doctor/fix", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ExportAllBlogPosts streams every blog post, including drafts and scheduled posts, with its full content and tags, in ID order. The response is sent in chunks as posts are read, so it isn't limited in size. If an error happens partway, the response is cut off, leaving invalid JSON, so a truncated export can't pass as complete
+//
+// GET /admin/export/blog-posts (admin)
+func (c *Client) ExportAllBlogPosts(ctx context.Context) (*BlogPostExport, error) {
+	var result BlogPostExport
+	if err := c.do(ctx, "GET", "/admin/export/blog-posts", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAllFAQs retrieves all FAQs, published or not, ordered by display order
+//
+// GET /admin/faqs (admin)
+func (c *Client) GetAllFAQs(ctx context.Context) (*FAQCollection, error) {
+	var result FAQCollection
+	if err := c.do(ctx, "GET", "/admin/faqs", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetGuestbookEntriesForModerationParams holds the optional parameters of GetGuestbookEntriesForModeration
+// Zero values are left out of the request
+type GetGuestbookEntriesForModerationParams struct {
+	// Moderation status
+	Status string
+}
+
+// GetGuestbookEntriesForModeration retrieves guestbook entries filtered by moderation status (pending, approved or all). Defaults to pending
+//
+// GET /admin/guestbook (admin)
+func (c *Client) GetGuestbookEntriesForModeration(ctx context.Context, params *GetGuestbookEntriesForModerationParams) (*GuestbookEntryCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Status != "" {
+			query.Set("status", params.Status)
+		}
+	}
+	var result GuestbookEntryCollection
+	if err := c.do(ctx, "GET", "/admin/guestbook", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteGuestbookEntry deletes a guestbook entry from the database by ID
+//
+// DELETE /admin/guestbook-entry/{entryID} (admin)
+func (c *Client) DeleteGuestbookEntry(ctx context.Context, entryID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/admin/guestbook-entry/"+url.PathEscape(entryID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ApproveGuestbookEntry approves a guestbook entry so it is shown publicly
+//
+// POST /admin/guestbook-entry/{entryID}/approve (admin)
+func (c *Client) ApproveGuestbookEntry(ctx context.Context, entryID string) (*GuestbookEntry, error) {
+	var result GuestbookEntry
+	if err := c.do(ctx, "POST", "/admin/guestbook-entry/"+url.PathEscape(entryID)+"/approve", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RejectGuestbookEntry marks a guestbook entry as not approved, hiding it from the public guestbook
+//
+// POST /admin/guestbook-entry/{entryID}/reject (admin)
+func (c *Client) RejectGuestbookEntry(ctx context.Context, entryID string) (*GuestbookEntry, error) {
+	var result GuestbookEntry
+	if err := c.do(ctx, "POST", "/admin/guestbook-entry/"+url.PathEscape(entryID)+"/reject", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AuditIndexes runs EXPLAIN on the queries behind the blog post and project lists, archive, tags and scheduled publishing, and lists the indexes they rely on that don't exist. A sequential scan on a small table is expected; one on a table missing an index is flagged with how to create it
+//
+// GET /admin/indexes (admin)
+func (c *Client) AuditIndexes(ctx context.Context) (*IndexAuditReport, error) {
+	var result IndexAuditReport
+	if err := c.do(ctx, "GET", "/admin/indexes", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetScheduledJobs lists the recurring background jobs hosted by this instance with their schedule, whether they are enabled, and the outcome of their last run. Statuses are kept in memory, so they start empty after a restart and only cover this instance
+//
+// GET /admin/jobs (admin)
+func (c *Client) GetScheduledJobs(ctx context.Context) (*ScheduledJobList, error) {
+	var result ScheduledJobList
+	if err := c.do(ctx, "GET", "/admin/jobs", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetErrorMetrics counts the error responses sent by this instance since it started, by error code and HTTP status, most frequent first. Counts are kept in memory, so they start over after a restart and only cover this instance
+//
+// GET /admin/metrics (admin)
+func (c *Client) GetErrorMetrics(ctx context.Context) (*ErrorMetrics, error) {
+	var result ErrorMetrics
+	if err := c.do(ctx, "GET", "/admin/metrics", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ReportSchemaDrift compares each table the models are migrated to with its model, in order of table name: columns the table has that the model doesn't (extraColumns), model columns the table is missing (missingColumns, every column when the table doesn't exist) and columns whose type differs from the one the model's gorm tag declares. It's the column-report command's comparison, for checking a deployed instance. Running migrate fixes missing tables and columns; extra columns and type changes have to be dealt with by hand
+//
+// GET /admin/schema/drift (admin)
+func (c *Client) ReportSchemaDrift(ctx context.Context) (*SchemaDriftReport, error) {
+	var result SchemaDriftReport
+	if err := c.do(ctx, "GET", "/admin/schema/drift", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateShortLink creates a short link, served at /s/{code}. A blog post or project gets at most one short link, so asking for one it already has returns that link with 200 instead; its target is updated if the content's URL has changed. Blog post links point at the post's URL, or BASE_URL/blog/{id}, and project links at BASE_URL/projects/{id}. code is generated unless given
+//
+// POST /admin/short-link (admin)
+func (c *Client) CreateShortLink(ctx context.Context, body ShortLinkRequest) (*ShortLinkResponse, error) {
+	var result ShortLinkResponse
+	if err := c.do(ctx, "POST", "/admin/short-link", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteShortLink deletes a short link by ID, so its code stops redirecting. A blog post or project whose link is deleted gets a new code the next time one is made for it
+//
+// DELETE /admin/short-link/{shortLinkID} (admin)
+func (c *Client) DeleteShortLink(ctx context.Context, shortLinkID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/admin/short-link/"+url.PathEscape(shortLinkID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetShortLinksParams holds the optional parameters of GetShortLinks
+// Zero values are left out of the request
+type GetShortLinksParams struct {
+	// Page number (starts at 1)
+	Page int
+	// Short links per page (max 100)
+	PerPage int
+}
+
+// GetShortLinks retrieves every short link, newest first, with its click count and, when SHORT_LINK_BASE_URL is set, the URL it's shared as
+//
+// GET /admin/short-links (admin)
+func (c *Client) GetShortLinks(ctx context.Context, params *GetShortLinksParams) (*ShortLinkCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Page != 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage != 0 {
+			query.Set("perPage", strconv.Itoa(params.PerPage))
+		}
+	}
+	var result ShortLinkCollection
+	if err := c.do(ctx, "GET", "/admin/short-links", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSocialPostsParams holds the optional parameters of GetSocialPosts
+// Zero values are left out of the request
+type GetSocialPostsParams struct {
+	// Only include this content type
+	ContentType string
+	// Only include this blog post or note
+	ContentID string
+	// Page number (starts at 1)
+	Page int
+	// Social posts per page (max 100)
+	PerPage int
+}
+
+// GetSocialPosts retrieves the record of every attempt to share a blog post or note on a social platform, newest first, with the tracked share link and its click count when one was used
+//
+// GET /admin/social-posts (admin)
+func (c *Client) GetSocialPosts(ctx context.Context, params *GetSocialPostsParams) (*SocialPostCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.ContentType != "" {
+			query.Set("contentType", params.ContentType)
+		}
+		if params.ContentID != "" {
+			query.Set("contentId", params.ContentID)
+		}
+		if params.Page != 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage != 0 {
+			query.Set("perPage", strconv.Itoa(params.PerPage))
+		}
+	}
+	var result SocialPostCollection
+	if err := c.do(ctx, "GET", "/admin/social-posts", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CheckSocialPlatformCredentialsParams holds the optional parameters of CheckSocialPlatformCredentials
+// Zero values are left out of the request
+type CheckSocialPlatformCredentialsParams struct {
+	// Comma-separated platforms to check (default all). Valid values: substack, medium, twitter, linkedin, mastodon
+	Platforms string
+}
+
+// CheckSocialPlatformCredentials verifies each configured platform's credentials with a lightweight authenticated call that posts nothing, reporting the account they belong to and, where the platform says, when the token expires. Tokens expiring within 14 days are reported as expiring. healthy is false when any configured platform's credentials are invalid, expiring or couldn't be checked; unconfigured platforms don't count against it
+//
+// GET /admin/social/health (admin)
+func (c *Client) CheckSocialPlatformCredentials(ctx context.Context, params *CheckSocialPlatformCredentialsParams) (*CredentialHealthReport, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Platforms != "" {
+			query.Set("platforms", params.Platforms)
+		}
+	}
+	var result CredentialHealthReport
+	if err := c.do(ctx, "GET", "/admin/social/health", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteTestimonial deletes a testimonial from the database by ID
+//
+// DELETE /admin/testimonial/{testimonialID} (admin)
+func (c *Client) DeleteTestimonial(ctx context.Context, testimonialID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/admin/testimonial/"+url.PathEscape(testimonialID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ApproveTestimonial approves a testimonial so it is shown publicly
+//
+// POST /admin/testimonial/{testimonialID}/approve (admin)
+func (c *Client) ApproveTestimonial(ctx context.Context, testimonialID string) (*Testimonial, error) {
+	var result Testimonial
+	if err := c.do(ctx, "POST", "/admin/testimonial/"+url.PathEscape(testimonialID)+"/approve", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RejectTestimonial marks a testimonial as not approved, hiding it from the public listing
+//
+// POST /admin/testimonial/{testimonialID}/reject (admin)
+func (c *Client) RejectTestimonial(ctx context.Context, testimonialID string) (*Testimonial, error) {
+	var result Testimonial
+	if err := c.do(ctx, "POST", "/admin/testimonial/"+url.PathEscape(testimonialID)+"/reject", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetTestimonialsForModerationParams holds the optional parameters of GetTestimonialsForModeration
+// Zero values are left out of the request
+type GetTestimonialsForModerationParams struct {
+	// Moderation status
+	Status string
+}
+
+// GetTestimonialsForModeration retrieves testimonials filtered by moderation status (pending, approved or all). Defaults to pending
+//
+// GET /admin/testimonials (admin)
+func (c *Client) GetTestimonialsForModeration(ctx context.Context, params *GetTestimonialsForModerationParams) (*TestimonialCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Status != "" {
+			query.Set("status", params.Status)
+		}
+	}
+	var result TestimonialCollection
+	if err := c.do(ctx, "GET", "/admin/testimonials", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateWebhook subscribes a URL to one or more events (post.published, project.created, social.post.failed). A signing secret is generated when none is given. Deliveries are POSTed as JSON with X-Webhook-Event, X-Webhook-Delivery, X-Webhook-Timestamp and X-Webhook-Signature (sha256=HMAC-SHA256 of "<timestamp>.<body>") headers
+//
+// POST /admin/webhook (admin)
+func (c *Client) CreateWebhook(ctx context.Context, body Webhook) (*Webhook, error) {
+	var result Webhook
+	if err := c.do(ctx, "POST", "/admin/webhook", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RetryWebhookDelivery puts a delivery back in the queue with a fresh set of attempts, e.g. after fixing the receiving endpoint
+//
+// POST /admin/webhook-delivery/{deliveryID}/retry (admin)
+func (c *Client) RetryWebhookDelivery(ctx context.Context, deliveryID string) (*WebhookDelivery, error) {
+	var result WebhookDelivery
+	if err := c.do(ctx, "POST", "/admin/webhook-delivery/"+url.PathEscape(deliveryID)+"/retry", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetWebhook retrieves a specific webhook subscription by ID
+//
+// GET /admin/webhook/{webhookID} (admin)
+func (c *Client) GetWebhook(ctx context.Context, webhookID string) (*Webhook, error) {
+	var result Webhook
+	if err := c.do(ctx, "GET", "/admin/webhook/"+url.PathEscape(webhookID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateWebhook updates a webhook's URL, events, description or active flag. The secret is kept when none is given
+//
+// PUT /admin/webhook/{webhookID} (admin)
+func (c *Client) UpdateWebhook(ctx context.Context, webhookID string, body Webhook) (*Webhook, error) {
+	var result Webhook
+	if err := c.do(ctx, "PUT", "/admin/webhook/"+url.PathEscape(webhookID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteWebhook deletes a webhook subscription by ID along with its delivery log
+//
+// DELETE /admin/webhook/{webhookID} (admin)
+func (c *Client) DeleteWebhook(ctx context.Context, webhookID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/admin/webhook/"+url.PathEscape(webhookID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetWebhookDeliveriesParams holds the optional parameters of GetWebhookDeliveries
+// Zero values are left out of the request
+type GetWebhookDeliveriesParams struct {
+	// Page number (starts at 1)
+	Page int
+	// Deliveries per page (max 100)
+	PerPage int
+}
+
+// GetWebhookDeliveries retrieves the delivery log for a webhook, newest first, with status, attempt count and the last response or error
+//
+// GET /admin/webhook/{webhookID}/deliveries (admin)
+func (c *Client) GetWebhookDeliveries(ctx context.Context, webhookID string, params *GetWebhookDeliveriesParams) (*WebhookDeliveryCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Page != 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage != 0 {
+			query.Set("perPage", strconv.Itoa(params.PerPage))
+		}
+	}
+	var result WebhookDeliveryCollection
+	if err := c.do(ctx, "GET", "/admin/webhook/"+url.PathEscape(webhookID)+"/deliveries", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetWebhooks retrieves all webhook subscriptions, including their signing secrets
+//
+// GET /admin/webhooks (admin)
+func (c *Client) GetWebhooks(ctx context.Context) (*WebhookCollection, error) {
+	var result WebhookCollection
+	if err := c.do(ctx, "GET", "/admin/webhooks", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RecordPageView records one page view for first-party analytics. UTM tags are read from the query string of path, then the query string and fragment are dropped; only the host of referrer is kept, and the user agent is stored as a SHA-256 hash. Unique visitors are counted with a salted hash of IP and user agent whose salt changes daily and is then deleted. When GEOIP_DB_PATH is set, only the country and region of the IP are stored. Views are rolled up into daily per-path counts in the background. When ANALYTICS_PROVIDER is plausible or umami the view is forwarded there instead and nothing is stored
+//
+// POST /analytics/pageview
+func (c *Client) RecordPageView(ctx context.Context, body PageViewRequest) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "POST", "/analytics/pageview", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RunBatchParams holds the optional parameters of RunBatch
+// Zero values are left out of the request
+type RunBatchParams struct {
+	// Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)
+	IdempotencyKey string
+}
+
+// RunBatch runs a list of createBlogPost, createProject and attachTags operations in a single transaction. If any operation fails the whole batch is rolled back; the results show which operation failed and which were skipped. Blog posts created here are not cross-posted to social platforms
+//
+// POST /batch
+func (c *Client) RunBatch(ctx context.Context, body BatchRequest, params *RunBatchParams) (*BatchResponse, error) {
+	header := http.Header{}
+	if params != nil {
+		if params.IdempotencyKey != "" {
+			header.Set("Idempotency-Key", params.IdempotencyKey)
+		}
+	}
+	var result BatchResponse
+	if err := c.do(ctx, "POST", "/batch", nil, header, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateBlogPostParams holds the optional parameters of CreateBlogPost
+// Zero values are left out of the request
+type CreateBlogPostParams struct {
+	// Deprecated: feature one of the post's images instead. Main image URL for Substack posting, used when the post has no featured image
+	MainImageURL string
+	// Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)
+	IdempotencyKey string
+}
+
+// CreateBlogPost creates a new blog post in the database. Published posts (the default) are posted to all configured social media platforms right away; status draft keeps the post hidden, and status scheduled with publishAt has the scheduler publish it at that time. Images can be given with the post, in gallery order, and at most one of them featured; the featured image is the post's main image on Substack and the other platforms
+//
+// POST /blog-post
+func (c *Client) CreateBlogPost(ctx context.Context, body BlogPost, params *CreateBlogPostParams) (*BlogPostWithTags, error) {
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		if params.MainImageURL != "" {
+			query.Set("mainImageURL", params.MainImageURL)
+		}
+		if params.IdempotencyKey != "" {
+			header.Set("Idempotency-Key", params.IdempotencyKey)
+		}
+	}
+	var result BlogPostWithTags
+	if err := c.do(ctx, "POST", "/blog-post", query, header, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetBlogPostParams holds the optional parameters of GetBlogPost
+// Zero values are left out of the request
+type GetBlogPostParams struct {
+	// Preview token, which lets anyone read the draft until it expires
+	Preview string
+	// Locale to serve the post's translation in, e.g. pt; Accept-Language is used when not given
+	Locale string
+	// Whether content is served as the Markdown it's written in or rendered as sanitized HTML
+	Format string
+}
+
+// GetBlogPost retrieves detailed information about a specific blog post by ID with its tags. Drafts and scheduled posts are only returned with the backend password, or a preview token from POST /blog-post/{blogPostID}/preview-link. The post's translation into the negotiated locale is served in its place when there is one, and Content-Language says which was served
+//
+// GET /blog-post/{blogPostID}
+func (c *Client) GetBlogPost(ctx context.Context, blogPostID string, params *GetBlogPostParams) (*BlogPostWithTags, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Preview != "" {
+			query.Set("preview", params.Preview)
+		}
+		if params.Locale != "" {
+			query.Set("locale", params.Locale)
+		}
+		if params.Format != "" {
+			query.Set("format", params.Format)
+		}
+	}
+	var result BlogPostWithTags
+	if err := c.do(ctx, "GET", "/blog-post/"+url.PathEscape(blogPostID), query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateBlogPost updates an existing blog post in the database. Status and publishAt are kept when left out; changing the status to published publishes the post now, dated now unless dateAdded is given. Images are left as they are; they're changed under /blog-post/{blogPostID}/images
+//
+// PUT /blog-post/{blogPostID}
+func (c *Client) UpdateBlogPost(ctx context.Context, blogPostID string, body BlogPost) (*BlogPostWithTags, error) {
+	var result BlogPostWithTags
+	if err := c.do(ctx, "PUT", "/blog-post/"+url.PathEscape(blogPostID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteBlogPostParams holds the optional parameters of DeleteBlogPost
+// Zero values are left out of the request
+type DeleteBlogPostParams struct {
+	// Delete permanently instead of moving to the trash
+	Permanent bool
+}
+
+// DeleteBlogPost moves a blog post to the trash by ID, from where POST /blog-post/{blogPostID}/restore brings it back. With permanent=true, which requires the backend password, the blog post and its tags are removed from the database instead, whether or not it's in the trash
+//
+// DELETE /blog-post/{blogPostID}
+func (c *Client) DeleteBlogPost(ctx context.Context, blogPostID string, params *DeleteBlogPostParams) (map[string]string, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Permanent {
+			query.Set("permanent", "true")
+		}
+	}
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/blog-post/"+url.PathEscape(blogPostID), query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DuplicateBlogPost copies a blog post's content, summary, tags, images and SEO metadata into a new draft, for reusing the format of a recurring post. Drafts and scheduled posts can be copied too, which is why this needs the backend password. The copy is titled after the original with " (copy)" after it, numbered when that's taken, e.g. "Weekly Notes (copy 2)", so its slug is one no other post has. The original's url, translations and series aren't copied. Duplicating doesn't cross-post or announce anything
+//
+// POST /blog-post/{blogPostID}/duplicate (admin)
+func (c *Client) DuplicateBlogPost(ctx context.Context, blogPostID string) (*BlogPostWithTags, error) {
+	var result BlogPostWithTags
+	if err := c.do(ctx, "POST", "/blog-post/"+url.PathEscape(blogPostID)+"/duplicate", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateBlogPostImage replaces an image's URL, alt text, caption, position and whether it's featured. Featuring it takes over from the post's previous featured image
+//
+// PUT /blog-post/{blogPostID}/image/{imageID}
+func (c *Client) UpdateBlogPostImage(ctx context.Context, blogPostID string, imageID string, body BlogPostImage) (*BlogPostImage, error) {
+	var result BlogPostImage
+	if err := c.do(ctx, "PUT", "/blog-post/"+url.PathEscape(blogPostID)+"/image/"+url.PathEscape(imageID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteBlogPostImage removes an image from a blog post's gallery. The other images keep their positions, and a post whose featured image is deleted has none until another is featured
+//
+// DELETE /blog-post/{blogPostID}/image/{imageID}
+func (c *Client) DeleteBlogPostImage(ctx context.Context, blogPostID string, imageID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/blog-post/"+url.PathEscape(blogPostID)+"/image/"+url.PathEscape(imageID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetBlogPostImages lists a blog post's images in gallery order, by position. The featured image, if any, is marked isFeatured. Only admins can see the images of drafts and scheduled posts
+//
+// GET /blog-post/{blogPostID}/images
+func (c *Client) GetBlogPostImages(ctx context.Context, blogPostID string) (*BlogPostImageCollection, error) {
+	var result BlogPostImageCollection
+	if err := c.do(ctx, "GET", "/blog-post/"+url.PathEscape(blogPostID)+"/images", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AddBlogPostImage adds an image to a blog post's gallery, after its last image unless a position is given. Featuring it makes it the post's main image in feeds, link previews and cross-posts, in place of the post's previous featured image
+//
+// POST /blog-post/{blogPostID}/images
+func (c *Client) AddBlogPostImage(ctx context.Context, blogPostID string, body BlogPostImage) (*BlogPostImage, error) {
+	var result BlogPostImage
+	if err := c.do(ctx, "POST", "/blog-post/"+url.PathEscape(blogPostID)+"/images", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetBlogPostMetadataParams holds the optional parameters of GetBlogPostMetadata
+// Zero values are left out of the request
+type GetBlogPostMetadataParams struct {
+	// Locale to describe a translated post in, e.g. pt; Accept-Language is used when not given
+	Locale string
+}
+
+// GetBlogPostMetadata returns a published blog post's title, description, canonical URL and image for search engines, along with the OpenGraph and Twitter card meta tags for link previews, ready to render. The post's metaTitle, metaDescription and ogImageUrl are used when set, and its title, summary and featured image, or first image in its content, otherwise. The canonical URL is the post's url, or BASE_URL/blog/{id}. Posts translated into the negotiated locale are described in it, by their translated title and summary, with the canonical URL of the translation
+//
+// GET /blog-post/{blogPostID}/meta
+func (c *Client) GetBlogPostMetadata(ctx context.Context, blogPostID string, params *GetBlogPostMetadataParams) (*PageMeta, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Locale != "" {
+			query.Set("locale", params.Locale)
+		}
+	}
+	var result PageMeta
+	if err := c.do(ctx, "GET", "/blog-post/"+url.PathEscape(blogPostID)+"/meta", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateBlogPostPreviewLinkParams holds the optional parameters of CreateBlogPostPreviewLink
+// Zero values are left out of the request
+type CreateBlogPostPreviewLinkParams struct {
+	// How long the link works, as a duration such as 24h (defaults to PREVIEW_LINK_TTL, 72h unless set; at most 720h)
+	ExpiresIn string
+}
+
+// CreateBlogPostPreviewLink signs a link that lets anyone holding it read a draft or scheduled post, with GET /blog-post/{blogPostID} or its export.md, until it expires. Give the token to the frontend as ?preview= to render the post for reviewers. Nothing is stored, so a link can't be revoked on its own; changing PREVIEW_LINK_SECRET revokes them all
+//
+// POST /blog-post/{blogPostID}/preview-link (admin)
+func (c *Client) CreateBlogPostPreviewLink(ctx context.Context, blogPostID string, params *CreateBlogPostPreviewLinkParams) (*PreviewLink, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.ExpiresIn != "" {
+			query.Set("expiresIn", params.ExpiresIn)
+		}
+	}
+	var result PreviewLink
+	if err := c.do(ctx, "POST", "/blog-post/"+url.PathEscape(blogPostID)+"/preview-link", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateBlogPostPreviewTokenParams holds the optional parameters of CreateBlogPostPreviewToken
+// Zero values are left out of the request
+type CreateBlogPostPreviewTokenParams struct {
+	// How long the token works, as a duration such as 24h (defaults to PREVIEW_LINK_TTL, 72h unless set; at most 720h)
+	ExpiresIn string
+}
+
+// CreateBlogPostPreviewToken signs a token that lets anyone holding it read a draft or scheduled post at GET /preview/{token}, without knowing the post's ID, until it expires. The token also works as ?preview= on GET /blog-post/{blogPostID} and its export.md, like one from /blog-post/{blogPostID}/preview-link. Nothing is stored, so a token can't be revoked on its own; changing PREVIEW_LINK_SECRET revokes them all
+//
+// POST /blog-post/{blogPostID}/preview-token (admin)
+func (c *Client) CreateBlogPostPreviewToken(ctx context.Context, blogPostID string, params *CreateBlogPostPreviewTokenParams) (*PreviewLink, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.ExpiresIn != "" {
+			query.Set("expiresIn", params.ExpiresIn)
+		}
+	}
+	var result PreviewLink
+	if err := c.do(ctx, "POST", "/blog-post/"+url.PathEscape(blogPostID)+"/preview-token", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RestoreBlogPost takes a blog post moved to the trash by DELETE /blog-post/{blogPostID} out of it again, with its tags
+//
+// POST /blog-post/{blogPostID}/restore
+func (c *Client) RestoreBlogPost(ctx context.Context, blogPostID string) (*BlogPostWithTags, error) {
+	var result BlogPostWithTags
+	if err := c.do(ctx, "POST", "/blog-post/"+url.PathEscape(blogPostID)+"/restore", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PutBlogPostTranslation adds the blog post's translation into locale, or replaces the one it has. The locale must be one of CONTENT_LOCALES other than the first, which posts are written in. Tags, dates and status are the post's own
+//
+// PUT /blog-post/{blogPostID}/translation/{locale}
+func (c *Client) PutBlogPostTranslation(ctx context.Context, blogPostID string, locale string, body BlogPostTranslation) (*BlogPostTranslation, error) {
+	var result BlogPostTranslation
+	if err := c.do(ctx, "PUT", "/blog-post/"+url.PathEscape(blogPostID)+"/translation/"+url.PathEscape(locale), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteBlogPostTranslation deletes the blog post's translation into locale, so the post is served as written in that locale
+//
+// DELETE /blog-post/{blogPostID}/translation/{locale}
+func (c *Client) DeleteBlogPostTranslation(ctx context.Context, blogPostID string, locale string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/blog-post/"+url.PathEscape(blogPostID)+"/translation/"+url.PathEscape(locale), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetBlogPostTranslationsParams holds the optional parameters of GetBlogPostTranslations
+// Zero values are left out of the request
+type GetBlogPostTranslationsParams struct {
+	// Preview token from POST /blog-post/{blogPostID}/preview-link
+	Preview string
+}
+
+// GetBlogPostTranslations lists a blog post's translations, ordered by locale, e.g. to link to each with hreflang. Those of drafts and scheduled posts are only returned with the backend password or a preview token
+//
+// GET /blog-post/{blogPostID}/translations
+func (c *Client) GetBlogPostTranslations(ctx context.Context, blogPostID string, params *GetBlogPostTranslationsParams) (*BlogPostTranslations, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Preview != "" {
+			query.Set("preview", params.Preview)
+		}
+	}
+	var result BlogPostTranslations
+	if err := c.do(ctx, "GET", "/blog-post/"+url.PathEscape(blogPostID)+"/translations", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RecordBlogPostView counts a view of a published blog post for GET /blog-posts/popular. Visitors are told apart by the same daily salted hash of IP and user agent page views use, and a visitor's views are only counted once per BLOG_POST_VIEW_WINDOW (defaults to 30 minutes), so reloads don't inflate the count
+//
+// POST /blog-post/{blogPostID}/view
+func (c *Client) RecordBlogPostView(ctx context.Context, blogPostID string) (*BlogPostViewResponse, error) {
+	var result BlogPostViewResponse
+	if err := c.do(ctx, "POST", "/blog-post/"+url.PathEscape(blogPostID)+"/view", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAllBlogPostsParams holds the optional parameters of GetAllBlogPosts
+// Zero values are left out of the request
+type GetAllBlogPostsParams struct {
+	// Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, wordCount (snake_case spellings such as date_added work too). Not allowed with cursor
+	Sort string
+	// Page number (starts at 1). Not allowed with cursor
+	Page int
+	// meta.nextCursor of the previous page, or empty for the first page, to page by cursor
+	Cursor string
+	// Blog posts per page (max 100)
+	PerPage int
+	// Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given
+	Locale string
+	// Only blog posts with this tag, e.g. golang
+	Tag string
+	// Comma-separated tags to filter by, e.g. golang,postgres (max 10 together with tag)
+	Tags string
+	// Whether blog posts need any of the tags or all of them
+	TagMode string
+	// Whether content is served as the Markdown it's written in or rendered as sanitized HTML
+	Format string
+}
+
+// GetAllBlogPosts retrieves one page of published blog posts from the database with their associated tags, newest first unless sort is given. Posts translated into the negotiated locale are served in it. Pages are numbered, or taken by cursor when cursor is given (empty for the first page): cursor pages are newest first by dateAdded and id, stay fast however deep they go and don't shift when posts are published, and give the next page's cursor as meta.nextCursor
+//
+// GET /blog-posts
+func (c *Client) GetAllBlogPosts(ctx context.Context, params *GetAllBlogPostsParams) (*BlogPostCollectionWithTags, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Sort != "" {
+			query.Set("sort", params.Sort)
+		}
+		if params.Page != 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Cursor != "" {
+			query.Set("cursor", params.Cursor)
+		}
+		if params.PerPage != 0 {
+			query.Set("perPage", strconv.Itoa(params.PerPage))
+		}
+		if params.Locale != "" {
+			query.Set("locale", params.Locale)
+		}
+		if params.Tag != "" {
+			query.Set("tag", params.Tag)
+		}
+		if params.Tags != "" {
+			query.Set("tags", params.Tags)
+		}
+		if params.TagMode != "" {
+			query.Set("tagMode", params.TagMode)
+		}
+		if params.Format != "" {
+			query.Set("format", params.Format)
+		}
+	}
+	var result BlogPostCollectionWithTags
+	if err := c.do(ctx, "GET", "/blog-posts", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// BulkDeleteBlogPosts moves blog posts to the trash by ID and/or filter (tag, addedBefore, addedAfter) in a single transaction. All given criteria must match and at least one is required. Requires the backend password
+//
+// DELETE /blog-posts (admin)
+func (c *Client) BulkDeleteBlogPosts(ctx context.Context, body BulkDeleteRequest) (*BulkDeleteResult, error) {
+	var result BulkDeleteResult
+	if err := c.do(ctx, "DELETE", "/blog-posts", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetBlogPostArchive returns post counts and post stubs (id, title, date) grouped by year and month, newest first, for an archive sidebar
+//
+// GET /blog-posts/archive
+func (c *Client) GetBlogPostArchive(ctx context.Context) (*BlogPostArchive, error) {
+	var result BlogPostArchive
+	if err := c.do(ctx, "GET", "/blog-posts/archive", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ImportBlogPostsParams holds the optional parameters of ImportBlogPosts
+// Zero values are left out of the request
+type ImportBlogPostsParams struct {
+	// Comma-separated tags to add to every imported post; for a zip it can also be a form field
+	Tags string
+}
+
+// ImportBlogPosts imports markdown documents with YAML frontmatter as blog posts, either sent as a JSON array of documents or uploaded as a zipped folder in the file form field, which is read like it is by /import/markdown. Frontmatter can set title (otherwise the document's fileName), slug, summary, date, publishAt, draft, tags and canonicalURL. A document whose slug matches an existing post's updates that post. Documents are published unless they're marked draft or scheduled with a future publishAt, and publishing here doesn't cross-post or announce them. Documents with publish: false are skipped. The tags given here are added to every post. Each document is saved on its own, so one failing doesn't stop the rest, and the report says what happened to each
+//
+// POST /blog-posts/import (admin)
+func (c *Client) ImportBlogPosts(ctx context.Context, body []MarkdownDocument, params *ImportBlogPostsParams) (*ImportReport, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Tags != "" {
+			query.Set("tags", params.Tags)
+		}
+	}
+	var result ImportReport
+	if err := c.do(ctx, "POST", "/blog-posts/import", query, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetPopularBlogPostsParams holds the optional parameters of GetPopularBlogPosts
+// Zero values are left out of the request
+type GetPopularBlogPostsParams struct {
+	// How far back views count, in days or hours, e.g. 7d or 24h (max 365d)
+	Period string
+	// Maximum number of blog posts (max 50)
+	Limit int
+	// Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given
+	Locale string
+}
+
+// GetPopularBlogPosts lists the published blog posts with the most views recorded by POST /blog-post/{blogPostID}/view in the period, most viewed first, as summaries with their view counts. Posts without views in the period are left out. Posts translated into the negotiated locale are served in it
+//
+// GET /blog-posts/popular
+func (c *Client) GetPopularBlogPosts(ctx context.Context, params *GetPopularBlogPostsParams) (*PopularBlogPostCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Period != "" {
+			query.Set("period", params.Period)
+		}
+		if params.Limit != 0 {
+			query.Set("limit", strconv.Itoa(params.Limit))
+		}
+		if params.Locale != "" {
+			query.Set("locale", params.Locale)
+		}
+	}
+	var result PopularBlogPostCollection
+	if err := c.do(ctx, "GET", "/blog-posts/popular", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SearchBlogPostsParams holds the optional parameters of SearchBlogPosts
+// Zero values are left out of the request
+type SearchBlogPostsParams struct {
+	// Search query (max 200 characters)
+	Q string
+	// Maximum number of results (max 50)
+	Limit int
+}
+
+// SearchBlogPosts full-text search over blog posts, merged with trigram similarity matches so misspelled queries (e.g. "postgers") still find results. Full-text matches rank first.
+//
+// GET /blog-posts/search
+func (c *Client) SearchBlogPosts(ctx context.Context, params *SearchBlogPostsParams) (*BlogPostSearchResults, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Q != "" {
+			query.Set("q", params.Q)
+		}
+		if params.Limit != 0 {
+			query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var result BlogPostSearchResults
+	if err := c.do(ctx, "GET", "/blog-posts/search", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetBlogPostSummariesParams holds the optional parameters of GetBlogPostSummaries
+// Zero values are left out of the request
+type GetBlogPostSummariesParams struct {
+	// Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, wordCount (snake_case spellings such as date_added work too)
+	Sort string
+	// Page number (starts at 1)
+	Page int
+	// Blog posts per page (max 100)
+	PerPage int
+	// Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given
+	Locale string
+	// Only blog posts with this tag, e.g. golang
+	Tag string
+	// Comma-separated tags to filter by, e.g. golang,postgres (max 10 together with tag)
+	Tags string
+	// Whether blog posts need any of the tags or all of them
+	TagMode string
+}
+
+// GetBlogPostSummaries retrieves one page of published blog posts for list views such as the homepage. Content is left out and tags are plain values, so the page is much smaller than GET /blog-posts. Newest first unless sort is given. Posts translated into the negotiated locale are served in it
+//
+// GET /blog-posts/summaries
+func (c *Client) GetBlogPostSummaries(ctx context.Context, params *GetBlogPostSummariesParams) (*BlogPostSummaryCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Sort != "" {
+			query.Set("sort", params.Sort)
+		}
+		if params.Page != 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage != 0 {
+			query.Set("perPage", strconv.Itoa(params.PerPage))
+		}
+		if params.Locale != "" {
+			query.Set("locale", params.Locale)
+		}
+		if params.Tag != "" {
+			query.Set("tag", params.Tag)
+		}
+		if params.Tags != "" {
+			query.Set("tags", params.Tags)
+		}
+		if params.TagMode != "" {
+			query.Set("tagMode", params.TagMode)
+		}
+	}
+	var result BlogPostSummaryCollection
+	if err := c.do(ctx, "GET", "/blog-posts/summaries", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetTrashedBlogPostsParams holds the optional parameters of GetTrashedBlogPosts
+// Zero values are left out of the request
+type GetTrashedBlogPostsParams struct {
+	// Page number (starts at 1)
+	Page int
+	// Blog posts per page (max 100)
+	PerPage int
+}
+
+// GetTrashedBlogPosts retrieves one page of the blog posts moved to the trash, most recently deleted first, with their tags
+//
+// GET /blog-posts/trash (admin)
+func (c *Client) GetTrashedBlogPosts(ctx context.Context, params *GetTrashedBlogPostsParams) (*TrashedBlogPostCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Page != 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage != 0 {
+			query.Set("perPage", strconv.Itoa(params.PerPage))
+		}
+	}
+	var result TrashedBlogPostCollection
+	if err := c.do(ctx, "GET", "/blog-posts/trash", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateBook adds a new book. Cover, ISBN, OpenLibrary key and first publish year are looked up on OpenLibrary unless provided
+//
+// POST /book
+func (c *Client) CreateBook(ctx context.Context, body Book) (*Book, error) {
+	var result Book
+	if err := c.do(ctx, "POST", "/book", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetBook retrieves a specific book by ID
+//
+// GET /book/{bookID}
+func (c *Client) GetBook(ctx context.Context, bookID string) (*Book, error) {
+	var result Book
+	if err := c.do(ctx, "GET", "/book/"+url.PathEscape(bookID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateBook updates an existing book in the reading list. OpenLibrary metadata is kept unless provided
+//
+// PUT /book/{bookID}
+func (c *Client) UpdateBook(ctx context.Context, bookID string, body Book) (*Book, error) {
+	var result Book
+	if err := c.do(ctx, "PUT", "/book/"+url.PathEscape(bookID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteBook deletes a book from the reading list by ID
+//
+// DELETE /book/{bookID}
+func (c *Client) DeleteBook(ctx context.Context, bookID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/book/"+url.PathEscape(bookID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateBookmarkParams holds the optional parameters of CreateBookmark
+// Zero values are left out of the request
+type CreateBookmarkParams struct {
+	// Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)
+	IdempotencyKey string
+}
+
+// CreateBookmark saves a new bookmark. Title, description and image are fetched from the target page's OpenGraph and meta tags unless provided in the request
+//
+// POST /bookmark
+func (c *Client) CreateBookmark(ctx context.Context, body Bookmark, params *CreateBookmarkParams) (*Bookmark, error) {
+	header := http.Header{}
+	if params != nil {
+		if params.IdempotencyKey != "" {
+			header.Set("Idempotency-Key", params.IdempotencyKey)
+		}
+	}
+	var result Bookmark
+	if err := c.do(ctx, "POST", "/bookmark", nil, header, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetBookmark retrieves a specific bookmark by ID with its tags
+//
+// GET /bookmark/{bookmarkID}
+func (c *Client) GetBookmark(ctx context.Context, bookmarkID string) (*Bookmark, error) {
+	var result Bookmark
+	if err := c.do(ctx, "GET", "/bookmark/"+url.PathEscape(bookmarkID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateBookmark updates an existing bookmark and replaces its tags. Omitted title, description and image keep their current values
+//
+// PUT /bookmark/{bookmarkID}
+func (c *Client) UpdateBookmark(ctx context.Context, bookmarkID string, body Bookmark) (*Bookmark, error) {
+	var result Bookmark
+	if err := c.do(ctx, "PUT", "/bookmark/"+url.PathEscape(bookmarkID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteBookmark deletes a bookmark and its tags from the database by ID
+//
+// DELETE /bookmark/{bookmarkID}
+func (c *Client) DeleteBookmark(ctx context.Context, bookmarkID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/bookmark/"+url.PathEscape(bookmarkID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetBookmarksParams holds the optional parameters of GetBookmarks
+// Zero values are left out of the request
+type GetBookmarksParams struct {
+	// Page number (starts at 1)
+	Page int
+	// Bookmarks per page (max 100)
+	PerPage int
+}
+
+// GetBookmarks retrieves the bookmark feed with tags, newest first
+//
+// GET /bookmarks
+func (c *Client) GetBookmarks(ctx context.Context, params *GetBookmarksParams) (*BookmarkCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Page != 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage != 0 {
+			query.Set("perPage", strconv.Itoa(params.PerPage))
+		}
+	}
+	var result BookmarkCollection
+	if err := c.do(ctx, "GET", "/bookmarks", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetBooksParams holds the optional parameters of GetBooks
+// Zero values are left out of the request
+type GetBooksParams struct {
+	// Reading status
+	Status string
+}
+
+// GetBooks retrieves all books in the reading list, optionally filtered by reading status
+//
+// GET /books
+func (c *Client) GetBooks(ctx context.Context, params *GetBooksParams) (*BookCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Status != "" {
+			query.Set("status", params.Status)
+		}
+	}
+	var result BookCollection
+	if err := c.do(ctx, "GET", "/books", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateCertification creates a new certification. Omit expiryDate for certifications that never expire
+//
+// POST /certification
+func (c *Client) CreateCertification(ctx context.Context, body Certification) (*Certification, error) {
+	var result Certification
+	if err := c.do(ctx, "POST", "/certification", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCertification retrieves a specific certification by ID
+//
+// GET /certification/{certificationID}
+func (c *Client) GetCertification(ctx context.Context, certificationID string) (*Certification, error) {
+	var result Certification
+	if err := c.do(ctx, "GET", "/certification/"+url.PathEscape(certificationID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateCertification updates an existing certification in the database
+//
+// PUT /certification/{certificationID}
+func (c *Client) UpdateCertification(ctx context.Context, certificationID string, body Certification) (*Certification, error) {
+	var result Certification
+	if err := c.do(ctx, "PUT", "/certification/"+url.PathEscape(certificationID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteCertification deletes a certification from the database by ID
+//
+// DELETE /certification/{certificationID}
+func (c *Client) DeleteCertification(ctx context.Context, certificationID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/certification/"+url.PathEscape(certificationID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetCertifications retrieves all certifications, most recently issued first
+//
+// GET /certifications
+func (c *Client) GetCertifications(ctx context.Context) (*CertificationCollection, error) {
+	var result CertificationCollection
+	if err := c.do(ctx, "GET", "/certifications", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetEducation retrieves all education entries, most recent first
+//
+// GET /education
+func (c *Client) GetEducation(ctx context.Context) (*EducationCollection, error) {
+	var result EducationCollection
+	if err := c.do(ctx, "GET", "/education", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateEducationEntry creates a new education entry. The end date may not be before the start date
+//
+// POST /education
+func (c *Client) CreateEducationEntry(ctx context.Context, body Education) (*Education, error) {
+	var result Education
+	if err := c.do(ctx, "POST", "/education", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetEducationEntry retrieves a specific education entry by ID
+//
+// GET /education/{educationID}
+func (c *Client) GetEducationEntry(ctx context.Context, educationID string) (*Education, error) {
+	var result Education
+	if err := c.do(ctx, "GET", "/education/"+url.PathEscape(educationID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateEducationEntry updates an existing education entry. The end date may not be before the start date
+//
+// PUT /education/{educationID}
+func (c *Client) UpdateEducationEntry(ctx context.Context, educationID string, body Education) (*Education, error) {
+	var result Education
+	if err := c.do(ctx, "PUT", "/education/"+url.PathEscape(educationID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteEducationEntry deletes a education entry from the database by ID
+//
+// DELETE /education/{educationID}
+func (c *Client) DeleteEducationEntry(ctx context.Context, educationID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/education/"+url.PathEscape(educationID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ExportAllContentParams holds the optional parameters of ExportAllContent
+// Zero values are left out of the request
+type ExportAllContentParams struct {
+	// Whether to return a zip of markdown files or a JSON bundle of them
+	Format string
+}
+
+// ExportAllContent exports every blog post, including drafts and scheduled posts, and every project as markdown files with YAML frontmatter, for backups and moving the site elsewhere. Posts are written like /blog-post/{blogPostID}/export.md to blog-posts/{slug}.md, which POST /import/markdown and POST /blog-posts/import read back. Projects are written to projects/{slug}.md, with their description as the body and their type, links, dates and tags in the frontmatter. tags.json lists the tags in use on each, with how many carry them. The zip is streamed as it's written, so if an error happens partway it's cut off, leaving an invalid archive. With format=json the same files are returned as one JSON bundle instead. Items in the trash are left out
+//
+// GET /export (admin)
+func (c *Client) ExportAllContent(ctx context.Context, params *ExportAllContentParams) (*ContentExport, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Format != "" {
+			query.Set("format", params.Format)
+		}
+	}
+	var result ContentExport
+	if err := c.do(ctx, "GET", "/export", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateFAQ creates a new FAQ. The answer is stored as markdown
+//
+// POST /faq
+func (c *Client) CreateFAQ(ctx context.Context, body FAQ) (*FAQ, error) {
+	var result FAQ
+	if err := c.do(ctx, "POST", "/faq", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetFAQ retrieves a specific FAQ by ID
+//
+// GET /faq/{faqID}
+func (c *Client) GetFAQ(ctx context.Context, faqID string) (*FAQ, error) {
+	var result FAQ
+	if err := c.do(ctx, "GET", "/faq/"+url.PathEscape(faqID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateFAQ updates an existing FAQ in the database
+//
+// PUT /faq/{faqID}
+func (c *Client) UpdateFAQ(ctx context.Context, faqID string, body FAQ) (*FAQ, error) {
+	var result FAQ
+	if err := c.do(ctx, "PUT", "/faq/"+url.PathEscape(faqID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteFAQ deletes a FAQ from the database by ID
+//
+// DELETE /faq/{faqID}
+func (c *Client) DeleteFAQ(ctx context.Context, faqID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/faq/"+url.PathEscape(faqID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetFAQs retrieves all published FAQs ordered by display order
+//
+// GET /faqs
+func (c *Client) GetFAQs(ctx context.Context) (*FAQCollection, error) {
+	var result FAQCollection
+	if err := c.do(ctx, "GET", "/faqs", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetGuestbook retrieves all guestbook entries that have been approved for public display, newest first
+//
+// GET /guestbook
+func (c *Client) GetGuestbook(ctx context.Context) (*GuestbookEntryCollection, error) {
+	var result GuestbookEntryCollection
+	if err := c.do(ctx, "GET", "/guestbook", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SignGuestbook submits a new guestbook entry. Entries are hidden until approved by an admin. Submissions are rate limited per IP address
+//
+// POST /guestbook-entry
+func (c *Client) SignGuestbook(ctx context.Context, body GuestbookSubmission) (*GuestbookEntry, error) {
+	var result GuestbookEntry
+	if err := c.do(ctx, "POST", "/guestbook-entry", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ImportMarkdownFilesParams holds the optional parameters of ImportMarkdownFiles
+// Zero values are left out of the request
+type ImportMarkdownFilesParams struct {
+	// Comma-separated tags to add to every imported post
+	Tags string
+}
+
+// ImportMarkdownFiles imports every markdown file in a zipped folder, such as an Obsidian vault or posts from /blog-post/{blogPostID}/export.md. YAML frontmatter can set title (otherwise the file name), slug, summary, date, publishAt, draft, tags and canonicalURL. A file whose slug matches an existing post's updates that post; slugs are derived from titles unless the frontmatter sets one. Files are published unless they're marked draft or scheduled with a future publishAt, and publishing here doesn't cross-post or announce them. Files with publish: false are skipped, as are hidden folders like .obsidian. The tags given here are added to every post. The report says what happened to each file
+//
+// POST /import/markdown (admin)
+func (c *Client) ImportMarkdownFiles(ctx context.Context, file File, params *ImportMarkdownFilesParams) (*ImportReport, error) {
+	form := url.Values{}
+	if params != nil {
+		if params.Tags != "" {
+			form.Set("tags", params.Tags)
+		}
+	}
+	var result ImportReport
+	if err := c.do(ctx, "POST", "/import/markdown", nil, nil, multipartForm{fields: form, files: map[string]File{"file": file}}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ImportFromMediumParams holds the optional parameters of ImportFromMedium
+// Zero values are left out of the request
+type ImportFromMediumParams struct {
+	// Comma-separated tags to add to every imported post
+	Tags string
+}
+
+// ImportFromMedium imports the posts in a Medium export archive (the zip Medium emails from Settings > Security and apps > Download your information) as drafts. Each post's body is converted from HTML to markdown, its canonical link becomes the url and, for published posts, its publish date becomes dateAdded. Medium's export has no tags, so the tags given here are added to every post. Posts whose title is already taken are skipped. The report says what happened to each post
+//
+// POST /import/medium (admin)
+func (c *Client) ImportFromMedium(ctx context.Context, file File, params *ImportFromMediumParams) (*ImportReport, error) {
+	form := url.Values{}
+	if params != nil {
+		if params.Tags != "" {
+			form.Set("tags", params.Tags)
+		}
+	}
+	var result ImportReport
+	if err := c.do(ctx, "POST", "/import/medium", nil, nil, multipartForm{fields: form, files: map[string]File{"file": file}}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ImportFromSubstackParams holds the optional parameters of ImportFromSubstack
+// Zero values are left out of the request
+type ImportFromSubstackParams struct {
+	// Comma-separated tags to add to every imported post
+	Tags string
+	// Address of the publication, e.g. https://example.substack.com, to link each post to its page there as its url
+	PublicationURL string
+}
+
+// ImportFromSubstack imports the posts in a Substack export archive (the zip from Settings > Exports), which lists the posts in posts.csv and keeps each body as HTML. Bodies are converted to markdown. Published posts are imported as published, keeping their publish date, and aren't cross-posted or announced; unpublished ones become drafts. Images keep pointing at Substack's CDN. Substack's export has no tags, so the tags given here are added to every post. Posts without an HTML body, such as threads, and posts whose title is already taken are skipped. The report says what happened to each post
+//
+// POST /import/substack (admin)
+func (c *Client) ImportFromSubstack(ctx context.Context, file File, params *ImportFromSubstackParams) (*ImportReport, error) {
+	form := url.Values{}
+	if params != nil {
+		if params.Tags != "" {
+			form.Set("tags", params.Tags)
+		}
+		if params.PublicationURL != "" {
+			form.Set("publicationURL", params.PublicationURL)
+		}
+	}
+	var result ImportReport
+	if err := c.do(ctx, "POST", "/import/substack", nil, nil, multipartForm{fields: form, files: map[string]File{"file": file}}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ImportFromWordPressParams holds the optional parameters of ImportFromWordPress
+// Zero values are left out of the request
+type ImportFromWordPressParams struct {
+	// Comma-separated tags to add to every imported post
+	Tags string
+	// Report what would be created without saving anything
+	DryRun bool
+}
+
+// ImportFromWordPress imports the posts in a WordPress export (WXR) file, from Tools > Export. Bodies are converted to markdown. Published posts are imported as published, keeping their publish date, and aren't cross-posted or announced; scheduled posts stay scheduled and everything else becomes a draft. Tags and categories become tags, and the tags given here are added to every post. Media isn't copied: images keep pointing at the WordPress site, and each post lists its attachments. Posts whose title is already taken are skipped. With dryRun nothing is saved, and the report says what would have happened to each post
+//
+// POST /import/wordpress (admin)
+func (c *Client) ImportFromWordPress(ctx context.Context, file File, params *ImportFromWordPressParams) (*ImportReport, error) {
+	form := url.Values{}
+	if params != nil {
+		if params.Tags != "" {
+			form.Set("tags", params.Tags)
+		}
+		if params.DryRun {
+			form.Set("dryRun", "true")
+		}
+	}
+	var result ImportReport
+	if err := c.do(ctx, "POST", "/import/wordpress", nil, nil, multipartForm{fields: form, files: map[string]File{"file": file}}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetUploadedMediaParams holds the optional parameters of GetUploadedMedia
+// Zero values are left out of the request
+type GetUploadedMediaParams struct {
+	// Page number (starts at 1)
+	Page int
+	// Files per page (max 100)
+	PerPage int
+}
+
+// GetUploadedMedia lists the files uploaded with POST /media, newest first, with the URLs they're served from. Images stored with blog posts, such as by the new-post command, aren't listed
+//
+// GET /media (admin)
+func (c *Client) GetUploadedMedia(ctx context.Context, params *GetUploadedMediaParams) (*MediaObjectCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Page != 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage != 0 {
+			query.Set("perPage", strconv.Itoa(params.PerPage))
+		}
+	}
+	var result MediaObjectCollection
+	if err := c.do(ctx, "GET", "/media", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UploadMedia stores a file, sent as the file field of a multipart form, in the MEDIA_STORAGE bucket (Supabase Storage or S3) and returns the public URL it's served from, also sent as Location. PNG, JPEG, GIF and WebP images, PDFs, MP4 and WebM videos and MP3s are accepted, told apart by their content rather than their name or the type they're sent as, up to MEDIA_UPLOAD_MAX_MB (defaults to 25). PNG, JPEG and GIF images also get resized variants, stored alongside: one for each of MEDIA_VARIANT_WIDTHS narrower than the image and a square thumbnail, returned with a srcset of the image and its web-sized variants
+//
+// POST /media (admin)
+func (c *Client) UploadMedia(ctx context.Context, file File) (*MediaObject, error) {
+	var result MediaObject
+	if err := c.do(ctx, "POST", "/media", nil, nil, multipartForm{fields: nil, files: map[string]File{"file": file}}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteUploadedMedia deletes a file uploaded with POST /media, and its variants, from the bucket, after which their URLs stop working. Posts linking to it aren't changed
+//
+// DELETE /media/{mediaID} (admin)
+func (c *Client) DeleteUploadedMedia(ctx context.Context, mediaID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/media/"+url.PathEscape(mediaID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MicropubQueryParams holds the optional parameters of MicropubQuery
+// Zero values are left out of the request
+type MicropubQueryParams struct {
+	// What to return
+	Q string
+	// URL of the post, for q=source. Its last path segment must be the post's ID
+	URL string
+}
+
+// MicropubQuery answers Micropub queries. q=config returns the media endpoint and the platforms posts can be syndicated to, q=syndicate-to only the platforms, and q=source the properties of the blog post or note at url, limited to the ones named by properties[] if given. Takes an IndieAuth access token with any scope, in the Authorization header or an access_token parameter, issued to INDIEAUTH_ME by INDIEAUTH_TOKEN_ENDPOINT
+//
+// GET /micropub (admin)
+func (c *Client) MicropubQuery(ctx context.Context, params *MicropubQueryParams) (*MicropubConfig, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Q != "" {
+			query.Set("q", params.Q)
+		}
+		if params.URL != "" {
+			query.Set("url", params.URL)
+		}
+	}
+	var result MicropubConfig
+	if err := c.do(ctx, "GET", "/micropub", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// MicropubPost creates, updates or deletes a post from a Micropub client such as Quill. Bodies are form-encoded, multipart (with photo files) or JSON, as the Micropub spec has them. An h-entry with a name becomes a blog post and one without a note; category becomes tags, photo is added to the post, published dates it (a blog post dated in the future is scheduled) and post-status draft saves a blog post as a draft. New published posts are cross-posted to the platforms named by mp-syndicate-to, through the same pipeline as POST /blog-post and POST /note, and created posts are answered with 201 and a Location header. action=update (JSON only) replaces name, content, summary, category or post-status of a blog post, or content or photo of a note, adds or deletes categories and deletes summary or photo; action=delete moves the blog post at url to the trash, or deletes the note at it, and action=undelete takes a blog post out of the trash. Takes an IndieAuth access token with the create, update, delete or undelete scope to match, in the Authorization header or an access_token field
+//
+// POST /micropub (admin)
+func (c *Client) MicropubPost(ctx context.Context) error {
+	return c.do(ctx, "POST", "/micropub", nil, nil, nil, nil)
+}
+
+// MicropubMediaUpload stores the PNG, JPEG, GIF or WebP image sent as the multipart file field, of up to 10 MB, and answers with 201 and the URL it's served at, under /media, in the Location header. Uploading an image already stored links to that copy. Takes an IndieAuth access token with the media or create scope, in the Authorization header or an access_token field
+//
+// POST /micropub/media (admin)
+func (c *Client) MicropubMediaUpload(ctx context.Context) error {
+	return c.do(ctx, "POST", "/micropub/media", nil, nil, nil, nil)
+}
+
+// CreateNoteParams holds the optional parameters of CreateNote
+// Zero values are left out of the request
+type CreateNoteParams struct {
+	// Comma-separated list of platforms to cross-post to. Valid values: twitter, mastodon
+	Platforms string
+	// Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)
+	IdempotencyKey string
+}
+
+// CreateNote creates a new note. Pass platforms to cross-post it to Twitter and/or Mastodon; nothing is cross-posted by default
+//
+// POST /note
+func (c *Client) CreateNote(ctx context.Context, body Note, params *CreateNoteParams) (*Note, error) {
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		if params.Platforms != "" {
+			query.Set("platforms", params.Platforms)
+		}
+		if params.IdempotencyKey != "" {
+			header.Set("Idempotency-Key", params.IdempotencyKey)
+		}
+	}
+	var result Note
+	if err := c.do(ctx, "POST", "/note", query, header, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetNote retrieves a specific note by ID
+//
+// GET /note/{noteID}
+func (c *Client) GetNote(ctx context.Context, noteID string) (*Note, error) {
+	var result Note
+	if err := c.do(ctx, "GET", "/note/"+url.PathEscape(noteID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateNote updates an existing note. Cross-posted copies are not edited
+//
+// PUT /note/{noteID}
+func (c *Client) UpdateNote(ctx context.Context, noteID string, body Note) (*Note, error) {
+	var result Note
+	if err := c.do(ctx, "PUT", "/note/"+url.PathEscape(noteID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteNote deletes a note from the database by ID. Cross-posted copies are not deleted
+//
+// DELETE /note/{noteID}
+func (c *Client) DeleteNote(ctx context.Context, noteID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/note/"+url.PathEscape(noteID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetNotesParams holds the optional parameters of GetNotes
+// Zero values are left out of the request
+type GetNotesParams struct {
+	// Page number (starts at 1)
+	Page int
+	// Notes per page (max 100)
+	PerPage int
+}
+
+// GetNotes retrieves the notes feed, newest first
+//
+// GET /notes
+func (c *Client) GetNotes(ctx context.Context, params *GetNotesParams) (*NoteCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Page != 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage != 0 {
+			query.Set("perPage", strconv.Itoa(params.PerPage))
+		}
+	}
+	var result NoteCollection
+	if err := c.do(ctx, "GET", "/notes", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAPISpec exports the Swagger 2.0 spec of this API. Its host and schemes are API_BASE_URL's when set, otherwise the ones the request came in on
+//
+// GET /openapi.json
+func (c *Client) GetAPISpec(ctx context.Context) (json.RawMessage, error) {
+	var result json.RawMessage
+	if err := c.do(ctx, "GET", "/openapi.json", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PreviewBlogPostParams holds the optional parameters of PreviewBlogPost
+// Zero values are left out of the request
+type PreviewBlogPostParams struct {
+	// Whether content is served as the Markdown it's written in or rendered as sanitized HTML
+	Format string
+}
+
+// PreviewBlogPost returns the draft, scheduled or published post a token from POST /blog-post/{blogPostID}/preview-token was signed for, with its tags, so reviewers can read a draft without the backend password. Previews are sent with Cache-Control private, no-store and X-Robots-Tag noindex, and aren't counted as views. Tokens that have expired, were tampered with or were signed with an old PREVIEW_LINK_SECRET are treated as unknown
+//
+// GET /preview/{token}
+func (c *Client) PreviewBlogPost(ctx context.Context, token string, params *PreviewBlogPostParams) (*BlogPostWithTags, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Format != "" {
+			query.Set("format", params.Format)
+		}
+	}
+	var result BlogPostWithTags
+	if err := c.do(ctx, "GET", "/preview/"+url.PathEscape(token), query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateProjectParams holds the optional parameters of CreateProject
+// Zero values are left out of the request
+type CreateProjectParams struct {
+	// Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)
+	IdempotencyKey string
+}
+
+// CreateProject creates a new project in the database. Images can be given with the project, in gallery order
+//
+// POST /project
+func (c *Client) CreateProject(ctx context.Context, body Project, params *CreateProjectParams) (*ProjectWithTags, error) {
+	header := http.Header{}
+	if params != nil {
+		if params.IdempotencyKey != "" {
+			header.Set("Idempotency-Key", params.IdempotencyKey)
+		}
+	}
+	var result ProjectWithTags
+	if err := c.do(ctx, "POST", "/project", nil, header, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetProject retrieves detailed information about a specific project by ID with its tags
+//
+// GET /project/{projectID}
+func (c *Client) GetProject(ctx context.Context, projectID string) (*ProjectWithTags, error) {
+	var result ProjectWithTags
+	if err := c.do(ctx, "GET", "/project/"+url.PathEscape(projectID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateProject updates an existing project in the database. Images are left as they are; they're changed under /project/{projectID}/images
+//
+// PUT /project/{projectID}
+func (c *Client) UpdateProject(ctx context.Context, projectID string, body Project) (*ProjectWithTags, error) {
+	var result ProjectWithTags
+	if err := c.do(ctx, "PUT", "/project/"+url.PathEscape(projectID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteProjectParams holds the optional parameters of DeleteProject
+// Zero values are left out of the request
+type DeleteProjectParams struct {
+	// Delete permanently instead of moving to the trash
+	Permanent bool
+}
+
+// DeleteProject moves a project to the trash by ID, from where POST /project/{projectID}/restore brings it back. With permanent=true, which requires the backend password, the project and its tags are removed from the database instead, whether or not it's in the trash
+//
+// DELETE /project/{projectID}
+func (c *Client) DeleteProject(ctx context.Context, projectID string, params *DeleteProjectParams) (map[string]string, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Permanent {
+			query.Set("permanent", "true")
+		}
+	}
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/project/"+url.PathEscape(projectID), query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateProjectImage replaces an image's URL, alt text, caption and position
+//
+// PUT /project/{projectID}/image/{imageID}
+func (c *Client) UpdateProjectImage(ctx context.Context, projectID string, imageID string, body ProjectImage) (*ProjectImage, error) {
+	var result ProjectImage
+	if err := c.do(ctx, "PUT", "/project/"+url.PathEscape(projectID)+"/image/"+url.PathEscape(imageID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteProjectImage removes an image from a project's gallery. The other images keep their positions
+//
+// DELETE /project/{projectID}/image/{imageID}
+func (c *Client) DeleteProjectImage(ctx context.Context, projectID string, imageID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/project/"+url.PathEscape(projectID)+"/image/"+url.PathEscape(imageID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetProjectImages lists a project's screenshots in gallery order, by position, for showing as a carousel. Projects in the trash have none to show
+//
+// GET /project/{projectID}/images
+func (c *Client) GetProjectImages(ctx context.Context, projectID string) (*ProjectImageCollection, error) {
+	var result ProjectImageCollection
+	if err := c.do(ctx, "GET", "/project/"+url.PathEscape(projectID)+"/images", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AddProjectImage adds a screenshot to a project's gallery, after its last image unless a position is given
+//
+// POST /project/{projectID}/images
+func (c *Client) AddProjectImage(ctx context.Context, projectID string, body ProjectImage) (*ProjectImage, error) {
+	var result ProjectImage
+	if err := c.do(ctx, "POST", "/project/"+url.PathEscape(projectID)+"/images", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RestoreProject takes a project moved to the trash by DELETE /project/{projectID} out of it again, with its tags
+//
+// POST /project/{projectID}/restore
+func (c *Client) RestoreProject(ctx context.Context, projectID string) (*ProjectWithTags, error) {
+	var result ProjectWithTags
+	if err := c.do(ctx, "POST", "/project/"+url.PathEscape(projectID)+"/restore", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetProjectCaseStudy serves a project's long-form case study, written in Markdown as case_study, rendered as sanitized HTML the same way blog posts are: GitHub-flavored Markdown with heading IDs, and without raw HTML or script links. The HTML fragment is the response body, as text/html, unless the Accept header asks for application/json, which gets it in a JSON object with the project's ID, title and slug. The project is named by its slug, made from its title like a blog post's (e.g. "Personal Site Backend" is personal-site-backend), or by its ID
+//
+// GET /project/{slug}/case-study
+func (c *Client) GetProjectCaseStudy(ctx context.Context, slug string) (*ProjectCaseStudy, error) {
+	var result ProjectCaseStudy
+	if err := c.do(ctx, "GET", "/project/"+url.PathEscape(slug)+"/case-study", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAllProjectsParams holds the optional parameters of GetAllProjects
+// Zero values are left out of the request
+type GetAllProjectsParams struct {
+	// Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, type (snake_case spellings such as date_added work too). Not allowed with cursor
+	Sort string
+	// Page number (starts at 1). Not allowed with cursor
+	Page int
+	// meta.nextCursor of the previous page, or empty for the first page, to page by cursor
+	Cursor string
+	// Projects per page (max 100)
+	PerPage int
+}
+
+// GetAllProjects retrieves one page of projects from the database with their associated tags, newest first unless sort is given. Pages are numbered, or taken by cursor when cursor is given (empty for the first page): cursor pages are newest first by date_added and id, stay fast however deep they go and don't shift when projects are added, and give the next page's cursor as meta.nextCursor
+//
+// GET /projects
+func (c *Client) GetAllProjects(ctx context.Context, params *GetAllProjectsParams) (*ProjectCollectionWithTags, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Sort != "" {
+			query.Set("sort", params.Sort)
+		}
+		if params.Page != 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Cursor != "" {
+			query.Set("cursor", params.Cursor)
+		}
+		if params.PerPage != 0 {
+			query.Set("perPage", strconv.Itoa(params.PerPage))
+		}
+	}
+	var result ProjectCollectionWithTags
+	if err := c.do(ctx, "GET", "/projects", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// BulkDeleteProjects moves projects to the trash by ID and/or filter (tag, addedBefore, addedAfter) in a single transaction. All given criteria must match and at least one is required. Requires the backend password
+//
+// DELETE /projects (admin)
+func (c *Client) BulkDeleteProjects(ctx context.Context, body BulkDeleteRequest) (*BulkDeleteResult, error) {
+	var result BulkDeleteResult
+	if err := c.do(ctx, "DELETE", "/projects", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ImportProjectFromGitHub creates a project from a GitHub repository, read from the GitHub API. The repository's name becomes the title, its README the description (or its description when it has no README), its homepage the demo link and its topics the tags. Its description is also the meta_description when it fits in 160 characters. Without GITHUB_TOKEN only public repositories can be imported, at GitHub's lower rate limit. With dryRun the project is returned as it would be created, without saving it, so it can be edited first and sent to POST /project
+//
+// POST /projects/import-github (admin)
+func (c *Client) ImportProjectFromGitHub(ctx context.Context, body GitHubProjectImport) (*ProjectWithTags, error) {
+	var result ProjectWithTags
+	if err := c.do(ctx, "POST", "/projects/import-github", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SearchProjectsParams holds the optional parameters of SearchProjects
+// Zero values are left out of the request
+type SearchProjectsParams struct {
+	// Search query (max 200 characters)
+	Q string
+	// Maximum number of results (max 50)
+	Limit int
+}
+
+// SearchProjects full-text search over projects, merged with trigram similarity matches so misspelled queries (e.g. "postgers") still find results. Full-text matches rank first.
+//
+// GET /projects/search
+func (c *Client) SearchProjects(ctx context.Context, params *SearchProjectsParams) (*ProjectSearchResults, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Q != "" {
+			query.Set("q", params.Q)
+		}
+		if params.Limit != 0 {
+			query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var result ProjectSearchResults
+	if err := c.do(ctx, "GET", "/projects/search", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetProjectSummariesParams holds the optional parameters of GetProjectSummaries
+// Zero values are left out of the request
+type GetProjectSummariesParams struct {
+	// Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, type (snake_case spellings such as date_added work too)
+	Sort string
+	// Page number (starts at 1)
+	Page int
+	// Projects per page (max 100)
+	PerPage int
+}
+
+// GetProjectSummaries retrieves one page of projects for list views such as the homepage. Tags are plain values rather than full tag rows, so the page is smaller than GET /projects. Newest first unless sort is given
+//
+// GET /projects/summaries
+func (c *Client) GetProjectSummaries(ctx context.Context, params *GetProjectSummariesParams) (*ProjectSummaryCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Sort != "" {
+			query.Set("sort", params.Sort)
+		}
+		if params.Page != 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage != 0 {
+			query.Set("perPage", strconv.Itoa(params.PerPage))
+		}
+	}
+	var result ProjectSummaryCollection
+	if err := c.do(ctx, "GET", "/projects/summaries", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetTrashedProjectsParams holds the optional parameters of GetTrashedProjects
+// Zero values are left out of the request
+type GetTrashedProjectsParams struct {
+	// Page number (starts at 1)
+	Page int
+	// Projects per page (max 100)
+	PerPage int
+}
+
+// GetTrashedProjects retrieves one page of the projects moved to the trash, most recently deleted first, with their tags
+//
+// GET /projects/trash (admin)
+func (c *Client) GetTrashedProjects(ctx context.Context, params *GetTrashedProjectsParams) (*TrashedProjectCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Page != 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage != 0 {
+			query.Set("perPage", strconv.Itoa(params.PerPage))
+		}
+	}
+	var result TrashedProjectCollection
+	if err := c.do(ctx, "GET", "/projects/trash", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetReadingList retrieves books currently being read and finished books (most recently finished first)
+//
+// GET /reading-list
+func (c *Client) GetReadingList(ctx context.Context) (*ReadingList, error) {
+	var result ReadingList
+	if err := c.do(ctx, "GET", "/reading-list", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetRecentChangesParams holds the optional parameters of GetRecentChanges
+// Zero values are left out of the request
+type GetRecentChangesParams struct {
+	// Cursor returned as nextCursor by the previous page
+	Cursor string
+	// Items per page (max 50)
+	Limit int
+}
+
+// GetRecentChanges retrieves blog posts, projects and notes that have been edited, most recently edited first. Content that was never edited is left out; see /timeline for new content. Pass nextCursor from the previous response as cursor to get the next page
+//
+// GET /recent-changes
+func (c *Client) GetRecentChanges(ctx context.Context, params *GetRecentChangesParams) (*RecentChanges, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Cursor != "" {
+			query.Set("cursor", params.Cursor)
+		}
+		if params.Limit != 0 {
+			query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var result RecentChanges
+	if err := c.do(ctx, "GET", "/recent-changes", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetResumeParams holds the optional parameters of GetResume
+// Zero values are left out of the request
+type GetResumeParams struct {
+	// Response format
+	Format string
+}
+
+// GetResume retrieves structured CV data (work experience, education and unexpired certifications). Pass format=pdf to receive a rendered PDF instead of JSON
+//
+// GET /resume
+func (c *Client) GetResume(ctx context.Context, params *GetResumeParams) (*Resume, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Format != "" {
+			query.Set("format", params.Format)
+		}
+	}
+	var result Resume
+	if err := c.do(ctx, "GET", "/resume", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExamplePayload returns a canonical example request body for an entity, which the admin UI uses to prefill its forms. Entities are blog-post (POST /blog-post), project (POST /project) and publish (POST /blog-post scheduled for later)
+//
+// GET /schema/{entity}/example
+func (c *Client) GetExamplePayload(ctx context.Context, entity string) (json.RawMessage, error) {
+	var result json.RawMessage
+	if err := c.do(ctx, "GET", "/schema/"+url.PathEscape(entity)+"/example", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SearchEverythingParams holds the optional parameters of SearchEverything
+// Zero values are left out of the request
+type SearchEverythingParams struct {
+	// Search query (max 200 characters)
+	Q string
+	// Maximum number of results (max 50)
+	Limit int
+}
+
+// SearchEverything searches published blog posts, projects and the tags on them in one query, for a site-wide search box. Blog posts and projects are matched as /blog-posts/search and /projects/search match them, and tags by their value: a tag that is the query, ignoring case, scores 2, and one resembling a word of it scores its trigram similarity (0-1). All three are ranked together, best first, so scores above 1 are full-text or exact tag matches. Results say their type and carry the matching blog post or project summary, or the tag with how many posts and projects have it
+//
+// GET /search
+func (c *Client) SearchEverything(ctx context.Context, params *SearchEverythingParams) (*SearchResults, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Q != "" {
+			query.Set("q", params.Q)
+		}
+		if params.Limit != 0 {
+			query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var result SearchResults
+	if err := c.do(ctx, "GET", "/search", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSeries retrieves every series, newest first, without their posts
+//
+// GET /series
+func (c *Client) GetSeries(ctx context.Context) (*SeriesCollection, error) {
+	var result SeriesCollection
+	if err := c.do(ctx, "GET", "/series", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateSeries creates a new series. Its slug is made from the slug given, or the title when there isn't one, by lowercasing it and joining its words with hyphens
+//
+// POST /series
+func (c *Client) CreateSeries(ctx context.Context, body Series) (*Series, error) {
+	var result Series
+	if err := c.do(ctx, "POST", "/series", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSeriesBySlugParams holds the optional parameters of GetSeriesBySlug
+// Zero values are left out of the request
+type GetSeriesBySlugParams struct {
+	// Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given
+	Locale string
+}
+
+// GetSeriesBySlug retrieves a series with the summaries of its published posts, ordered by their position in it. Drafts and scheduled posts can be placed in a series ahead of time, and are listed once they're published. Posts translated into the negotiated locale are served in it
+//
+// GET /series/{slug}
+func (c *Client) GetSeriesBySlug(ctx context.Context, slug string, params *GetSeriesBySlugParams) (*SeriesResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Locale != "" {
+			query.Set("locale", params.Locale)
+		}
+	}
+	var result SeriesResponse
+	if err := c.do(ctx, "GET", "/series/"+url.PathEscape(slug), query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateSeries updates an existing series' title and description, and its slug when one is given. Its posts stay where they are
+//
+// PUT /series/{slug}
+func (c *Client) UpdateSeries(ctx context.Context, slug string, body Series) (*Series, error) {
+	var result Series
+	if err := c.do(ctx, "PUT", "/series/"+url.PathEscape(slug), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteSeries deletes a series by slug. Its posts are taken out of it, not deleted
+//
+// DELETE /series/{slug}
+func (c *Client) DeleteSeries(ctx context.Context, slug string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/series/"+url.PathEscape(slug), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AddBlogPostToSeries places a blog post at a position in a series, or after its last post when no position is given. A post is in at most one series, so a post already in a series is moved, and putting it at a position another post holds is a conflict
+//
+// PUT /series/{slug}/post/{blogPostID}
+func (c *Client) AddBlogPostToSeries(ctx context.Context, slug string, blogPostID string, body SeriesPostRequest) (*SeriesPost, error) {
+	var result SeriesPost
+	if err := c.do(ctx, "PUT", "/series/"+url.PathEscape(slug)+"/post/"+url.PathEscape(blogPostID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RemoveBlogPostFromSeries takes a blog post out of a series. The post itself is left as it is, and the other posts keep their positions
+//
+// DELETE /series/{slug}/post/{blogPostID}
+func (c *Client) RemoveBlogPostFromSeries(ctx context.Context, slug string, blogPostID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/series/"+url.PathEscape(slug)+"/post/"+url.PathEscape(blogPostID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateSkill creates a new skill in the database
+//
+// POST /skill
+func (c *Client) CreateSkill(ctx context.Context, body Skill) (*Skill, error) {
+	var result Skill
+	if err := c.do(ctx, "POST", "/skill", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSkill retrieves a specific skill by ID
+//
+// GET /skill/{skillID}
+func (c *Client) GetSkill(ctx context.Context, skillID string) (*Skill, error) {
+	var result Skill
+	if err := c.do(ctx, "GET", "/skill/"+url.PathEscape(skillID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateSkill updates an existing skill in the database
+//
+// PUT /skill/{skillID}
+func (c *Client) UpdateSkill(ctx context.Context, skillID string, body Skill) (*Skill, error) {
+	var result Skill
+	if err := c.do(ctx, "PUT", "/skill/"+url.PathEscape(skillID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteSkill deletes a skill from the database by ID
+//
+// DELETE /skill/{skillID}
+func (c *Client) DeleteSkill(ctx context.Context, skillID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/skill/"+url.PathEscape(skillID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetAllSkillsParams holds the optional parameters of GetAllSkills
+// Zero values are left out of the request
+type GetAllSkillsParams struct {
+	// Only return skills in this category
+	Category string
+}
+
+// GetAllSkills retrieves all skills ordered by category and name. Pass category to only return skills in that category
+//
+// GET /skills
+func (c *Client) GetAllSkills(ctx context.Context, params *GetAllSkillsParams) (*SkillCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Category != "" {
+			query.Set("category", params.Category)
+		}
+	}
+	var result SkillCollection
+	if err := c.do(ctx, "GET", "/skills", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateSnippet creates a new code snippet. Blog posts embed it with a snippet shortcode holding its ID on a line of its own (see the README), which the markdown export replaces with a fenced code block
+//
+// POST /snippet
+func (c *Client) CreateSnippet(ctx context.Context, body Snippet) (*Snippet, error) {
+	var result Snippet
+	if err := c.do(ctx, "POST", "/snippet", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSnippet retrieves a specific code snippet by ID
+//
+// GET /snippet/{snippetID}
+func (c *Client) GetSnippet(ctx context.Context, snippetID string) (*Snippet, error) {
+	var result Snippet
+	if err := c.do(ctx, "GET", "/snippet/"+url.PathEscape(snippetID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateSnippet updates an existing code snippet. Posts embedding it show the new code the next time they're exported
+//
+// PUT /snippet/{snippetID}
+func (c *Client) UpdateSnippet(ctx context.Context, snippetID string, body Snippet) (*Snippet, error) {
+	var result Snippet
+	if err := c.do(ctx, "PUT", "/snippet/"+url.PathEscape(snippetID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteSnippet deletes a code snippet by ID. Shortcodes embedding it are left in posts as they are
+//
+// DELETE /snippet/{snippetID}
+func (c *Client) DeleteSnippet(ctx context.Context, snippetID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/snippet/"+url.PathEscape(snippetID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetSnippetsParams holds the optional parameters of GetSnippets
+// Zero values are left out of the request
+type GetSnippetsParams struct {
+	// Only snippets in this language, e.g. go (case-insensitive)
+	Language string
+	// Only snippets with this tag
+	Tag string
+	// Page number (starts at 1)
+	Page int
+	// Snippets per page (max 100)
+	PerPage int
+}
+
+// GetSnippets retrieves the code snippets gallery, newest first, optionally only those in one language or with one tag
+//
+// GET /snippets
+func (c *Client) GetSnippets(ctx context.Context, params *GetSnippetsParams) (*SnippetCollection, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Language != "" {
+			query.Set("language", params.Language)
+		}
+		if params.Tag != "" {
+			query.Set("tag", params.Tag)
+		}
+		if params.Page != 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage != 0 {
+			query.Set("perPage", strconv.Itoa(params.PerPage))
+		}
+	}
+	var result SnippetCollection
+	if err := c.do(ctx, "GET", "/snippets", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RenameTag renames a tag on every blog post and project carrying it. The tag is named by its value, URL-escaped. Renaming to a tag that's already in use is refused; merge into it with POST /tags/merge instead
+//
+// PUT /tag/{id} (admin)
+func (c *Client) RenameTag(ctx context.Context, id string, body TagRename) (*Tag, error) {
+	var result Tag
+	if err := c.do(ctx, "PUT", "/tag/"+url.PathEscape(id), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteTag removes a tag from every blog post and project carrying it, including drafts and the trash. The posts and projects themselves are kept. The tag is named by its value, URL-escaped
+//
+// DELETE /tag/{id} (admin)
+func (c *Client) DeleteTag(ctx context.Context, id string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/tag/"+url.PathEscape(id), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetTags lists every tag on a blog post or project, with how many of each carry it, most used first and then alphabetically. Drafts, scheduled posts and the trash are counted, since renaming, merging and deleting tags changes them too
+//
+// GET /tags (admin)
+func (c *Client) GetTags(ctx context.Context) (*TagCollection, error) {
+	var result TagCollection
+	if err := c.do(ctx, "GET", "/tags", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// MergeTags moves the tag from onto the tag into on every blog post and project carrying it, then from is gone. Posts and projects carrying both keep into once. into needn't be in use yet, though renaming with PUT /tag/{id} says so more plainly
+//
+// POST /tags/merge (admin)
+func (c *Client) MergeTags(ctx context.Context, body TagMerge) (*Tag, error) {
+	var result Tag
+	if err := c.do(ctx, "POST", "/tags/merge", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SubmitTestimonial submits a new testimonial. Submitted testimonials are hidden until approved by an admin
+//
+// POST /testimonial
+func (c *Client) SubmitTestimonial(ctx context.Context, body TestimonialSubmission) (*Testimonial, error) {
+	var result Testimonial
+	if err := c.do(ctx, "POST", "/testimonial", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetApprovedTestimonials retrieves all testimonials that have been approved for public display, newest first
+//
+// GET /testimonials
+func (c *Client) GetApprovedTestimonials(ctx context.Context) (*TestimonialCollection, error) {
+	var result TestimonialCollection
+	if err := c.do(ctx, "GET", "/testimonials", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetTimelineParams holds the optional parameters of GetTimeline
+// Zero values are left out of the request
+type GetTimelineParams struct {
+	// Cursor returned as nextCursor by the previous page
+	Cursor string
+	// Items per page (max 50)
+	Limit int
+}
+
+// GetTimeline retrieves blog posts, projects and notes merged into one feed, newest first. Pass nextCursor from the previous response as cursor to get the next page
+//
+// GET /timeline
+func (c *Client) GetTimeline(ctx context.Context, params *GetTimelineParams) (*Timeline, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Cursor != "" {
+			query.Set("cursor", params.Cursor)
+		}
+		if params.Limit != 0 {
+			query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var result Timeline
+	if err := c.do(ctx, "GET", "/timeline", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetTrendingContentParams holds the optional parameters of GetTrendingContent
+// Zero values are left out of the request
+type GetTrendingContentParams struct {
+	// Maximum number of items (max 50)
+	Limit int
+}
+
+// GetTrendingContent returns blog posts and projects ranked by recent views, with older views decaying (3-day half-life over a 14-day window). The list is recomputed every 15 minutes.
+//
+// GET /trending
+func (c *Client) GetTrendingContent(ctx context.Context, params *GetTrendingContentParams) (*TrendingResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Limit != 0 {
+			query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var result TrendingResponse
+	if err := c.do(ctx, "GET", "/trending", query, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetUsesPage retrieves all uses items grouped by category, with items ordered by display order within each category
+//
+// GET /uses
+func (c *Client) GetUsesPage(ctx context.Context) (*UsesPage, error) {
+	var result UsesPage
+	if err := c.do(ctx, "GET", "/uses", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateUsesItem creates a new uses item in the database
+//
+// POST /uses-item
+func (c *Client) CreateUsesItem(ctx context.Context, body UsesItem) (*UsesItem, error) {
+	var result UsesItem
+	if err := c.do(ctx, "POST", "/uses-item", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetUsesItem retrieves a specific uses item by ID
+//
+// GET /uses-item/{usesItemID}
+func (c *Client) GetUsesItem(ctx context.Context, usesItemID string) (*UsesItem, error) {
+	var result UsesItem
+	if err := c.do(ctx, "GET", "/uses-item/"+url.PathEscape(usesItemID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateUsesItem updates an existing uses item in the database
+//
+// PUT /uses-item/{usesItemID}
+func (c *Client) UpdateUsesItem(ctx context.Context, usesItemID string, body UsesItem) (*UsesItem, error) {
+	var result UsesItem
+	if err := c.do(ctx, "PUT", "/uses-item/"+url.PathEscape(usesItemID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteUsesItem deletes a uses item from the database by ID
+//
+// DELETE /uses-item/{usesItemID}
+func (c *Client) DeleteUsesItem(ctx context.Context, usesItemID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/uses-item/"+url.PathEscape(usesItemID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetAPIVersion returns the version of the API spec served at /openapi.json and the changelog of every version, newest first. The generated clients export the version they were built from as SpecVersion (Go) and specVersion (TypeScript)
+//
+// GET /version
+func (c *Client) GetAPIVersion(ctx context.Context) (*APIVersion, error) {
+	var result APIVersion
+	if err := c.do(ctx, "GET", "/version", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetWorkExperience retrieves all work experience entries, current positions first and then by most recent start date
+//
+// GET /work-experience
+func (c *Client) GetWorkExperience(ctx context.Context) (*WorkExperienceCollection, error) {
+	var result WorkExperienceCollection
+	if err := c.do(ctx, "GET", "/work-experience", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateWorkExperienceEntry creates a new work experience entry. Dates must form a valid range and may not overlap another entry at the same company
+//
+// POST /work-experience
+func (c *Client) CreateWorkExperienceEntry(ctx context.Context, body WorkExperience) (*WorkExperience, error) {
+	var result WorkExperience
+	if err := c.do(ctx, "POST", "/work-experience", nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetWorkExperienceEntry retrieves a specific work experience entry by ID
+//
+// GET /work-experience/{workExperienceID}
+func (c *Client) GetWorkExperienceEntry(ctx context.Context, workExperienceID string) (*WorkExperience, error) {
+	var result WorkExperience
+	if err := c.do(ctx, "GET", "/work-experience/"+url.PathEscape(workExperienceID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateWorkExperienceEntry updates an existing work experience entry. Dates must form a valid range and may not overlap another entry at the same company
+//
+// PUT /work-experience/{workExperienceID}
+func (c *Client) UpdateWorkExperienceEntry(ctx context.Context, workExperienceID string, body WorkExperience) (*WorkExperience, error) {
+	var result WorkExperience
+	if err := c.do(ctx, "PUT", "/work-experience/"+url.PathEscape(workExperienceID), nil, nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteWorkExperienceEntry deletes a work experience entry from the database by ID
+//
+// DELETE /work-experience/{workExperienceID}
+func (c *Client) DeleteWorkExperienceEntry(ctx context.Context, workExperienceID string) (map[string]string, error) {
+	var result map[string]string
+	if err := c.do(ctx, "DELETE", "/work-experience/"+url.PathEscape(workExperienceID), nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
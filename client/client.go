@@ -0,0 +1,170 @@
+// Package client calls the Personal Site API from Go
+// The types and methods in client.gen.go are generated from the Swagger spec in docs/swagger.json, so rerun
+// `go generate ./client` after `swag init`
+package client
+
+//go:generate go run generate.go
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Client sends requests to one deployment of the API
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	token      string
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient sends requests through httpClient instead of http.DefaultClient
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithToken authenticates every request with token, which admin routes require to be BACKEND_PASSWORD
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// New returns a client for the API at baseURL, e.g. https://api.example.com
+func New(baseURL string, options ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: http.DefaultClient,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// Error is an error response from the API
+type Error struct {
+	StatusCode int
+	Response   ErrorResponse
+}
+
+func (e *Error) Error() string {
+	if e.Response.Code == "" {
+		return fmt.Sprintf("api returned status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("api returned status %d: %s: %s", e.StatusCode, e.Response.Code, e.Response.Error)
+}
+
+// File is a file to upload, such as an export archive
+type File struct {
+	Name    string // File name sent with the upload, e.g. medium-export.zip
+	Content io.Reader
+}
+
+// multipartForm is a request body sent as multipart/form-data instead of JSON
+type multipartForm struct {
+	fields url.Values
+	files  map[string]File
+}
+
+// encode streams the form through a pipe, so large files aren't held in memory, and returns its content type
+func (f multipartForm) encode() (io.ReadCloser, string) {
+	reader, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
+	go func() {
+		writer.CloseWithError(f.write(form))
+	}()
+	return reader, form.FormDataContentType()
+}
+
+func (f multipartForm) write(form *multipart.Writer) error {
+	for name, values := range f.fields {
+		for _, value := range values {
+			if err := form.WriteField(name, value); err != nil {
+				return err
+			}
+		}
+	}
+	for name, file := range f.files {
+		part, err := form.CreateFormFile(name, file.Name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(part, file.Content); err != nil {
+			return err
+		}
+	}
+	return form.Close()
+}
+
+// do sends a request and decodes a successful response's JSON body into out, unless out is nil
+// body is sent as JSON, or as multipart/form-data when it's a multipartForm
+// Responses outside the 2xx range are returned as an *Error
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, header http.Header, body, out any) error {
+	requestURL := c.baseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	var bodyReader io.Reader
+	contentType := ""
+	switch body := body.(type) {
+	case nil:
+	case multipartForm:
+		formReader, formType := body.encode()
+		defer formReader.Close()
+		bodyReader, contentType = formReader, formType
+	default:
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		bodyReader, contentType = bytes.NewReader(encoded), "application/json"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, bodyReader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &Error{StatusCode: resp.StatusCode}
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr.Response)
+		return apiErr
+	}
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
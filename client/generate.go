@@ -0,0 +1,742 @@
+//go:build ignore
+
+// generate.go writes the Go and TypeScript clients from the Swagger spec
+// Run it with `go generate ./client` after `swag init`
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	specPath = "../docs/swagger.json"
+	goPath   = "client.gen.go"
+	tsPath   = "typescript/client.ts"
+)
+
+type spec struct {
+	Info struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	} `json:"info"`
+	Paths       map[string]map[string]operation `json:"paths"`
+	Definitions map[string]schema               `json:"definitions"`
+}
+
+type operation struct {
+	Summary     string              `json:"summary"`
+	Description string              `json:"description"`
+	Produces    []string            `json:"produces"`
+	Parameters  []parameter         `json:"parameters"`
+	Responses   map[string]response `json:"responses"`
+	Security    []map[string]any    `json:"security"`
+}
+
+type parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Type        string  `json:"type"`
+	Required    bool    `json:"required"`
+	Description string  `json:"description"`
+	Schema      *schema `json:"schema"`
+	Items       *schema `json:"items"`
+}
+
+type response struct {
+	Schema *schema `json:"schema"`
+}
+
+type schema struct {
+	Ref                  string            `json:"$ref"`
+	Type                 string            `json:"type"`
+	Description          string            `json:"description"`
+	Properties           map[string]schema `json:"properties"`
+	Items                *schema           `json:"items"`
+	AdditionalProperties *schema           `json:"additionalProperties"`
+	Enum                 []string          `json:"enum"`
+	EnumVarNames         []string          `json:"x-enum-varnames"`
+}
+
+// endpoint is an operation with everything both clients need to call it
+type endpoint struct {
+	name        string
+	method      string
+	path        string
+	description string
+	admin       bool
+	pathParams  []parameter
+	otherParams []parameter // Query, header and form parameters
+	fileParams  []parameter // Files uploaded as multipart/form-data
+	body        *schema
+	result      *schema
+}
+
+func main() {
+	raw, err := os.ReadFile(specPath)
+	if err != nil {
+		fail("failed to read spec: %v", err)
+	}
+	var s spec
+	if err := json.Unmarshal(raw, &s); err != nil {
+		fail("failed to parse spec: %v", err)
+	}
+
+	typeNames := schemaTypeNames(s.Definitions)
+	endpoints := collectEndpoints(s)
+
+	goSource, err := format.Source(generateGo(s, typeNames, endpoints))
+	if err != nil {
+		fail("failed to format Go client: %v", err)
+	}
+	if err := os.WriteFile(goPath, goSource, 0o644); err != nil {
+		fail("failed to write Go client: %v", err)
+	}
+	if err := os.WriteFile(tsPath, generateTypeScript(s, typeNames, endpoints), 0o644); err != nil {
+		fail("failed to write TypeScript client: %v", err)
+	}
+}
+
+func fail(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+// schemaTypeNames names each definition after its Go type without the package, e.g. models.BlogPost is BlogPost
+// Definitions whose type names clash keep their package as a prefix
+func schemaTypeNames(definitions map[string]schema) map[string]string {
+	counts := map[string]int{}
+	for ref := range definitions {
+		counts[typeName(ref)]++
+	}
+	names := map[string]string{}
+	for ref := range definitions {
+		name := typeName(ref)
+		if counts[name] > 1 {
+			name = exportedName(strings.ReplaceAll(ref, ".", " "))
+		}
+		names[ref] = name
+	}
+	return names
+}
+
+func typeName(ref string) string {
+	return exportedName(ref[strings.LastIndex(ref, ".")+1:])
+}
+
+// collectEndpoints returns every JSON operation in the spec, ordered by path and method
+func collectEndpoints(s spec) []endpoint {
+	var endpoints []endpoint
+	usedNames := map[string]int{}
+	for path, operations := range s.Paths {
+		for method, op := range operations {
+			// Redirects like /r/{token} are for browsers, not API clients
+			if !slices.Contains(op.Produces, "application/json") {
+				continue
+			}
+
+			e := endpoint{
+				name:        exportedName(op.Summary),
+				method:      strings.ToUpper(method),
+				path:        path,
+				description: op.Description,
+				admin:       len(op.Security) > 0,
+			}
+			for _, param := range op.Parameters {
+				switch param.In {
+				case "path":
+					e.pathParams = append(e.pathParams, param)
+				case "query", "header":
+					e.otherParams = append(e.otherParams, param)
+				case "formData":
+					if param.Type == "file" {
+						e.fileParams = append(e.fileParams, param)
+					} else {
+						e.otherParams = append(e.otherParams, param)
+					}
+				case "body":
+					e.body = param.Schema
+				}
+			}
+			e.result = successSchema(op.Responses)
+			endpoints = append(endpoints, e)
+		}
+	}
+
+	methodOrder := []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+	slices.SortFunc(endpoints, func(a, b endpoint) int {
+		if a.path != b.path {
+			return strings.Compare(a.path, b.path)
+		}
+		return slices.Index(methodOrder, a.method) - slices.Index(methodOrder, b.method)
+	})
+	for i := range endpoints {
+		usedNames[endpoints[i].name]++
+		if count := usedNames[endpoints[i].name]; count > 1 {
+			endpoints[i].name += fmt.Sprint(count)
+		}
+	}
+	return endpoints
+}
+
+// successSchema returns the schema of the first 2xx response, or nil when it has no body
+func successSchema(responses map[string]response) *schema {
+	for _, status := range []string{"200", "201", "202"} {
+		if resp, ok := responses[status]; ok {
+			return resp.Schema
+		}
+	}
+	return nil
+}
+
+var wordPattern = regexp.MustCompile(`[A-Z]*[a-z0-9]*`)
+
+// words splits text like "Get all FAQs", "blogPostID" or "Idempotency-Key" at spaces, punctuation and camel case humps
+func words(text string) []string {
+	var found []string
+	for _, part := range regexp.MustCompile(`[^A-Za-z0-9]+`).Split(text, -1) {
+		for _, word := range wordPattern.FindAllString(part, -1) {
+			if word != "" {
+				found = append(found, word)
+			}
+		}
+	}
+	return found
+}
+
+// exportedName turns text into an exported Go name, e.g. GetAllFAQs, BlogPostID or IdempotencyKey
+func exportedName(text string) string {
+	var name strings.Builder
+	for _, word := range words(text) {
+		switch word {
+		case "Id", "id", "Url", "url", "Api", "api":
+			word = strings.ToUpper(word)
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		name.WriteString(string(runes))
+	}
+	return name.String()
+}
+
+// unexportedName turns text into a name that starts lowercase, e.g. getAllFAQs, blogPostID or idempotencyKey
+func unexportedName(text string) string {
+	parts := words(exportedName(text))
+	if len(parts) == 0 {
+		return ""
+	}
+	parts[0] = strings.ToLower(parts[0])
+	return strings.Join(parts, "")
+}
+
+// sentence lowers the first word of description so it can follow a method name in a doc comment
+func sentence(description string) string {
+	runes := []rune(description)
+	if len(runes) > 1 && unicode.IsUpper(runes[0]) && unicode.IsLower(runes[1]) {
+		runes[0] = unicode.ToLower(runes[0])
+	}
+	return string(runes)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func refName(ref string) string {
+	return strings.TrimPrefix(ref, "#/definitions/")
+}
+
+func generateGo(s spec, typeNames map[string]string, endpoints []endpoint) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// SpecVersion is the version of the API spec this client was generated from\n")
+	fmt.Fprintf(&b, "const SpecVersion = %q\n\n", s.Info.Version)
+
+	goType := func(sch *schema) string { return goSchemaType(sch, typeNames) }
+
+	for _, ref := range sortedKeys(s.Definitions) {
+		def := s.Definitions[ref]
+		name := typeNames[ref]
+		if def.Description != "" {
+			fmt.Fprintf(&b, "// %s %s\n", name, sentence(def.Description))
+		}
+		if len(def.Enum) > 0 {
+			fmt.Fprintf(&b, "type %s string\n\nconst (\n", name)
+			for i, value := range def.Enum {
+				constName := name + exportedName(value)
+				if i < len(def.EnumVarNames) {
+					constName = def.EnumVarNames[i]
+				}
+				fmt.Fprintf(&b, "%s %s = %q\n", constName, name, value)
+			}
+			fmt.Fprintf(&b, ")\n\n")
+			continue
+		}
+		fmt.Fprintf(&b, "type %s struct {\n", name)
+		for _, property := range sortedKeys(def.Properties) {
+			prop := def.Properties[property]
+			if prop.Description != "" {
+				fmt.Fprintf(&b, "// %s\n", prop.Description)
+			}
+			fieldType := goType(&prop)
+			// Point at nested objects so omitempty can leave them out
+			if prop.Ref != "" && len(s.Definitions[refName(prop.Ref)].Enum) == 0 {
+				fieldType = "*" + fieldType
+			}
+			fmt.Fprintf(&b, "%s %s `json:\"%s,omitempty\"`\n", exportedName(property), fieldType, property)
+		}
+		fmt.Fprintf(&b, "}\n\n")
+	}
+
+	for _, e := range endpoints {
+		paramsType := e.name + "Params"
+		if len(e.otherParams) > 0 {
+			fmt.Fprintf(&b, "// %s holds the optional parameters of %s\n", paramsType, e.name)
+			fmt.Fprintf(&b, "// Zero values are left out of the request\n")
+			fmt.Fprintf(&b, "type %s struct {\n", paramsType)
+			for _, param := range e.otherParams {
+				if param.Description != "" {
+					fmt.Fprintf(&b, "// %s\n", param.Description)
+				}
+				fmt.Fprintf(&b, "%s %s\n", exportedName(param.Name), goParamType(param))
+			}
+			fmt.Fprintf(&b, "}\n\n")
+		}
+
+		args := []string{"ctx context.Context"}
+		for _, param := range e.pathParams {
+			args = append(args, unexportedName(param.Name)+" string")
+		}
+		for _, param := range e.fileParams {
+			args = append(args, unexportedName(param.Name)+" File")
+		}
+		if e.body != nil {
+			args = append(args, "body "+goType(e.body))
+		}
+		if len(e.otherParams) > 0 {
+			args = append(args, "params *"+paramsType)
+		}
+
+		resultType := ""
+		if e.result != nil {
+			resultType = goType(e.result)
+			if e.result.Ref != "" {
+				resultType = "*" + resultType
+			}
+		}
+
+		if e.description != "" {
+			fmt.Fprintf(&b, "// %s %s\n", e.name, sentence(e.description))
+		} else {
+			fmt.Fprintf(&b, "// %s calls %s %s\n", e.name, e.method, e.path)
+		}
+		fmt.Fprintf(&b, "//\n// %s %s", e.method, e.path)
+		if e.admin {
+			fmt.Fprintf(&b, " (admin)")
+		}
+		fmt.Fprintf(&b, "\n")
+		if resultType == "" {
+			fmt.Fprintf(&b, "func (c *Client) %s(%s) error {\n", e.name, strings.Join(args, ", "))
+		} else {
+			fmt.Fprintf(&b, "func (c *Client) %s(%s) (%s, error) {\n", e.name, strings.Join(args, ", "), resultType)
+		}
+
+		path := fmt.Sprintf("%q", e.path)
+		for _, param := range e.pathParams {
+			path = strings.Replace(path, "{"+param.Name+"}", `" + url.PathEscape(`+unexportedName(param.Name)+`) + "`, 1)
+		}
+		path = strings.TrimSuffix(strings.TrimPrefix(path, `"" + `), ` + ""`)
+
+		// Declare only the kinds of parameters the operation has
+		targets := map[string]string{"query": "nil", "header": "nil", "formData": "nil"}
+		declarations := map[string]string{"query": "url.Values{}", "header": "http.Header{}", "formData": "url.Values{}"}
+		targetNames := map[string]string{"query": "query", "header": "header", "formData": "form"}
+		for _, param := range e.otherParams {
+			if targets[param.In] == "nil" {
+				targets[param.In] = targetNames[param.In]
+				fmt.Fprintf(&b, "%s := %s\n", targetNames[param.In], declarations[param.In])
+			}
+		}
+		if len(e.otherParams) > 0 {
+			fmt.Fprintf(&b, "if params != nil {\n")
+			for _, param := range e.otherParams {
+				field := "params." + exportedName(param.Name)
+				target := targetNames[param.In]
+				switch goParamType(param) {
+				case "int":
+					fmt.Fprintf(&b, "if %s != 0 {\n%s.Set(%q, strconv.Itoa(%s))\n}\n", field, target, param.Name, field)
+				case "bool":
+					fmt.Fprintf(&b, "if %s {\n%s.Set(%q, \"true\")\n}\n", field, target, param.Name)
+				case "[]string":
+					fmt.Fprintf(&b, "for _, value := range %s {\n%s.Add(%q, value)\n}\n", field, target, param.Name)
+				default:
+					fmt.Fprintf(&b, "if %s != \"\" {\n%s.Set(%q, %s)\n}\n", field, target, param.Name, field)
+				}
+			}
+			fmt.Fprintf(&b, "}\n")
+		}
+		query, header := targets["query"], targets["header"]
+
+		body := "nil"
+		switch {
+		case len(e.fileParams) > 0:
+			var files []string
+			for _, param := range e.fileParams {
+				files = append(files, fmt.Sprintf("%q: %s", param.Name, unexportedName(param.Name)))
+			}
+			body = fmt.Sprintf("multipartForm{fields: %s, files: map[string]File{%s}}", targets["formData"], strings.Join(files, ", "))
+		case e.body != nil:
+			body = "body"
+		}
+		if resultType == "" {
+			fmt.Fprintf(&b, "return c.do(ctx, %q, %s, %s, %s, %s, nil)\n}\n\n", e.method, path, query, header, body)
+			continue
+		}
+		fmt.Fprintf(&b, "var result %s\n", strings.TrimPrefix(resultType, "*"))
+		fmt.Fprintf(&b, "if err := c.do(ctx, %q, %s, %s, %s, %s, &result); err != nil {\nreturn nil, err\n}\n", e.method, path, query, header, body)
+		if strings.HasPrefix(resultType, "*") {
+			fmt.Fprintf(&b, "return &result, nil\n}\n\n")
+		} else {
+			fmt.Fprintf(&b, "return result, nil\n}\n\n")
+		}
+	}
+
+	// Import only the packages the generated code uses
+	var source bytes.Buffer
+	fmt.Fprintf(&source, "// Code generated by generate.go from docs/swagger.json. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&source, "package client\n\nimport (\n")
+	for _, pkg := range []string{"context", "encoding/json", "net/http", "net/url", "strconv"} {
+		if bytes.Contains(b.Bytes(), []byte(pkg[strings.LastIndex(pkg, "/")+1:]+".")) {
+			fmt.Fprintf(&source, "%q\n", pkg)
+		}
+	}
+	fmt.Fprintf(&source, ")\n\n")
+	source.Write(b.Bytes())
+	return source.Bytes()
+}
+
+func goSchemaType(sch *schema, typeNames map[string]string) string {
+	if sch.Ref != "" {
+		return typeNames[refName(sch.Ref)]
+	}
+	switch sch.Type {
+	case "string":
+		return "string"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		if sch.Items == nil {
+			return "[]json.RawMessage"
+		}
+		return "[]" + goSchemaType(sch.Items, typeNames)
+	case "object":
+		if sch.AdditionalProperties != nil {
+			return "map[string]" + goSchemaType(sch.AdditionalProperties, typeNames)
+		}
+	}
+	return "json.RawMessage"
+}
+
+func goParamType(param parameter) string {
+	switch param.Type {
+	case "integer":
+		return "int"
+	case "boolean":
+		return "bool"
+	case "array":
+		return "[]string"
+	}
+	return "string"
+}
+
+func generateTypeScript(s spec, typeNames map[string]string, endpoints []endpoint) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by client/generate.go from docs/swagger.json. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "/** Version of the API spec this client was generated from */\n")
+	fmt.Fprintf(&b, "export const specVersion = %q;\n\n", s.Info.Version)
+	b.WriteString(tsRuntime)
+
+	tsType := func(sch *schema) string { return tsSchemaType(sch, typeNames) }
+
+	for _, ref := range sortedKeys(s.Definitions) {
+		def := s.Definitions[ref]
+		name := typeNames[ref]
+		if def.Description != "" {
+			fmt.Fprintf(&b, "/** %s */\n", def.Description)
+		}
+		if len(def.Enum) > 0 {
+			fmt.Fprintf(&b, "export type %s = %s;\n\n", name, tsEnum(def.Enum))
+			continue
+		}
+		fmt.Fprintf(&b, "export interface %s {\n", name)
+		for _, property := range sortedKeys(def.Properties) {
+			prop := def.Properties[property]
+			if prop.Description != "" {
+				fmt.Fprintf(&b, "  /** %s */\n", prop.Description)
+			}
+			fmt.Fprintf(&b, "  %s?: %s;\n", tsPropertyName(property), tsType(&prop))
+		}
+		fmt.Fprintf(&b, "}\n\n")
+	}
+
+	for _, e := range endpoints {
+		if len(e.otherParams) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "/** Optional parameters of %s */\n", unexportedName(e.name))
+		fmt.Fprintf(&b, "export interface %sParams {\n", e.name)
+		for _, param := range e.otherParams {
+			if param.Description != "" {
+				fmt.Fprintf(&b, "  /** %s */\n", param.Description)
+			}
+			fmt.Fprintf(&b, "  %s?: %s;\n", unexportedName(param.Name), tsParamType(param))
+		}
+		fmt.Fprintf(&b, "}\n\n")
+	}
+
+	fmt.Fprintf(&b, "/** Sends requests to one deployment of the API */\n")
+	fmt.Fprintf(&b, "export class Client {\n")
+	b.WriteString(tsClientRuntime)
+	for _, e := range endpoints {
+		fmt.Fprintf(&b, "\n  /**\n")
+		if e.description != "" {
+			fmt.Fprintf(&b, "   * %s\n   *\n", e.description)
+		}
+		fmt.Fprintf(&b, "   * `%s %s`", e.method, e.path)
+		if e.admin {
+			fmt.Fprintf(&b, " (admin)")
+		}
+		fmt.Fprintf(&b, "\n   */\n")
+
+		var args []string
+		for _, param := range e.pathParams {
+			args = append(args, unexportedName(param.Name)+": string")
+		}
+		for _, param := range e.fileParams {
+			args = append(args, unexportedName(param.Name)+": Blob")
+		}
+		if e.body != nil {
+			args = append(args, "body: "+tsType(e.body))
+		}
+		if len(e.otherParams) > 0 {
+			args = append(args, "params: "+e.name+"Params = {}")
+		}
+		args = append(args, "init: RequestInit = {}")
+
+		resultType := "void"
+		if e.result != nil {
+			resultType = tsType(e.result)
+		}
+		fmt.Fprintf(&b, "  %s(%s): Promise<%s> {\n", unexportedName(e.name), strings.Join(args, ", "), resultType)
+
+		path := "`" + e.path + "`"
+		for _, param := range e.pathParams {
+			path = strings.Replace(path, "{"+param.Name+"}", "${encodeURIComponent("+unexportedName(param.Name)+")}", 1)
+		}
+
+		var query, headers, form []string
+		for _, param := range e.fileParams {
+			form = append(form, fmt.Sprintf("%q: %s", param.Name, unexportedName(param.Name)))
+		}
+		for _, param := range e.otherParams {
+			entry := fmt.Sprintf("%q: params.%s", param.Name, unexportedName(param.Name))
+			switch param.In {
+			case "header":
+				headers = append(headers, entry)
+			case "formData":
+				form = append(form, entry)
+			default:
+				query = append(query, entry)
+			}
+		}
+		var parts []string
+		if len(query) > 0 {
+			parts = append(parts, "query: { "+strings.Join(query, ", ")+" }")
+		}
+		if len(headers) > 0 {
+			parts = append(parts, "headers: { "+strings.Join(headers, ", ")+" }")
+		}
+		if len(form) > 0 {
+			parts = append(parts, "form: { "+strings.Join(form, ", ")+" }")
+		}
+		if e.body != nil {
+			parts = append(parts, "body")
+		}
+		parts = append(parts, "init")
+		fmt.Fprintf(&b, "    return this.request<%s>(%q, %s, { %s });\n  }\n", resultType, e.method, path, strings.Join(parts, ", "))
+	}
+	fmt.Fprintf(&b, "}\n")
+	return b.Bytes()
+}
+
+func tsSchemaType(sch *schema, typeNames map[string]string) string {
+	if sch.Ref != "" {
+		return typeNames[refName(sch.Ref)]
+	}
+	if len(sch.Enum) > 0 {
+		return tsEnum(sch.Enum)
+	}
+	switch sch.Type {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "array":
+		if sch.Items == nil {
+			return "unknown[]"
+		}
+		item := tsSchemaType(sch.Items, typeNames)
+		if strings.Contains(item, "|") {
+			item = "(" + item + ")"
+		}
+		return item + "[]"
+	case "object":
+		if sch.AdditionalProperties != nil {
+			return "Record<string, " + tsSchemaType(sch.AdditionalProperties, typeNames) + ">"
+		}
+		return "Record<string, unknown>"
+	}
+	return "unknown"
+}
+
+func tsEnum(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	return strings.Join(quoted, " | ")
+}
+
+func tsParamType(param parameter) string {
+	switch param.Type {
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "array":
+		return "string[]"
+	}
+	return "string"
+}
+
+var tsIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+func tsPropertyName(name string) string {
+	if tsIdentifier.MatchString(name) {
+		return name
+	}
+	return fmt.Sprintf("%q", name)
+}
+
+// tsRuntime holds the hand-written types of the TypeScript client
+const tsRuntime = `/** An error response from the API */
+export class ApiError extends Error {
+  constructor(
+    readonly status: number,
+    readonly response: ErrorResponse,
+  ) {
+    super(response.code ? ` + "`api returned status ${status}: ${response.code}: ${response.error}`" + ` : ` + "`api returned status ${status}`" + `);
+    this.name = "ApiError";
+  }
+}
+
+export interface ClientOptions {
+  /** Sent as a bearer token on every request, which admin routes require to be BACKEND_PASSWORD */
+  token?: string;
+  /** Used instead of the global fetch, e.g. to add retries or run on older runtimes */
+  fetch?: typeof fetch;
+}
+
+interface RequestParts {
+  query?: Record<string, string | number | boolean | string[] | undefined>;
+  headers?: Record<string, string | undefined>;
+  /** Sent as multipart/form-data instead of a JSON body */
+  form?: Record<string, string | number | boolean | string[] | Blob | undefined>;
+  body?: unknown;
+  init: RequestInit;
+}
+
+`
+
+// tsClientRuntime opens the Client class with the constructor and the request helper every generated method calls
+const tsClientRuntime = `  private readonly baseUrl: string;
+
+  constructor(
+    baseUrl: string,
+    private readonly options: ClientOptions = {},
+  ) {
+    this.baseUrl = baseUrl.replace(/\/$/, "");
+  }
+
+  private async request<T>(method: string, path: string, parts: RequestParts): Promise<T> {
+    const query = new URLSearchParams();
+    for (const [name, value] of Object.entries(parts.query ?? {})) {
+      if (Array.isArray(value)) {
+        value.forEach((item) => query.append(name, item));
+      } else if (value !== undefined && value !== "") {
+        query.set(name, String(value));
+      }
+    }
+
+    const headers = new Headers(parts.init.headers);
+    headers.set("Accept", "application/json");
+    for (const [name, value] of Object.entries(parts.headers ?? {})) {
+      if (value !== undefined && value !== "") {
+        headers.set(name, value);
+      }
+    }
+    let body: BodyInit | undefined;
+    if (parts.form) {
+      const form = new FormData();
+      for (const [name, value] of Object.entries(parts.form)) {
+        if (value instanceof Blob) {
+          form.append(name, value);
+        } else if (Array.isArray(value)) {
+          value.forEach((item) => form.append(name, item));
+        } else if (value !== undefined && value !== "") {
+          form.append(name, String(value));
+        }
+      }
+      body = form;
+    } else if (parts.body !== undefined) {
+      headers.set("Content-Type", "application/json");
+      body = JSON.stringify(parts.body);
+    }
+    if (this.options.token) {
+      headers.set("Authorization", ` + "`Bearer ${this.options.token}`" + `);
+    }
+
+    const search = query.toString();
+    const doFetch = this.options.fetch ?? fetch;
+    const response = await doFetch(this.baseUrl + path + (search ? "?" + search : ""), {
+      ...parts.init,
+      method,
+      headers,
+      body,
+    });
+
+    if (!response.ok) {
+      const body = await response.json().catch(() => ({}));
+      throw new ApiError(response.status, body as ErrorResponse);
+    }
+    const text = await response.text();
+    return (text ? JSON.parse(text) : undefined) as T;
+  }
+`
@@ -0,0 +1,3174 @@
+// Code generated by client/generate.go from docs/swagger.json. DO NOT EDIT.
+
+/** Version of the API spec this client was generated from */
+export const specVersion = "1.1";
+
+/** An error response from the API */
+export class ApiError extends Error {
+  constructor(
+    readonly status: number,
+    readonly response: ErrorResponse,
+  ) {
+    super(response.code ? `api returned status ${status}: ${response.code}: ${response.error}` : `api returned status ${status}`);
+    this.name = "ApiError";
+  }
+}
+
+export interface ClientOptions {
+  /** Sent as a bearer token on every request, which admin routes require to be BACKEND_PASSWORD */
+  token?: string;
+  /** Used instead of the global fetch, e.g. to add retries or run on older runtimes */
+  fetch?: typeof fetch;
+}
+
+interface RequestParts {
+  query?: Record<string, string | number | boolean | string[] | undefined>;
+  headers?: Record<string, string | undefined>;
+  /** Sent as multipart/form-data instead of a JSON body */
+  form?: Record<string, string | number | boolean | string[] | Blob | undefined>;
+  body?: unknown;
+  init: RequestInit;
+}
+
+export interface APIChange {
+  changes?: string[];
+  version?: string;
+}
+
+export interface APIVersion {
+  changelog?: APIChange[];
+  spec?: string;
+  version?: string;
+}
+
+export interface AnalyticsOverview {
+  from?: string;
+  socialPosting?: PlatformSuccess[];
+  to?: string;
+  topPosts?: ContentViews[];
+  topProjects?: ContentViews[];
+  totalViews?: number;
+  totalVisitors?: number;
+  viewsOverTime?: DailyViews[];
+}
+
+export interface BatchOperation {
+  blogPost?: BlogPost;
+  blogPostId?: string;
+  op?: "createBlogPost" | "createProject" | "attachTags";
+  project?: Project;
+  projectId?: string;
+  ref?: number;
+  tags?: string[];
+}
+
+export interface BatchRequest {
+  operations?: BatchOperation[];
+}
+
+export interface BatchResponse {
+  committed?: boolean;
+  results?: BatchResult[];
+}
+
+export interface BatchResult {
+  error?: string;
+  id?: string;
+  index?: number;
+  op?: string;
+  status?: "ok" | "error" | "skipped";
+}
+
+export interface BenchEndpointReport {
+  avgBytes?: number;
+  cacheHits?: number;
+  errors?: number;
+  maxMs?: number;
+  meanMs?: number;
+  minMs?: number;
+  name?: string;
+  p50Ms?: number;
+  p90Ms?: number;
+  p99Ms?: number;
+  path?: string;
+  requests?: number;
+  requestsPerSecond?: number;
+}
+
+export interface BenchReport {
+  concurrency?: number;
+  durationMs?: number;
+  endpoints?: BenchEndpointReport[];
+  requests?: number;
+  seeded?: number;
+}
+
+export interface BlogPostArchive {
+  total?: number;
+  years?: BlogPostArchiveYear[];
+}
+
+export interface BlogPostArchiveMonth {
+  count?: number;
+  month?: number;
+  name?: string;
+  posts?: BlogPostStub[];
+}
+
+export interface BlogPostArchiveYear {
+  count?: number;
+  months?: BlogPostArchiveMonth[];
+  year?: number;
+}
+
+export interface BlogPostCollectionWithTags {
+  data?: BlogPostWithTags[];
+  links?: ListLinks;
+  meta?: ListMeta;
+}
+
+export interface BlogPostExport {
+  count?: number;
+  data?: BlogPostWithTags[];
+}
+
+export interface BlogPostImageCollection {
+  images?: BlogPostImage[];
+}
+
+export interface BlogPostSearchResult {
+  blogPost?: BlogPost;
+  score?: number;
+  tags?: BlogTag[];
+}
+
+export interface BlogPostSearchResults {
+  data?: BlogPostSearchResult[];
+  query?: string;
+}
+
+export interface BlogPostStub {
+  dateAdded?: string;
+  id?: string;
+  title?: string;
+}
+
+export interface BlogPostSummary {
+  dateAdded?: string;
+  dateEdited?: string;
+  id?: string;
+  readingTimeMinutes?: number;
+  summary?: string;
+  tags?: string[];
+  title?: string;
+  url?: string;
+  wordCount?: number;
+}
+
+export interface BlogPostSummaryCollection {
+  data?: BlogPostSummary[];
+  links?: ListLinks;
+  meta?: ListMeta;
+}
+
+export interface BlogPostTranslations {
+  data?: BlogPostTranslation[];
+  defaultLocale?: string;
+}
+
+export interface BlogPostViewResponse {
+  /** Counted is false when the visitor's view had already been counted within the window */
+  counted?: boolean;
+  views?: number;
+}
+
+export interface BlogPostWithTags {
+  blogPost?: BlogPost;
+  tags?: BlogTag[];
+}
+
+export interface BookCollection {
+  books?: Book[];
+  total?: number;
+}
+
+export interface BookmarkCollection {
+  data?: Bookmark[];
+  links?: ListLinks;
+  meta?: ListMeta;
+}
+
+export interface BulkDeleteRequest {
+  addedAfter?: string;
+  addedBefore?: string;
+  ids?: string[];
+  tag?: string;
+}
+
+export interface BulkDeleteResult {
+  deleted?: number;
+  deletedIds?: string[];
+  /** NotFound lists requested IDs that didn't exist or didn't match the rest of the filter */
+  notFound?: string[];
+}
+
+export interface CertificationCollection {
+  certifications?: Certification[];
+  total?: number;
+}
+
+export interface ColumnTypeMismatchReport {
+  column?: string;
+  databaseType?: string;
+  modelType?: string;
+}
+
+export interface ContentExport {
+  blogPosts?: MarkdownDocument[];
+  exportedAt?: string;
+  projects?: MarkdownDocument[];
+  tags?: ExportedTags;
+}
+
+export interface ContentSources {
+  contentId?: string;
+  contentType?: "blogPost" | "project";
+  referrers?: SourceViews[];
+  title?: string;
+  utmSources?: SourceViews[];
+  views?: number;
+}
+
+export interface ContentViews {
+  id?: string;
+  title?: string;
+  views?: number;
+}
+
+export interface CountryViews {
+  country?: string;
+  regions?: RegionViews[];
+  views?: number;
+  visitors?: number;
+}
+
+export interface CredentialHealth {
+  account?: string;
+  error?: string;
+  expiresAt?: string;
+  platform?: string;
+  status?: "ok" | "expiring" | "invalid" | "unconfigured" | "unreachable";
+}
+
+export interface CredentialHealthReport {
+  healthy?: boolean;
+  platforms?: CredentialHealth[];
+}
+
+export interface DailyViews {
+  day?: string;
+  views?: number;
+  visitors?: number;
+}
+
+export interface DoctorIssue {
+  detail?: string;
+  fixable?: boolean;
+  id?: string;
+  kind?: "orphanedTag" | "wordCountMismatch" | "danglingSocialPost" | "missingSlug" | "duplicateSlug" | "brokenMedia";
+  table?: string;
+}
+
+export interface DoctorReport {
+  fixed?: number;
+  issues?: DoctorIssue[];
+}
+
+export interface EducationCollection {
+  education?: Education[];
+  total?: number;
+}
+
+export interface ErrorCount {
+  code?: string;
+  count?: number;
+  status?: number;
+}
+
+export interface ErrorMetrics {
+  clientErrors?: number;
+  errors?: ErrorCount[];
+  serverErrors?: number;
+  since?: string;
+}
+
+/** Error response structure */
+export interface ErrorResponse {
+  cause?: string;
+  code?: string;
+  details?: string;
+  error?: string;
+  field?: string;
+  fields?: FieldError[];
+  status?: string;
+}
+
+export interface ExpiringCertification {
+  credentialId?: string;
+  credentialUrl?: string;
+  daysUntilExpiry?: number;
+  expired?: boolean;
+  expiryDate?: string;
+  id?: string;
+  issueDate?: string;
+  issuer?: string;
+  name?: string;
+}
+
+export interface ExpiringCertificationReport {
+  certifications?: ExpiringCertification[];
+  withinDays?: number;
+}
+
+export interface ExportedTags {
+  blogPosts?: TagCount[];
+  projects?: TagCount[];
+}
+
+export interface FAQCollection {
+  faqs?: FAQ[];
+  total?: number;
+}
+
+export interface GeoReport {
+  data?: CountryViews[];
+  from?: string;
+  to?: string;
+}
+
+export interface GitHubProjectImport {
+  /** DryRun returns the project as it would be created without saving it, e.g. to prefill a form */
+  dryRun?: boolean;
+  /** Type is the project's type, open-source unless given */
+  type?: string;
+  url?: string;
+}
+
+export interface GuestbookEntryCollection {
+  entries?: GuestbookEntry[];
+  total?: number;
+}
+
+export interface GuestbookSubmission {
+  /** Email is a honeypot: the form hides it from people, so anything filled in came from a bot */
+  email?: string;
+  message?: string;
+  name?: string;
+  website?: string;
+}
+
+export interface ImportReport {
+  created?: number;
+  dryRun?: boolean;
+  failed?: number;
+  posts?: ImportResult[];
+  skipped?: number;
+  updated?: number;
+}
+
+export interface ImportResult {
+  /** Attachments lists the URLs of the media attached to the post on its old platform, which aren't copied */
+  attachments?: string[];
+  error?: string;
+  file?: string;
+  id?: string;
+  status?: "created" | "updated" | "skipped" | "failed";
+  title?: string;
+}
+
+export interface IndexAuditReport {
+  missingIndexes?: string[];
+  plans?: QueryPlanReport[];
+}
+
+export interface JSONFeed {
+  authors?: JSONFeedAuthor[];
+  description?: string;
+  feed_url?: string;
+  home_page_url?: string;
+  items?: JSONFeedItem[];
+  language?: string;
+  title?: string;
+  version?: string;
+}
+
+export interface JSONFeedAttachment {
+  mime_type?: string;
+  url?: string;
+}
+
+export interface JSONFeedAuthor {
+  name?: string;
+  url?: string;
+}
+
+export interface JSONFeedItem {
+  attachments?: JSONFeedAttachment[];
+  content_html?: string;
+  content_text?: string;
+  date_modified?: string;
+  date_published?: string;
+  id?: string;
+  image?: string;
+  language?: string;
+  summary?: string;
+  tags?: string[];
+  title?: string;
+  url?: string;
+}
+
+export interface ListLinks {
+  next?: string;
+  prev?: string;
+  self?: string;
+}
+
+export interface ListMeta {
+  nextCursor?: string;
+  page?: number;
+  perPage?: number;
+  total?: number;
+}
+
+export interface MarkdownDocument {
+  content?: string;
+  fileName?: string;
+}
+
+export interface MediaObjectCollection {
+  data?: MediaObject[];
+  links?: ListLinks;
+  meta?: ListMeta;
+}
+
+export interface MetaTag {
+  content?: string;
+  name?: string;
+  property?: string;
+}
+
+export interface MicropubConfig {
+  "media-endpoint"?: string;
+  "syndicate-to"?: MicropubSyndicationTarget[];
+}
+
+export interface MicropubError {
+  error?: string;
+  error_description?: string;
+}
+
+export interface MicropubSyndicationTarget {
+  name?: string;
+  uid?: string;
+}
+
+export interface NoteCollection {
+  data?: Note[];
+  links?: ListLinks;
+  meta?: ListMeta;
+}
+
+export interface PageMeta {
+  canonicalUrl?: string;
+  description?: string;
+  image?: string;
+  openGraph?: MetaTag[];
+  title?: string;
+  twitter?: MetaTag[];
+}
+
+export interface PageViewRequest {
+  contentId?: string;
+  contentType?: "blogPost" | "project";
+  path?: string;
+  referrer?: string;
+}
+
+export interface PlatformSuccess {
+  attempts?: number;
+  failed?: number;
+  platform?: string;
+  succeeded?: number;
+  successRate?: number;
+}
+
+export interface PopularBlogPost {
+  dateAdded?: string;
+  dateEdited?: string;
+  id?: string;
+  readingTimeMinutes?: number;
+  summary?: string;
+  tags?: string[];
+  title?: string;
+  url?: string;
+  views?: number;
+  wordCount?: number;
+}
+
+export interface PopularBlogPostCollection {
+  data?: PopularBlogPost[];
+  period?: string;
+}
+
+export interface PreviewLink {
+  expiresAt?: string;
+  token?: string;
+  url?: string;
+}
+
+export interface ProjectCaseStudy {
+  html?: string;
+  project_id?: string;
+  slug?: string;
+  title?: string;
+}
+
+export interface ProjectCollectionWithTags {
+  data?: ProjectWithTags[];
+  links?: ListLinks;
+  meta?: ListMeta;
+}
+
+export interface ProjectImageCollection {
+  images?: ProjectImage[];
+}
+
+export interface ProjectSearchResult {
+  project?: Project;
+  score?: number;
+  tags?: ProjectTag[];
+}
+
+export interface ProjectSearchResults {
+  data?: ProjectSearchResult[];
+  query?: string;
+}
+
+export interface ProjectSummary {
+  date_added?: string;
+  date_edited?: string;
+  demo_link?: string;
+  description?: string;
+  gif_link?: string;
+  github_link?: string;
+  id?: string;
+  tags?: string[];
+  title?: string;
+  type?: string;
+}
+
+export interface ProjectSummaryCollection {
+  data?: ProjectSummary[];
+  links?: ListLinks;
+  meta?: ListMeta;
+}
+
+export interface ProjectWithTags {
+  project?: Project;
+  tags?: ProjectTag[];
+}
+
+export interface QueryPlanReport {
+  indexesUsed?: string[];
+  name?: string;
+  seqScans?: string[];
+  sql?: string;
+  totalCost?: number;
+  warnings?: string[];
+}
+
+export interface ReadingList {
+  currentlyReading?: Book[];
+  finished?: Book[];
+}
+
+export interface RecentChange {
+  blogPost?: BlogPost;
+  dateAdded?: string;
+  dateEdited?: string;
+  id?: string;
+  note?: Note;
+  project?: Project;
+  type?: "blog_post" | "project" | "note";
+}
+
+export interface RecentChanges {
+  items?: RecentChange[];
+  nextCursor?: string;
+}
+
+export interface ReferrerReport {
+  data?: ContentSources[];
+  from?: string;
+  to?: string;
+}
+
+export interface RegionViews {
+  region?: string;
+  views?: number;
+  visitors?: number;
+}
+
+export interface Resume {
+  certifications?: Certification[];
+  education?: Education[];
+  name?: string;
+  workExperience?: WorkExperience[];
+}
+
+export interface ScheduledJobList {
+  data?: ScheduledJobStatus[];
+}
+
+export interface ScheduledJobStatus {
+  enabled?: boolean;
+  failures?: number;
+  interval?: string;
+  jitter?: string;
+  lastDurationMs?: number;
+  lastError?: string;
+  lastFinishedAt?: string;
+  lastStartedAt?: string;
+  name?: string;
+  nextRunAt?: string;
+  running?: boolean;
+  runs?: number;
+}
+
+export interface SchemaDriftReport {
+  drifted?: boolean;
+  tables?: TableDriftReport[];
+}
+
+export interface SearchResult {
+  blogPost?: BlogPostSummary;
+  project?: ProjectSummary;
+  score?: number;
+  tag?: SearchTag;
+  type?: "blog_post" | "project" | "tag";
+}
+
+export interface SearchResults {
+  data?: SearchResult[];
+  query?: string;
+}
+
+export interface SearchTag {
+  blogPosts?: number;
+  projects?: number;
+  value?: string;
+}
+
+export interface SeriesCollection {
+  series?: Series[];
+  total?: number;
+}
+
+export interface SeriesPostRequest {
+  /** Position is where the post goes in the series; it's put after the last post when not given */
+  position?: number;
+}
+
+export interface SeriesPostSummary {
+  dateAdded?: string;
+  dateEdited?: string;
+  id?: string;
+  position?: number;
+  readingTimeMinutes?: number;
+  summary?: string;
+  tags?: string[];
+  title?: string;
+  url?: string;
+  wordCount?: number;
+}
+
+export interface SeriesResponse {
+  dateAdded?: string;
+  dateEdited?: string;
+  description?: string;
+  id?: string;
+  posts?: SeriesPostSummary[];
+  slug?: string;
+  title?: string;
+}
+
+export interface ShortLinkCollection {
+  data?: ShortLinkResponse[];
+  links?: ListLinks;
+  meta?: ListMeta;
+}
+
+export interface ShortLinkRequest {
+  code?: string;
+  contentId?: string;
+  contentType?: "blogPost" | "project" | "url";
+  url?: string;
+}
+
+export interface ShortLinkResponse {
+  clicks?: number;
+  code?: string;
+  contentId?: string;
+  contentType?: string;
+  dateAdded?: string;
+  id?: string;
+  shortUrl?: string;
+  targetUrl?: string;
+}
+
+export interface SkillCollection {
+  skills?: Skill[];
+  total?: number;
+}
+
+export interface SnippetCollection {
+  data?: Snippet[];
+  links?: ListLinks;
+  meta?: ListMeta;
+}
+
+export interface SocialPostCollection {
+  data?: SocialPost[];
+  links?: ListLinks;
+  meta?: ListMeta;
+}
+
+export interface SourceViews {
+  source?: string;
+  views?: number;
+}
+
+export interface TableDriftReport {
+  drifted?: boolean;
+  exists?: boolean;
+  extraColumns?: string[];
+  missingColumns?: string[];
+  table?: string;
+  typeMismatches?: ColumnTypeMismatchReport[];
+}
+
+export interface Tag {
+  blogPosts?: number;
+  projects?: number;
+  total?: number;
+  value?: string;
+}
+
+export interface TagCollection {
+  data?: Tag[];
+}
+
+export interface TagCount {
+  count?: number;
+  value?: string;
+}
+
+export interface TagMerge {
+  from?: string;
+  into?: string;
+}
+
+export interface TagRename {
+  value?: string;
+}
+
+export interface TestimonialCollection {
+  testimonials?: Testimonial[];
+  total?: number;
+}
+
+export interface TestimonialSubmission {
+  authorCompany?: string;
+  authorName?: string;
+  authorRole?: string;
+  text?: string;
+}
+
+export interface Timeline {
+  items?: TimelineItem[];
+  nextCursor?: string;
+}
+
+export interface TimelineItem {
+  blogPost?: BlogPost;
+  date?: string;
+  id?: string;
+  note?: Note;
+  project?: Project;
+  type?: "blog_post" | "project" | "note";
+}
+
+export interface TrashedBlogPost {
+  blogPost?: BlogPost;
+  deletedAt?: string;
+  tags?: BlogTag[];
+}
+
+export interface TrashedBlogPostCollection {
+  data?: TrashedBlogPost[];
+  links?: ListLinks;
+  meta?: ListMeta;
+}
+
+export interface TrashedProject {
+  deletedAt?: string;
+  project?: Project;
+  tags?: ProjectTag[];
+}
+
+export interface TrashedProjectCollection {
+  data?: TrashedProject[];
+  links?: ListLinks;
+  meta?: ListMeta;
+}
+
+export interface TrendingItem {
+  id?: string;
+  score?: number;
+  title?: string;
+  type?: "blogPost" | "project";
+  views?: number;
+}
+
+export interface TrendingResponse {
+  data?: TrendingItem[];
+  generatedAt?: string;
+}
+
+export interface UnpublishedBlogPosts {
+  data?: BlogPostWithTags[];
+}
+
+export interface UsesCategory {
+  category?: string;
+  items?: UsesItem[];
+}
+
+export interface UsesPage {
+  categories?: UsesCategory[];
+  total?: number;
+}
+
+export interface WebhookCollection {
+  total?: number;
+  webhooks?: Webhook[];
+}
+
+export interface WebhookDeliveryCollection {
+  data?: WebhookDelivery[];
+  links?: ListLinks;
+  meta?: ListMeta;
+}
+
+export interface WorkExperienceCollection {
+  total?: number;
+  workExperience?: WorkExperience[];
+}
+
+export interface FieldError {
+  field?: string;
+  message?: string;
+}
+
+export interface BlogPost {
+  content?: string;
+  dateAdded?: string;
+  dateEdited?: string;
+  id?: string;
+  /** Images can be given when the post is created, and are managed under /blog-post/{blogPostID}/images after that */
+  images?: BlogPostImage[];
+  /** MetaDescription stands in for the summary in search results and link previews */
+  metaDescription?: string;
+  /** MetaTitle stands in for the title in search results and link previews */
+  metaTitle?: string;
+  /** OGImageURL stands in for the first image in the content in link previews */
+  ogImageUrl?: string;
+  publishAt?: string;
+  /** ReadingTimeMinutes is how long Content takes to read, rounded up to the minute */
+  readingTimeMinutes?: number;
+  status?: "draft" | "scheduled" | "published";
+  summary?: string;
+  tags?: BlogTag[];
+  title?: string;
+  /** URL is where the post lives, and its canonical URL wherever it's shared or cross-posted */
+  url?: string;
+  /** WordCount is how many words Content has, counted whenever it's saved */
+  wordCount?: number;
+}
+
+export interface BlogPostImage {
+  altText?: string;
+  blogPostId?: string;
+  caption?: string;
+  dateAdded?: string;
+  id?: string;
+  isFeatured?: boolean;
+  /** Position orders the gallery, lowest first; images added without one go after the last */
+  position?: number;
+  url?: string;
+}
+
+export interface BlogPostTranslation {
+  blogPostId?: string;
+  content?: string;
+  dateAdded?: string;
+  dateEdited?: string;
+  id?: string;
+  locale?: string;
+  summary?: string;
+  title?: string;
+}
+
+export interface BlogTag {
+  blog_post?: BlogPost;
+  blog_post_id?: string;
+  id?: string;
+  value?: string;
+}
+
+export interface Book {
+  author?: string;
+  coverUrl?: string;
+  dateAdded?: string;
+  dateFinished?: string;
+  dateStarted?: string;
+  firstPublishYear?: number;
+  id?: string;
+  isbn?: string;
+  notes?: string;
+  openLibraryKey?: string;
+  rating?: number;
+  status?: "want_to_read" | "reading" | "finished";
+  title?: string;
+}
+
+export interface Bookmark {
+  comment?: string;
+  dateAdded?: string;
+  description?: string;
+  id?: string;
+  imageUrl?: string;
+  tags?: BookmarkTag[];
+  title?: string;
+  url?: string;
+}
+
+export interface BookmarkTag {
+  bookmark_id?: string;
+  id?: string;
+  value?: string;
+}
+
+export interface Certification {
+  credentialId?: string;
+  credentialUrl?: string;
+  expiryDate?: string;
+  id?: string;
+  issueDate?: string;
+  issuer?: string;
+  name?: string;
+}
+
+export interface Education {
+  degree?: string;
+  description?: string;
+  endDate?: string;
+  fieldOfStudy?: string;
+  id?: string;
+  institution?: string;
+  startDate?: string;
+}
+
+export interface FAQ {
+  answer?: string;
+  displayOrder?: number;
+  id?: string;
+  published?: boolean;
+  question?: string;
+}
+
+export interface GuestbookEntry {
+  approved?: boolean;
+  dateApproved?: string;
+  dateSubmitted?: string;
+  id?: string;
+  message?: string;
+  name?: string;
+  website?: string;
+}
+
+export interface MediaObject {
+  contentType?: string;
+  dateAdded?: string;
+  fileName?: string;
+  height?: number;
+  id?: string;
+  key?: string;
+  size?: number;
+  /** Srcset lists the image and its web-sized variants by width, ready for an img tag's srcset */
+  srcset?: string;
+  url?: string;
+  /** Variants are the resized copies made of an image, stored next to it in the bucket */
+  variants?: MediaVariant[];
+  /** Width and Height are only set for images */
+  width?: number;
+}
+
+export interface MediaVariant {
+  contentType?: string;
+  height?: number;
+  key?: string;
+  name?: string;
+  size?: number;
+  url?: string;
+  width?: number;
+}
+
+export interface Note {
+  content?: string;
+  dateAdded?: string;
+  dateEdited?: string;
+  id?: string;
+  imageUrl?: string;
+}
+
+export interface Project {
+  /** CanonicalURL is the project's page on the site, for search engines to index instead of its other addresses */
+  canonical_url?: string;
+  /** CaseStudy is a long-form write-up of the project in Markdown, served as HTML by GET /project/{slug}/case-study */
+  case_study?: string;
+  date_added?: string;
+  date_edited?: string;
+  demo_link?: string;
+  description?: string;
+  gif_link?: string;
+  github_link?: string;
+  id?: string;
+  /** Images can be given when the project is created, and are managed under /project/{projectID}/images after that */
+  images?: ProjectImage[];
+  /** MetaDescription stands in for the description in search results and link previews */
+  meta_description?: string;
+  /** MetaTitle stands in for the title in search results and link previews */
+  meta_title?: string;
+  /** OGImageURL is the image link previews show */
+  og_image_url?: string;
+  tags?: ProjectTag[];
+  title?: string;
+  type?: string;
+}
+
+export interface ProjectImage {
+  alt_text?: string;
+  caption?: string;
+  date_added?: string;
+  id?: string;
+  /** Position orders the gallery, lowest first; images added without one go after the last */
+  position?: number;
+  project_id?: string;
+  url?: string;
+}
+
+export interface ProjectTag {
+  id?: string;
+  project?: Project;
+  project_id?: string;
+  value?: string;
+}
+
+export interface Series {
+  dateAdded?: string;
+  dateEdited?: string;
+  description?: string;
+  id?: string;
+  slug?: string;
+  title?: string;
+}
+
+export interface SeriesPost {
+  blogPostId?: string;
+  position?: number;
+  seriesId?: string;
+}
+
+export interface ShareLink {
+  clicks?: number;
+  contentId?: string;
+  contentType?: string;
+  dateAdded?: string;
+  id?: string;
+  platform?: string;
+  targetUrl?: string;
+  token?: string;
+}
+
+export interface Skill {
+  category?: string;
+  id?: string;
+  name?: string;
+  proficiency?: "beginner" | "intermediate" | "advanced" | "expert";
+  projectIds?: string[];
+  yearsOfExperience?: number;
+}
+
+export interface Snippet {
+  code?: string;
+  dateAdded?: string;
+  dateEdited?: string;
+  description?: string;
+  id?: string;
+  language?: string;
+  tags?: string[];
+  title?: string;
+}
+
+export interface SocialPost {
+  contentId?: string;
+  contentType?: string;
+  dateAdded?: string;
+  error?: string;
+  id?: string;
+  platform?: string;
+  shareLink?: ShareLink;
+  shareLinkId?: string;
+  status?: string;
+}
+
+export interface Testimonial {
+  approved?: boolean;
+  authorCompany?: string;
+  authorName?: string;
+  authorRole?: string;
+  dateApproved?: string;
+  dateSubmitted?: string;
+  id?: string;
+  text?: string;
+}
+
+export interface UsesItem {
+  category?: string;
+  description?: string;
+  displayOrder?: number;
+  id?: string;
+  link?: string;
+  name?: string;
+}
+
+export interface Webhook {
+  active?: boolean;
+  dateAdded?: string;
+  description?: string;
+  events?: string[];
+  id?: string;
+  secret?: string;
+  url?: string;
+}
+
+export interface WebhookDelivery {
+  attempts?: number;
+  dateAdded?: string;
+  dateDelivered?: string;
+  event?: string;
+  id?: string;
+  lastError?: string;
+  lastStatusCode?: number;
+  nextAttemptAt?: string;
+  payload?: Record<string, unknown>;
+  status?: WebhookDeliveryStatus;
+  webhookId?: string;
+}
+
+export type WebhookDeliveryStatus = "pending" | "succeeded" | "failed";
+
+export interface WorkExperience {
+  company?: string;
+  description?: string;
+  endDate?: string;
+  highlights?: string[];
+  id?: string;
+  location?: string;
+  role?: string;
+  startDate?: string;
+  techTags?: string[];
+}
+
+/** Optional parameters of getGeographicBreakdown */
+export interface GetGeographicBreakdownParams {
+  /** First day to include (YYYY-MM-DD, UTC). Defaults to 29 days before to */
+  from?: string;
+  /** Last day to include (YYYY-MM-DD, UTC). Defaults to today */
+  to?: string;
+  /** Only include this content type */
+  contentType?: string;
+  /** Only include this blog post or project */
+  contentID?: string;
+}
+
+/** Optional parameters of getAnalyticsOverview */
+export interface GetAnalyticsOverviewParams {
+  /** First day to include (YYYY-MM-DD, UTC). Defaults to 29 days before to */
+  from?: string;
+  /** Last day to include (YYYY-MM-DD, UTC). Defaults to today */
+  to?: string;
+}
+
+/** Optional parameters of getReferrersAndUTMSources */
+export interface GetReferrersAndUTMSourcesParams {
+  /** First day to include (YYYY-MM-DD, UTC). Defaults to 29 days before to */
+  from?: string;
+  /** Last day to include (YYYY-MM-DD, UTC). Defaults to today */
+  to?: string;
+  /** Only include this content type */
+  contentType?: string;
+  /** Only include this blog post or project */
+  contentID?: string;
+  /** Maximum referrers and UTM sources listed per entry (max 100) */
+  limit?: number;
+}
+
+/** Optional parameters of runBenchmark */
+export interface RunBenchmarkParams {
+  /** Requests per endpoint (default 100, at most 1000) */
+  requests?: number;
+  /** Requests in flight at once (default 4, at most 32) */
+  concurrency?: number;
+  /** Blog posts and projects to seed (default 50, at most 1000) */
+  seed?: number;
+  /** Search query (default benchmark, which matches the seeded content) */
+  q?: string;
+}
+
+/** Optional parameters of getExpiringCertifications */
+export interface GetExpiringCertificationsParams {
+  /** Look-ahead window in days */
+  days?: number;
+}
+
+/** Optional parameters of checkDataConsistency */
+export interface CheckDataConsistencyParams {
+  /** Whether to request every image URL to check it loads, which is the slow part */
+  media?: boolean;
+}
+
+/** Optional parameters of fixDataConsistencyIssues */
+export interface FixDataConsistencyIssuesParams {
+  /** Whether to request every image URL to check it loads, which is the slow part */
+  media?: boolean;
+}
+
+/** Optional parameters of getGuestbookEntriesForModeration */
+export interface GetGuestbookEntriesForModerationParams {
+  /** Moderation status */
+  status?: string;
+}
+
+/** Optional parameters of getShortLinks */
+export interface GetShortLinksParams {
+  /** Page number (starts at 1) */
+  page?: number;
+  /** Short links per page (max 100) */
+  perPage?: number;
+}
+
+/** Optional parameters of getSocialPosts */
+export interface GetSocialPostsParams {
+  /** Only include this content type */
+  contentType?: string;
+  /** Only include this blog post or note */
+  contentID?: string;
+  /** Page number (starts at 1) */
+  page?: number;
+  /** Social posts per page (max 100) */
+  perPage?: number;
+}
+
+/** Optional parameters of checkSocialPlatformCredentials */
+export interface CheckSocialPlatformCredentialsParams {
+  /** Comma-separated platforms to check (default all). Valid values: substack, medium, twitter, linkedin, mastodon */
+  platforms?: string;
+}
+
+/** Optional parameters of getTestimonialsForModeration */
+export interface GetTestimonialsForModerationParams {
+  /** Moderation status */
+  status?: string;
+}
+
+/** Optional parameters of getWebhookDeliveries */
+export interface GetWebhookDeliveriesParams {
+  /** Page number (starts at 1) */
+  page?: number;
+  /** Deliveries per page (max 100) */
+  perPage?: number;
+}
+
+/** Optional parameters of runBatch */
+export interface RunBatchParams {
+  /** Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours) */
+  idempotencyKey?: string;
+}
+
+/** Optional parameters of createBlogPost */
+export interface CreateBlogPostParams {
+  /** Deprecated: feature one of the post's images instead. Main image URL for Substack posting, used when the post has no featured image */
+  mainImageURL?: string;
+  /** Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours) */
+  idempotencyKey?: string;
+}
+
+/** Optional parameters of getBlogPost */
+export interface GetBlogPostParams {
+  /** Preview token, which lets anyone read the draft until it expires */
+  preview?: string;
+  /** Locale to serve the post's translation in, e.g. pt; Accept-Language is used when not given */
+  locale?: string;
+  /** Whether content is served as the Markdown it's written in or rendered as sanitized HTML */
+  format?: string;
+}
+
+/** Optional parameters of deleteBlogPost */
+export interface DeleteBlogPostParams {
+  /** Delete permanently instead of moving to the trash */
+  permanent?: boolean;
+}
+
+/** Optional parameters of getBlogPostMetadata */
+export interface GetBlogPostMetadataParams {
+  /** Locale to describe a translated post in, e.g. pt; Accept-Language is used when not given */
+  locale?: string;
+}
+
+/** Optional parameters of createBlogPostPreviewLink */
+export interface CreateBlogPostPreviewLinkParams {
+  /** How long the link works, as a duration such as 24h (defaults to PREVIEW_LINK_TTL, 72h unless set; at most 720h) */
+  expiresIn?: string;
+}
+
+/** Optional parameters of createBlogPostPreviewToken */
+export interface CreateBlogPostPreviewTokenParams {
+  /** How long the token works, as a duration such as 24h (defaults to PREVIEW_LINK_TTL, 72h unless set; at most 720h) */
+  expiresIn?: string;
+}
+
+/** Optional parameters of getBlogPostTranslations */
+export interface GetBlogPostTranslationsParams {
+  /** Preview token from POST /blog-post/{blogPostID}/preview-link */
+  preview?: string;
+}
+
+/** Optional parameters of getAllBlogPosts */
+export interface GetAllBlogPostsParams {
+  /** Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, wordCount (snake_case spellings such as date_added work too). Not allowed with cursor */
+  sort?: string;
+  /** Page number (starts at 1). Not allowed with cursor */
+  page?: number;
+  /** meta.nextCursor of the previous page, or empty for the first page, to page by cursor */
+  cursor?: string;
+  /** Blog posts per page (max 100) */
+  perPage?: number;
+  /** Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given */
+  locale?: string;
+  /** Only blog posts with this tag, e.g. golang */
+  tag?: string;
+  /** Comma-separated tags to filter by, e.g. golang,postgres (max 10 together with tag) */
+  tags?: string;
+  /** Whether blog posts need any of the tags or all of them */
+  tagMode?: string;
+  /** Whether content is served as the Markdown it's written in or rendered as sanitized HTML */
+  format?: string;
+}
+
+/** Optional parameters of importBlogPosts */
+export interface ImportBlogPostsParams {
+  /** Comma-separated tags to add to every imported post; for a zip it can also be a form field */
+  tags?: string;
+}
+
+/** Optional parameters of getPopularBlogPosts */
+export interface GetPopularBlogPostsParams {
+  /** How far back views count, in days or hours, e.g. 7d or 24h (max 365d) */
+  period?: string;
+  /** Maximum number of blog posts (max 50) */
+  limit?: number;
+  /** Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given */
+  locale?: string;
+}
+
+/** Optional parameters of searchBlogPosts */
+export interface SearchBlogPostsParams {
+  /** Search query (max 200 characters) */
+  q?: string;
+  /** Maximum number of results (max 50) */
+  limit?: number;
+}
+
+/** Optional parameters of getBlogPostSummaries */
+export interface GetBlogPostSummariesParams {
+  /** Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, wordCount (snake_case spellings such as date_added work too) */
+  sort?: string;
+  /** Page number (starts at 1) */
+  page?: number;
+  /** Blog posts per page (max 100) */
+  perPage?: number;
+  /** Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given */
+  locale?: string;
+  /** Only blog posts with this tag, e.g. golang */
+  tag?: string;
+  /** Comma-separated tags to filter by, e.g. golang,postgres (max 10 together with tag) */
+  tags?: string;
+  /** Whether blog posts need any of the tags or all of them */
+  tagMode?: string;
+}
+
+/** Optional parameters of getTrashedBlogPosts */
+export interface GetTrashedBlogPostsParams {
+  /** Page number (starts at 1) */
+  page?: number;
+  /** Blog posts per page (max 100) */
+  perPage?: number;
+}
+
+/** Optional parameters of createBookmark */
+export interface CreateBookmarkParams {
+  /** Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours) */
+  idempotencyKey?: string;
+}
+
+/** Optional parameters of getBookmarks */
+export interface GetBookmarksParams {
+  /** Page number (starts at 1) */
+  page?: number;
+  /** Bookmarks per page (max 100) */
+  perPage?: number;
+}
+
+/** Optional parameters of getBooks */
+export interface GetBooksParams {
+  /** Reading status */
+  status?: string;
+}
+
+/** Optional parameters of exportAllContent */
+export interface ExportAllContentParams {
+  /** Whether to return a zip of markdown files or a JSON bundle of them */
+  format?: string;
+}
+
+/** Optional parameters of importMarkdownFiles */
+export interface ImportMarkdownFilesParams {
+  /** Comma-separated tags to add to every imported post */
+  tags?: string;
+}
+
+/** Optional parameters of importFromMedium */
+export interface ImportFromMediumParams {
+  /** Comma-separated tags to add to every imported post */
+  tags?: string;
+}
+
+/** Optional parameters of importFromSubstack */
+export interface ImportFromSubstackParams {
+  /** Comma-separated tags to add to every imported post */
+  tags?: string;
+  /** Address of the publication, e.g. https://example.substack.com, to link each post to its page there as its url */
+  publicationURL?: string;
+}
+
+/** Optional parameters of importFromWordPress */
+export interface ImportFromWordPressParams {
+  /** Comma-separated tags to add to every imported post */
+  tags?: string;
+  /** Report what would be created without saving anything */
+  dryRun?: boolean;
+}
+
+/** Optional parameters of getUploadedMedia */
+export interface GetUploadedMediaParams {
+  /** Page number (starts at 1) */
+  page?: number;
+  /** Files per page (max 100) */
+  perPage?: number;
+}
+
+/** Optional parameters of micropubQuery */
+export interface MicropubQueryParams {
+  /** What to return */
+  q?: string;
+  /** URL of the post, for q=source. Its last path segment must be the post's ID */
+  url?: string;
+}
+
+/** Optional parameters of createNote */
+export interface CreateNoteParams {
+  /** Comma-separated list of platforms to cross-post to. Valid values: twitter, mastodon */
+  platforms?: string;
+  /** Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours) */
+  idempotencyKey?: string;
+}
+
+/** Optional parameters of getNotes */
+export interface GetNotesParams {
+  /** Page number (starts at 1) */
+  page?: number;
+  /** Notes per page (max 100) */
+  perPage?: number;
+}
+
+/** Optional parameters of previewBlogPost */
+export interface PreviewBlogPostParams {
+  /** Whether content is served as the Markdown it's written in or rendered as sanitized HTML */
+  format?: string;
+}
+
+/** Optional parameters of createProject */
+export interface CreateProjectParams {
+  /** Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours) */
+  idempotencyKey?: string;
+}
+
+/** Optional parameters of deleteProject */
+export interface DeleteProjectParams {
+  /** Delete permanently instead of moving to the trash */
+  permanent?: boolean;
+}
+
+/** Optional parameters of getAllProjects */
+export interface GetAllProjectsParams {
+  /** Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, type (snake_case spellings such as date_added work too). Not allowed with cursor */
+  sort?: string;
+  /** Page number (starts at 1). Not allowed with cursor */
+  page?: number;
+  /** meta.nextCursor of the previous page, or empty for the first page, to page by cursor */
+  cursor?: string;
+  /** Projects per page (max 100) */
+  perPage?: number;
+}
+
+/** Optional parameters of searchProjects */
+export interface SearchProjectsParams {
+  /** Search query (max 200 characters) */
+  q?: string;
+  /** Maximum number of results (max 50) */
+  limit?: number;
+}
+
+/** Optional parameters of getProjectSummaries */
+export interface GetProjectSummariesParams {
+  /** Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, type (snake_case spellings such as date_added work too) */
+  sort?: string;
+  /** Page number (starts at 1) */
+  page?: number;
+  /** Projects per page (max 100) */
+  perPage?: number;
+}
+
+/** Optional parameters of getTrashedProjects */
+export interface GetTrashedProjectsParams {
+  /** Page number (starts at 1) */
+  page?: number;
+  /** Projects per page (max 100) */
+  perPage?: number;
+}
+
+/** Optional parameters of getRecentChanges */
+export interface GetRecentChangesParams {
+  /** Cursor returned as nextCursor by the previous page */
+  cursor?: string;
+  /** Items per page (max 50) */
+  limit?: number;
+}
+
+/** Optional parameters of getResume */
+export interface GetResumeParams {
+  /** Response format */
+  format?: string;
+}
+
+/** Optional parameters of searchEverything */
+export interface SearchEverythingParams {
+  /** Search query (max 200 characters) */
+  q?: string;
+  /** Maximum number of results (max 50) */
+  limit?: number;
+}
+
+/** Optional parameters of getSeriesBySlug */
+export interface GetSeriesBySlugParams {
+  /** Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given */
+  locale?: string;
+}
+
+/** Optional parameters of getAllSkills */
+export interface GetAllSkillsParams {
+  /** Only return skills in this category */
+  category?: string;
+}
+
+/** Optional parameters of getSnippets */
+export interface GetSnippetsParams {
+  /** Only snippets in this language, e.g. go (case-insensitive) */
+  language?: string;
+  /** Only snippets with this tag */
+  tag?: string;
+  /** Page number (starts at 1) */
+  page?: number;
+  /** Snippets per page (max 100) */
+  perPage?: number;
+}
+
+/** Optional parameters of getTimeline */
+export interface GetTimelineParams {
+  /** Cursor returned as nextCursor by the previous page */
+  cursor?: string;
+  /** Items per page (max 50) */
+  limit?: number;
+}
+
+/** Optional parameters of getTrendingContent */
+export interface GetTrendingContentParams {
+  /** Maximum number of items (max 50) */
+  limit?: number;
+}
+
+/** Sends requests to one deployment of the API */
+export class Client {
+  private readonly baseUrl: string;
+
+  constructor(
+    baseUrl: string,
+    private readonly options: ClientOptions = {},
+  ) {
+    this.baseUrl = baseUrl.replace(/\/$/, "");
+  }
+
+  private async request<T>(method: string, path: string, parts: RequestParts): Promise<T> {
+    const query = new URLSearchParams();
+    for (const [name, value] of Object.entries(parts.query ?? {})) {
+      if (Array.isArray(value)) {
+        value.forEach((item) => query.append(name, item));
+      } else if (value !== undefined && value !== "") {
+        query.set(name, String(value));
+      }
+    }
+
+    const headers = new Headers(parts.init.headers);
+    headers.set("Accept", "application/json");
+    for (const [name, value] of Object.entries(parts.headers ?? {})) {
+      if (value !== undefined && value !== "") {
+        headers.set(name, value);
+      }
+    }
+    let body: BodyInit | undefined;
+    if (parts.form) {
+      const form = new FormData();
+      for (const [name, value] of Object.entries(parts.form)) {
+        if (value instanceof Blob) {
+          form.append(name, value);
+        } else if (Array.isArray(value)) {
+          value.forEach((item) => form.append(name, item));
+        } else if (value !== undefined && value !== "") {
+          form.append(name, String(value));
+        }
+      }
+      body = form;
+    } else if (parts.body !== undefined) {
+      headers.set("Content-Type", "application/json");
+      body = JSON.stringify(parts.body);
+    }
+    if (this.options.token) {
+      headers.set("Authorization", `Bearer ${this.options.token}`);
+    }
+
+    const search = query.toString();
+    const doFetch = this.options.fetch ?? fetch;
+    const response = await doFetch(this.baseUrl + path + (search ? "?" + search : ""), {
+      ...parts.init,
+      method,
+      headers,
+      body,
+    });
+
+    if (!response.ok) {
+      const body = await response.json().catch(() => ({}));
+      throw new ApiError(response.status, body as ErrorResponse);
+    }
+    const text = await response.text();
+    return (text ? JSON.parse(text) : undefined) as T;
+  }
+
+  /**
+   * Returns page views and unique visitors per country (ISO 3166-1 alpha-2) and region (ISO 3166-2 subdivision code) over a date range, most viewed first. Locations are only recorded when GEOIP_DB_PATH points at a MaxMind database; views without one are counted under country "". Unique visitors are counted once per day they visited. Raw page views are kept for 90 days, so older ranges come back empty
+   *
+   * `GET /admin/analytics/geo` (admin)
+   */
+  getGeographicBreakdown(params: GetGeographicBreakdownParams = {}, init: RequestInit = {}): Promise<GeoReport> {
+    return this.request<GeoReport>("GET", `/admin/analytics/geo`, { query: { "from": params.from, "to": params.to, "contentType": params.contentType, "contentId": params.contentID }, init });
+  }
+
+  /**
+   * Returns page views and unique visitors per day (every day in the range, including zeros), the 10 most viewed blog posts and projects, and per-platform social posting success rates, for one date range
+   *
+   * `GET /admin/analytics/overview` (admin)
+   */
+  getAnalyticsOverview(params: GetAnalyticsOverviewParams = {}, init: RequestInit = {}): Promise<AnalyticsOverview> {
+    return this.request<AnalyticsOverview>("GET", `/admin/analytics/overview`, { query: { "from": params.from, "to": params.to }, init });
+  }
+
+  /**
+   * Returns the top referring hosts and utm_source values for each blog post and project over a date range, most viewed content first. Views without a referrer are counted as "(direct)". Raw page views are kept for 90 days, so older ranges come back empty
+   *
+   * `GET /admin/analytics/referrers` (admin)
+   */
+  getReferrersAndUTMSources(params: GetReferrersAndUTMSourcesParams = {}, init: RequestInit = {}): Promise<ReferrerReport> {
+    return this.request<ReferrerReport>("GET", `/admin/analytics/referrers`, { query: { "from": params.from, "to": params.to, "contentType": params.contentType, "contentId": params.contentID, "limit": params.limit }, init });
+  }
+
+  /**
+   * Seeds published blog posts and projects, sends each list, detail and search endpoint the given number of GET requests through the router with the given concurrency, then removes the seed and reports latencies per endpoint. With seed=0 the existing content is used instead. Endpoints run one after another, and cacheHits shows how many responses came from the response cache. Benchmark requests aren't counted as views. Not available when APP_ENV=production
+   *
+   * `POST /admin/bench` (admin)
+   */
+  runBenchmark(params: RunBenchmarkParams = {}, init: RequestInit = {}): Promise<BenchReport> {
+    return this.request<BenchReport>("POST", `/admin/bench`, { query: { "requests": params.requests, "concurrency": params.concurrency, "seed": params.seed, "q": params.q }, init });
+  }
+
+  /**
+   * Retrieves every draft and scheduled blog post with its tags, scheduled posts first in publishing order, then drafts newest first
+   *
+   * `GET /admin/blog-posts/unpublished` (admin)
+   */
+  getUnpublishedBlogPosts(init: RequestInit = {}): Promise<UnpublishedBlogPosts> {
+    return this.request<UnpublishedBlogPosts>("GET", `/admin/blog-posts/unpublished`, { init });
+  }
+
+  /**
+   * Lists certifications that have already expired or will expire within the given number of days, soonest first
+   *
+   * `GET /admin/certifications/expiring` (admin)
+   */
+  getExpiringCertifications(params: GetExpiringCertificationsParams = {}, init: RequestInit = {}): Promise<ExpiringCertificationReport> {
+    return this.request<ExpiringCertificationReport>("GET", `/admin/certifications/expiring`, { query: { "days": params.days }, init });
+  }
+
+  /**
+   * Looks for tags whose blog post, project or bookmark is gone, blog posts whose word count or reading time doesn't match their content, titles with no slug or one shared with another post, social post records for deleted blog posts or notes, and note, bookmark, project and blog post images that don't load. Nothing is changed; POST /admin/doctor/fix repairs the issues marked fixable
+   *
+   * `GET /admin/doctor` (admin)
+   */
+  checkDataConsistency(params: CheckDataConsistencyParams = {}, init: RequestInit = {}): Promise<DoctorReport> {
+    return this.request<DoctorReport>("GET", `/admin/doctor`, { query: { "media": params.media }, init });
+  }
+
+  /**
+   * Deletes orphaned tags and social post records for deleted content and recounts blog post words, then reports the issues that are left, as GET /admin/doctor does. Slug and media issues are only reported
+   *
+   * `POST /admin/doctor/fix` (admin)
+   */
+  fixDataConsistencyIssues(params: FixDataConsistencyIssuesParams = {}, init: RequestInit = {}): Promise<DoctorReport> {
+    return this.request<DoctorReport>("POST", `/admin/doctor/fix`, { query: { "media": params.media }, init });
+  }
+
+  /**
+   * Streams every blog post, including drafts and scheduled posts, with its full content and tags, in ID order. The response is sent in chunks as posts are read, so it isn't limited in size. If an error happens partway, the response is cut off, leaving invalid JSON, so a truncated export can't pass as complete
+   *
+   * `GET /admin/export/blog-posts` (admin)
+   */
+  exportAllBlogPosts(init: RequestInit = {}): Promise<BlogPostExport> {
+    return this.request<BlogPostExport>("GET", `/admin/export/blog-posts`, { init });
+  }
+
+  /**
+   * Retrieves all FAQs, published or not, ordered by display order
+   *
+   * `GET /admin/faqs` (admin)
+   */
+  getAllFAQs(init: RequestInit = {}): Promise<FAQCollection> {
+    return this.request<FAQCollection>("GET", `/admin/faqs`, { init });
+  }
+
+  /**
+   * Retrieves guestbook entries filtered by moderation status (pending, approved or all). Defaults to pending
+   *
+   * `GET /admin/guestbook` (admin)
+   */
+  getGuestbookEntriesForModeration(params: GetGuestbookEntriesForModerationParams = {}, init: RequestInit = {}): Promise<GuestbookEntryCollection> {
+    return this.request<GuestbookEntryCollection>("GET", `/admin/guestbook`, { query: { "status": params.status }, init });
+  }
+
+  /**
+   * Deletes a guestbook entry from the database by ID
+   *
+   * `DELETE /admin/guestbook-entry/{entryID}` (admin)
+   */
+  deleteGuestbookEntry(entryID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/admin/guestbook-entry/${encodeURIComponent(entryID)}`, { init });
+  }
+
+  /**
+   * Approves a guestbook entry so it is shown publicly
+   *
+   * `POST /admin/guestbook-entry/{entryID}/approve` (admin)
+   */
+  approveGuestbookEntry(entryID: string, init: RequestInit = {}): Promise<GuestbookEntry> {
+    return this.request<GuestbookEntry>("POST", `/admin/guestbook-entry/${encodeURIComponent(entryID)}/approve`, { init });
+  }
+
+  /**
+   * Marks a guestbook entry as not approved, hiding it from the public guestbook
+   *
+   * `POST /admin/guestbook-entry/{entryID}/reject` (admin)
+   */
+  rejectGuestbookEntry(entryID: string, init: RequestInit = {}): Promise<GuestbookEntry> {
+    return this.request<GuestbookEntry>("POST", `/admin/guestbook-entry/${encodeURIComponent(entryID)}/reject`, { init });
+  }
+
+  /**
+   * Runs EXPLAIN on the queries behind the blog post and project lists, archive, tags and scheduled publishing, and lists the indexes they rely on that don't exist. A sequential scan on a small table is expected; one on a table missing an index is flagged with how to create it
+   *
+   * `GET /admin/indexes` (admin)
+   */
+  auditIndexes(init: RequestInit = {}): Promise<IndexAuditReport> {
+    return this.request<IndexAuditReport>("GET", `/admin/indexes`, { init });
+  }
+
+  /**
+   * Lists the recurring background jobs hosted by this instance with their schedule, whether they are enabled, and the outcome of their last run. Statuses are kept in memory, so they start empty after a restart and only cover this instance
+   *
+   * `GET /admin/jobs` (admin)
+   */
+  getScheduledJobs(init: RequestInit = {}): Promise<ScheduledJobList> {
+    return this.request<ScheduledJobList>("GET", `/admin/jobs`, { init });
+  }
+
+  /**
+   * Counts the error responses sent by this instance since it started, by error code and HTTP status, most frequent first. Counts are kept in memory, so they start over after a restart and only cover this instance
+   *
+   * `GET /admin/metrics` (admin)
+   */
+  getErrorMetrics(init: RequestInit = {}): Promise<ErrorMetrics> {
+    return this.request<ErrorMetrics>("GET", `/admin/metrics`, { init });
+  }
+
+  /**
+   * Compares each table the models are migrated to with its model, in order of table name: columns the table has that the model doesn't (extraColumns), model columns the table is missing (missingColumns, every column when the table doesn't exist) and columns whose type differs from the one the model's gorm tag declares. It's the column-report command's comparison, for checking a deployed instance. Running migrate fixes missing tables and columns; extra columns and type changes have to be dealt with by hand
+   *
+   * `GET /admin/schema/drift` (admin)
+   */
+  reportSchemaDrift(init: RequestInit = {}): Promise<SchemaDriftReport> {
+    return this.request<SchemaDriftReport>("GET", `/admin/schema/drift`, { init });
+  }
+
+  /**
+   * Creates a short link, served at /s/{code}. A blog post or project gets at most one short link, so asking for one it already has returns that link with 200 instead; its target is updated if the content's URL has changed. Blog post links point at the post's URL, or BASE_URL/blog/{id}, and project links at BASE_URL/projects/{id}. code is generated unless given
+   *
+   * `POST /admin/short-link` (admin)
+   */
+  createShortLink(body: ShortLinkRequest, init: RequestInit = {}): Promise<ShortLinkResponse> {
+    return this.request<ShortLinkResponse>("POST", `/admin/short-link`, { body, init });
+  }
+
+  /**
+   * Deletes a short link by ID, so its code stops redirecting. A blog post or project whose link is deleted gets a new code the next time one is made for it
+   *
+   * `DELETE /admin/short-link/{shortLinkID}` (admin)
+   */
+  deleteShortLink(shortLinkID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/admin/short-link/${encodeURIComponent(shortLinkID)}`, { init });
+  }
+
+  /**
+   * Retrieves every short link, newest first, with its click count and, when SHORT_LINK_BASE_URL is set, the URL it's shared as
+   *
+   * `GET /admin/short-links` (admin)
+   */
+  getShortLinks(params: GetShortLinksParams = {}, init: RequestInit = {}): Promise<ShortLinkCollection> {
+    return this.request<ShortLinkCollection>("GET", `/admin/short-links`, { query: { "page": params.page, "perPage": params.perPage }, init });
+  }
+
+  /**
+   * Retrieves the record of every attempt to share a blog post or note on a social platform, newest first, with the tracked share link and its click count when one was used
+   *
+   * `GET /admin/social-posts` (admin)
+   */
+  getSocialPosts(params: GetSocialPostsParams = {}, init: RequestInit = {}): Promise<SocialPostCollection> {
+    return this.request<SocialPostCollection>("GET", `/admin/social-posts`, { query: { "contentType": params.contentType, "contentId": params.contentID, "page": params.page, "perPage": params.perPage }, init });
+  }
+
+  /**
+   * Verifies each configured platform's credentials with a lightweight authenticated call that posts nothing, reporting the account they belong to and, where the platform says, when the token expires. Tokens expiring within 14 days are reported as expiring. healthy is false when any configured platform's credentials are invalid, expiring or couldn't be checked; unconfigured platforms don't count against it
+   *
+   * `GET /admin/social/health` (admin)
+   */
+  checkSocialPlatformCredentials(params: CheckSocialPlatformCredentialsParams = {}, init: RequestInit = {}): Promise<CredentialHealthReport> {
+    return this.request<CredentialHealthReport>("GET", `/admin/social/health`, { query: { "platforms": params.platforms }, init });
+  }
+
+  /**
+   * Deletes a testimonial from the database by ID
+   *
+   * `DELETE /admin/testimonial/{testimonialID}` (admin)
+   */
+  deleteTestimonial(testimonialID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/admin/testimonial/${encodeURIComponent(testimonialID)}`, { init });
+  }
+
+  /**
+   * Approves a testimonial so it is shown publicly
+   *
+   * `POST /admin/testimonial/{testimonialID}/approve` (admin)
+   */
+  approveTestimonial(testimonialID: string, init: RequestInit = {}): Promise<Testimonial> {
+    return this.request<Testimonial>("POST", `/admin/testimonial/${encodeURIComponent(testimonialID)}/approve`, { init });
+  }
+
+  /**
+   * Marks a testimonial as not approved, hiding it from the public listing
+   *
+   * `POST /admin/testimonial/{testimonialID}/reject` (admin)
+   */
+  rejectTestimonial(testimonialID: string, init: RequestInit = {}): Promise<Testimonial> {
+    return this.request<Testimonial>("POST", `/admin/testimonial/${encodeURIComponent(testimonialID)}/reject`, { init });
+  }
+
+  /**
+   * Retrieves testimonials filtered by moderation status (pending, approved or all). Defaults to pending
+   *
+   * `GET /admin/testimonials` (admin)
+   */
+  getTestimonialsForModeration(params: GetTestimonialsForModerationParams = {}, init: RequestInit = {}): Promise<TestimonialCollection> {
+    return this.request<TestimonialCollection>("GET", `/admin/testimonials`, { query: { "status": params.status }, init });
+  }
+
+  /**
+   * Subscribes a URL to one or more events (post.published, project.created, social.post.failed). A signing secret is generated when none is given. Deliveries are POSTed as JSON with X-Webhook-Event, X-Webhook-Delivery, X-Webhook-Timestamp and X-Webhook-Signature (sha256=HMAC-SHA256 of "<timestamp>.<body>") headers
+   *
+   * `POST /admin/webhook` (admin)
+   */
+  createWebhook(body: Webhook, init: RequestInit = {}): Promise<Webhook> {
+    return this.request<Webhook>("POST", `/admin/webhook`, { body, init });
+  }
+
+  /**
+   * Puts a delivery back in the queue with a fresh set of attempts, e.g. after fixing the receiving endpoint
+   *
+   * `POST /admin/webhook-delivery/{deliveryID}/retry` (admin)
+   */
+  retryWebhookDelivery(deliveryID: string, init: RequestInit = {}): Promise<WebhookDelivery> {
+    return this.request<WebhookDelivery>("POST", `/admin/webhook-delivery/${encodeURIComponent(deliveryID)}/retry`, { init });
+  }
+
+  /**
+   * Retrieves a specific webhook subscription by ID
+   *
+   * `GET /admin/webhook/{webhookID}` (admin)
+   */
+  getWebhook(webhookID: string, init: RequestInit = {}): Promise<Webhook> {
+    return this.request<Webhook>("GET", `/admin/webhook/${encodeURIComponent(webhookID)}`, { init });
+  }
+
+  /**
+   * Updates a webhook's URL, events, description or active flag. The secret is kept when none is given
+   *
+   * `PUT /admin/webhook/{webhookID}` (admin)
+   */
+  updateWebhook(webhookID: string, body: Webhook, init: RequestInit = {}): Promise<Webhook> {
+    return this.request<Webhook>("PUT", `/admin/webhook/${encodeURIComponent(webhookID)}`, { body, init });
+  }
+
+  /**
+   * Deletes a webhook subscription by ID along with its delivery log
+   *
+   * `DELETE /admin/webhook/{webhookID}` (admin)
+   */
+  deleteWebhook(webhookID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/admin/webhook/${encodeURIComponent(webhookID)}`, { init });
+  }
+
+  /**
+   * Retrieves the delivery log for a webhook, newest first, with status, attempt count and the last response or error
+   *
+   * `GET /admin/webhook/{webhookID}/deliveries` (admin)
+   */
+  getWebhookDeliveries(webhookID: string, params: GetWebhookDeliveriesParams = {}, init: RequestInit = {}): Promise<WebhookDeliveryCollection> {
+    return this.request<WebhookDeliveryCollection>("GET", `/admin/webhook/${encodeURIComponent(webhookID)}/deliveries`, { query: { "page": params.page, "perPage": params.perPage }, init });
+  }
+
+  /**
+   * Retrieves all webhook subscriptions, including their signing secrets
+   *
+   * `GET /admin/webhooks` (admin)
+   */
+  getWebhooks(init: RequestInit = {}): Promise<WebhookCollection> {
+    return this.request<WebhookCollection>("GET", `/admin/webhooks`, { init });
+  }
+
+  /**
+   * Records one page view for first-party analytics. UTM tags are read from the query string of path, then the query string and fragment are dropped; only the host of referrer is kept, and the user agent is stored as a SHA-256 hash. Unique visitors are counted with a salted hash of IP and user agent whose salt changes daily and is then deleted. When GEOIP_DB_PATH is set, only the country and region of the IP are stored. Views are rolled up into daily per-path counts in the background. When ANALYTICS_PROVIDER is plausible or umami the view is forwarded there instead and nothing is stored
+   *
+   * `POST /analytics/pageview`
+   */
+  recordPageView(body: PageViewRequest, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("POST", `/analytics/pageview`, { body, init });
+  }
+
+  /**
+   * Runs a list of createBlogPost, createProject and attachTags operations in a single transaction. If any operation fails the whole batch is rolled back; the results show which operation failed and which were skipped. Blog posts created here are not cross-posted to social platforms
+   *
+   * `POST /batch`
+   */
+  runBatch(body: BatchRequest, params: RunBatchParams = {}, init: RequestInit = {}): Promise<BatchResponse> {
+    return this.request<BatchResponse>("POST", `/batch`, { headers: { "Idempotency-Key": params.idempotencyKey }, body, init });
+  }
+
+  /**
+   * Creates a new blog post in the database. Published posts (the default) are posted to all configured social media platforms right away; status draft keeps the post hidden, and status scheduled with publishAt has the scheduler publish it at that time. Images can be given with the post, in gallery order, and at most one of them featured; the featured image is the post's main image on Substack and the other platforms
+   *
+   * `POST /blog-post`
+   */
+  createBlogPost(body: BlogPost, params: CreateBlogPostParams = {}, init: RequestInit = {}): Promise<BlogPostWithTags> {
+    return this.request<BlogPostWithTags>("POST", `/blog-post`, { query: { "mainImageURL": params.mainImageURL }, headers: { "Idempotency-Key": params.idempotencyKey }, body, init });
+  }
+
+  /**
+   * Retrieves detailed information about a specific blog post by ID with its tags. Drafts and scheduled posts are only returned with the backend password, or a preview token from POST /blog-post/{blogPostID}/preview-link. The post's translation into the negotiated locale is served in its place when there is one, and Content-Language says which was served
+   *
+   * `GET /blog-post/{blogPostID}`
+   */
+  getBlogPost(blogPostID: string, params: GetBlogPostParams = {}, init: RequestInit = {}): Promise<BlogPostWithTags> {
+    return this.request<BlogPostWithTags>("GET", `/blog-post/${encodeURIComponent(blogPostID)}`, { query: { "preview": params.preview, "locale": params.locale, "format": params.format }, init });
+  }
+
+  /**
+   * Updates an existing blog post in the database. Status and publishAt are kept when left out; changing the status to published publishes the post now, dated now unless dateAdded is given. Images are left as they are; they're changed under /blog-post/{blogPostID}/images
+   *
+   * `PUT /blog-post/{blogPostID}`
+   */
+  updateBlogPost(blogPostID: string, body: BlogPost, init: RequestInit = {}): Promise<BlogPostWithTags> {
+    return this.request<BlogPostWithTags>("PUT", `/blog-post/${encodeURIComponent(blogPostID)}`, { body, init });
+  }
+
+  /**
+   * Moves a blog post to the trash by ID, from where POST /blog-post/{blogPostID}/restore brings it back. With permanent=true, which requires the backend password, the blog post and its tags are removed from the database instead, whether or not it's in the trash
+   *
+   * `DELETE /blog-post/{blogPostID}`
+   */
+  deleteBlogPost(blogPostID: string, params: DeleteBlogPostParams = {}, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/blog-post/${encodeURIComponent(blogPostID)}`, { query: { "permanent": params.permanent }, init });
+  }
+
+  /**
+   * Copies a blog post's content, summary, tags, images and SEO metadata into a new draft, for reusing the format of a recurring post. Drafts and scheduled posts can be copied too, which is why this needs the backend password. The copy is titled after the original with " (copy)" after it, numbered when that's taken, e.g. "Weekly Notes (copy 2)", so its slug is one no other post has. The original's url, translations and series aren't copied. Duplicating doesn't cross-post or announce anything
+   *
+   * `POST /blog-post/{blogPostID}/duplicate` (admin)
+   */
+  duplicateBlogPost(blogPostID: string, init: RequestInit = {}): Promise<BlogPostWithTags> {
+    return this.request<BlogPostWithTags>("POST", `/blog-post/${encodeURIComponent(blogPostID)}/duplicate`, { init });
+  }
+
+  /**
+   * Replaces an image's URL, alt text, caption, position and whether it's featured. Featuring it takes over from the post's previous featured image
+   *
+   * `PUT /blog-post/{blogPostID}/image/{imageID}`
+   */
+  updateBlogPostImage(blogPostID: string, imageID: string, body: BlogPostImage, init: RequestInit = {}): Promise<BlogPostImage> {
+    return this.request<BlogPostImage>("PUT", `/blog-post/${encodeURIComponent(blogPostID)}/image/${encodeURIComponent(imageID)}`, { body, init });
+  }
+
+  /**
+   * Removes an image from a blog post's gallery. The other images keep their positions, and a post whose featured image is deleted has none until another is featured
+   *
+   * `DELETE /blog-post/{blogPostID}/image/{imageID}`
+   */
+  deleteBlogPostImage(blogPostID: string, imageID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/blog-post/${encodeURIComponent(blogPostID)}/image/${encodeURIComponent(imageID)}`, { init });
+  }
+
+  /**
+   * Lists a blog post's images in gallery order, by position. The featured image, if any, is marked isFeatured. Only admins can see the images of drafts and scheduled posts
+   *
+   * `GET /blog-post/{blogPostID}/images`
+   */
+  getBlogPostImages(blogPostID: string, init: RequestInit = {}): Promise<BlogPostImageCollection> {
+    return this.request<BlogPostImageCollection>("GET", `/blog-post/${encodeURIComponent(blogPostID)}/images`, { init });
+  }
+
+  /**
+   * Adds an image to a blog post's gallery, after its last image unless a position is given. Featuring it makes it the post's main image in feeds, link previews and cross-posts, in place of the post's previous featured image
+   *
+   * `POST /blog-post/{blogPostID}/images`
+   */
+  addBlogPostImage(blogPostID: string, body: BlogPostImage, init: RequestInit = {}): Promise<BlogPostImage> {
+    return this.request<BlogPostImage>("POST", `/blog-post/${encodeURIComponent(blogPostID)}/images`, { body, init });
+  }
+
+  /**
+   * Returns a published blog post's title, description, canonical URL and image for search engines, along with the OpenGraph and Twitter card meta tags for link previews, ready to render. The post's metaTitle, metaDescription and ogImageUrl are used when set, and its title, summary and featured image, or first image in its content, otherwise. The canonical URL is the post's url, or BASE_URL/blog/{id}. Posts translated into the negotiated locale are described in it, by their translated title and summary, with the canonical URL of the translation
+   *
+   * `GET /blog-post/{blogPostID}/meta`
+   */
+  getBlogPostMetadata(blogPostID: string, params: GetBlogPostMetadataParams = {}, init: RequestInit = {}): Promise<PageMeta> {
+    return this.request<PageMeta>("GET", `/blog-post/${encodeURIComponent(blogPostID)}/meta`, { query: { "locale": params.locale }, init });
+  }
+
+  /**
+   * Signs a link that lets anyone holding it read a draft or scheduled post, with GET /blog-post/{blogPostID} or its export.md, until it expires. Give the token to the frontend as ?preview= to render the post for reviewers. Nothing is stored, so a link can't be revoked on its own; changing PREVIEW_LINK_SECRET revokes them all
+   *
+   * `POST /blog-post/{blogPostID}/preview-link` (admin)
+   */
+  createBlogPostPreviewLink(blogPostID: string, params: CreateBlogPostPreviewLinkParams = {}, init: RequestInit = {}): Promise<PreviewLink> {
+    return this.request<PreviewLink>("POST", `/blog-post/${encodeURIComponent(blogPostID)}/preview-link`, { query: { "expiresIn": params.expiresIn }, init });
+  }
+
+  /**
+   * Signs a token that lets anyone holding it read a draft or scheduled post at GET /preview/{token}, without knowing the post's ID, until it expires. The token also works as ?preview= on GET /blog-post/{blogPostID} and its export.md, like one from /blog-post/{blogPostID}/preview-link. Nothing is stored, so a token can't be revoked on its own; changing PREVIEW_LINK_SECRET revokes them all
+   *
+   * `POST /blog-post/{blogPostID}/preview-token` (admin)
+   */
+  createBlogPostPreviewToken(blogPostID: string, params: CreateBlogPostPreviewTokenParams = {}, init: RequestInit = {}): Promise<PreviewLink> {
+    return this.request<PreviewLink>("POST", `/blog-post/${encodeURIComponent(blogPostID)}/preview-token`, { query: { "expiresIn": params.expiresIn }, init });
+  }
+
+  /**
+   * Takes a blog post moved to the trash by DELETE /blog-post/{blogPostID} out of it again, with its tags
+   *
+   * `POST /blog-post/{blogPostID}/restore`
+   */
+  restoreBlogPost(blogPostID: string, init: RequestInit = {}): Promise<BlogPostWithTags> {
+    return this.request<BlogPostWithTags>("POST", `/blog-post/${encodeURIComponent(blogPostID)}/restore`, { init });
+  }
+
+  /**
+   * Adds the blog post's translation into locale, or replaces the one it has. The locale must be one of CONTENT_LOCALES other than the first, which posts are written in. Tags, dates and status are the post's own
+   *
+   * `PUT /blog-post/{blogPostID}/translation/{locale}`
+   */
+  putBlogPostTranslation(blogPostID: string, locale: string, body: BlogPostTranslation, init: RequestInit = {}): Promise<BlogPostTranslation> {
+    return this.request<BlogPostTranslation>("PUT", `/blog-post/${encodeURIComponent(blogPostID)}/translation/${encodeURIComponent(locale)}`, { body, init });
+  }
+
+  /**
+   * Deletes the blog post's translation into locale, so the post is served as written in that locale
+   *
+   * `DELETE /blog-post/{blogPostID}/translation/{locale}`
+   */
+  deleteBlogPostTranslation(blogPostID: string, locale: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/blog-post/${encodeURIComponent(blogPostID)}/translation/${encodeURIComponent(locale)}`, { init });
+  }
+
+  /**
+   * Lists a blog post's translations, ordered by locale, e.g. to link to each with hreflang. Those of drafts and scheduled posts are only returned with the backend password or a preview token
+   *
+   * `GET /blog-post/{blogPostID}/translations`
+   */
+  getBlogPostTranslations(blogPostID: string, params: GetBlogPostTranslationsParams = {}, init: RequestInit = {}): Promise<BlogPostTranslations> {
+    return this.request<BlogPostTranslations>("GET", `/blog-post/${encodeURIComponent(blogPostID)}/translations`, { query: { "preview": params.preview }, init });
+  }
+
+  /**
+   * Counts a view of a published blog post for GET /blog-posts/popular. Visitors are told apart by the same daily salted hash of IP and user agent page views use, and a visitor's views are only counted once per BLOG_POST_VIEW_WINDOW (defaults to 30 minutes), so reloads don't inflate the count
+   *
+   * `POST /blog-post/{blogPostID}/view`
+   */
+  recordBlogPostView(blogPostID: string, init: RequestInit = {}): Promise<BlogPostViewResponse> {
+    return this.request<BlogPostViewResponse>("POST", `/blog-post/${encodeURIComponent(blogPostID)}/view`, { init });
+  }
+
+  /**
+   * Retrieves one page of published blog posts from the database with their associated tags, newest first unless sort is given. Posts translated into the negotiated locale are served in it. Pages are numbered, or taken by cursor when cursor is given (empty for the first page): cursor pages are newest first by dateAdded and id, stay fast however deep they go and don't shift when posts are published, and give the next page's cursor as meta.nextCursor
+   *
+   * `GET /blog-posts`
+   */
+  getAllBlogPosts(params: GetAllBlogPostsParams = {}, init: RequestInit = {}): Promise<BlogPostCollectionWithTags> {
+    return this.request<BlogPostCollectionWithTags>("GET", `/blog-posts`, { query: { "sort": params.sort, "page": params.page, "cursor": params.cursor, "perPage": params.perPage, "locale": params.locale, "tag": params.tag, "tags": params.tags, "tagMode": params.tagMode, "format": params.format }, init });
+  }
+
+  /**
+   * Moves blog posts to the trash by ID and/or filter (tag, addedBefore, addedAfter) in a single transaction. All given criteria must match and at least one is required. Requires the backend password
+   *
+   * `DELETE /blog-posts` (admin)
+   */
+  bulkDeleteBlogPosts(body: BulkDeleteRequest, init: RequestInit = {}): Promise<BulkDeleteResult> {
+    return this.request<BulkDeleteResult>("DELETE", `/blog-posts`, { body, init });
+  }
+
+  /**
+   * Returns post counts and post stubs (id, title, date) grouped by year and month, newest first, for an archive sidebar
+   *
+   * `GET /blog-posts/archive`
+   */
+  getBlogPostArchive(init: RequestInit = {}): Promise<BlogPostArchive> {
+    return this.request<BlogPostArchive>("GET", `/blog-posts/archive`, { init });
+  }
+
+  /**
+   * Imports markdown documents with YAML frontmatter as blog posts, either sent as a JSON array of documents or uploaded as a zipped folder in the file form field, which is read like it is by /import/markdown. Frontmatter can set title (otherwise the document's fileName), slug, summary, date, publishAt, draft, tags and canonicalURL. A document whose slug matches an existing post's updates that post. Documents are published unless they're marked draft or scheduled with a future publishAt, and publishing here doesn't cross-post or announce them. Documents with publish: false are skipped. The tags given here are added to every post. Each document is saved on its own, so one failing doesn't stop the rest, and the report says what happened to each
+   *
+   * `POST /blog-posts/import` (admin)
+   */
+  importBlogPosts(body: MarkdownDocument[], params: ImportBlogPostsParams = {}, init: RequestInit = {}): Promise<ImportReport> {
+    return this.request<ImportReport>("POST", `/blog-posts/import`, { query: { "tags": params.tags }, body, init });
+  }
+
+  /**
+   * Lists the published blog posts with the most views recorded by POST /blog-post/{blogPostID}/view in the period, most viewed first, as summaries with their view counts. Posts without views in the period are left out. Posts translated into the negotiated locale are served in it
+   *
+   * `GET /blog-posts/popular`
+   */
+  getPopularBlogPosts(params: GetPopularBlogPostsParams = {}, init: RequestInit = {}): Promise<PopularBlogPostCollection> {
+    return this.request<PopularBlogPostCollection>("GET", `/blog-posts/popular`, { query: { "period": params.period, "limit": params.limit, "locale": params.locale }, init });
+  }
+
+  /**
+   * Full-text search over blog posts, merged with trigram similarity matches so misspelled queries (e.g. "postgers") still find results. Full-text matches rank first.
+   *
+   * `GET /blog-posts/search`
+   */
+  searchBlogPosts(params: SearchBlogPostsParams = {}, init: RequestInit = {}): Promise<BlogPostSearchResults> {
+    return this.request<BlogPostSearchResults>("GET", `/blog-posts/search`, { query: { "q": params.q, "limit": params.limit }, init });
+  }
+
+  /**
+   * Retrieves one page of published blog posts for list views such as the homepage. Content is left out and tags are plain values, so the page is much smaller than GET /blog-posts. Newest first unless sort is given. Posts translated into the negotiated locale are served in it
+   *
+   * `GET /blog-posts/summaries`
+   */
+  getBlogPostSummaries(params: GetBlogPostSummariesParams = {}, init: RequestInit = {}): Promise<BlogPostSummaryCollection> {
+    return this.request<BlogPostSummaryCollection>("GET", `/blog-posts/summaries`, { query: { "sort": params.sort, "page": params.page, "perPage": params.perPage, "locale": params.locale, "tag": params.tag, "tags": params.tags, "tagMode": params.tagMode }, init });
+  }
+
+  /**
+   * Retrieves one page of the blog posts moved to the trash, most recently deleted first, with their tags
+   *
+   * `GET /blog-posts/trash` (admin)
+   */
+  getTrashedBlogPosts(params: GetTrashedBlogPostsParams = {}, init: RequestInit = {}): Promise<TrashedBlogPostCollection> {
+    return this.request<TrashedBlogPostCollection>("GET", `/blog-posts/trash`, { query: { "page": params.page, "perPage": params.perPage }, init });
+  }
+
+  /**
+   * Adds a new book. Cover, ISBN, OpenLibrary key and first publish year are looked up on OpenLibrary unless provided
+   *
+   * `POST /book`
+   */
+  createBook(body: Book, init: RequestInit = {}): Promise<Book> {
+    return this.request<Book>("POST", `/book`, { body, init });
+  }
+
+  /**
+   * Retrieves a specific book by ID
+   *
+   * `GET /book/{bookID}`
+   */
+  getBook(bookID: string, init: RequestInit = {}): Promise<Book> {
+    return this.request<Book>("GET", `/book/${encodeURIComponent(bookID)}`, { init });
+  }
+
+  /**
+   * Updates an existing book in the reading list. OpenLibrary metadata is kept unless provided
+   *
+   * `PUT /book/{bookID}`
+   */
+  updateBook(bookID: string, body: Book, init: RequestInit = {}): Promise<Book> {
+    return this.request<Book>("PUT", `/book/${encodeURIComponent(bookID)}`, { body, init });
+  }
+
+  /**
+   * Deletes a book from the reading list by ID
+   *
+   * `DELETE /book/{bookID}`
+   */
+  deleteBook(bookID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/book/${encodeURIComponent(bookID)}`, { init });
+  }
+
+  /**
+   * Saves a new bookmark. Title, description and image are fetched from the target page's OpenGraph and meta tags unless provided in the request
+   *
+   * `POST /bookmark`
+   */
+  createBookmark(body: Bookmark, params: CreateBookmarkParams = {}, init: RequestInit = {}): Promise<Bookmark> {
+    return this.request<Bookmark>("POST", `/bookmark`, { headers: { "Idempotency-Key": params.idempotencyKey }, body, init });
+  }
+
+  /**
+   * Retrieves a specific bookmark by ID with its tags
+   *
+   * `GET /bookmark/{bookmarkID}`
+   */
+  getBookmark(bookmarkID: string, init: RequestInit = {}): Promise<Bookmark> {
+    return this.request<Bookmark>("GET", `/bookmark/${encodeURIComponent(bookmarkID)}`, { init });
+  }
+
+  /**
+   * Updates an existing bookmark and replaces its tags. Omitted title, description and image keep their current values
+   *
+   * `PUT /bookmark/{bookmarkID}`
+   */
+  updateBookmark(bookmarkID: string, body: Bookmark, init: RequestInit = {}): Promise<Bookmark> {
+    return this.request<Bookmark>("PUT", `/bookmark/${encodeURIComponent(bookmarkID)}`, { body, init });
+  }
+
+  /**
+   * Deletes a bookmark and its tags from the database by ID
+   *
+   * `DELETE /bookmark/{bookmarkID}`
+   */
+  deleteBookmark(bookmarkID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/bookmark/${encodeURIComponent(bookmarkID)}`, { init });
+  }
+
+  /**
+   * Retrieves the bookmark feed with tags, newest first
+   *
+   * `GET /bookmarks`
+   */
+  getBookmarks(params: GetBookmarksParams = {}, init: RequestInit = {}): Promise<BookmarkCollection> {
+    return this.request<BookmarkCollection>("GET", `/bookmarks`, { query: { "page": params.page, "perPage": params.perPage }, init });
+  }
+
+  /**
+   * Retrieves all books in the reading list, optionally filtered by reading status
+   *
+   * `GET /books`
+   */
+  getBooks(params: GetBooksParams = {}, init: RequestInit = {}): Promise<BookCollection> {
+    return this.request<BookCollection>("GET", `/books`, { query: { "status": params.status }, init });
+  }
+
+  /**
+   * Creates a new certification. Omit expiryDate for certifications that never expire
+   *
+   * `POST /certification`
+   */
+  createCertification(body: Certification, init: RequestInit = {}): Promise<Certification> {
+    return this.request<Certification>("POST", `/certification`, { body, init });
+  }
+
+  /**
+   * Retrieves a specific certification by ID
+   *
+   * `GET /certification/{certificationID}`
+   */
+  getCertification(certificationID: string, init: RequestInit = {}): Promise<Certification> {
+    return this.request<Certification>("GET", `/certification/${encodeURIComponent(certificationID)}`, { init });
+  }
+
+  /**
+   * Updates an existing certification in the database
+   *
+   * `PUT /certification/{certificationID}`
+   */
+  updateCertification(certificationID: string, body: Certification, init: RequestInit = {}): Promise<Certification> {
+    return this.request<Certification>("PUT", `/certification/${encodeURIComponent(certificationID)}`, { body, init });
+  }
+
+  /**
+   * Deletes a certification from the database by ID
+   *
+   * `DELETE /certification/{certificationID}`
+   */
+  deleteCertification(certificationID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/certification/${encodeURIComponent(certificationID)}`, { init });
+  }
+
+  /**
+   * Retrieves all certifications, most recently issued first
+   *
+   * `GET /certifications`
+   */
+  getCertifications(init: RequestInit = {}): Promise<CertificationCollection> {
+    return this.request<CertificationCollection>("GET", `/certifications`, { init });
+  }
+
+  /**
+   * Retrieves all education entries, most recent first
+   *
+   * `GET /education`
+   */
+  getEducation(init: RequestInit = {}): Promise<EducationCollection> {
+    return this.request<EducationCollection>("GET", `/education`, { init });
+  }
+
+  /**
+   * Creates a new education entry. The end date may not be before the start date
+   *
+   * `POST /education`
+   */
+  createEducationEntry(body: Education, init: RequestInit = {}): Promise<Education> {
+    return this.request<Education>("POST", `/education`, { body, init });
+  }
+
+  /**
+   * Retrieves a specific education entry by ID
+   *
+   * `GET /education/{educationID}`
+   */
+  getEducationEntry(educationID: string, init: RequestInit = {}): Promise<Education> {
+    return this.request<Education>("GET", `/education/${encodeURIComponent(educationID)}`, { init });
+  }
+
+  /**
+   * Updates an existing education entry. The end date may not be before the start date
+   *
+   * `PUT /education/{educationID}`
+   */
+  updateEducationEntry(educationID: string, body: Education, init: RequestInit = {}): Promise<Education> {
+    return this.request<Education>("PUT", `/education/${encodeURIComponent(educationID)}`, { body, init });
+  }
+
+  /**
+   * Deletes a education entry from the database by ID
+   *
+   * `DELETE /education/{educationID}`
+   */
+  deleteEducationEntry(educationID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/education/${encodeURIComponent(educationID)}`, { init });
+  }
+
+  /**
+   * Exports every blog post, including drafts and scheduled posts, and every project as markdown files with YAML frontmatter, for backups and moving the site elsewhere. Posts are written like /blog-post/{blogPostID}/export.md to blog-posts/{slug}.md, which POST /import/markdown and POST /blog-posts/import read back. Projects are written to projects/{slug}.md, with their description as the body and their type, links, dates and tags in the frontmatter. tags.json lists the tags in use on each, with how many carry them. The zip is streamed as it's written, so if an error happens partway it's cut off, leaving an invalid archive. With format=json the same files are returned as one JSON bundle instead. Items in the trash are left out
+   *
+   * `GET /export` (admin)
+   */
+  exportAllContent(params: ExportAllContentParams = {}, init: RequestInit = {}): Promise<ContentExport> {
+    return this.request<ContentExport>("GET", `/export`, { query: { "format": params.format }, init });
+  }
+
+  /**
+   * Creates a new FAQ. The answer is stored as markdown
+   *
+   * `POST /faq`
+   */
+  createFAQ(body: FAQ, init: RequestInit = {}): Promise<FAQ> {
+    return this.request<FAQ>("POST", `/faq`, { body, init });
+  }
+
+  /**
+   * Retrieves a specific FAQ by ID
+   *
+   * `GET /faq/{faqID}`
+   */
+  getFAQ(faqID: string, init: RequestInit = {}): Promise<FAQ> {
+    return this.request<FAQ>("GET", `/faq/${encodeURIComponent(faqID)}`, { init });
+  }
+
+  /**
+   * Updates an existing FAQ in the database
+   *
+   * `PUT /faq/{faqID}`
+   */
+  updateFAQ(faqID: string, body: FAQ, init: RequestInit = {}): Promise<FAQ> {
+    return this.request<FAQ>("PUT", `/faq/${encodeURIComponent(faqID)}`, { body, init });
+  }
+
+  /**
+   * Deletes a FAQ from the database by ID
+   *
+   * `DELETE /faq/{faqID}`
+   */
+  deleteFAQ(faqID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/faq/${encodeURIComponent(faqID)}`, { init });
+  }
+
+  /**
+   * Retrieves all published FAQs ordered by display order
+   *
+   * `GET /faqs`
+   */
+  getFAQs(init: RequestInit = {}): Promise<FAQCollection> {
+    return this.request<FAQCollection>("GET", `/faqs`, { init });
+  }
+
+  /**
+   * Retrieves all guestbook entries that have been approved for public display, newest first
+   *
+   * `GET /guestbook`
+   */
+  getGuestbook(init: RequestInit = {}): Promise<GuestbookEntryCollection> {
+    return this.request<GuestbookEntryCollection>("GET", `/guestbook`, { init });
+  }
+
+  /**
+   * Submits a new guestbook entry. Entries are hidden until approved by an admin. Submissions are rate limited per IP address
+   *
+   * `POST /guestbook-entry`
+   */
+  signGuestbook(body: GuestbookSubmission, init: RequestInit = {}): Promise<GuestbookEntry> {
+    return this.request<GuestbookEntry>("POST", `/guestbook-entry`, { body, init });
+  }
+
+  /**
+   * Imports every markdown file in a zipped folder, such as an Obsidian vault or posts from /blog-post/{blogPostID}/export.md. YAML frontmatter can set title (otherwise the file name), slug, summary, date, publishAt, draft, tags and canonicalURL. A file whose slug matches an existing post's updates that post; slugs are derived from titles unless the frontmatter sets one. Files are published unless they're marked draft or scheduled with a future publishAt, and publishing here doesn't cross-post or announce them. Files with publish: false are skipped, as are hidden folders like .obsidian. The tags given here are added to every post. The report says what happened to each file
+   *
+   * `POST /import/markdown` (admin)
+   */
+  importMarkdownFiles(file: Blob, params: ImportMarkdownFilesParams = {}, init: RequestInit = {}): Promise<ImportReport> {
+    return this.request<ImportReport>("POST", `/import/markdown`, { form: { "file": file, "tags": params.tags }, init });
+  }
+
+  /**
+   * Imports the posts in a Medium export archive (the zip Medium emails from Settings > Security and apps > Download your information) as drafts. Each post's body is converted from HTML to markdown, its canonical link becomes the url and, for published posts, its publish date becomes dateAdded. Medium's export has no tags, so the tags given here are added to every post. Posts whose title is already taken are skipped. The report says what happened to each post
+   *
+   * `POST /import/medium` (admin)
+   */
+  importFromMedium(file: Blob, params: ImportFromMediumParams = {}, init: RequestInit = {}): Promise<ImportReport> {
+    return this.request<ImportReport>("POST", `/import/medium`, { form: { "file": file, "tags": params.tags }, init });
+  }
+
+  /**
+   * Imports the posts in a Substack export archive (the zip from Settings > Exports), which lists the posts in posts.csv and keeps each body as HTML. Bodies are converted to markdown. Published posts are imported as published, keeping their publish date, and aren't cross-posted or announced; unpublished ones become drafts. Images keep pointing at Substack's CDN. Substack's export has no tags, so the tags given here are added to every post. Posts without an HTML body, such as threads, and posts whose title is already taken are skipped. The report says what happened to each post
+   *
+   * `POST /import/substack` (admin)
+   */
+  importFromSubstack(file: Blob, params: ImportFromSubstackParams = {}, init: RequestInit = {}): Promise<ImportReport> {
+    return this.request<ImportReport>("POST", `/import/substack`, { form: { "file": file, "tags": params.tags, "publicationURL": params.publicationURL }, init });
+  }
+
+  /**
+   * Imports the posts in a WordPress export (WXR) file, from Tools > Export. Bodies are converted to markdown. Published posts are imported as published, keeping their publish date, and aren't cross-posted or announced; scheduled posts stay scheduled and everything else becomes a draft. Tags and categories become tags, and the tags given here are added to every post. Media isn't copied: images keep pointing at the WordPress site, and each post lists its attachments. Posts whose title is already taken are skipped. With dryRun nothing is saved, and the report says what would have happened to each post
+   *
+   * `POST /import/wordpress` (admin)
+   */
+  importFromWordPress(file: Blob, params: ImportFromWordPressParams = {}, init: RequestInit = {}): Promise<ImportReport> {
+    return this.request<ImportReport>("POST", `/import/wordpress`, { form: { "file": file, "tags": params.tags, "dryRun": params.dryRun }, init });
+  }
+
+  /**
+   * Lists the files uploaded with POST /media, newest first, with the URLs they're served from. Images stored with blog posts, such as by the new-post command, aren't listed
+   *
+   * `GET /media` (admin)
+   */
+  getUploadedMedia(params: GetUploadedMediaParams = {}, init: RequestInit = {}): Promise<MediaObjectCollection> {
+    return this.request<MediaObjectCollection>("GET", `/media`, { query: { "page": params.page, "perPage": params.perPage }, init });
+  }
+
+  /**
+   * Stores a file, sent as the file field of a multipart form, in the MEDIA_STORAGE bucket (Supabase Storage or S3) and returns the public URL it's served from, also sent as Location. PNG, JPEG, GIF and WebP images, PDFs, MP4 and WebM videos and MP3s are accepted, told apart by their content rather than their name or the type they're sent as, up to MEDIA_UPLOAD_MAX_MB (defaults to 25). PNG, JPEG and GIF images also get resized variants, stored alongside: one for each of MEDIA_VARIANT_WIDTHS narrower than the image and a square thumbnail, returned with a srcset of the image and its web-sized variants
+   *
+   * `POST /media` (admin)
+   */
+  uploadMedia(file: Blob, init: RequestInit = {}): Promise<MediaObject> {
+    return this.request<MediaObject>("POST", `/media`, { form: { "file": file }, init });
+  }
+
+  /**
+   * Deletes a file uploaded with POST /media, and its variants, from the bucket, after which their URLs stop working. Posts linking to it aren't changed
+   *
+   * `DELETE /media/{mediaID}` (admin)
+   */
+  deleteUploadedMedia(mediaID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/media/${encodeURIComponent(mediaID)}`, { init });
+  }
+
+  /**
+   * Answers Micropub queries. q=config returns the media endpoint and the platforms posts can be syndicated to, q=syndicate-to only the platforms, and q=source the properties of the blog post or note at url, limited to the ones named by properties[] if given. Takes an IndieAuth access token with any scope, in the Authorization header or an access_token parameter, issued to INDIEAUTH_ME by INDIEAUTH_TOKEN_ENDPOINT
+   *
+   * `GET /micropub` (admin)
+   */
+  micropubQuery(params: MicropubQueryParams = {}, init: RequestInit = {}): Promise<MicropubConfig> {
+    return this.request<MicropubConfig>("GET", `/micropub`, { query: { "q": params.q, "url": params.url }, init });
+  }
+
+  /**
+   * Creates, updates or deletes a post from a Micropub client such as Quill. Bodies are form-encoded, multipart (with photo files) or JSON, as the Micropub spec has them. An h-entry with a name becomes a blog post and one without a note; category becomes tags, photo is added to the post, published dates it (a blog post dated in the future is scheduled) and post-status draft saves a blog post as a draft. New published posts are cross-posted to the platforms named by mp-syndicate-to, through the same pipeline as POST /blog-post and POST /note, and created posts are answered with 201 and a Location header. action=update (JSON only) replaces name, content, summary, category or post-status of a blog post, or content or photo of a note, adds or deletes categories and deletes summary or photo; action=delete moves the blog post at url to the trash, or deletes the note at it, and action=undelete takes a blog post out of the trash. Takes an IndieAuth access token with the create, update, delete or undelete scope to match, in the Authorization header or an access_token field
+   *
+   * `POST /micropub` (admin)
+   */
+  micropubPost(init: RequestInit = {}): Promise<void> {
+    return this.request<void>("POST", `/micropub`, { init });
+  }
+
+  /**
+   * Stores the PNG, JPEG, GIF or WebP image sent as the multipart file field, of up to 10 MB, and answers with 201 and the URL it's served at, under /media, in the Location header. Uploading an image already stored links to that copy. Takes an IndieAuth access token with the media or create scope, in the Authorization header or an access_token field
+   *
+   * `POST /micropub/media` (admin)
+   */
+  micropubMediaUpload(init: RequestInit = {}): Promise<void> {
+    return this.request<void>("POST", `/micropub/media`, { init });
+  }
+
+  /**
+   * Creates a new note. Pass platforms to cross-post it to Twitter and/or Mastodon; nothing is cross-posted by default
+   *
+   * `POST /note`
+   */
+  createNote(body: Note, params: CreateNoteParams = {}, init: RequestInit = {}): Promise<Note> {
+    return this.request<Note>("POST", `/note`, { query: { "platforms": params.platforms }, headers: { "Idempotency-Key": params.idempotencyKey }, body, init });
+  }
+
+  /**
+   * Retrieves a specific note by ID
+   *
+   * `GET /note/{noteID}`
+   */
+  getNote(noteID: string, init: RequestInit = {}): Promise<Note> {
+    return this.request<Note>("GET", `/note/${encodeURIComponent(noteID)}`, { init });
+  }
+
+  /**
+   * Updates an existing note. Cross-posted copies are not edited
+   *
+   * `PUT /note/{noteID}`
+   */
+  updateNote(noteID: string, body: Note, init: RequestInit = {}): Promise<Note> {
+    return this.request<Note>("PUT", `/note/${encodeURIComponent(noteID)}`, { body, init });
+  }
+
+  /**
+   * Deletes a note from the database by ID. Cross-posted copies are not deleted
+   *
+   * `DELETE /note/{noteID}`
+   */
+  deleteNote(noteID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/note/${encodeURIComponent(noteID)}`, { init });
+  }
+
+  /**
+   * Retrieves the notes feed, newest first
+   *
+   * `GET /notes`
+   */
+  getNotes(params: GetNotesParams = {}, init: RequestInit = {}): Promise<NoteCollection> {
+    return this.request<NoteCollection>("GET", `/notes`, { query: { "page": params.page, "perPage": params.perPage }, init });
+  }
+
+  /**
+   * Exports the Swagger 2.0 spec of this API. Its host and schemes are API_BASE_URL's when set, otherwise the ones the request came in on
+   *
+   * `GET /openapi.json`
+   */
+  getAPISpec(init: RequestInit = {}): Promise<Record<string, unknown>> {
+    return this.request<Record<string, unknown>>("GET", `/openapi.json`, { init });
+  }
+
+  /**
+   * Returns the draft, scheduled or published post a token from POST /blog-post/{blogPostID}/preview-token was signed for, with its tags, so reviewers can read a draft without the backend password. Previews are sent with Cache-Control private, no-store and X-Robots-Tag noindex, and aren't counted as views. Tokens that have expired, were tampered with or were signed with an old PREVIEW_LINK_SECRET are treated as unknown
+   *
+   * `GET /preview/{token}`
+   */
+  previewBlogPost(token: string, params: PreviewBlogPostParams = {}, init: RequestInit = {}): Promise<BlogPostWithTags> {
+    return this.request<BlogPostWithTags>("GET", `/preview/${encodeURIComponent(token)}`, { query: { "format": params.format }, init });
+  }
+
+  /**
+   * Creates a new project in the database. Images can be given with the project, in gallery order
+   *
+   * `POST /project`
+   */
+  createProject(body: Project, params: CreateProjectParams = {}, init: RequestInit = {}): Promise<ProjectWithTags> {
+    return this.request<ProjectWithTags>("POST", `/project`, { headers: { "Idempotency-Key": params.idempotencyKey }, body, init });
+  }
+
+  /**
+   * Retrieves detailed information about a specific project by ID with its tags
+   *
+   * `GET /project/{projectID}`
+   */
+  getProject(projectID: string, init: RequestInit = {}): Promise<ProjectWithTags> {
+    return this.request<ProjectWithTags>("GET", `/project/${encodeURIComponent(projectID)}`, { init });
+  }
+
+  /**
+   * Updates an existing project in the database. Images are left as they are; they're changed under /project/{projectID}/images
+   *
+   * `PUT /project/{projectID}`
+   */
+  updateProject(projectID: string, body: Project, init: RequestInit = {}): Promise<ProjectWithTags> {
+    return this.request<ProjectWithTags>("PUT", `/project/${encodeURIComponent(projectID)}`, { body, init });
+  }
+
+  /**
+   * Moves a project to the trash by ID, from where POST /project/{projectID}/restore brings it back. With permanent=true, which requires the backend password, the project and its tags are removed from the database instead, whether or not it's in the trash
+   *
+   * `DELETE /project/{projectID}`
+   */
+  deleteProject(projectID: string, params: DeleteProjectParams = {}, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/project/${encodeURIComponent(projectID)}`, { query: { "permanent": params.permanent }, init });
+  }
+
+  /**
+   * Replaces an image's URL, alt text, caption and position
+   *
+   * `PUT /project/{projectID}/image/{imageID}`
+   */
+  updateProjectImage(projectID: string, imageID: string, body: ProjectImage, init: RequestInit = {}): Promise<ProjectImage> {
+    return this.request<ProjectImage>("PUT", `/project/${encodeURIComponent(projectID)}/image/${encodeURIComponent(imageID)}`, { body, init });
+  }
+
+  /**
+   * Removes an image from a project's gallery. The other images keep their positions
+   *
+   * `DELETE /project/{projectID}/image/{imageID}`
+   */
+  deleteProjectImage(projectID: string, imageID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/project/${encodeURIComponent(projectID)}/image/${encodeURIComponent(imageID)}`, { init });
+  }
+
+  /**
+   * Lists a project's screenshots in gallery order, by position, for showing as a carousel. Projects in the trash have none to show
+   *
+   * `GET /project/{projectID}/images`
+   */
+  getProjectImages(projectID: string, init: RequestInit = {}): Promise<ProjectImageCollection> {
+    return this.request<ProjectImageCollection>("GET", `/project/${encodeURIComponent(projectID)}/images`, { init });
+  }
+
+  /**
+   * Adds a screenshot to a project's gallery, after its last image unless a position is given
+   *
+   * `POST /project/{projectID}/images`
+   */
+  addProjectImage(projectID: string, body: ProjectImage, init: RequestInit = {}): Promise<ProjectImage> {
+    return this.request<ProjectImage>("POST", `/project/${encodeURIComponent(projectID)}/images`, { body, init });
+  }
+
+  /**
+   * Takes a project moved to the trash by DELETE /project/{projectID} out of it again, with its tags
+   *
+   * `POST /project/{projectID}/restore`
+   */
+  restoreProject(projectID: string, init: RequestInit = {}): Promise<ProjectWithTags> {
+    return this.request<ProjectWithTags>("POST", `/project/${encodeURIComponent(projectID)}/restore`, { init });
+  }
+
+  /**
+   * Serves a project's long-form case study, written in Markdown as case_study, rendered as sanitized HTML the same way blog posts are: GitHub-flavored Markdown with heading IDs, and without raw HTML or script links. The HTML fragment is the response body, as text/html, unless the Accept header asks for application/json, which gets it in a JSON object with the project's ID, title and slug. The project is named by its slug, made from its title like a blog post's (e.g. "Personal Site Backend" is personal-site-backend), or by its ID
+   *
+   * `GET /project/{slug}/case-study`
+   */
+  getProjectCaseStudy(slug: string, init: RequestInit = {}): Promise<ProjectCaseStudy> {
+    return this.request<ProjectCaseStudy>("GET", `/project/${encodeURIComponent(slug)}/case-study`, { init });
+  }
+
+  /**
+   * Retrieves one page of projects from the database with their associated tags, newest first unless sort is given. Pages are numbered, or taken by cursor when cursor is given (empty for the first page): cursor pages are newest first by date_added and id, stay fast however deep they go and don't shift when projects are added, and give the next page's cursor as meta.nextCursor
+   *
+   * `GET /projects`
+   */
+  getAllProjects(params: GetAllProjectsParams = {}, init: RequestInit = {}): Promise<ProjectCollectionWithTags> {
+    return this.request<ProjectCollectionWithTags>("GET", `/projects`, { query: { "sort": params.sort, "page": params.page, "cursor": params.cursor, "perPage": params.perPage }, init });
+  }
+
+  /**
+   * Moves projects to the trash by ID and/or filter (tag, addedBefore, addedAfter) in a single transaction. All given criteria must match and at least one is required. Requires the backend password
+   *
+   * `DELETE /projects` (admin)
+   */
+  bulkDeleteProjects(body: BulkDeleteRequest, init: RequestInit = {}): Promise<BulkDeleteResult> {
+    return this.request<BulkDeleteResult>("DELETE", `/projects`, { body, init });
+  }
+
+  /**
+   * Creates a project from a GitHub repository, read from the GitHub API. The repository's name becomes the title, its README the description (or its description when it has no README), its homepage the demo link and its topics the tags. Its description is also the meta_description when it fits in 160 characters. Without GITHUB_TOKEN only public repositories can be imported, at GitHub's lower rate limit. With dryRun the project is returned as it would be created, without saving it, so it can be edited first and sent to POST /project
+   *
+   * `POST /projects/import-github` (admin)
+   */
+  importProjectFromGitHub(body: GitHubProjectImport, init: RequestInit = {}): Promise<ProjectWithTags> {
+    return this.request<ProjectWithTags>("POST", `/projects/import-github`, { body, init });
+  }
+
+  /**
+   * Full-text search over projects, merged with trigram similarity matches so misspelled queries (e.g. "postgers") still find results. Full-text matches rank first.
+   *
+   * `GET /projects/search`
+   */
+  searchProjects(params: SearchProjectsParams = {}, init: RequestInit = {}): Promise<ProjectSearchResults> {
+    return this.request<ProjectSearchResults>("GET", `/projects/search`, { query: { "q": params.q, "limit": params.limit }, init });
+  }
+
+  /**
+   * Retrieves one page of projects for list views such as the homepage. Tags are plain values rather than full tag rows, so the page is smaller than GET /projects. Newest first unless sort is given
+   *
+   * `GET /projects/summaries`
+   */
+  getProjectSummaries(params: GetProjectSummariesParams = {}, init: RequestInit = {}): Promise<ProjectSummaryCollection> {
+    return this.request<ProjectSummaryCollection>("GET", `/projects/summaries`, { query: { "sort": params.sort, "page": params.page, "perPage": params.perPage }, init });
+  }
+
+  /**
+   * Retrieves one page of the projects moved to the trash, most recently deleted first, with their tags
+   *
+   * `GET /projects/trash` (admin)
+   */
+  getTrashedProjects(params: GetTrashedProjectsParams = {}, init: RequestInit = {}): Promise<TrashedProjectCollection> {
+    return this.request<TrashedProjectCollection>("GET", `/projects/trash`, { query: { "page": params.page, "perPage": params.perPage }, init });
+  }
+
+  /**
+   * Retrieves books currently being read and finished books (most recently finished first)
+   *
+   * `GET /reading-list`
+   */
+  getReadingList(init: RequestInit = {}): Promise<ReadingList> {
+    return this.request<ReadingList>("GET", `/reading-list`, { init });
+  }
+
+  /**
+   * Retrieves blog posts, projects and notes that have been edited, most recently edited first. Content that was never edited is left out; see /timeline for new content. Pass nextCursor from the previous response as cursor to get the next page
+   *
+   * `GET /recent-changes`
+   */
+  getRecentChanges(params: GetRecentChangesParams = {}, init: RequestInit = {}): Promise<RecentChanges> {
+    return this.request<RecentChanges>("GET", `/recent-changes`, { query: { "cursor": params.cursor, "limit": params.limit }, init });
+  }
+
+  /**
+   * Retrieves structured CV data (work experience, education and unexpired certifications). Pass format=pdf to receive a rendered PDF instead of JSON
+   *
+   * `GET /resume`
+   */
+  getResume(params: GetResumeParams = {}, init: RequestInit = {}): Promise<Resume> {
+    return this.request<Resume>("GET", `/resume`, { query: { "format": params.format }, init });
+  }
+
+  /**
+   * Returns a canonical example request body for an entity, which the admin UI uses to prefill its forms. Entities are blog-post (POST /blog-post), project (POST /project) and publish (POST /blog-post scheduled for later)
+   *
+   * `GET /schema/{entity}/example`
+   */
+  getExamplePayload(entity: string, init: RequestInit = {}): Promise<Record<string, unknown>> {
+    return this.request<Record<string, unknown>>("GET", `/schema/${encodeURIComponent(entity)}/example`, { init });
+  }
+
+  /**
+   * Searches published blog posts, projects and the tags on them in one query, for a site-wide search box. Blog posts and projects are matched as /blog-posts/search and /projects/search match them, and tags by their value: a tag that is the query, ignoring case, scores 2, and one resembling a word of it scores its trigram similarity (0-1). All three are ranked together, best first, so scores above 1 are full-text or exact tag matches. Results say their type and carry the matching blog post or project summary, or the tag with how many posts and projects have it
+   *
+   * `GET /search`
+   */
+  searchEverything(params: SearchEverythingParams = {}, init: RequestInit = {}): Promise<SearchResults> {
+    return this.request<SearchResults>("GET", `/search`, { query: { "q": params.q, "limit": params.limit }, init });
+  }
+
+  /**
+   * Retrieves every series, newest first, without their posts
+   *
+   * `GET /series`
+   */
+  getSeries(init: RequestInit = {}): Promise<SeriesCollection> {
+    return this.request<SeriesCollection>("GET", `/series`, { init });
+  }
+
+  /**
+   * Creates a new series. Its slug is made from the slug given, or the title when there isn't one, by lowercasing it and joining its words with hyphens
+   *
+   * `POST /series`
+   */
+  createSeries(body: Series, init: RequestInit = {}): Promise<Series> {
+    return this.request<Series>("POST", `/series`, { body, init });
+  }
+
+  /**
+   * Retrieves a series with the summaries of its published posts, ordered by their position in it. Drafts and scheduled posts can be placed in a series ahead of time, and are listed once they're published. Posts translated into the negotiated locale are served in it
+   *
+   * `GET /series/{slug}`
+   */
+  getSeriesBySlug(slug: string, params: GetSeriesBySlugParams = {}, init: RequestInit = {}): Promise<SeriesResponse> {
+    return this.request<SeriesResponse>("GET", `/series/${encodeURIComponent(slug)}`, { query: { "locale": params.locale }, init });
+  }
+
+  /**
+   * Updates an existing series' title and description, and its slug when one is given. Its posts stay where they are
+   *
+   * `PUT /series/{slug}`
+   */
+  updateSeries(slug: string, body: Series, init: RequestInit = {}): Promise<Series> {
+    return this.request<Series>("PUT", `/series/${encodeURIComponent(slug)}`, { body, init });
+  }
+
+  /**
+   * Deletes a series by slug. Its posts are taken out of it, not deleted
+   *
+   * `DELETE /series/{slug}`
+   */
+  deleteSeries(slug: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/series/${encodeURIComponent(slug)}`, { init });
+  }
+
+  /**
+   * Places a blog post at a position in a series, or after its last post when no position is given. A post is in at most one series, so a post already in a series is moved, and putting it at a position another post holds is a conflict
+   *
+   * `PUT /series/{slug}/post/{blogPostID}`
+   */
+  addBlogPostToSeries(slug: string, blogPostID: string, body: SeriesPostRequest, init: RequestInit = {}): Promise<SeriesPost> {
+    return this.request<SeriesPost>("PUT", `/series/${encodeURIComponent(slug)}/post/${encodeURIComponent(blogPostID)}`, { body, init });
+  }
+
+  /**
+   * Takes a blog post out of a series. The post itself is left as it is, and the other posts keep their positions
+   *
+   * `DELETE /series/{slug}/post/{blogPostID}`
+   */
+  removeBlogPostFromSeries(slug: string, blogPostID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/series/${encodeURIComponent(slug)}/post/${encodeURIComponent(blogPostID)}`, { init });
+  }
+
+  /**
+   * Creates a new skill in the database
+   *
+   * `POST /skill`
+   */
+  createSkill(body: Skill, init: RequestInit = {}): Promise<Skill> {
+    return this.request<Skill>("POST", `/skill`, { body, init });
+  }
+
+  /**
+   * Retrieves a specific skill by ID
+   *
+   * `GET /skill/{skillID}`
+   */
+  getSkill(skillID: string, init: RequestInit = {}): Promise<Skill> {
+    return this.request<Skill>("GET", `/skill/${encodeURIComponent(skillID)}`, { init });
+  }
+
+  /**
+   * Updates an existing skill in the database
+   *
+   * `PUT /skill/{skillID}`
+   */
+  updateSkill(skillID: string, body: Skill, init: RequestInit = {}): Promise<Skill> {
+    return this.request<Skill>("PUT", `/skill/${encodeURIComponent(skillID)}`, { body, init });
+  }
+
+  /**
+   * Deletes a skill from the database by ID
+   *
+   * `DELETE /skill/{skillID}`
+   */
+  deleteSkill(skillID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/skill/${encodeURIComponent(skillID)}`, { init });
+  }
+
+  /**
+   * Retrieves all skills ordered by category and name. Pass category to only return skills in that category
+   *
+   * `GET /skills`
+   */
+  getAllSkills(params: GetAllSkillsParams = {}, init: RequestInit = {}): Promise<SkillCollection> {
+    return this.request<SkillCollection>("GET", `/skills`, { query: { "category": params.category }, init });
+  }
+
+  /**
+   * Creates a new code snippet. Blog posts embed it with a snippet shortcode holding its ID on a line of its own (see the README), which the markdown export replaces with a fenced code block
+   *
+   * `POST /snippet`
+   */
+  createSnippet(body: Snippet, init: RequestInit = {}): Promise<Snippet> {
+    return this.request<Snippet>("POST", `/snippet`, { body, init });
+  }
+
+  /**
+   * Retrieves a specific code snippet by ID
+   *
+   * `GET /snippet/{snippetID}`
+   */
+  getSnippet(snippetID: string, init: RequestInit = {}): Promise<Snippet> {
+    return this.request<Snippet>("GET", `/snippet/${encodeURIComponent(snippetID)}`, { init });
+  }
+
+  /**
+   * Updates an existing code snippet. Posts embedding it show the new code the next time they're exported
+   *
+   * `PUT /snippet/{snippetID}`
+   */
+  updateSnippet(snippetID: string, body: Snippet, init: RequestInit = {}): Promise<Snippet> {
+    return this.request<Snippet>("PUT", `/snippet/${encodeURIComponent(snippetID)}`, { body, init });
+  }
+
+  /**
+   * Deletes a code snippet by ID. Shortcodes embedding it are left in posts as they are
+   *
+   * `DELETE /snippet/{snippetID}`
+   */
+  deleteSnippet(snippetID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/snippet/${encodeURIComponent(snippetID)}`, { init });
+  }
+
+  /**
+   * Retrieves the code snippets gallery, newest first, optionally only those in one language or with one tag
+   *
+   * `GET /snippets`
+   */
+  getSnippets(params: GetSnippetsParams = {}, init: RequestInit = {}): Promise<SnippetCollection> {
+    return this.request<SnippetCollection>("GET", `/snippets`, { query: { "language": params.language, "tag": params.tag, "page": params.page, "perPage": params.perPage }, init });
+  }
+
+  /**
+   * Renames a tag on every blog post and project carrying it. The tag is named by its value, URL-escaped. Renaming to a tag that's already in use is refused; merge into it with POST /tags/merge instead
+   *
+   * `PUT /tag/{id}` (admin)
+   */
+  renameTag(id: string, body: TagRename, init: RequestInit = {}): Promise<Tag> {
+    return this.request<Tag>("PUT", `/tag/${encodeURIComponent(id)}`, { body, init });
+  }
+
+  /**
+   * Removes a tag from every blog post and project carrying it, including drafts and the trash. The posts and projects themselves are kept. The tag is named by its value, URL-escaped
+   *
+   * `DELETE /tag/{id}` (admin)
+   */
+  deleteTag(id: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/tag/${encodeURIComponent(id)}`, { init });
+  }
+
+  /**
+   * Lists every tag on a blog post or project, with how many of each carry it, most used first and then alphabetically. Drafts, scheduled posts and the trash are counted, since renaming, merging and deleting tags changes them too
+   *
+   * `GET /tags` (admin)
+   */
+  getTags(init: RequestInit = {}): Promise<TagCollection> {
+    return this.request<TagCollection>("GET", `/tags`, { init });
+  }
+
+  /**
+   * Moves the tag from onto the tag into on every blog post and project carrying it, then from is gone. Posts and projects carrying both keep into once. into needn't be in use yet, though renaming with PUT /tag/{id} says so more plainly
+   *
+   * `POST /tags/merge` (admin)
+   */
+  mergeTags(body: TagMerge, init: RequestInit = {}): Promise<Tag> {
+    return this.request<Tag>("POST", `/tags/merge`, { body, init });
+  }
+
+  /**
+   * Submits a new testimonial. Submitted testimonials are hidden until approved by an admin
+   *
+   * `POST /testimonial`
+   */
+  submitTestimonial(body: TestimonialSubmission, init: RequestInit = {}): Promise<Testimonial> {
+    return this.request<Testimonial>("POST", `/testimonial`, { body, init });
+  }
+
+  /**
+   * Retrieves all testimonials that have been approved for public display, newest first
+   *
+   * `GET /testimonials`
+   */
+  getApprovedTestimonials(init: RequestInit = {}): Promise<TestimonialCollection> {
+    return this.request<TestimonialCollection>("GET", `/testimonials`, { init });
+  }
+
+  /**
+   * Retrieves blog posts, projects and notes merged into one feed, newest first. Pass nextCursor from the previous response as cursor to get the next page
+   *
+   * `GET /timeline`
+   */
+  getTimeline(params: GetTimelineParams = {}, init: RequestInit = {}): Promise<Timeline> {
+    return this.request<Timeline>("GET", `/timeline`, { query: { "cursor": params.cursor, "limit": params.limit }, init });
+  }
+
+  /**
+   * Returns blog posts and projects ranked by recent views, with older views decaying (3-day half-life over a 14-day window). The list is recomputed every 15 minutes.
+   *
+   * `GET /trending`
+   */
+  getTrendingContent(params: GetTrendingContentParams = {}, init: RequestInit = {}): Promise<TrendingResponse> {
+    return this.request<TrendingResponse>("GET", `/trending`, { query: { "limit": params.limit }, init });
+  }
+
+  /**
+   * Retrieves all uses items grouped by category, with items ordered by display order within each category
+   *
+   * `GET /uses`
+   */
+  getUsesPage(init: RequestInit = {}): Promise<UsesPage> {
+    return this.request<UsesPage>("GET", `/uses`, { init });
+  }
+
+  /**
+   * Creates a new uses item in the database
+   *
+   * `POST /uses-item`
+   */
+  createUsesItem(body: UsesItem, init: RequestInit = {}): Promise<UsesItem> {
+    return this.request<UsesItem>("POST", `/uses-item`, { body, init });
+  }
+
+  /**
+   * Retrieves a specific uses item by ID
+   *
+   * `GET /uses-item/{usesItemID}`
+   */
+  getUsesItem(usesItemID: string, init: RequestInit = {}): Promise<UsesItem> {
+    return this.request<UsesItem>("GET", `/uses-item/${encodeURIComponent(usesItemID)}`, { init });
+  }
+
+  /**
+   * Updates an existing uses item in the database
+   *
+   * `PUT /uses-item/{usesItemID}`
+   */
+  updateUsesItem(usesItemID: string, body: UsesItem, init: RequestInit = {}): Promise<UsesItem> {
+    return this.request<UsesItem>("PUT", `/uses-item/${encodeURIComponent(usesItemID)}`, { body, init });
+  }
+
+  /**
+   * Deletes a uses item from the database by ID
+   *
+   * `DELETE /uses-item/{usesItemID}`
+   */
+  deleteUsesItem(usesItemID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/uses-item/${encodeURIComponent(usesItemID)}`, { init });
+  }
+
+  /**
+   * Returns the version of the API spec served at /openapi.json and the changelog of every version, newest first. The generated clients export the version they were built from as SpecVersion (Go) and specVersion (TypeScript)
+   *
+   * `GET /version`
+   */
+  getAPIVersion(init: RequestInit = {}): Promise<APIVersion> {
+    return this.request<APIVersion>("GET", `/version`, { init });
+  }
+
+  /**
+   * Retrieves all work experience entries, current positions first and then by most recent start date
+   *
+   * `GET /work-experience`
+   */
+  getWorkExperience(init: RequestInit = {}): Promise<WorkExperienceCollection> {
+    return this.request<WorkExperienceCollection>("GET", `/work-experience`, { init });
+  }
+
+  /**
+   * Creates a new work experience entry. Dates must form a valid range and may not overlap another entry at the same company
+   *
+   * `POST /work-experience`
+   */
+  createWorkExperienceEntry(body: WorkExperience, init: RequestInit = {}): Promise<WorkExperience> {
+    return this.request<WorkExperience>("POST", `/work-experience`, { body, init });
+  }
+
+  /**
+   * Retrieves a specific work experience entry by ID
+   *
+   * `GET /work-experience/{workExperienceID}`
+   */
+  getWorkExperienceEntry(workExperienceID: string, init: RequestInit = {}): Promise<WorkExperience> {
+    return this.request<WorkExperience>("GET", `/work-experience/${encodeURIComponent(workExperienceID)}`, { init });
+  }
+
+  /**
+   * Updates an existing work experience entry. Dates must form a valid range and may not overlap another entry at the same company
+   *
+   * `PUT /work-experience/{workExperienceID}`
+   */
+  updateWorkExperienceEntry(workExperienceID: string, body: WorkExperience, init: RequestInit = {}): Promise<WorkExperience> {
+    return this.request<WorkExperience>("PUT", `/work-experience/${encodeURIComponent(workExperienceID)}`, { body, init });
+  }
+
+  /**
+   * Deletes a work experience entry from the database by ID
+   *
+   * `DELETE /work-experience/{workExperienceID}`
+   */
+  deleteWorkExperienceEntry(workExperienceID: string, init: RequestInit = {}): Promise<Record<string, string>> {
+    return this.request<Record<string, string>>("DELETE", `/work-experience/${encodeURIComponent(workExperienceID)}`, { init });
+  }
+}
@@ -0,0 +1,709 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	api "github.com/rpupo63/unified-personal-site-backend/api"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+)
+
+// exportBatchSize is how many blog posts export reads at a time
+const exportBatchSize = 100
+
+// runMigrate creates or updates the tables, the indexes declared in the models' gorm tags and the search indexes, and
+// renders the content of blog posts saved before content was rendered
+func runMigrate(args []string) error {
+	if err := flag.NewFlagSet("migrate", flag.ContinueOnError).Parse(args); err != nil {
+		return err
+	}
+	db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+
+	if err := models.Migrate(db); err != nil {
+		return fmt.Errorf("error migrating models: %w", err)
+	}
+	if err := database.New(db).CreateSearchIndexes(); err != nil {
+		return fmt.Errorf("error creating search indexes: %w", err)
+	}
+	rendered, err := database.New(db).RenderMissingContentHTML(services.RenderMarkdownHTML)
+	if err != nil {
+		return fmt.Errorf("error rendering blog posts: %w", err)
+	}
+	if rendered > 0 {
+		fmt.Printf("Rendered %d blog posts and translations\n", rendered)
+	}
+	fmt.Println("Database migration completed successfully!")
+	return nil
+}
+
+// runGenerateModels migrates, reports column mismatches and regenerates the query helpers in generated/
+func runGenerateModels(args []string) error {
+	if err := flag.NewFlagSet("generate-models", flag.ContinueOnError).Parse(args); err != nil {
+		return err
+	}
+	db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Generating models...")
+	models.GenerateModels(db)
+	if err := database.New(db).CreateSearchIndexes(); err != nil {
+		return fmt.Errorf("error creating search indexes: %w", err)
+	}
+	return nil
+}
+
+// runColumnReport lists the database columns the models don't account for, without migrating
+func runColumnReport(args []string) error {
+	if err := flag.NewFlagSet("column-report", flag.ContinueOnError).Parse(args); err != nil {
+		return err
+	}
+	db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Generating column report...")
+	models.GenerateColumnMismatchReportStandalone(db)
+	return nil
+}
+
+// runSeed adds the example blog posts and project served by /schema/{entity}/example, skipping any whose title is
+// taken, so a fresh database has something to show. With -count it also adds that many generated published posts
+// and projects, as the benchmark does, to try list views and pagination against
+func runSeed(args []string) error {
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	count := flags.Int("count", 0, "generated blog posts and projects to add besides the examples")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *count < 0 {
+		return fmt.Errorf("-count must not be negative")
+	}
+	db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+	currentDB := database.New(db)
+
+	titles, err := currentDB.BlogPostRepo().FindAllTitles()
+	if err != nil {
+		return fmt.Errorf("error finding blog posts: %w", err)
+	}
+	takenTitles := make(map[string]bool, len(titles))
+	for _, title := range titles {
+		takenTitles[title] = true
+	}
+	for _, example := range []string{models.BlogPostExample, models.BlogPostPublishExample} {
+		var blogPost models.BlogPost
+		if err := json.Unmarshal([]byte(example), &blogPost); err != nil {
+			return fmt.Errorf("error reading example blog post: %w", err)
+		}
+		if takenTitles[blogPost.Title] {
+			fmt.Printf("Skipping blog post %q, it already exists\n", blogPost.Title)
+			continue
+		}
+		blogPost.DateAdded = time.Now()
+		if err := services.RenderBlogPost(&blogPost); err != nil {
+			return fmt.Errorf("error rendering blog post %q: %w", blogPost.Title, err)
+		}
+		if err := currentDB.BlogPostRepo().Add(&blogPost); err != nil {
+			return fmt.Errorf("error adding blog post %q: %w", blogPost.Title, err)
+		}
+		fmt.Printf("Added blog post %q\n", blogPost.Title)
+	}
+
+	var project models.Project
+	if err := json.Unmarshal([]byte(models.ProjectExample), &project); err != nil {
+		return fmt.Errorf("error reading example project: %w", err)
+	}
+	projects, err := currentDB.ProjectRepo().FindAll()
+	if err != nil {
+		return fmt.Errorf("error finding projects: %w", err)
+	}
+	projectExists := false
+	for _, existing := range projects {
+		projectExists = projectExists || existing.Title == project.Title
+	}
+	if projectExists {
+		fmt.Printf("Skipping project %q, it already exists\n", project.Title)
+	} else {
+		project.DateAdded = time.Now()
+		if err := currentDB.ProjectRepo().Add(&project); err != nil {
+			return fmt.Errorf("error adding project %q: %w", project.Title, err)
+		}
+		fmt.Printf("Added project %q\n", project.Title)
+	}
+
+	if *count > 0 {
+		if _, err := currentDB.SeedBenchmark(*count); err != nil {
+			return fmt.Errorf("error adding generated content: %w", err)
+		}
+		fmt.Printf("Added %d generated blog posts and %d generated projects\n", *count, *count)
+	}
+	return nil
+}
+
+// runNewPost creates a blog post from a markdown file, read like the files POST /import/markdown takes: frontmatter
+// gives the title, summary, tags, dates and canonical URL. Images the file links to by a path on disk are uploaded
+// and served from /media/{mediaID} on API_BASE_URL. The post is a draft unless -publish is given, in which case it's
+// published, or scheduled when the frontmatter's publishAt is still to come. It isn't cross-posted; the post command
+// does that
+func runNewPost(args []string) error {
+	flags := flag.NewFlagSet("new-post", flag.ContinueOnError)
+	path := flags.String("file", "", "markdown file to create the post from")
+	publish := flags.Bool("publish", false, "publish the post instead of saving it as a draft")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments %v; pass the markdown file with -file", flags.Args())
+	}
+	if *path == "" {
+		return fmt.Errorf("-file is required")
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		return fmt.Errorf("error opening %s: %w", *path, err)
+	}
+	note, err := services.ParseMarkdownNote(filepath.Base(*path), file)
+	file.Close()
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", *path, err)
+	}
+	if strings.TrimSpace(note.Content) == "" {
+		return fmt.Errorf("%s has no content", *path)
+	}
+
+	blogPost := models.BlogPost{
+		Title:     note.Title,
+		Content:   note.Content,
+		DateAdded: time.Now(),
+		Status:    models.BlogPostStatusDraft,
+	}
+	if note.Summary != "" {
+		blogPost.Summary = &note.Summary
+	}
+	if note.CanonicalURL != "" {
+		blogPost.URL = &note.CanonicalURL
+	}
+	if note.PublishedAt != nil {
+		blogPost.DateAdded = *note.PublishedAt
+	}
+	if *publish {
+		blogPost.Status = models.BlogPostStatusPublished
+		if note.ScheduledAt != nil && note.ScheduledAt.After(time.Now()) {
+			blogPost.Status = models.BlogPostStatusScheduled
+			blogPost.PublishAt = note.ScheduledAt
+		}
+	}
+	seenTags := make(map[string]bool, len(note.Tags))
+	for _, tag := range note.Tags {
+		if tag != "" && !seenTags[tag] {
+			seenTags[tag] = true
+			blogPost.Tags = append(blogPost.Tags, models.BlogTag{Value: tag})
+		}
+	}
+
+	db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+	currentDB := database.New(db)
+
+	titles, err := currentDB.BlogPostRepo().FindAllTitles()
+	if err != nil {
+		return fmt.Errorf("error finding blog posts: %w", err)
+	}
+	for id, title := range titles {
+		if title == blogPost.Title {
+			return fmt.Errorf("blog post %s is already titled %q", id, title)
+		}
+	}
+
+	apiBaseURL := strings.TrimSuffix(getEnv("API_BASE_URL", ""), "/")
+	uploads := 0
+	err = currentDB.Transaction(func(tx database.Database) error {
+		content, err := services.UploadLocalImages(blogPost.Content, filepath.Dir(*path), func(fileName, contentType string, data []byte) (string, error) {
+			if apiBaseURL == "" {
+				return "", fmt.Errorf("API_BASE_URL must be set to link to uploaded images")
+			}
+			sum := sha256.Sum256(data)
+			mediaFile := models.MediaFile{
+				FileName:    fileName,
+				ContentType: contentType,
+				Size:        len(data),
+				SHA256:      hex.EncodeToString(sum[:]),
+				Data:        data,
+				DateAdded:   time.Now(),
+			}
+			if err := tx.MediaFileRepo().AddOrFind(&mediaFile); err != nil {
+				return "", err
+			}
+			uploads++
+			fmt.Printf("Uploaded %s\n", fileName)
+			return apiBaseURL + "/media/" + mediaFile.ID.String(), nil
+		})
+		if err != nil {
+			return err
+		}
+		blogPost.Content = content
+		if err := services.RenderBlogPost(&blogPost); err != nil {
+			return err
+		}
+		return tx.BlogPostRepo().Add(&blogPost)
+	})
+	if err != nil {
+		return fmt.Errorf("error creating blog post: %w", err)
+	}
+
+	fmt.Printf("Created %s blog post %q (%s) with %d uploaded images\n", blogPost.Status, blogPost.Title, blogPost.ID, uploads)
+	if blogPost.Status == models.BlogPostStatusPublished {
+		fmt.Printf("Cross-post it with: post -id %s\n", blogPost.ID)
+	}
+	return nil
+}
+
+// runPost cross-posts an existing published blog post, as creating it through the API does, and records the
+// outcome for GET /admin/social-posts. It fails when any platform did, so cron can tell. With -dry-run it prints what
+// each platform would get instead, without posting or recording anything
+func runPost(args []string) error {
+	flags := flag.NewFlagSet("post", flag.ContinueOnError)
+	id := flags.String("id", "", "id of the blog post to cross-post")
+	platforms := flags.String("platforms", "substack,medium,twitter,linkedin", "comma-separated platforms to post to")
+	mainImageURL := flags.String("image", "", "main image URL, required for Substack unless the post has a featured image")
+	dryRun := flags.Bool("dry-run", false, "print what each platform would get without posting")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments %v; pass the blog post with -id", flags.Args())
+	}
+	blogPostID, err := uuid.Parse(*id)
+	if err != nil {
+		return fmt.Errorf("-id must be a blog post id: %w", err)
+	}
+	platformsToPost := strings.Split(*platforms, ",")
+	for i := range platformsToPost {
+		platformsToPost[i] = strings.TrimSpace(platformsToPost[i])
+	}
+
+	db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+	currentDB := database.New(db)
+	services.UseStoredCredentials(currentDB.CredentialRepo().FindAll)
+
+	blogPost, err := currentDB.BlogPostRepo().FindByID(blogPostID)
+	if err != nil {
+		return fmt.Errorf("error finding blog post %s: %w", blogPostID, err)
+	}
+	if blogPost.Status != models.BlogPostStatusPublished {
+		return fmt.Errorf("blog post %s is %s; only published posts can be cross-posted", blogPostID, blogPost.Status)
+	}
+	if featured := blogPost.FeaturedImage(); featured != nil && *mainImageURL == "" {
+		*mainImageURL = featured.URL
+	}
+
+	// Tweets link to the post's short link when SHORT_LINK_BASE_URL is set, as they do when posting through the API
+	links := map[string]string{}
+	shortLinkBaseURL := getEnv("SHORT_LINK_BASE_URL", "")
+	if *dryRun {
+		// A dry run makes nothing, so it shows the short link only if the post already has one
+		if shortLink, err := currentDB.ShortLinkRepo().FindForContent(models.ContentTypeBlogPost, blogPost.ID); err == nil && shortLinkBaseURL != "" {
+			links["twitter"] = api.ShortLinkURL(shortLinkBaseURL, shortLink.Code)
+		}
+		for _, preview := range services.PreviewEverywhere(*blogPost, blogPost.Tags, *mainImageURL, platformsToPost, links) {
+			fmt.Printf("=== %s ===\n", preview.Platform)
+			if preview.Err != nil {
+				fmt.Printf("Would fail: %v\n\n", preview.Err)
+				continue
+			}
+			if preview.Title != "" {
+				fmt.Printf("Title: %s\n\n", preview.Title)
+			}
+			fmt.Printf("%s\n\n", preview.Text)
+		}
+		return nil
+	}
+
+	// Ctrl-C cancels the posts still in flight
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if slices.ContainsFunc(platformsToPost, func(platform string) bool { return strings.EqualFold(platform, "twitter") }) {
+		link, err := api.BlogPostShortLink(currentDB.ShortLinkRepo(), shortLinkBaseURL, *blogPost)
+		if err != nil {
+			fmt.Printf("Error creating short link, tweeting the post's URL: %v\n", err)
+		}
+		if link != "" {
+			links["twitter"] = link
+		}
+	}
+	results, postErr := services.PostEverywhere(ctx, *blogPost, blogPost.Tags, *mainImageURL, platformsToPost, links)
+
+	now := time.Now()
+	socialPosts := make([]models.SocialPost, 0, len(results))
+	for _, result := range results {
+		socialPost := models.SocialPost{
+			ContentType: models.ContentTypeBlogPost,
+			ContentID:   blogPost.ID,
+			Platform:    result.Platform,
+			Status:      models.SocialPostStatusSucceeded,
+			DateAdded:   now,
+		}
+		if result.Err != nil {
+			message := result.Err.Error()
+			socialPost.Status = models.SocialPostStatusFailed
+			socialPost.Error = &message
+			fmt.Printf("  %s: failed: %v\n", result.Platform, result.Err)
+		} else {
+			fmt.Printf("  %s: posted\n", result.Platform)
+		}
+		socialPosts = append(socialPosts, socialPost)
+	}
+	if err := currentDB.SocialPostRepo().AddAll(socialPosts); err != nil {
+		fmt.Printf("Error recording social posts: %v\n", err)
+	}
+	return postErr
+}
+
+// runDoctor checks the stored data for inconsistencies, as GET /admin/doctor does, or repairs the fixable ones first
+// with -fix, as POST /admin/doctor/fix does. It fails when any issue is left, so cron can tell
+func runDoctor(args []string) error {
+	flags := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	fix := flags.Bool("fix", false, "delete orphaned tags and dangling social posts and recount blog post words")
+	skipMedia := flags.Bool("skip-media", false, "don't request every image URL to check it loads")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+
+	report, err := api.RunDoctor(context.Background(), database.New(db), !*skipMedia, *fix)
+	if err != nil {
+		return fmt.Errorf("error checking data consistency: %w", err)
+	}
+	if *fix {
+		fmt.Printf("Fixed %d rows\n", report.Fixed)
+	}
+	for _, issue := range report.Issues {
+		fixable := ""
+		if issue.Fixable {
+			fixable = " (fixable with -fix)"
+		}
+		fmt.Printf("  %s %s %s: %s%s\n", issue.Kind, issue.Table, issue.ID, issue.Detail, fixable)
+	}
+	if len(report.Issues) > 0 {
+		return fmt.Errorf("found %d issues", len(report.Issues))
+	}
+	fmt.Println("No issues found")
+	return nil
+}
+
+// runCheckCredentials checks each social platform's credentials, as GET /admin/social/health does, and fails when
+// any configured platform's are invalid, expiring or couldn't be checked, so cron can tell ahead of publish day
+func runCheckCredentials(args []string) error {
+	flags := flag.NewFlagSet("check-credentials", flag.ContinueOnError)
+	platforms := flags.String("platforms", strings.Join(services.CredentialPlatforms, ","), "comma-separated platforms to check")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	platformsToCheck := strings.Split(*platforms, ",")
+	for i := range platformsToCheck {
+		platformsToCheck[i] = strings.TrimSpace(platformsToCheck[i])
+	}
+
+	// Credentials saved by refresh-substack are checked in place of the environment's, when the database is there
+	if db, err := openDatabase(); err == nil {
+		services.UseStoredCredentials(database.New(db).CredentialRepo().FindAll)
+	} else {
+		fmt.Printf("Checking the environment's credentials only: %v\n", err)
+	}
+
+	checks := services.CheckCredentials(context.Background(), platformsToCheck)
+	for _, check := range checks {
+		line := fmt.Sprintf("  %s: %s", check.Platform, check.Status)
+		if check.Account != "" {
+			line += " (" + check.Account + ")"
+		}
+		if check.ExpiresAt != nil {
+			line += fmt.Sprintf(", expires %s", check.ExpiresAt.Format(time.DateOnly))
+		}
+		if check.Err != nil {
+			line += fmt.Sprintf(": %v", check.Err)
+		}
+		fmt.Println(line)
+	}
+	if !services.CredentialsHealthy(checks) {
+		return fmt.Errorf("some platform credentials need attention")
+	}
+	return nil
+}
+
+// substackCookieLifetime is how long a Substack session cookie is assumed to last when its expiry isn't given
+const substackCookieLifetime = 90 * 24 * time.Hour
+
+// runRefreshSubstack walks through copying a fresh connect.sid cookie from a signed-in browser, checks Substack
+// accepts it and stores it with its expiry, where posting and check-credentials take it in place of SUBSTACK_COOKIE
+func runRefreshSubstack(args []string) error {
+	flags := flag.NewFlagSet("refresh-substack", flag.ContinueOnError)
+	domain := flags.String("domain", getEnv("SUBSTACK_DOMAIN", ""), "Substack subdomain, e.g. name for name.substack.com (defaults to SUBSTACK_DOMAIN)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *domain == "" {
+		return fmt.Errorf("-domain or SUBSTACK_DOMAIN is required")
+	}
+	db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("1. Sign in to https://%s.substack.com in your browser\n", *domain)
+	fmt.Println("2. Open the developer tools and find the site's cookies (Application > Cookies in Chrome, Storage > Cookies in Firefox)")
+	fmt.Println("3. Copy the value of the connect.sid cookie, and its Expires date")
+	reader := bufio.NewReader(os.Stdin)
+	prompt := func(label string) (string, error) {
+		fmt.Print(label)
+		line, err := reader.ReadString('\n')
+		if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+			return "", fmt.Errorf("error reading input: %w", err)
+		}
+		return strings.TrimSpace(line), nil
+	}
+
+	cookie, err := prompt("\nconnect.sid: ")
+	if err != nil {
+		return err
+	}
+	// Accept the cookie as copied from a Cookie header too
+	cookie = strings.Trim(strings.TrimSuffix(strings.TrimPrefix(cookie, "connect.sid="), ";"), `"`)
+	if cookie == "" {
+		return fmt.Errorf("no cookie given")
+	}
+
+	fmt.Println("Checking the cookie with Substack...")
+	check := services.CheckSubstackCookie(context.Background(), *domain, cookie)
+	if check.Status != services.CredentialOK {
+		return fmt.Errorf("cookie not accepted (%s): %w", check.Status, check.Err)
+	}
+
+	expires, err := prompt(fmt.Sprintf("Expires (YYYY-MM-DD, or blank to assume %d days): ", int(substackCookieLifetime.Hours()/24)))
+	if err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(substackCookieLifetime)
+	if expires != "" {
+		// Browsers show the expiry as a date and time, e.g. 2026-01-14T12:00:00.000Z
+		expiresAt, err = time.Parse(time.RFC3339, expires)
+		if err != nil {
+			expiresAt, err = time.Parse(time.DateOnly, expires)
+		}
+		if err != nil {
+			return fmt.Errorf("expires must be a date such as 2026-01-14: %w", err)
+		}
+		if expiresAt.Before(time.Now()) {
+			return fmt.Errorf("the cookie expired on %s", expiresAt.Format(time.DateOnly))
+		}
+	}
+
+	credential := models.Credential{Platform: "substack", Name: "SUBSTACK_COOKIE", Value: cookie, ExpiresAt: &expiresAt}
+	if err := database.New(db).CredentialRepo().Save(&credential); err != nil {
+		return fmt.Errorf("error saving the cookie: %w", err)
+	}
+	fmt.Printf("Saved the cookie for %s.substack.com, expiring %s. It's used in place of SUBSTACK_COOKIE from the next post on\n", *domain, expiresAt.Format(time.DateOnly))
+	return nil
+}
+
+// runDevClone copies production's content into the local database with emails, IPs, tokens and visitor hashes
+// scrubbed, so bugs can be reproduced against realistic data. The source is only read from
+func runDevClone(args []string) error {
+	flags := flag.NewFlagSet("dev-clone", flag.ContinueOnError)
+	from := flags.String("from", getEnv("SOURCE_DATABASE_URL", ""), "connection string of the database to copy from (defaults to SOURCE_DATABASE_URL)")
+	reset := flags.Bool("reset", false, "empty the local tables before copying")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *from == "" {
+		return fmt.Errorf("-from or SOURCE_DATABASE_URL is required")
+	}
+	if strings.EqualFold(getEnv("APP_ENV", ""), "production") {
+		return fmt.Errorf("dev-clone overwrites the database it's run against, so it can't be run with APP_ENV=production")
+	}
+
+	sourceConnStr, err := normalizeConnectionString(*from)
+	if err != nil {
+		return fmt.Errorf("invalid source connection string: %w", err)
+	}
+	fmt.Println("Connecting to the source database...")
+	src, err := connectDatabase(sourceConnStr)
+	if err != nil {
+		return err
+	}
+	dst, err := openDatabase()
+	if err != nil {
+		return err
+	}
+
+	// The same server can be reached by more than one connection string, so ask each which database it is
+	var sourceID, destinationID string
+	const identify = "SELECT current_database() || '@' || COALESCE(host(inet_server_addr()), '') || ':' || COALESCE(inet_server_port(), 0)"
+	if err := src.Raw(identify).Scan(&sourceID).Error; err != nil {
+		return fmt.Errorf("error identifying the source database: %w", err)
+	}
+	if err := dst.Raw(identify).Scan(&destinationID).Error; err != nil {
+		return fmt.Errorf("error identifying the local database: %w", err)
+	}
+	if sourceID == destinationID {
+		return fmt.Errorf("the source and local database are both %s", sourceID)
+	}
+
+	if err := models.Migrate(dst); err != nil {
+		return fmt.Errorf("error migrating models: %w", err)
+	}
+	if err := database.New(dst).CreateSearchIndexes(); err != nil {
+		return fmt.Errorf("error creating search indexes: %w", err)
+	}
+
+	copied, err := database.New(src).CopyAnonymizedTo(database.New(dst), *reset)
+	if err != nil {
+		return fmt.Errorf("error copying the database: %w", err)
+	}
+	total := 0
+	for _, table := range copied {
+		fmt.Printf("  %s: %d rows\n", table.Table, table.Rows)
+		total += table.Rows
+	}
+	fmt.Printf("Copied %d rows into %d tables; left out %s\n", total, len(copied), strings.Join(database.NotCopiedTables, ", "))
+	return nil
+}
+
+// runExport writes every blog post, with its tags, to a JSON file shaped like GET /admin/export/blog-posts, or to a
+// folder with one markdown file per post like GET /blog-post/{blogPostID}/export.md, which POST /import/markdown
+// reads back
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := flags.String("format", "json", "json or markdown")
+	out := flags.String("out", "", "file to write JSON to (default blog-posts.json), or folder to write markdown to (default blog-posts)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *format != "json" && *format != "markdown" {
+		return fmt.Errorf("-format must be json or markdown, not %q", *format)
+	}
+	if *out == "" {
+		*out = "blog-posts"
+		if *format == "json" {
+			*out = "blog-posts.json"
+		}
+	}
+
+	db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+	repos := database.New(db)
+	blogPostRepo := repos.BlogPostRepo()
+
+	var count int
+	if *format == "json" {
+		count, err = exportJSON(blogPostRepo, *out)
+	} else {
+		count, err = exportMarkdown(blogPostRepo, repos.SnippetRepo(), *out)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Exported %d blog posts to %s\n", count, *out)
+	return nil
+}
+
+func exportJSON(blogPostRepo *database.BlogPostRepo, path string) (int, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return 0, fmt.Errorf("error creating %s: %w", path, err)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(`{"data":[`); err != nil {
+		return 0, err
+	}
+	encoder := json.NewEncoder(file)
+	count := 0
+	err = blogPostRepo.FindInBatches(exportBatchSize, func(blogPosts []*models.BlogPost) error {
+		for _, blogPost := range blogPosts {
+			if count > 0 {
+				if _, err := file.WriteString(","); err != nil {
+					return err
+				}
+			}
+			if err := encoder.Encode(api.BlogPostWithTags{BlogPost: *blogPost, Tags: blogPost.Tags}); err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error exporting blog posts: %w", err)
+	}
+	if _, err := fmt.Fprintf(file, "],\"count\":%d}\n", count); err != nil {
+		return 0, err
+	}
+	return count, file.Close()
+}
+
+func exportMarkdown(blogPostRepo *database.BlogPostRepo, snippetRepo *database.SnippetRepo, dir string) (int, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return 0, fmt.Errorf("error creating %s: %w", dir, err)
+	}
+
+	written := make(map[string]bool)
+	err := blogPostRepo.FindInBatches(exportBatchSize, func(blogPosts []*models.BlogPost) error {
+		for _, blogPost := range blogPosts {
+			name := services.ExportFileName(blogPost.Title, blogPost.ID, written)
+
+			snippets, err := snippetRepo.FindByIDs(services.SnippetShortcodeIDs(blogPost.Content))
+			if err != nil {
+				return fmt.Errorf("error finding the snippets %q embeds: %w", blogPost.Title, err)
+			}
+			var content strings.Builder
+			if err := services.RenderBlogPostMarkdown(*blogPost, snippets, &content); err != nil {
+				return fmt.Errorf("error rendering %q: %w", blogPost.Title, err)
+			}
+			if err := os.WriteFile(filepath.Join(dir, name+".md"), []byte(content.String()), 0o644); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error exporting blog posts: %w", err)
+	}
+	return len(written), nil
+}
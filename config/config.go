@@ -4,6 +4,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func New() map[string]string {
@@ -55,3 +56,41 @@ func GetInt(config map[string]string, key string, defaultValue int) int {
 
 	return asInt
 }
+
+func GetBool(config map[string]string, key string, defaultValue bool) bool {
+	if config == nil {
+		return defaultValue
+	}
+
+	s, ok := config[key]
+	if !ok {
+		return defaultValue
+	}
+
+	asBool, err := strconv.ParseBool(s)
+	if err != nil {
+		return defaultValue
+	}
+
+	return asBool
+}
+
+// GetDuration parses the value as a Go duration such as "30s" or "2m", falling back to defaultValue when it is
+// missing, malformed or negative
+func GetDuration(config map[string]string, key string, defaultValue time.Duration) time.Duration {
+	if config == nil {
+		return defaultValue
+	}
+
+	s, ok := config[key]
+	if !ok {
+		return defaultValue
+	}
+
+	asDuration, err := time.ParseDuration(s)
+	if err != nil || asDuration < 0 {
+		return defaultValue
+	}
+
+	return asDuration
+}
@@ -0,0 +1,243 @@
+package database
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// copyBatchSize is how many rows CopyAnonymizedTo reads and writes at a time
+const copyBatchSize = 500
+
+// CopiedTable is how many rows CopyAnonymizedTo copied into one table
+type CopiedTable struct {
+	Table string
+	Rows  int
+}
+
+// NotCopiedTables are left out of anonymized copies: they hold secrets, such as platform credentials and the salts
+// visitor hashes are made with, or cached responses and webhook payloads that are of no use locally
+var NotCopiedTables = []string{"credentials", "idempotency_keys", "visitor_salts", "webhook_deliveries"}
+
+// Patterns scrubText replaces with placeholders
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	ipv4Pattern  = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+	// Full IPv6 addresses, or ones shortened with ::. Fewer than four groups would also match times of day
+	ipv6Pattern = regexp.MustCompile(`\b(?:[0-9A-Fa-f]{1,4}:){3,7}[0-9A-Fa-f]{1,4}\b|\b(?:[0-9A-Fa-f]{1,4}:){1,6}:(?:[0-9A-Fa-f]{1,4}(?::[0-9A-Fa-f]{1,4}){0,5}\b)?`)
+	// Long unbroken runs of letters, digits and -_ are API keys, access tokens and signed URLs' signatures
+	tokenPattern = regexp.MustCompile(`\b[A-Za-z0-9_\-]{32,}\b`)
+)
+
+// scrubText replaces the email addresses, IP addresses and token-like strings in text with placeholders from the
+// ranges reserved for documentation
+func scrubText(text string) string {
+	text = emailPattern.ReplaceAllString(text, "user@example.com")
+	text = ipv6Pattern.ReplaceAllString(text, "2001:db8::1")
+	text = ipv4Pattern.ReplaceAllString(text, "192.0.2.1")
+	return tokenPattern.ReplaceAllString(text, "[redacted]")
+}
+
+func scrubOptionalText(text *string) {
+	if text != nil {
+		*text = scrubText(*text)
+	}
+}
+
+// CopyAnonymizedTo copies the content of every table but NotCopiedTables into dst, with what identifies visitors
+// or could be used against the site scrubbed on the way:
+//   - guestbook entry and testimonial authors are renamed, and their messages have emails, IPs and tokens replaced
+//   - webhooks point at example.com with new secrets, and are deactivated so nothing is delivered from dst
+//   - visitor and user agent hashes on page and blog post views are rehashed with a key that's thrown away, so
+//     visitors can still be counted but not matched with the source's
+//   - social post errors, which can quote a platform's response, have emails, IPs and tokens replaced
+//
+// Rows are read in one read-only transaction, so the copy is a consistent snapshot and d is never written to, and
+// written in one transaction, so a failed copy leaves dst as it was. dst's tables must be empty unless reset, which
+// empties them first
+func (d Database) CopyAnonymizedTo(dst Database, reset bool) ([]CopiedTable, error) {
+	hashKey := make([]byte, 32)
+	if _, err := rand.Read(hashKey); err != nil {
+		return nil, fmt.Errorf("failed to generate hash key: %w", err)
+	}
+	rehash := func(value string) string {
+		if value == "" {
+			return ""
+		}
+		mac := hmac.New(sha256.New, hashKey)
+		mac.Write([]byte(value))
+		sum := hex.EncodeToString(mac.Sum(nil))
+		if len(value) < len(sum) {
+			return sum[:len(value)]
+		}
+		return sum
+	}
+
+	guestbookEntries, testimonials, webhooks := 0, 0, 0
+	// Parents come before the rows that point at them
+	tables := []func(src, dst *gorm.DB) (CopiedTable, error){
+		copier[models.BlogPost]("blog_posts", nil),
+		copier[models.BlogPostView]("blog_post_views", func(view *models.BlogPostView) {
+			view.VisitorHash = rehash(view.VisitorHash)
+		}),
+		copier[models.BlogTag]("blog_tags", nil),
+		copier[models.BlogPostTranslation]("blog_post_translations", nil),
+		copier[models.Series]("series", nil),
+		copier[models.SeriesPost]("series_posts", nil),
+		copier[models.BlogPostImage]("blog_post_images", nil),
+		copier[models.Project]("projects", nil),
+		copier[models.ProjectTag]("project_tags", nil),
+		copier[models.ProjectImage]("project_images", nil),
+		copier[models.WorkExperience]("work_experiences", nil),
+		copier[models.Education]("educations", nil),
+		copier[models.Skill]("skills", nil),
+		copier[models.Testimonial]("testimonials", func(testimonial *models.Testimonial) {
+			testimonials++
+			testimonial.AuthorName = fmt.Sprintf("Reviewer %d", testimonials)
+			if testimonial.AuthorCompany != nil {
+				company := "Example Co"
+				testimonial.AuthorCompany = &company
+			}
+			testimonial.Text = scrubText(testimonial.Text)
+		}),
+		copier[models.UsesItem]("uses_items", nil),
+		copier[models.Bookmark]("bookmarks", nil),
+		copier[models.BookmarkTag]("bookmark_tags", nil),
+		copier[models.Note]("notes", nil),
+		copier[models.GuestbookEntry]("guestbook_entries", func(entry *models.GuestbookEntry) {
+			guestbookEntries++
+			entry.Name = fmt.Sprintf("Visitor %d", guestbookEntries)
+			entry.Website = nil
+			entry.Message = scrubText(entry.Message)
+		}),
+		copier[models.Book]("books", nil),
+		copier[models.Certification]("certifications", nil),
+		copier[models.FAQ]("faqs", nil),
+		copier[models.Snippet]("snippets", nil),
+		copier[models.Webhook]("webhooks", func(webhook *models.Webhook) {
+			webhooks++
+			webhook.URL = fmt.Sprintf("https://example.com/webhooks/%d", webhooks)
+			secret := make([]byte, 32)
+			_, _ = rand.Read(secret)
+			webhook.Secret = hex.EncodeToString(secret)
+			webhook.Active = false
+		}),
+		copier[models.ContentView]("content_views", nil),
+		copier[models.PageView]("page_views", func(pageView *models.PageView) {
+			pageView.VisitorHash = rehash(pageView.VisitorHash)
+			pageView.UserAgentHash = rehash(pageView.UserAgentHash)
+		}),
+		copier[models.PageViewDaily]("page_view_dailies", nil),
+		copier[models.VisitorDaily]("visitor_dailies", nil),
+		copier[models.ShareLink]("share_links", nil),
+		copier[models.ShortLink]("short_links", nil),
+		copier[models.SocialPost]("social_posts", func(socialPost *models.SocialPost) {
+			scrubOptionalText(socialPost.Error)
+		}),
+		copier[models.NotionPage]("notion_pages", nil),
+		copier[models.MediaFile]("media_files", nil),
+		copier[models.MediaObject]("media_objects", nil),
+	}
+
+	var copied []CopiedTable
+	err := d.db.Transaction(func(src *gorm.DB) error {
+		return dst.db.Transaction(func(tx *gorm.DB) error {
+			if err := prepareCopyDestination(tx, reset); err != nil {
+				return err
+			}
+			for _, copyTable := range tables {
+				table, err := copyTable(src, tx)
+				if err != nil {
+					return err
+				}
+				copied = append(copied, table)
+			}
+			// Active defaults to true, so Create leaves a false value out of the insert
+			return tx.Model(&models.Webhook{}).Where("active").Update("active", false).Error
+		})
+	}, &sql.TxOptions{ReadOnly: true, Isolation: sql.LevelRepeatableRead})
+	if err != nil {
+		return nil, err
+	}
+	return copied, nil
+}
+
+// copier returns a function that copies every row of table from src to dst, calling scrub, if set, on each first
+func copier[T any](table string, scrub func(row *T)) func(src, dst *gorm.DB) (CopiedTable, error) {
+	return func(src, dst *gorm.DB) (CopiedTable, error) {
+		copied := CopiedTable{Table: table}
+		var batch []*T
+		// Unscoped so blog posts and projects in the trash are copied too, as their tags are
+		err := src.Unscoped().FindInBatches(&batch, copyBatchSize, func(_ *gorm.DB, _ int) error {
+			if scrub != nil {
+				for _, row := range batch {
+					scrub(row)
+				}
+			}
+			// Associations are copied as tables of their own
+			if err := dst.Omit(clause.Associations).Create(&batch).Error; err != nil {
+				return err
+			}
+			copied.Rows += len(batch)
+			return nil
+		}).Error
+		if err != nil {
+			return CopiedTable{}, fmt.Errorf("failed to copy %s: %w", table, err)
+		}
+		return copied, nil
+	}
+}
+
+// prepareCopyDestination empties dst's tables with reset, or makes sure they're already empty without it
+func prepareCopyDestination(dst *gorm.DB, reset bool) error {
+	var tables []string
+	for _, model := range []any{
+		&models.BlogPost{}, &models.Project{}, &models.WorkExperience{}, &models.Education{}, &models.Skill{},
+		&models.Testimonial{}, &models.UsesItem{}, &models.Bookmark{}, &models.Note{}, &models.GuestbookEntry{},
+		&models.Book{}, &models.Certification{}, &models.FAQ{}, &models.Webhook{}, &models.ContentView{},
+		&models.PageView{}, &models.PageViewDaily{}, &models.VisitorDaily{}, &models.ShareLink{}, &models.SocialPost{},
+		&models.NotionPage{}, &models.MediaFile{}, &models.MediaObject{}, &models.ShortLink{}, &models.Snippet{},
+		&models.BlogPostTranslation{}, &models.BlogPostView{}, &models.Series{}, &models.SeriesPost{},
+		&models.BlogPostImage{}, &models.ProjectImage{},
+	} {
+		stmt := &gorm.Statement{DB: dst}
+		if err := stmt.Parse(model); err != nil {
+			return err
+		}
+		tables = append(tables, stmt.Schema.Table)
+	}
+
+	if reset {
+		// CASCADE also empties the tag tables and webhook deliveries, which point at these
+		query := "TRUNCATE TABLE"
+		for i, table := range tables {
+			if i > 0 {
+				query += ","
+			}
+			query += " " + table
+		}
+		if err := dst.Exec(query + " CASCADE").Error; err != nil {
+			return fmt.Errorf("failed to empty the destination: %w", err)
+		}
+		return nil
+	}
+
+	for _, table := range tables {
+		var exists bool
+		if err := dst.Raw(fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s)", table)).Scan(&exists).Error; err != nil {
+			return fmt.Errorf("failed to check %s: %w", table, err)
+		}
+		if exists {
+			return fmt.Errorf("%s already has rows; copy with reset to replace what's there", table)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+// BenchmarkSeed is the content SeedBenchmark created, which RemoveBenchmarkSeed deletes again
+type BenchmarkSeed struct {
+	BlogPostIDs []uuid.UUID
+	ProjectIDs  []uuid.UUID
+}
+
+// benchmarkParagraph pads seeded posts to the length of a typical post, so list and detail responses are realistic
+const benchmarkParagraph = "This post was written by the benchmark to exercise the list, detail and search endpoints. " +
+	"It is removed again once the run finishes.\n\n"
+
+// SeedBenchmark adds count published blog posts and count projects, each with tags, for a benchmark run
+// Titles are unique per run, so a seed left behind by a crashed run doesn't collide with the next one
+func (d Database) SeedBenchmark(count int) (BenchmarkSeed, error) {
+	run := uuid.NewString()[:8]
+	now := time.Now()
+	content := strings.Repeat(benchmarkParagraph, 20)
+	wordCount := models.CountWords(content)
+
+	blogPosts := make([]*models.BlogPost, count)
+	projects := make([]*models.Project, count)
+	for i := range count {
+		summary := fmt.Sprintf("Benchmark post %d", i+1)
+		blogPosts[i] = &models.BlogPost{
+			Title:              fmt.Sprintf("Benchmark %s post %d", run, i+1),
+			Summary:            &summary,
+			Content:            content,
+			DateAdded:          now.Add(-time.Duration(i) * time.Hour),
+			WordCount:          wordCount,
+			ReadingTimeMinutes: models.ReadingTimeMinutes(wordCount),
+			Status:             models.BlogPostStatusPublished,
+			Tags:               []models.BlogTag{{Value: "benchmark"}, {Value: "benchmark-" + run}},
+		}
+		projects[i] = &models.Project{
+			Title:       fmt.Sprintf("Benchmark %s project %d", run, i+1),
+			Description: "A project seeded by the benchmark.",
+			Type:        "benchmark",
+			DateAdded:   now.Add(-time.Duration(i) * time.Hour),
+			Tags:        []models.ProjectTag{{Value: "benchmark"}, {Value: "benchmark-" + run}},
+		}
+	}
+
+	err := d.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.CreateInBatches(blogPosts, 100).Error; err != nil {
+			return fmt.Errorf("failed to seed blog posts: %w", err)
+		}
+		if err := tx.CreateInBatches(projects, 100).Error; err != nil {
+			return fmt.Errorf("failed to seed projects: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return BenchmarkSeed{}, err
+	}
+
+	seed := BenchmarkSeed{BlogPostIDs: make([]uuid.UUID, count), ProjectIDs: make([]uuid.UUID, count)}
+	for i := range count {
+		seed.BlogPostIDs[i] = blogPosts[i].ID
+		seed.ProjectIDs[i] = projects[i].ID
+	}
+	return seed, nil
+}
+
+// RemoveBenchmarkSeed deletes what SeedBenchmark added; their tags are deleted along with them
+func (d Database) RemoveBenchmarkSeed(seed BenchmarkSeed) error {
+	return d.db.Transaction(func(tx *gorm.DB) error {
+		if len(seed.BlogPostIDs) > 0 {
+			if err := tx.Where("id IN ?", seed.BlogPostIDs).Delete(&models.BlogPost{}).Error; err != nil {
+				return fmt.Errorf("failed to remove seeded blog posts: %w", err)
+			}
+		}
+		if len(seed.ProjectIDs) > 0 {
+			if err := tx.Where("id IN ?", seed.ProjectIDs).Delete(&models.Project{}).Error; err != nil {
+				return fmt.Errorf("failed to remove seeded projects: %w", err)
+			}
+		}
+		return nil
+	})
+}
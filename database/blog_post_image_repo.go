@@ -0,0 +1,100 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type BlogPostImageRepo struct {
+	db *gorm.DB
+}
+
+func NewBlogPostImageRepo(db *gorm.DB) *BlogPostImageRepo {
+	return &BlogPostImageRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *BlogPostImageRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindForBlogPost returns a blog post's images in gallery order
+func (r *BlogPostImageRepo) FindForBlogPost(blogPostID uuid.UUID) ([]*models.BlogPostImage, error) {
+	var images []*models.BlogPostImage
+	err := r.db.Where("blog_post_id = ?", blogPostID).Order("position").Order("date_added").Order("id").Find(&images).Error
+	return images, err
+}
+
+// FindByID returns one of a blog post's images
+func (r *BlogPostImageRepo) FindByID(blogPostID, id uuid.UUID) (*models.BlogPostImage, error) {
+	var image models.BlogPostImage
+	err := r.db.Where("blog_post_id = ?", blogPostID).First(&image, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &image, nil
+}
+
+// FindFeaturedURLs returns the URLs of the featured images of blogPostIDs, keyed by post. Posts without one are
+// left out
+func (r *BlogPostImageRepo) FindFeaturedURLs(blogPostIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	urls := make(map[uuid.UUID]string, len(blogPostIDs))
+	if len(blogPostIDs) == 0 {
+		return urls, nil
+	}
+
+	var images []models.BlogPostImage
+	if err := r.db.Select("blog_post_id", "url").Where("blog_post_id IN ? AND is_featured", blogPostIDs).Find(&images).Error; err != nil {
+		return nil, err
+	}
+	for _, image := range images {
+		urls[image.BlogPostID] = image.URL
+	}
+	return urls, nil
+}
+
+// Add inserts a new image into its blog post's gallery, after the last image unless it has a position. A featured
+// image takes over from the post's previous one
+func (r *BlogPostImageRepo) Add(image *models.BlogPostImage) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if image.Position == 0 {
+			if err := tx.Model(&models.BlogPostImage{}).
+				Select("COALESCE(MAX(position), 0) + 1").
+				Where("blog_post_id = ?", image.BlogPostID).
+				Scan(&image.Position).Error; err != nil {
+				return err
+			}
+		}
+		if err := unfeatureBlogPostImages(tx, image); err != nil {
+			return err
+		}
+		return tx.Create(image).Error
+	})
+}
+
+// Update updates an existing image. A featured image takes over from the post's previous one
+func (r *BlogPostImageRepo) Update(image *models.BlogPostImage) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := unfeatureBlogPostImages(tx, image); err != nil {
+			return err
+		}
+		return tx.Save(image).Error
+	})
+}
+
+// Delete removes one of a blog post's images, reporting whether it had it
+func (r *BlogPostImageRepo) Delete(blogPostID, id uuid.UUID) (bool, error) {
+	result := r.db.Where("blog_post_id = ?", blogPostID).Delete(&models.BlogPostImage{}, id)
+	return result.RowsAffected > 0, result.Error
+}
+
+// unfeatureBlogPostImages stops the other images of image's post being featured when image is
+func unfeatureBlogPostImages(tx *gorm.DB, image *models.BlogPostImage) error {
+	if !image.IsFeatured {
+		return nil
+	}
+	return tx.Model(&models.BlogPostImage{}).
+		Where("blog_post_id = ? AND id <> ? AND is_featured", image.BlogPostID, image.ID).
+		Update("is_featured", false).Error
+}
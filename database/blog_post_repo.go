@@ -1,9 +1,12 @@
 package database
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/rpupo63/unified-personal-site-backend/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type BlogPostRepo struct {
@@ -19,34 +22,354 @@ func (r *BlogPostRepo) GetDB() *gorm.DB {
 	return r.db
 }
 
-// FindAll returns all blog posts from the database
-func (r *BlogPostRepo) FindAll() ([]*models.BlogPost, error) {
+// published limits query to published blog posts; every public read goes through it
+func published(query *gorm.DB) *gorm.DB {
+	return query.Where("status = ?", models.BlogPostStatusPublished)
+}
+
+// publishedTagged limits query to published blog posts matching tags
+func publishedTagged(query *gorm.DB, tags TagFilter) *gorm.DB {
+	return applyTagFilter(published(query), tags, "blog_tags", "blog_post_id")
+}
+
+// FindAll returns all published blog posts from the database, ordered by the given sort fields if any
+func (r *BlogPostRepo) FindAll(sort ...SortField) ([]*models.BlogPost, error) {
 	var blogPosts []*models.BlogPost
-	err := r.db.Preload("Tags").Find(&blogPosts).Error
+	err := applySort(published(r.db.Preload("Tags")), sort).Find(&blogPosts).Error
 	return blogPosts, err
 }
 
-// FindByID returns a blog post by its ID
+// FindPage returns one page of the published blog posts matching tags ordered by sort (newest first when empty), along with the total number of them
+// id is always the final sort key so pages don't overlap when sort values tie
+func (r *BlogPostRepo) FindPage(tags TagFilter, offset, limit int, sort ...SortField) ([]*models.BlogPost, int64, error) {
+	var total int64
+	if err := publishedTagged(r.db.Model(&models.BlogPost{}), tags).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if len(sort) == 0 {
+		sort = newestFirst
+	}
+
+	var blogPosts []*models.BlogPost
+	err := applySort(publishedTagged(r.db.Preload("Tags"), tags), sort).
+		Order("id").
+		Offset(offset).
+		Limit(pageLimit(limit)).
+		Find(&blogPosts).Error
+	return blogPosts, total, err
+}
+
+// FindPageBefore returns up to limit published blog posts matching tags older than cursor, newest first by
+// (date_added, id), which stays as fast however deep the page is. next is where the following page starts, nil on the
+// last one; total is the number of published blog posts matching tags
+func (r *BlogPostRepo) FindPageBefore(tags TagFilter, cursor *Cursor, limit int) ([]*models.BlogPost, *Cursor, int64, error) {
+	var total int64
+	if err := publishedTagged(r.db.Model(&models.BlogPost{}), tags).Count(&total).Error; err != nil {
+		return nil, nil, 0, err
+	}
+
+	limit = pageLimit(limit)
+	var blogPosts []*models.BlogPost
+	err := before(publishedTagged(r.db.Preload("Tags"), tags), "date_added", cursor).
+		Order("date_added DESC").
+		Order("id DESC").
+		Limit(limit + 1).
+		Find(&blogPosts).Error
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	blogPosts, next := trimToCursorPage(blogPosts, limit, func(blogPost *models.BlogPost) Cursor {
+		return Cursor{Time: blogPost.DateAdded, ID: blogPost.ID}
+	})
+	return blogPosts, next, total, nil
+}
+
+// blogPostSummaryColumns are the columns list views show; content is left out since it's most of a post's size
+var blogPostSummaryColumns = []string{"id", "title", "summary", "date_added", "date_edited", "word_count", "reading_time_minutes", "url"}
+
+// FindSummaryPage is FindPage for list views: content and tags aren't loaded, and the other columns are
+// Use BlogTagRepo.FindValues to get the page's tags in one query
+func (r *BlogPostRepo) FindSummaryPage(tags TagFilter, offset, limit int, sort ...SortField) ([]*models.BlogPost, int64, error) {
+	var total int64
+	if err := publishedTagged(r.db.Model(&models.BlogPost{}), tags).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if len(sort) == 0 {
+		sort = newestFirst
+	}
+
+	var blogPosts []*models.BlogPost
+	err := applySort(publishedTagged(r.db.Select(blogPostSummaryColumns), tags), sort).
+		Order("id").
+		Offset(offset).
+		Limit(pageLimit(limit)).
+		Find(&blogPosts).Error
+	return blogPosts, total, err
+}
+
+// Search returns up to limit published blog posts matching query, best first, with each one's score
+// Full-text matches come first, then typo-tolerant trigram matches
+func (r *BlogPostRepo) Search(query string, limit int) ([]*models.BlogPost, []float64, error) {
+	hits, err := blogPostSearch.hits(r.db, query, limit)
+	if err != nil || len(hits) == 0 {
+		return nil, nil, err
+	}
+
+	var found []*models.BlogPost
+	if err := r.db.Preload("Tags").Where("id IN ?", hitIDs(hits)).Find(&found).Error; err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[uuid.UUID]*models.BlogPost, len(found))
+	for _, blogPost := range found {
+		byID[blogPost.ID] = blogPost
+	}
+
+	blogPosts := make([]*models.BlogPost, 0, len(hits))
+	scores := make([]float64, 0, len(hits))
+	for _, hit := range hits {
+		if blogPost, ok := byID[hit.ID]; ok {
+			blogPosts = append(blogPosts, blogPost)
+			scores = append(scores, hit.Score)
+		}
+	}
+	return blogPosts, scores, nil
+}
+
+// FindArchiveStubs returns the id, title and date of every published blog post, newest first
+// Content is skipped so the archive stays cheap however long the posts are
+func (r *BlogPostRepo) FindArchiveStubs() ([]*models.BlogPost, error) {
+	var blogPosts []*models.BlogPost
+	err := published(r.db.Select("id", "title", "date_added")).
+		Order("date_added DESC").
+		Order("id").
+		Find(&blogPosts).Error
+	return blogPosts, err
+}
+
+// FindSitemapEntries returns the id, URL and dates of every published blog post, newest first, for the sitemap
+func (r *BlogPostRepo) FindSitemapEntries() ([]*models.BlogPost, error) {
+	var blogPosts []*models.BlogPost
+	err := published(r.db.Select("id", "url", "date_added", "date_edited")).
+		Order("date_added DESC").
+		Order("id").
+		Find(&blogPosts).Error
+	return blogPosts, err
+}
+
+// FindSummaries is FindSummaryPage for the published blog posts among ids, in no particular order
+func (r *BlogPostRepo) FindSummaries(ids []uuid.UUID) ([]*models.BlogPost, error) {
+	var blogPosts []*models.BlogPost
+	if len(ids) == 0 {
+		return blogPosts, nil
+	}
+	err := published(r.db).Select(blogPostSummaryColumns).Where("id IN ?", ids).Find(&blogPosts).Error
+	return blogPosts, err
+}
+
+// FindTitles returns the titles of the blog posts with the given IDs, keyed by ID
+// IDs with no matching row are simply missing from the map
+func (r *BlogPostRepo) FindTitles(ids []uuid.UUID) (map[uuid.UUID]string, error) {
+	titles := make(map[uuid.UUID]string, len(ids))
+	if len(ids) == 0 {
+		return titles, nil
+	}
+
+	var rows []models.BlogPost
+	if err := r.db.Select("id", "title").Where("id IN ?", ids).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		titles[row.ID] = row.Title
+	}
+	return titles, nil
+}
+
+// FindAllTitles returns the title of every blog post, whatever its status, keyed by ID
+func (r *BlogPostRepo) FindAllTitles() (map[uuid.UUID]string, error) {
+	var rows []models.BlogPost
+	if err := r.db.Select("id", "title").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	titles := make(map[uuid.UUID]string, len(rows))
+	for _, row := range rows {
+		titles[row.ID] = row.Title
+	}
+	return titles, nil
+}
+
+// FindTakenTitles returns the title of every blog post, those in the trash included, since their titles stay taken
+// until they're deleted for good
+func (r *BlogPostRepo) FindTakenTitles() ([]string, error) {
+	var titles []string
+	err := r.db.Unscoped().Model(&models.BlogPost{}).Pluck("title", &titles).Error
+	return titles, err
+}
+
+// FindInBatches calls fn with every blog post, whatever its status, batchSize at a time in ID order with their tags
+// Only one batch is held in memory, so exports can cover every post however many there are. An error from fn stops it
+func (r *BlogPostRepo) FindInBatches(batchSize int, fn func(blogPosts []*models.BlogPost) error) error {
+	var batch []*models.BlogPost
+	return r.db.Preload("Tags").FindInBatches(&batch, batchSize, func(_ *gorm.DB, _ int) error {
+		return fn(batch)
+	}).Error
+}
+
+// FindByID returns a blog post by its ID, with its tags and images
 func (r *BlogPostRepo) FindByID(id uuid.UUID) (*models.BlogPost, error) {
 	var blogPost models.BlogPost
-	err := r.db.Preload("Tags").First(&blogPost, id).Error
+	err := r.db.Preload("Tags").Preload("Images", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position").Order("date_added").Order("id")
+	}).First(&blogPost, id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &blogPost, nil
 }
 
+// FindUnpublished returns every draft and scheduled blog post, scheduled ones first by publish time, then drafts newest first
+func (r *BlogPostRepo) FindUnpublished() ([]*models.BlogPost, error) {
+	var blogPosts []*models.BlogPost
+	err := r.db.Preload("Tags").
+		Where("status <> ?", models.BlogPostStatusPublished).
+		Order("publish_at ASC NULLS LAST").
+		Order("date_added DESC").
+		Order("id").
+		Find(&blogPosts).Error
+	return blogPosts, err
+}
+
+// PublishDue publishes every scheduled blog post whose publish time is at or before now and returns them with their tags
+// DateAdded is set to the scheduled time, since that is the date readers see. Rows are locked while they are
+// flipped, so two instances running the job at once publish each post only once
+func (r *BlogPostRepo) PublishDue(now time.Time) ([]*models.BlogPost, error) {
+	var due []*models.BlogPost
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("status = ? AND publish_at <= ?", models.BlogPostStatusScheduled, now).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Find(&due).Error
+		if err != nil || len(due) == 0 {
+			return err
+		}
+
+		ids := make([]uuid.UUID, 0, len(due))
+		for _, blogPost := range due {
+			ids = append(ids, blogPost.ID)
+		}
+		return tx.Model(&models.BlogPost{}).
+			Where("id IN ?", ids).
+			Updates(map[string]any{
+				"status":     models.BlogPostStatusPublished,
+				"date_added": gorm.Expr("publish_at"),
+				"publish_at": nil,
+			}).Error
+	})
+	if err != nil || len(due) == 0 {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(due))
+	for _, blogPost := range due {
+		ids = append(ids, blogPost.ID)
+	}
+	var publishedPosts []*models.BlogPost
+	err = r.db.Preload("Tags").Where("id IN ?", ids).Order("date_added").Find(&publishedPosts).Error
+	return publishedPosts, err
+}
+
 // Add inserts a new blog post into the database
 func (r *BlogPostRepo) Add(blogPost *models.BlogPost) error {
 	return r.db.Create(blogPost).Error
 }
 
-// Update updates an existing blog post in the database
+// Update updates an existing blog post in the database. Its images are left as they are, since they're changed
+// through BlogPostImageRepo
 func (r *BlogPostRepo) Update(blogPost *models.BlogPost) error {
-	return r.db.Save(blogPost).Error
+	return r.db.Omit("Images").Save(blogPost).Error
 }
 
-// Delete removes a blog post from the database by id
+// Delete moves a blog post to the trash by id, where it's kept, tags and all, until restored or deleted permanently
 func (r *BlogPostRepo) Delete(id uuid.UUID) error {
 	return r.db.Delete(&models.BlogPost{}, id).Error
 }
+
+// DeletePermanently removes a blog post, whether in the trash or not, and its tags from the database by id
+// gorm.ErrRecordNotFound is returned when there's no such blog post
+func (r *BlogPostRepo) DeletePermanently(id uuid.UUID) error {
+	result := r.db.Unscoped().Delete(&models.BlogPost{}, id)
+	if result.Error == nil && result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return result.Error
+}
+
+// Restore takes a blog post out of the trash by id
+// gorm.ErrRecordNotFound is returned when it isn't in the trash
+func (r *BlogPostRepo) Restore(id uuid.UUID) error {
+	result := r.db.Unscoped().Model(&models.BlogPost{}).Where("id = ? AND deleted_at IS NOT NULL", id).Update("deleted_at", nil)
+	if result.Error == nil && result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return result.Error
+}
+
+// FindTrashPage returns one page of the blog posts in the trash, most recently deleted first, along with how many there are
+func (r *BlogPostRepo) FindTrashPage(offset, limit int) ([]*models.BlogPost, int64, error) {
+	var total int64
+	if err := r.db.Unscoped().Model(&models.BlogPost{}).Where("deleted_at IS NOT NULL").Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var blogPosts []*models.BlogPost
+	err := r.db.Unscoped().Preload("Tags").
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Order("id").
+		Offset(offset).
+		Limit(pageLimit(limit)).
+		Find(&blogPosts).Error
+	return blogPosts, total, err
+}
+
+// FindBefore returns up to limit published blog posts older than cursor, newest first
+func (r *BlogPostRepo) FindBefore(cursor *Cursor, limit int) ([]*models.BlogPost, error) {
+	var blogPosts []*models.BlogPost
+	err := before(published(r.db.Preload("Tags")), "date_added", cursor).
+		Order("date_added DESC").
+		Order("id DESC").
+		Limit(limit).
+		Find(&blogPosts).Error
+	return blogPosts, err
+}
+
+// FindEditedBefore returns up to limit published blog posts that have been edited, edited before cursor, most recently edited first
+func (r *BlogPostRepo) FindEditedBefore(cursor *Cursor, limit int) ([]*models.BlogPost, error) {
+	var blogPosts []*models.BlogPost
+	err := before(published(r.db.Preload("Tags")).Where("date_edited IS NOT NULL"), "date_edited", cursor).
+		Order("date_edited DESC").
+		Order("id DESC").
+		Limit(limit).
+		Find(&blogPosts).Error
+	return blogPosts, err
+}
+
+// DeleteMatching moves every blog post matching filter to the trash in a single transaction and returns their IDs
+func (r *BlogPostRepo) DeleteMatching(filter ContentFilter) ([]uuid.UUID, error) {
+	if filter.IsEmpty() {
+		return nil, nil
+	}
+
+	var ids []uuid.UUID
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		query := applyContentFilter(tx.Model(&models.BlogPost{}), filter, "blog_tags", "blog_post_id")
+		if err := query.Clauses(clause.Locking{Strength: "UPDATE"}).Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		return tx.Delete(&models.BlogPost{}, ids).Error
+	})
+	return ids, err
+}
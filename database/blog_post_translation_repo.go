@@ -0,0 +1,92 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BlogPostTranslationRepo struct {
+	db *gorm.DB
+}
+
+func NewBlogPostTranslationRepo(db *gorm.DB) *BlogPostTranslationRepo {
+	return &BlogPostTranslationRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *BlogPostTranslationRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindForBlogPost returns every translation of a blog post, ordered by locale
+func (r *BlogPostTranslationRepo) FindForBlogPost(blogPostID uuid.UUID) ([]*models.BlogPostTranslation, error) {
+	var translations []*models.BlogPostTranslation
+	err := r.db.Where("blog_post_id = ?", blogPostID).Order("locale ASC").Find(&translations).Error
+	return translations, err
+}
+
+// FindByLocale returns a blog post's translation into locale
+func (r *BlogPostTranslationRepo) FindByLocale(blogPostID uuid.UUID, locale string) (*models.BlogPostTranslation, error) {
+	var translation models.BlogPostTranslation
+	err := r.db.Where("blog_post_id = ? AND locale = ?", blogPostID, locale).First(&translation).Error
+	if err != nil {
+		return nil, err
+	}
+	return &translation, nil
+}
+
+// FindForBlogPosts returns the translations into locale of the blog posts with ids, keyed by blog post ID. Posts that
+// aren't translated are left out. Content isn't loaded unless withContent, since list views don't show it
+func (r *BlogPostTranslationRepo) FindForBlogPosts(ids []uuid.UUID, locale string, withContent bool) (map[uuid.UUID]models.BlogPostTranslation, error) {
+	translations := make(map[uuid.UUID]models.BlogPostTranslation, len(ids))
+	if len(ids) == 0 {
+		return translations, nil
+	}
+
+	query := r.db.Where("blog_post_id IN ? AND locale = ?", ids, locale)
+	if !withContent {
+		query = query.Omit("content", "content_html")
+	}
+	var found []models.BlogPostTranslation
+	if err := query.Find(&found).Error; err != nil {
+		return nil, err
+	}
+	for _, translation := range found {
+		translations[translation.BlogPostID] = translation
+	}
+	return translations, nil
+}
+
+// FindLocales returns the locales each blog post is translated into, keyed by blog post ID
+func (r *BlogPostTranslationRepo) FindLocales() (map[uuid.UUID][]string, error) {
+	var rows []struct {
+		BlogPostID uuid.UUID
+		Locale     string
+	}
+	err := r.db.Model(&models.BlogPostTranslation{}).Select("blog_post_id", "locale").Order("locale ASC").Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	locales := make(map[uuid.UUID][]string)
+	for _, row := range rows {
+		locales[row.BlogPostID] = append(locales[row.BlogPostID], row.Locale)
+	}
+	return locales, nil
+}
+
+// Save adds translation, or replaces the title, summary and content of the post's existing translation into the same
+// locale, filling in translation's ID either way
+func (r *BlogPostTranslationRepo) Save(translation *models.BlogPostTranslation) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "blog_post_id"}, {Name: "locale"}},
+		DoUpdates: clause.AssignmentColumns([]string{"title", "summary", "content", "date_edited"}),
+	}).Create(translation).Error
+}
+
+// Delete removes a blog post's translation into locale
+func (r *BlogPostTranslationRepo) Delete(blogPostID uuid.UUID, locale string) error {
+	return r.db.Where("blog_post_id = ? AND locale = ?", blogPostID, locale).Delete(&models.BlogPostTranslation{}).Error
+}
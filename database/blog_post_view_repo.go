@@ -0,0 +1,62 @@
+package database
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type BlogPostViewRepo struct {
+	db *gorm.DB
+}
+
+func NewBlogPostViewRepo(db *gorm.DB) *BlogPostViewRepo {
+	return &BlogPostViewRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *BlogPostViewRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// BlogPostViewCount is how many views one blog post got
+type BlogPostViewCount struct {
+	BlogPostID uuid.UUID
+	Views      int64
+}
+
+// AddUnlessSeen records a view of a blog post by visitorHash at viewedAt, unless the same visitor already has a
+// view of it in the window before then. It reports whether the view was recorded. The selected values are cast, as
+// Postgres would otherwise take them for text
+func (r *BlogPostViewRepo) AddUnlessSeen(blogPostID uuid.UUID, visitorHash string, viewedAt time.Time, window time.Duration) (bool, error) {
+	result := r.db.Exec(
+		"INSERT INTO blog_post_views (blog_post_id, visitor_hash, viewed_at) SELECT CAST(? AS uuid), ?, CAST(? AS timestamp) WHERE NOT EXISTS "+
+			"(SELECT 1 FROM blog_post_views WHERE blog_post_id = ? AND visitor_hash = ? AND viewed_at > ?)",
+		blogPostID, visitorHash, viewedAt, blogPostID, visitorHash, viewedAt.Add(-window),
+	)
+	return result.RowsAffected > 0, result.Error
+}
+
+// Count returns how many views a blog post has had
+func (r *BlogPostViewRepo) Count(blogPostID uuid.UUID) (int64, error) {
+	var views int64
+	err := r.db.Model(&models.BlogPostView{}).Where("blog_post_id = ?", blogPostID).Count(&views).Error
+	return views, err
+}
+
+// FindMostViewedSince returns the limit published blog posts with the most views on or after since, most viewed
+// first. Posts in the trash and those without views are left out
+func (r *BlogPostViewRepo) FindMostViewedSince(since time.Time, limit int) ([]BlogPostViewCount, error) {
+	var counts []BlogPostViewCount
+	err := r.db.Model(&models.BlogPostView{}).
+		Select("blog_post_views.blog_post_id, COUNT(*) AS views").
+		Joins("JOIN blog_posts ON blog_posts.id = blog_post_views.blog_post_id").
+		Where("blog_post_views.viewed_at >= ? AND blog_posts.status = ? AND blog_posts.deleted_at IS NULL", since, models.BlogPostStatusPublished).
+		Group("blog_post_views.blog_post_id").
+		Order("views DESC, blog_post_views.blog_post_id").
+		Limit(pageLimit(limit)).
+		Scan(&counts).Error
+	return counts, err
+}
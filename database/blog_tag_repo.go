@@ -1,8 +1,10 @@
 package database
 
 import (
+	"github.com/google/uuid"
 	"github.com/rpupo63/unified-personal-site-backend/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type BlogTagRepo struct {
@@ -25,11 +27,29 @@ func (r *BlogTagRepo) FindAll() ([]*models.BlogTag, error) {
 	return blogTags, err
 }
 
+// FindValues returns the tag values of each of the blog posts with blogPostIDs, keyed by blog post ID
+func (r *BlogTagRepo) FindValues(blogPostIDs []uuid.UUID) (map[uuid.UUID][]string, error) {
+	return findTagValues(r.db, "blog_tags", "blog_post_id", blogPostIDs)
+}
+
 // Add inserts a new blog tag into the database
 func (r *BlogTagRepo) Add(blogTag *models.BlogTag) error {
 	return r.db.Create(blogTag).Error
 }
 
+// AddAll inserts the given blog post tags, skipping any the post already has
+func (r *BlogTagRepo) AddAll(blogTags []models.BlogTag) error {
+	if len(blogTags) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Omit(clause.Associations).Create(&blogTags).Error
+}
+
+// DeleteAllFor removes every tag of the blog post with blogPostID
+func (r *BlogTagRepo) DeleteAllFor(blogPostID uuid.UUID) error {
+	return r.db.Where("blog_post_id = ?", blogPostID).Delete(&models.BlogTag{}).Error
+}
+
 // Delete removes a blog tag from the database by blog_id and value
 func (r *BlogTagRepo) Delete(blogID string, value string) error {
 	return r.db.Where("blog_id = ? AND value = ?", blogID, value).Delete(&models.BlogTag{}).Error
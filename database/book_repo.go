@@ -0,0 +1,66 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type BookRepo struct {
+	db *gorm.DB
+}
+
+func NewBookRepo(db *gorm.DB) *BookRepo {
+	return &BookRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *BookRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindAll returns all books, most recently added first
+func (r *BookRepo) FindAll() ([]*models.Book, error) {
+	var books []*models.Book
+	err := r.db.Order("date_added DESC").Find(&books).Error
+	return books, err
+}
+
+// FindByStatus returns the books with the given reading status
+// Finished books are ordered by when they were finished, others by when they were started or added
+func (r *BookRepo) FindByStatus(status string) ([]*models.Book, error) {
+	var books []*models.Book
+	query := r.db.Where("status = ?", status)
+	if status == models.BookStatusFinished {
+		query = query.Order("date_finished DESC NULLS LAST")
+	} else {
+		query = query.Order("date_started DESC NULLS LAST")
+	}
+	err := query.Order("date_added DESC").Find(&books).Error
+	return books, err
+}
+
+// FindByID returns a book by its ID
+func (r *BookRepo) FindByID(id uuid.UUID) (*models.Book, error) {
+	var book models.Book
+	err := r.db.First(&book, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &book, nil
+}
+
+// Add inserts a new book into the database
+func (r *BookRepo) Add(book *models.Book) error {
+	return r.db.Create(book).Error
+}
+
+// Update updates an existing book in the database
+func (r *BookRepo) Update(book *models.Book) error {
+	return r.db.Save(book).Error
+}
+
+// Delete removes a book from the database by id
+func (r *BookRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Book{}, id).Error
+}
@@ -0,0 +1,77 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type BookmarkRepo struct {
+	db *gorm.DB
+}
+
+func NewBookmarkRepo(db *gorm.DB) *BookmarkRepo {
+	return &BookmarkRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *BookmarkRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindPage returns one page of bookmarks, newest first, along with the total number of bookmarks
+func (r *BookmarkRepo) FindPage(offset, limit int) ([]*models.Bookmark, int64, error) {
+	var total int64
+	if err := r.db.Model(&models.Bookmark{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var bookmarks []*models.Bookmark
+	err := r.db.Preload("Tags").
+		Order("date_added DESC").
+		Order("id DESC").
+		Offset(offset).
+		Limit(pageLimit(limit)).
+		Find(&bookmarks).Error
+	return bookmarks, total, err
+}
+
+// FindByID returns a bookmark by its ID
+func (r *BookmarkRepo) FindByID(id uuid.UUID) (*models.Bookmark, error) {
+	var bookmark models.Bookmark
+	err := r.db.Preload("Tags").First(&bookmark, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &bookmark, nil
+}
+
+// Add inserts a new bookmark and its tags into the database
+func (r *BookmarkRepo) Add(bookmark *models.Bookmark) error {
+	return r.db.Create(bookmark).Error
+}
+
+// Update updates an existing bookmark and replaces its tags
+func (r *BookmarkRepo) Update(bookmark *models.Bookmark) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Tags").Save(bookmark).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("bookmark_id = ?", bookmark.ID).Delete(&models.BookmarkTag{}).Error; err != nil {
+			return err
+		}
+		if len(bookmark.Tags) == 0 {
+			return nil
+		}
+		for i := range bookmark.Tags {
+			bookmark.Tags[i].ID = uuid.New()
+			bookmark.Tags[i].BookmarkID = bookmark.ID
+		}
+		return tx.Create(&bookmark.Tags).Error
+	})
+}
+
+// Delete removes a bookmark from the database by id
+func (r *BookmarkRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Bookmark{}, id).Error
+}
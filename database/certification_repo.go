@@ -0,0 +1,68 @@
+package database
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type CertificationRepo struct {
+	db *gorm.DB
+}
+
+func NewCertificationRepo(db *gorm.DB) *CertificationRepo {
+	return &CertificationRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *CertificationRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindAll returns all certifications, most recently issued first
+func (r *CertificationRepo) FindAll() ([]*models.Certification, error) {
+	var certifications []*models.Certification
+	err := r.db.Order("issue_date DESC").Find(&certifications).Error
+	return certifications, err
+}
+
+// FindValidAt returns the certifications that have not expired at the given time, most recently issued first
+func (r *CertificationRepo) FindValidAt(at time.Time) ([]*models.Certification, error) {
+	var certifications []*models.Certification
+	err := r.db.Where("expiry_date IS NULL OR expiry_date >= ?", at).Order("issue_date DESC").Find(&certifications).Error
+	return certifications, err
+}
+
+// FindExpiringBefore returns the certifications that expire on or before cutoff, including already expired ones, soonest first
+func (r *CertificationRepo) FindExpiringBefore(cutoff time.Time) ([]*models.Certification, error) {
+	var certifications []*models.Certification
+	err := r.db.Where("expiry_date IS NOT NULL AND expiry_date <= ?", cutoff).Order("expiry_date ASC").Find(&certifications).Error
+	return certifications, err
+}
+
+// FindByID returns a certification by its ID
+func (r *CertificationRepo) FindByID(id uuid.UUID) (*models.Certification, error) {
+	var certification models.Certification
+	err := r.db.First(&certification, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &certification, nil
+}
+
+// Add inserts a new certification into the database
+func (r *CertificationRepo) Add(certification *models.Certification) error {
+	return r.db.Create(certification).Error
+}
+
+// Update updates an existing certification in the database
+func (r *CertificationRepo) Update(certification *models.Certification) error {
+	return r.db.Save(certification).Error
+}
+
+// Delete removes a certification from the database by id
+func (r *CertificationRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Certification{}, id).Error
+}
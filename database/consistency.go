@@ -0,0 +1,236 @@
+package database
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+// Kinds of problem CheckConsistency reports
+const (
+	IssueOrphanedTag        = "orphanedTag"        // A tag whose blog post, project or bookmark no longer exists
+	IssueWordCountMismatch  = "wordCountMismatch"  // A blog post whose word count or reading time doesn't match its content
+	IssueDanglingSocialPost = "danglingSocialPost" // A social post record for a blog post or note that no longer exists
+)
+
+// ConsistencyIssue is one problem found in the stored data
+type ConsistencyIssue struct {
+	Kind    string
+	Table   string
+	ID      uuid.UUID
+	Detail  string
+	Fixable bool // Whether FixConsistency can repair it without losing anything that's still referenced
+}
+
+// MediaReference is an image URL stored on a row, either in a column or embedded in a blog post's content
+type MediaReference struct {
+	Table string
+	ID    uuid.UUID
+	Field string
+	URL   string
+}
+
+// orphanedTagTables are the tag tables checked for orphans, with the table and column their rows point at
+var orphanedTagTables = []struct {
+	table, parent, column string
+}{
+	{"blog_tags", "blog_posts", "blog_post_id"},
+	{"project_tags", "projects", "project_id"},
+	{"bookmark_tags", "bookmarks", "bookmark_id"},
+}
+
+// socialPostContentTables are the tables social post records point at, keyed by content type
+var socialPostContentTables = map[string]string{
+	models.ContentTypeBlogPost: "blog_posts",
+	models.ContentTypeNote:     "notes",
+}
+
+// CheckConsistency looks for data the foreign keys and handlers should have kept consistent but didn't, e.g. rows
+// left behind by a manual delete or written before a constraint existed. It only reads
+func (d Database) CheckConsistency() ([]ConsistencyIssue, error) {
+	var issues []ConsistencyIssue
+
+	for _, tags := range orphanedTagTables {
+		var orphans []struct {
+			ID    uuid.UUID
+			Value string
+		}
+		query := fmt.Sprintf("SELECT t.id, t.value FROM %s t LEFT JOIN %s p ON p.id = t.%s WHERE p.id IS NULL", tags.table, tags.parent, tags.column)
+		if err := d.db.Raw(query).Scan(&orphans).Error; err != nil {
+			return nil, fmt.Errorf("failed to find orphaned %s: %w", tags.table, err)
+		}
+		for _, orphan := range orphans {
+			issues = append(issues, ConsistencyIssue{
+				Kind:    IssueOrphanedTag,
+				Table:   tags.table,
+				ID:      orphan.ID,
+				Detail:  fmt.Sprintf("tag %q points at a missing row in %s", orphan.Value, tags.parent),
+				Fixable: true,
+			})
+		}
+	}
+
+	// Words are counted in Go, so every post's content is read, a batch at a time
+	var batch []*models.BlogPost
+	err := d.db.Unscoped().Select("id", "title", "content", "word_count", "reading_time_minutes").FindInBatches(&batch, 100, func(_ *gorm.DB, _ int) error {
+		for _, blogPost := range batch {
+			measured := *blogPost
+			measured.Measure()
+			if measured.WordCount == blogPost.WordCount && measured.ReadingTimeMinutes == blogPost.ReadingTimeMinutes {
+				continue
+			}
+			issues = append(issues, ConsistencyIssue{
+				Kind:    IssueWordCountMismatch,
+				Table:   "blog_posts",
+				ID:      blogPost.ID,
+				Detail:  fmt.Sprintf("%q has %d words and a %d minute reading time but its content has %d words", blogPost.Title, blogPost.WordCount, blogPost.ReadingTimeMinutes, measured.WordCount),
+				Fixable: true,
+			})
+		}
+		return nil
+	}).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blog posts with the wrong word count: %w", err)
+	}
+
+	for _, contentType := range []string{models.ContentTypeBlogPost, models.ContentTypeNote} {
+		table := socialPostContentTables[contentType]
+		var dangling []models.SocialPost
+		query := fmt.Sprintf("SELECT s.id, s.content_id, s.platform FROM social_posts s LEFT JOIN %s c ON c.id = s.content_id WHERE s.content_type = ? AND c.id IS NULL", table)
+		if err := d.db.Raw(query, contentType).Scan(&dangling).Error; err != nil {
+			return nil, fmt.Errorf("failed to find dangling social posts: %w", err)
+		}
+		for _, socialPost := range dangling {
+			issues = append(issues, ConsistencyIssue{
+				Kind:    IssueDanglingSocialPost,
+				Table:   "social_posts",
+				ID:      socialPost.ID,
+				Detail:  fmt.Sprintf("%s post for %s %s, which no longer exists", socialPost.Platform, contentType, socialPost.ContentID),
+				Fixable: true,
+			})
+		}
+	}
+
+	return issues, nil
+}
+
+// FixConsistency repairs the fixable issues: it deletes orphaned tags and dangling social post records and recounts
+// blog post words. Rows that were fixed in the meantime are left alone. It returns how many rows it changed
+func (d Database) FixConsistency(issues []ConsistencyIssue) (int, error) {
+	idsByKindAndTable := make(map[[2]string][]uuid.UUID)
+	for _, issue := range issues {
+		if issue.Fixable {
+			key := [2]string{issue.Kind, issue.Table}
+			idsByKindAndTable[key] = append(idsByKindAndTable[key], issue.ID)
+		}
+	}
+
+	fixed := 0
+	err := d.db.Transaction(func(tx *gorm.DB) error {
+		for _, tags := range orphanedTagTables {
+			ids := idsByKindAndTable[[2]string{IssueOrphanedTag, tags.table}]
+			if len(ids) == 0 {
+				continue
+			}
+			query := fmt.Sprintf("DELETE FROM %s t WHERE t.id IN ? AND NOT EXISTS (SELECT 1 FROM %s p WHERE p.id = t.%s)", tags.table, tags.parent, tags.column)
+			result := tx.Exec(query, ids)
+			if result.Error != nil {
+				return fmt.Errorf("failed to delete orphaned %s: %w", tags.table, result.Error)
+			}
+			fixed += int(result.RowsAffected)
+		}
+
+		if ids := idsByKindAndTable[[2]string{IssueWordCountMismatch, "blog_posts"}]; len(ids) > 0 {
+			var blogPosts []*models.BlogPost
+			if err := tx.Unscoped().Select("id", "content", "word_count", "reading_time_minutes").Where("id IN ?", ids).Find(&blogPosts).Error; err != nil {
+				return fmt.Errorf("failed to find blog posts to recount: %w", err)
+			}
+			for _, blogPost := range blogPosts {
+				measured := *blogPost
+				measured.Measure()
+				if measured.WordCount == blogPost.WordCount && measured.ReadingTimeMinutes == blogPost.ReadingTimeMinutes {
+					continue
+				}
+				result := tx.Unscoped().Model(&models.BlogPost{}).Where("id = ?", blogPost.ID).UpdateColumns(map[string]any{
+					"word_count":           measured.WordCount,
+					"reading_time_minutes": measured.ReadingTimeMinutes,
+				})
+				if result.Error != nil {
+					return fmt.Errorf("failed to recount blog post words: %w", result.Error)
+				}
+				fixed += int(result.RowsAffected)
+			}
+		}
+
+		if ids := idsByKindAndTable[[2]string{IssueDanglingSocialPost, "social_posts"}]; len(ids) > 0 {
+			for contentType, table := range socialPostContentTables {
+				query := fmt.Sprintf("DELETE FROM social_posts s WHERE s.id IN ? AND s.content_type = ? AND NOT EXISTS (SELECT 1 FROM %s c WHERE c.id = s.content_id)", table)
+				result := tx.Exec(query, ids, contentType)
+				if result.Error != nil {
+					return fmt.Errorf("failed to delete dangling social posts: %w", result.Error)
+				}
+				fixed += int(result.RowsAffected)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return fixed, nil
+}
+
+// contentImagePattern matches the images in a blog post's content, written either as markdown or as HTML
+var contentImagePattern = regexp.MustCompile(`!\[[^\]]*\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)|<img[^>]+src=["']([^"']+)["']`)
+
+// MediaReferences lists every image URL stored on notes, bookmarks and projects or embedded in blog post content,
+// for the caller to check they still load
+func (d Database) MediaReferences() ([]MediaReference, error) {
+	var references []MediaReference
+
+	var notes []models.Note
+	if err := d.db.Select("id", "image_url").Where("image_url IS NOT NULL AND image_url <> ''").Find(&notes).Error; err != nil {
+		return nil, fmt.Errorf("failed to find note images: %w", err)
+	}
+	for _, note := range notes {
+		references = append(references, MediaReference{Table: "notes", ID: note.ID, Field: "image_url", URL: *note.ImageURL})
+	}
+
+	var bookmarks []models.Bookmark
+	if err := d.db.Select("id", "image_url").Where("image_url IS NOT NULL AND image_url <> ''").Find(&bookmarks).Error; err != nil {
+		return nil, fmt.Errorf("failed to find bookmark images: %w", err)
+	}
+	for _, bookmark := range bookmarks {
+		references = append(references, MediaReference{Table: "bookmarks", ID: bookmark.ID, Field: "image_url", URL: *bookmark.ImageURL})
+	}
+
+	var projects []models.Project
+	if err := d.db.Select("id", "gif_link").Where("gif_link IS NOT NULL AND gif_link <> ''").Find(&projects).Error; err != nil {
+		return nil, fmt.Errorf("failed to find project gifs: %w", err)
+	}
+	for _, project := range projects {
+		references = append(references, MediaReference{Table: "projects", ID: project.ID, Field: "gif_link", URL: *project.GifLink})
+	}
+
+	err := d.blogPostRepo.FindInBatches(100, func(blogPosts []*models.BlogPost) error {
+		for _, blogPost := range blogPosts {
+			seen := make(map[string]bool)
+			for _, match := range contentImagePattern.FindAllStringSubmatch(blogPost.Content, -1) {
+				imageURL := match[1] + match[2]
+				if seen[imageURL] {
+					continue
+				}
+				seen[imageURL] = true
+				references = append(references, MediaReference{Table: "blog_posts", ID: blogPost.ID, Field: "content", URL: imageURL})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blog post images: %w", err)
+	}
+	return references, nil
+}
@@ -0,0 +1,61 @@
+package database
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ContentFilter selects blog posts or projects for bulk operations
+// Every set field must match; an empty filter matches nothing so a bulk delete can never wipe a table by accident
+type ContentFilter struct {
+	IDs         []uuid.UUID
+	Tag         string
+	AddedBefore *time.Time
+	AddedAfter  *time.Time
+}
+
+// IsEmpty reports whether no criteria are set
+func (f ContentFilter) IsEmpty() bool {
+	return len(f.IDs) == 0 && f.Tag == "" && f.AddedBefore == nil && f.AddedAfter == nil
+}
+
+// applyContentFilter adds the filter's conditions to query
+// tagTable and tagForeignKey name the tag table and its column referencing the content row
+func applyContentFilter(query *gorm.DB, filter ContentFilter, tagTable, tagForeignKey string) *gorm.DB {
+	if len(filter.IDs) > 0 {
+		query = query.Where("id IN ?", filter.IDs)
+	}
+	if filter.Tag != "" {
+		query = query.Where("id IN (?)", query.Session(&gorm.Session{NewDB: true}).
+			Table(tagTable).Select(tagForeignKey).Where("value = ?", filter.Tag))
+	}
+	if filter.AddedBefore != nil {
+		query = query.Where("date_added < ?", *filter.AddedBefore)
+	}
+	if filter.AddedAfter != nil {
+		query = query.Where("date_added > ?", *filter.AddedAfter)
+	}
+	return query
+}
+
+// TagFilter limits a list to content tagged with any of Tags, or with every one of them when MatchAll is set
+// An empty filter matches everything. Tags are expected to be distinct
+type TagFilter struct {
+	Tags     []string
+	MatchAll bool
+}
+
+// applyTagFilter adds the filter's condition to query, with tagTable and tagForeignKey as in applyContentFilter
+func applyTagFilter(query *gorm.DB, filter TagFilter, tagTable, tagForeignKey string) *gorm.DB {
+	if len(filter.Tags) == 0 {
+		return query
+	}
+	tagged := query.Session(&gorm.Session{NewDB: true}).
+		Table(tagTable).Select(tagForeignKey).Where("value IN ?", filter.Tags)
+	if filter.MatchAll {
+		tagged = tagged.Group(tagForeignKey).Having("COUNT(DISTINCT value) = ?", len(filter.Tags))
+	}
+	return query.Where("id IN (?)", tagged)
+}
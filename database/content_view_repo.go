@@ -0,0 +1,44 @@
+package database
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ContentViewRepo struct {
+	db *gorm.DB
+}
+
+func NewContentViewRepo(db *gorm.DB) *ContentViewRepo {
+	return &ContentViewRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *ContentViewRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// Increment adds views to the day's count for a piece of content, creating the row on its first view that day
+func (r *ContentViewRepo) Increment(contentType string, contentID uuid.UUID, day time.Time, views int64) error {
+	contentView := models.ContentView{
+		ContentType: contentType,
+		ContentID:   contentID,
+		Day:         day.UTC().Truncate(24 * time.Hour),
+		Views:       views,
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "content_type"}, {Name: "content_id"}, {Name: "day"}},
+		DoUpdates: clause.Assignments(map[string]any{"views": gorm.Expr("content_views.views + EXCLUDED.views")}),
+	}).Create(&contentView).Error
+}
+
+// FindSince returns every daily count on or after since
+func (r *ContentViewRepo) FindSince(since time.Time) ([]*models.ContentView, error) {
+	var contentViews []*models.ContentView
+	err := r.db.Where("day >= ?", since.UTC().Truncate(24*time.Hour)).Find(&contentViews).Error
+	return contentViews, err
+}
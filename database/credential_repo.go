@@ -0,0 +1,38 @@
+package database
+
+import (
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CredentialRepo struct {
+	db *gorm.DB
+}
+
+func NewCredentialRepo(db *gorm.DB) *CredentialRepo {
+	return &CredentialRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *CredentialRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindAll returns every stored credential
+func (r *CredentialRepo) FindAll() ([]models.Credential, error) {
+	var credentials []models.Credential
+	err := r.db.Find(&credentials).Error
+	return credentials, err
+}
+
+// Save stores credential, replacing the value and expiry of any credential already stored under its name
+func (r *CredentialRepo) Save(credential *models.Credential) error {
+	credential.DateUpdated = time.Now()
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"platform", "value", "expires_at", "date_updated"}),
+	}).Create(credential).Error
+}
@@ -0,0 +1,34 @@
+package database
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Cursor marks a position in a feed ordered by a timestamp column and then id, both descending
+type Cursor struct {
+	Time time.Time
+	ID   uuid.UUID
+}
+
+// before restricts query to rows that sort after cursor in (column DESC, id DESC) order
+// A nil cursor leaves the query unchanged so the feed starts from the newest row
+func before(query *gorm.DB, column string, cursor *Cursor) *gorm.DB {
+	if cursor == nil {
+		return query
+	}
+	return query.Where("("+column+", id) < (?, ?)", cursor.Time, cursor.ID)
+}
+
+// trimToCursorPage cuts rows, fetched with one more than limit, back to limit, returning the cursor of the last row
+// kept when there was a row past it and nil when rows was the last page
+func trimToCursorPage[T any](rows []T, limit int, position func(row T) Cursor) ([]T, *Cursor) {
+	if len(rows) <= limit {
+		return rows, nil
+	}
+	rows = rows[:limit]
+	next := position(rows[limit-1])
+	return rows, &next
+}
@@ -5,23 +5,115 @@ import (
 	"gorm.io/gorm"
 )
 
+// Database holds the repositories backed by one connection or transaction
+// Finders that return a single row, like FindByID, return gorm.ErrRecordNotFound when nothing matches,
+// which errs.NewDatabaseError turns into a 404
 type Database struct {
-	blogPostRepo   *BlogPostRepo
-	blogTagRepo    *BlogTagRepo
-	projectRepo    *ProjectRepo
-	projectTagRepo *ProjectTagRepo
+	db                      *gorm.DB
+	blogPostRepo            *BlogPostRepo
+	blogTagRepo             *BlogTagRepo
+	projectRepo             *ProjectRepo
+	projectTagRepo          *ProjectTagRepo
+	workExperienceRepo      *WorkExperienceRepo
+	educationRepo           *EducationRepo
+	skillRepo               *SkillRepo
+	testimonialRepo         *TestimonialRepo
+	usesItemRepo            *UsesItemRepo
+	bookmarkRepo            *BookmarkRepo
+	noteRepo                *NoteRepo
+	guestbookEntryRepo      *GuestbookEntryRepo
+	bookRepo                *BookRepo
+	certificationRepo       *CertificationRepo
+	faqRepo                 *FAQRepo
+	idempotencyKeyRepo      *IdempotencyKeyRepo
+	webhookRepo             *WebhookRepo
+	webhookDeliveryRepo     *WebhookDeliveryRepo
+	contentViewRepo         *ContentViewRepo
+	pageViewRepo            *PageViewRepo
+	socialPostRepo          *SocialPostRepo
+	visitorSaltRepo         *VisitorSaltRepo
+	shareLinkRepo           *ShareLinkRepo
+	notionPageRepo          *NotionPageRepo
+	mediaFileRepo           *MediaFileRepo
+	mediaObjectRepo         *MediaObjectRepo
+	projectImageRepo        *ProjectImageRepo
+	credentialRepo          *CredentialRepo
+	shortLinkRepo           *ShortLinkRepo
+	snippetRepo             *SnippetRepo
+	blogPostTranslationRepo *BlogPostTranslationRepo
+	blogPostViewRepo        *BlogPostViewRepo
+	seriesRepo              *SeriesRepo
+	blogPostImageRepo       *BlogPostImageRepo
 }
 
 // New initializes a new Database struct with each repository using a shared GORM database instance
 func New(db *gorm.DB) Database {
 	return Database{
-		blogPostRepo:   NewBlogPostRepo(db),
-		blogTagRepo:    NewBlogTagRepo(db),
-		projectRepo:    NewProjectRepo(db),
-		projectTagRepo: NewProjectTagRepo(db),
+		db:                      db,
+		blogPostRepo:            NewBlogPostRepo(db),
+		blogTagRepo:             NewBlogTagRepo(db),
+		projectRepo:             NewProjectRepo(db),
+		projectTagRepo:          NewProjectTagRepo(db),
+		workExperienceRepo:      NewWorkExperienceRepo(db),
+		educationRepo:           NewEducationRepo(db),
+		skillRepo:               NewSkillRepo(db),
+		testimonialRepo:         NewTestimonialRepo(db),
+		usesItemRepo:            NewUsesItemRepo(db),
+		bookmarkRepo:            NewBookmarkRepo(db),
+		noteRepo:                NewNoteRepo(db),
+		guestbookEntryRepo:      NewGuestbookEntryRepo(db),
+		bookRepo:                NewBookRepo(db),
+		certificationRepo:       NewCertificationRepo(db),
+		faqRepo:                 NewFAQRepo(db),
+		idempotencyKeyRepo:      NewIdempotencyKeyRepo(db),
+		webhookRepo:             NewWebhookRepo(db),
+		webhookDeliveryRepo:     NewWebhookDeliveryRepo(db),
+		contentViewRepo:         NewContentViewRepo(db),
+		pageViewRepo:            NewPageViewRepo(db),
+		socialPostRepo:          NewSocialPostRepo(db),
+		visitorSaltRepo:         NewVisitorSaltRepo(db),
+		shareLinkRepo:           NewShareLinkRepo(db),
+		notionPageRepo:          NewNotionPageRepo(db),
+		mediaFileRepo:           NewMediaFileRepo(db),
+		mediaObjectRepo:         NewMediaObjectRepo(db),
+		projectImageRepo:        NewProjectImageRepo(db),
+		credentialRepo:          NewCredentialRepo(db),
+		shortLinkRepo:           NewShortLinkRepo(db),
+		snippetRepo:             NewSnippetRepo(db),
+		blogPostTranslationRepo: NewBlogPostTranslationRepo(db),
+		blogPostViewRepo:        NewBlogPostViewRepo(db),
+		seriesRepo:              NewSeriesRepo(db),
+		blogPostImageRepo:       NewBlogPostImageRepo(db),
 	}
 }
 
+// Transaction runs fn with a Database whose repositories all share a single transaction
+// The transaction is committed if fn returns nil and rolled back otherwise
+func (d Database) Transaction(fn func(tx Database) error) error {
+	return d.db.Transaction(func(tx *gorm.DB) error {
+		return fn(New(tx))
+	})
+}
+
+// OnWrite calls fn with the table written to after every successful create, update and delete, including those in
+// transactions, so caches of query results can drop what a write made stale. name identifies the hooks, and
+// registering the same name twice replaces them
+func (d Database) OnWrite(name string, fn func(table string)) error {
+	hook := func(db *gorm.DB) {
+		if db.Error == nil && db.Statement.Table != "" {
+			fn(db.Statement.Table)
+		}
+	}
+	callbacks := d.db.Callback()
+	if err := callbacks.Create().After("gorm:create").Register(name+":create", hook); err != nil {
+		return err
+	}
+	if err := callbacks.Update().After("gorm:update").Register(name+":update", hook); err != nil {
+		return err
+	}
+	return callbacks.Delete().After("gorm:delete").Register(name+":delete", hook)
+}
+
 // Accessor methods for each repository
 
 func (d Database) BlogPostRepo() *BlogPostRepo {
@@ -40,6 +132,126 @@ func (d Database) ProjectTagRepo() *ProjectTagRepo {
 	return d.projectTagRepo
 }
 
+func (d Database) WorkExperienceRepo() *WorkExperienceRepo {
+	return d.workExperienceRepo
+}
+
+func (d Database) EducationRepo() *EducationRepo {
+	return d.educationRepo
+}
+
+func (d Database) SkillRepo() *SkillRepo {
+	return d.skillRepo
+}
+
+func (d Database) TestimonialRepo() *TestimonialRepo {
+	return d.testimonialRepo
+}
+
+func (d Database) UsesItemRepo() *UsesItemRepo {
+	return d.usesItemRepo
+}
+
+func (d Database) BookmarkRepo() *BookmarkRepo {
+	return d.bookmarkRepo
+}
+
+func (d Database) NoteRepo() *NoteRepo {
+	return d.noteRepo
+}
+
+func (d Database) GuestbookEntryRepo() *GuestbookEntryRepo {
+	return d.guestbookEntryRepo
+}
+
+func (d Database) BookRepo() *BookRepo {
+	return d.bookRepo
+}
+
+func (d Database) CertificationRepo() *CertificationRepo {
+	return d.certificationRepo
+}
+
+func (d Database) FAQRepo() *FAQRepo {
+	return d.faqRepo
+}
+
+func (d Database) IdempotencyKeyRepo() *IdempotencyKeyRepo {
+	return d.idempotencyKeyRepo
+}
+
+func (d Database) WebhookRepo() *WebhookRepo {
+	return d.webhookRepo
+}
+
+func (d Database) WebhookDeliveryRepo() *WebhookDeliveryRepo {
+	return d.webhookDeliveryRepo
+}
+
+func (d Database) ContentViewRepo() *ContentViewRepo {
+	return d.contentViewRepo
+}
+
+func (d Database) PageViewRepo() *PageViewRepo {
+	return d.pageViewRepo
+}
+
+func (d Database) SocialPostRepo() *SocialPostRepo {
+	return d.socialPostRepo
+}
+
+func (d Database) VisitorSaltRepo() *VisitorSaltRepo {
+	return d.visitorSaltRepo
+}
+
+func (d Database) ShareLinkRepo() *ShareLinkRepo {
+	return d.shareLinkRepo
+}
+
+func (d Database) NotionPageRepo() *NotionPageRepo {
+	return d.notionPageRepo
+}
+
+func (d Database) MediaFileRepo() *MediaFileRepo {
+	return d.mediaFileRepo
+}
+
+func (d Database) MediaObjectRepo() *MediaObjectRepo {
+	return d.mediaObjectRepo
+}
+
+func (d Database) ProjectImageRepo() *ProjectImageRepo {
+	return d.projectImageRepo
+}
+
+func (d Database) CredentialRepo() *CredentialRepo {
+	return d.credentialRepo
+}
+
+func (d Database) ShortLinkRepo() *ShortLinkRepo {
+	return d.shortLinkRepo
+}
+
+func (d Database) SnippetRepo() *SnippetRepo {
+	return d.snippetRepo
+}
+
+func (d Database) BlogPostTranslationRepo() *BlogPostTranslationRepo {
+	return d.blogPostTranslationRepo
+}
+
+func (d Database) BlogPostViewRepo() *BlogPostViewRepo {
+	return d.blogPostViewRepo
+}
+
+func (d Database) SeriesRepo() *SeriesRepo {
+	return d.seriesRepo
+}
+
+func (d Database) BlogPostImageRepo() *BlogPostImageRepo {
+	return d.blogPostImageRepo
+}
+
 func (d Database) MigrateStep(migrationDir string, steps int) error {
 	if migrationDir == "" {
 		return errs.BadRequest("migration directory cannot be empty")
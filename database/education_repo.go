@@ -0,0 +1,52 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type EducationRepo struct {
+	db *gorm.DB
+}
+
+func NewEducationRepo(db *gorm.DB) *EducationRepo {
+	return &EducationRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *EducationRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindAll returns all education entries, most recent first
+func (r *EducationRepo) FindAll() ([]*models.Education, error) {
+	var educations []*models.Education
+	err := r.db.Order("start_date DESC").Find(&educations).Error
+	return educations, err
+}
+
+// FindByID returns an education entry by its ID
+func (r *EducationRepo) FindByID(id uuid.UUID) (*models.Education, error) {
+	var education models.Education
+	err := r.db.First(&education, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &education, nil
+}
+
+// Add inserts a new education entry into the database
+func (r *EducationRepo) Add(education *models.Education) error {
+	return r.db.Create(education).Error
+}
+
+// Update updates an existing education entry in the database
+func (r *EducationRepo) Update(education *models.Education) error {
+	return r.db.Save(education).Error
+}
+
+// Delete removes an education entry from the database by id
+func (r *EducationRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Education{}, id).Error
+}
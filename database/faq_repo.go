@@ -0,0 +1,59 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type FAQRepo struct {
+	db *gorm.DB
+}
+
+func NewFAQRepo(db *gorm.DB) *FAQRepo {
+	return &FAQRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *FAQRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindAll returns all FAQs ordered by display order
+func (r *FAQRepo) FindAll() ([]*models.FAQ, error) {
+	var faqs []*models.FAQ
+	err := r.db.Order("display_order ASC").Order("question ASC").Find(&faqs).Error
+	return faqs, err
+}
+
+// FindPublished returns the published FAQs ordered by display order
+func (r *FAQRepo) FindPublished() ([]*models.FAQ, error) {
+	var faqs []*models.FAQ
+	err := r.db.Where("published = ?", true).Order("display_order ASC").Order("question ASC").Find(&faqs).Error
+	return faqs, err
+}
+
+// FindByID returns a FAQ by its ID
+func (r *FAQRepo) FindByID(id uuid.UUID) (*models.FAQ, error) {
+	var faq models.FAQ
+	err := r.db.First(&faq, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &faq, nil
+}
+
+// Add inserts a new FAQ into the database
+func (r *FAQRepo) Add(faq *models.FAQ) error {
+	return r.db.Create(faq).Error
+}
+
+// Update updates an existing FAQ in the database
+func (r *FAQRepo) Update(faq *models.FAQ) error {
+	return r.db.Save(faq).Error
+}
+
+// Delete removes a FAQ from the database by id
+func (r *FAQRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.FAQ{}, id).Error
+}
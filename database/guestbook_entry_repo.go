@@ -0,0 +1,69 @@
+package database
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type GuestbookEntryRepo struct {
+	db *gorm.DB
+}
+
+func NewGuestbookEntryRepo(db *gorm.DB) *GuestbookEntryRepo {
+	return &GuestbookEntryRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *GuestbookEntryRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindAll returns all guestbook entries, newest first
+func (r *GuestbookEntryRepo) FindAll() ([]*models.GuestbookEntry, error) {
+	var entries []*models.GuestbookEntry
+	err := r.db.Order("date_submitted DESC").Find(&entries).Error
+	return entries, err
+}
+
+// FindByApproved returns the guestbook entries with the given approval state, newest first
+func (r *GuestbookEntryRepo) FindByApproved(approved bool) ([]*models.GuestbookEntry, error) {
+	var entries []*models.GuestbookEntry
+	err := r.db.Where("approved = ?", approved).Order("date_submitted DESC").Find(&entries).Error
+	return entries, err
+}
+
+// FindByID returns a guestbook entry by its ID
+func (r *GuestbookEntryRepo) FindByID(id uuid.UUID) (*models.GuestbookEntry, error) {
+	var entry models.GuestbookEntry
+	err := r.db.First(&entry, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// Add inserts a new guestbook entry into the database
+func (r *GuestbookEntryRepo) Add(entry *models.GuestbookEntry) error {
+	return r.db.Create(entry).Error
+}
+
+// SetApproved updates the approval state of a guestbook entry, recording when it was approved
+func (r *GuestbookEntryRepo) SetApproved(id uuid.UUID, approved bool) error {
+	var dateApproved *time.Time
+	if approved {
+		now := time.Now()
+		dateApproved = &now
+	}
+	return r.db.Model(&models.GuestbookEntry{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"approved":      approved,
+		"date_approved": dateApproved,
+	}).Error
+}
+
+// Delete removes a guestbook entry from the database by id
+func (r *GuestbookEntryRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.GuestbookEntry{}, id).Error
+}
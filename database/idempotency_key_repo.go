@@ -0,0 +1,61 @@
+package database
+
+import (
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IdempotencyKeyRepo struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyKeyRepo(db *gorm.DB) *IdempotencyKeyRepo {
+	return &IdempotencyKeyRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *IdempotencyKeyRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindByKey returns the record stored for key
+func (r *IdempotencyKeyRepo) FindByKey(key string) (*models.IdempotencyKey, error) {
+	var record models.IdempotencyKey
+	err := r.db.Where("key = ?", key).First(&record).Error
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// Reserve inserts record unless its key is already taken, reporting whether it was inserted
+// Expired keys are cleared first so they can be reused
+func (r *IdempotencyKeyRepo) Reserve(record *models.IdempotencyKey) (bool, error) {
+	if err := r.db.Where("expires_at < ?", time.Now()).Delete(&models.IdempotencyKey{}).Error; err != nil {
+		return false, err
+	}
+
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Complete stores the response for a reserved key
+func (r *IdempotencyKeyRepo) Complete(key string, statusCode int, contentType string, body []byte) error {
+	return r.db.Model(&models.IdempotencyKey{}).Where("key = ?", key).Updates(map[string]any{
+		"completed":     true,
+		"status_code":   statusCode,
+		"content_type":  contentType,
+		"response_body": body,
+	}).Error
+}
+
+// Delete releases a reserved key so the request can be retried
+func (r *IdempotencyKeyRepo) Delete(key string) error {
+	return r.db.Where("key = ?", key).Delete(&models.IdempotencyKey{}).Error
+}
@@ -0,0 +1,158 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+// QueryPlan is what EXPLAIN says about one of the queries behind the main list views
+type QueryPlan struct {
+	Name        string
+	SQL         string
+	TotalCost   float64
+	SeqScans    []string // Tables read in full
+	IndexesUsed []string
+	Warnings    []string
+}
+
+// IndexAudit reports how the main list queries are planned and which of the indexes they rely on are missing
+type IndexAudit struct {
+	Plans          []QueryPlan
+	MissingIndexes []string
+}
+
+// expectedIndexes are the indexes the list queries rely on, keyed by table
+// They're declared in the models' gorm tags and created by the migrate command
+var expectedIndexes = map[string][]string{
+	"blog_posts":   {"idx_blog_post_date_added", "idx_blog_post_status_publish_at"},
+	"blog_tags":    {"idx_blog_tag_blog_post_id"},
+	"projects":     {"idx_project_type"},
+	"project_tags": {"idx_project_tag_project_id"},
+}
+
+// listQuery is one of the queries behind a list view, built the way its repo builds it
+type listQuery struct {
+	name  string
+	build func(tx *gorm.DB) *gorm.DB
+}
+
+var listQueries = []listQuery{
+	{"blog posts page", func(tx *gorm.DB) *gorm.DB {
+		return applySort(published(tx), newestFirst).Order("id").Limit(50).Find(&[]models.BlogPost{})
+	}},
+	{"blog post summaries page", func(tx *gorm.DB) *gorm.DB {
+		return applySort(published(tx.Select(blogPostSummaryColumns)), newestFirst).Order("id").Limit(50).Find(&[]models.BlogPost{})
+	}},
+	{"blog post archive", func(tx *gorm.DB) *gorm.DB {
+		return published(tx.Select("id", "title", "date_added")).Order("date_added DESC").Order("id").Find(&[]models.BlogPost{})
+	}},
+	{"due scheduled blog posts", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("status = ? AND publish_at <= ?", models.BlogPostStatusScheduled, time.Now()).Find(&[]models.BlogPost{})
+	}},
+	{"blog post tags", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("blog_post_id IN ?", []uuid.UUID{uuid.Nil}).Find(&[]models.BlogTag{})
+	}},
+	{"projects page", func(tx *gorm.DB) *gorm.DB {
+		return applySort(tx, newestFirst).Order("id").Limit(50).Find(&[]models.Project{})
+	}},
+	{"projects by type", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("type = ?", "web").Find(&[]models.Project{})
+	}},
+	{"project tags", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("project_id IN ?", []uuid.UUID{uuid.Nil}).Find(&[]models.ProjectTag{})
+	}},
+}
+
+// explainNode is a node of EXPLAIN (FORMAT JSON) output
+type explainNode struct {
+	NodeType     string        `json:"Node Type"`
+	RelationName string        `json:"Relation Name"`
+	IndexName    string        `json:"Index Name"`
+	TotalCost    float64       `json:"Total Cost"`
+	Plans        []explainNode `json:"Plans"`
+}
+
+// AuditIndexes runs EXPLAIN on the main list queries and checks that the indexes they rely on exist
+// Postgres reads small tables in full even when an index exists, so a sequential scan on its own is only a warning
+// that matters once the table grows; one on a table missing an expected index is worth fixing now
+func (d Database) AuditIndexes() (IndexAudit, error) {
+	var existing []string
+	if err := d.db.Raw("SELECT indexname FROM pg_indexes WHERE schemaname = current_schema()").Scan(&existing).Error; err != nil {
+		return IndexAudit{}, fmt.Errorf("failed to list indexes: %w", err)
+	}
+	exists := make(map[string]bool, len(existing))
+	for _, name := range existing {
+		exists[name] = true
+	}
+
+	audit := IndexAudit{Plans: make([]QueryPlan, 0, len(listQueries))}
+	missingOn := make(map[string][]string)
+	for _, table := range []string{"blog_posts", "blog_tags", "projects", "project_tags"} {
+		for _, index := range expectedIndexes[table] {
+			if !exists[index] {
+				audit.MissingIndexes = append(audit.MissingIndexes, index)
+				missingOn[table] = append(missingOn[table], index)
+			}
+		}
+	}
+
+	for _, query := range listQueries {
+		plan, err := d.explain(query)
+		if err != nil {
+			return IndexAudit{}, fmt.Errorf("failed to explain %s: %w", query.name, err)
+		}
+		for _, table := range plan.SeqScans {
+			if missing := missingOn[table]; len(missing) > 0 {
+				plan.Warnings = append(plan.Warnings, fmt.Sprintf("reads %s in full and %v is missing; run the migrate command to create it", table, missing))
+			} else {
+				plan.Warnings = append(plan.Warnings, fmt.Sprintf("reads %s in full, which is expected while it's small", table))
+			}
+		}
+		audit.Plans = append(audit.Plans, plan)
+	}
+	return audit, nil
+}
+
+func (d Database) explain(query listQuery) (QueryPlan, error) {
+	sql := d.db.ToSQL(query.build)
+
+	var output string
+	if err := d.db.Raw("EXPLAIN (FORMAT JSON) " + sql).Row().Scan(&output); err != nil {
+		return QueryPlan{}, err
+	}
+	var explained []struct {
+		Plan explainNode `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(output), &explained); err != nil {
+		return QueryPlan{}, fmt.Errorf("failed to read plan: %w", err)
+	}
+	if len(explained) == 0 {
+		return QueryPlan{}, fmt.Errorf("EXPLAIN returned no plan")
+	}
+
+	plan := QueryPlan{Name: query.name, SQL: sql, TotalCost: explained[0].Plan.TotalCost}
+	var walk func(node explainNode)
+	walk = func(node explainNode) {
+		switch {
+		case node.NodeType == "Seq Scan":
+			plan.SeqScans = append(plan.SeqScans, node.RelationName)
+		case node.IndexName != "":
+			plan.IndexesUsed = append(plan.IndexesUsed, node.IndexName)
+		}
+		for _, child := range node.Plans {
+			walk(child)
+		}
+	}
+	walk(explained[0].Plan)
+	return plan, nil
+}
+
+// SchemaDrift compares every table's columns with the model it's migrated from
+func (d Database) SchemaDrift() ([]models.TableDrift, error) {
+	return models.DetectSchemaDrift(d.db)
+}
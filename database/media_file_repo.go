@@ -0,0 +1,48 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type MediaFileRepo struct {
+	db *gorm.DB
+}
+
+func NewMediaFileRepo(db *gorm.DB) *MediaFileRepo {
+	return &MediaFileRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *MediaFileRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindByID returns the media file with id, including its data
+func (r *MediaFileRepo) FindByID(id uuid.UUID) (*models.MediaFile, error) {
+	var mediaFile models.MediaFile
+	err := r.db.First(&mediaFile, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &mediaFile, nil
+}
+
+// AddOrFind stores mediaFile unless a file with the same SHA256 is already stored, in which case mediaFile is filled
+// in with that one instead, so re-uploading an image links to the copy already served
+func (r *MediaFileRepo) AddOrFind(mediaFile *models.MediaFile) error {
+	var existing models.MediaFile
+	err := r.db.Select("id", "file_name", "content_type", "size", "sha256", "date_added").Where("sha256 = ?", mediaFile.SHA256).First(&existing).Error
+	if err == nil {
+		existing.Data = mediaFile.Data
+		*mediaFile = existing
+		return nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return r.db.Create(mediaFile).Error
+}
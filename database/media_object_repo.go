@@ -0,0 +1,56 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type MediaObjectRepo struct {
+	db *gorm.DB
+}
+
+func NewMediaObjectRepo(db *gorm.DB) *MediaObjectRepo {
+	return &MediaObjectRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *MediaObjectRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindPage returns one page of uploaded media, newest first, and how many files there are in all
+func (r *MediaObjectRepo) FindPage(offset, limit int) ([]*models.MediaObject, int64, error) {
+	var total int64
+	if err := r.db.Model(&models.MediaObject{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var mediaObjects []*models.MediaObject
+	err := r.db.Order("date_added DESC").
+		Order("id DESC").
+		Offset(offset).
+		Limit(pageLimit(limit)).
+		Find(&mediaObjects).Error
+	return mediaObjects, total, err
+}
+
+// FindByID returns the uploaded media file with id
+func (r *MediaObjectRepo) FindByID(id uuid.UUID) (*models.MediaObject, error) {
+	var mediaObject models.MediaObject
+	err := r.db.First(&mediaObject, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &mediaObject, nil
+}
+
+// Add records a file stored in the bucket
+func (r *MediaObjectRepo) Add(mediaObject *models.MediaObject) error {
+	return r.db.Create(mediaObject).Error
+}
+
+// Delete removes the record of an uploaded file by id
+func (r *MediaObjectRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.MediaObject{}, "id = ?", id).Error
+}
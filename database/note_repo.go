@@ -0,0 +1,83 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type NoteRepo struct {
+	db *gorm.DB
+}
+
+func NewNoteRepo(db *gorm.DB) *NoteRepo {
+	return &NoteRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *NoteRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindPage returns one page of notes, newest first, along with the total number of notes
+func (r *NoteRepo) FindPage(offset, limit int) ([]*models.Note, int64, error) {
+	var total int64
+	if err := r.db.Model(&models.Note{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var notes []*models.Note
+	err := r.db.Order("date_added DESC").
+		Order("id DESC").
+		Offset(offset).
+		Limit(pageLimit(limit)).
+		Find(&notes).Error
+	return notes, total, err
+}
+
+// FindByID returns a note by its ID
+func (r *NoteRepo) FindByID(id uuid.UUID) (*models.Note, error) {
+	var note models.Note
+	err := r.db.First(&note, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &note, nil
+}
+
+// Add inserts a new note into the database
+func (r *NoteRepo) Add(note *models.Note) error {
+	return r.db.Create(note).Error
+}
+
+// Update updates an existing note in the database
+func (r *NoteRepo) Update(note *models.Note) error {
+	return r.db.Save(note).Error
+}
+
+// Delete removes a note from the database by its ID
+func (r *NoteRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Note{}, id).Error
+}
+
+// FindBefore returns up to limit notes older than cursor, newest first
+func (r *NoteRepo) FindBefore(cursor *Cursor, limit int) ([]*models.Note, error) {
+	var notes []*models.Note
+	err := before(r.db, "date_added", cursor).
+		Order("date_added DESC").
+		Order("id DESC").
+		Limit(limit).
+		Find(&notes).Error
+	return notes, err
+}
+
+// FindEditedBefore returns up to limit notes that have been edited, edited before cursor, most recently edited first
+func (r *NoteRepo) FindEditedBefore(cursor *Cursor, limit int) ([]*models.Note, error) {
+	var notes []*models.Note
+	err := before(r.db.Where("date_edited IS NOT NULL"), "date_edited", cursor).
+		Order("date_edited DESC").
+		Order("id DESC").
+		Limit(limit).
+		Find(&notes).Error
+	return notes, err
+}
@@ -0,0 +1,35 @@
+package database
+
+import (
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotionPageRepo struct {
+	db *gorm.DB
+}
+
+func NewNotionPageRepo(db *gorm.DB) *NotionPageRepo {
+	return &NotionPageRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *NotionPageRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindAll returns every synced Notion page
+func (r *NotionPageRepo) FindAll() ([]*models.NotionPage, error) {
+	var notionPages []*models.NotionPage
+	err := r.db.Find(&notionPages).Error
+	return notionPages, err
+}
+
+// Save records that the Notion page with notionPage.PageID was synced to notionPage.BlogPostID as of its LastEditedAt
+func (r *NotionPageRepo) Save(notionPage *models.NotionPage) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "page_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"blog_post_id", "last_edited_at"}),
+	}).Create(notionPage).Error
+}
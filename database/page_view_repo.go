@@ -0,0 +1,172 @@
+package database
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+// PageViewFilter selects the page views a report covers
+// From is inclusive and To exclusive; ContentType and ContentID are optional
+type PageViewFilter struct {
+	From        time.Time
+	To          time.Time
+	ContentType string
+	ContentID   *uuid.UUID
+}
+
+// apply adds the filter's conditions to query
+func (f PageViewFilter) apply(query *gorm.DB) *gorm.DB {
+	query = query.Where("date_added >= ? AND date_added < ?", f.From, f.To)
+	if f.ContentType != "" {
+		query = query.Where("content_type = ?", f.ContentType)
+	}
+	if f.ContentID != nil {
+		query = query.Where("content_id = ?", *f.ContentID)
+	}
+	return query
+}
+
+// SourceCount is how many views one blog post or project got from one traffic source
+type SourceCount struct {
+	ContentType string
+	ContentID   uuid.UUID
+	Source      string
+	Views       int64
+}
+
+// DailyCount is the number of page views and unique visitors on one day
+type DailyCount struct {
+	Day      time.Time
+	Views    int64
+	Visitors int64
+}
+
+// ContentCount is the number of page views one blog post or project got
+type ContentCount struct {
+	ContentType string
+	ContentID   uuid.UUID
+	Views       int64
+}
+
+// GeoCount is the number of page views and unique visitors from one country and region
+// Country is "" for views that couldn't be located, and Region is "" when only the country is known
+type GeoCount struct {
+	Country  string
+	Region   string
+	Views    int64
+	Visitors int64
+}
+
+type PageViewRepo struct {
+	db *gorm.DB
+}
+
+func NewPageViewRepo(db *gorm.DB) *PageViewRepo {
+	return &PageViewRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *PageViewRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// Add inserts a page view event
+func (r *PageViewRepo) Add(pageView *models.PageView) error {
+	return r.db.Create(pageView).Error
+}
+
+// AggregateDays recomputes the daily per-path view and unique visitor counts for every UTC day from from up to, not including, to
+// Counts are replaced rather than added to, so re-running a day that is still in progress is safe
+func (r *PageViewRepo) AggregateDays(from, to time.Time) error {
+	from, to = from.UTC().Truncate(24*time.Hour), to.UTC().Truncate(24*time.Hour)
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`INSERT INTO page_view_dailies (day, path, views, visitors)
+			SELECT date_trunc('day', date_added)::date, path, count(*), count(DISTINCT NULLIF(visitor_hash, ''))
+			FROM page_views
+			WHERE date_added >= ? AND date_added < ?
+			GROUP BY 1, 2
+			ON CONFLICT (day, path) DO UPDATE SET views = EXCLUDED.views, visitors = EXCLUDED.visitors`,
+			from, to).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`INSERT INTO visitor_dailies (day, visitors)
+			SELECT date_trunc('day', date_added)::date, count(DISTINCT NULLIF(visitor_hash, ''))
+			FROM page_views
+			WHERE date_added >= ? AND date_added < ?
+			GROUP BY 1
+			ON CONFLICT (day) DO UPDATE SET visitors = EXCLUDED.visitors`,
+			from, to).Error
+	})
+}
+
+// DeleteBefore removes raw page view events older than before and returns how many were removed
+func (r *PageViewRepo) DeleteBefore(before time.Time) (int64, error) {
+	result := r.db.Where("date_added < ?", before).Delete(&models.PageView{})
+	return result.RowsAffected, result.Error
+}
+
+// CountReferrers counts the views of each blog post and project by referring host, most views first
+// Views without a referrer are counted under "(direct)"
+func (r *PageViewRepo) CountReferrers(filter PageViewFilter) ([]SourceCount, error) {
+	return countSources(filter.apply(r.db), "coalesce(referrer, '(direct)')")
+}
+
+// CountUTMSources counts the views of each blog post and project by utm_source, most views first
+// Views without a utm_source are left out
+func (r *PageViewRepo) CountUTMSources(filter PageViewFilter) ([]SourceCount, error) {
+	return countSources(filter.apply(r.db).Where("utm_source IS NOT NULL"), "utm_source")
+}
+
+// countSources groups the content page views matched by query by their content and the source expression
+func countSources(query *gorm.DB, source string) ([]SourceCount, error) {
+	var counts []SourceCount
+	err := query.Model(&models.PageView{}).
+		Select("content_type, content_id, " + source + " AS source, count(*) AS views").
+		Where("content_id IS NOT NULL").
+		Group("content_type, content_id, source").
+		Order("views DESC, source").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// CountDaily returns the total views and unique visitors per day, from the daily rollup, for days from from up to, not including, to
+// Days without views are missing from the result
+func (r *PageViewRepo) CountDaily(from, to time.Time) ([]DailyCount, error) {
+	var counts []DailyCount
+	err := r.db.Table("page_view_dailies").
+		Select("page_view_dailies.day, sum(page_view_dailies.views) AS views, coalesce(max(visitor_dailies.visitors), 0) AS visitors").
+		Joins("LEFT JOIN visitor_dailies ON visitor_dailies.day = page_view_dailies.day").
+		Where("page_view_dailies.day >= ? AND page_view_dailies.day < ?", from, to).
+		Group("page_view_dailies.day").
+		Order("page_view_dailies.day").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// CountTopContent returns the limit most viewed blog posts or projects matched by filter, most views first
+func (r *PageViewRepo) CountTopContent(filter PageViewFilter, limit int) ([]ContentCount, error) {
+	var counts []ContentCount
+	err := filter.apply(r.db.Model(&models.PageView{})).
+		Select("content_type, content_id, count(*) AS views").
+		Where("content_id IS NOT NULL").
+		Group("content_type, content_id").
+		Order("views DESC, content_id").
+		Limit(limit).
+		Scan(&counts).Error
+	return counts, err
+}
+
+// CountGeo counts the views and unique visitors matched by filter per country and region, most views first
+// Visitor hashes change daily, so Visitors counts someone once per day they visited, like the daily rollup
+func (r *PageViewRepo) CountGeo(filter PageViewFilter) ([]GeoCount, error) {
+	var counts []GeoCount
+	err := filter.apply(r.db.Model(&models.PageView{})).
+		Select("coalesce(country, '') AS country, coalesce(region, '') AS region, count(*) AS views, count(DISTINCT nullif(visitor_hash, '')) AS visitors").
+		Group("1, 2").
+		Order("views DESC, country, region").
+		Scan(&counts).Error
+	return counts, err
+}
@@ -0,0 +1,68 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type ProjectImageRepo struct {
+	db *gorm.DB
+}
+
+func NewProjectImageRepo(db *gorm.DB) *ProjectImageRepo {
+	return &ProjectImageRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *ProjectImageRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindForProject returns a project's images in gallery order
+func (r *ProjectImageRepo) FindForProject(projectID uuid.UUID) ([]*models.ProjectImage, error) {
+	var images []*models.ProjectImage
+	err := orderProjectImages(r.db.Where("project_id = ?", projectID)).Find(&images).Error
+	return images, err
+}
+
+// FindByID returns one of a project's images
+func (r *ProjectImageRepo) FindByID(projectID, id uuid.UUID) (*models.ProjectImage, error) {
+	var image models.ProjectImage
+	err := r.db.Where("project_id = ?", projectID).First(&image, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &image, nil
+}
+
+// Add inserts a new image into its project's gallery, after the last image unless it has a position
+func (r *ProjectImageRepo) Add(image *models.ProjectImage) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if image.Position == 0 {
+			if err := tx.Model(&models.ProjectImage{}).
+				Select("COALESCE(MAX(position), 0) + 1").
+				Where("project_id = ?", image.ProjectID).
+				Scan(&image.Position).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(image).Error
+	})
+}
+
+// Update updates an existing image
+func (r *ProjectImageRepo) Update(image *models.ProjectImage) error {
+	return r.db.Save(image).Error
+}
+
+// Delete removes one of a project's images, reporting whether it had it
+func (r *ProjectImageRepo) Delete(projectID, id uuid.UUID) (bool, error) {
+	result := r.db.Where("project_id = ?", projectID).Delete(&models.ProjectImage{}, id)
+	return result.RowsAffected > 0, result.Error
+}
+
+// orderProjectImages sorts project images into gallery order
+func orderProjectImages(db *gorm.DB) *gorm.DB {
+	return db.Order("position").Order("date_added").Order("id")
+}
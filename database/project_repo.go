@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/rpupo63/unified-personal-site-backend/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ProjectRepo struct {
@@ -19,19 +20,153 @@ func (r *ProjectRepo) GetDB() *gorm.DB {
 	return r.db
 }
 
-// FindAll returns all projects from the database
-func (r *ProjectRepo) FindAll() ([]*models.Project, error) {
-	// Use Preload which GORM optimizes to batch load tags in a single query
+// FindAll returns all projects from the database, ordered by the given sort fields if any
+func (r *ProjectRepo) FindAll(sort ...SortField) ([]*models.Project, error) {
+	// Use Preload which GORM optimizes to batch load tags and images in a query each
 	// This is more efficient than N+1 queries and handles the relationship properly
 	var projects []*models.Project
-	err := r.db.Preload("Tags").Find(&projects).Error
+	err := applySort(preloadProjectRelations(r.db), sort).Find(&projects).Error
 	return projects, err
 }
 
-// FindByID returns a project by its ID
+// FindPage returns one page of projects ordered by sort (newest first when empty), along with the total number of projects
+// id is always the final sort key so pages don't overlap when sort values tie
+func (r *ProjectRepo) FindPage(offset, limit int, sort ...SortField) ([]*models.Project, int64, error) {
+	var total int64
+	if err := r.db.Model(&models.Project{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if len(sort) == 0 {
+		sort = newestFirst
+	}
+
+	var projects []*models.Project
+	err := applySort(preloadProjectRelations(r.db), sort).
+		Order("id").
+		Offset(offset).
+		Limit(pageLimit(limit)).
+		Find(&projects).Error
+	return projects, total, err
+}
+
+// FindPageBefore returns up to limit projects added before cursor, newest first by (date_added, id), which stays as
+// fast however deep the page is. next is where the following page starts, nil on the last one; total is the number
+// of projects
+func (r *ProjectRepo) FindPageBefore(cursor *Cursor, limit int) ([]*models.Project, *Cursor, int64, error) {
+	var total int64
+	if err := r.db.Model(&models.Project{}).Count(&total).Error; err != nil {
+		return nil, nil, 0, err
+	}
+
+	limit = pageLimit(limit)
+	projects, err := r.FindBefore(cursor, limit+1)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	projects, next := trimToCursorPage(projects, limit, func(project *models.Project) Cursor {
+		return Cursor{Time: project.DateAdded, ID: project.ID}
+	})
+	return projects, next, total, nil
+}
+
+// FindSummaryPage is FindPage for list views: tags aren't preloaded as full rows
+// Use ProjectTagRepo.FindValues to get the page's tag values in one query
+func (r *ProjectRepo) FindSummaryPage(offset, limit int, sort ...SortField) ([]*models.Project, int64, error) {
+	var total int64
+	if err := r.db.Model(&models.Project{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if len(sort) == 0 {
+		sort = newestFirst
+	}
+
+	var projects []*models.Project
+	err := applySort(r.db, sort).
+		Order("id").
+		Offset(offset).
+		Limit(pageLimit(limit)).
+		Find(&projects).Error
+	return projects, total, err
+}
+
+// FindSummaries is FindSummaryPage for the projects among ids, in no particular order
+func (r *ProjectRepo) FindSummaries(ids []uuid.UUID) ([]*models.Project, error) {
+	var projects []*models.Project
+	if len(ids) == 0 {
+		return projects, nil
+	}
+	err := r.db.Where("id IN ?", ids).Find(&projects).Error
+	return projects, err
+}
+
+// Search returns up to limit projects matching query, best first, with each one's score
+// Full-text matches come first, then typo-tolerant trigram matches
+func (r *ProjectRepo) Search(query string, limit int) ([]*models.Project, []float64, error) {
+	hits, err := projectSearch.hits(r.db, query, limit)
+	if err != nil || len(hits) == 0 {
+		return nil, nil, err
+	}
+
+	var found []*models.Project
+	if err := preloadProjectRelations(r.db).Where("id IN ?", hitIDs(hits)).Find(&found).Error; err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[uuid.UUID]*models.Project, len(found))
+	for _, project := range found {
+		byID[project.ID] = project
+	}
+
+	projects := make([]*models.Project, 0, len(hits))
+	scores := make([]float64, 0, len(hits))
+	for _, hit := range hits {
+		if project, ok := byID[hit.ID]; ok {
+			projects = append(projects, project)
+			scores = append(scores, hit.Score)
+		}
+	}
+	return projects, scores, nil
+}
+
+// FindTitles returns the titles of the projects with the given IDs, keyed by ID
+// IDs with no matching row are simply missing from the map
+func (r *ProjectRepo) FindTitles(ids []uuid.UUID) (map[uuid.UUID]string, error) {
+	titles := make(map[uuid.UUID]string, len(ids))
+	if len(ids) == 0 {
+		return titles, nil
+	}
+
+	var rows []models.Project
+	if err := r.db.Select("id", "title").Where("id IN ?", ids).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		titles[row.ID] = row.Title
+	}
+	return titles, nil
+}
+
+// FindBySlug returns the project, with its tags and images, whose title slugOf turns into slug. Titles are unique but
+// their slugs needn't be, so when several share slug the oldest of them has it. It returns gorm.ErrRecordNotFound when
+// none does
+func (r *ProjectRepo) FindBySlug(slug string, slugOf func(title string) string) (*models.Project, error) {
+	var rows []models.Project
+	if err := r.db.Select("id", "title").Order("date_added").Order("id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if slugOf(row.Title) == slug {
+			return r.FindByID(row.ID)
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// FindByID returns a project by its ID, with its tags and images
 func (r *ProjectRepo) FindByID(id uuid.UUID) (*models.Project, error) {
 	var project models.Project
-	err := r.db.Preload("Tags").First(&project, id).Error
+	err := preloadProjectRelations(r.db).First(&project, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -43,12 +178,105 @@ func (r *ProjectRepo) Add(project *models.Project) error {
 	return r.db.Create(project).Error
 }
 
-// Update updates an existing project in the database
+// Update updates an existing project in the database. Its images are left as they are, since they're changed
+// through ProjectImageRepo
 func (r *ProjectRepo) Update(project *models.Project) error {
-	return r.db.Save(project).Error
+	return r.db.Omit("Images").Save(project).Error
 }
 
-// Delete removes a project from the database by id
+// Delete moves a project to the trash by id, where it's kept, tags and all, until restored or deleted permanently
 func (r *ProjectRepo) Delete(id uuid.UUID) error {
 	return r.db.Delete(&models.Project{}, id).Error
 }
+
+// DeletePermanently removes a project, whether in the trash or not, and its tags from the database by id
+// gorm.ErrRecordNotFound is returned when there's no such project
+func (r *ProjectRepo) DeletePermanently(id uuid.UUID) error {
+	result := r.db.Unscoped().Delete(&models.Project{}, id)
+	if result.Error == nil && result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return result.Error
+}
+
+// Restore takes a project out of the trash by id
+// gorm.ErrRecordNotFound is returned when it isn't in the trash
+func (r *ProjectRepo) Restore(id uuid.UUID) error {
+	result := r.db.Unscoped().Model(&models.Project{}).Where("id = ? AND deleted_at IS NOT NULL", id).Update("deleted_at", nil)
+	if result.Error == nil && result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return result.Error
+}
+
+// FindTrashPage returns one page of the projects in the trash, most recently deleted first, along with how many there are
+func (r *ProjectRepo) FindTrashPage(offset, limit int) ([]*models.Project, int64, error) {
+	var total int64
+	if err := r.db.Unscoped().Model(&models.Project{}).Where("deleted_at IS NOT NULL").Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var projects []*models.Project
+	err := preloadProjectRelations(r.db.Unscoped()).
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Order("id").
+		Offset(offset).
+		Limit(pageLimit(limit)).
+		Find(&projects).Error
+	return projects, total, err
+}
+
+// CountByIDs returns how many of the given ids belong to existing projects
+func (r *ProjectRepo) CountByIDs(ids []uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Project{}).Where("id IN ?", ids).Count(&count).Error
+	return count, err
+}
+
+// FindBefore returns up to limit projects added before cursor, newest first
+func (r *ProjectRepo) FindBefore(cursor *Cursor, limit int) ([]*models.Project, error) {
+	var projects []*models.Project
+	err := before(preloadProjectRelations(r.db), "date_added", cursor).
+		Order("date_added DESC").
+		Order("id DESC").
+		Limit(limit).
+		Find(&projects).Error
+	return projects, err
+}
+
+// FindEditedBefore returns up to limit projects that have been edited, edited before cursor, most recently edited first
+func (r *ProjectRepo) FindEditedBefore(cursor *Cursor, limit int) ([]*models.Project, error) {
+	var projects []*models.Project
+	err := before(r.db.Preload("Tags").Where("date_edited IS NOT NULL"), "date_edited", cursor).
+		Order("date_edited DESC").
+		Order("id DESC").
+		Limit(limit).
+		Find(&projects).Error
+	return projects, err
+}
+
+// DeleteMatching moves every project matching filter to the trash in a single transaction and returns their IDs
+func (r *ProjectRepo) DeleteMatching(filter ContentFilter) ([]uuid.UUID, error) {
+	if filter.IsEmpty() {
+		return nil, nil
+	}
+
+	var ids []uuid.UUID
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		query := applyContentFilter(tx.Model(&models.Project{}), filter, "project_tags", "project_id")
+		if err := query.Clauses(clause.Locking{Strength: "UPDATE"}).Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		return tx.Delete(&models.Project{}, ids).Error
+	})
+	return ids, err
+}
+
+// preloadProjectRelations has db load projects with their tags and their images in gallery order
+func preloadProjectRelations(db *gorm.DB) *gorm.DB {
+	return db.Preload("Tags").Preload("Images", orderProjectImages)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/rpupo63/unified-personal-site-backend/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ProjectTagRepo struct {
@@ -26,11 +27,24 @@ func (r *ProjectTagRepo) FindAll() ([]*models.ProjectTag, error) {
 	return projectTags, err
 }
 
+// FindValues returns the tag values of each of the projects with projectIDs, keyed by project ID
+func (r *ProjectTagRepo) FindValues(projectIDs []uuid.UUID) (map[uuid.UUID][]string, error) {
+	return findTagValues(r.db, "project_tags", "project_id", projectIDs)
+}
+
 // Add inserts a new project tag into the database
 func (r *ProjectTagRepo) Add(projectTag *models.ProjectTag) error {
 	return r.db.Create(projectTag).Error
 }
 
+// AddAll inserts the given project tags, skipping any the project already has
+func (r *ProjectTagRepo) AddAll(projectTags []models.ProjectTag) error {
+	if len(projectTags) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Omit(clause.Associations).Create(&projectTags).Error
+}
+
 // Delete removes a project tag from the database by id
 func (r *ProjectTagRepo) Delete(id uuid.UUID) error {
 	return r.db.Delete(&models.ProjectTag{}, id).Error
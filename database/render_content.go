@@ -0,0 +1,50 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+// renderBatchSize is how many rows RenderMissingContentHTML reads at a time
+const renderBatchSize = 100
+
+// RenderMissingContentHTML stores render's HTML for every blog post, including those in the trash, and translation
+// that has content but no rendered HTML, such as those saved before content was rendered. It returns how many rows
+// it rendered
+func (d Database) RenderMissingContentHTML(render func(markdown string) (string, error)) (int, error) {
+	blogPosts, err := renderMissingContentHTML(d.db, render, func(blogPost *models.BlogPost) string {
+		return blogPost.Content
+	})
+	if err != nil {
+		return blogPosts, fmt.Errorf("failed to render blog posts: %w", err)
+	}
+	translations, err := renderMissingContentHTML(d.db, render, func(translation *models.BlogPostTranslation) string {
+		return translation.Content
+	})
+	if err != nil {
+		return blogPosts + translations, fmt.Errorf("failed to render blog post translations: %w", err)
+	}
+	return blogPosts + translations, nil
+}
+
+// renderMissingContentHTML renders the content of the rows of T without content_html, a batch at a time
+func renderMissingContentHTML[T any](db *gorm.DB, render func(markdown string) (string, error), content func(row *T) string) (int, error) {
+	rendered := 0
+	var batch []*T
+	err := db.Unscoped().Select("id", "content").Where("content_html = '' AND content <> ''").FindInBatches(&batch, renderBatchSize, func(_ *gorm.DB, _ int) error {
+		for _, row := range batch {
+			contentHTML, err := render(content(row))
+			if err != nil {
+				return err
+			}
+			if err := db.Unscoped().Model(row).UpdateColumn("content_html", contentHTML).Error; err != nil {
+				return err
+			}
+			rendered++
+		}
+		return nil
+	}).Error
+	return rendered, err
+}
@@ -0,0 +1,159 @@
+package database
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// fuzzySimilarityThreshold is the pg_trgm word similarity a fuzzy match needs
+// The extension default of 0.6 is too strict for typos, e.g. "postgers" only scores about 0.4 against "postgres"
+const fuzzySimilarityThreshold = "0.3"
+
+// searchTarget describes how one table is searched
+// document is the text fed to full-text search; fuzzyColumns are matched by trigram similarity when full-text finds nothing.
+// visible, when set, is a condition rows must meet to be searchable at all
+type searchTarget struct {
+	table        string
+	document     string
+	fuzzyColumns []string
+	visible      string
+}
+
+var (
+	blogPostSearch = searchTarget{
+		table:        "blog_posts",
+		document:     "to_tsvector('english', title || ' ' || coalesce(summary, '') || ' ' || content)",
+		fuzzyColumns: []string{"title", "content"},
+		visible:      "status = 'published' AND deleted_at IS NULL",
+	}
+	projectSearch = searchTarget{
+		table:        "projects",
+		document:     "to_tsvector('english', title || ' ' || description || ' ' || type)",
+		fuzzyColumns: []string{"title", "description"},
+		visible:      "deleted_at IS NULL",
+	}
+)
+
+// SearchHit is one search match and its relevance
+// Full-text matches score above 1 so they always rank ahead of fuzzy-only matches, which score their trigram similarity (0-1)
+type SearchHit struct {
+	ID    uuid.UUID
+	Score float64
+}
+
+// Types of ContentSearchHit
+const (
+	SearchHitBlogPost = "blog_post"
+	SearchHitProject  = "project"
+	SearchHitTag      = "tag"
+)
+
+// ContentSearchHit is one match of Database.Search: a blog post or project, whose ID Key is, or a tag, whose value
+// Key is. Blog posts and projects score as SearchHit does; a tag scores 2 when it's the query, ignoring case, and its
+// trigram similarity to the query otherwise. Tags count the published blog posts and the projects carrying them
+type ContentSearchHit struct {
+	Type      string
+	Key       string
+	Score     float64
+	BlogPosts int
+	Projects  int
+}
+
+// tagSearch matches the values of the tags on published blog posts and projects out of the trash against @query
+var tagSearch = "SELECT '" + SearchHitTag + "' AS type, value AS key," +
+	" CASE WHEN lower(value) = lower(@query) THEN 2 ELSE word_similarity(value, @query) END AS score," +
+	" SUM(blog_posts) AS blog_posts, SUM(projects) AS projects FROM (" +
+	"SELECT value, 1 AS blog_posts, 0 AS projects FROM blog_tags WHERE blog_post_id IN (SELECT id FROM blog_posts WHERE " + blogPostSearch.visible + ")" +
+	" UNION ALL SELECT value, 0, 1 FROM project_tags WHERE project_id IN (SELECT id FROM projects WHERE " + projectSearch.visible + ")" +
+	") AS tags WHERE lower(value) = lower(@query) OR value <% @query GROUP BY value"
+
+// Search returns up to limit blog posts, projects and tags matching query, best first, ranked against each other in
+// a single query. Blog posts and projects are searched as BlogPostRepo.Search and ProjectRepo.Search do
+func (d Database) Search(query string, limit int) ([]ContentSearchHit, error) {
+	sql := "SELECT '" + SearchHitBlogPost + "' AS type, id::text AS key, score, 0 AS blog_posts, 0 AS projects FROM (" + blogPostSearch.scored() + ") AS blog_post_hits" +
+		" UNION ALL SELECT '" + SearchHitProject + "', id::text, score, 0, 0 FROM (" + projectSearch.scored() + ") AS project_hits" +
+		" UNION ALL " + tagSearch +
+		" ORDER BY score DESC, type, key LIMIT @limit"
+
+	var hits []ContentSearchHit
+	err := searchRaw(d.db, sql, query, limit, &hits)
+	return hits, err
+}
+
+// hits returns up to limit matches for query, best first
+func (t searchTarget) hits(db *gorm.DB, query string, limit int) ([]SearchHit, error) {
+	var hits []SearchHit
+	err := searchRaw(db, t.scored()+" ORDER BY score DESC, id LIMIT @limit", query, limit, &hits)
+	return hits, err
+}
+
+// scored returns the query selecting the id and score of every row matching @query
+func (t searchTarget) scored() string {
+	tsQuery := "websearch_to_tsquery('english', @query)"
+
+	similarities := make([]string, 0, len(t.fuzzyColumns))
+	fuzzyMatches := make([]string, 0, len(t.fuzzyColumns))
+	for _, column := range t.fuzzyColumns {
+		similarities = append(similarities, "word_similarity(@query, "+column+")")
+		fuzzyMatches = append(fuzzyMatches, "@query <% "+column)
+	}
+
+	where := "(" + t.document + " @@ " + tsQuery + " OR " + strings.Join(fuzzyMatches, " OR ") + ")"
+	if t.visible != "" {
+		where += " AND " + t.visible
+	}
+
+	return "SELECT id, CASE WHEN " + t.document + " @@ " + tsQuery +
+		" THEN 1 + ts_rank(" + t.document + ", " + tsQuery + ")" +
+		" ELSE GREATEST(" + strings.Join(similarities, ", ") + ") END AS score" +
+		" FROM " + t.table +
+		" WHERE " + where
+}
+
+// searchRaw scans the rows sql, which takes @query and @limit, returns into dest, with fuzzySimilarityThreshold as
+// the trigram threshold
+func searchRaw(db *gorm.DB, sql, query string, limit int, dest any) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		// set_config with is_local=true only lasts for this transaction, so pooled connections keep the default
+		if err := tx.Exec("SELECT set_config('pg_trgm.word_similarity_threshold', ?, true)", fuzzySimilarityThreshold).Error; err != nil {
+			return err
+		}
+		return tx.Raw(sql, map[string]any{"query": query, "limit": limit}).Scan(dest).Error
+	})
+}
+
+// indexStatements returns the statements creating the indexes this target's search relies on
+func (t searchTarget) indexStatements() []string {
+	statements := []string{
+		"CREATE INDEX IF NOT EXISTS idx_" + t.table + "_search_document ON " + t.table + " USING gin ((" + t.document + "))",
+	}
+	for _, column := range t.fuzzyColumns {
+		statements = append(statements,
+			"CREATE INDEX IF NOT EXISTS idx_"+t.table+"_"+column+"_trgm ON "+t.table+" USING gin ("+column+" gin_trgm_ops)")
+	}
+	return statements
+}
+
+// CreateSearchIndexes creates the full-text and trigram indexes used by blog post and project search
+// It needs the pg_trgm extension and is safe to run repeatedly
+func (d Database) CreateSearchIndexes() error {
+	for _, target := range []searchTarget{blogPostSearch, projectSearch} {
+		for _, statement := range target.indexStatements() {
+			if err := d.db.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hitIDs returns the IDs of hits in rank order
+func hitIDs(hits []SearchHit) []uuid.UUID {
+	ids := make([]uuid.UUID, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+	}
+	return ids
+}
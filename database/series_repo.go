@@ -0,0 +1,84 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SeriesRepo struct {
+	db *gorm.DB
+}
+
+func NewSeriesRepo(db *gorm.DB) *SeriesRepo {
+	return &SeriesRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *SeriesRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindAll returns every series, newest first
+func (r *SeriesRepo) FindAll() ([]*models.Series, error) {
+	var series []*models.Series
+	err := r.db.Order("date_added DESC").Order("id DESC").Find(&series).Error
+	return series, err
+}
+
+// FindBySlug returns the series with slug
+func (r *SeriesRepo) FindBySlug(slug string) (*models.Series, error) {
+	var series models.Series
+	err := r.db.Where("slug = ?", slug).First(&series).Error
+	if err != nil {
+		return nil, err
+	}
+	return &series, nil
+}
+
+// Add inserts a new series into the database
+func (r *SeriesRepo) Add(series *models.Series) error {
+	return r.db.Create(series).Error
+}
+
+// Update updates an existing series in the database
+func (r *SeriesRepo) Update(series *models.Series) error {
+	return r.db.Save(series).Error
+}
+
+// Delete removes a series from the database by id. Its posts are detached, not deleted
+func (r *SeriesRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Series{}, id).Error
+}
+
+// FindPosts returns the posts placed in a series, in order, whether they're published or not
+func (r *SeriesRepo) FindPosts(seriesID uuid.UUID) ([]models.SeriesPost, error) {
+	var posts []models.SeriesPost
+	err := r.db.Where("series_id = ?", seriesID).Order("position").Find(&posts).Error
+	return posts, err
+}
+
+// NextPosition returns the position after the last post in a series, or 1 for an empty series
+func (r *SeriesRepo) NextPosition(seriesID uuid.UUID) (int, error) {
+	var position int
+	err := r.db.Model(&models.SeriesPost{}).
+		Select("COALESCE(MAX(position), 0) + 1").
+		Where("series_id = ?", seriesID).
+		Scan(&position).Error
+	return position, err
+}
+
+// SavePost places a blog post in a series, moving it there from the series or position it was in before
+func (r *SeriesRepo) SavePost(post *models.SeriesPost) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "blog_post_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"series_id", "position"}),
+	}).Create(post).Error
+}
+
+// DeletePost takes a blog post out of a series, reporting whether it was in it
+func (r *SeriesRepo) DeletePost(seriesID, blogPostID uuid.UUID) (bool, error) {
+	result := r.db.Where("series_id = ? AND blog_post_id = ?", seriesID, blogPostID).Delete(&models.SeriesPost{})
+	return result.RowsAffected > 0, result.Error
+}
@@ -0,0 +1,42 @@
+package database
+
+import (
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ShareLinkRepo struct {
+	db *gorm.DB
+}
+
+func NewShareLinkRepo(db *gorm.DB) *ShareLinkRepo {
+	return &ShareLinkRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *ShareLinkRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// Add inserts a new share link
+func (r *ShareLinkRepo) Add(shareLink *models.ShareLink) error {
+	return r.db.Create(shareLink).Error
+}
+
+// RecordClick adds one click to the share link with token and returns the updated link
+// The increment happens in the database, so concurrent clicks are never lost
+func (r *ShareLinkRepo) RecordClick(token string) (*models.ShareLink, error) {
+	var shareLinks []models.ShareLink
+	result := r.db.Model(&shareLinks).
+		Clauses(clause.Returning{}).
+		Where("token = ?", token).
+		Update("clicks", gorm.Expr("clicks + 1"))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(shareLinks) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &shareLinks[0], nil
+}
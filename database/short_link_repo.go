@@ -0,0 +1,153 @@
+package database
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// shortLinkCodeAlphabet is what generated codes are made of: letters and digits, which need no escaping in a URL
+	shortLinkCodeAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	// shortLinkCodeLength gives 62^6, over 56 billion, codes, so a random one is all but certain to be free
+	shortLinkCodeLength = 6
+	// shortLinkCodeAttempts is how many random codes are tried before giving up on finding a free one
+	shortLinkCodeAttempts = 5
+)
+
+type ShortLinkRepo struct {
+	db *gorm.DB
+}
+
+func NewShortLinkRepo(db *gorm.DB) *ShortLinkRepo {
+	return &ShortLinkRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *ShortLinkRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// Add inserts a new short link, generating its code unless one is given
+// A code that's taken is reported as a duplicate; a generated one is replaced until a free one is found
+func (r *ShortLinkRepo) Add(shortLink *models.ShortLink) error {
+	if shortLink.Code != "" {
+		return r.db.Create(shortLink).Error
+	}
+	for range shortLinkCodeAttempts {
+		code, err := newShortLinkCode()
+		if err != nil {
+			return err
+		}
+		shortLink.Code = code
+		result := r.db.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "code"}}, DoNothing: true}).Create(shortLink)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 1 {
+			return nil
+		}
+	}
+	shortLink.Code = ""
+	return fmt.Errorf("no free short link code found in %d attempts", shortLinkCodeAttempts)
+}
+
+// FindForContent returns the short link to the blog post or project with contentID
+func (r *ShortLinkRepo) FindForContent(contentType string, contentID uuid.UUID) (*models.ShortLink, error) {
+	var shortLink models.ShortLink
+	err := r.db.Where("content_type = ? AND content_id = ?", contentType, contentID).First(&shortLink).Error
+	if err != nil {
+		return nil, err
+	}
+	return &shortLink, nil
+}
+
+// ForContent returns the short link to the blog post or project with contentID, creating one to targetURL if there
+// isn't one yet. An existing link is pointed at targetURL if the content has moved
+func (r *ShortLinkRepo) ForContent(contentType string, contentID uuid.UUID, targetURL string) (*models.ShortLink, error) {
+	shortLink, err := r.FindForContent(contentType, contentID)
+	if err == nil {
+		if shortLink.TargetURL != targetURL {
+			shortLink.TargetURL = targetURL
+			if err := r.db.Model(shortLink).Update("target_url", targetURL).Error; err != nil {
+				return nil, err
+			}
+		}
+		return shortLink, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	shortLink = &models.ShortLink{
+		ContentType: contentType,
+		ContentID:   &contentID,
+		TargetURL:   targetURL,
+		DateAdded:   time.Now(),
+	}
+	if err := r.Add(shortLink); err != nil {
+		return nil, err
+	}
+	return shortLink, nil
+}
+
+// RecordClick adds one click to the short link with code and returns the updated link
+// The increment happens in the database, so concurrent clicks are never lost
+func (r *ShortLinkRepo) RecordClick(code string) (*models.ShortLink, error) {
+	var shortLinks []models.ShortLink
+	result := r.db.Model(&shortLinks).
+		Clauses(clause.Returning{}).
+		Where("code = ?", code).
+		Update("clicks", gorm.Expr("clicks + 1"))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(shortLinks) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &shortLinks[0], nil
+}
+
+// FindPage returns one page of short links, newest first, along with the total
+func (r *ShortLinkRepo) FindPage(offset, limit int) ([]*models.ShortLink, int64, error) {
+	var total int64
+	if err := r.db.Model(&models.ShortLink{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var shortLinks []*models.ShortLink
+	err := r.db.Order("date_added DESC").Order("id").Offset(offset).Limit(limit).Find(&shortLinks).Error
+	return shortLinks, total, err
+}
+
+// Delete removes the short link with id, returning gorm.ErrRecordNotFound if there's none
+func (r *ShortLinkRepo) Delete(id uuid.UUID) error {
+	result := r.db.Delete(&models.ShortLink{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// newShortLinkCode returns a random code of shortLinkCodeLength letters and digits
+func newShortLinkCode() (string, error) {
+	code := make([]byte, shortLinkCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(shortLinkCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		code[i] = shortLinkCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
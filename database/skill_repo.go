@@ -0,0 +1,59 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type SkillRepo struct {
+	db *gorm.DB
+}
+
+func NewSkillRepo(db *gorm.DB) *SkillRepo {
+	return &SkillRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *SkillRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindAll returns all skills ordered by category and name
+func (r *SkillRepo) FindAll() ([]*models.Skill, error) {
+	var skills []*models.Skill
+	err := r.db.Order("category ASC").Order("name ASC").Find(&skills).Error
+	return skills, err
+}
+
+// FindByCategory returns the skills in a single category ordered by name
+func (r *SkillRepo) FindByCategory(category string) ([]*models.Skill, error) {
+	var skills []*models.Skill
+	err := r.db.Where("category = ?", category).Order("name ASC").Find(&skills).Error
+	return skills, err
+}
+
+// FindByID returns a skill by its ID
+func (r *SkillRepo) FindByID(id uuid.UUID) (*models.Skill, error) {
+	var skill models.Skill
+	err := r.db.First(&skill, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &skill, nil
+}
+
+// Add inserts a new skill into the database
+func (r *SkillRepo) Add(skill *models.Skill) error {
+	return r.db.Create(skill).Error
+}
+
+// Update updates an existing skill in the database
+func (r *SkillRepo) Update(skill *models.Skill) error {
+	return r.db.Save(skill).Error
+}
+
+// Delete removes a skill from the database by id
+func (r *SkillRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Skill{}, id).Error
+}
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type SnippetRepo struct {
+	db *gorm.DB
+}
+
+func NewSnippetRepo(db *gorm.DB) *SnippetRepo {
+	return &SnippetRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *SnippetRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindPage returns one page of snippets, newest first, along with how many there are in total
+// Only snippets in language, and tagged with tag, are included when they're set
+func (r *SnippetRepo) FindPage(language, tag string, offset, limit int) ([]*models.Snippet, int64, error) {
+	query := r.db.Model(&models.Snippet{})
+	if language != "" {
+		query = query.Where("LOWER(language) = LOWER(?)", language)
+	}
+	if tag != "" {
+		tags, err := json.Marshal([]string{tag})
+		if err != nil {
+			return nil, 0, err
+		}
+		query = query.Where("tags @> ?::jsonb", string(tags))
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var snippets []*models.Snippet
+	err := query.Order("date_added DESC").
+		Order("id DESC").
+		Offset(offset).
+		Limit(pageLimit(limit)).
+		Find(&snippets).Error
+	return snippets, total, err
+}
+
+// FindByID returns a snippet by its ID
+func (r *SnippetRepo) FindByID(id uuid.UUID) (*models.Snippet, error) {
+	var snippet models.Snippet
+	err := r.db.First(&snippet, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &snippet, nil
+}
+
+// FindByIDs returns the snippets with ids, keyed by ID. IDs no snippet has are left out
+func (r *SnippetRepo) FindByIDs(ids []uuid.UUID) (map[uuid.UUID]models.Snippet, error) {
+	snippets := make(map[uuid.UUID]models.Snippet, len(ids))
+	if len(ids) == 0 {
+		return snippets, nil
+	}
+
+	var found []models.Snippet
+	if err := r.db.Where("id IN ?", ids).Find(&found).Error; err != nil {
+		return nil, err
+	}
+	for _, snippet := range found {
+		snippets[snippet.ID] = snippet
+	}
+	return snippets, nil
+}
+
+// Add inserts a new snippet into the database
+func (r *SnippetRepo) Add(snippet *models.Snippet) error {
+	return r.db.Create(snippet).Error
+}
+
+// Update updates an existing snippet in the database
+func (r *SnippetRepo) Update(snippet *models.Snippet) error {
+	return r.db.Save(snippet).Error
+}
+
+// Delete removes a snippet from the database by id
+func (r *SnippetRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Snippet{}, id).Error
+}
@@ -0,0 +1,86 @@
+package database
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+// PlatformCount is how many social posts to one platform succeeded and failed
+type PlatformCount struct {
+	Platform  string
+	Succeeded int64
+	Failed    int64
+}
+
+type SocialPostRepo struct {
+	db *gorm.DB
+}
+
+func NewSocialPostRepo(db *gorm.DB) *SocialPostRepo {
+	return &SocialPostRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *SocialPostRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// AddAll inserts social post records in one statement
+func (r *SocialPostRepo) AddAll(socialPosts []models.SocialPost) error {
+	if len(socialPosts) == 0 {
+		return nil
+	}
+	return r.db.Create(&socialPosts).Error
+}
+
+// FindByContent returns the social posts made for one blog post or note, newest first
+func (r *SocialPostRepo) FindByContent(contentType string, contentID uuid.UUID) ([]*models.SocialPost, error) {
+	var socialPosts []*models.SocialPost
+	err := r.db.Where("content_type = ? AND content_id = ?", contentType, contentID).
+		Preload("ShareLink").
+		Order("date_added DESC").
+		Find(&socialPosts).Error
+	return socialPosts, err
+}
+
+// FindPage returns one page of social posts, newest first, with their share links, along with the total matching
+// contentType and contentID are optional filters
+func (r *SocialPostRepo) FindPage(contentType string, contentID *uuid.UUID, offset, limit int) ([]*models.SocialPost, int64, error) {
+	query := r.db.Model(&models.SocialPost{})
+	if contentType != "" {
+		query = query.Where("content_type = ?", contentType)
+	}
+	if contentID != nil {
+		query = query.Where("content_id = ?", *contentID)
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var socialPosts []*models.SocialPost
+	err := query.Preload("ShareLink").
+		Order("date_added DESC").
+		Order("id DESC").
+		Offset(offset).
+		Limit(pageLimit(limit)).
+		Find(&socialPosts).Error
+	return socialPosts, total, err
+}
+
+// CountByPlatform counts succeeded and failed social posts per platform made from from up to, not including, to
+func (r *SocialPostRepo) CountByPlatform(from, to time.Time) ([]PlatformCount, error) {
+	var counts []PlatformCount
+	err := r.db.Model(&models.SocialPost{}).
+		Select("platform, count(*) FILTER (WHERE status = ?) AS succeeded, count(*) FILTER (WHERE status = ?) AS failed",
+			models.SocialPostStatusSucceeded, models.SocialPostStatusFailed).
+		Where("date_added >= ? AND date_added < ?", from, to).
+		Group("platform").
+		Order("platform").
+		Scan(&counts).Error
+	return counts, err
+}
@@ -0,0 +1,40 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// findTagValues returns the tag values of each of ids, sorted, keyed by ID, in a single aggregated query
+// tagTable and tagForeignKey name the tag table and its column referencing the content row. IDs without tags are
+// missing from the map
+func findTagValues(db *gorm.DB, tagTable, tagForeignKey string, ids []uuid.UUID) (map[uuid.UUID][]string, error) {
+	values := make(map[uuid.UUID][]string, len(ids))
+	if len(ids) == 0 {
+		return values, nil
+	}
+
+	var rows []struct {
+		OwnerID   uuid.UUID
+		TagValues string
+	}
+	err := db.Table(tagTable).
+		Select(tagForeignKey+" AS owner_id, json_agg(value ORDER BY value) AS tag_values").
+		Where(tagForeignKey+" IN ?", ids).
+		Group(tagForeignKey).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		var tags []string
+		if err := json.Unmarshal([]byte(row.TagValues), &tags); err != nil {
+			return nil, fmt.Errorf("failed to decode tag values of %s: %w", row.OwnerID, err)
+		}
+		values[row.OwnerID] = tags
+	}
+	return values, nil
+}
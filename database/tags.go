@@ -0,0 +1,81 @@
+package database
+
+import (
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+// TagUsage is a tag and how many blog posts and projects carry it. Tags aren't rows of their own but values on each
+// post and project, so a tag is named by its value and counts every post and project, drafts and the trash included
+type TagUsage struct {
+	Value     string
+	BlogPosts int
+	Projects  int
+}
+
+// tagUsageQuery counts the blog posts and projects carrying each tag value
+const tagUsageQuery = "SELECT value, SUM(blog_posts) AS blog_posts, SUM(projects) AS projects FROM (" +
+	"SELECT value, 1 AS blog_posts, 0 AS projects FROM blog_tags UNION ALL SELECT value, 0, 1 FROM project_tags" +
+	") AS tags"
+
+// FindTagUsage returns every tag on a blog post or project, most used first and then alphabetically
+func (d Database) FindTagUsage() ([]TagUsage, error) {
+	var usage []TagUsage
+	err := d.db.Raw(tagUsageQuery + " GROUP BY value ORDER BY SUM(blog_posts) + SUM(projects) DESC, value").Scan(&usage).Error
+	return usage, err
+}
+
+// FindTag returns the usage of the tag value, or gorm.ErrRecordNotFound when nothing carries it
+func (d Database) FindTag(value string) (TagUsage, error) {
+	var usage []TagUsage
+	if err := d.db.Raw(tagUsageQuery+" WHERE value = ? GROUP BY value", value).Scan(&usage).Error; err != nil {
+		return TagUsage{}, err
+	}
+	if len(usage) == 0 {
+		return TagUsage{}, gorm.ErrRecordNotFound
+	}
+	return usage[0], nil
+}
+
+// MergeTag moves the tag from onto into, on every blog post and project carrying it, in one transaction. Posts and
+// projects that already carry into just lose from. It's how tags are renamed too, into being any value. It returns
+// the usage of into afterwards
+func (d Database) MergeTag(from, into string) (TagUsage, error) {
+	err := d.db.Transaction(func(tx *gorm.DB) error {
+		if err := mergeTagValue(tx, &models.BlogTag{}, "blog_post_id", from, into); err != nil {
+			return err
+		}
+		return mergeTagValue(tx, &models.ProjectTag{}, "project_id", from, into)
+	})
+	if err != nil {
+		return TagUsage{}, err
+	}
+	return d.FindTag(into)
+}
+
+// DeleteTag removes the tag value from every blog post and project carrying it, returning how many it was removed
+// from
+func (d Database) DeleteTag(value string) (int64, error) {
+	var removed int64
+	err := d.db.Transaction(func(tx *gorm.DB) error {
+		for _, model := range []any{&models.BlogTag{}, &models.ProjectTag{}} {
+			result := tx.Where("value = ?", value).Delete(model)
+			if result.Error != nil {
+				return result.Error
+			}
+			removed += result.RowsAffected
+		}
+		return nil
+	})
+	return removed, err
+}
+
+// mergeTagValue changes from to into in the tag table of model, whose rows belong to the owner in ownerColumn. Rows
+// of owners that already have into are deleted instead, since an owner can carry a value only once
+func mergeTagValue(tx *gorm.DB, model any, ownerColumn, from, into string) error {
+	owners := tx.Session(&gorm.Session{NewDB: true}).Model(model).Select(ownerColumn).Where("value = ?", into)
+	if err := tx.Where("value = ? AND "+ownerColumn+" IN (?)", from, owners).Delete(model).Error; err != nil {
+		return err
+	}
+	return tx.Model(model).Where("value = ?", from).Update("value", into).Error
+}
@@ -0,0 +1,69 @@
+package database
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type TestimonialRepo struct {
+	db *gorm.DB
+}
+
+func NewTestimonialRepo(db *gorm.DB) *TestimonialRepo {
+	return &TestimonialRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *TestimonialRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindAll returns all testimonials, newest first
+func (r *TestimonialRepo) FindAll() ([]*models.Testimonial, error) {
+	var testimonials []*models.Testimonial
+	err := r.db.Order("date_submitted DESC").Find(&testimonials).Error
+	return testimonials, err
+}
+
+// FindByApproved returns the testimonials with the given approval state, newest first
+func (r *TestimonialRepo) FindByApproved(approved bool) ([]*models.Testimonial, error) {
+	var testimonials []*models.Testimonial
+	err := r.db.Where("approved = ?", approved).Order("date_submitted DESC").Find(&testimonials).Error
+	return testimonials, err
+}
+
+// FindByID returns a testimonial by its ID
+func (r *TestimonialRepo) FindByID(id uuid.UUID) (*models.Testimonial, error) {
+	var testimonial models.Testimonial
+	err := r.db.First(&testimonial, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &testimonial, nil
+}
+
+// Add inserts a new testimonial into the database
+func (r *TestimonialRepo) Add(testimonial *models.Testimonial) error {
+	return r.db.Create(testimonial).Error
+}
+
+// SetApproved updates the approval state of a testimonial, recording when it was approved
+func (r *TestimonialRepo) SetApproved(id uuid.UUID, approved bool) error {
+	var dateApproved *time.Time
+	if approved {
+		now := time.Now()
+		dateApproved = &now
+	}
+	return r.db.Model(&models.Testimonial{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"approved":      approved,
+		"date_approved": dateApproved,
+	}).Error
+}
+
+// Delete removes a testimonial from the database by id
+func (r *TestimonialRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Testimonial{}, id).Error
+}
@@ -0,0 +1,52 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type UsesItemRepo struct {
+	db *gorm.DB
+}
+
+func NewUsesItemRepo(db *gorm.DB) *UsesItemRepo {
+	return &UsesItemRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *UsesItemRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindAll returns all uses items ordered by category, then display order and name
+func (r *UsesItemRepo) FindAll() ([]*models.UsesItem, error) {
+	var usesItems []*models.UsesItem
+	err := r.db.Order("category ASC").Order("display_order ASC").Order("name ASC").Find(&usesItems).Error
+	return usesItems, err
+}
+
+// FindByID returns a uses item by its ID
+func (r *UsesItemRepo) FindByID(id uuid.UUID) (*models.UsesItem, error) {
+	var usesItem models.UsesItem
+	err := r.db.First(&usesItem, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &usesItem, nil
+}
+
+// Add inserts a new uses item into the database
+func (r *UsesItemRepo) Add(usesItem *models.UsesItem) error {
+	return r.db.Create(usesItem).Error
+}
+
+// Update updates an existing uses item in the database
+func (r *UsesItemRepo) Update(usesItem *models.UsesItem) error {
+	return r.db.Save(usesItem).Error
+}
+
+// Delete removes a uses item from the database by id
+func (r *UsesItemRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.UsesItem{}, id).Error
+}
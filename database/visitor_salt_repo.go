@@ -0,0 +1,43 @@
+package database
+
+import (
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type VisitorSaltRepo struct {
+	db *gorm.DB
+}
+
+func NewVisitorSaltRepo(db *gorm.DB) *VisitorSaltRepo {
+	return &VisitorSaltRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *VisitorSaltRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindOrCreate returns the salt for day, storing salt as that day's salt if it has none yet
+// When several instances race, the first insert wins and every instance gets the same salt back
+func (r *VisitorSaltRepo) FindOrCreate(day time.Time, salt []byte) ([]byte, error) {
+	day = day.UTC().Truncate(24 * time.Hour)
+	candidate := models.VisitorSalt{Day: day, Salt: salt}
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&candidate).Error; err != nil {
+		return nil, err
+	}
+
+	var stored models.VisitorSalt
+	if err := r.db.Where("day = ?", day).First(&stored).Error; err != nil {
+		return nil, err
+	}
+	return stored.Salt, nil
+}
+
+// DeleteBefore removes the salts of every day before day
+func (r *VisitorSaltRepo) DeleteBefore(day time.Time) error {
+	return r.db.Where("day < ?", day.UTC().Truncate(24*time.Hour)).Delete(&models.VisitorSalt{}).Error
+}
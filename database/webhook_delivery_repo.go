@@ -0,0 +1,105 @@
+package database
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WebhookDeliveryRepo struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryRepo(db *gorm.DB) *WebhookDeliveryRepo {
+	return &WebhookDeliveryRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *WebhookDeliveryRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindPageByWebhook returns one page of a webhook's deliveries, newest first, along with the total count
+func (r *WebhookDeliveryRepo) FindPageByWebhook(webhookID uuid.UUID, offset, limit int) ([]*models.WebhookDelivery, int64, error) {
+	var total int64
+	if err := r.db.Model(&models.WebhookDelivery{}).Where("webhook_id = ?", webhookID).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var deliveries []*models.WebhookDelivery
+	err := r.db.Where("webhook_id = ?", webhookID).
+		Order("date_added DESC").
+		Order("id DESC").
+		Offset(offset).
+		Limit(pageLimit(limit)).
+		Find(&deliveries).Error
+	return deliveries, total, err
+}
+
+// FindByID returns a delivery by its ID
+func (r *WebhookDeliveryRepo) FindByID(id uuid.UUID) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	err := r.db.First(&delivery, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// AddAll inserts deliveries in one statement
+func (r *WebhookDeliveryRepo) AddAll(deliveries []models.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return r.db.Omit(clause.Associations).Create(&deliveries).Error
+}
+
+// Update updates an existing delivery in the database
+func (r *WebhookDeliveryRepo) Update(delivery *models.WebhookDelivery) error {
+	return r.db.Omit(clause.Associations).Save(delivery).Error
+}
+
+// ClaimDue returns up to limit pending deliveries whose next attempt is due, with their webhooks preloaded
+// Claimed rows have their next attempt pushed back by lease so another worker won't pick them up
+// while this one is still sending them
+func (r *WebhookDeliveryRepo) ClaimDue(now time.Time, limit int, lease time.Duration) ([]*models.WebhookDelivery, error) {
+	var deliveries []*models.WebhookDelivery
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryPending, now).
+			Order("next_attempt_at ASC").
+			Limit(limit).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Find(&deliveries).Error
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+
+		return tx.Model(&models.WebhookDelivery{}).
+			Where("id IN ?", deliveryIDs(deliveries)).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil || len(deliveries) == 0 {
+		return nil, err
+	}
+
+	err = r.db.Preload("Webhook").Where("id IN ?", deliveryIDs(deliveries)).Find(&deliveries).Error
+	return deliveries, err
+}
+
+// Release makes claimed deliveries due again immediately, for when the worker stops before sending them
+func (r *WebhookDeliveryRepo) Release(deliveries []*models.WebhookDelivery) error {
+	return r.db.Model(&models.WebhookDelivery{}).
+		Where("id IN ? AND status = ?", deliveryIDs(deliveries), models.WebhookDeliveryPending).
+		Update("next_attempt_at", time.Now()).Error
+}
+
+func deliveryIDs(deliveries []*models.WebhookDelivery) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		ids = append(ids, delivery.ID)
+	}
+	return ids
+}
@@ -0,0 +1,59 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type WebhookRepo struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepo(db *gorm.DB) *WebhookRepo {
+	return &WebhookRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *WebhookRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindAll returns all webhooks, oldest first
+func (r *WebhookRepo) FindAll() ([]*models.Webhook, error) {
+	var webhooks []*models.Webhook
+	err := r.db.Order("date_added ASC").Find(&webhooks).Error
+	return webhooks, err
+}
+
+// FindSubscribed returns the active webhooks subscribed to event
+func (r *WebhookRepo) FindSubscribed(event string) ([]*models.Webhook, error) {
+	var webhooks []*models.Webhook
+	err := r.db.Where("active = ? AND events @> ?::jsonb", true, `["`+event+`"]`).Find(&webhooks).Error
+	return webhooks, err
+}
+
+// FindByID returns a webhook by its ID
+func (r *WebhookRepo) FindByID(id uuid.UUID) (*models.Webhook, error) {
+	var webhook models.Webhook
+	err := r.db.First(&webhook, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// Add inserts a new webhook into the database
+func (r *WebhookRepo) Add(webhook *models.Webhook) error {
+	return r.db.Create(webhook).Error
+}
+
+// Update updates an existing webhook in the database
+func (r *WebhookRepo) Update(webhook *models.Webhook) error {
+	return r.db.Save(webhook).Error
+}
+
+// Delete removes a webhook from the database by id, along with its delivery log
+func (r *WebhookRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Webhook{}, id).Error
+}
@@ -0,0 +1,69 @@
+package database
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"gorm.io/gorm"
+)
+
+type WorkExperienceRepo struct {
+	db *gorm.DB
+}
+
+func NewWorkExperienceRepo(db *gorm.DB) *WorkExperienceRepo {
+	return &WorkExperienceRepo{db}
+}
+
+// GetDB returns the underlying database connection for debugging purposes
+func (r *WorkExperienceRepo) GetDB() *gorm.DB {
+	return r.db
+}
+
+// FindAll returns all work experience entries, current positions first and then most recent first
+func (r *WorkExperienceRepo) FindAll() ([]*models.WorkExperience, error) {
+	var workExperiences []*models.WorkExperience
+	err := r.db.Order("end_date DESC NULLS FIRST").Order("start_date DESC").Find(&workExperiences).Error
+	return workExperiences, err
+}
+
+// FindByID returns a work experience entry by its ID
+func (r *WorkExperienceRepo) FindByID(id uuid.UUID) (*models.WorkExperience, error) {
+	var workExperience models.WorkExperience
+	err := r.db.First(&workExperience, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &workExperience, nil
+}
+
+// Add inserts a new work experience entry into the database
+func (r *WorkExperienceRepo) Add(workExperience *models.WorkExperience) error {
+	return r.db.Create(workExperience).Error
+}
+
+// Update updates an existing work experience entry in the database
+func (r *WorkExperienceRepo) Update(workExperience *models.WorkExperience) error {
+	return r.db.Save(workExperience).Error
+}
+
+// Delete removes a work experience entry from the database by id
+func (r *WorkExperienceRepo) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.WorkExperience{}, id).Error
+}
+
+// FindOverlapping returns entries at company whose date range overlaps [start, end]
+// A nil end means the position is ongoing. The entry with excludeID is ignored so updates don't clash with themselves
+func (r *WorkExperienceRepo) FindOverlapping(company string, start time.Time, end *time.Time, excludeID uuid.UUID) ([]*models.WorkExperience, error) {
+	query := r.db.Where("LOWER(company) = LOWER(?)", company).
+		Where("id <> ?", excludeID).
+		Where("end_date IS NULL OR end_date >= ?", start)
+	if end != nil {
+		query = query.Where("start_date <= ?", *end)
+	}
+
+	var workExperiences []*models.WorkExperience
+	err := query.Order("start_date DESC").Find(&workExperiences).Error
+	return workExperiences, err
+}
@@ -24,9 +24,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/blog-post": {
-            "post": {
-                "description": "Creates a new blog post in the database and posts it to all configured social media platforms",
+        "/admin/analytics/geo": {
+            "get": {
+                "description": "Returns page views and unique visitors per country (ISO 3166-1 alpha-2) and region (ISO 3166-2 subdivision code) over a date range, most viewed first. Locations are only recorded when GEOIP_DB_PATH points at a MaxMind database; views without one are counted under country \"\". Unique visitors are counted once per day they visited. Raw page views are kept for 90 days, so older ranges come back empty",
                 "consumes": [
                     "application/json"
                 ],
@@ -34,51 +34,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Blog Posts"
+                    "Analytics"
                 ],
-                "summary": "Create blog post",
+                "summary": "Get geographic breakdown",
                 "parameters": [
                     {
-                        "description": "Blog post data",
-                        "name": "blogPost",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.BlogPost"
-                        }
+                        "type": "string",
+                        "description": "First day to include (YYYY-MM-DD, UTC). Defaults to 29 days before to",
+                        "name": "from",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Main image URL for Substack posting",
-                        "name": "mainImageURL",
+                        "description": "Last day to include (YYYY-MM-DD, UTC). Defaults to today",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "blogPost",
+                            "project"
+                        ],
+                        "type": "string",
+                        "description": "Only include this content type",
+                        "name": "contentType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include this blog post or project",
+                        "name": "contentId",
                         "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created blog post with tags",
+                    "200": {
+                        "description": "Page views per country and region",
                         "schema": {
-                            "$ref": "#/definitions/api.BlogPostWithTags"
+                            "$ref": "#/definitions/api.GeoReport"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Invalid blog post data",
+                        "description": "Bad Request - Invalid date range or content filter",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error - Error creating blog post",
+                        "description": "Internal Server Error - Error fetching page views",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/blog-post/{blogPostID}": {
+        "/admin/analytics/overview": {
             "get": {
-                "description": "Retrieves detailed information about a specific blog post by ID with its tags",
+                "description": "Returns page views and unique visitors per day (every day in the range, including zeros), the 10 most viewed blog posts and projects, and per-platform social posting success rates, for one date range",
                 "consumes": [
                     "application/json"
                 ],
@@ -86,48 +110,59 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Blog Posts"
+                    "Analytics"
                 ],
-                "summary": "Get blog post",
+                "summary": "Get analytics overview",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Blog Post ID",
-                        "name": "blogPostID",
-                        "in": "path",
-                        "required": true
+                        "description": "First day to include (YYYY-MM-DD, UTC). Defaults to 29 days before to",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day to include (YYYY-MM-DD, UTC). Defaults to today",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Blog post details with tags",
+                        "description": "Dashboard numbers for the range",
                         "schema": {
-                            "$ref": "#/definitions/api.BlogPostWithTags"
+                            "$ref": "#/definitions/api.AnalyticsOverview"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Invalid blogPostID",
+                        "description": "Bad Request - Invalid date range",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found - Blog post not found",
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error - Error fetching blog post",
+                        "description": "Internal Server Error - Error fetching analytics",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
-                }
-            },
-            "put": {
-                "description": "Updates an existing blog post in the database",
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/analytics/referrers": {
+            "get": {
+                "description": "Returns the top referring hosts and utm_source values for each blog post and project over a date range, most viewed content first. Views without a referrer are counted as \"(direct)\". Raw page views are kept for 90 days, so older ranges come back empty",
                 "consumes": [
                     "application/json"
                 ],
@@ -135,57 +170,82 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Blog Posts"
+                    "Analytics"
                 ],
-                "summary": "Update blog post",
+                "summary": "Get referrers and UTM sources",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Blog Post ID",
-                        "name": "blogPostID",
-                        "in": "path",
-                        "required": true
+                        "description": "First day to include (YYYY-MM-DD, UTC). Defaults to 29 days before to",
+                        "name": "from",
+                        "in": "query"
                     },
                     {
-                        "description": "Updated blog post data",
-                        "name": "blogPost",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.BlogPost"
-                        }
+                        "type": "string",
+                        "description": "Last day to include (YYYY-MM-DD, UTC). Defaults to today",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "blogPost",
+                            "project"
+                        ],
+                        "type": "string",
+                        "description": "Only include this content type",
+                        "name": "contentType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include this blog post or project",
+                        "name": "contentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Maximum referrers and UTM sources listed per entry (max 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated blog post with tags",
+                        "description": "Referrers and UTM sources per blog post and project",
                         "schema": {
-                            "$ref": "#/definitions/api.BlogPostWithTags"
+                            "$ref": "#/definitions/api.ReferrerReport"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Invalid blog post data",
+                        "description": "Bad Request - Invalid date range, content filter or limit",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found - Blog post not found",
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error - Error updating blog post",
+                        "description": "Internal Server Error - Error fetching page views",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
-                }
-            },
-            "delete": {
-                "description": "Deletes a blog post from the database by ID",
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/bench": {
+            "post": {
+                "description": "Seeds published blog posts and projects, sends each list, detail and search endpoint the given number of GET requests through the router with the given concurrency, then removes the seed and reports latencies per endpoint. With seed=0 the existing content is used instead. Endpoints run one after another, and cacheHits shows how many responses came from the response cache. Benchmark requests aren't counted as views. Not available when APP_ENV=production",
                 "consumes": [
                     "application/json"
                 ],
@@ -193,53 +253,71 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Blog Posts"
+                    "Admin"
                 ],
-                "summary": "Delete blog post",
+                "summary": "Run benchmark",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Requests per endpoint (default 100, at most 1000)",
+                        "name": "requests",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Requests in flight at once (default 4, at most 32)",
+                        "name": "concurrency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Blog posts and projects to seed (default 50, at most 1000)",
+                        "name": "seed",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Blog Post ID",
-                        "name": "blogPostID",
-                        "in": "path",
-                        "required": true
+                        "description": "Search query (default benchmark, which matches the seeded content)",
+                        "name": "q",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success message",
+                        "description": "Latencies per endpoint",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/api.BenchReport"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Invalid blogPostID",
+                        "description": "Bad Request - Invalid requests, concurrency or seed",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found - Blog post not found",
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error - Error deleting blog post",
+                        "description": "Internal Server Error - Error seeding content",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/blog-posts": {
+        "/admin/blog-posts/unpublished": {
             "get": {
-                "description": "Retrieves all blog posts from the database with their associated tags",
+                "description": "Retrieves every draft and scheduled blog post with its tags, scheduled posts first in publishing order, then drafts newest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -249,12 +327,18 @@ const docTemplate = `{
                 "tags": [
                     "Blog Posts"
                 ],
-                "summary": "Get all blog posts",
+                "summary": "Get unpublished blog posts",
                 "responses": {
                     "200": {
-                        "description": "List of blog posts with tags",
+                        "description": "Draft and scheduled blog posts",
                         "schema": {
-                            "$ref": "#/definitions/api.BlogPostCollectionWithTags"
+                            "$ref": "#/definitions/api.UnpublishedBlogPosts"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "500": {
@@ -263,12 +347,17 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/project": {
-            "post": {
-                "description": "Creates a new project in the database",
+        "/admin/certifications/expiring": {
+            "get": {
+                "description": "Lists certifications that have already expired or will expire within the given number of days, soonest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -276,171 +365,313 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Certifications"
                 ],
-                "summary": "Create project",
+                "summary": "Get expiring certifications",
                 "parameters": [
                     {
-                        "description": "Project data",
-                        "name": "project",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Project"
-                        }
+                        "type": "integer",
+                        "default": 90,
+                        "description": "Look-ahead window in days",
+                        "name": "days",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created project with tags",
+                    "200": {
+                        "description": "Expiring certifications",
                         "schema": {
-                            "$ref": "#/definitions/api.ProjectWithTags"
+                            "$ref": "#/definitions/api.ExpiringCertificationReport"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Invalid project data",
+                        "description": "Bad Request - Invalid days",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error - Error creating project",
+                        "description": "Internal Server Error - Error fetching certifications",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/project/{projectID}": {
+        "/admin/doctor": {
             "get": {
-                "description": "Retrieves detailed information about a specific project by ID with its tags",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Looks for tags whose blog post, project or bookmark is gone, blog posts whose word count or reading time doesn't match their content, titles with no slug or one shared with another post, social post records for deleted blog posts or notes, and note, bookmark, project and blog post images that don't load. Nothing is changed; POST /admin/doctor/fix repairs the issues marked fixable",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Admin"
                 ],
-                "summary": "Get project",
+                "summary": "Check data consistency",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Project ID",
-                        "name": "projectID",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "default": true,
+                        "description": "Whether to request every image URL to check it loads, which is the slow part",
+                        "name": "media",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Project details with tags",
+                        "description": "Issues found",
                         "schema": {
-                            "$ref": "#/definitions/api.ProjectWithTags"
+                            "$ref": "#/definitions/api.DoctorReport"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Invalid projectID",
+                        "description": "Bad Request - Invalid media parameter",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found - Project not found",
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error - Error fetching project",
+                        "description": "Internal Server Error - Error checking the data",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
-                }
-            },
-            "put": {
-                "description": "Updates an existing project in the database",
-                "consumes": [
-                    "application/json"
-                ],
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/doctor/fix": {
+            "post": {
+                "description": "Deletes orphaned tags and social post records for deleted content and recounts blog post words, then reports the issues that are left, as GET /admin/doctor does. Slug and media issues are only reported",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Admin"
                 ],
-                "summary": "Update project",
+                "summary": "Fix data consistency issues",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Project ID",
-                        "name": "projectID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated project data",
-                        "name": "project",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Project"
-                        }
+                        "type": "boolean",
+                        "default": true,
+                        "description": "Whether to request every image URL to check it loads, which is the slow part",
+                        "name": "media",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated project with tags",
+                        "description": "Rows fixed and the issues left",
                         "schema": {
-                            "$ref": "#/definitions/api.ProjectWithTags"
+                            "$ref": "#/definitions/api.DoctorReport"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Invalid project data",
+                        "description": "Bad Request - Invalid media parameter",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found - Project not found",
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error - Error updating project",
+                        "description": "Internal Server Error - Error checking or fixing the data",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
-                }
-            },
-            "delete": {
-                "description": "Deletes a project from the database by ID",
-                "consumes": [
-                    "application/json"
-                ],
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/export/blog-posts": {
+            "get": {
+                "description": "Streams every blog post, including drafts and scheduled posts, with its full content and tags, in ID order. The response is sent in chunks as posts are read, so it isn't limited in size. If an error happens partway, the response is cut off, leaving invalid JSON, so a truncated export can't pass as complete",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Blog Posts"
                 ],
-                "summary": "Delete project",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Project ID",
-                        "name": "projectID",
-                        "in": "path",
-                        "required": true
-                    }
+                "summary": "Export all blog posts",
+                "responses": {
+                    "200": {
+                        "description": "Every blog post with its tags",
+                        "schema": {
+                            "$ref": "#/definitions/api.BlogPostExport"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/faqs": {
+            "get": {
+                "description": "Retrieves all FAQs, published or not, ordered by display order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FAQs"
+                ],
+                "summary": "Get all FAQs",
+                "responses": {
+                    "200": {
+                        "description": "List of FAQs",
+                        "schema": {
+                            "$ref": "#/definitions/api.FAQCollection"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching FAQs",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/guestbook": {
+            "get": {
+                "description": "Retrieves guestbook entries filtered by moderation status (pending, approved or all). Defaults to pending",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Guestbook"
+                ],
+                "summary": "Get guestbook entries for moderation",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pending",
+                            "approved",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "Moderation status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of guestbook entries",
+                        "schema": {
+                            "$ref": "#/definitions/api.GuestbookEntryCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid status",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching guestbook entries",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/guestbook-entry/{entryID}": {
+            "delete": {
+                "description": "Deletes a guestbook entry from the database by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Guestbook"
+                ],
+                "summary": "Delete guestbook entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Guestbook entry ID",
+                        "name": "entryID",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -453,29 +684,164 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Invalid projectID",
+                        "description": "Bad Request - Invalid entryID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Not Found - Project not found",
+                        "description": "Not Found - Guestbook entry not found",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error - Error deleting project",
+                        "description": "Internal Server Error - Error deleting guestbook entry",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/projects": {
+        "/admin/guestbook-entry/{entryID}/approve": {
+            "post": {
+                "description": "Approves a guestbook entry so it is shown publicly",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Guestbook"
+                ],
+                "summary": "Approve guestbook entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Guestbook entry ID",
+                        "name": "entryID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Approved guestbook entry",
+                        "schema": {
+                            "$ref": "#/definitions/models.GuestbookEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid entryID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Guestbook entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error approving guestbook entry",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/guestbook-entry/{entryID}/reject": {
+            "post": {
+                "description": "Marks a guestbook entry as not approved, hiding it from the public guestbook",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Guestbook"
+                ],
+                "summary": "Reject guestbook entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Guestbook entry ID",
+                        "name": "entryID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rejected guestbook entry",
+                        "schema": {
+                            "$ref": "#/definitions/models.GuestbookEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid entryID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Guestbook entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error rejecting guestbook entry",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/indexes": {
             "get": {
-                "description": "Retrieves all projects from the database with their associated tags",
+                "description": "Runs EXPLAIN on the queries behind the blog post and project lists, archive, tags and scheduled publishing, and lists the indexes they rely on that don't exist. A sequential scan on a small table is expected; one on a table missing an index is flagged with how to create it",
                 "consumes": [
                     "application/json"
                 ],
@@ -483,113 +849,11669 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Projects"
+                    "Admin"
                 ],
-                "summary": "Get all projects",
+                "summary": "Audit indexes",
                 "responses": {
                     "200": {
-                        "description": "List of projects with tags",
+                        "description": "Query plans and missing indexes",
                         "schema": {
-                            "$ref": "#/definitions/api.ProjectCollectionWithTags"
+                            "$ref": "#/definitions/api.IndexAuditReport"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error - Error fetching projects",
+                        "description": "Internal Server Error - Error explaining queries",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/jobs": {
+            "get": {
+                "description": "Lists the recurring background jobs hosted by this instance with their schedule, whether they are enabled, and the outcome of their last run. Statuses are kept in memory, so they start empty after a restart and only cover this instance",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scheduler"
+                ],
+                "summary": "Get scheduled jobs",
+                "responses": {
+                    "200": {
+                        "description": "Scheduled jobs",
+                        "schema": {
+                            "$ref": "#/definitions/api.ScheduledJobList"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/metrics": {
+            "get": {
+                "description": "Counts the error responses sent by this instance since it started, by error code and HTTP status, most frequent first. Counts are kept in memory, so they start over after a restart and only cover this instance",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metrics"
+                ],
+                "summary": "Get error metrics",
+                "responses": {
+                    "200": {
+                        "description": "Error metrics",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorMetrics"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/schema/drift": {
+            "get": {
+                "description": "Compares each table the models are migrated to with its model, in order of table name: columns the table has that the model doesn't (extraColumns), model columns the table is missing (missingColumns, every column when the table doesn't exist) and columns whose type differs from the one the model's gorm tag declares. It's the column-report command's comparison, for checking a deployed instance. Running migrate fixes missing tables and columns; extra columns and type changes have to be dealt with by hand",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Report schema drift",
+                "responses": {
+                    "200": {
+                        "description": "Drift per table",
+                        "schema": {
+                            "$ref": "#/definitions/api.SchemaDriftReport"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error reading the database schema",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/short-link": {
+            "post": {
+                "description": "Creates a short link, served at /s/{code}. A blog post or project gets at most one short link, so asking for one it already has returns that link with 200 instead; its target is updated if the content's URL has changed. Blog post links point at the post's URL, or BASE_URL/blog/{id}, and project links at BASE_URL/projects/{id}. code is generated unless given",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Short Links"
+                ],
+                "summary": "Create short link",
+                "parameters": [
+                    {
+                        "description": "What to link to",
+                        "name": "shortLink",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.ShortLinkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Existing short link",
+                        "schema": {
+                            "$ref": "#/definitions/api.ShortLinkResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Created short link",
+                        "schema": {
+                            "$ref": "#/definitions/api.ShortLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid short link data, or no BASE_URL to link content to",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post or project not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Code already taken",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating short link",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/short-link/{shortLinkID}": {
+            "delete": {
+                "description": "Deletes a short link by ID, so its code stops redirecting. A blog post or project whose link is deleted gets a new code the next time one is made for it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Short Links"
+                ],
+                "summary": "Delete short link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Short link ID",
+                        "name": "shortLinkID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid shortLinkID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Short link not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting short link",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/short-links": {
+            "get": {
+                "description": "Retrieves every short link, newest first, with its click count and, when SHORT_LINK_BASE_URL is set, the URL it's shared as",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Short Links"
+                ],
+                "summary": "Get short links",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (starts at 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Short links per page (max 100)",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of short links",
+                        "schema": {
+                            "$ref": "#/definitions/api.ShortLinkCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid pagination parameters",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching short links",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/social-posts": {
+            "get": {
+                "description": "Retrieves the record of every attempt to share a blog post or note on a social platform, newest first, with the tracked share link and its click count when one was used",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Social Posts"
+                ],
+                "summary": "Get social posts",
+                "parameters": [
+                    {
+                        "enum": [
+                            "blogPost",
+                            "note"
+                        ],
+                        "type": "string",
+                        "description": "Only include this content type",
+                        "name": "contentType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Only include this blog post or note",
+                        "name": "contentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (starts at 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Social posts per page (max 100)",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of social posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.SocialPostCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid filter or pagination parameters",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching social posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/social/health": {
+            "get": {
+                "description": "Verifies each configured platform's credentials with a lightweight authenticated call that posts nothing, reporting the account they belong to and, where the platform says, when the token expires. Tokens expiring within 14 days are reported as expiring. healthy is false when any configured platform's credentials are invalid, expiring or couldn't be checked; unconfigured platforms don't count against it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Social Posts"
+                ],
+                "summary": "Check social platform credentials",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated platforms to check (default all). Valid values: substack, medium, twitter, linkedin, mastodon",
+                        "name": "platforms",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Credential status of each platform",
+                        "schema": {
+                            "$ref": "#/definitions/api.CredentialHealthReport"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/testimonial/{testimonialID}": {
+            "delete": {
+                "description": "Deletes a testimonial from the database by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Testimonials"
+                ],
+                "summary": "Delete testimonial",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Testimonial ID",
+                        "name": "testimonialID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid testimonialID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Testimonial not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting testimonial",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/testimonial/{testimonialID}/approve": {
+            "post": {
+                "description": "Approves a testimonial so it is shown publicly",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Testimonials"
+                ],
+                "summary": "Approve testimonial",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Testimonial ID",
+                        "name": "testimonialID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Approved testimonial",
+                        "schema": {
+                            "$ref": "#/definitions/models.Testimonial"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid testimonialID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Testimonial not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error approving testimonial",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/testimonial/{testimonialID}/reject": {
+            "post": {
+                "description": "Marks a testimonial as not approved, hiding it from the public listing",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Testimonials"
+                ],
+                "summary": "Reject testimonial",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Testimonial ID",
+                        "name": "testimonialID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rejected testimonial",
+                        "schema": {
+                            "$ref": "#/definitions/models.Testimonial"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid testimonialID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Testimonial not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error rejecting testimonial",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/testimonials": {
+            "get": {
+                "description": "Retrieves testimonials filtered by moderation status (pending, approved or all). Defaults to pending",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Testimonials"
+                ],
+                "summary": "Get testimonials for moderation",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pending",
+                            "approved",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "Moderation status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of testimonials",
+                        "schema": {
+                            "$ref": "#/definitions/api.TestimonialCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid status",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching testimonials",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/webhook": {
+            "post": {
+                "description": "Subscribes a URL to one or more events (post.published, project.created, social.post.failed). A signing secret is generated when none is given. Deliveries are POSTed as JSON with X-Webhook-Event, X-Webhook-Delivery, X-Webhook-Timestamp and X-Webhook-Signature (sha256=HMAC-SHA256 of \"\u003ctimestamp\u003e.\u003cbody\u003e\") headers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Create webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook data",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Webhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created webhook, including its secret",
+                        "schema": {
+                            "$ref": "#/definitions/models.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid webhook data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating webhook",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/webhook-delivery/{deliveryID}/retry": {
+            "post": {
+                "description": "Puts a delivery back in the queue with a fresh set of attempts, e.g. after fixing the receiving endpoint",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Retry webhook delivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Delivery ID",
+                        "name": "deliveryID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Requeued delivery",
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid deliveryID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Delivery not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error requeueing delivery",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/webhook/{webhookID}": {
+            "get": {
+                "description": "Retrieves a specific webhook subscription by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Webhook ID",
+                        "name": "webhookID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid webhookID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching webhook",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "Updates a webhook's URL, events, description or active flag. The secret is kept when none is given",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Webhook ID",
+                        "name": "webhookID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated webhook data",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Webhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated webhook",
+                        "schema": {
+                            "$ref": "#/definitions/models.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid webhook data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating webhook",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "Deletes a webhook subscription by ID along with its delivery log",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Webhook ID",
+                        "name": "webhookID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid webhookID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting webhook",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/webhook/{webhookID}/deliveries": {
+            "get": {
+                "description": "Retrieves the delivery log for a webhook, newest first, with status, attempt count and the last response or error",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Webhook ID",
+                        "name": "webhookID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (starts at 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Deliveries per page (max 100)",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of deliveries",
+                        "schema": {
+                            "$ref": "#/definitions/api.WebhookDeliveryCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid webhookID or pagination parameters",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching deliveries",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/webhooks": {
+            "get": {
+                "description": "Retrieves all webhook subscriptions, including their signing secrets",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get webhooks",
+                "responses": {
+                    "200": {
+                        "description": "List of webhooks",
+                        "schema": {
+                            "$ref": "#/definitions/api.WebhookCollection"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching webhooks",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/analytics/pageview": {
+            "post": {
+                "description": "Records one page view for first-party analytics. UTM tags are read from the query string of path, then the query string and fragment are dropped; only the host of referrer is kept, and the user agent is stored as a SHA-256 hash. Unique visitors are counted with a salted hash of IP and user agent whose salt changes daily and is then deleted. When GEOIP_DB_PATH is set, only the country and region of the IP are stored. Views are rolled up into daily per-path counts in the background. When ANALYTICS_PROVIDER is plausible or umami the view is forwarded there instead and nothing is stored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Record page view",
+                "parameters": [
+                    {
+                        "description": "Page view",
+                        "name": "pageView",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.PageViewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Page view recorded",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid path or content",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests - Rate limit exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error recording page view",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/batch": {
+            "post": {
+                "description": "Runs a list of createBlogPost, createProject and attachTags operations in a single transaction. If any operation fails the whole batch is rolled back; the results show which operation failed and which were skipped. Blog posts created here are not cross-posted to social platforms",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Batch"
+                ],
+                "summary": "Run batch",
+                "parameters": [
+                    {
+                        "description": "Operations to run",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BatchRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "All operations succeeded and were committed",
+                        "schema": {
+                            "$ref": "#/definitions/api.BatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "An operation failed and the batch was rolled back",
+                        "schema": {
+                            "$ref": "#/definitions/api.BatchResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error running batch",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-post": {
+            "post": {
+                "description": "Creates a new blog post in the database. Published posts (the default) are posted to all configured social media platforms right away; status draft keeps the post hidden, and status scheduled with publishAt has the scheduler publish it at that time. Images can be given with the post, in gallery order, and at most one of them featured; the featured image is the post's main image on Substack and the other platforms",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Create blog post",
+                "parameters": [
+                    {
+                        "description": "Blog post data",
+                        "name": "blogPost",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BlogPost"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated: feature one of the post's images instead. Main image URL for Substack posting, used when the post has no featured image",
+                        "name": "mainImageURL",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created blog post with tags",
+                        "schema": {
+                            "$ref": "#/definitions/api.BlogPostWithTags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blog post data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating blog post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-post/{blogPostID}": {
+            "get": {
+                "description": "Retrieves detailed information about a specific blog post by ID with its tags. Drafts and scheduled posts are only returned with the backend password, or a preview token from POST /blog-post/{blogPostID}/preview-link. The post's translation into the negotiated locale is served in its place when there is one, and Content-Language says which was served",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Get blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preview token, which lets anyone read the draft until it expires",
+                        "name": "preview",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale to serve the post's translation in, e.g. pt; Accept-Language is used when not given",
+                        "name": "locale",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "markdown",
+                            "html"
+                        ],
+                        "type": "string",
+                        "default": "markdown",
+                        "description": "Whether content is served as the Markdown it's written in or rendered as sanitized HTML",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Blog post details with tags",
+                        "schema": {
+                            "$ref": "#/definitions/api.BlogPostWithTags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID, locale or format",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing blog post in the database. Status and publishAt are kept when left out; changing the status to published publishes the post now, dated now unless dateAdded is given. Images are left as they are; they're changed under /blog-post/{blogPostID}/images",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Update blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated blog post data",
+                        "name": "blogPost",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BlogPost"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated blog post with tags",
+                        "schema": {
+                            "$ref": "#/definitions/api.BlogPostWithTags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blog post data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating blog post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Moves a blog post to the trash by ID, from where POST /blog-post/{blogPostID}/restore brings it back. With permanent=true, which requires the backend password, the blog post and its tags are removed from the database instead, whether or not it's in the trash",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Delete blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Delete permanently instead of moving to the trash",
+                        "name": "permanent",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID or permanent",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required to delete permanently",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting blog post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-post/{blogPostID}/duplicate": {
+            "post": {
+                "description": "Copies a blog post's content, summary, tags, images and SEO metadata into a new draft, for reusing the format of a recurring post. Drafts and scheduled posts can be copied too, which is why this needs the backend password. The copy is titled after the original with \" (copy)\" after it, numbered when that's taken, e.g. \"Weekly Notes (copy 2)\", so its slug is one no other post has. The original's url, translations and series aren't copied. Duplicating doesn't cross-post or announce anything",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Duplicate blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "The new draft",
+                        "schema": {
+                            "$ref": "#/definitions/api.BlogPostWithTags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error copying blog post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/blog-post/{blogPostID}/export.md": {
+            "get": {
+                "description": "Returns the post as markdown with YAML frontmatter holding its title, slug, summary, tags, dates and canonical URL, ready to be dropped into a static site or kept as a backup. Snippet shortcodes on a line of their own are replaced with the snippet's code as a fenced code block. Drafts and scheduled posts are only returned with the backend password or a preview token, and are marked draft",
+                "produces": [
+                    "text/markdown"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Export blog post as markdown",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preview token from POST /blog-post/{blogPostID}/preview-link",
+                        "name": "preview",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Markdown file with YAML frontmatter",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-post/{blogPostID}/image/{imageID}": {
+            "put": {
+                "description": "Replaces an image's URL, alt text, caption, position and whether it's featured. Featuring it takes over from the post's previous featured image",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Update blog post image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Image ID",
+                        "name": "imageID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated image data",
+                        "name": "image",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BlogPostImage"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated image",
+                        "schema": {
+                            "$ref": "#/definitions/models.BlogPostImage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid image data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post or image not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating image",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes an image from a blog post's gallery. The other images keep their positions, and a post whose featured image is deleted has none until another is featured",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Delete blog post image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Image ID",
+                        "name": "imageID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID or imageID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Image not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting image",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-post/{blogPostID}/images": {
+            "get": {
+                "description": "Lists a blog post's images in gallery order, by position. The featured image, if any, is marked isFeatured. Only admins can see the images of drafts and scheduled posts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Get blog post images",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Blog post images",
+                        "schema": {
+                            "$ref": "#/definitions/api.BlogPostImageCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching images",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds an image to a blog post's gallery, after its last image unless a position is given. Featuring it makes it the post's main image in feeds, link previews and cross-posts, in place of the post's previous featured image",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Add blog post image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Image data",
+                        "name": "image",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BlogPostImage"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created image",
+                        "schema": {
+                            "$ref": "#/definitions/models.BlogPostImage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid image data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating image",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-post/{blogPostID}/meta": {
+            "get": {
+                "description": "Returns a published blog post's title, description, canonical URL and image for search engines, along with the OpenGraph and Twitter card meta tags for link previews, ready to render. The post's metaTitle, metaDescription and ogImageUrl are used when set, and its title, summary and featured image, or first image in its content, otherwise. The canonical URL is the post's url, or BASE_URL/blog/{id}. Posts translated into the negotiated locale are described in it, by their translated title and summary, with the canonical URL of the translation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Get blog post metadata",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale to describe a translated post in, e.g. pt; Accept-Language is used when not given",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Blog post metadata",
+                        "schema": {
+                            "$ref": "#/definitions/api.PageMeta"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID or locale",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-post/{blogPostID}/preview-link": {
+            "post": {
+                "description": "Signs a link that lets anyone holding it read a draft or scheduled post, with GET /blog-post/{blogPostID} or its export.md, until it expires. Give the token to the frontend as ?preview= to render the post for reviewers. Nothing is stored, so a link can't be revoked on its own; changing PREVIEW_LINK_SECRET revokes them all",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Create blog post preview link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "How long the link works, as a duration such as 24h (defaults to PREVIEW_LINK_TTL, 72h unless set; at most 720h)",
+                        "name": "expiresIn",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Preview link",
+                        "schema": {
+                            "$ref": "#/definitions/api.PreviewLink"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID or expiresIn",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/blog-post/{blogPostID}/preview-token": {
+            "post": {
+                "description": "Signs a token that lets anyone holding it read a draft or scheduled post at GET /preview/{token}, without knowing the post's ID, until it expires. The token also works as ?preview= on GET /blog-post/{blogPostID} and its export.md, like one from /blog-post/{blogPostID}/preview-link. Nothing is stored, so a token can't be revoked on its own; changing PREVIEW_LINK_SECRET revokes them all",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Create blog post preview token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "How long the token works, as a duration such as 24h (defaults to PREVIEW_LINK_TTL, 72h unless set; at most 720h)",
+                        "name": "expiresIn",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Preview token, with the GET /preview/{token} URL",
+                        "schema": {
+                            "$ref": "#/definitions/api.PreviewLink"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID or expiresIn",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/blog-post/{blogPostID}/restore": {
+            "post": {
+                "description": "Takes a blog post moved to the trash by DELETE /blog-post/{blogPostID} out of it again, with its tags",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Restore blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Restored blog post",
+                        "schema": {
+                            "$ref": "#/definitions/api.BlogPostWithTags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post not in the trash",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error restoring blog post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-post/{blogPostID}/translation/{locale}": {
+            "put": {
+                "description": "Adds the blog post's translation into locale, or replaces the one it has. The locale must be one of CONTENT_LOCALES other than the first, which posts are written in. Tags, dates and status are the post's own",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Put blog post translation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale, e.g. pt",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Translated title, summary and content",
+                        "name": "translation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BlogPostTranslation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Replaced translation",
+                        "schema": {
+                            "$ref": "#/definitions/models.BlogPostTranslation"
+                        }
+                    },
+                    "201": {
+                        "description": "Added translation",
+                        "schema": {
+                            "$ref": "#/definitions/models.BlogPostTranslation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID, locale or translation data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error saving translation",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes the blog post's translation into locale, so the post is served as written in that locale",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Delete blog post translation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale, e.g. pt",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID or locale",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Translation not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting translation",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-post/{blogPostID}/translations": {
+            "get": {
+                "description": "Lists a blog post's translations, ordered by locale, e.g. to link to each with hreflang. Those of drafts and scheduled posts are only returned with the backend password or a preview token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Get blog post translations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preview token from POST /blog-post/{blogPostID}/preview-link",
+                        "name": "preview",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The post's translations",
+                        "schema": {
+                            "$ref": "#/definitions/api.BlogPostTranslations"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching translations",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-post/{blogPostID}/view": {
+            "post": {
+                "description": "Counts a view of a published blog post for GET /blog-posts/popular. Visitors are told apart by the same daily salted hash of IP and user agent page views use, and a visitor's views are only counted once per BLOG_POST_VIEW_WINDOW (defaults to 30 minutes), so reloads don't inflate the count",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Record blog post view",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Whether the view was counted, and the post's views",
+                        "schema": {
+                            "$ref": "#/definitions/api.BlogPostViewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Blog post not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests - Rate limit exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error recording view",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-posts": {
+            "get": {
+                "description": "Retrieves one page of published blog posts from the database with their associated tags, newest first unless sort is given. Posts translated into the negotiated locale are served in it. Pages are numbered, or taken by cursor when cursor is given (empty for the first page): cursor pages are newest first by dateAdded and id, stay fast however deep they go and don't shift when posts are published, and give the next page's cursor as meta.nextCursor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Get all blog posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, wordCount (snake_case spellings such as date_added work too). Not allowed with cursor",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (starts at 1). Not allowed with cursor",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "meta.nextCursor of the previous page, or empty for the first page, to page by cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Blog posts per page (max 100)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given",
+                        "name": "locale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only blog posts with this tag, e.g. golang",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tags to filter by, e.g. golang,postgres (max 10 together with tag)",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "Whether blog posts need any of the tags or all of them",
+                        "name": "tagMode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "markdown",
+                            "html"
+                        ],
+                        "type": "string",
+                        "default": "markdown",
+                        "description": "Whether content is served as the Markdown it's written in or rendered as sanitized HTML",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of blog posts with tags",
+                        "schema": {
+                            "$ref": "#/definitions/api.BlogPostCollectionWithTags"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "links.next and links.prev as rel=\\\"next\\\" and rel=\\\"prev\\\" links, when there are such pages"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid sort, pagination, cursor, tag, locale or format parameters",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Moves blog posts to the trash by ID and/or filter (tag, addedBefore, addedAfter) in a single transaction. All given criteria must match and at least one is required. Requires the backend password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Bulk delete blog posts",
+                "parameters": [
+                    {
+                        "description": "IDs and/or filter selecting the blog posts to delete",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkDeleteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Summary of deleted blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkDeleteResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Malformed or empty selection",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/blog-posts/archive": {
+            "get": {
+                "description": "Returns post counts and post stubs (id, title, date) grouped by year and month, newest first, for an archive sidebar",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Get blog post archive",
+                "responses": {
+                    "200": {
+                        "description": "Blog posts grouped by year and month",
+                        "schema": {
+                            "$ref": "#/definitions/api.BlogPostArchive"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-posts/import": {
+            "post": {
+                "description": "Imports markdown documents with YAML frontmatter as blog posts, either sent as a JSON array of documents or uploaded as a zipped folder in the file form field, which is read like it is by /import/markdown. Frontmatter can set title (otherwise the document's fileName), slug, summary, date, publishAt, draft, tags and canonicalURL. A document whose slug matches an existing post's updates that post. Documents are published unless they're marked draft or scheduled with a future publishAt, and publishing here doesn't cross-post or announce them. Documents with publish: false are skipped. The tags given here are added to every post. Each document is saved on its own, so one failing doesn't stop the rest, and the report says what happened to each",
+                "consumes": [
+                    "application/json",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Import"
+                ],
+                "summary": "Import blog posts",
+                "parameters": [
+                    {
+                        "description": "Markdown documents, when not uploading a zip",
+                        "name": "documents",
+                        "in": "body",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.MarkdownDocument"
+                            }
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tags to add to every imported post; for a zip it can also be a form field",
+                        "name": "tags",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "What happened to each document",
+                        "schema": {
+                            "$ref": "#/definitions/api.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Malformed body, no documents, or not a zip with markdown files in it",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large - Body over 100 MB",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/blog-posts/popular": {
+            "get": {
+                "description": "Lists the published blog posts with the most views recorded by POST /blog-post/{blogPostID}/view in the period, most viewed first, as summaries with their view counts. Posts without views in the period are left out. Posts translated into the negotiated locale are served in it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Get popular blog posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "7d",
+                        "description": "How far back views count, in days or hours, e.g. 7d or 24h (max 365d)",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Maximum number of blog posts (max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Most viewed blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.PopularBlogPostCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid period, limit or locale",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-posts/search": {
+            "get": {
+                "description": "Full-text search over blog posts, merged with trigram similarity matches so misspelled queries (e.g. \"postgers\") still find results. Full-text matches rank first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Search blog posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query (max 200 characters)",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of results (max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Matching blog posts, best first",
+                        "schema": {
+                            "$ref": "#/definitions/api.BlogPostSearchResults"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing or invalid q or limit",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error searching blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-posts/summaries": {
+            "get": {
+                "description": "Retrieves one page of published blog posts for list views such as the homepage. Content is left out and tags are plain values, so the page is much smaller than GET /blog-posts. Newest first unless sort is given. Posts translated into the negotiated locale are served in it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Get blog post summaries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, wordCount (snake_case spellings such as date_added work too)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (starts at 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Blog posts per page (max 100)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given",
+                        "name": "locale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only blog posts with this tag, e.g. golang",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tags to filter by, e.g. golang,postgres (max 10 together with tag)",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "Whether blog posts need any of the tags or all of them",
+                        "name": "tagMode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of blog post summaries",
+                        "schema": {
+                            "$ref": "#/definitions/api.BlogPostSummaryCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid sort, pagination, tag or locale parameters",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/blog-posts/trash": {
+            "get": {
+                "description": "Retrieves one page of the blog posts moved to the trash, most recently deleted first, with their tags",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Get trashed blog posts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (starts at 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Blog posts per page (max 100)",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of trashed blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.TrashedBlogPostCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid pagination parameters",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/book": {
+            "post": {
+                "description": "Adds a new book. Cover, ISBN, OpenLibrary key and first publish year are looked up on OpenLibrary unless provided",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Books"
+                ],
+                "summary": "Create book",
+                "parameters": [
+                    {
+                        "description": "Book data",
+                        "name": "book",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Book"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created book",
+                        "schema": {
+                            "$ref": "#/definitions/models.Book"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid book data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating book",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/book/{bookID}": {
+            "get": {
+                "description": "Retrieves a specific book by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Books"
+                ],
+                "summary": "Get book",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Book ID",
+                        "name": "bookID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Book details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Book"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid bookID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Book not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching book",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing book in the reading list. OpenLibrary metadata is kept unless provided",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Books"
+                ],
+                "summary": "Update book",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Book ID",
+                        "name": "bookID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated book data",
+                        "name": "book",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Book"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated book",
+                        "schema": {
+                            "$ref": "#/definitions/models.Book"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid book data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Book not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating book",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a book from the reading list by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Books"
+                ],
+                "summary": "Delete book",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Book ID",
+                        "name": "bookID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid bookID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Book not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting book",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/bookmark": {
+            "post": {
+                "description": "Saves a new bookmark. Title, description and image are fetched from the target page's OpenGraph and meta tags unless provided in the request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Create bookmark",
+                "parameters": [
+                    {
+                        "description": "Bookmark data (only url is required)",
+                        "name": "bookmark",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Bookmark"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created bookmark",
+                        "schema": {
+                            "$ref": "#/definitions/models.Bookmark"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid bookmark data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - URL already bookmarked",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating bookmark",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/bookmark/{bookmarkID}": {
+            "get": {
+                "description": "Retrieves a specific bookmark by ID with its tags",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Get bookmark",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Bookmark ID",
+                        "name": "bookmarkID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bookmark details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Bookmark"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid bookmarkID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Bookmark not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching bookmark",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing bookmark and replaces its tags. Omitted title, description and image keep their current values",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Update bookmark",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Bookmark ID",
+                        "name": "bookmarkID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated bookmark data",
+                        "name": "bookmark",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Bookmark"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated bookmark",
+                        "schema": {
+                            "$ref": "#/definitions/models.Bookmark"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid bookmark data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Bookmark not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating bookmark",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a bookmark and its tags from the database by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Delete bookmark",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Bookmark ID",
+                        "name": "bookmarkID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid bookmarkID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Bookmark not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting bookmark",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/bookmarks": {
+            "get": {
+                "description": "Retrieves the bookmark feed with tags, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Get bookmarks",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (starts at 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Bookmarks per page (max 100)",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of bookmarks",
+                        "schema": {
+                            "$ref": "#/definitions/api.BookmarkCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid pagination parameters",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching bookmarks",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/books": {
+            "get": {
+                "description": "Retrieves all books in the reading list, optionally filtered by reading status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Books"
+                ],
+                "summary": "Get books",
+                "parameters": [
+                    {
+                        "enum": [
+                            "want_to_read",
+                            "reading",
+                            "finished"
+                        ],
+                        "type": "string",
+                        "description": "Reading status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of books",
+                        "schema": {
+                            "$ref": "#/definitions/api.BookCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid status",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching books",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/certification": {
+            "post": {
+                "description": "Creates a new certification. Omit expiryDate for certifications that never expire",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "Create certification",
+                "parameters": [
+                    {
+                        "description": "Certification data",
+                        "name": "certification",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Certification"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created certification",
+                        "schema": {
+                            "$ref": "#/definitions/models.Certification"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid certification data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating certification",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/certification/{certificationID}": {
+            "get": {
+                "description": "Retrieves a specific certification by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "Get certification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Certification ID",
+                        "name": "certificationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Certification details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Certification"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid certificationID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Certification not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching certification",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing certification in the database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "Update certification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Certification ID",
+                        "name": "certificationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated certification data",
+                        "name": "certification",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Certification"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated certification",
+                        "schema": {
+                            "$ref": "#/definitions/models.Certification"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid certification data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Certification not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating certification",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a certification from the database by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "Delete certification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Certification ID",
+                        "name": "certificationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid certificationID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Certification not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting certification",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/certifications": {
+            "get": {
+                "description": "Retrieves all certifications, most recently issued first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "Get certifications",
+                "responses": {
+                    "200": {
+                        "description": "List of certifications",
+                        "schema": {
+                            "$ref": "#/definitions/api.CertificationCollection"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching certifications",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/education": {
+            "get": {
+                "description": "Retrieves all education entries, most recent first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Education"
+                ],
+                "summary": "Get education",
+                "responses": {
+                    "200": {
+                        "description": "List of education entries",
+                        "schema": {
+                            "$ref": "#/definitions/api.EducationCollection"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching education",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new education entry. The end date may not be before the start date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Education"
+                ],
+                "summary": "Create education entry",
+                "parameters": [
+                    {
+                        "description": "Education data",
+                        "name": "education",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Education"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created education",
+                        "schema": {
+                            "$ref": "#/definitions/models.Education"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid education data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating education",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/education/{educationID}": {
+            "get": {
+                "description": "Retrieves a specific education entry by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Education"
+                ],
+                "summary": "Get education entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Education ID",
+                        "name": "educationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Education details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Education"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid educationID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Education not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching education",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing education entry. The end date may not be before the start date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Education"
+                ],
+                "summary": "Update education entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Education ID",
+                        "name": "educationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated education data",
+                        "name": "education",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Education"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated education",
+                        "schema": {
+                            "$ref": "#/definitions/models.Education"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid education data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Education not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating education",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a education entry from the database by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Education"
+                ],
+                "summary": "Delete education entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Education ID",
+                        "name": "educationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid educationID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Education not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting education",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/export": {
+            "get": {
+                "description": "Exports every blog post, including drafts and scheduled posts, and every project as markdown files with YAML frontmatter, for backups and moving the site elsewhere. Posts are written like /blog-post/{blogPostID}/export.md to blog-posts/{slug}.md, which POST /import/markdown and POST /blog-posts/import read back. Projects are written to projects/{slug}.md, with their description as the body and their type, links, dates and tags in the frontmatter. tags.json lists the tags in use on each, with how many carry them. The zip is streamed as it's written, so if an error happens partway it's cut off, leaving an invalid archive. With format=json the same files are returned as one JSON bundle instead. Items in the trash are left out",
+                "produces": [
+                    "application/zip",
+                    "application/json"
+                ],
+                "tags": [
+                    "Import"
+                ],
+                "summary": "Export all content",
+                "parameters": [
+                    {
+                        "enum": [
+                            "zip",
+                            "json"
+                        ],
+                        "type": "string",
+                        "default": "zip",
+                        "description": "Whether to return a zip of markdown files or a JSON bundle of them",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Zip of markdown files, or with format=json the bundle",
+                        "schema": {
+                            "$ref": "#/definitions/api.ContentExport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid format",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error reading content",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/faq": {
+            "post": {
+                "description": "Creates a new FAQ. The answer is stored as markdown",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FAQs"
+                ],
+                "summary": "Create FAQ",
+                "parameters": [
+                    {
+                        "description": "FAQ data",
+                        "name": "faq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.FAQ"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created FAQ",
+                        "schema": {
+                            "$ref": "#/definitions/models.FAQ"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid FAQ data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating FAQ",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/faq/{faqID}": {
+            "get": {
+                "description": "Retrieves a specific FAQ by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FAQs"
+                ],
+                "summary": "Get FAQ",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "FAQ ID",
+                        "name": "faqID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "FAQ details",
+                        "schema": {
+                            "$ref": "#/definitions/models.FAQ"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid faqID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - FAQ not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching FAQ",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing FAQ in the database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FAQs"
+                ],
+                "summary": "Update FAQ",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "FAQ ID",
+                        "name": "faqID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated FAQ data",
+                        "name": "faq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.FAQ"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated FAQ",
+                        "schema": {
+                            "$ref": "#/definitions/models.FAQ"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid FAQ data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - FAQ not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating FAQ",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a FAQ from the database by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FAQs"
+                ],
+                "summary": "Delete FAQ",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "FAQ ID",
+                        "name": "faqID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid faqID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - FAQ not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting FAQ",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/faqs": {
+            "get": {
+                "description": "Retrieves all published FAQs ordered by display order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FAQs"
+                ],
+                "summary": "Get FAQs",
+                "responses": {
+                    "200": {
+                        "description": "List of published FAQs",
+                        "schema": {
+                            "$ref": "#/definitions/api.FAQCollection"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching FAQs",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed": {
+            "get": {
+                "description": "Serves the feed in the format the Accept header asks for: application/rss+xml (or application/xml) for /feed.xml, application/atom+xml for /feed.atom and application/feed+json (or application/json) for /feed.json. RSS is served when none of them is accepted. The feed is the same as at the format's own path",
+                "produces": [
+                    "application/rss+xml",
+                    "application/atom+xml",
+                    "application/feed+json"
+                ],
+                "tags": [
+                    "Feeds"
+                ],
+                "summary": "Get feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Locale of the feed, e.g. pt; Accept-Language is used when not given",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Feed of the newest posts",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified - The feed hasn't changed since the reader's copy"
+                    },
+                    "400": {
+                        "description": "Bad Request - Unsupported locale",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed.atom": {
+            "get": {
+                "description": "Lists the 50 newest published blog posts as an Atom (RFC 4287) feed, each entry with its title, summary, markdown content, link, publication and update dates and tags as categories. Like /feed.json, each locale has its own feed at ?locale=, and responses carry an ETag and Last-Modified for conditional requests",
+                "produces": [
+                    "application/atom+xml"
+                ],
+                "tags": [
+                    "Feeds"
+                ],
+                "summary": "Get Atom feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Locale of the feed, e.g. pt; Accept-Language is used when not given",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Atom feed of the newest posts",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified - The feed hasn't changed since the reader's copy"
+                    },
+                    "400": {
+                        "description": "Bad Request - Unsupported locale",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed.json": {
+            "get": {
+                "description": "Lists the 50 newest published blog posts as a JSON Feed 1.1 document. Each item's content_text is the post's markdown, and the first image in the post is its image and an attachment. Each locale has its own feed, at ?locale=, listing posts in their translation into it when they have one and as written otherwise; each item's language says which. Responses carry an ETag and Last-Modified and may be kept for 15 minutes, so readers polling with If-None-Match or If-Modified-Since get a 304 when nothing changed",
+                "produces": [
+                    "application/feed+json"
+                ],
+                "tags": [
+                    "Feeds"
+                ],
+                "summary": "Get JSON Feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Locale of the feed, e.g. pt; Accept-Language is used when not given",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JSON Feed of the newest posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.JSONFeed"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified - The feed hasn't changed since the reader's copy"
+                    },
+                    "400": {
+                        "description": "Bad Request - Unsupported locale",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed.xml": {
+            "get": {
+                "description": "Lists the 50 newest published blog posts as an RSS 2.0 feed, each with its title, summary, link, publication date and tags as categories. Like /feed.json, each locale has its own feed at ?locale=. Responses carry an ETag and Last-Modified and may be kept for 15 minutes, so readers polling with If-None-Match or If-Modified-Since get a 304 when nothing changed",
+                "produces": [
+                    "application/rss+xml"
+                ],
+                "tags": [
+                    "Feeds"
+                ],
+                "summary": "Get RSS feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Locale of the feed, e.g. pt; Accept-Language is used when not given",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "RSS feed of the newest posts",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified - The feed hasn't changed since the reader's copy"
+                    },
+                    "400": {
+                        "description": "Bad Request - Unsupported locale",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/guestbook": {
+            "get": {
+                "description": "Retrieves all guestbook entries that have been approved for public display, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Guestbook"
+                ],
+                "summary": "Get guestbook",
+                "responses": {
+                    "200": {
+                        "description": "List of approved guestbook entries",
+                        "schema": {
+                            "$ref": "#/definitions/api.GuestbookEntryCollection"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching guestbook entries",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/guestbook-entry": {
+            "post": {
+                "description": "Submits a new guestbook entry. Entries are hidden until approved by an admin. Submissions are rate limited per IP address",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Guestbook"
+                ],
+                "summary": "Sign guestbook",
+                "parameters": [
+                    {
+                        "description": "Guestbook entry data",
+                        "name": "entry",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.GuestbookSubmission"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Submitted guestbook entry",
+                        "schema": {
+                            "$ref": "#/definitions/models.GuestbookEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid guestbook entry data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests - Rate limit exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error submitting guestbook entry",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/import/markdown": {
+            "post": {
+                "description": "Imports every markdown file in a zipped folder, such as an Obsidian vault or posts from /blog-post/{blogPostID}/export.md. YAML frontmatter can set title (otherwise the file name), slug, summary, date, publishAt, draft, tags and canonicalURL. A file whose slug matches an existing post's updates that post; slugs are derived from titles unless the frontmatter sets one. Files are published unless they're marked draft or scheduled with a future publishAt, and publishing here doesn't cross-post or announce them. Files with publish: false are skipped, as are hidden folders like .obsidian. The tags given here are added to every post. The report says what happened to each file",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Import"
+                ],
+                "summary": "Import markdown files",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Zipped folder of markdown files (.zip)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tags to add to every imported post",
+                        "name": "tags",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "What happened to each file",
+                        "schema": {
+                            "$ref": "#/definitions/api.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing archive, or not a zip with markdown files in it",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large - Archive over 100 MB",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/import/medium": {
+            "post": {
+                "description": "Imports the posts in a Medium export archive (the zip Medium emails from Settings \u003e Security and apps \u003e Download your information) as drafts. Each post's body is converted from HTML to markdown, its canonical link becomes the url and, for published posts, its publish date becomes dateAdded. Medium's export has no tags, so the tags given here are added to every post. Posts whose title is already taken are skipped. The report says what happened to each post",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Import"
+                ],
+                "summary": "Import from Medium",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Medium export archive (.zip)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tags to add to every imported post",
+                        "name": "tags",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "What happened to each post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing archive, or not a zip with Medium posts in it",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large - Archive over 100 MB",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/import/substack": {
+            "post": {
+                "description": "Imports the posts in a Substack export archive (the zip from Settings \u003e Exports), which lists the posts in posts.csv and keeps each body as HTML. Bodies are converted to markdown. Published posts are imported as published, keeping their publish date, and aren't cross-posted or announced; unpublished ones become drafts. Images keep pointing at Substack's CDN. Substack's export has no tags, so the tags given here are added to every post. Posts without an HTML body, such as threads, and posts whose title is already taken are skipped. The report says what happened to each post",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Import"
+                ],
+                "summary": "Import from Substack",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Substack export archive (.zip)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tags to add to every imported post",
+                        "name": "tags",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Address of the publication, e.g. https://example.substack.com, to link each post to its page there as its url",
+                        "name": "publicationURL",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "What happened to each post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing archive, or not a zip with Substack posts in it",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large - Archive over 100 MB",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/import/wordpress": {
+            "post": {
+                "description": "Imports the posts in a WordPress export (WXR) file, from Tools \u003e Export. Bodies are converted to markdown. Published posts are imported as published, keeping their publish date, and aren't cross-posted or announced; scheduled posts stay scheduled and everything else becomes a draft. Tags and categories become tags, and the tags given here are added to every post. Media isn't copied: images keep pointing at the WordPress site, and each post lists its attachments. Posts whose title is already taken are skipped. With dryRun nothing is saved, and the report says what would have happened to each post",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Import"
+                ],
+                "summary": "Import from WordPress",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "WordPress export file (.xml)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tags to add to every imported post",
+                        "name": "tags",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Report what would be created without saving anything",
+                        "name": "dryRun",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "What happened, or would happen, to each post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing file, or not a WordPress export with posts in it",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large - File over 100 MB",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/media": {
+            "get": {
+                "description": "Lists the files uploaded with POST /media, newest first, with the URLs they're served from. Images stored with blog posts, such as by the new-post command, aren't listed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media"
+                ],
+                "summary": "Get uploaded media",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (starts at 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Files per page (max 100)",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of uploaded media",
+                        "schema": {
+                            "$ref": "#/definitions/api.MediaObjectCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid pagination parameters",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching media",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Stores a file, sent as the file field of a multipart form, in the MEDIA_STORAGE bucket (Supabase Storage or S3) and returns the public URL it's served from, also sent as Location. PNG, JPEG, GIF and WebP images, PDFs, MP4 and WebM videos and MP3s are accepted, told apart by their content rather than their name or the type they're sent as, up to MEDIA_UPLOAD_MAX_MB (defaults to 25). PNG, JPEG and GIF images also get resized variants, stored alongside: one for each of MEDIA_VARIANT_WIDTHS narrower than the image and a square thumbnail, returned with a srcset of the image and its web-sized variants",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media"
+                ],
+                "summary": "Upload media",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "File to upload",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Uploaded file",
+                        "schema": {
+                            "$ref": "#/definitions/models.MediaObject"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing file, or a type that isn't accepted",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large - File over MEDIA_UPLOAD_MAX_MB",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error storing file",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - No media storage configured",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/media/{mediaID}": {
+            "get": {
+                "description": "Serves an image uploaded with a blog post, such as by the new-post command. A stored file never changes, so it's cached for a year",
+                "produces": [
+                    "image/png",
+                    "image/jpeg",
+                    "image/gif",
+                    "image/webp"
+                ],
+                "tags": [
+                    "Media"
+                ],
+                "summary": "Get media file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Media file ID",
+                        "name": "mediaID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The image",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid mediaID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Media file not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching media file",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a file uploaded with POST /media, and its variants, from the bucket, after which their URLs stop working. Posts linking to it aren't changed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media"
+                ],
+                "summary": "Delete uploaded media",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Uploaded file ID",
+                        "name": "mediaID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid mediaID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Uploaded file not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting file",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - No media storage configured",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/micropub": {
+            "get": {
+                "description": "Answers Micropub queries. q=config returns the media endpoint and the platforms posts can be syndicated to, q=syndicate-to only the platforms, and q=source the properties of the blog post or note at url, limited to the ones named by properties[] if given. Takes an IndieAuth access token with any scope, in the Authorization header or an access_token parameter, issued to INDIEAUTH_ME by INDIEAUTH_TOKEN_ENDPOINT",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Micropub"
+                ],
+                "summary": "Micropub query",
+                "parameters": [
+                    {
+                        "enum": [
+                            "config",
+                            "syndicate-to",
+                            "source"
+                        ],
+                        "type": "string",
+                        "description": "What to return",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "URL of the post, for q=source. Its last path segment must be the post's ID",
+                        "name": "url",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Endpoint configuration, for q=config; q=syndicate-to returns just syndicate-to, q=source a MicropubSource",
+                        "schema": {
+                            "$ref": "#/definitions/api.MicropubConfig"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Unknown query or invalid url",
+                        "schema": {
+                            "$ref": "#/definitions/api.MicropubError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Missing or invalid access token, or IndieAuth isn't configured",
+                        "schema": {
+                            "$ref": "#/definitions/api.MicropubError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Token was issued for another site",
+                        "schema": {
+                            "$ref": "#/definitions/api.MicropubError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - No blog post or note has that URL",
+                        "schema": {
+                            "$ref": "#/definitions/api.MicropubError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "IndieAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Creates, updates or deletes a post from a Micropub client such as Quill. Bodies are form-encoded, multipart (with photo files) or JSON, as the Micropub spec has them. An h-entry with a name becomes a blog post and one without a note; category becomes tags, photo is added to the post, published dates it (a blog post dated in the future is scheduled) and post-status draft saves a blog post as a draft. New published posts are cross-posted to the platforms named by mp-syndicate-to, through the same pipeline as POST /blog-post and POST /note, and created posts are answered with 201 and a Location header. action=update (JSON only) replaces name, content, summary, category or post-status of a blog post, or content or photo of a note, adds or deletes categories and deletes summary or photo; action=delete moves the blog post at url to the trash, or deletes the note at it, and action=undelete takes a blog post out of the trash. Takes an IndieAuth access token with the create, update, delete or undelete scope to match, in the Authorization header or an access_token field",
+                "consumes": [
+                    "application/x-www-form-urlencoded",
+                    "multipart/form-data",
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Micropub"
+                ],
+                "summary": "Micropub post",
+                "responses": {
+                    "201": {
+                        "description": "Post created, at the URL in the Location header"
+                    },
+                    "204": {
+                        "description": "Post updated or deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid post or unsupported action",
+                        "schema": {
+                            "$ref": "#/definitions/api.MicropubError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Missing or invalid access token, or IndieAuth isn't configured",
+                        "schema": {
+                            "$ref": "#/definitions/api.MicropubError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Token was issued for another site, or lacks the scope the action needs",
+                        "schema": {
+                            "$ref": "#/definitions/api.MicropubError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - No blog post or note has that URL",
+                        "schema": {
+                            "$ref": "#/definitions/api.MicropubError"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large - Request is over 11 MB",
+                        "schema": {
+                            "$ref": "#/definitions/api.MicropubError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error saving post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "IndieAuth": []
+                    }
+                ]
+            }
+        },
+        "/micropub/media": {
+            "post": {
+                "description": "Stores the PNG, JPEG, GIF or WebP image sent as the multipart file field, of up to 10 MB, and answers with 201 and the URL it's served at, under /media, in the Location header. Uploading an image already stored links to that copy. Takes an IndieAuth access token with the media or create scope, in the Authorization header or an access_token field",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Micropub"
+                ],
+                "summary": "Micropub media upload",
+                "responses": {
+                    "201": {
+                        "description": "Image stored, at the URL in the Location header"
+                    },
+                    "400": {
+                        "description": "Bad Request - No file, or not a supported image",
+                        "schema": {
+                            "$ref": "#/definitions/api.MicropubError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Missing or invalid access token, or IndieAuth isn't configured",
+                        "schema": {
+                            "$ref": "#/definitions/api.MicropubError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Token was issued for another site, or lacks the media scope",
+                        "schema": {
+                            "$ref": "#/definitions/api.MicropubError"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large - Request is over 11 MB",
+                        "schema": {
+                            "$ref": "#/definitions/api.MicropubError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error storing image",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "IndieAuth": []
+                    }
+                ]
+            }
+        },
+        "/note": {
+            "post": {
+                "description": "Creates a new note. Pass platforms to cross-post it to Twitter and/or Mastodon; nothing is cross-posted by default",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notes"
+                ],
+                "summary": "Create note",
+                "parameters": [
+                    {
+                        "description": "Note data",
+                        "name": "note",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Note"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of platforms to cross-post to. Valid values: twitter, mastodon",
+                        "name": "platforms",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created note",
+                        "schema": {
+                            "$ref": "#/definitions/models.Note"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid note data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating note",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/note/{noteID}": {
+            "get": {
+                "description": "Retrieves a specific note by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notes"
+                ],
+                "summary": "Get note",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Note ID",
+                        "name": "noteID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Note details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Note"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid noteID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Note not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching note",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing note. Cross-posted copies are not edited",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notes"
+                ],
+                "summary": "Update note",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Note ID",
+                        "name": "noteID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated note data",
+                        "name": "note",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Note"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated note",
+                        "schema": {
+                            "$ref": "#/definitions/models.Note"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid note data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Note not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating note",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a note from the database by ID. Cross-posted copies are not deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notes"
+                ],
+                "summary": "Delete note",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Note ID",
+                        "name": "noteID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid noteID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Note not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting note",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notes": {
+            "get": {
+                "description": "Retrieves the notes feed, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notes"
+                ],
+                "summary": "Get notes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (starts at 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Notes per page (max 100)",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of notes",
+                        "schema": {
+                            "$ref": "#/definitions/api.NoteCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid pagination parameters",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching notes",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/openapi.json": {
+            "get": {
+                "description": "Exports the Swagger 2.0 spec of this API. Its host and schemes are API_BASE_URL's when set, otherwise the ones the request came in on",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Documentation"
+                ],
+                "summary": "Get API spec",
+                "responses": {
+                    "200": {
+                        "description": "Swagger spec",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/preview/{token}": {
+            "get": {
+                "description": "Returns the draft, scheduled or published post a token from POST /blog-post/{blogPostID}/preview-token was signed for, with its tags, so reviewers can read a draft without the backend password. Previews are sent with Cache-Control private, no-store and X-Robots-Tag noindex, and aren't counted as views. Tokens that have expired, were tampered with or were signed with an old PREVIEW_LINK_SECRET are treated as unknown",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blog Posts"
+                ],
+                "summary": "Preview blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Preview token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "markdown",
+                            "html"
+                        ],
+                        "type": "string",
+                        "default": "markdown",
+                        "description": "Whether content is served as the Markdown it's written in or rendered as sanitized HTML",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Blog post with tags",
+                        "schema": {
+                            "$ref": "#/definitions/api.BlogPostWithTags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid format",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Unknown or expired preview token, or the post is gone",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/project": {
+            "post": {
+                "description": "Creates a new project in the database. Images can be given with the project, in gallery order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Create project",
+                "parameters": [
+                    {
+                        "description": "Project data",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Project"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retrying with the same key replays the first response instead of creating a duplicate (keys are kept for 24 hours)",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created project with tags",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProjectWithTags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid project data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating project",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/project/{projectID}": {
+            "get": {
+                "description": "Retrieves detailed information about a specific project by ID with its tags",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Get project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "projectID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project details with tags",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProjectWithTags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid projectID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching project",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing project in the database. Images are left as they are; they're changed under /project/{projectID}/images",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Update project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "projectID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated project data",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Project"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated project with tags",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProjectWithTags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid project data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating project",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Moves a project to the trash by ID, from where POST /project/{projectID}/restore brings it back. With permanent=true, which requires the backend password, the project and its tags are removed from the database instead, whether or not it's in the trash",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Delete project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "projectID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Delete permanently instead of moving to the trash",
+                        "name": "permanent",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid projectID or permanent",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required to delete permanently",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting project",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/project/{projectID}/image/{imageID}": {
+            "put": {
+                "description": "Replaces an image's URL, alt text, caption and position",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Update project image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "projectID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Image ID",
+                        "name": "imageID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated image data",
+                        "name": "image",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ProjectImage"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated image",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProjectImage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid image data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Project or image not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating image",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes an image from a project's gallery. The other images keep their positions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Delete project image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "projectID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Image ID",
+                        "name": "imageID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid projectID or imageID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Image not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting image",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/project/{projectID}/images": {
+            "get": {
+                "description": "Lists a project's screenshots in gallery order, by position, for showing as a carousel. Projects in the trash have none to show",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Get project images",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "projectID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project images",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProjectImageCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid projectID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching images",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a screenshot to a project's gallery, after its last image unless a position is given",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Add project image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "projectID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Image data",
+                        "name": "image",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ProjectImage"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created image",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProjectImage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid image data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating image",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/project/{projectID}/restore": {
+            "post": {
+                "description": "Takes a project moved to the trash by DELETE /project/{projectID} out of it again, with its tags",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Restore project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "projectID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Restored project",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProjectWithTags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid projectID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Project not in the trash",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error restoring project",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/project/{slug}/case-study": {
+            "get": {
+                "description": "Serves a project's long-form case study, written in Markdown as case_study, rendered as sanitized HTML the same way blog posts are: GitHub-flavored Markdown with heading IDs, and without raw HTML or script links. The HTML fragment is the response body, as text/html, unless the Accept header asks for application/json, which gets it in a JSON object with the project's ID, title and slug. The project is named by its slug, made from its title like a blog post's (e.g. \"Personal Site Backend\" is personal-site-backend), or by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/html",
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Get project case study",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project slug or ID",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rendered case study, as an HTML fragment or, with Accept: application/json, this object",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProjectCaseStudy"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Project not found, or it has no case study",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching project",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects": {
+            "get": {
+                "description": "Retrieves one page of projects from the database with their associated tags, newest first unless sort is given. Pages are numbered, or taken by cursor when cursor is given (empty for the first page): cursor pages are newest first by date_added and id, stay fast however deep they go and don't shift when projects are added, and give the next page's cursor as meta.nextCursor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Get all projects",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, type (snake_case spellings such as date_added work too). Not allowed with cursor",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (starts at 1). Not allowed with cursor",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "meta.nextCursor of the previous page, or empty for the first page, to page by cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Projects per page (max 100)",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of projects with tags",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProjectCollectionWithTags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid sort, pagination or cursor parameters",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching projects",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Moves projects to the trash by ID and/or filter (tag, addedBefore, addedAfter) in a single transaction. All given criteria must match and at least one is required. Requires the backend password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Bulk delete projects",
+                "parameters": [
+                    {
+                        "description": "IDs and/or filter selecting the projects to delete",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkDeleteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Summary of deleted projects",
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkDeleteResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Malformed or empty selection",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting projects",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/projects/import-github": {
+            "post": {
+                "description": "Creates a project from a GitHub repository, read from the GitHub API. The repository's name becomes the title, its README the description (or its description when it has no README), its homepage the demo link and its topics the tags. Its description is also the meta_description when it fits in 160 characters. Without GITHUB_TOKEN only public repositories can be imported, at GitHub's lower rate limit. With dryRun the project is returned as it would be created, without saving it, so it can be edited first and sent to POST /project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Import project from GitHub",
+                "parameters": [
+                    {
+                        "description": "Repository to import",
+                        "name": "import",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.GitHubProjectImport"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "With dryRun, the project as it would be created",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProjectWithTags"
+                        }
+                    },
+                    "201": {
+                        "description": "Created project with tags",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProjectWithTags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing url, or not a GitHub repository URL",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - No such repository, or it's private",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - A project already has the repository's name as its title",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway - GitHub API error, such as its rate limit",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/projects/search": {
+            "get": {
+                "description": "Full-text search over projects, merged with trigram similarity matches so misspelled queries (e.g. \"postgers\") still find results. Full-text matches rank first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Search projects",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query (max 200 characters)",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of results (max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Matching projects, best first",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProjectSearchResults"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing or invalid q or limit",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error searching projects",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/summaries": {
+            "get": {
+                "description": "Retrieves one page of projects for list views such as the homepage. Tags are plain values rather than full tag rows, so the page is smaller than GET /projects. Newest first unless sort is given",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Get project summaries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated field:direction pairs, e.g. dateAdded:desc,title:asc. Sortable fields: dateAdded, dateEdited, title, type (snake_case spellings such as date_added work too)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (starts at 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Projects per page (max 100)",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of project summaries",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProjectSummaryCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid sort or pagination parameters",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching projects",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/trash": {
+            "get": {
+                "description": "Retrieves one page of the projects moved to the trash, most recently deleted first, with their tags",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Projects"
+                ],
+                "summary": "Get trashed projects",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (starts at 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Projects per page (max 100)",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of trashed projects",
+                        "schema": {
+                            "$ref": "#/definitions/api.TrashedProjectCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid pagination parameters",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching projects",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/r/{token}": {
+            "get": {
+                "description": "Counts one click on a tracked share link from a social post and redirects to the content it points to",
+                "tags": [
+                    "Social Posts"
+                ],
+                "summary": "Follow share link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Redirect to the shared content"
+                    },
+                    "404": {
+                        "description": "Not Found - Unknown share link",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error recording click",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reading-list": {
+            "get": {
+                "description": "Retrieves books currently being read and finished books (most recently finished first)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Books"
+                ],
+                "summary": "Get reading list",
+                "responses": {
+                    "200": {
+                        "description": "Reading list",
+                        "schema": {
+                            "$ref": "#/definitions/api.ReadingList"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching books",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/recent-changes": {
+            "get": {
+                "description": "Retrieves blog posts, projects and notes that have been edited, most recently edited first. Content that was never edited is left out; see /timeline for new content. Pass nextCursor from the previous response as cursor to get the next page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Timeline"
+                ],
+                "summary": "Get recent changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as nextCursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page (max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of recently edited content",
+                        "schema": {
+                            "$ref": "#/definitions/api.RecentChanges"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid cursor or limit",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching recent changes",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/resume": {
+            "get": {
+                "description": "Retrieves structured CV data (work experience, education and unexpired certifications). Pass format=pdf to receive a rendered PDF instead of JSON",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Resume"
+                ],
+                "summary": "Get resume",
+                "parameters": [
+                    {
+                        "enum": [
+                            "json",
+                            "pdf"
+                        ],
+                        "type": "string",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Structured resume data",
+                        "schema": {
+                            "$ref": "#/definitions/api.Resume"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid format",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching resume",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/s/{code}": {
+            "get": {
+                "description": "Counts one click on a short link and redirects to the blog post, project or URL it points to",
+                "tags": [
+                    "Short Links"
+                ],
+                "summary": "Follow short link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Short link code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Redirect to the linked content"
+                    },
+                    "404": {
+                        "description": "Not Found - Unknown short link",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error recording click",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schema/{entity}/example": {
+            "get": {
+                "description": "Returns a canonical example request body for an entity, which the admin UI uses to prefill its forms. Entities are blog-post (POST /blog-post), project (POST /project) and publish (POST /blog-post scheduled for later)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Documentation"
+                ],
+                "summary": "Get example payload",
+                "parameters": [
+                    {
+                        "enum": [
+                            "blog-post",
+                            "project",
+                            "publish"
+                        ],
+                        "type": "string",
+                        "description": "Entity to get an example of",
+                        "name": "entity",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Example request body",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - No example for the entity",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "Searches published blog posts, projects and the tags on them in one query, for a site-wide search box. Blog posts and projects are matched as /blog-posts/search and /projects/search match them, and tags by their value: a tag that is the query, ignoring case, scores 2, and one resembling a word of it scores its trigram similarity (0-1). All three are ranked together, best first, so scores above 1 are full-text or exact tag matches. Results say their type and carry the matching blog post or project summary, or the tag with how many posts and projects have it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search everything",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query (max 200 characters)",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of results (max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Matching blog posts, projects and tags, best first",
+                        "schema": {
+                            "$ref": "#/definitions/api.SearchResults"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing or invalid q or limit",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error searching",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/series": {
+            "get": {
+                "description": "Retrieves every series, newest first, without their posts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Get series",
+                "responses": {
+                    "200": {
+                        "description": "List of series",
+                        "schema": {
+                            "$ref": "#/definitions/api.SeriesCollection"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching series",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new series. Its slug is made from the slug given, or the title when there isn't one, by lowercasing it and joining its words with hyphens",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Create series",
+                "parameters": [
+                    {
+                        "description": "Series data",
+                        "name": "series",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Series"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created series",
+                        "schema": {
+                            "$ref": "#/definitions/models.Series"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid series data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Slug already taken",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating series",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/series/{slug}": {
+            "get": {
+                "description": "Retrieves a series with the summaries of its published posts, ordered by their position in it. Drafts and scheduled posts can be placed in a series ahead of time, and are listed once they're published. Posts translated into the negotiated locale are served in it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Get series by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale to serve translated posts in, e.g. pt; Accept-Language is used when not given",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Series and its posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.SeriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid locale",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Series not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching series",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing series' title and description, and its slug when one is given. Its posts stay where they are",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Update series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated series data",
+                        "name": "series",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Series"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated series",
+                        "schema": {
+                            "$ref": "#/definitions/models.Series"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid series data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Series not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Slug already taken",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating series",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a series by slug. Its posts are taken out of it, not deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Delete series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Series not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting series",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/series/{slug}/post/{blogPostID}": {
+            "put": {
+                "description": "Places a blog post at a position in a series, or after its last post when no position is given. A post is in at most one series, so a post already in a series is moved, and putting it at a position another post holds is a conflict",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Add blog post to series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Position in the series",
+                        "name": "position",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/api.SeriesPostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post's place in the series",
+                        "schema": {
+                            "$ref": "#/definitions/models.SeriesPost"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID or position",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Series or blog post not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Position already taken",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error adding blog post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Takes a blog post out of a series. The post itself is left as it is, and the other posts keep their positions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Remove blog post from series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Blog Post ID",
+                        "name": "blogPostID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid blogPostID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Series not found, or blog post not in it",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error removing blog post",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sitemap.xml": {
+            "get": {
+                "description": "Lists every published blog post's page as a sitemaps.org XML sitemap. A translated post is listed once per locale, each with hreflang alternates for all of them and x-default for the original. Translations are linked with the locale as the first segment of the post's path, e.g. https://example.com/pt/blog/{id}. Posts without a url or BASE_URL to link to are left out",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Feeds"
+                ],
+                "summary": "Get sitemap",
+                "responses": {
+                    "200": {
+                        "description": "XML sitemap",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching blog posts",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/skill": {
+            "post": {
+                "description": "Creates a new skill in the database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Create skill",
+                "parameters": [
+                    {
+                        "description": "Skill data",
+                        "name": "skill",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Skill"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created skill",
+                        "schema": {
+                            "$ref": "#/definitions/models.Skill"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid skill data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Skill already exists",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating skill",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/skill/{skillID}": {
+            "get": {
+                "description": "Retrieves a specific skill by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Get skill",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Skill ID",
+                        "name": "skillID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Skill details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Skill"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid skillID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Skill not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching skill",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing skill in the database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Update skill",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Skill ID",
+                        "name": "skillID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated skill data",
+                        "name": "skill",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Skill"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated skill",
+                        "schema": {
+                            "$ref": "#/definitions/models.Skill"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid skill data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Skill not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating skill",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a skill from the database by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Delete skill",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Skill ID",
+                        "name": "skillID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid skillID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Skill not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting skill",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/skills": {
+            "get": {
+                "description": "Retrieves all skills ordered by category and name. Pass category to only return skills in that category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Get all skills",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only return skills in this category",
+                        "name": "category",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of skills",
+                        "schema": {
+                            "$ref": "#/definitions/api.SkillCollection"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching skills",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/snippet": {
+            "post": {
+                "description": "Creates a new code snippet. Blog posts embed it with a snippet shortcode holding its ID on a line of its own (see the README), which the markdown export replaces with a fenced code block",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Snippets"
+                ],
+                "summary": "Create snippet",
+                "parameters": [
+                    {
+                        "description": "Snippet data",
+                        "name": "snippet",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Snippet"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created snippet",
+                        "schema": {
+                            "$ref": "#/definitions/models.Snippet"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid snippet data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating snippet",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/snippet/{snippetID}": {
+            "get": {
+                "description": "Retrieves a specific code snippet by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Snippets"
+                ],
+                "summary": "Get snippet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Snippet ID",
+                        "name": "snippetID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Snippet details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Snippet"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid snippetID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Snippet not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching snippet",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing code snippet. Posts embedding it show the new code the next time they're exported",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Snippets"
+                ],
+                "summary": "Update snippet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Snippet ID",
+                        "name": "snippetID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated snippet data",
+                        "name": "snippet",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Snippet"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated snippet",
+                        "schema": {
+                            "$ref": "#/definitions/models.Snippet"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid snippet data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Snippet not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating snippet",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a code snippet by ID. Shortcodes embedding it are left in posts as they are",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Snippets"
+                ],
+                "summary": "Delete snippet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Snippet ID",
+                        "name": "snippetID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid snippetID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Snippet not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting snippet",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/snippets": {
+            "get": {
+                "description": "Retrieves the code snippets gallery, newest first, optionally only those in one language or with one tag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Snippets"
+                ],
+                "summary": "Get snippets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only snippets in this language, e.g. go (case-insensitive)",
+                        "name": "language",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only snippets with this tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (starts at 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Snippets per page (max 100)",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of snippets",
+                        "schema": {
+                            "$ref": "#/definitions/api.SnippetCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid pagination parameters",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching snippets",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tag/{id}": {
+            "put": {
+                "description": "Renames a tag on every blog post and project carrying it. The tag is named by its value, URL-escaped. Renaming to a tag that's already in use is refused; merge into it with POST /tags/merge instead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Rename tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag value",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New value",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.TagRename"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Renamed tag",
+                        "schema": {
+                            "$ref": "#/definitions/api.Tag"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing or invalid value",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - The new value is already a tag",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error renaming tag",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "Removes a tag from every blog post and project carrying it, including drafts and the trash. The posts and projects themselves are kept. The tag is named by its value, URL-escaped",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Delete tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag value",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting tag",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/tags": {
+            "get": {
+                "description": "Lists every tag on a blog post or project, with how many of each carry it, most used first and then alphabetically. Drafts, scheduled posts and the trash are counted, since renaming, merging and deleting tags changes them too",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get tags",
+                "responses": {
+                    "200": {
+                        "description": "Tags with their usage",
+                        "schema": {
+                            "$ref": "#/definitions/api.TagCollection"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching tags",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/tags/merge": {
+            "post": {
+                "description": "Moves the tag from onto the tag into on every blog post and project carrying it, then from is gone. Posts and projects carrying both keep into once. into needn't be in use yet, though renaming with PUT /tag/{id} says so more plainly",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Merge tags",
+                "parameters": [
+                    {
+                        "description": "Tags to merge",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.TagMerge"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The merged tag",
+                        "schema": {
+                            "$ref": "#/definitions/api.Tag"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Missing from or into, or they're the same",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Admin authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - The from tag isn't in use",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error merging tags",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/testimonial": {
+            "post": {
+                "description": "Submits a new testimonial. Submitted testimonials are hidden until approved by an admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Testimonials"
+                ],
+                "summary": "Submit testimonial",
+                "parameters": [
+                    {
+                        "description": "Testimonial data",
+                        "name": "testimonial",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.TestimonialSubmission"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Submitted testimonial",
+                        "schema": {
+                            "$ref": "#/definitions/models.Testimonial"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid testimonial data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error submitting testimonial",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials": {
+            "get": {
+                "description": "Retrieves all testimonials that have been approved for public display, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Testimonials"
+                ],
+                "summary": "Get approved testimonials",
+                "responses": {
+                    "200": {
+                        "description": "List of approved testimonials",
+                        "schema": {
+                            "$ref": "#/definitions/api.TestimonialCollection"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching testimonials",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/timeline": {
+            "get": {
+                "description": "Retrieves blog posts, projects and notes merged into one feed, newest first. Pass nextCursor from the previous response as cursor to get the next page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Timeline"
+                ],
+                "summary": "Get timeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as nextCursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page (max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of timeline items",
+                        "schema": {
+                            "$ref": "#/definitions/api.Timeline"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid cursor or limit",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching timeline",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trending": {
+            "get": {
+                "description": "Returns blog posts and projects ranked by recent views, with older views decaying (3-day half-life over a 14-day window). The list is recomputed every 15 minutes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trending"
+                ],
+                "summary": "Get trending content",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Maximum number of items (max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Trending blog posts and projects, most popular first",
+                        "schema": {
+                            "$ref": "#/definitions/api.TrendingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid limit",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/uses": {
+            "get": {
+                "description": "Retrieves all uses items grouped by category, with items ordered by display order within each category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Uses"
+                ],
+                "summary": "Get uses page",
+                "responses": {
+                    "200": {
+                        "description": "Uses items grouped by category",
+                        "schema": {
+                            "$ref": "#/definitions/api.UsesPage"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching uses items",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/uses-item": {
+            "post": {
+                "description": "Creates a new uses item in the database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Uses"
+                ],
+                "summary": "Create uses item",
+                "parameters": [
+                    {
+                        "description": "Uses item data",
+                        "name": "usesItem",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UsesItem"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created uses item",
+                        "schema": {
+                            "$ref": "#/definitions/models.UsesItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid uses item data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating uses item",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/uses-item/{usesItemID}": {
+            "get": {
+                "description": "Retrieves a specific uses item by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Uses"
+                ],
+                "summary": "Get uses item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Uses Item ID",
+                        "name": "usesItemID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Uses item details",
+                        "schema": {
+                            "$ref": "#/definitions/models.UsesItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid usesItemID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Uses item not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching uses item",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing uses item in the database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Uses"
+                ],
+                "summary": "Update uses item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Uses Item ID",
+                        "name": "usesItemID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated uses item data",
+                        "name": "usesItem",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UsesItem"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated uses item",
+                        "schema": {
+                            "$ref": "#/definitions/models.UsesItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid uses item data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Uses item not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating uses item",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a uses item from the database by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Uses"
+                ],
+                "summary": "Delete uses item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Uses Item ID",
+                        "name": "usesItemID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid usesItemID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Uses item not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting uses item",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "Returns the version of the API spec served at /openapi.json and the changelog of every version, newest first. The generated clients export the version they were built from as SpecVersion (Go) and specVersion (TypeScript)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Documentation"
+                ],
+                "summary": "Get API version",
+                "responses": {
+                    "200": {
+                        "description": "API version and changelog",
+                        "schema": {
+                            "$ref": "#/definitions/api.APIVersion"
+                        }
+                    }
+                }
+            }
+        },
+        "/work-experience": {
+            "get": {
+                "description": "Retrieves all work experience entries, current positions first and then by most recent start date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Work Experience"
+                ],
+                "summary": "Get work experience",
+                "responses": {
+                    "200": {
+                        "description": "List of work experience entries",
+                        "schema": {
+                            "$ref": "#/definitions/api.WorkExperienceCollection"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching work experience",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new work experience entry. Dates must form a valid range and may not overlap another entry at the same company",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Work Experience"
+                ],
+                "summary": "Create work experience entry",
+                "parameters": [
+                    {
+                        "description": "Work experience data",
+                        "name": "workExperience",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkExperience"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created work experience",
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkExperience"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid work experience data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Overlaps another entry at the same company",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error creating work experience",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/work-experience/{workExperienceID}": {
+            "get": {
+                "description": "Retrieves a specific work experience entry by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Work Experience"
+                ],
+                "summary": "Get work experience entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Work Experience ID",
+                        "name": "workExperienceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Work experience details",
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkExperience"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid workExperienceID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Work experience not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error fetching work experience",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing work experience entry. Dates must form a valid range and may not overlap another entry at the same company",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Work Experience"
+                ],
+                "summary": "Update work experience entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Work Experience ID",
+                        "name": "workExperienceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated work experience data",
+                        "name": "workExperience",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkExperience"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated work experience",
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkExperience"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid work experience data",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Work experience not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Overlaps another entry at the same company",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error updating work experience",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a work experience entry from the database by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Work Experience"
+                ],
+                "summary": "Delete work experience entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Work Experience ID",
+                        "name": "workExperienceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Invalid workExperienceID",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - Work experience not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Error deleting work experience",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "api.APIChange": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "version": {
+                    "type": "string",
+                    "example": "1.1"
+                }
+            }
+        },
+        "api.APIVersion": {
+            "type": "object",
+            "properties": {
+                "changelog": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.APIChange"
+                    }
+                },
+                "spec": {
+                    "type": "string",
+                    "example": "/openapi.json"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "1.1"
+                }
+            }
+        },
+        "api.AnalyticsOverview": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string",
+                    "example": "2026-01-01"
+                },
+                "socialPosting": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.PlatformSuccess"
+                    }
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2026-01-31"
+                },
+                "topPosts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ContentViews"
+                    }
+                },
+                "topProjects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ContentViews"
+                    }
+                },
+                "totalViews": {
+                    "type": "integer"
+                },
+                "totalVisitors": {
+                    "type": "integer"
+                },
+                "viewsOverTime": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.DailyViews"
+                    }
+                }
+            }
+        },
+        "api.BatchOperation": {
+            "type": "object",
+            "properties": {
+                "blogPost": {
+                    "$ref": "#/definitions/models.BlogPost"
+                },
+                "blogPostId": {
+                    "type": "string"
+                },
+                "op": {
+                    "type": "string",
+                    "enum": [
+                        "createBlogPost",
+                        "createProject",
+                        "attachTags"
+                    ]
+                },
+                "project": {
+                    "$ref": "#/definitions/models.Project"
+                },
+                "projectId": {
+                    "type": "string"
+                },
+                "ref": {
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "api.BatchRequest": {
+            "type": "object",
+            "properties": {
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BatchOperation"
+                    }
+                }
+            }
+        },
+        "api.BatchResponse": {
+            "type": "object",
+            "properties": {
+                "committed": {
+                    "type": "boolean"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BatchResult"
+                    }
+                }
+            }
+        },
+        "api.BatchResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "op": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "ok",
+                        "error",
+                        "skipped"
+                    ]
+                }
+            }
+        },
+        "api.BenchEndpointReport": {
+            "type": "object",
+            "properties": {
+                "avgBytes": {
+                    "type": "integer"
+                },
+                "cacheHits": {
+                    "type": "integer"
+                },
+                "errors": {
+                    "type": "integer"
+                },
+                "maxMs": {
+                    "type": "number"
+                },
+                "meanMs": {
+                    "type": "number"
+                },
+                "minMs": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "blog posts page"
+                },
+                "p50Ms": {
+                    "type": "number"
+                },
+                "p90Ms": {
+                    "type": "number"
+                },
+                "p99Ms": {
+                    "type": "number"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/blog-posts"
+                },
+                "requests": {
+                    "type": "integer"
+                },
+                "requestsPerSecond": {
+                    "type": "number"
+                }
+            }
+        },
+        "api.BenchReport": {
+            "type": "object",
+            "properties": {
+                "concurrency": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "durationMs": {
+                    "type": "number"
+                },
+                "endpoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BenchEndpointReport"
+                    }
+                },
+                "requests": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "seeded": {
+                    "type": "integer",
+                    "example": 50
+                }
+            }
+        },
+        "api.BlogPostArchive": {
+            "type": "object",
+            "properties": {
+                "total": {
+                    "type": "integer"
+                },
+                "years": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BlogPostArchiveYear"
+                    }
+                }
+            }
+        },
+        "api.BlogPostArchiveMonth": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "month": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BlogPostStub"
+                    }
+                }
+            }
+        },
+        "api.BlogPostArchiveYear": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BlogPostArchiveMonth"
+                    }
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.BlogPostCollectionWithTags": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BlogPostWithTags"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/api.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.ListMeta"
+                }
+            }
+        },
+        "api.BlogPostExport": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BlogPostWithTags"
+                    }
+                }
+            }
+        },
+        "api.BlogPostImageCollection": {
+            "type": "object",
+            "properties": {
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BlogPostImage"
+                    }
+                }
+            }
+        },
+        "api.BlogPostSearchResult": {
+            "type": "object",
+            "properties": {
+                "blogPost": {
+                    "$ref": "#/definitions/models.BlogPost"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BlogTag"
+                    }
+                }
+            }
+        },
+        "api.BlogPostSearchResults": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BlogPostSearchResult"
+                    }
+                },
+                "query": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.BlogPostStub": {
+            "type": "object",
+            "properties": {
+                "dateAdded": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.BlogPostSummary": {
+            "type": "object",
+            "properties": {
+                "dateAdded": {
+                    "type": "string"
+                },
+                "dateEdited": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "readingTimeMinutes": {
+                    "type": "integer"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "wordCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.BlogPostSummaryCollection": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BlogPostSummary"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/api.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.ListMeta"
+                }
+            }
+        },
+        "api.BlogPostTranslations": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BlogPostTranslation"
+                    }
+                },
+                "defaultLocale": {
+                    "type": "string",
+                    "example": "en"
+                }
+            }
+        },
+        "api.BlogPostViewResponse": {
+            "type": "object",
+            "properties": {
+                "counted": {
+                    "description": "Counted is false when the visitor's view had already been counted within the window",
+                    "type": "boolean"
+                },
+                "views": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.BlogPostWithTags": {
+            "type": "object",
+            "properties": {
+                "blogPost": {
+                    "$ref": "#/definitions/models.BlogPost"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BlogTag"
+                    }
+                }
+            }
+        },
+        "api.BookCollection": {
+            "type": "object",
+            "properties": {
+                "books": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Book"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.BookmarkCollection": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Bookmark"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/api.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.ListMeta"
+                }
+            }
+        },
+        "api.BulkDeleteRequest": {
+            "type": "object",
+            "properties": {
+                "addedAfter": {
+                    "type": "string"
+                },
+                "addedBefore": {
+                    "type": "string"
+                },
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tag": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.BulkDeleteResult": {
+            "type": "object",
+            "properties": {
+                "deleted": {
+                    "type": "integer"
+                },
+                "deletedIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "notFound": {
+                    "description": "NotFound lists requested IDs that didn't exist or didn't match the rest of the filter",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "api.CertificationCollection": {
+            "type": "object",
+            "properties": {
+                "certifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Certification"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.ColumnTypeMismatchReport": {
+            "type": "object",
+            "properties": {
+                "column": {
+                    "type": "string",
+                    "example": "reading_time"
+                },
+                "databaseType": {
+                    "type": "string",
+                    "example": "text"
+                },
+                "modelType": {
+                    "type": "string",
+                    "example": "integer"
+                }
+            }
+        },
+        "api.ContentExport": {
+            "type": "object",
+            "properties": {
+                "blogPosts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.MarkdownDocument"
+                    }
+                },
+                "exportedAt": {
+                    "type": "string"
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.MarkdownDocument"
+                    }
+                },
+                "tags": {
+                    "$ref": "#/definitions/api.ExportedTags"
+                }
+            }
+        },
+        "api.ContentSources": {
+            "type": "object",
+            "properties": {
+                "contentId": {
+                    "type": "string"
+                },
+                "contentType": {
+                    "type": "string",
+                    "enum": [
+                        "blogPost",
+                        "project"
+                    ]
+                },
+                "referrers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.SourceViews"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "utmSources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.SourceViews"
+                    }
+                },
+                "views": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.ContentViews": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "views": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.CountryViews": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "string",
+                    "example": "US"
+                },
+                "regions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.RegionViews"
+                    }
+                },
+                "views": {
+                    "type": "integer"
+                },
+                "visitors": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.CredentialHealth": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string",
+                    "example": "urn:li:person:abc123"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string",
+                    "example": "linkedin"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "ok",
+                        "expiring",
+                        "invalid",
+                        "unconfigured",
+                        "unreachable"
+                    ],
+                    "example": "expiring"
+                }
+            }
+        },
+        "api.CredentialHealthReport": {
+            "type": "object",
+            "properties": {
+                "healthy": {
+                    "type": "boolean"
+                },
+                "platforms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.CredentialHealth"
+                    }
+                }
+            }
+        },
+        "api.DailyViews": {
+            "type": "object",
+            "properties": {
+                "day": {
+                    "type": "string",
+                    "example": "2026-01-31"
+                },
+                "views": {
+                    "type": "integer"
+                },
+                "visitors": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.DoctorIssue": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "tag \"go\" points at a missing row in blog_posts"
+                },
+                "fixable": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "orphanedTag",
+                        "wordCountMismatch",
+                        "danglingSocialPost",
+                        "missingSlug",
+                        "duplicateSlug",
+                        "brokenMedia"
+                    ],
+                    "example": "orphanedTag"
+                },
+                "table": {
+                    "type": "string",
+                    "example": "blog_tags"
+                }
+            }
+        },
+        "api.DoctorReport": {
+            "type": "object",
+            "properties": {
+                "fixed": {
+                    "type": "integer"
+                },
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.DoctorIssue"
+                    }
+                }
+            }
+        },
+        "api.EducationCollection": {
+            "type": "object",
+            "properties": {
+                "education": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Education"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.ErrorCount": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "BLOG_POST_NOT_FOUND"
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                }
+            }
+        },
+        "api.ErrorMetrics": {
+            "type": "object",
+            "properties": {
+                "clientErrors": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ErrorCount"
+                    }
+                },
+                "serverErrors": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "since": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.ErrorResponse": {
+            "description": "Error response structure",
+            "type": "object",
+            "properties": {
+                "cause": {
+                    "type": "string",
+                    "example": "Underlying error cause"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "BLOG_POST_NOT_FOUND"
+                },
+                "details": {
+                    "type": "string",
+                    "example": "Additional error details"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "Internal Server Error"
+                },
+                "field": {
+                    "type": "string",
+                    "example": "title"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/errs.FieldError"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "example": "error"
+                }
+            }
+        },
+        "api.ExpiringCertification": {
+            "type": "object",
+            "required": [
+                "issueDate"
+            ],
+            "properties": {
+                "credentialId": {
+                    "type": "string"
+                },
+                "credentialUrl": {
+                    "type": "string"
+                },
+                "daysUntilExpiry": {
+                    "type": "integer"
+                },
+                "expired": {
+                    "type": "boolean"
+                },
+                "expiryDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "issueDate": {
+                    "type": "string"
+                },
+                "issuer": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.ExpiringCertificationReport": {
+            "type": "object",
+            "properties": {
+                "certifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ExpiringCertification"
+                    }
+                },
+                "withinDays": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.ExportedTags": {
+            "type": "object",
+            "properties": {
+                "blogPosts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TagCount"
+                    }
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TagCount"
+                    }
+                }
+            }
+        },
+        "api.FAQCollection": {
+            "type": "object",
+            "properties": {
+                "faqs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FAQ"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.GeoReport": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.CountryViews"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2026-01-01"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2026-01-31"
+                }
+            }
+        },
+        "api.GitHubProjectImport": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "dryRun": {
+                    "description": "DryRun returns the project as it would be created without saving it, e.g. to prefill a form",
+                    "type": "boolean"
+                },
+                "type": {
+                    "description": "Type is the project's type, open-source unless given",
+                    "type": "string",
+                    "example": "web"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://github.com/example/personal-site-backend"
+                }
+            }
+        },
+        "api.GuestbookEntryCollection": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GuestbookEntry"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.GuestbookSubmission": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "Email is a honeypot: the form hides it from people, so anything filled in came from a bot",
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Cool site!"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Jane Doe"
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://janedoe.dev"
+                }
+            }
+        },
+        "api.ImportReport": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "dryRun": {
+                    "type": "boolean"
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ImportResult"
+                    }
+                },
+                "skipped": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "api.ImportResult": {
+            "type": "object",
+            "properties": {
+                "attachments": {
+                    "description": "Attachments lists the URLs of the media attached to the post on its old platform, which aren't copied",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "https://example.com/wp-content/uploads/2024/01/cover.jpg"
+                    ]
+                },
+                "error": {
+                    "type": "string"
+                },
+                "file": {
+                    "type": "string",
+                    "example": "posts/2024-01-15_Hello-World-1a2b3c4d5e6f.html"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "created",
+                        "updated",
+                        "skipped",
+                        "failed"
+                    ]
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Hello World"
+                }
+            }
+        },
+        "api.IndexAuditReport": {
+            "type": "object",
+            "properties": {
+                "missingIndexes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "idx_blog_post_date_added"
+                    ]
+                },
+                "plans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.QueryPlanReport"
+                    }
+                }
+            }
+        },
+        "api.JSONFeed": {
+            "type": "object",
+            "properties": {
+                "authors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.JSONFeedAuthor"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "feed_url": {
+                    "type": "string",
+                    "example": "https://api.example.com/feed.json"
+                },
+                "home_page_url": {
+                    "type": "string",
+                    "example": "https://example.com"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.JSONFeedItem"
+                    }
+                },
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Blog"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "https://jsonfeed.org/version/1.1"
+                }
+            }
+        },
+        "api.JSONFeedAttachment": {
+            "type": "object",
+            "properties": {
+                "mime_type": {
+                    "type": "string",
+                    "example": "image/png"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.JSONFeedAuthor": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com"
+                }
+            }
+        },
+        "api.JSONFeedItem": {
+            "type": "object",
+            "properties": {
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.JSONFeedAttachment"
+                    }
+                },
+                "content_html": {
+                    "type": "string"
+                },
+                "content_text": {
+                    "type": "string"
+                },
+                "date_modified": {
+                    "type": "string"
+                },
+                "date_published": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "pt"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.ListLinks": {
+            "type": "object",
+            "properties": {
+                "next": {
+                    "type": "string"
+                },
+                "prev": {
+                    "type": "string"
+                },
+                "self": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.ListMeta": {
+            "type": "object",
+            "properties": {
+                "nextCursor": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "perPage": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.MarkdownDocument": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "---\ntitle: Hello World\ntags: [go]\n---\nFirst post."
+                },
+                "fileName": {
+                    "type": "string",
+                    "example": "hello-world.md"
+                }
+            }
+        },
+        "api.MediaObjectCollection": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MediaObject"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/api.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.ListMeta"
+                }
+            }
+        },
+        "api.MetaTag": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "twitter:title"
+                },
+                "property": {
+                    "type": "string",
+                    "example": "og:title"
+                }
+            }
+        },
+        "api.MicropubConfig": {
+            "type": "object",
+            "properties": {
+                "media-endpoint": {
+                    "type": "string",
+                    "example": "https://api.example.com/micropub/media"
+                },
+                "syndicate-to": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.MicropubSyndicationTarget"
+                    }
+                }
+            }
+        },
+        "api.MicropubError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "insufficient_scope"
+                },
+                "error_description": {
+                    "type": "string",
+                    "example": "token needs the create scope"
+                }
+            }
+        },
+        "api.MicropubSyndicationTarget": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Mastodon"
+                },
+                "uid": {
+                    "type": "string",
+                    "example": "mastodon"
+                }
+            }
+        },
+        "api.NoteCollection": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Note"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/api.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.ListMeta"
+                }
+            }
+        },
+        "api.PageMeta": {
+            "type": "object",
+            "properties": {
+                "canonicalUrl": {
+                    "type": "string",
+                    "example": "https://example.com/blog/personal-site-backend"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "openGraph": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.MetaTag"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Building a Personal Site Backend in Go"
+                },
+                "twitter": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.MetaTag"
+                    }
+                }
+            }
+        },
+        "api.PageViewRequest": {
+            "type": "object",
+            "properties": {
+                "contentId": {
+                    "type": "string"
+                },
+                "contentType": {
+                    "type": "string",
+                    "enum": [
+                        "blogPost",
+                        "project"
+                    ]
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/blog/my-post?utm_source=newsletter"
+                },
+                "referrer": {
+                    "type": "string",
+                    "example": "https://news.ycombinator.com/item?id=1"
+                }
+            }
+        },
+        "api.PlatformSuccess": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "succeeded": {
+                    "type": "integer"
+                },
+                "successRate": {
+                    "type": "number"
+                }
+            }
+        },
+        "api.PopularBlogPost": {
+            "type": "object",
+            "properties": {
+                "dateAdded": {
+                    "type": "string"
+                },
+                "dateEdited": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "readingTimeMinutes": {
+                    "type": "integer"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "views": {
+                    "type": "integer"
+                },
+                "wordCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.PopularBlogPostCollection": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.PopularBlogPost"
+                    }
+                },
+                "period": {
+                    "type": "string",
+                    "example": "7d"
+                }
+            }
+        },
+        "api.PreviewLink": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.ProjectCaseStudy": {
+            "type": "object",
+            "properties": {
+                "html": {
+                    "type": "string",
+                    "example": "\u003ch2 id=\"why\"\u003eWhy\u003c/h2\u003e\n\u003cp\u003eThe site needed an API.\u003c/p\u003e\n"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "personal-site-backend"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Personal Site Backend"
+                }
+            }
+        },
+        "api.ProjectCollectionWithTags": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ProjectWithTags"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/api.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.ListMeta"
+                }
+            }
+        },
+        "api.ProjectImageCollection": {
+            "type": "object",
+            "properties": {
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ProjectImage"
+                    }
+                }
+            }
+        },
+        "api.ProjectSearchResult": {
+            "type": "object",
+            "properties": {
+                "project": {
+                    "$ref": "#/definitions/models.Project"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ProjectTag"
+                    }
+                }
+            }
+        },
+        "api.ProjectSearchResults": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ProjectSearchResult"
+                    }
+                },
+                "query": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.ProjectSummary": {
+            "type": "object",
+            "properties": {
+                "date_added": {
+                    "type": "string"
+                },
+                "date_edited": {
+                    "type": "string"
+                },
+                "demo_link": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "gif_link": {
+                    "type": "string"
+                },
+                "github_link": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.ProjectSummaryCollection": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ProjectSummary"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/api.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.ListMeta"
+                }
+            }
+        },
+        "api.ProjectWithTags": {
+            "type": "object",
+            "properties": {
+                "project": {
+                    "$ref": "#/definitions/models.Project"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ProjectTag"
+                    }
+                }
+            }
+        },
+        "api.QueryPlanReport": {
+            "type": "object",
+            "properties": {
+                "indexesUsed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "blog posts page"
+                },
+                "seqScans": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sql": {
+                    "type": "string"
+                },
+                "totalCost": {
+                    "type": "number"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "api.ReadingList": {
+            "type": "object",
+            "properties": {
+                "currentlyReading": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Book"
+                    }
+                },
+                "finished": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Book"
+                    }
+                }
+            }
+        },
+        "api.RecentChange": {
+            "type": "object",
+            "properties": {
+                "blogPost": {
+                    "$ref": "#/definitions/models.BlogPost"
+                },
+                "dateAdded": {
+                    "type": "string"
+                },
+                "dateEdited": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "$ref": "#/definitions/models.Note"
+                },
+                "project": {
+                    "$ref": "#/definitions/models.Project"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "blog_post",
+                        "project",
+                        "note"
+                    ]
+                }
+            }
+        },
+        "api.RecentChanges": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.RecentChange"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.ReferrerReport": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ContentSources"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2026-01-01"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2026-01-31"
+                }
+            }
+        },
+        "api.RegionViews": {
+            "type": "object",
+            "properties": {
+                "region": {
+                    "type": "string",
+                    "example": "CA"
+                },
+                "views": {
+                    "type": "integer"
+                },
+                "visitors": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.Resume": {
+            "type": "object",
+            "properties": {
+                "certifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Certification"
+                    }
+                },
+                "education": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Education"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "workExperience": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkExperience"
+                    }
+                }
+            }
+        },
+        "api.ScheduledJobList": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ScheduledJobStatus"
+                    }
+                }
+            }
+        },
+        "api.ScheduledJobStatus": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "failures": {
+                    "type": "integer"
+                },
+                "interval": {
+                    "type": "string",
+                    "example": "1m0s"
+                },
+                "jitter": {
+                    "type": "string",
+                    "example": "5s"
+                },
+                "lastDurationMs": {
+                    "type": "integer"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "lastFinishedAt": {
+                    "type": "string"
+                },
+                "lastStartedAt": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "publishScheduledPosts"
+                },
+                "nextRunAt": {
+                    "type": "string"
+                },
+                "running": {
+                    "type": "boolean"
+                },
+                "runs": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.SchemaDriftReport": {
+            "type": "object",
+            "properties": {
+                "drifted": {
+                    "type": "boolean"
+                },
+                "tables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TableDriftReport"
+                    }
+                }
+            }
+        },
+        "api.SearchResult": {
+            "type": "object",
+            "properties": {
+                "blogPost": {
+                    "$ref": "#/definitions/api.BlogPostSummary"
+                },
+                "project": {
+                    "$ref": "#/definitions/api.ProjectSummary"
+                },
+                "score": {
+                    "type": "number",
+                    "example": 1.06
+                },
+                "tag": {
+                    "$ref": "#/definitions/api.SearchTag"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "blog_post",
+                        "project",
+                        "tag"
+                    ],
+                    "example": "blog_post"
+                }
+            }
+        },
+        "api.SearchResults": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.SearchResult"
+                    }
+                },
+                "query": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.SearchTag": {
+            "type": "object",
+            "properties": {
+                "blogPosts": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "projects": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "value": {
+                    "type": "string",
+                    "example": "go"
+                }
+            }
+        },
+        "api.SeriesCollection": {
+            "type": "object",
+            "properties": {
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Series"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.SeriesPostRequest": {
+            "type": "object",
+            "properties": {
+                "position": {
+                    "description": "Position is where the post goes in the series; it's put after the last post when not given",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 2
+                }
+            }
+        },
+        "api.SeriesPostSummary": {
+            "type": "object",
+            "properties": {
+                "dateAdded": {
+                    "type": "string"
+                },
+                "dateEdited": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "readingTimeMinutes": {
+                    "type": "integer"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "wordCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.SeriesResponse": {
+            "type": "object",
+            "properties": {
+                "dateAdded": {
+                    "type": "string"
+                },
+                "dateEdited": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.SeriesPostSummary"
+                    }
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.ShortLinkCollection": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ShortLinkResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/api.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.ListMeta"
+                }
+            }
+        },
+        "api.ShortLinkRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "gh"
+                },
+                "contentId": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "contentType": {
+                    "type": "string",
+                    "enum": [
+                        "blogPost",
+                        "project",
+                        "url"
+                    ],
+                    "example": "blogPost"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://github.com/rpupo63"
+                }
+            }
+        },
+        "api.ShortLinkResponse": {
+            "type": "object",
+            "properties": {
+                "clicks": {
+                    "type": "integer"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "contentId": {
+                    "type": "string"
+                },
+                "contentType": {
+                    "type": "string"
+                },
+                "dateAdded": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "shortUrl": {
+                    "type": "string",
+                    "example": "https://rp.dev/s/aZ3kQ9"
+                },
+                "targetUrl": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.SkillCollection": {
+            "type": "object",
+            "properties": {
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Skill"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.SnippetCollection": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Snippet"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/api.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.ListMeta"
+                }
+            }
+        },
+        "api.SocialPostCollection": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SocialPost"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/api.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.ListMeta"
+                }
+            }
+        },
+        "api.SourceViews": {
+            "type": "object",
+            "properties": {
+                "source": {
+                    "type": "string"
+                },
+                "views": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.TableDriftReport": {
+            "type": "object",
+            "properties": {
+                "drifted": {
+                    "type": "boolean"
+                },
+                "exists": {
+                    "type": "boolean"
+                },
+                "extraColumns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "legacy_slug"
+                    ]
+                },
+                "missingColumns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "canonical_url"
+                    ]
+                },
+                "table": {
+                    "type": "string",
+                    "example": "blog_posts"
+                },
+                "typeMismatches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ColumnTypeMismatchReport"
+                    }
+                }
+            }
+        },
+        "api.Tag": {
+            "type": "object",
+            "properties": {
+                "blogPosts": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "projects": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "value": {
+                    "type": "string",
+                    "example": "go"
+                }
+            }
+        },
+        "api.TagCollection": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.Tag"
+                    }
+                }
+            }
+        },
+        "api.TagCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "value": {
+                    "type": "string",
+                    "example": "go"
+                }
+            }
+        },
+        "api.TagMerge": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string",
+                    "example": "golang"
+                },
+                "into": {
+                    "type": "string",
+                    "example": "go"
+                }
+            }
+        },
+        "api.TagRename": {
+            "type": "object",
+            "properties": {
+                "value": {
+                    "type": "string",
+                    "example": "golang"
+                }
+            }
+        },
+        "api.TestimonialCollection": {
+            "type": "object",
+            "properties": {
+                "testimonials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Testimonial"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.TestimonialSubmission": {
+            "type": "object",
+            "properties": {
+                "authorCompany": {
+                    "type": "string",
+                    "example": "Acme Corp"
+                },
+                "authorName": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "authorRole": {
+                    "type": "string",
+                    "example": "Engineering Manager"
+                },
+                "text": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "A pleasure to work with."
+                }
+            }
+        },
+        "api.Timeline": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TimelineItem"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.TimelineItem": {
+            "type": "object",
+            "properties": {
+                "blogPost": {
+                    "$ref": "#/definitions/models.BlogPost"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "$ref": "#/definitions/models.Note"
+                },
+                "project": {
+                    "$ref": "#/definitions/models.Project"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "blog_post",
+                        "project",
+                        "note"
+                    ]
+                }
+            }
+        },
+        "api.TrashedBlogPost": {
+            "type": "object",
+            "properties": {
+                "blogPost": {
+                    "$ref": "#/definitions/models.BlogPost"
+                },
+                "deletedAt": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BlogTag"
+                    }
+                }
+            }
+        },
+        "api.TrashedBlogPostCollection": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TrashedBlogPost"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/api.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.ListMeta"
+                }
+            }
+        },
+        "api.TrashedProject": {
+            "type": "object",
+            "properties": {
+                "deletedAt": {
+                    "type": "string"
+                },
+                "project": {
+                    "$ref": "#/definitions/models.Project"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ProjectTag"
+                    }
+                }
+            }
+        },
+        "api.TrashedProjectCollection": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TrashedProject"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/api.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.ListMeta"
+                }
+            }
+        },
+        "api.TrendingItem": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "blogPost",
+                        "project"
+                    ]
+                },
+                "views": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.TrendingResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TrendingItem"
+                    }
+                },
+                "generatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.UnpublishedBlogPosts": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BlogPostWithTags"
+                    }
+                }
+            }
+        },
+        "api.UsesCategory": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.UsesItem"
+                    }
+                }
+            }
+        },
+        "api.UsesPage": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.UsesCategory"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.WebhookCollection": {
+            "type": "object",
+            "properties": {
+                "total": {
+                    "type": "integer"
+                },
+                "webhooks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Webhook"
+                    }
+                }
+            }
+        },
+        "api.WebhookDeliveryCollection": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WebhookDelivery"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/api.ListLinks"
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.ListMeta"
+                }
+            }
+        },
+        "api.WorkExperienceCollection": {
+            "type": "object",
+            "properties": {
+                "total": {
+                    "type": "integer"
+                },
+                "workExperience": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkExperience"
+                    }
+                }
+            }
+        },
+        "errs.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "title"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "is required"
+                }
+            }
+        },
+        "models.BlogPost": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "dateAdded": {
+                    "type": "string"
+                },
+                "dateEdited": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "images": {
+                    "description": "Images can be given when the post is created, and are managed under /blog-post/{blogPostID}/images after that",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BlogPostImage"
+                    }
+                },
+                "metaDescription": {
+                    "description": "MetaDescription stands in for the summary in search results and link previews",
+                    "type": "string",
+                    "maxLength": 160
+                },
+                "metaTitle": {
+                    "description": "MetaTitle stands in for the title in search results and link previews",
+                    "type": "string",
+                    "maxLength": 70
+                },
+                "ogImageUrl": {
+                    "description": "OGImageURL stands in for the first image in the content in link previews",
+                    "type": "string"
+                },
+                "publishAt": {
+                    "type": "string"
+                },
+                "readingTimeMinutes": {
+                    "description": "ReadingTimeMinutes is how long Content takes to read, rounded up to the minute",
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "draft",
+                        "scheduled",
+                        "published"
+                    ]
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BlogTag"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "description": "URL is where the post lives, and its canonical URL wherever it's shared or cross-posted",
+                    "type": "string"
+                },
+                "wordCount": {
+                    "description": "WordCount is how many words Content has, counted whenever it's saved",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.BlogPostImage": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "altText": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "blogPostId": {
+                    "type": "string"
+                },
+                "caption": {
+                    "type": "string"
+                },
+                "dateAdded": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isFeatured": {
+                    "type": "boolean"
+                },
+                "position": {
+                    "description": "Position orders the gallery, lowest first; images added without one go after the last",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BlogPostTranslation": {
+            "type": "object",
+            "properties": {
+                "blogPostId": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "dateAdded": {
+                    "type": "string"
+                },
+                "dateEdited": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BlogTag": {
+            "type": "object",
+            "properties": {
+                "blog_post": {
+                    "$ref": "#/definitions/models.BlogPost"
+                },
+                "blog_post_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Book": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "type": "string"
+                },
+                "coverUrl": {
+                    "type": "string"
+                },
+                "dateAdded": {
+                    "type": "string"
+                },
+                "dateFinished": {
+                    "type": "string"
+                },
+                "dateStarted": {
+                    "type": "string"
+                },
+                "firstPublishYear": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isbn": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "openLibraryKey": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "want_to_read",
+                        "reading",
+                        "finished"
+                    ]
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Bookmark": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "dateAdded": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "imageUrl": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BookmarkTag"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BookmarkTag": {
+            "type": "object",
+            "properties": {
+                "bookmark_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Certification": {
+            "type": "object",
+            "required": [
+                "issueDate"
+            ],
+            "properties": {
+                "credentialId": {
+                    "type": "string"
+                },
+                "credentialUrl": {
+                    "type": "string"
+                },
+                "expiryDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "issueDate": {
+                    "type": "string"
+                },
+                "issuer": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Education": {
+            "type": "object",
+            "required": [
+                "startDate"
+            ],
+            "properties": {
+                "degree": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "fieldOfStudy": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "institution": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.FAQ": {
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "published": {
+                    "type": "boolean"
+                },
+                "question": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.GuestbookEntry": {
+            "type": "object",
+            "properties": {
+                "approved": {
+                    "type": "boolean"
+                },
+                "dateApproved": {
+                    "type": "string"
+                },
+                "dateSubmitted": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.MediaObject": {
+            "type": "object",
+            "properties": {
+                "contentType": {
+                    "type": "string"
+                },
+                "dateAdded": {
+                    "type": "string"
+                },
+                "fileName": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "srcset": {
+                    "description": "Srcset lists the image and its web-sized variants by width, ready for an img tag's srcset",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "variants": {
+                    "description": "Variants are the resized copies made of an image, stored next to it in the bucket",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MediaVariant"
+                    }
+                },
+                "width": {
+                    "description": "Width and Height are only set for images",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.MediaVariant": {
+            "type": "object",
+            "properties": {
+                "contentType": {
+                    "type": "string",
+                    "example": "image/jpeg"
+                },
+                "height": {
+                    "type": "integer",
+                    "example": 540
+                },
+                "key": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "w960"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "width": {
+                    "type": "integer",
+                    "example": 960
+                }
+            }
+        },
+        "models.Note": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "dateAdded": {
+                    "type": "string"
+                },
+                "dateEdited": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "imageUrl": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Project": {
+            "type": "object",
+            "properties": {
+                "canonical_url": {
+                    "description": "CanonicalURL is the project's page on the site, for search engines to index instead of its other addresses",
+                    "type": "string"
+                },
+                "case_study": {
+                    "description": "CaseStudy is a long-form write-up of the project in Markdown, served as HTML by GET /project/{slug}/case-study",
+                    "type": "string"
+                },
+                "date_added": {
+                    "type": "string"
+                },
+                "date_edited": {
+                    "type": "string"
+                },
+                "demo_link": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "gif_link": {
+                    "type": "string"
+                },
+                "github_link": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "images": {
+                    "description": "Images can be given when the project is created, and are managed under /project/{projectID}/images after that",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ProjectImage"
+                    }
+                },
+                "meta_description": {
+                    "description": "MetaDescription stands in for the description in search results and link previews",
+                    "type": "string",
+                    "maxLength": 160
+                },
+                "meta_title": {
+                    "description": "MetaTitle stands in for the title in search results and link previews",
+                    "type": "string",
+                    "maxLength": 70
+                },
+                "og_image_url": {
+                    "description": "OGImageURL is the image link previews show",
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ProjectTag"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
-        }
-    },
-    "definitions": {
-        "api.BlogPostCollectionWithTags": {
+        },
+        "models.ProjectImage": {
             "type": "object",
+            "required": [
+                "url"
+            ],
             "properties": {
-                "blogPosts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/api.BlogPostWithTags"
-                    }
+                "alt_text": {
+                    "type": "string",
+                    "maxLength": 500
                 },
-                "total": {
-                    "type": "integer"
+                "caption": {
+                    "type": "string"
+                },
+                "date_added": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "position": {
+                    "description": "Position orders the gallery, lowest first; images added without one go after the last",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
                 }
             }
         },
-        "api.BlogPostWithTags": {
+        "models.ProjectTag": {
             "type": "object",
             "properties": {
-                "blogPost": {
-                    "$ref": "#/definitions/models.BlogPost"
+                "id": {
+                    "type": "string"
                 },
-                "tags": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.BlogTag"
-                    }
+                "project": {
+                    "$ref": "#/definitions/models.Project"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
                 }
             }
         },
-        "api.ErrorResponse": {
-            "description": "Error response structure",
+        "models.Series": {
             "type": "object",
             "properties": {
-                "cause": {
-                    "type": "string",
-                    "example": "Underlying error cause"
+                "dateAdded": {
+                    "type": "string"
                 },
-                "details": {
-                    "type": "string",
-                    "example": "Additional error details"
+                "dateEdited": {
+                    "type": "string"
                 },
-                "error": {
-                    "type": "string",
-                    "example": "Internal Server Error"
+                "description": {
+                    "type": "string"
                 },
-                "field": {
-                    "type": "string",
-                    "example": "title"
+                "id": {
+                    "type": "string"
                 },
-                "status": {
-                    "type": "string",
-                    "example": "error"
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
-        "api.ProjectCollectionWithTags": {
+        "models.SeriesPost": {
             "type": "object",
             "properties": {
-                "projects": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/api.ProjectWithTags"
-                    }
+                "blogPostId": {
+                    "type": "string"
                 },
-                "total": {
+                "position": {
                     "type": "integer"
+                },
+                "seriesId": {
+                    "type": "string"
                 }
             }
         },
-        "api.ProjectWithTags": {
+        "models.ShareLink": {
             "type": "object",
             "properties": {
-                "project": {
-                    "$ref": "#/definitions/models.Project"
+                "clicks": {
+                    "type": "integer"
                 },
-                "tags": {
+                "contentId": {
+                    "type": "string"
+                },
+                "contentType": {
+                    "type": "string"
+                },
+                "dateAdded": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "targetUrl": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Skill": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "proficiency": {
+                    "type": "string",
+                    "enum": [
+                        "beginner",
+                        "intermediate",
+                        "advanced",
+                        "expert"
+                    ]
+                },
+                "projectIds": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.ProjectTag"
+                        "type": "string",
+                        "format": "uuid"
                     }
+                },
+                "yearsOfExperience": {
+                    "type": "number",
+                    "minimum": 0
                 }
             }
         },
-        "models.BlogPost": {
+        "models.Snippet": {
             "type": "object",
             "properties": {
-                "content": {
+                "code": {
                     "type": "string"
                 },
                 "dateAdded": {
@@ -598,101 +12520,253 @@ const docTemplate = `{
                 "dateEdited": {
                     "type": "string"
                 },
-                "id": {
+                "description": {
                     "type": "string"
                 },
-                "length": {
-                    "type": "integer"
-                },
-                "summary": {
+                "id": {
                     "type": "string"
                 },
+                "language": {
+                    "type": "string",
+                    "maxLength": 32
+                },
                 "tags": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.BlogTag"
+                        "type": "string"
                     }
                 },
                 "title": {
                     "type": "string"
+                }
+            }
+        },
+        "models.SocialPost": {
+            "type": "object",
+            "properties": {
+                "contentId": {
+                    "type": "string"
                 },
-                "url": {
+                "contentType": {
+                    "type": "string"
+                },
+                "dateAdded": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "shareLink": {
+                    "$ref": "#/definitions/models.ShareLink"
+                },
+                "shareLinkId": {
+                    "type": "string"
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "models.BlogTag": {
+        "models.Testimonial": {
             "type": "object",
             "properties": {
-                "blog_post": {
-                    "$ref": "#/definitions/models.BlogPost"
+                "approved": {
+                    "type": "boolean"
                 },
-                "blog_post_id": {
+                "authorCompany": {
+                    "type": "string"
+                },
+                "authorName": {
+                    "type": "string"
+                },
+                "authorRole": {
+                    "type": "string"
+                },
+                "dateApproved": {
+                    "type": "string"
+                },
+                "dateSubmitted": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "value": {
+                "text": {
                     "type": "string"
                 }
             }
         },
-        "models.Project": {
+        "models.UsesItem": {
             "type": "object",
             "properties": {
-                "demo_link": {
+                "category": {
                     "type": "string"
                 },
                 "description": {
                     "type": "string"
                 },
-                "gif_link": {
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "id": {
                     "type": "string"
                 },
-                "github_link": {
+                "link": {
                     "type": "string"
                 },
-                "id": {
+                "name": {
                     "type": "string"
+                }
+            }
+        },
+        "models.Webhook": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
                 },
-                "tags": {
+                "dateAdded": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "events": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/models.ProjectTag"
+                        "type": "string"
                     }
                 },
-                "title": {
+                "id": {
                     "type": "string"
                 },
-                "type": {
+                "secret": {
+                    "type": "string"
+                },
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "models.ProjectTag": {
+        "models.WebhookDelivery": {
             "type": "object",
             "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "dateAdded": {
+                    "type": "string"
+                },
+                "dateDelivered": {
+                    "type": "string"
+                },
+                "event": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
-                "project": {
-                    "$ref": "#/definitions/models.Project"
+                "lastError": {
+                    "type": "string"
                 },
-                "project_id": {
+                "lastStatusCode": {
+                    "type": "integer"
+                },
+                "nextAttemptAt": {
                     "type": "string"
                 },
-                "value": {
+                "payload": {
+                    "type": "object"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.WebhookDeliveryStatus"
+                },
+                "webhookId": {
                     "type": "string"
                 }
             }
+        },
+        "models.WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "succeeded",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "WebhookDeliveryPending",
+                "WebhookDeliverySucceeded",
+                "WebhookDeliveryFailed"
+            ]
+        },
+        "models.WorkExperience": {
+            "type": "object",
+            "required": [
+                "startDate"
+            ],
+            "properties": {
+                "company": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "highlights": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "techTags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "Admin routes require \"Bearer \u003cBACKEND_PASSWORD\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        },
+        "IndieAuth": {
+            "description": "Micropub routes require \"Bearer \u003caccess token\u003e\", issued to INDIEAUTH_ME by INDIEAUTH_TOKEN_ENDPOINT",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
         }
     }
 }`
 
 // SwaggerInfo holds exported Swagger Info so clients can modify it
 var SwaggerInfo = &swag.Spec{
-	Version:          "1.0",
+	Version:          "1.1",
 	Host:             "localhost:8080",
 	BasePath:         "/",
 	Schemes:          []string{"http", "https"},
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 // Common error sentinel values
@@ -27,19 +28,29 @@ var (
 	ErrInvalidXML           = errors.New("invalid XML")
 	ErrInvalidContentType   = errors.New("invalid content type")
 	ErrInvalidCharset       = errors.New("invalid charset")
+	ErrValidationFailed     = errors.New("validation failed")
 )
 
 type ApiErr struct {
 	StatusCode int
+	Code       string // Stable machine-readable identifier, e.g. BLOG_POST_NOT_FOUND
 	err        error
-	Details    string // Additional details about the error
-	Field      string // Field that caused the error (for validation errors)
-	Cause      error  // The underlying cause of the error
+	Details    string       // Additional details about the error
+	Field      string       // Field that caused the error (for validation errors)
+	Cause      error        // The underlying cause of the error
+	Fields     []FieldError // Every field that failed validation, when several are reported at once
+}
+
+// FieldError describes one field that failed validation
+type FieldError struct {
+	Field   string `json:"field" example:"title"`
+	Message string `json:"message" example:"is required"`
 }
 
 func NewApiErr(statusCode int, message string) *ApiErr {
 	return &ApiErr{
 		StatusCode: statusCode,
+		Code:       statusCodeName(statusCode),
 		err:        errors.New(message),
 	}
 }
@@ -75,27 +86,27 @@ func (e *ApiErr) Unwrap() error {
 
 // Common error constructors with appropriate HTTP status codes
 func NewNotFoundError(message string) *ApiErr {
-	return &ApiErr{StatusCode: 404, err: errors.New(message)}
+	return &ApiErr{StatusCode: 404, Code: CodeNotFound, err: errors.New(message)}
 }
 
 func NewForbiddenError(message string) *ApiErr {
-	return &ApiErr{StatusCode: 403, err: errors.New(message)}
+	return &ApiErr{StatusCode: 403, Code: CodeForbidden, err: errors.New(message)}
 }
 
 func NewBadRequestError(message string) *ApiErr {
-	return &ApiErr{StatusCode: 400, err: errors.New(message)}
+	return &ApiErr{StatusCode: 400, Code: CodeBadRequest, err: errors.New(message)}
 }
 
 func NewUnauthorizedError(message string) *ApiErr {
-	return &ApiErr{StatusCode: 401, err: errors.New(message)}
+	return &ApiErr{StatusCode: 401, Code: CodeUnauthorized, err: errors.New(message)}
 }
 
 func NewInternalError(message string) *ApiErr {
-	return &ApiErr{StatusCode: 500, err: errors.New(message)}
+	return &ApiErr{StatusCode: 500, Code: CodeInternal, err: errors.New(message)}
 }
 
 func NewConflictError(message string) *ApiErr {
-	return &ApiErr{StatusCode: 409, err: errors.New(message)}
+	return &ApiErr{StatusCode: 409, Code: CodeConflict, err: errors.New(message)}
 }
 
 func IsForbidden(err error) bool {
@@ -126,6 +137,7 @@ func IsNotFound(err error) bool {
 func NewBadRequestErrorWithDetails(message, details string) *ApiErr {
 	return &ApiErr{
 		StatusCode: 400,
+		Code:       CodeBadRequest,
 		err:        errors.New(message),
 		Details:    details,
 	}
@@ -134,6 +146,7 @@ func NewBadRequestErrorWithDetails(message, details string) *ApiErr {
 func NewBadRequestErrorWithField(message, field, details string) *ApiErr {
 	return &ApiErr{
 		StatusCode: 400,
+		Code:       CodeBadRequest,
 		err:        errors.New(message),
 		Field:      field,
 		Details:    details,
@@ -143,6 +156,7 @@ func NewBadRequestErrorWithField(message, field, details string) *ApiErr {
 func NewInternalErrorWithCause(message string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: 500,
+		Code:       CodeInternal,
 		err:        errors.New(message),
 		Cause:      cause,
 	}
@@ -152,6 +166,7 @@ func NewCORSError(origin string) *ApiErr {
 	return &ApiErr{
 		StatusCode: 403,
 		err:        ErrCORSBlocked,
+		Code:       CodeCORSBlocked,
 		Details:    fmt.Sprintf("Origin '%s' is not allowed by CORS policy", origin),
 	}
 }
@@ -161,6 +176,7 @@ func NewMalformedPayloadError(payloadType string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrMalformedPayload,
+		Code:       CodeMalformedPayload,
 		Details:    fmt.Sprintf("Malformed %s payload", payloadType),
 		Cause:      cause,
 		Field:      "payload",
@@ -171,6 +187,7 @@ func NewMissingRequiredFieldError(fieldName string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrMissingRequiredField,
+		Code:       CodeMissingRequiredField,
 		Details:    fmt.Sprintf("Missing required field: %s", fieldName),
 		Field:      fieldName,
 	}
@@ -180,15 +197,36 @@ func NewInvalidFieldError(fieldName string, reason string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrInvalidField,
+		Code:       CodeInvalidField,
 		Details:    fmt.Sprintf("Invalid field %s: %s", fieldName, reason),
 		Field:      fieldName,
 	}
 }
 
+// NewValidationError reports every field that failed validation in a single error
+func NewValidationError(fields []FieldError) *ApiErr {
+	messages := make([]string, len(fields))
+	for i, field := range fields {
+		messages[i] = field.Field + " " + field.Message
+	}
+	apiErr := &ApiErr{
+		StatusCode: http.StatusBadRequest,
+		err:        ErrValidationFailed,
+		Code:       CodeValidationFailed,
+		Details:    strings.Join(messages, "; "),
+		Fields:     fields,
+	}
+	if len(fields) == 1 {
+		apiErr.Field = fields[0].Field
+	}
+	return apiErr
+}
+
 func NewUnsupportedMediaTypeError(contentType string, allowedTypes []string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusUnsupportedMediaType,
 		err:        ErrUnsupportedMediaType,
+		Code:       CodeUnsupportedMediaType,
 		Details:    fmt.Sprintf("Unsupported media type: %s. Allowed types: %v", contentType, allowedTypes),
 		Field:      "content_type",
 	}
@@ -198,6 +236,7 @@ func NewMaxBodySizeExceededError(maxSize int64) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusRequestEntityTooLarge,
 		err:        ErrMaxBodySizeExceeded,
+		Code:       CodeMaxBodySizeExceeded,
 		Details:    fmt.Sprintf("Request body size exceeded maximum allowed size of %d bytes", maxSize),
 		Field:      "body_size",
 	}
@@ -207,6 +246,7 @@ func NewInvalidJSONError(cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrInvalidJSON,
+		Code:       CodeInvalidJSON,
 		Details:    "Invalid JSON format",
 		Cause:      cause,
 		Field:      "json",
@@ -217,6 +257,7 @@ func NewInvalidXMLError(cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrInvalidXML,
+		Code:       CodeInvalidXML,
 		Details:    "Invalid XML format",
 		Cause:      cause,
 		Field:      "xml",
@@ -227,6 +268,7 @@ func NewInvalidContentTypeError(contentType string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrInvalidContentType,
+		Code:       CodeInvalidContentType,
 		Details:    fmt.Sprintf("Invalid content type: %s", contentType),
 		Field:      "content_type",
 	}
@@ -236,6 +278,7 @@ func NewInvalidCharsetError(charset string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrInvalidCharset,
+		Code:       CodeInvalidCharset,
 		Details:    fmt.Sprintf("Invalid charset: %s", charset),
 		Field:      "charset",
 	}
@@ -277,3 +320,7 @@ func IsInvalidContentTypeError(err error) bool {
 func IsInvalidCharsetError(err error) bool {
 	return errors.Is(err, ErrInvalidCharset)
 }
+
+func IsValidationFailedError(err error) bool {
+	return errors.Is(err, ErrValidationFailed)
+}
@@ -0,0 +1,176 @@
+package errs
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Codes are stable, machine-readable identifiers sent as "code" in every error response, so clients can branch on
+// them instead of parsing messages. Messages may be reworded; a code never changes meaning once released.
+// Each sentinel error ErrX has a matching CodeX. Errors about a specific entity prefix the entity, see EntityCode
+
+// Request & Input-Validation Codes
+const (
+	CodeForbidden            = "FORBIDDEN"
+	CodeBadRequest           = "BAD_REQUEST"
+	CodeUnauthorized         = "UNAUTHORIZED"
+	CodeInternal             = "INTERNAL_ERROR"
+	CodeConflict             = "CONFLICT"
+	CodeCORSBlocked          = "CORS_BLOCKED"
+	CodeMalformedPayload     = "MALFORMED_PAYLOAD"
+	CodeMissingRequiredField = "MISSING_REQUIRED_FIELD"
+	CodeInvalidField         = "INVALID_FIELD"
+	CodeUnsupportedMediaType = "UNSUPPORTED_MEDIA_TYPE"
+	CodeMaxBodySizeExceeded  = "MAX_BODY_SIZE_EXCEEDED"
+	CodeInvalidJSON          = "INVALID_JSON"
+	CodeInvalidXML           = "INVALID_XML"
+	CodeInvalidContentType   = "INVALID_CONTENT_TYPE"
+	CodeInvalidCharset       = "INVALID_CHARSET"
+	CodeValidationFailed     = "VALIDATION_FAILED"
+)
+
+// Database & Storage Codes
+const (
+	CodeAlreadyExists             = "ALREADY_EXISTS"
+	CodeNotFound                  = "NOT_FOUND"
+	CodeDatabaseQuery             = "DATABASE_QUERY"
+	CodeDatabaseConnection        = "DATABASE_CONNECTION"
+	CodePoolExhausted             = "POOL_EXHAUSTED"
+	CodeDeadlock                  = "DEADLOCK"
+	CodeSerializationFailure      = "SERIALIZATION_FAILURE"
+	CodeUniqueConstraintViolation = "UNIQUE_CONSTRAINT_VIOLATION"
+	CodeReplicaLag                = "REPLICA_LAG"
+	CodeMigrationMismatch         = "MIGRATION_MISMATCH"
+	CodeStorageQuotaFull          = "STORAGE_QUOTA_FULL"
+	CodeTransactionFailed         = "TRANSACTION_FAILED"
+	CodeForeignKeyConstraint      = "FOREIGN_KEY_CONSTRAINT"
+	CodeDatabaseTimeout           = "DATABASE_TIMEOUT"
+	CodeDatabaseLock              = "DATABASE_LOCK"
+	CodeDatabaseCorruption        = "DATABASE_CORRUPTION"
+)
+
+// Authentication, Authorization & Concurrency Codes
+const (
+	CodeMissingToken      = "MISSING_TOKEN"
+	CodeExpiredToken      = "EXPIRED_TOKEN"
+	CodeInvalidToken      = "INVALID_TOKEN"
+	CodeInsufficientScope = "INSUFFICIENT_SCOPE"
+	CodeInsufficientRole  = "INSUFFICIENT_ROLE"
+	CodeTokenExpired      = "TOKEN_EXPIRED"
+	CodeGoroutineLeak     = "GOROUTINE_LEAK"
+	CodeDataRace          = "DATA_RACE"
+	CodeStarvation        = "STARVATION"
+	CodePriorityInversion = "PRIORITY_INVERSION"
+)
+
+// External Service & Infrastructure Codes
+const (
+	CodeRateLimitExceeded      = "RATE_LIMIT_EXCEEDED"
+	CodeModelOverloaded        = "MODEL_OVERLOADED"
+	CodeContextLengthExceeded  = "CONTEXT_LENGTH_EXCEEDED"
+	CodeContentPolicyViolation = "CONTENT_POLICY_VIOLATION"
+	CodeBillingQuotaExhausted  = "BILLING_QUOTA_EXHAUSTED"
+	CodeStreamingChunkDropped  = "STREAMING_CHUNK_DROPPED"
+	CodeInvalidAPIKey          = "INVALID_API_KEY"
+	CodeServiceUnavailable     = "SERVICE_UNAVAILABLE"
+	CodeTimeout                = "TIMEOUT"
+	CodeCircuitBreakerOpen     = "CIRCUIT_BREAKER_OPEN"
+	CodeConfigMissing          = "CONFIG_MISSING"
+	CodeConfigInvalid          = "CONFIG_INVALID"
+	CodeRegionNotSupported     = "REGION_NOT_SUPPORTED"
+	CodeSecretMismatch         = "SECRET_MISMATCH"
+	CodeEnvironmentVariable    = "ENVIRONMENT_VARIABLE"
+	CodeDNSResolution          = "DNS_RESOLUTION"
+	CodeTCPTimeout             = "TCP_TIMEOUT"
+	CodeTLSHandshake           = "TLS_HANDSHAKE"
+	CodeConnectionReset        = "CONNECTION_RESET"
+	CodeProxyBlocked           = "PROXY_BLOCKED"
+	CodeNetworkUnreachable     = "NETWORK_UNREACHABLE"
+	CodeOutOfMemory            = "OUT_OF_MEMORY"
+	CodeCPUExhausted           = "CPU_EXHAUSTED"
+	CodeFileDescriptorLimit    = "FILE_DESCRIPTOR_LIMIT"
+	CodeDiskSpaceFull          = "DISK_SPACE_FULL"
+	CodeContextDeadline        = "CONTEXT_DEADLINE"
+	CodeClientDisconnected     = "CLIENT_DISCONNECTED"
+	CodeComputationTimeout     = "COMPUTATION_TIMEOUT"
+	CodeRequestTimeout         = "REQUEST_TIMEOUT"
+	CodeSchemaVersionMismatch  = "SCHEMA_VERSION_MISMATCH"
+	CodeClockSkew              = "CLOCK_SKEW"
+	CodePartialFailure         = "PARTIAL_FAILURE"
+	CodeDataCorruption         = "DATA_CORRUPTION"
+	CodeDivideByZero           = "DIVIDE_BY_ZERO"
+	CodeOverflow               = "OVERFLOW"
+	CodeUnderflow              = "UNDERFLOW"
+	CodeNilPointer             = "NIL_POINTER"
+	CodeInvalidInput           = "INVALID_INPUT"
+	CodeCircularStructure      = "CIRCULAR_STRUCTURE"
+	CodeBase64Decode           = "BASE64_DECODE"
+	CodeCharsetConversion      = "CHARSET_CONVERSION"
+	CodeJSONMarshal            = "JSON_MARSHAL"
+	CodeJSONUnmarshal          = "JSON_UNMARSHAL"
+	CodeServiceUnreachable     = "SERVICE_UNREACHABLE"
+	CodeServiceDiscovery       = "SERVICE_DISCOVERY"
+	CodeFeatureFlagBackend     = "FEATURE_FLAG_BACKEND"
+	CodeLoadBalancer           = "LOAD_BALANCER"
+	CodeSQLInjection           = "SQL_INJECTION"
+	CodeRequestForgery         = "REQUEST_FORGERY"
+	CodeSecretsLeaked          = "SECRETS_LEAKED"
+	CodePIIBreach              = "PII_BREACH"
+	CodeUnauthorizedAccess     = "UNAUTHORIZED_ACCESS"
+	CodeTracerExporter         = "TRACER_EXPORTER"
+	CodeLogPipeline            = "LOG_PIPELINE"
+	CodeMetricsCardinality     = "METRICS_CARDINALITY"
+	CodeTelemetryFailure       = "TELEMETRY_FAILURE"
+	CodeBadRollout             = "BAD_ROLLOUT"
+	CodeSidecarCrash           = "SIDECAR_CRASH"
+	CodeContainerImage         = "CONTAINER_IMAGE"
+	CodePodUnhealthy           = "POD_UNHEALTHY"
+)
+
+// Codes for errors raised by specific endpoints rather than by a constructor
+const (
+	CodeRouteNotFound            = "ROUTE_NOT_FOUND"
+	CodeMethodNotAllowed         = "METHOD_NOT_ALLOWED"
+	CodePageTooLarge             = "PAGE_TOO_LARGE"
+	CodeIdempotencyKeyFailed     = "IDEMPOTENCY_KEY_FAILED"
+	CodeIdempotencyKeyReused     = "IDEMPOTENCY_KEY_REUSED"
+	CodeIdempotencyKeyInProgress = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	CodeWorkExperienceOverlap    = "WORK_EXPERIENCE_OVERLAP"
+)
+
+// Codes for entity errors, used as suffixes by EntityCode
+const (
+	CodeSuffixNotFound         = "NOT_FOUND"
+	CodeSuffixDuplicate        = "DUPLICATE"
+	CodeSuffixInvalidReference = "INVALID_REFERENCE"
+)
+
+// EntityCode builds the code for an error about entity, e.g. EntityCode("blog_post", CodeSuffixNotFound)
+// is BLOG_POST_NOT_FOUND. Entities are the snake_case names passed to the database error constructors
+func EntityCode(entity, suffix string) string {
+	entity = strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_").Replace(strings.TrimSpace(entity)))
+	if entity == "" {
+		return suffix
+	}
+	return entity + "_" + suffix
+}
+
+// statusCodeName returns a code for errors that only carry an HTTP status, e.g. METHOD_NOT_ALLOWED for 405
+func statusCodeName(statusCode int) string {
+	if statusCode == http.StatusInternalServerError {
+		return CodeInternal
+	}
+	text := http.StatusText(statusCode)
+	if text == "" {
+		return CodeInternal
+	}
+	return strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_", "'", "").Replace(text))
+}
+
+// WithCode replaces the error's code with a more specific one and returns the error, for use on a newly built error:
+//
+//	errs.NewConflictError("dates overlap").WithCode("WORK_EXPERIENCE_OVERLAP")
+func (e *ApiErr) WithCode(code string) *ApiErr {
+	e.Code = code
+	return e
+}
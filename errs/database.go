@@ -1,11 +1,17 @@
 package errs
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
-	"strings"
 	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
 )
 
 var (
@@ -34,6 +40,7 @@ var (
 func NewAlreadyExists(entity string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusConflict,
+		Code:       EntityCode(entity, CodeSuffixDuplicate),
 		err:        fmt.Errorf("%s %w", entity, ErrAlreadyExists),
 	}
 }
@@ -41,63 +48,160 @@ func NewAlreadyExists(entity string) *ApiErr {
 func NewNotFound(entity string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusNotFound,
+		Code:       EntityCode(entity, CodeSuffixNotFound),
 		err:        fmt.Errorf("%s %w", entity, ErrNotFound),
 	}
 }
 
+// PostgreSQL error codes mapped by NewDatabaseError, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgUniqueViolation           = "23505"
+	pgForeignKeyViolation       = "23503"
+	pgNotNullViolation          = "23502"
+	pgCheckViolation            = "23514"
+	pgStringDataTruncation      = "22001"
+	pgInvalidTextRepresentation = "22P02"
+	pgSerializationFailure      = "40001"
+	pgDeadlockDetected          = "40P01"
+	pgLockNotAvailable          = "55P03"
+	pgQueryCanceled             = "57014"
+)
+
 // NewDatabaseError creates a new database error with details about the operation
+// The cause is classified by type: gorm.ErrRecordNotFound is a 404, PostgreSQL errors are mapped by SQLSTATE code,
+// and connection failures and timeouts get their own status. Anything else is a 500
 func NewDatabaseError(operation, entity string, cause error) *ApiErr {
 	details := fmt.Sprintf("Failed to %s %s", operation, entity)
 
-	// Check for common database errors and provide more specific messages
-	if cause != nil {
-		errStr := cause.Error()
-		switch {
-		case strings.Contains(errStr, "duplicate key"):
+	switch {
+	case cause == nil:
+	case errors.Is(cause, gorm.ErrRecordNotFound), errors.Is(cause, ErrNotFound):
+		return &ApiErr{
+			StatusCode: http.StatusNotFound,
+			Code:       EntityCode(entity, CodeSuffixNotFound),
+			err:        fmt.Errorf("%s not found", entity),
+			Details:    details,
+			Cause:      cause,
+		}
+	case errors.Is(cause, gorm.ErrDuplicatedKey):
+		return newDuplicateError(entity, details, "", cause)
+	case errors.Is(cause, gorm.ErrForeignKeyViolated):
+		return newInvalidReferenceError(entity, "", cause)
+	case errors.Is(cause, context.DeadlineExceeded):
+		return &ApiErr{
+			StatusCode: http.StatusRequestTimeout,
+			Code:       CodeDatabaseTimeout,
+			err:        ErrDatabaseTimeout,
+			Details:    details,
+			Cause:      cause,
+		}
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(cause, &pgErr) {
+		switch pgErr.Code {
+		case pgUniqueViolation:
+			return newDuplicateError(entity, details, pgErr.ConstraintName, cause)
+		case pgForeignKeyViolation:
+			return newInvalidReferenceError(entity, pgErr.ConstraintName, cause)
+		case pgNotNullViolation:
 			return &ApiErr{
-				StatusCode: http.StatusConflict,
-				err:        fmt.Errorf("%s already exists", entity),
-				Details:    details,
+				StatusCode: http.StatusBadRequest,
+				Code:       CodeMissingRequiredField,
+				err:        ErrMissingRequiredField,
+				Details:    fmt.Sprintf("Missing required field: %s", pgErr.ColumnName),
+				Field:      pgErr.ColumnName,
 				Cause:      cause,
 			}
-		case strings.Contains(errStr, "foreign key constraint"):
+		case pgCheckViolation, pgStringDataTruncation, pgInvalidTextRepresentation:
 			return &ApiErr{
 				StatusCode: http.StatusBadRequest,
-				err:        fmt.Errorf("invalid reference in %s", entity),
-				Details:    "The referenced resource does not exist or cannot be linked",
+				Code:       CodeInvalidField,
+				err:        ErrInvalidField,
+				Details:    fmt.Sprintf("Invalid value for %s: %s", entity, pgErr.Message),
+				Field:      pgErr.ColumnName,
 				Cause:      cause,
 			}
-		case strings.Contains(errStr, "not found"):
+		case pgSerializationFailure:
+			return NewSerializationFailureError(operation, cause)
+		case pgDeadlockDetected:
+			return NewDeadlockError(operation, cause)
+		case pgLockNotAvailable:
 			return &ApiErr{
-				StatusCode: http.StatusNotFound,
-				err:        fmt.Errorf("%s not found", entity),
+				StatusCode: http.StatusConflict,
+				Code:       CodeDatabaseLock,
+				err:        ErrDatabaseLock,
 				Details:    details,
 				Cause:      cause,
 			}
-		case strings.Contains(errStr, "connection"):
+		case pgQueryCanceled:
 			return &ApiErr{
-				StatusCode: http.StatusServiceUnavailable,
-				err:        ErrDatabaseConnection,
-				Details:    "Unable to connect to database",
+				StatusCode: http.StatusRequestTimeout,
+				Code:       CodeDatabaseTimeout,
+				err:        ErrDatabaseTimeout,
+				Details:    details,
 				Cause:      cause,
 			}
 		}
 	}
 
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	if errors.As(cause, &connectErr) || errors.As(cause, &netErr) || errors.Is(cause, driver.ErrBadConn) || errors.Is(cause, sql.ErrConnDone) {
+		return &ApiErr{
+			StatusCode: http.StatusServiceUnavailable,
+			Code:       CodeDatabaseConnection,
+			err:        ErrDatabaseConnection,
+			Details:    "Unable to connect to database",
+			Cause:      cause,
+		}
+	}
+
 	// Generic database error
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
+		Code:       CodeDatabaseQuery,
 		err:        ErrDatabaseQuery,
 		Details:    details,
 		Cause:      cause,
 	}
 }
 
+// newDuplicateError reports a unique constraint violation on entity, naming the constraint when it's known
+func newDuplicateError(entity, details, constraint string, cause error) *ApiErr {
+	if constraint != "" {
+		details = fmt.Sprintf("%s (constraint %s)", details, constraint)
+	}
+	return &ApiErr{
+		StatusCode: http.StatusConflict,
+		Code:       EntityCode(entity, CodeSuffixDuplicate),
+		err:        fmt.Errorf("%s already exists", entity),
+		Details:    details,
+		Cause:      cause,
+	}
+}
+
+// newInvalidReferenceError reports a foreign key violation on entity, naming the constraint when it's known
+func newInvalidReferenceError(entity, constraint string, cause error) *ApiErr {
+	details := "The referenced resource does not exist or cannot be linked"
+	if constraint != "" {
+		details = fmt.Sprintf("%s (constraint %s)", details, constraint)
+	}
+	return &ApiErr{
+		StatusCode: http.StatusBadRequest,
+		Code:       EntityCode(entity, CodeSuffixInvalidReference),
+		err:        fmt.Errorf("invalid reference in %s", entity),
+		Details:    details,
+		Cause:      cause,
+	}
+}
+
 // Database & Storage Error Constructors
 func NewPoolExhaustedError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusServiceUnavailable,
 		err:        ErrPoolExhausted,
+		Code:       CodePoolExhausted,
 		Details:    fmt.Sprintf("Connection pool exhausted during %s", operation),
 		Field:      "connection_pool",
 	}
@@ -107,6 +211,7 @@ func NewDeadlockError(operation string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusConflict,
 		err:        ErrDeadlock,
+		Code:       CodeDeadlock,
 		Details:    fmt.Sprintf("Database deadlock during %s", operation),
 		Cause:      cause,
 		Field:      "deadlock",
@@ -117,6 +222,7 @@ func NewSerializationFailureError(operation string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusConflict,
 		err:        ErrSerializationFailure,
+		Code:       CodeSerializationFailure,
 		Details:    fmt.Sprintf("Serialization failure during %s", operation),
 		Cause:      cause,
 		Field:      "serialization",
@@ -127,6 +233,7 @@ func NewUniqueConstraintViolationError(entity, field string, cause error) *ApiEr
 	return &ApiErr{
 		StatusCode: http.StatusConflict,
 		err:        ErrUniqueConstraintViolation,
+		Code:       CodeUniqueConstraintViolation,
 		Details:    fmt.Sprintf("Unique constraint violation on %s.%s", entity, field),
 		Cause:      cause,
 		Field:      field,
@@ -137,6 +244,7 @@ func NewReplicaLagError(operation string, lag time.Duration) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusServiceUnavailable,
 		err:        ErrReplicaLag,
+		Code:       CodeReplicaLag,
 		Details:    fmt.Sprintf("Replica lag detected during %s: %v", operation, lag),
 		Field:      "replica_lag",
 	}
@@ -146,6 +254,7 @@ func NewMigrationMismatchError(expected, actual string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrMigrationMismatch,
+		Code:       CodeMigrationMismatch,
 		Details:    fmt.Sprintf("Migration mismatch: expected %s, got %s", expected, actual),
 		Field:      "migration",
 	}
@@ -155,6 +264,7 @@ func NewStorageQuotaFullError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInsufficientStorage,
 		err:        ErrStorageQuotaFull,
+		Code:       CodeStorageQuotaFull,
 		Details:    fmt.Sprintf("Storage quota full during %s", operation),
 		Field:      "storage",
 	}
@@ -164,6 +274,7 @@ func NewTransactionFailedError(operation string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrTransactionFailed,
+		Code:       CodeTransactionFailed,
 		Details:    fmt.Sprintf("Transaction failed during %s", operation),
 		Cause:      cause,
 		Field:      "transaction",
@@ -174,6 +285,7 @@ func NewForeignKeyConstraintError(entity, referencedEntity string, cause error)
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrForeignKeyConstraint,
+		Code:       CodeForeignKeyConstraint,
 		Details:    fmt.Sprintf("Foreign key constraint violation: %s references %s", entity, referencedEntity),
 		Cause:      cause,
 		Field:      "foreign_key",
@@ -184,6 +296,7 @@ func NewDatabaseTimeoutError(operation string, timeout time.Duration) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusRequestTimeout,
 		err:        ErrDatabaseTimeout,
+		Code:       CodeDatabaseTimeout,
 		Details:    fmt.Sprintf("Database timeout during %s after %v", operation, timeout),
 		Field:      "timeout",
 	}
@@ -193,6 +306,7 @@ func NewDatabaseLockError(operation string, lockType string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusConflict,
 		err:        ErrDatabaseLock,
+		Code:       CodeDatabaseLock,
 		Details:    fmt.Sprintf("Database lock timeout during %s (lock type: %s)", operation, lockType),
 		Field:      "lock",
 	}
@@ -202,6 +316,7 @@ func NewDatabaseCorruptionError(operation string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrDatabaseCorruption,
+		Code:       CodeDatabaseCorruption,
 		Details:    fmt.Sprintf("Database corruption detected during %s", operation),
 		Cause:      cause,
 		Field:      "corruption",
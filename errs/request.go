@@ -41,6 +41,7 @@ func NewMissingTokenError() *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusUnauthorized,
 		err:        ErrMissingToken,
+		Code:       CodeMissingToken,
 		Details:    "Missing access token",
 		Field:      "authorization",
 	}
@@ -50,6 +51,7 @@ func NewExpiredTokenError() *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusUnauthorized,
 		err:        ErrExpiredToken,
+		Code:       CodeExpiredToken,
 		Details:    "Access token has expired",
 		Field:      "authorization",
 	}
@@ -59,6 +61,7 @@ func NewInvalidTokenError() *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusUnauthorized,
 		err:        ErrInvalidToken,
+		Code:       CodeInvalidToken,
 		Details:    "Invalid access token",
 		Field:      "authorization",
 	}
@@ -68,6 +71,7 @@ func NewInsufficientScopeError(requiredScope string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusForbidden,
 		err:        ErrInsufficientScope,
+		Code:       CodeInsufficientScope,
 		Details:    fmt.Sprintf("Insufficient scope. Required: %s", requiredScope),
 		Field:      "authorization",
 	}
@@ -77,6 +81,7 @@ func NewInsufficientRoleError(requiredRole string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusForbidden,
 		err:        ErrInsufficientRole,
+		Code:       CodeInsufficientRole,
 		Details:    fmt.Sprintf("Insufficient role. Required: %s", requiredRole),
 		Field:      "authorization",
 	}
@@ -86,6 +91,7 @@ func NewTokenExpiredError() *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusUnauthorized,
 		err:        ErrTokenExpired,
+		Code:       CodeTokenExpired,
 		Details:    "Token has expired",
 		Field:      "authorization",
 	}
@@ -96,6 +102,7 @@ func NewGoroutineLeakError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrGoroutineLeak,
+		Code:       CodeGoroutineLeak,
 		Details:    fmt.Sprintf("Goroutine leak detected in %s", operation),
 		Field:      "concurrency",
 	}
@@ -105,6 +112,7 @@ func NewDataRaceError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrDataRace,
+		Code:       CodeDataRace,
 		Details:    fmt.Sprintf("Data race detected in %s", operation),
 		Field:      "concurrency",
 	}
@@ -114,6 +122,7 @@ func NewStarvationError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrStarvation,
+		Code:       CodeStarvation,
 		Details:    fmt.Sprintf("Starvation detected in %s", operation),
 		Field:      "concurrency",
 	}
@@ -123,6 +132,7 @@ func NewPriorityInversionError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrPriorityInversion,
+		Code:       CodePriorityInversion,
 		Details:    fmt.Sprintf("Priority inversion detected in %s", operation),
 		Field:      "concurrency",
 	}
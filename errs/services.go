@@ -120,6 +120,7 @@ func NewRateLimitError(service string, retryAfter time.Duration) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusTooManyRequests,
 		err:        ErrRateLimitExceeded,
+		Code:       CodeRateLimitExceeded,
 		Details:    fmt.Sprintf("Rate limit exceeded for %s service", service),
 		Field:      "rate_limit",
 	}
@@ -129,6 +130,7 @@ func NewModelOverloadedError(service string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusServiceUnavailable,
 		err:        ErrModelOverloaded,
+		Code:       CodeModelOverloaded,
 		Details:    fmt.Sprintf("Model overloaded for %s service", service),
 		Field:      "model_capacity",
 	}
@@ -138,6 +140,7 @@ func NewContextLengthError(service string, maxTokens int) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrContextLengthExceeded,
+		Code:       CodeContextLengthExceeded,
 		Details:    fmt.Sprintf("Context length exceeded for %s service (max: %d tokens)", service, maxTokens),
 		Field:      "context_length",
 	}
@@ -147,6 +150,7 @@ func NewContentPolicyError(service string, violation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrContentPolicyViolation,
+		Code:       CodeContentPolicyViolation,
 		Details:    fmt.Sprintf("Content policy violation in %s service: %s", service, violation),
 		Field:      "content_policy",
 	}
@@ -156,6 +160,7 @@ func NewBillingQuotaError(service string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusPaymentRequired,
 		err:        ErrBillingQuotaExhausted,
+		Code:       CodeBillingQuotaExhausted,
 		Details:    fmt.Sprintf("Billing quota exhausted for %s service", service),
 		Field:      "billing",
 	}
@@ -165,6 +170,7 @@ func NewStreamingError(service string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrStreamingChunkDropped,
+		Code:       CodeStreamingChunkDropped,
 		Details:    fmt.Sprintf("Streaming chunk dropped for %s service", service),
 		Field:      "streaming",
 	}
@@ -175,6 +181,7 @@ func NewConfigError(configName string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrConfigMissing,
+		Code:       CodeConfigMissing,
 		Details:    fmt.Sprintf("Configuration error for %s", configName),
 		Cause:      cause,
 	}
@@ -184,6 +191,7 @@ func NewEnvironmentVariableError(varName string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrEnvironmentVariable,
+		Code:       CodeEnvironmentVariable,
 		Details:    fmt.Sprintf("Environment variable %s is not set or invalid", varName),
 		Field:      varName,
 	}
@@ -193,6 +201,7 @@ func NewRegionNotSupportedError(region string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrRegionNotSupported,
+		Code:       CodeRegionNotSupported,
 		Details:    fmt.Sprintf("Region %s is not supported", region),
 		Field:      "region",
 	}
@@ -203,6 +212,7 @@ func NewDNSError(host string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusServiceUnavailable,
 		err:        ErrDNSResolution,
+		Code:       CodeDNSResolution,
 		Details:    fmt.Sprintf("DNS resolution failed for %s", host),
 		Cause:      cause,
 	}
@@ -212,6 +222,7 @@ func NewTCPTimeoutError(host string, timeout time.Duration) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusServiceUnavailable,
 		err:        ErrTCPTimeout,
+		Code:       CodeTCPTimeout,
 		Details:    fmt.Sprintf("TCP connection timeout to %s after %v", host, timeout),
 		Field:      "connection",
 	}
@@ -221,6 +232,7 @@ func NewTLSHandshakeError(host string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusServiceUnavailable,
 		err:        ErrTLSHandshake,
+		Code:       CodeTLSHandshake,
 		Details:    fmt.Sprintf("TLS handshake failed for %s", host),
 		Cause:      cause,
 	}
@@ -230,6 +242,7 @@ func NewConnectionResetError(host string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusServiceUnavailable,
 		err:        ErrConnectionReset,
+		Code:       CodeConnectionReset,
 		Details:    fmt.Sprintf("Connection reset by peer for %s", host),
 		Field:      "connection",
 	}
@@ -240,6 +253,7 @@ func NewOutOfMemoryError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrOutOfMemory,
+		Code:       CodeOutOfMemory,
 		Details:    fmt.Sprintf("Out of memory during %s operation", operation),
 		Field:      "memory",
 	}
@@ -249,6 +263,7 @@ func NewCPUExhaustedError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrCPUExhausted,
+		Code:       CodeCPUExhausted,
 		Details:    fmt.Sprintf("CPU exhausted during %s operation", operation),
 		Field:      "cpu",
 	}
@@ -258,6 +273,7 @@ func NewFileDescriptorLimitError() *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrFileDescriptorLimit,
+		Code:       CodeFileDescriptorLimit,
 		Details:    "File descriptor limit exceeded",
 		Field:      "file_descriptors",
 	}
@@ -268,6 +284,7 @@ func NewContextDeadlineError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusRequestTimeout,
 		err:        ErrContextDeadline,
+		Code:       CodeContextDeadline,
 		Details:    fmt.Sprintf("Context deadline exceeded for %s", operation),
 		Field:      "timeout",
 	}
@@ -277,6 +294,7 @@ func NewClientDisconnectedError() *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusRequestTimeout,
 		err:        ErrClientDisconnected,
+		Code:       CodeClientDisconnected,
 		Details:    "Client disconnected during request",
 		Field:      "client",
 	}
@@ -286,6 +304,7 @@ func NewComputationTimeoutError(operation string, timeout time.Duration) *ApiErr
 	return &ApiErr{
 		StatusCode: http.StatusRequestTimeout,
 		err:        ErrComputationTimeout,
+		Code:       CodeComputationTimeout,
 		Details:    fmt.Sprintf("Computation timeout for %s after %v", operation, timeout),
 		Field:      "computation",
 	}
@@ -296,6 +315,7 @@ func NewSchemaVersionMismatchError(service string, expected, actual string) *Api
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrSchemaVersionMismatch,
+		Code:       CodeSchemaVersionMismatch,
 		Details:    fmt.Sprintf("Schema version mismatch in %s service: expected %s, got %s", service, expected, actual),
 		Field:      "schema_version",
 	}
@@ -305,6 +325,7 @@ func NewClockSkewError(service string, skew time.Duration) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrClockSkew,
+		Code:       CodeClockSkew,
 		Details:    fmt.Sprintf("Clock skew detected in %s service: %v", service, skew),
 		Field:      "clock",
 	}
@@ -314,6 +335,7 @@ func NewPartialFailureError(operation string, failedSteps []string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrPartialFailure,
+		Code:       CodePartialFailure,
 		Details:    fmt.Sprintf("Partial failure in %s operation. Failed steps: %v", operation, failedSteps),
 		Field:      "partial_failure",
 	}
@@ -324,6 +346,7 @@ func NewDivideByZeroError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrDivideByZero,
+		Code:       CodeDivideByZero,
 		Details:    fmt.Sprintf("Divide by zero error in %s", operation),
 		Field:      "arithmetic",
 	}
@@ -333,6 +356,7 @@ func NewOverflowError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrOverflow,
+		Code:       CodeOverflow,
 		Details:    fmt.Sprintf("Arithmetic overflow in %s", operation),
 		Field:      "arithmetic",
 	}
@@ -342,6 +366,7 @@ func NewNilPointerError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrNilPointer,
+		Code:       CodeNilPointer,
 		Details:    fmt.Sprintf("Nil pointer dereference in %s", operation),
 		Field:      "pointer",
 	}
@@ -352,6 +377,7 @@ func NewCircularStructureError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrCircularStructure,
+		Code:       CodeCircularStructure,
 		Details:    fmt.Sprintf("Circular structure detected in %s", operation),
 		Field:      "serialization",
 	}
@@ -361,6 +387,7 @@ func NewBase64DecodeError(operation string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrBase64Decode,
+		Code:       CodeBase64Decode,
 		Details:    fmt.Sprintf("Base64 decode error in %s", operation),
 		Cause:      cause,
 		Field:      "encoding",
@@ -371,6 +398,7 @@ func NewJSONMarshalError(operation string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrJSONMarshal,
+		Code:       CodeJSONMarshal,
 		Details:    fmt.Sprintf("JSON marshal error in %s", operation),
 		Cause:      cause,
 		Field:      "json",
@@ -381,6 +409,7 @@ func NewJSONUnmarshalError(operation string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrJSONUnmarshal,
+		Code:       CodeJSONUnmarshal,
 		Details:    fmt.Sprintf("JSON unmarshal error in %s", operation),
 		Cause:      cause,
 		Field:      "json",
@@ -392,6 +421,7 @@ func NewServiceUnreachableError(service string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusServiceUnavailable,
 		err:        ErrServiceUnreachable,
+		Code:       CodeServiceUnreachable,
 		Details:    fmt.Sprintf("Service %s is unreachable", service),
 		Cause:      cause,
 		Field:      "service_discovery",
@@ -402,6 +432,7 @@ func NewServiceDiscoveryError(service string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusServiceUnavailable,
 		err:        ErrServiceDiscovery,
+		Code:       CodeServiceDiscovery,
 		Details:    fmt.Sprintf("Service discovery failed for %s", service),
 		Cause:      cause,
 		Field:      "service_discovery",
@@ -413,6 +444,7 @@ func NewSQLInjectionError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrSQLInjection,
+		Code:       CodeSQLInjection,
 		Details:    fmt.Sprintf("SQL injection attempt detected in %s", operation),
 		Field:      "security",
 	}
@@ -422,6 +454,7 @@ func NewRequestForgeryError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusForbidden,
 		err:        ErrRequestForgery,
+		Code:       CodeRequestForgery,
 		Details:    fmt.Sprintf("Request forgery detected in %s", operation),
 		Field:      "security",
 	}
@@ -431,6 +464,7 @@ func NewSecretsLeakedError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrSecretsLeaked,
+		Code:       CodeSecretsLeaked,
 		Details:    fmt.Sprintf("Secrets leaked in %s", operation),
 		Field:      "security",
 	}
@@ -440,6 +474,7 @@ func NewPIIBreachError(operation string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusBadRequest,
 		err:        ErrPIIBreach,
+		Code:       CodePIIBreach,
 		Details:    fmt.Sprintf("PII breach detected in %s", operation),
 		Field:      "compliance",
 	}
@@ -450,6 +485,7 @@ func NewTracerExporterError(cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrTracerExporter,
+		Code:       CodeTracerExporter,
 		Details:    "Tracer exporter is down",
 		Cause:      cause,
 		Field:      "telemetry",
@@ -460,6 +496,7 @@ func NewLogPipelineError(cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrLogPipeline,
+		Code:       CodeLogPipeline,
 		Details:    "Log pipeline error",
 		Cause:      cause,
 		Field:      "telemetry",
@@ -470,6 +507,7 @@ func NewMetricsCardinalityError(metric string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrMetricsCardinality,
+		Code:       CodeMetricsCardinality,
 		Details:    fmt.Sprintf("Metrics cardinality explosion for %s", metric),
 		Field:      "telemetry",
 	}
@@ -480,6 +518,7 @@ func NewBadRolloutError(service string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusServiceUnavailable,
 		err:        ErrBadRollout,
+		Code:       CodeBadRollout,
 		Details:    fmt.Sprintf("Bad rollout for %s service", service),
 		Field:      "deployment",
 	}
@@ -489,6 +528,7 @@ func NewSidecarCrashError(service string) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusServiceUnavailable,
 		err:        ErrSidecarCrash,
+		Code:       CodeSidecarCrash,
 		Details:    fmt.Sprintf("Sidecar crash for %s service", service),
 		Field:      "deployment",
 	}
@@ -498,6 +538,7 @@ func NewContainerImageError(service string, cause error) *ApiErr {
 	return &ApiErr{
 		StatusCode: http.StatusInternalServerError,
 		err:        ErrContainerImage,
+		Code:       CodeContainerImage,
 		Details:    fmt.Sprintf("Container image error for %s service", service),
 		Cause:      cause,
 		Field:      "deployment",
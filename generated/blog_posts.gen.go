@@ -34,7 +34,8 @@ func newBlogPost(db *gorm.DB, opts ...gen.DOOption) blogPost {
 	_blogPost.Content = field.NewString(tableName, "content")
 	_blogPost.DateAdded = field.NewTime(tableName, "date_added")
 	_blogPost.DateEdited = field.NewTime(tableName, "date_edited")
-	_blogPost.Length = field.NewInt(tableName, "length")
+	_blogPost.WordCount = field.NewInt(tableName, "word_count")
+	_blogPost.ReadingTimeMinutes = field.NewInt(tableName, "reading_time_minutes")
 	_blogPost.URL = field.NewString(tableName, "url")
 	_blogPost.Tags = blogPostHasManyTags{
 		db: db.Session(&gorm.Session{}),
@@ -63,16 +64,17 @@ func newBlogPost(db *gorm.DB, opts ...gen.DOOption) blogPost {
 type blogPost struct {
 	blogPostDo blogPostDo
 
-	ALL        field.Asterisk
-	ID         field.Field
-	Title      field.String
-	Summary    field.String
-	Content    field.String
-	DateAdded  field.Time
-	DateEdited field.Time
-	Length     field.Int
-	URL        field.String
-	Tags       blogPostHasManyTags
+	ALL                field.Asterisk
+	ID                 field.Field
+	Title              field.String
+	Summary            field.String
+	Content            field.String
+	DateAdded          field.Time
+	DateEdited         field.Time
+	WordCount          field.Int
+	ReadingTimeMinutes field.Int
+	URL                field.String
+	Tags               blogPostHasManyTags
 
 	fieldMap map[string]field.Expr
 }
@@ -95,7 +97,8 @@ func (b *blogPost) updateTableName(table string) *blogPost {
 	b.Content = field.NewString(table, "content")
 	b.DateAdded = field.NewTime(table, "date_added")
 	b.DateEdited = field.NewTime(table, "date_edited")
-	b.Length = field.NewInt(table, "length")
+	b.WordCount = field.NewInt(table, "word_count")
+	b.ReadingTimeMinutes = field.NewInt(table, "reading_time_minutes")
 	b.URL = field.NewString(table, "url")
 
 	b.fillFieldMap()
@@ -121,14 +124,15 @@ func (b *blogPost) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (b *blogPost) fillFieldMap() {
-	b.fieldMap = make(map[string]field.Expr, 9)
+	b.fieldMap = make(map[string]field.Expr, 10)
 	b.fieldMap["id"] = b.ID
 	b.fieldMap["title"] = b.Title
 	b.fieldMap["summary"] = b.Summary
 	b.fieldMap["content"] = b.Content
 	b.fieldMap["date_added"] = b.DateAdded
 	b.fieldMap["date_edited"] = b.DateEdited
-	b.fieldMap["length"] = b.Length
+	b.fieldMap["word_count"] = b.WordCount
+	b.fieldMap["reading_time_minutes"] = b.ReadingTimeMinutes
 	b.fieldMap["url"] = b.URL
 
 }
@@ -0,0 +1,190 @@
+package grpcapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	contentv1 "github.com/rpupo63/unified-personal-site-backend/proto/content/v1"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type blogPostService struct {
+	contentv1.UnimplementedBlogPostServiceServer
+	database database.Database
+}
+
+func newBlogPostService(database database.Database) *blogPostService {
+	return &blogPostService{database: database}
+}
+
+// ListBlogPosts returns every blog post, ordered by the optional sort expression
+func (s *blogPostService) ListBlogPosts(ctx context.Context, req *contentv1.ListBlogPostsRequest) (*contentv1.ListBlogPostsResponse, error) {
+	sort, err := database.ParseSort(req.GetSort(), database.BlogPostSortColumns)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "sort: %v", err)
+	}
+
+	blogPosts, err := s.database.BlogPostRepo().FindAll(sort...)
+	if err != nil {
+		return nil, toStatus(errs.NewDatabaseError("find all blog posts", "blog_posts", err))
+	}
+
+	response := &contentv1.ListBlogPostsResponse{Total: int64(len(blogPosts))}
+	for _, blogPost := range blogPosts {
+		response.BlogPosts = append(response.BlogPosts, toProtoBlogPost(blogPost))
+	}
+	return response, nil
+}
+
+// GetBlogPost returns a single published blog post by ID
+// Read calls don't need the backend password, so drafts and scheduled posts are reported as not found
+func (s *blogPostService) GetBlogPost(ctx context.Context, req *contentv1.GetBlogPostRequest) (*contentv1.BlogPost, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	blogPost, err := s.database.BlogPostRepo().FindByID(id)
+	if err != nil {
+		return nil, toStatus(errs.NewDatabaseError("find blog post", "blog_post", err))
+	}
+	if blogPost.Status != models.BlogPostStatusPublished {
+		return nil, status.Error(codes.NotFound, "blog post not found")
+	}
+	return toProtoBlogPost(blogPost), nil
+}
+
+// findBlogPost returns the blog post with id whatever its status, for answering writes
+func (s *blogPostService) findBlogPost(id uuid.UUID) (*contentv1.BlogPost, error) {
+	blogPost, err := s.database.BlogPostRepo().FindByID(id)
+	if err != nil {
+		return nil, toStatus(errs.NewDatabaseError("find blog post", "blog_post", err))
+	}
+	return toProtoBlogPost(blogPost), nil
+}
+
+// CreateBlogPost creates a blog post and its tags in one transaction
+// Unlike POST /blog-post it never cross-posts to social platforms
+func (s *blogPostService) CreateBlogPost(ctx context.Context, req *contentv1.CreateBlogPostRequest) (*contentv1.BlogPost, error) {
+	if req.GetBlogPost() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog_post is required")
+	}
+
+	blogPost := fromProtoBlogPost(req.GetBlogPost())
+	if blogPost.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if blogPost.Content == "" {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+	if blogPost.DateAdded.IsZero() {
+		blogPost.DateAdded = time.Now()
+	}
+	if err := services.RenderBlogPost(blogPost); err != nil {
+		return nil, toStatus(err)
+	}
+	// The proto has no status, so posts created over gRPC are published right away
+	blogPost.Status = models.BlogPostStatusPublished
+
+	err := s.database.Transaction(func(tx database.Database) error {
+		if err := tx.BlogPostRepo().Add(blogPost); err != nil {
+			return errs.NewDatabaseError("create blog post", "blog_post", err)
+		}
+		if err := tx.BlogTagRepo().AddAll(blogTags(blogPost.ID, req.GetBlogPost().GetTags())); err != nil {
+			return errs.NewDatabaseError("create blog tags", "blog_tags", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return s.findBlogPost(blogPost.ID)
+}
+
+// UpdateBlogPost replaces a blog post's fields, keeping DateAdded when it isn't provided and always keeping its publishing status
+func (s *blogPostService) UpdateBlogPost(ctx context.Context, req *contentv1.UpdateBlogPostRequest) (*contentv1.BlogPost, error) {
+	if req.GetBlogPost() == nil {
+		return nil, status.Error(codes.InvalidArgument, "blog_post is required")
+	}
+
+	id, err := parseID("blog_post.id", req.GetBlogPost().GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	blogPost := fromProtoBlogPost(req.GetBlogPost())
+	blogPost.ID = id
+	if blogPost.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if blogPost.Content == "" {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+
+	err = s.database.Transaction(func(tx database.Database) error {
+		existing, err := tx.BlogPostRepo().FindByID(id)
+		if err != nil {
+			return errs.NewDatabaseError("find blog post", "blog_post", err)
+		}
+
+		if blogPost.DateAdded.IsZero() {
+			blogPost.DateAdded = existing.DateAdded
+		}
+		blogPost.Status = existing.Status
+		blogPost.PublishAt = existing.PublishAt
+		// SEO metadata isn't part of the message, so it's kept as it is
+		blogPost.MetaTitle = existing.MetaTitle
+		blogPost.MetaDescription = existing.MetaDescription
+		blogPost.OGImageURL = existing.OGImageURL
+		now := time.Now()
+		blogPost.DateEdited = &now
+		if err := services.RenderBlogPost(blogPost); err != nil {
+			return err
+		}
+
+		if err := tx.BlogPostRepo().Update(blogPost); err != nil {
+			return errs.NewDatabaseError("update blog post", "blog_post", err)
+		}
+		if err := tx.BlogTagRepo().AddAll(blogTags(id, req.GetBlogPost().GetTags())); err != nil {
+			return errs.NewDatabaseError("create blog tags", "blog_tags", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return s.findBlogPost(id)
+}
+
+// DeleteBlogPost deletes a blog post by ID
+func (s *blogPostService) DeleteBlogPost(ctx context.Context, req *contentv1.DeleteBlogPostRequest) (*contentv1.DeleteBlogPostResponse, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.database.BlogPostRepo().FindByID(id); err != nil {
+		return nil, toStatus(errs.NewDatabaseError("find blog post", "blog_post", err))
+	}
+
+	if err := s.database.BlogPostRepo().Delete(id); err != nil {
+		return nil, toStatus(errs.NewDatabaseError("delete blog post", "blog_post", err))
+	}
+	return &contentv1.DeleteBlogPostResponse{}, nil
+}
+
+func blogTags(blogPostID uuid.UUID, values []string) []models.BlogTag {
+	var tags []models.BlogTag
+	for _, value := range tagValues(values) {
+		tags = append(tags, models.BlogTag{ID: uuid.New(), BlogPostID: blogPostID, Value: value})
+	}
+	return tags
+}
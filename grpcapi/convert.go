@@ -0,0 +1,138 @@
+package grpcapi
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	contentv1 "github.com/rpupo63/unified-personal-site-backend/proto/content/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toStatus converts repository and validation errors into gRPC status errors
+// ApiErr status codes are mapped to their closest gRPC equivalent; anything else is Internal
+func toStatus(err error) error {
+	var apiErr *errs.ApiErr
+	if !errors.As(err, &apiErr) {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	code := codes.Internal
+	switch apiErr.StatusCode {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusUnauthorized:
+		code = codes.Unauthenticated
+	case http.StatusForbidden:
+		code = codes.PermissionDenied
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusConflict:
+		code = codes.AlreadyExists
+	case http.StatusRequestTimeout:
+		code = codes.DeadlineExceeded
+	case http.StatusServiceUnavailable:
+		code = codes.Unavailable
+	case http.StatusInsufficientStorage:
+		code = codes.ResourceExhausted
+	}
+
+	return status.Error(code, apiErr.Error())
+}
+
+// parseID parses a UUID from a request field, returning InvalidArgument when it is malformed
+func parseID(field, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "%s must be a valid UUID", field)
+	}
+	return id, nil
+}
+
+// tagValues trims tag values and drops blanks and duplicates
+func tagValues(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var unique []string
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		unique = append(unique, value)
+	}
+	return unique
+}
+
+func toProtoBlogPost(blogPost *models.BlogPost) *contentv1.BlogPost {
+	message := &contentv1.BlogPost{
+		Id:                 blogPost.ID.String(),
+		Title:              blogPost.Title,
+		Summary:            blogPost.Summary,
+		Content:            blogPost.Content,
+		DateAdded:          timestamppb.New(blogPost.DateAdded),
+		WordCount:          int32(blogPost.WordCount),
+		ReadingTimeMinutes: int32(blogPost.ReadingTimeMinutes),
+		Url:                blogPost.URL,
+	}
+	if blogPost.DateEdited != nil {
+		message.DateEdited = timestamppb.New(*blogPost.DateEdited)
+	}
+	for _, tag := range blogPost.Tags {
+		message.Tags = append(message.Tags, tag.Value)
+	}
+	return message
+}
+
+func fromProtoBlogPost(message *contentv1.BlogPost) *models.BlogPost {
+	blogPost := &models.BlogPost{
+		Title:   strings.TrimSpace(message.GetTitle()),
+		Summary: message.Summary,
+		Content: message.GetContent(),
+		URL:     message.Url,
+	}
+	if message.GetDateAdded() != nil {
+		blogPost.DateAdded = message.GetDateAdded().AsTime()
+	}
+	return blogPost
+}
+
+func toProtoProject(project *models.Project) *contentv1.Project {
+	message := &contentv1.Project{
+		Id:          project.ID.String(),
+		Title:       project.Title,
+		Description: project.Description,
+		GithubLink:  project.GithubLink,
+		DemoLink:    project.DemoLink,
+		Type:        project.Type,
+		GifLink:     project.GifLink,
+		DateAdded:   timestamppb.New(project.DateAdded),
+	}
+	if project.DateEdited != nil {
+		message.DateEdited = timestamppb.New(*project.DateEdited)
+	}
+	for _, tag := range project.Tags {
+		message.Tags = append(message.Tags, tag.Value)
+	}
+	return message
+}
+
+func fromProtoProject(message *contentv1.Project) *models.Project {
+	project := &models.Project{
+		Title:       strings.TrimSpace(message.GetTitle()),
+		Description: message.GetDescription(),
+		GithubLink:  message.GetGithubLink(),
+		DemoLink:    message.GetDemoLink(),
+		Type:        message.GetType(),
+		GifLink:     message.GifLink,
+	}
+	if message.GetDateAdded() != nil {
+		project.DateAdded = message.GetDateAdded().AsTime()
+	}
+	return project
+}
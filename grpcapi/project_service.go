@@ -0,0 +1,162 @@
+package grpcapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/errs"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	contentv1 "github.com/rpupo63/unified-personal-site-backend/proto/content/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type projectService struct {
+	contentv1.UnimplementedProjectServiceServer
+	database database.Database
+}
+
+func newProjectService(database database.Database) *projectService {
+	return &projectService{database: database}
+}
+
+// ListProjects returns every project, ordered by the optional sort expression
+func (s *projectService) ListProjects(ctx context.Context, req *contentv1.ListProjectsRequest) (*contentv1.ListProjectsResponse, error) {
+	sort, err := database.ParseSort(req.GetSort(), database.ProjectSortColumns)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "sort: %v", err)
+	}
+
+	projects, err := s.database.ProjectRepo().FindAll(sort...)
+	if err != nil {
+		return nil, toStatus(errs.NewDatabaseError("find all projects", "projects", err))
+	}
+
+	response := &contentv1.ListProjectsResponse{Total: int64(len(projects))}
+	for _, project := range projects {
+		response.Projects = append(response.Projects, toProtoProject(project))
+	}
+	return response, nil
+}
+
+// GetProject returns a single project by ID
+func (s *projectService) GetProject(ctx context.Context, req *contentv1.GetProjectRequest) (*contentv1.Project, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.database.ProjectRepo().FindByID(id)
+	if err != nil {
+		return nil, toStatus(errs.NewDatabaseError("find project", "project", err))
+	}
+	return toProtoProject(project), nil
+}
+
+// CreateProject creates a project and its tags in one transaction
+func (s *projectService) CreateProject(ctx context.Context, req *contentv1.CreateProjectRequest) (*contentv1.Project, error) {
+	if req.GetProject() == nil {
+		return nil, status.Error(codes.InvalidArgument, "project is required")
+	}
+
+	project := fromProtoProject(req.GetProject())
+	if project.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if project.DateAdded.IsZero() {
+		project.DateAdded = time.Now()
+	}
+
+	err := s.database.Transaction(func(tx database.Database) error {
+		if err := tx.ProjectRepo().Add(project); err != nil {
+			return errs.NewDatabaseError("create project", "project", err)
+		}
+		if err := tx.ProjectTagRepo().AddAll(projectTags(project.ID, req.GetProject().GetTags())); err != nil {
+			return errs.NewDatabaseError("create project tags", "project_tags", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return s.GetProject(ctx, &contentv1.GetProjectRequest{Id: project.ID.String()})
+}
+
+// UpdateProject replaces a project's fields, keeping DateAdded when it isn't provided
+func (s *projectService) UpdateProject(ctx context.Context, req *contentv1.UpdateProjectRequest) (*contentv1.Project, error) {
+	if req.GetProject() == nil {
+		return nil, status.Error(codes.InvalidArgument, "project is required")
+	}
+
+	id, err := parseID("project.id", req.GetProject().GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	project := fromProtoProject(req.GetProject())
+	project.ID = id
+	if project.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+
+	err = s.database.Transaction(func(tx database.Database) error {
+		existing, err := tx.ProjectRepo().FindByID(id)
+		if err != nil {
+			return errs.NewDatabaseError("find project", "project", err)
+		}
+
+		if project.DateAdded.IsZero() {
+			project.DateAdded = existing.DateAdded
+		}
+		// SEO metadata and the case study aren't part of the message, so they're kept as they are
+		project.CaseStudy = existing.CaseStudy
+		project.CaseStudyHTML = existing.CaseStudyHTML
+		project.MetaTitle = existing.MetaTitle
+		project.MetaDescription = existing.MetaDescription
+		project.OGImageURL = existing.OGImageURL
+		project.CanonicalURL = existing.CanonicalURL
+		now := time.Now()
+		project.DateEdited = &now
+
+		if err := tx.ProjectRepo().Update(project); err != nil {
+			return errs.NewDatabaseError("update project", "project", err)
+		}
+		if err := tx.ProjectTagRepo().AddAll(projectTags(id, req.GetProject().GetTags())); err != nil {
+			return errs.NewDatabaseError("create project tags", "project_tags", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return s.GetProject(ctx, &contentv1.GetProjectRequest{Id: id.String()})
+}
+
+// DeleteProject deletes a project by ID
+func (s *projectService) DeleteProject(ctx context.Context, req *contentv1.DeleteProjectRequest) (*contentv1.DeleteProjectResponse, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.database.ProjectRepo().FindByID(id); err != nil {
+		return nil, toStatus(errs.NewDatabaseError("find project", "project", err))
+	}
+
+	if err := s.database.ProjectRepo().Delete(id); err != nil {
+		return nil, toStatus(errs.NewDatabaseError("delete project", "project", err))
+	}
+	return &contentv1.DeleteProjectResponse{}, nil
+}
+
+func projectTags(projectID uuid.UUID, values []string) []models.ProjectTag {
+	var tags []models.ProjectTag
+	for _, value := range tagValues(values) {
+		tags = append(tags, models.ProjectTag{ID: uuid.New(), ProjectID: projectID, Value: value})
+	}
+	return tags
+}
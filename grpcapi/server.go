@@ -0,0 +1,163 @@
+// Package grpcapi serves the blog post and project content API over gRPC
+// It shares the database repositories with the REST handlers in package api
+// Service definitions live in proto/content/v1 and are regenerated with:
+//
+//	protoc -I proto --go_out=proto --go_opt=paths=source_relative \
+//	  --go-grpc_out=proto --go-grpc_opt=paths=source_relative content/v1/content.proto
+package grpcapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/config"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	contentv1 "github.com/rpupo63/unified-personal-site-backend/proto/content/v1"
+	"github.com/rpupo63/unified-personal-site-backend/restart"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+type Server struct {
+	*grpc.Server
+	address    string
+	listenFunc restart.ListenFunc
+	listener   *net.Listener // Opened by Listen, shared by copies of the Server
+}
+
+// WithListenFunc opens the server's socket with listen, e.g. one that inherits it across a restart
+func WithListenFunc(listen restart.ListenFunc) func(*Server) {
+	return func(s *Server) {
+		s.listenFunc = listen
+	}
+}
+
+// NewServer builds the gRPC server listening on GRPC_PORT
+// The second return value is false when GRPC_PORT is unset, in which case gRPC stays disabled
+func NewServer(database database.Database, opts ...func(*Server)) (Server, bool) {
+	c := config.New()
+
+	port := config.GetString(c, "GRPC_PORT", "")
+	if port == "" {
+		return Server{}, false
+	}
+	address := "0.0.0.0:" + port
+
+	backendPassword := config.GetString(c, "BACKEND_PASSWORD", "")
+
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		logCalls,
+		requireAdminForWrites(backendPassword),
+	))
+	contentv1.RegisterBlogPostServiceServer(server, newBlogPostService(database))
+	contentv1.RegisterProjectServiceServer(server, newProjectService(database))
+	reflection.Register(server)
+
+	s := Server{server, address, net.Listen, new(net.Listener)}
+	for _, opt := range opts {
+		opt(&s)
+	}
+	return s, true
+}
+
+// Listen opens the server's socket without serving on it yet
+func (s Server) Listen() error {
+	if *s.listener != nil {
+		return nil
+	}
+	listener, err := s.listenFunc("tcp", s.address)
+	if err != nil {
+		return err
+	}
+	*s.listener = listener
+	return nil
+}
+
+func (s Server) Start(errChannel chan<- error) {
+	if err := s.Listen(); err != nil {
+		errChannel <- err
+		return
+	}
+
+	log.Info().Msgf("gRPC server started on: %s", s.address)
+	// Serve only returns nil after a deliberate stop, which isn't worth reporting
+	if err := s.Serve(*s.listener); err != nil {
+		errChannel <- err
+	}
+}
+
+func (s Server) ShutdownGracefully(timeout time.Duration) {
+	log.Info().Msg("Gracefully shutting down gRPC server...")
+
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		log.Info().Msg("gRPC server gracefully shut down")
+	case <-time.After(timeout):
+		log.Error().Msg("Timed out shutting down the gRPC server, forcing stop")
+		s.Stop()
+	}
+}
+
+// logCalls logs every unary call with its duration and resulting status code
+func logCalls(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	code := status.Code(err)
+	event := log.Info()
+	if code == codes.Internal || code == codes.Unknown {
+		event = log.Error().Err(err)
+	}
+	event.Str("method", info.FullMethod).Str("code", code.String()).Dur("duration", time.Since(start)).Msg("gRPC call")
+
+	return resp, err
+}
+
+// readOnlyMethods lists the calls that don't need the backend password
+var readOnlyMethods = map[string]bool{
+	contentv1.BlogPostService_ListBlogPosts_FullMethodName: true,
+	contentv1.BlogPostService_GetBlogPost_FullMethodName:   true,
+	contentv1.ProjectService_ListProjects_FullMethodName:   true,
+	contentv1.ProjectService_GetProject_FullMethodName:     true,
+}
+
+// requireAdminForWrites rejects mutating calls unless the authorization metadata carries
+// "Bearer <BACKEND_PASSWORD>". Like the REST admin routes, writes are refused outright when no password is configured
+func requireAdminForWrites(backendPassword string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if readOnlyMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		if backendPassword == "" {
+			log.Warn().Str("method", info.FullMethod).Msg("BACKEND_PASSWORD is not set, rejecting gRPC write")
+			return nil, status.Error(codes.Unauthenticated, "unauthorized")
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get("authorization")
+		if len(values) == 0 || !strings.HasPrefix(values[0], "Bearer ") {
+			return nil, status.Error(codes.Unauthenticated, "unauthorized")
+		}
+
+		token := strings.TrimPrefix(values[0], "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(backendPassword)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "unauthorized")
+		}
+
+		return handler(ctx, req)
+	}
+}
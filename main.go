@@ -1,28 +1,19 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
-	"log"
-	"net/url"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
-	"time"
 
 	"github.com/joho/godotenv"
-	"gorm.io/driver/postgres"
-	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 
-	api "github.com/rpupo63/unified-personal-site-backend/api"
-	"github.com/rpupo63/unified-personal-site-backend/database"
 	_ "github.com/rpupo63/unified-personal-site-backend/docs" // Swagger docs
-	"github.com/rpupo63/unified-personal-site-backend/models"
 )
 
 // @title           Personal Site API
-// @version         1.0
+// @version         1.1
 // @description     API for managing personal site projects and blog posts
 // @termsOfService  http://swagger.io/terms/
 
@@ -38,9 +29,40 @@ import (
 
 // @schemes   http https
 
-func main() {
-	fmt.Println("Initializing app...")
+// @securityDefinitions.apikey  BearerAuth
+// @in                          header
+// @name                        Authorization
+// @description                 Admin routes require "Bearer <BACKEND_PASSWORD>"
+
+// @securityDefinitions.apikey  IndieAuth
+// @in                          header
+// @name                        Authorization
+// @description                 Micropub routes require "Bearer <access token>", issued to INDIEAUTH_ME by INDIEAUTH_TOKEN_ENDPOINT
+
+// command is one of the binary's subcommands, e.g. `backend migrate`
+type command struct {
+	name    string
+	args    string // Usage of its flags and arguments, for help
+	summary string
+	run     func(args []string) error
+}
 
+var commands = []command{
+	{"serve", "", "Run the HTTP and gRPC servers (the default)", runServe},
+	{"migrate", "", "Create or update every table and index", runMigrate},
+	{"seed", "[-count n]", "Add the example blog posts and project, and optionally n generated ones", runSeed},
+	{"generate-models", "", "Migrate, report column mismatches and regenerate generated/", runGenerateModels},
+	{"column-report", "", "Compare each table's columns with its model", runColumnReport},
+	{"new-post", "-file <post.md> [-publish]", "Create a blog post from a markdown file, uploading the images it links to", runNewPost},
+	{"post", "-id <blogPostID> [-platforms p1,p2] [-image url] [-dry-run]", "Cross-post a published blog post to social platforms", runPost},
+	{"export", "[-format json|markdown] [-out path]", "Export every blog post to a JSON file or a folder of markdown files", runExport},
+	{"doctor", "[-fix] [-skip-media]", "Check the stored data for inconsistencies, and repair what can be", runDoctor},
+	{"check-credentials", "[-platforms p1,p2]", "Check that each social platform's credentials still work", runCheckCredentials},
+	{"refresh-substack", "[-domain name]", "Store a fresh Substack session cookie, checked against Substack first", runRefreshSubstack},
+	{"dev-clone", "[-from databaseURL] [-reset]", "Copy production's content, anonymized, into the local database", runDevClone},
+}
+
+func main() {
 	// Load environment variables from .env file (for local development only)
 	// In production (e.g., Coolify), environment variables are provided directly
 	// Environment variables from the system always take precedence over .env file values
@@ -50,244 +72,61 @@ func main() {
 		fmt.Printf("Info: No .env file found (using system environment variables): %v\n", err)
 	}
 
-	// -------------------------------------------------------------------------
-	// DATABASE CONNECTION LOGIC
-	// -------------------------------------------------------------------------
-	var db *gorm.DB
-	var err error
-	var currentDB database.Database
-
-	// Priority 1: Full Connection String (Recommended for Pooler)
-	connStr := getEnv("DATABASE_URL", "")
-	if connStr == "" {
-		connStr = getEnv("SUPABASE_DB_URL", "")
+	var name string
+	args := os.Args[1:]
+	if len(args) > 0 {
+		switch {
+		case args[0] == "help" || args[0] == "-h" || args[0] == "-help" || args[0] == "--help":
+			printUsage()
+			return
+		case !strings.HasPrefix(args[0], "-"):
+			name, args = args[0], args[1:]
+		}
+	}
+	if name == "" {
+		name = legacyCommand()
 	}
 
-	// Priority 2: Individual Components
-	if connStr == "" {
-		host := getEnv("SUPABASE_DB_HOST", "")
-		// Note: Supabase Transaction Pooler uses port 6543
-		port := getEnv("SUPABASE_DB_PORT", "6543")
-		user := getEnv("SUPABASE_DB_USER", "")
-		password := getEnv("SUPABASE_DB_PASSWORD", "")
-		dbname := getEnv("SUPABASE_DB_NAME", "postgres")
-
-		if host == "" || user == "" || password == "" {
-			fmt.Printf("Error: Missing required database configuration.\n")
-			fmt.Printf("Set DATABASE_URL or (SUPABASE_DB_HOST, SUPABASE_DB_USER, SUPABASE_DB_PASSWORD)\n")
-			os.Exit(1)
+	for _, c := range commands {
+		if c.name != name {
+			continue
 		}
-
-		connStr = fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=require",
-			host, user, password, dbname, port)
-	} else {
-		// Validate provided string
-		normalized, err := normalizeConnectionString(connStr)
-		if err != nil {
-			fmt.Printf("Error: Invalid connection string: %v\n", err)
+		if err := c.run(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return
+			}
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
-		connStr = normalized
-	}
-
-	fmt.Println("Connecting to Supabase (Transaction Pooler)...")
-
-	newLogger := logger.New(
-		log.New(os.Stdout, "\r\n", log.LstdFlags),
-		logger.Config{
-			SlowThreshold:             200 * time.Millisecond,
-			LogLevel:                  logger.Warn,
-			IgnoreRecordNotFoundError: true,
-			Colorful:                  true,
-		},
-	)
-
-	// -------------------------------------------------------------------------
-	// CRITICAL SUPABASE POOLER CONFIGURATION
-	// -------------------------------------------------------------------------
-	db, err = gorm.Open(postgres.New(postgres.Config{
-		DSN: connStr,
-		// PreferSimpleProtocol is CRITICAL for the Transaction Pooler (port 6543).
-		// It disables the extended query protocol which creates prepared statements.
-		PreferSimpleProtocol: true,
-	}), &gorm.Config{
-		// PrepareStmt must be FALSE. The pooler does not support prepared statements
-		// in Transaction mode.
-		PrepareStmt: false,
-		Logger:      newLogger,
-	})
-
-	if err != nil {
-		errMsg := err.Error()
-		if strings.Contains(errMsg, "network is unreachable") {
-			fmt.Printf("Network Error: Unreachable. (Check IPv6 settings or Host address)\n")
-		} else {
-			fmt.Printf("Error connecting to database: %v\n", err)
-		}
-		os.Exit(1)
-	}
-
-	// Enable required PostgreSQL extensions
-	// Note: 'vector' extension is required for Embeddings/AI features
-	// Use a separate session with a higher slow threshold for extension creation
-	// to avoid warnings during startup (extension creation can be slow on first run)
-	extensionLogger := logger.New(
-		log.New(os.Stdout, "\r\n", log.LstdFlags),
-		logger.Config{
-			SlowThreshold:             1 * time.Second, // Higher threshold for extension creation
-			LogLevel:                  logger.Warn,
-			IgnoreRecordNotFoundError: true,
-			Colorful:                  true,
-		},
-	)
-	extensionDB := db.Session(&gorm.Session{
-		Logger: extensionLogger,
-	})
-
-	if err := extensionDB.Exec("CREATE EXTENSION IF NOT EXISTS \"uuid-ossp\"").Error; err != nil {
-		fmt.Printf("Error enabling uuid-ossp extension: %v\n", err)
-		os.Exit(1)
-	}
-	if err := extensionDB.Exec("CREATE EXTENSION IF NOT EXISTS \"vector\"").Error; err != nil {
-		fmt.Printf("Error enabling vector extension: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Test connection
-	sqlDB, err := db.DB()
-	if err != nil {
-		fmt.Printf("Error getting generic database object: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Set connection pool settings to prevent opening too many connections
-	// in the container, though the Supabase Pooler handles the hard limit.
-	sqlDB.SetMaxIdleConns(5)
-	sqlDB.SetMaxOpenConns(20)
-	sqlDB.SetConnMaxLifetime(time.Hour)
-
-	if err := sqlDB.Ping(); err != nil {
-		fmt.Printf("Error pinging database: %v\n", err)
-		os.Exit(1)
-	}
-
-	currentDB = database.New(db)
-
-	// Handle Generation flags
-	if strings.ToLower(os.Getenv("GENERATE_MODELS")) == "true" {
-		fmt.Println("Generating models...")
-		models.GenerateModels(db)
-		return
-	}
-
-	if os.Getenv("GENERATE_COLUMN_REPORT") == "true" {
-		fmt.Println("Generating column report...")
-		models.GenerateColumnMismatchReportStandalone(db)
 		return
 	}
 
-	// Initialize Server
-	errChannel := make(chan error)
-	defer close(errChannel)
-
-	server, err := api.NewServer(currentDB)
-	if err != nil {
-		fmt.Printf("Error initializing server: %v\n", err)
-		os.Exit(1)
-	}
-
-	go server.Start(errChannel)
-	go listenToInterrupt(errChannel)
-
-	fatalErr := <-errChannel
-	fmt.Printf("Closing server: %v\n", fatalErr)
-
-	server.ShutdownGracefully(30 * time.Second)
+	fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", name)
+	printUsage()
+	os.Exit(2)
 }
 
-// listenToInterrupt waits for SIGINT or SIGTERM
-func listenToInterrupt(errChannel chan<- error) {
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
-	errChannel <- fmt.Errorf("%s", <-c)
-}
-
-// getEnv returns the value or fallback
-func getEnv(key, fallback string) string {
-	if value, exists := os.LookupEnv(key); exists {
-		return value
-	}
-	return fallback
-}
-
-// normalizeConnectionString validates and standardizes the connection string
-func normalizeConnectionString(connStr string) (string, error) {
-	connStr = strings.TrimSpace(connStr)
-	if connStr == "" {
-		return "", fmt.Errorf("connection string is empty")
-	}
-
-	if strings.HasPrefix(connStr, "postgres://") || strings.HasPrefix(connStr, "postgresql://") {
-		return normalizeURLConnectionString(connStr)
-	}
-	return normalizeKeyValueConnectionString(connStr)
-}
-
-func normalizeURLConnectionString(urlStr string) (string, error) {
-	parsedURL, err := url.Parse(urlStr)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse URL: %w", err)
-	}
-
-	host := parsedURL.Hostname()
-	if host == "" {
-		return "", fmt.Errorf("missing host")
-	}
-
-	port := parsedURL.Port()
-	if port == "" {
-		port = "5432"
-	} // Default fallback, though Pooler is 6543
-
-	user := parsedURL.User.Username()
-	if user == "" {
-		return "", fmt.Errorf("missing user")
-	}
-
-	password, hasPassword := parsedURL.User.Password()
-	if !hasPassword {
-		return "", fmt.Errorf("missing password")
-	}
-
-	dbname := strings.TrimPrefix(parsedURL.Path, "/")
-	if dbname == "" {
-		return "", fmt.Errorf("missing dbname")
+// legacyCommand maps the GENERATE_MODELS and GENERATE_COLUMN_REPORT switches that came before commands onto theirs,
+// so existing scripts keep working; without either it's serve
+func legacyCommand() string {
+	if strings.ToLower(os.Getenv("GENERATE_MODELS")) == "true" {
+		fmt.Println("GENERATE_MODELS=true is deprecated, run the generate-models command instead")
+		return "generate-models"
 	}
-
-	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s",
-		host, port, user, password, dbname)
-
-	sslmode := parsedURL.Query().Get("sslmode")
-	if sslmode == "" {
-		sslmode = "require"
+	if os.Getenv("GENERATE_COLUMN_REPORT") == "true" {
+		fmt.Println("GENERATE_COLUMN_REPORT=true is deprecated, run the column-report command instead")
+		return "column-report"
 	}
-	connStr += fmt.Sprintf(" sslmode=%s", sslmode)
-
-	return connStr, nil
+	return "serve"
 }
 
-func normalizeKeyValueConnectionString(connStr string) (string, error) {
-	if !strings.Contains(connStr, "host=") {
-		return "", fmt.Errorf("missing 'host='")
-	}
-	if !strings.Contains(connStr, "user=") {
-		return "", fmt.Errorf("missing 'user='")
-	}
-	if !strings.Contains(connStr, "dbname=") && !strings.Contains(connStr, "database=") {
-		return "", fmt.Errorf("missing 'dbname='")
-	}
-	if !strings.Contains(connStr, "sslmode=") {
-		connStr += " sslmode=require"
+func printUsage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s <command> [flags]\n\nCommands:\n", os.Args[0])
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-16s %s\n", c.name, c.summary)
+		if c.args != "" {
+			fmt.Fprintf(os.Stderr, "  %-16s   %s %s\n", "", c.name, c.args)
+		}
 	}
-	return connStr, nil
+	fmt.Fprintf(os.Stderr, "\nRun %s <command> -h for a command's flags\n", os.Args[0])
 }
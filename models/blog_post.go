@@ -1,20 +1,123 @@
 package models
 
 import (
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+// Blog post publishing statuses
+// Only published posts are shown publicly; scheduled posts are published by the scheduler once PublishAt passes
+const (
+	BlogPostStatusDraft     = "draft"
+	BlogPostStatusScheduled = "scheduled"
+	BlogPostStatusPublished = "published"
+)
+
+// BlogPostStatuses lists the accepted values for BlogPost.Status
+var BlogPostStatuses = []string{
+	BlogPostStatusDraft,
+	BlogPostStatusScheduled,
+	BlogPostStatusPublished,
+}
+
 // BlogPost represents a complete blog post with metadata
 type BlogPost struct {
-	ID         uuid.UUID  `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
-	Title      string     `json:"title" db:"title" gorm:"type:text;not null;unique"`
-	Summary    *string    `json:"summary,omitempty" db:"summary" gorm:"type:text"`
-	Content    string     `json:"content" db:"content" gorm:"type:text;not null"`
-	DateAdded  time.Time  `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
-	DateEdited *time.Time `json:"dateEdited,omitempty" db:"date_edited" gorm:"type:timestamp"`
-	Length     int        `json:"length" db:"length" gorm:"type:integer;not null;default:0"`
-	URL        *string    `json:"url,omitempty" db:"url" gorm:"type:text"`
-	Tags       []BlogTag  `json:"tags,omitempty" gorm:"foreignKey:BlogPostID;references:ID;constraint:OnDelete:CASCADE"`
+	ID      uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Title   string    `json:"title" validate:"notblank" db:"title" gorm:"type:text;not null;unique"`
+	Summary *string   `json:"summary,omitempty" db:"summary" gorm:"type:text"`
+	Content string    `json:"content" validate:"notblank" db:"content" gorm:"type:text;not null"`
+	// ContentHTML is Content rendered as sanitized HTML whenever it's saved, served instead with ?format=html
+	ContentHTML string     `json:"-" db:"content_html" gorm:"type:text;not null;default:''"`
+	DateAdded   time.Time  `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP;index:idx_blog_post_date_added"`
+	DateEdited  *time.Time `json:"dateEdited,omitempty" db:"date_edited" gorm:"type:timestamp"`
+	// WordCount is how many words Content has, counted whenever it's saved
+	WordCount int `json:"wordCount" db:"word_count" gorm:"type:integer;not null;default:0"`
+	// ReadingTimeMinutes is how long Content takes to read, rounded up to the minute
+	ReadingTimeMinutes int `json:"readingTimeMinutes" db:"reading_time_minutes" gorm:"type:integer;not null;default:0"`
+	// URL is where the post lives, and its canonical URL wherever it's shared or cross-posted
+	URL *string `json:"url,omitempty" db:"url" gorm:"type:text"`
+	// MetaTitle stands in for the title in search results and link previews
+	MetaTitle *string `json:"metaTitle,omitempty" validate:"omitempty,max=70" db:"meta_title" gorm:"type:text"`
+	// MetaDescription stands in for the summary in search results and link previews
+	MetaDescription *string `json:"metaDescription,omitempty" validate:"omitempty,max=160" db:"meta_description" gorm:"type:text"`
+	// OGImageURL stands in for the first image in the content in link previews
+	OGImageURL *string    `json:"ogImageUrl,omitempty" validate:"omitempty,httpurl" db:"og_image_url" gorm:"type:text"`
+	Status     string     `json:"status" db:"status" gorm:"type:text;not null;default:'published';index:idx_blog_post_status_publish_at" enums:"draft,scheduled,published"`
+	PublishAt  *time.Time `json:"publishAt,omitempty" db:"publish_at" gorm:"type:timestamp;index:idx_blog_post_status_publish_at"`
+	// DeletedAt is set while the post is in the trash, which every query but the trash's own leaves out
+	DeletedAt gorm.DeletedAt `json:"-" db:"deleted_at" gorm:"type:timestamp;index:idx_blog_post_deleted_at"`
+	Tags      []BlogTag      `json:"tags,omitempty" gorm:"foreignKey:BlogPostID;references:ID;constraint:OnDelete:CASCADE"`
+	// Translations are served in place of the post by locale negotiation, and managed on their own
+	Translations []BlogPostTranslation `json:"-" gorm:"foreignKey:BlogPostID;references:ID;constraint:OnDelete:CASCADE"`
+	// Views are counted on their own, and deleted with the post
+	Views []BlogPostView `json:"-" gorm:"foreignKey:BlogPostID;references:ID;constraint:OnDelete:CASCADE"`
+	// Images can be given when the post is created, and are managed under /blog-post/{blogPostID}/images after that
+	Images []BlogPostImage `json:"images,omitempty" validate:"omitempty,dive" gorm:"foreignKey:BlogPostID;references:ID;constraint:OnDelete:CASCADE"`
+	// SeriesPost places the post in a series, and is deleted with it
+	SeriesPost *SeriesPost `json:"-" gorm:"foreignKey:BlogPostID;references:ID;constraint:OnDelete:CASCADE"`
 }
+
+// FeaturedImage returns the post's featured image among the Images loaded with it, or nil if it has none
+func (b *BlogPost) FeaturedImage() *BlogPostImage {
+	for i := range b.Images {
+		if b.Images[i].IsFeatured {
+			return &b.Images[i]
+		}
+	}
+	return nil
+}
+
+// wordsPerMinute is the reading speed reading times are worked out at
+const wordsPerMinute = 200
+
+// Measure sets WordCount and ReadingTimeMinutes from Content. They're stored rather than worked out on every read
+// so list views can show and sort by them without loading the content
+func (b *BlogPost) Measure() {
+	b.WordCount = CountWords(b.Content)
+	b.ReadingTimeMinutes = ReadingTimeMinutes(b.WordCount)
+}
+
+// CountWords counts the words in content, leaving out Markdown markup such as headings' #s and list bullets, which
+// have no letters or digits
+func CountWords(content string) int {
+	words := 0
+	for _, field := range strings.Fields(content) {
+		if strings.IndexFunc(field, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsNumber(r) }) >= 0 {
+			words++
+		}
+	}
+	return words
+}
+
+// ReadingTimeMinutes is how many minutes words take to read, rounded up, so any post with words in it takes at
+// least a minute
+func ReadingTimeMinutes(words int) int {
+	return (words + wordsPerMinute - 1) / wordsPerMinute
+}
+
+// BlogPostExample is a canonical request body for creating a blog post, served by GET /schema/blog-post/example
+// so the admin UI can prefill its form
+const BlogPostExample = `{
+	"title": "Building a Personal Site Backend in Go",
+	"summary": "How the API behind this site is put together, from chi routes to Postgres.",
+	"content": "# Building a Personal Site Backend in Go\n\nThis post walks through the handlers, repositories and background jobs behind the site.",
+	"url": "https://example.com/blog/personal-site-backend",
+	"metaDescription": "How the Go API behind this site is put together.",
+	"status": "draft",
+	"tags": [{"value": "go"}, {"value": "backend"}]
+}`
+
+// BlogPostPublishExample is a canonical request body for scheduling a blog post, served by
+// GET /schema/publish/example. The scheduler publishes it once publishAt passes
+const BlogPostPublishExample = `{
+	"title": "Announcing the New Site",
+	"summary": "The site has been rebuilt from scratch.",
+	"content": "The new site is live, with a blog, projects and a reading list.",
+	"status": "scheduled",
+	"publishAt": "2030-01-15T09:00:00Z",
+	"tags": [{"value": "announcements"}]
+}`
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BlogPostImage is one image in a blog post's gallery, managed under /blog-post/{blogPostID}/images
+// A post has at most one featured image, which is its main image in feeds, link previews and cross-posts
+type BlogPostImage struct {
+	ID         uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	BlogPostID uuid.UUID `json:"blogPostId" db:"blog_post_id" gorm:"type:uuid;not null;index:idx_blog_post_image_position,priority:1;uniqueIndex:idx_blog_post_image_featured,where:is_featured"`
+	URL        string    `json:"url" validate:"required,httpurl" db:"url" gorm:"type:text;not null"`
+	AltText    string    `json:"altText" validate:"max=500" db:"alt_text" gorm:"type:text;not null;default:''"`
+	Caption    *string   `json:"caption,omitempty" db:"caption" gorm:"type:text"`
+	// Position orders the gallery, lowest first; images added without one go after the last
+	Position   int       `json:"position" validate:"min=0" db:"position" gorm:"type:integer;not null;default:0;index:idx_blog_post_image_position,priority:2"`
+	IsFeatured bool      `json:"isFeatured" db:"is_featured" gorm:"type:boolean;not null;default:false"`
+	DateAdded  time.Time `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
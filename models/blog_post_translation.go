@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BlogPostTranslation is a blog post's title, summary and content in another locale, such as pt
+// Each post has at most one translation per locale; the post itself is in the site's default locale
+type BlogPostTranslation struct {
+	ID         uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	BlogPostID uuid.UUID `json:"blogPostId" db:"blog_post_id" gorm:"type:uuid;not null;uniqueIndex:idx_blog_post_translation_locale"`
+	Locale     string    `json:"locale" db:"locale" gorm:"type:text;not null;uniqueIndex:idx_blog_post_translation_locale;index:idx_blog_post_translation_by_locale"`
+	Title      string    `json:"title" validate:"notblank" db:"title" gorm:"type:text;not null"`
+	Summary    *string   `json:"summary,omitempty" db:"summary" gorm:"type:text"`
+	Content    string    `json:"content" validate:"notblank" db:"content" gorm:"type:text;not null"`
+	// ContentHTML is Content rendered as sanitized HTML, as BlogPost.ContentHTML is
+	ContentHTML string     `json:"-" db:"content_html" gorm:"type:text;not null;default:''"`
+	DateAdded   time.Time  `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	DateEdited  *time.Time `json:"dateEdited,omitempty" db:"date_edited" gorm:"type:timestamp"`
+}
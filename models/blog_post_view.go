@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BlogPostView is one visitor's view of a blog post, as recorded by POST /blog-post/{blogPostID}/view
+// A visitor is only counted again once BLOG_POST_VIEW_WINDOW has passed since their last counted view
+type BlogPostView struct {
+	ID          uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	BlogPostID  uuid.UUID `json:"blogPostId" db:"blog_post_id" gorm:"type:uuid;not null;index:idx_blog_post_view_visitor,priority:1"`
+	VisitorHash string    `json:"-" db:"visitor_hash" gorm:"type:text;not null;index:idx_blog_post_view_visitor,priority:2"`
+	ViewedAt    time.Time `json:"viewedAt" db:"viewed_at" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP;index:idx_blog_post_view_visitor,priority:3;index:idx_blog_post_view_viewed_at"`
+}
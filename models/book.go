@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Book reading statuses
+const (
+	BookStatusWantToRead = "want_to_read"
+	BookStatusReading    = "reading"
+	BookStatusFinished   = "finished"
+)
+
+// BookStatuses lists the accepted values for Book.Status
+var BookStatuses = []string{
+	BookStatusWantToRead,
+	BookStatusReading,
+	BookStatusFinished,
+}
+
+// Book represents an entry in the reading list
+// Cover, ISBN and publish year are filled in from OpenLibrary when the book is created
+type Book struct {
+	ID               uuid.UUID  `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Title            string     `json:"title" validate:"notblank" db:"title" gorm:"type:text;not null"`
+	Author           string     `json:"author" validate:"notblank" db:"author" gorm:"type:text;not null"`
+	Status           string     `json:"status" validate:"oneof=want_to_read reading finished" db:"status" gorm:"type:text;not null;index:idx_book_status"`
+	Rating           *int       `json:"rating,omitempty" validate:"omitempty,min=1,max=5" db:"rating" gorm:"type:integer"`
+	Notes            *string    `json:"notes,omitempty" db:"notes" gorm:"type:text"`
+	ISBN             *string    `json:"isbn,omitempty" db:"isbn" gorm:"type:text"`
+	CoverURL         *string    `json:"coverUrl,omitempty" db:"cover_url" gorm:"type:text"`
+	OpenLibraryKey   *string    `json:"openLibraryKey,omitempty" db:"open_library_key" gorm:"type:text"`
+	FirstPublishYear *int       `json:"firstPublishYear,omitempty" db:"first_publish_year" gorm:"type:integer"`
+	DateStarted      *time.Time `json:"dateStarted,omitempty" db:"date_started" gorm:"type:date"`
+	DateFinished     *time.Time `json:"dateFinished,omitempty" validate:"omitempty,notbefore=DateStarted" db:"date_finished" gorm:"type:date"`
+	DateAdded        time.Time  `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Bookmark represents a saved link shown in the link blog
+// Title, Description and ImageURL are fetched from the target page when not provided
+type Bookmark struct {
+	ID          uuid.UUID     `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	URL         string        `json:"url" validate:"notblank,httpurl" db:"url" gorm:"type:text;not null;unique"`
+	Title       string        `json:"title" db:"title" gorm:"type:text;not null"`
+	Description *string       `json:"description,omitempty" db:"description" gorm:"type:text"`
+	ImageURL    *string       `json:"imageUrl,omitempty" db:"image_url" gorm:"type:text"`
+	Comment     *string       `json:"comment,omitempty" db:"comment" gorm:"type:text"`
+	DateAdded   time.Time     `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP;index:idx_bookmark_date_added"`
+	Tags        []BookmarkTag `json:"tags,omitempty" gorm:"foreignKey:BookmarkID;references:ID;constraint:OnDelete:CASCADE"`
+}
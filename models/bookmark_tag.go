@@ -0,0 +1,10 @@
+package models
+
+import "github.com/google/uuid"
+
+// BookmarkTag represents a tag associated with a bookmark
+type BookmarkTag struct {
+	ID         uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	BookmarkID uuid.UUID `json:"bookmark_id" db:"bookmark_id" gorm:"type:uuid;not null;index:idx_bookmark_tag_bookmark_id;uniqueIndex:idx_bookmark_tag_unique"`
+	Value      string    `json:"value" db:"value" gorm:"type:text;not null;uniqueIndex:idx_bookmark_tag_unique"`
+}
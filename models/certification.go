@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Certification represents a professional certification, used by the resume
+// Certifications without an ExpiryDate never expire
+type Certification struct {
+	ID            uuid.UUID  `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Name          string     `json:"name" validate:"notblank" db:"name" gorm:"type:text;not null"`
+	Issuer        string     `json:"issuer" validate:"notblank" db:"issuer" gorm:"type:text;not null"`
+	IssueDate     time.Time  `json:"issueDate" validate:"required" db:"issue_date" gorm:"type:date;not null"`
+	ExpiryDate    *time.Time `json:"expiryDate,omitempty" validate:"omitempty,notbefore=IssueDate" db:"expiry_date" gorm:"type:date;index:idx_certification_expiry_date"`
+	CredentialID  *string    `json:"credentialId,omitempty" db:"credential_id" gorm:"type:text"`
+	CredentialURL *string    `json:"credentialUrl,omitempty" validate:"omitempty,httpurl" db:"credential_url" gorm:"type:text"`
+}
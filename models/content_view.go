@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Content types used by view counts, page views and social post records
+const (
+	ContentTypeBlogPost = "blogPost"
+	ContentTypeProject  = "project"
+	ContentTypeNote     = "note"
+)
+
+// ContentView counts the views one blog post or project got on one day (UTC)
+// Views are aggregated per day so the table stays small however busy the site is
+type ContentView struct {
+	ID          uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	ContentType string    `json:"contentType" db:"content_type" gorm:"type:text;not null;uniqueIndex:idx_content_view_content_day"`
+	ContentID   uuid.UUID `json:"contentId" db:"content_id" gorm:"type:uuid;not null;uniqueIndex:idx_content_view_content_day"`
+	Day         time.Time `json:"day" db:"day" gorm:"type:date;not null;uniqueIndex:idx_content_view_content_day;index:idx_content_view_day"`
+	Views       int64     `json:"views" db:"views" gorm:"type:bigint;not null;default:0"`
+}
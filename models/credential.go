@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Credential is a platform credential saved in the database, such as a Substack session cookie captured by the
+// refresh-substack command. Name is the environment variable it stands in for, and posting prefers it over that
+// variable, so a refreshed credential is used without editing .env or restarting
+type Credential struct {
+	ID          uuid.UUID  `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Platform    string     `json:"platform" db:"platform" gorm:"type:text;not null"`
+	Name        string     `json:"name" db:"name" gorm:"type:text;not null;uniqueIndex:idx_credential_name"`
+	Value       string     `json:"-" db:"value" gorm:"type:text;not null"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty" db:"expires_at" gorm:"type:timestamp"`
+	DateAdded   time.Time  `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	DateUpdated time.Time  `json:"dateUpdated" db:"date_updated" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
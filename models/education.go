@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Education represents a degree or program attended at an institution, used by the resume
+type Education struct {
+	ID           uuid.UUID  `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Institution  string     `json:"institution" validate:"notblank" db:"institution" gorm:"type:text;not null"`
+	Degree       string     `json:"degree" validate:"notblank" db:"degree" gorm:"type:text;not null"`
+	FieldOfStudy *string    `json:"fieldOfStudy,omitempty" db:"field_of_study" gorm:"type:text"`
+	StartDate    time.Time  `json:"startDate" validate:"required" db:"start_date" gorm:"type:date;not null"`
+	EndDate      *time.Time `json:"endDate,omitempty" validate:"omitempty,notbefore=StartDate" db:"end_date" gorm:"type:date"`
+	Description  *string    `json:"description,omitempty" db:"description" gorm:"type:text"`
+}
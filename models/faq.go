@@ -0,0 +1,13 @@
+package models
+
+import "github.com/google/uuid"
+
+// FAQ represents a frequently asked question and its markdown answer
+// Only published FAQs are shown publicly
+type FAQ struct {
+	ID           uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Question     string    `json:"question" validate:"notblank" db:"question" gorm:"type:text;not null"`
+	Answer       string    `json:"answer" validate:"notblank" db:"answer" gorm:"type:text;not null"`
+	DisplayOrder int       `json:"displayOrder" db:"display_order" gorm:"type:integer;not null;default:0"`
+	Published    bool      `json:"published" db:"published" gorm:"type:boolean;not null;default:false;index:idx_faq_published"`
+}
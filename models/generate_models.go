@@ -4,28 +4,31 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"reflect"
+	"sort"
 	"strings"
+	"sync"
 
 	"gorm.io/gen"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
 )
 
 /*
 Column Mismatch Report Usage:
 
 This file contains functionality to generate a report of database columns that aren't
-accounted for as variables in the corresponding Go model structs.
+accounted for as variables in the corresponding Go model structs. The same comparison is
+served as JSON by GET /admin/schema/drift.
 
-To generate the report:
+To generate the report, run:
 
-1. Set the environment variable: GENERATE_COLUMN_REPORT=true
-2. Run the application: go run main.go
+	go run . column-report
 
 The report will show:
 - Each table name
 - List of columns that exist in the database but not in the Go model
+- List of model columns the table is missing, and columns whose type differs from the model's
 - Summary of total mismatched columns across all tables
 
 Example output:
@@ -84,182 +87,383 @@ func GenerateModels(db *gorm.DB) {
 		BlogTag{},
 		Project{},
 		ProjectTag{},
+		WorkExperience{},
+		Education{},
+		Skill{},
+		Testimonial{},
+		UsesItem{},
+		Bookmark{},
+		BookmarkTag{},
+		Note{},
+		GuestbookEntry{},
+		Book{},
+		Certification{},
+		FAQ{},
+		IdempotencyKey{},
+		Webhook{},
+		WebhookDelivery{},
+		ContentView{},
+		PageView{},
+		PageViewDaily{},
+		SocialPost{},
+		VisitorSalt{},
+		VisitorDaily{},
+		ShareLink{},
+		NotionPage{},
+		MediaFile{},
+		MediaObject{},
+		ProjectImage{},
+		Credential{},
+		ShortLink{},
+		Snippet{},
+		BlogPostTranslation{},
+		BlogPostView{},
+		Series{},
+		SeriesPost{},
+		BlogPostImage{},
 	)
 
 	fmt.Println("Starting database migration...")
+	if err := Migrate(db); err != nil {
+		fmt.Printf("Error during models migration: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("Database migration completed successfully!")
+
+	// Generate column mismatch report
+	GenerateColumnMismatchReport(db)
+
+	// Execute the code generation
+	g.Execute()
+	fmt.Println("Model generation complete!")
+}
 
+// Migrate creates or updates the table of every model, along with the indexes declared in their gorm tags
+func Migrate(db *gorm.DB) error {
 	// Create a new session for migration with specific settings
 	migrateDB := db.Session(&gorm.Session{
 		SkipDefaultTransaction: true,
 		PrepareStmt:            false,
-		Logger:                 newLogger,
 	})
 
-	// Migrate all models
 	fmt.Println("Migrating models...")
-	if err := migrateDB.AutoMigrate(
+	err := migrateDB.AutoMigrate(
 		&BlogPost{},
 		&BlogTag{},
 		&Project{},
 		&ProjectTag{},
-	); err != nil {
-		fmt.Printf("Error during models migration: %v\n", err)
-		os.Exit(1)
+		&WorkExperience{},
+		&Education{},
+		&Skill{},
+		&Testimonial{},
+		&UsesItem{},
+		&Bookmark{},
+		&BookmarkTag{},
+		&Note{},
+		&GuestbookEntry{},
+		&Book{},
+		&Certification{},
+		&FAQ{},
+		&IdempotencyKey{},
+		&Webhook{},
+		&WebhookDelivery{},
+		&ContentView{},
+		&PageView{},
+		&PageViewDaily{},
+		&SocialPost{},
+		&VisitorSalt{},
+		&VisitorDaily{},
+		&ShareLink{},
+		&NotionPage{},
+		&MediaFile{},
+		&MediaObject{},
+		&ProjectImage{},
+		&Credential{},
+		&ShortLink{},
+		&Snippet{},
+		&BlogPostTranslation{},
+		&BlogPostView{},
+		&Series{},
+		&SeriesPost{},
+		&BlogPostImage{},
+	)
+	if err != nil {
+		return err
 	}
+	return measureBlogPosts(migrateDB)
+}
 
-	fmt.Println("Database migration completed successfully!")
+// measureBlogPosts replaces the length column blog posts used to have with their word counts and reading times,
+// which AutoMigrate adds as zeroes. It does nothing once the column is gone
+func measureBlogPosts(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&BlogPost{}, "length") {
+		return nil
+	}
 
-	// Generate column mismatch report
-	GenerateColumnMismatchReport(db)
+	fmt.Println("Counting blog post words...")
+	var batch []*BlogPost
+	err := db.Unscoped().Select("id", "content").FindInBatches(&batch, 100, func(_ *gorm.DB, _ int) error {
+		for _, blogPost := range batch {
+			blogPost.Measure()
+			err := db.Unscoped().Model(&BlogPost{}).Where("id = ?", blogPost.ID).UpdateColumns(map[string]any{
+				"word_count":           blogPost.WordCount,
+				"reading_time_minutes": blogPost.ReadingTimeMinutes,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to count blog post words: %w", err)
+	}
+	return db.Migrator().DropColumn(&BlogPost{}, "length")
+}
 
-	// Execute the code generation
-	g.Execute()
-	fmt.Println("Model generation complete!")
+// tableModels maps each table to the model it's migrated from
+var tableModels = map[string]interface{}{
+	"blog_posts":             BlogPost{},
+	"blog_tags":              BlogTag{},
+	"projects":               Project{},
+	"project_tags":           ProjectTag{},
+	"work_experiences":       WorkExperience{},
+	"educations":             Education{},
+	"skills":                 Skill{},
+	"testimonials":           Testimonial{},
+	"uses_items":             UsesItem{},
+	"bookmarks":              Bookmark{},
+	"bookmark_tags":          BookmarkTag{},
+	"notes":                  Note{},
+	"guestbook_entries":      GuestbookEntry{},
+	"books":                  Book{},
+	"certifications":         Certification{},
+	"faqs":                   FAQ{},
+	"idempotency_keys":       IdempotencyKey{},
+	"webhooks":               Webhook{},
+	"webhook_deliveries":     WebhookDelivery{},
+	"content_views":          ContentView{},
+	"page_views":             PageView{},
+	"page_view_dailies":      PageViewDaily{},
+	"social_posts":           SocialPost{},
+	"visitor_salts":          VisitorSalt{},
+	"visitor_dailies":        VisitorDaily{},
+	"share_links":            ShareLink{},
+	"notion_pages":           NotionPage{},
+	"media_files":            MediaFile{},
+	"media_objects":          MediaObject{},
+	"project_images":         ProjectImage{},
+	"credentials":            Credential{},
+	"short_links":            ShortLink{},
+	"snippets":               Snippet{},
+	"blog_post_translations": BlogPostTranslation{},
+	"blog_post_views":        BlogPostView{},
+	"series":                 Series{},
+	"series_posts":           SeriesPost{},
+	"blog_post_images":       BlogPostImage{},
 }
 
-// GenerateColumnMismatchReport generates a report of database columns that aren't accounted for in Go models
-func GenerateColumnMismatchReport(db *gorm.DB) {
-	fmt.Println("=== COLUMN MISMATCH REPORT ===")
-	fmt.Println("Generating report of database columns not accounted for in Go models...")
+// TableDrift is how a table in the database differs from the model it's migrated from
+type TableDrift struct {
+	Table          string
+	Exists         bool
+	ExtraColumns   []string // In the database but not the model
+	MissingColumns []string // In the model but not the database
+	TypeMismatches []ColumnTypeMismatch
+}
 
-	// Define model mappings (table name -> struct type)
-	modelMappings := map[string]interface{}{
-		"blog_posts":   BlogPost{},
-		"blog_tags":    BlogTag{},
-		"projects":     Project{},
-		"project_tags": ProjectTag{},
-	}
+// Drifted reports whether the table differs from its model at all
+func (t TableDrift) Drifted() bool {
+	return !t.Exists || len(t.ExtraColumns) > 0 || len(t.MissingColumns) > 0 || len(t.TypeMismatches) > 0
+}
 
-	totalMismatches := 0
+// ColumnTypeMismatch is a column whose type in the database isn't the one its model declares
+type ColumnTypeMismatch struct {
+	Column       string
+	ModelType    string
+	DatabaseType string
+}
 
-	for tableName, modelStruct := range modelMappings {
-		fmt.Printf("\n--- Table: %s ---\n", tableName)
+// DetectSchemaDrift compares every table's columns and their types with its model, in order of table name. Only
+// types declared in a model's gorm tags are compared
+func DetectSchemaDrift(db *gorm.DB) ([]TableDrift, error) {
+	tableNames := make([]string, 0, len(tableModels))
+	for tableName := range tableModels {
+		tableNames = append(tableNames, tableName)
+	}
+	sort.Strings(tableNames)
 
-		// Get database columns
+	drifts := make([]TableDrift, 0, len(tableNames))
+	for _, tableName := range tableNames {
+		modelSchema, err := schema.Parse(tableModels[tableName], &sync.Map{}, db.NamingStrategy)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing the model for table %s: %w", tableName, err)
+		}
 		dbColumns, err := getTableColumns(db, tableName)
 		if err != nil {
-			if strings.Contains(err.Error(), "does not exist") {
-				fmt.Printf("Table does not exist yet (will be created during migration)\n")
-			} else {
-				fmt.Printf("Error getting columns for table %s: %v\n", tableName, err)
-			}
-			continue
+			return nil, err
 		}
 
-		// Get model fields
-		modelFields := getModelFields(modelStruct)
-
-		// Find mismatches
-		mismatches := findColumnMismatches(dbColumns, modelFields)
-
-		if len(mismatches) > 0 {
-			fmt.Printf("Found %d columns not accounted for in model:\n", len(mismatches))
-			for _, col := range mismatches {
-				fmt.Printf("  - %s\n", col)
+		drift := TableDrift{Table: tableName, Exists: dbColumns != nil}
+		if !drift.Exists {
+			drift.MissingColumns = modelSchema.DBNames
+			drifts = append(drifts, drift)
+			continue
+		}
+		for _, column := range dbColumns {
+			if modelSchema.LookUpField(column.Name) == nil {
+				drift.ExtraColumns = append(drift.ExtraColumns, column.Name)
+			}
+		}
+		for _, columnName := range modelSchema.DBNames {
+			column, ok := findColumn(dbColumns, columnName)
+			if !ok {
+				drift.MissingColumns = append(drift.MissingColumns, columnName)
+				continue
+			}
+			modelType := modelSchema.FieldsByDBName[columnName].TagSettings["TYPE"]
+			if modelType != "" && normalizeColumnType(modelType) != column.Type {
+				drift.TypeMismatches = append(drift.TypeMismatches, ColumnTypeMismatch{
+					Column:       columnName,
+					ModelType:    modelType,
+					DatabaseType: column.Type,
+				})
 			}
-			totalMismatches += len(mismatches)
-		} else {
-			fmt.Println("All columns are accounted for in the model.")
 		}
+		drifts = append(drifts, drift)
 	}
-
-	fmt.Printf("\n=== SUMMARY ===\n")
-	fmt.Printf("Total mismatched columns across all tables: %d\n", totalMismatches)
+	return drifts, nil
 }
 
-// getTableColumns retrieves column names from a database table
-func getTableColumns(db *gorm.DB, tableName string) ([]string, error) {
-	var columns []string
-	query := `
-		SELECT column_name 
-		FROM information_schema.columns 
-		WHERE table_name = ? 
-		AND table_schema = CURRENT_SCHEMA()
-		ORDER BY ordinal_position
-	`
+// GenerateColumnMismatchReport prints how each table in the database differs from its model
+func GenerateColumnMismatchReport(db *gorm.DB) {
+	fmt.Println("=== COLUMN MISMATCH REPORT ===")
+	fmt.Println("Generating report of database columns not accounted for in Go models...")
 
-	err := db.Raw(query, tableName).Scan(&columns).Error
+	drifts, err := DetectSchemaDrift(db)
 	if err != nil {
-		return nil, fmt.Errorf("error querying columns for table %s: %w", tableName, err)
+		fmt.Printf("Error comparing tables with models: %v\n", err)
+		return
 	}
 
-	// Check if table exists
-	if len(columns) == 0 {
-		// Verify if table exists
-		var tableExists bool
-		tableQuery := `
-			SELECT EXISTS (
-				SELECT FROM information_schema.tables 
-				WHERE table_schema = CURRENT_SCHEMA() 
-				AND table_name = ?
-			)
-		`
-		if err := db.Raw(tableQuery, tableName).Scan(&tableExists).Error; err != nil {
-			return nil, fmt.Errorf("error checking if table %s exists: %w", tableName, err)
+	totalMismatches := 0
+	for _, drift := range drifts {
+		fmt.Printf("\n--- Table: %s ---\n", drift.Table)
+		if !drift.Exists {
+			fmt.Printf("Table does not exist yet (will be created during migration)\n")
+			continue
+		}
+		if !drift.Drifted() {
+			fmt.Println("All columns are accounted for in the model.")
+			continue
 		}
 
-		if !tableExists {
-			return nil, fmt.Errorf("table %s does not exist", tableName)
+		if len(drift.ExtraColumns) > 0 {
+			fmt.Printf("Found %d columns not accounted for in model:\n", len(drift.ExtraColumns))
+			for _, col := range drift.ExtraColumns {
+				fmt.Printf("  - %s\n", col)
+			}
+		}
+		if len(drift.MissingColumns) > 0 {
+			fmt.Printf("Found %d model columns missing from the table:\n", len(drift.MissingColumns))
+			for _, col := range drift.MissingColumns {
+				fmt.Printf("  - %s\n", col)
+			}
 		}
+		if len(drift.TypeMismatches) > 0 {
+			fmt.Printf("Found %d columns with a different type than the model's:\n", len(drift.TypeMismatches))
+			for _, mismatch := range drift.TypeMismatches {
+				fmt.Printf("  - %s: %s in the database, %s in the model\n", mismatch.Column, mismatch.DatabaseType, mismatch.ModelType)
+			}
+		}
+		totalMismatches += len(drift.ExtraColumns) + len(drift.MissingColumns) + len(drift.TypeMismatches)
 	}
 
-	return columns, nil
+	fmt.Printf("\n=== SUMMARY ===\n")
+	fmt.Printf("Total mismatched columns across all tables: %d\n", totalMismatches)
 }
 
-// getModelFields extracts field names from a Go struct using reflection
-func getModelFields(model interface{}) []string {
-	var fields []string
-	t := reflect.TypeOf(model)
-
-	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-
-		// Skip embedded structs (foreign key relationships)
-		if field.Anonymous {
-			continue
-		}
+// tableColumn is a column of a table in the database, with its type as Postgres formats it
+type tableColumn struct {
+	Name string
+	Type string
+}
 
-		// Get the GORM column name from the tag
-		gormTag := field.Tag.Get("gorm")
-		if gormTag != "" {
-			// Parse the gorm tag to find the column name
-			columnName := extractColumnNameFromGormTag(gormTag)
-			if columnName != "" {
-				fields = append(fields, columnName)
-			}
-		}
+// getTableColumns retrieves the columns of a database table, or nil if the table doesn't exist
+func getTableColumns(db *gorm.DB, tableName string) ([]tableColumn, error) {
+	var tableExists bool
+	tableQuery := `
+		SELECT EXISTS (
+			SELECT FROM information_schema.tables 
+			WHERE table_schema = CURRENT_SCHEMA() 
+			AND table_name = ?
+		)
+	`
+	if err := db.Raw(tableQuery, tableName).Scan(&tableExists).Error; err != nil {
+		return nil, fmt.Errorf("error checking if table %s exists: %w", tableName, err)
+	}
+	if !tableExists {
+		return nil, nil
 	}
 
-	return fields
+	columns := []tableColumn{}
+	query := `
+		SELECT a.attname AS name, format_type(a.atttypid, a.atttypmod) AS type
+		FROM pg_attribute a
+		JOIN pg_class c ON c.oid = a.attrelid
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE c.relname = ?
+		AND n.nspname = CURRENT_SCHEMA()
+		AND a.attnum > 0
+		AND NOT a.attisdropped
+		ORDER BY a.attnum
+	`
+	if err := db.Raw(query, tableName).Scan(&columns).Error; err != nil {
+		return nil, fmt.Errorf("error querying columns for table %s: %w", tableName, err)
+	}
+	return columns, nil
 }
 
-// extractColumnNameFromGormTag extracts the column name from a GORM tag
-func extractColumnNameFromGormTag(gormTag string) string {
-	parts := strings.Split(gormTag, ";")
-	for _, part := range parts {
-		part = strings.TrimSpace(part)
-		if strings.HasPrefix(part, "column:") {
-			return strings.TrimPrefix(part, "column:")
+func findColumn(columns []tableColumn, name string) (tableColumn, bool) {
+	for _, column := range columns {
+		if column.Name == name {
+			return column, true
 		}
 	}
-	return ""
+	return tableColumn{}, false
 }
 
-// findColumnMismatches finds columns that exist in the database but not in the model
-func findColumnMismatches(dbColumns, modelFields []string) []string {
-	modelFieldSet := make(map[string]bool)
-	for _, field := range modelFields {
-		modelFieldSet[field] = true
-	}
+// columnTypeAliases maps the shorthand type names models declare to the names Postgres formats them with
+var columnTypeAliases = map[string]string{
+	"int":         "integer",
+	"int4":        "integer",
+	"serial":      "integer",
+	"int8":        "bigint",
+	"bigserial":   "bigint",
+	"int2":        "smallint",
+	"bool":        "boolean",
+	"float8":      "double precision",
+	"float4":      "real",
+	"decimal":     "numeric",
+	"timestamp":   "timestamp without time zone",
+	"timestamptz": "timestamp with time zone",
+	"varchar":     "character varying",
+	"char":        "character",
+}
 
-	var mismatches []string
-	for _, col := range dbColumns {
-		if !modelFieldSet[col] {
-			mismatches = append(mismatches, col)
-		}
+// normalizeColumnType converts a type declared in a gorm tag, such as varchar(255), into the form Postgres reports
+// it in, such as character varying(255), so the two can be compared
+func normalizeColumnType(columnType string) string {
+	columnType = strings.ToLower(strings.TrimSpace(columnType))
+	name, rest := columnType, ""
+	if i := strings.IndexAny(columnType, "(["); i >= 0 {
+		name, rest = columnType[:i], columnType[i:]
 	}
-
-	return mismatches
+	if alias, ok := columnTypeAliases[name]; ok {
+		name = alias
+	}
+	return name + strings.ReplaceAll(rest, " ", "")
 }
 
 // GenerateColumnMismatchReportStandalone generates a report without running migrations
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GuestbookEntry represents a message left by a visitor in the guestbook
+// Entries are only shown publicly once they have been approved
+type GuestbookEntry struct {
+	ID            uuid.UUID  `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Name          string     `json:"name" db:"name" gorm:"type:text;not null"`
+	Website       *string    `json:"website,omitempty" db:"website" gorm:"type:text"`
+	Message       string     `json:"message" db:"message" gorm:"type:text;not null"`
+	Approved      bool       `json:"approved" db:"approved" gorm:"type:boolean;not null;default:false;index:idx_guestbook_entry_approved"`
+	DateSubmitted time.Time  `json:"dateSubmitted" db:"date_submitted" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	DateApproved  *time.Time `json:"dateApproved,omitempty" db:"date_approved" gorm:"type:timestamp"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyKey records a POST request made with an Idempotency-Key header and the response it produced
+// Retries with the same key get the stored response instead of running the request again
+type IdempotencyKey struct {
+	ID           uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Key          string    `json:"key" db:"key" gorm:"type:text;not null;uniqueIndex:idx_idempotency_key_key"`
+	RequestHash  string    `json:"requestHash" db:"request_hash" gorm:"type:text;not null"`
+	Completed    bool      `json:"completed" db:"completed" gorm:"type:boolean;not null;default:false"`
+	StatusCode   int       `json:"statusCode" db:"status_code" gorm:"type:integer;not null;default:0"`
+	ContentType  string    `json:"contentType" db:"content_type" gorm:"type:text;not null;default:''"`
+	ResponseBody []byte    `json:"-" db:"response_body" gorm:"type:bytea"`
+	DateAdded    time.Time `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	ExpiresAt    time.Time `json:"expiresAt" db:"expires_at" gorm:"type:timestamp;not null;index:idx_idempotency_key_expires_at"`
+}
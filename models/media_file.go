@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MediaFile is an uploaded image, such as one referenced by a blog post written in markdown, served by
+// GET /media/{mediaID}. SHA256 is the hash of Data, so uploading the same image again reuses its row
+type MediaFile struct {
+	ID          uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	FileName    string    `json:"fileName" db:"file_name" gorm:"type:text;not null"`
+	ContentType string    `json:"contentType" db:"content_type" gorm:"type:text;not null"`
+	Size        int       `json:"size" db:"size" gorm:"type:integer;not null"`
+	SHA256      string    `json:"sha256" db:"sha256" gorm:"column:sha256;type:text;not null;uniqueIndex:idx_media_file_sha256"`
+	Data        []byte    `json:"-" db:"data" gorm:"type:bytea;not null"`
+	DateAdded   time.Time `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MediaObject is a file uploaded with POST /media, kept in the configured object store bucket under Key and served
+// from URL rather than by the API
+type MediaObject struct {
+	ID          uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Key         string    `json:"key" db:"key" gorm:"type:text;not null;uniqueIndex:idx_media_object_key"`
+	FileName    string    `json:"fileName" db:"file_name" gorm:"type:text;not null"`
+	ContentType string    `json:"contentType" db:"content_type" gorm:"type:text;not null"`
+	Size        int       `json:"size" db:"size" gorm:"type:integer;not null"`
+	URL         string    `json:"url" db:"url" gorm:"type:text;not null"`
+	// Width and Height are only set for images
+	Width  int `json:"width,omitempty" db:"width" gorm:"type:integer;not null;default:0"`
+	Height int `json:"height,omitempty" db:"height" gorm:"type:integer;not null;default:0"`
+	// Variants are the resized copies made of an image, stored next to it in the bucket
+	Variants []MediaVariant `json:"variants" db:"variants" gorm:"serializer:json;type:jsonb;not null;default:'[]'"`
+	// Srcset lists the image and its web-sized variants by width, ready for an img tag's srcset
+	Srcset    string    `json:"srcset,omitempty" db:"srcset" gorm:"type:text;not null;default:''"`
+	DateAdded time.Time `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP;index:idx_media_object_date_added"`
+}
+
+// MediaVariant is a resized copy of an uploaded image: a web-sized one named w{width}, or the square thumbnail
+type MediaVariant struct {
+	Name        string `json:"name" example:"w960"`
+	Key         string `json:"key"`
+	URL         string `json:"url"`
+	ContentType string `json:"contentType" example:"image/jpeg"`
+	Size        int    `json:"size"`
+	Width       int    `json:"width" example:"960"`
+	Height      int    `json:"height" example:"540"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Note represents a short, untitled microblog post
+type Note struct {
+	ID         uuid.UUID  `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Content    string     `json:"content" validate:"notblank,max=1000" db:"content" gorm:"type:text;not null"`
+	ImageURL   *string    `json:"imageUrl,omitempty" db:"image_url" gorm:"type:text"`
+	DateAdded  time.Time  `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP;index:idx_note_date_added"`
+	DateEdited *time.Time `json:"dateEdited,omitempty" db:"date_edited" gorm:"type:timestamp"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotionPage links a page in the configured Notion database to the blog post drafted from it
+// LastEditedAt is the page's edit time as of its last sync, so pages that haven't changed since aren't fetched again
+type NotionPage struct {
+	ID           uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	PageID       string    `json:"pageId" db:"page_id" gorm:"type:text;not null;uniqueIndex:idx_notion_page_page_id"`
+	BlogPostID   uuid.UUID `json:"blogPostId" db:"blog_post_id" gorm:"type:uuid;not null;index:idx_notion_page_blog_post_id"`
+	LastEditedAt time.Time `json:"lastEditedAt" db:"last_edited_at" gorm:"type:timestamp;not null"`
+	DateAdded    time.Time `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
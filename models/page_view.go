@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PageView is one first-party page view event sent by the frontend
+// No IP address or raw user agent is stored; only the referring host, UTM tags, a hash of the user agent
+// and a visitor hash that changes every day
+// ContentType and ContentID are set when the page shows a blog post or project.
+// Country and Region are looked up from the IP at ingestion when a GeoIP database is configured; the IP itself is discarded
+type PageView struct {
+	ID            uuid.UUID  `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Path          string     `json:"path" db:"path" gorm:"type:text;not null"`
+	ContentType   *string    `json:"contentType,omitempty" db:"content_type" gorm:"type:text;index:idx_page_view_content"`
+	ContentID     *uuid.UUID `json:"contentId,omitempty" db:"content_id" gorm:"type:uuid;index:idx_page_view_content"`
+	Referrer      *string    `json:"referrer,omitempty" db:"referrer" gorm:"type:text"`
+	UTMSource     *string    `json:"utmSource,omitempty" db:"utm_source" gorm:"type:text"`
+	UTMMedium     *string    `json:"utmMedium,omitempty" db:"utm_medium" gorm:"type:text"`
+	UTMCampaign   *string    `json:"utmCampaign,omitempty" db:"utm_campaign" gorm:"type:text"`
+	UserAgentHash string     `json:"userAgentHash" db:"user_agent_hash" gorm:"type:text;not null;default:''"`
+	VisitorHash   string     `json:"visitorHash" db:"visitor_hash" gorm:"type:text;not null;default:''"`
+	Country       *string    `json:"country,omitempty" db:"country" gorm:"type:text"`
+	Region        *string    `json:"region,omitempty" db:"region" gorm:"type:text"`
+	DateAdded     time.Time  `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP;index:idx_page_view_date_added"`
+}
+
+// PageViewDaily is the number of views a path got on one day (UTC), rolled up from PageView
+// Daily rows are kept after the raw events are pruned
+type PageViewDaily struct {
+	ID       uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Day      time.Time `json:"day" db:"day" gorm:"type:date;not null;uniqueIndex:idx_page_view_daily_day_path"`
+	Path     string    `json:"path" db:"path" gorm:"type:text;not null;uniqueIndex:idx_page_view_daily_day_path"`
+	Views    int64     `json:"views" db:"views" gorm:"type:bigint;not null;default:0"`
+	Visitors int64     `json:"visitors" db:"visitors" gorm:"type:bigint;not null;default:0"`
+}
+
+// VisitorDaily is the number of unique visitors to the whole site on one day (UTC), rolled up from PageView
+// It can't be summed from PageViewDaily, where a visitor who read several pages is counted once per page
+type VisitorDaily struct {
+	ID       uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Day      time.Time `json:"day" db:"day" gorm:"type:date;not null;uniqueIndex:idx_visitor_daily_day"`
+	Visitors int64     `json:"visitors" db:"visitors" gorm:"type:bigint;not null;default:0"`
+}
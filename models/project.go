@@ -1,15 +1,52 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
 
 // Project represents a complete project with metadata
 type Project struct {
-	ID          uuid.UUID    `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
-	Title       string       `json:"title" db:"title" gorm:"type:text;not null;unique"`
-	Description string       `json:"description" db:"description" gorm:"type:text;not null"`
-	GithubLink  string       `json:"github_link" db:"github_link" gorm:"type:text;not null"`
-	DemoLink    string       `json:"demo_link" db:"demo_link" gorm:"type:text;not null"`
-	Type        string       `json:"type" db:"type" gorm:"type:text;not null"`
-	GifLink     *string      `json:"gif_link,omitempty" db:"gif_link" gorm:"type:text"`
-	Tags        []ProjectTag `json:"tags,omitempty" gorm:"foreignKey:ProjectID;references:ID;constraint:OnDelete:CASCADE"`
+	ID          uuid.UUID  `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Title       string     `json:"title" validate:"notblank" db:"title" gorm:"type:text;not null;unique"`
+	Description string     `json:"description" db:"description" gorm:"type:text;not null"`
+	GithubLink  string     `json:"github_link" db:"github_link" gorm:"type:text;not null"`
+	DemoLink    string     `json:"demo_link" db:"demo_link" gorm:"type:text;not null"`
+	Type        string     `json:"type" db:"type" gorm:"type:text;not null;index:idx_project_type"`
+	GifLink     *string    `json:"gif_link,omitempty" db:"gif_link" gorm:"type:text"`
+	DateAdded   time.Time  `json:"date_added" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	DateEdited  *time.Time `json:"date_edited,omitempty" db:"date_edited" gorm:"type:timestamp"`
+	// CaseStudy is a long-form write-up of the project in Markdown, served as HTML by GET /project/{slug}/case-study
+	CaseStudy string `json:"case_study" db:"case_study" gorm:"type:text;not null;default:''"`
+	// CaseStudyHTML is CaseStudy rendered as sanitized HTML whenever it's saved, as BlogPost.ContentHTML is
+	CaseStudyHTML string `json:"-" db:"case_study_html" gorm:"type:text;not null;default:''"`
+	// MetaTitle stands in for the title in search results and link previews
+	MetaTitle *string `json:"meta_title,omitempty" validate:"omitempty,max=70" db:"meta_title" gorm:"type:text"`
+	// MetaDescription stands in for the description in search results and link previews
+	MetaDescription *string `json:"meta_description,omitempty" validate:"omitempty,max=160" db:"meta_description" gorm:"type:text"`
+	// OGImageURL is the image link previews show
+	OGImageURL *string `json:"og_image_url,omitempty" validate:"omitempty,httpurl" db:"og_image_url" gorm:"type:text"`
+	// CanonicalURL is the project's page on the site, for search engines to index instead of its other addresses
+	CanonicalURL *string `json:"canonical_url,omitempty" validate:"omitempty,httpurl" db:"canonical_url" gorm:"type:text"`
+	// DeletedAt is set while the project is in the trash, which every query but the trash's own leaves out
+	DeletedAt gorm.DeletedAt `json:"-" db:"deleted_at" gorm:"type:timestamp;index:idx_project_deleted_at"`
+	Tags      []ProjectTag   `json:"tags,omitempty" gorm:"foreignKey:ProjectID;references:ID;constraint:OnDelete:CASCADE"`
+	// Images can be given when the project is created, and are managed under /project/{projectID}/images after that
+	Images []ProjectImage `json:"images,omitempty" validate:"omitempty,dive" gorm:"foreignKey:ProjectID;references:ID;constraint:OnDelete:CASCADE"`
 }
+
+// ProjectExample is a canonical request body for creating a project, served by GET /schema/project/example so the
+// admin UI can prefill its form
+const ProjectExample = `{
+	"title": "Personal Site Backend",
+	"description": "The Go API that serves this site's blog posts, projects and resume.",
+	"case_study": "## Why\n\nThe site needed one API for everything it shows.",
+	"github_link": "https://github.com/example/personal-site-backend",
+	"demo_link": "https://example.com",
+	"type": "web",
+	"gif_link": "https://example.com/images/personal-site-backend.gif",
+	"tags": [{"value": "go"}, {"value": "postgres"}],
+	"images": [{"url": "https://example.com/images/personal-site-backend-docs.png", "alt_text": "The API docs page", "caption": "Generated OpenAPI docs"}]
+}`
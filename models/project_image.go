@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProjectImage is one screenshot in a project's gallery, managed under /project/{projectID}/images
+// Galleries are shown as carousels alongside, or in place of, the project's GifLink
+type ProjectImage struct {
+	ID        uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	ProjectID uuid.UUID `json:"project_id" db:"project_id" gorm:"type:uuid;not null;index:idx_project_image_position,priority:1"`
+	URL       string    `json:"url" validate:"required,httpurl" db:"url" gorm:"type:text;not null"`
+	AltText   string    `json:"alt_text" validate:"max=500" db:"alt_text" gorm:"type:text;not null;default:''"`
+	Caption   *string   `json:"caption,omitempty" db:"caption" gorm:"type:text"`
+	// Position orders the gallery, lowest first; images added without one go after the last
+	Position  int       `json:"position" validate:"min=0" db:"position" gorm:"type:integer;not null;default:0;index:idx_project_image_position,priority:2"`
+	DateAdded time.Time `json:"date_added" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
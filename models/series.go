@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Series is an ordered run of blog posts, such as the parts of a multi-part article, served at GET /series/{slug}
+type Series struct {
+	ID          uuid.UUID  `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Slug        string     `json:"slug" db:"slug" gorm:"type:text;not null;uniqueIndex:idx_series_slug"`
+	Title       string     `json:"title" validate:"notblank" db:"title" gorm:"type:text;not null"`
+	Description *string    `json:"description,omitempty" db:"description" gorm:"type:text"`
+	DateAdded   time.Time  `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	DateEdited  *time.Time `json:"dateEdited,omitempty" db:"date_edited" gorm:"type:timestamp"`
+	// Posts are attached and detached on their own, and detached when the series is deleted
+	Posts []SeriesPost `json:"-" gorm:"foreignKey:SeriesID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+// SeriesPost places a blog post at a position in a series
+// A post is in at most one series, and each position in a series holds one post
+type SeriesPost struct {
+	BlogPostID uuid.UUID `json:"blogPostId" db:"blog_post_id" gorm:"type:uuid;primaryKey"`
+	SeriesID   uuid.UUID `json:"seriesId" db:"series_id" gorm:"type:uuid;not null;uniqueIndex:idx_series_post_position,priority:1"`
+	Position   int       `json:"position" db:"position" gorm:"type:integer;not null;uniqueIndex:idx_series_post_position,priority:2"`
+}
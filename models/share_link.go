@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ShareLink is a tracked redirect (/r/{token}) used in place of a content URL in one platform's social post
+// Each click is counted before redirecting to TargetURL
+type ShareLink struct {
+	ID          uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Token       string    `json:"token" db:"token" gorm:"type:text;not null;uniqueIndex:idx_share_link_token"`
+	ContentType string    `json:"contentType" db:"content_type" gorm:"type:text;not null;index:idx_share_link_content"`
+	ContentID   uuid.UUID `json:"contentId" db:"content_id" gorm:"type:uuid;not null;index:idx_share_link_content"`
+	Platform    string    `json:"platform" db:"platform" gorm:"type:text;not null"`
+	TargetURL   string    `json:"targetUrl" db:"target_url" gorm:"type:text;not null"`
+	Clicks      int64     `json:"clicks" db:"clicks" gorm:"type:bigint;not null;default:0"`
+	DateAdded   time.Time `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
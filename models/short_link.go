@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ContentTypeURL marks a short link to a URL outside the site, rather than to a blog post or project
+const ContentTypeURL = "url"
+
+// ShortLink is a short redirect (/s/{code}) to a blog post, a project or any other URL
+// Unlike a ShareLink, which is made for each social post, a blog post or project has at most one short link, which
+// is reused wherever it's shared. Each click is counted before redirecting to TargetURL
+type ShortLink struct {
+	ID          uuid.UUID  `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Code        string     `json:"code" db:"code" gorm:"type:text;not null;uniqueIndex:idx_short_link_code"`
+	ContentType string     `json:"contentType" db:"content_type" gorm:"type:text;not null;uniqueIndex:idx_short_link_content,where:content_id IS NOT NULL"`
+	ContentID   *uuid.UUID `json:"contentId,omitempty" db:"content_id" gorm:"type:uuid;uniqueIndex:idx_short_link_content,where:content_id IS NOT NULL"`
+	TargetURL   string     `json:"targetUrl" db:"target_url" gorm:"type:text;not null"`
+	Clicks      int64      `json:"clicks" db:"clicks" gorm:"type:bigint;not null;default:0"`
+	DateAdded   time.Time  `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// Skill proficiency levels, from least to most experienced
+const (
+	SkillProficiencyBeginner     = "beginner"
+	SkillProficiencyIntermediate = "intermediate"
+	SkillProficiencyAdvanced     = "advanced"
+	SkillProficiencyExpert       = "expert"
+)
+
+// SkillProficiencies lists the accepted values for Skill.Proficiency
+var SkillProficiencies = []string{
+	SkillProficiencyBeginner,
+	SkillProficiencyIntermediate,
+	SkillProficiencyAdvanced,
+	SkillProficiencyExpert,
+}
+
+// Skill represents a technical or professional skill shown on the site
+type Skill struct {
+	ID                uuid.UUID                      `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Name              string                         `json:"name" validate:"notblank" db:"name" gorm:"type:text;not null;unique"`
+	Category          string                         `json:"category" validate:"notblank" db:"category" gorm:"type:text;not null;index:idx_skill_category"`
+	Proficiency       string                         `json:"proficiency" validate:"oneof=beginner intermediate advanced expert" db:"proficiency" gorm:"type:text;not null"`
+	YearsOfExperience float64                        `json:"yearsOfExperience" validate:"gte=0" db:"years_of_experience" gorm:"type:numeric(4,1);not null;default:0"`
+	ProjectIDs        datatypes.JSONSlice[uuid.UUID] `json:"projectIds" db:"project_ids" gorm:"type:jsonb;not null;default:'[]'" swaggertype:"array,string" format:"uuid"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// Snippet represents a piece of code shown in the snippets gallery, which blog posts can embed with a
+// {{< snippet id >}} shortcode
+// Language is the fence info string the code is highlighted with, e.g. go or typescript
+type Snippet struct {
+	ID          uuid.UUID                   `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Title       string                      `json:"title" validate:"notblank" db:"title" gorm:"type:text;not null"`
+	Language    string                      `json:"language" validate:"notblank,max=32" db:"language" gorm:"type:text;not null;index:idx_snippet_language"`
+	Code        string                      `json:"code" validate:"notblank" db:"code" gorm:"type:text;not null"`
+	Description *string                     `json:"description,omitempty" db:"description" gorm:"type:text"`
+	Tags        datatypes.JSONSlice[string] `json:"tags" db:"tags" gorm:"type:jsonb;not null;default:'[]'" swaggertype:"array,string"`
+	DateAdded   time.Time                   `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP;index:idx_snippet_date_added"`
+	DateEdited  *time.Time                  `json:"dateEdited,omitempty" db:"date_edited" gorm:"type:timestamp"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Social post statuses
+const (
+	SocialPostStatusSucceeded = "succeeded"
+	SocialPostStatusFailed    = "failed"
+)
+
+// SocialPost records one attempt to share a blog post or note on a social platform
+// ContentType is "blogPost" or "note"; Error holds the platform's error when the attempt failed.
+// ShareLink is the tracked link the post used, when there was one, with its click count
+type SocialPost struct {
+	ID          uuid.UUID  `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	ContentType string     `json:"contentType" db:"content_type" gorm:"type:text;not null;index:idx_social_post_content"`
+	ContentID   uuid.UUID  `json:"contentId" db:"content_id" gorm:"type:uuid;not null;index:idx_social_post_content"`
+	Platform    string     `json:"platform" db:"platform" gorm:"type:text;not null"`
+	Status      string     `json:"status" db:"status" gorm:"type:text;not null"`
+	Error       *string    `json:"error,omitempty" db:"error" gorm:"type:text"`
+	ShareLinkID *uuid.UUID `json:"shareLinkId,omitempty" db:"share_link_id" gorm:"type:uuid"`
+	DateAdded   time.Time  `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP;index:idx_social_post_date_added"`
+	ShareLink   *ShareLink `json:"shareLink,omitempty" gorm:"foreignKey:ShareLinkID;references:ID;constraint:OnDelete:SET NULL"`
+}
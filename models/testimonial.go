@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Testimonial represents a recommendation submitted by a visitor
+// Testimonials are only shown publicly once they have been approved
+type Testimonial struct {
+	ID            uuid.UUID  `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	AuthorName    string     `json:"authorName" db:"author_name" gorm:"type:text;not null"`
+	AuthorRole    *string    `json:"authorRole,omitempty" db:"author_role" gorm:"type:text"`
+	AuthorCompany *string    `json:"authorCompany,omitempty" db:"author_company" gorm:"type:text"`
+	Text          string     `json:"text" db:"text" gorm:"type:text;not null"`
+	Approved      bool       `json:"approved" db:"approved" gorm:"type:boolean;not null;default:false;index:idx_testimonial_approved"`
+	DateSubmitted time.Time  `json:"dateSubmitted" db:"date_submitted" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	DateApproved  *time.Time `json:"dateApproved,omitempty" db:"date_approved" gorm:"type:timestamp"`
+}
@@ -0,0 +1,13 @@
+package models
+
+import "github.com/google/uuid"
+
+// UsesItem represents a tool, app or piece of gear listed on the uses page
+type UsesItem struct {
+	ID           uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Category     string    `json:"category" validate:"notblank" db:"category" gorm:"type:text;not null;index:idx_uses_item_category_order"`
+	Name         string    `json:"name" validate:"notblank" db:"name" gorm:"type:text;not null"`
+	Description  *string   `json:"description,omitempty" db:"description" gorm:"type:text"`
+	Link         *string   `json:"link,omitempty" db:"link" gorm:"type:text"`
+	DisplayOrder int       `json:"displayOrder" db:"display_order" gorm:"type:integer;not null;default:0;index:idx_uses_item_category_order"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VisitorSalt is the random salt mixed into visitor hashes on one day (UTC)
+// Salts are deleted once their day is over, so a stored visitor hash can't be linked back to an IP address or tracked across days
+type VisitorSalt struct {
+	ID   uuid.UUID `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Day  time.Time `json:"day" db:"day" gorm:"type:date;not null;uniqueIndex:idx_visitor_salt_day"`
+	Salt []byte    `json:"-" db:"salt" gorm:"type:bytea;not null"`
+}
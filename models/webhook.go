@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// Webhook events external systems can subscribe to
+const (
+	WebhookEventPostPublished    = "post.published"
+	WebhookEventProjectCreated   = "project.created"
+	WebhookEventSocialPostFailed = "social.post.failed"
+)
+
+// WebhookEvents lists every event a webhook may subscribe to
+var WebhookEvents = []string{
+	WebhookEventPostPublished,
+	WebhookEventProjectCreated,
+	WebhookEventSocialPostFailed,
+}
+
+// Webhook is an external endpoint that receives signed event payloads
+// Each delivery carries an HMAC-SHA256 signature made with Secret so receivers can verify it came from us
+type Webhook struct {
+	ID          uuid.UUID                   `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	URL         string                      `json:"url" validate:"httpurl" db:"url" gorm:"type:text;not null"`
+	Secret      string                      `json:"secret" db:"secret" gorm:"type:text;not null"`
+	Events      datatypes.JSONSlice[string] `json:"events" validate:"min=1,dive,oneof=post.published project.created social.post.failed" db:"events" gorm:"type:jsonb;not null;default:'[]'" swaggertype:"array,string"`
+	Description *string                     `json:"description,omitempty" db:"description" gorm:"type:text"`
+	Active      bool                        `json:"active" db:"active" gorm:"type:boolean;not null;default:true"`
+	DateAdded   time.Time                   `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
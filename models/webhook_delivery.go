@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// WebhookDeliveryStatus tracks where a delivery is in its retry cycle
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is one event sent, or waiting to be sent, to one webhook
+// Pending deliveries are retried with backoff until they succeed or run out of attempts
+type WebhookDelivery struct {
+	ID             uuid.UUID             `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	WebhookID      uuid.UUID             `json:"webhookId" db:"webhook_id" gorm:"type:uuid;not null;index:idx_webhook_delivery_webhook_id"`
+	Event          string                `json:"event" db:"event" gorm:"type:text;not null"`
+	Payload        datatypes.JSON        `json:"payload" db:"payload" gorm:"type:jsonb;not null" swaggertype:"object"`
+	Status         WebhookDeliveryStatus `json:"status" db:"status" gorm:"type:text;not null;default:'pending';index:idx_webhook_delivery_due,priority:1"`
+	Attempts       int                   `json:"attempts" db:"attempts" gorm:"type:integer;not null;default:0"`
+	NextAttemptAt  time.Time             `json:"nextAttemptAt" db:"next_attempt_at" gorm:"type:timestamp;not null;index:idx_webhook_delivery_due,priority:2"`
+	LastStatusCode *int                  `json:"lastStatusCode,omitempty" db:"last_status_code" gorm:"type:integer"`
+	LastError      *string               `json:"lastError,omitempty" db:"last_error" gorm:"type:text"`
+	DateAdded      time.Time             `json:"dateAdded" db:"date_added" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	DateDelivered  *time.Time            `json:"dateDelivered,omitempty" db:"date_delivered" gorm:"type:timestamp"`
+
+	Webhook Webhook `json:"-" gorm:"foreignKey:WebhookID;references:ID;constraint:OnDelete:CASCADE"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// WorkExperience represents a position held at a company, used by the resume and the experience page
+type WorkExperience struct {
+	ID          uuid.UUID                   `json:"id" db:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid();not null"`
+	Company     string                      `json:"company" validate:"notblank" db:"company" gorm:"type:text;not null"`
+	Role        string                      `json:"role" validate:"notblank" db:"role" gorm:"type:text;not null"`
+	Location    *string                     `json:"location,omitempty" db:"location" gorm:"type:text"`
+	StartDate   time.Time                   `json:"startDate" validate:"required" db:"start_date" gorm:"type:date;not null"`
+	EndDate     *time.Time                  `json:"endDate,omitempty" validate:"omitempty,notbefore=StartDate" db:"end_date" gorm:"type:date"`
+	Description *string                     `json:"description,omitempty" db:"description" gorm:"type:text"`
+	Highlights  datatypes.JSONSlice[string] `json:"highlights" db:"highlights" gorm:"type:jsonb;not null;default:'[]'" swaggertype:"array,string"`
+	TechTags    datatypes.JSONSlice[string] `json:"techTags" db:"tech_tags" gorm:"type:jsonb;not null;default:'[]'" swaggertype:"array,string"`
+}
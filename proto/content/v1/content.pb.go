@@ -0,0 +1,1057 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: content/v1/content.proto
+
+package contentv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BlogPost mirrors models.BlogPost, with tags flattened to their values
+type BlogPost struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title      string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Summary    *string                `protobuf:"bytes,3,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
+	Content    string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	DateAdded  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_added,json=dateAdded,proto3" json:"date_added,omitempty"`
+	DateEdited *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_edited,json=dateEdited,proto3" json:"date_edited,omitempty"`
+	Url        *string                `protobuf:"bytes,8,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	Tags       []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	// word_count and reading_time_minutes are worked out from content, and ignored when sent
+	WordCount          int32 `protobuf:"varint,10,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	ReadingTimeMinutes int32 `protobuf:"varint,11,opt,name=reading_time_minutes,json=readingTimeMinutes,proto3" json:"reading_time_minutes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BlogPost) Reset() {
+	*x = BlogPost{}
+	mi := &file_content_v1_content_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlogPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlogPost) ProtoMessage() {}
+
+func (x *BlogPost) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlogPost.ProtoReflect.Descriptor instead.
+func (*BlogPost) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BlogPost) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BlogPost) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BlogPost) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+func (x *BlogPost) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *BlogPost) GetDateAdded() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateAdded
+	}
+	return nil
+}
+
+func (x *BlogPost) GetDateEdited() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateEdited
+	}
+	return nil
+}
+
+func (x *BlogPost) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *BlogPost) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *BlogPost) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *BlogPost) GetReadingTimeMinutes() int32 {
+	if x != nil {
+		return x.ReadingTimeMinutes
+	}
+	return 0
+}
+
+// Project mirrors models.Project, with tags flattened to their values
+type Project struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	GithubLink    string                 `protobuf:"bytes,4,opt,name=github_link,json=githubLink,proto3" json:"github_link,omitempty"`
+	DemoLink      string                 `protobuf:"bytes,5,opt,name=demo_link,json=demoLink,proto3" json:"demo_link,omitempty"`
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	GifLink       *string                `protobuf:"bytes,7,opt,name=gif_link,json=gifLink,proto3,oneof" json:"gif_link,omitempty"`
+	DateAdded     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=date_added,json=dateAdded,proto3" json:"date_added,omitempty"`
+	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	DateEdited    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=date_edited,json=dateEdited,proto3" json:"date_edited,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	mi := &file_content_v1_content_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Project) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Project) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Project) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Project) GetGithubLink() string {
+	if x != nil {
+		return x.GithubLink
+	}
+	return ""
+}
+
+func (x *Project) GetDemoLink() string {
+	if x != nil {
+		return x.DemoLink
+	}
+	return ""
+}
+
+func (x *Project) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Project) GetGifLink() string {
+	if x != nil && x.GifLink != nil {
+		return *x.GifLink
+	}
+	return ""
+}
+
+func (x *Project) GetDateAdded() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateAdded
+	}
+	return nil
+}
+
+func (x *Project) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Project) GetDateEdited() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateEdited
+	}
+	return nil
+}
+
+type ListBlogPostsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Same syntax as the REST ?sort= parameter, e.g. "dateAdded:desc,title:asc"
+	Sort          string `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlogPostsRequest) Reset() {
+	*x = ListBlogPostsRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlogPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlogPostsRequest) ProtoMessage() {}
+
+func (x *ListBlogPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlogPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListBlogPostsRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListBlogPostsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListBlogPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlogPosts     []*BlogPost            `protobuf:"bytes,1,rep,name=blog_posts,json=blogPosts,proto3" json:"blog_posts,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlogPostsResponse) Reset() {
+	*x = ListBlogPostsResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlogPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlogPostsResponse) ProtoMessage() {}
+
+func (x *ListBlogPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlogPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListBlogPostsResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListBlogPostsResponse) GetBlogPosts() []*BlogPost {
+	if x != nil {
+		return x.BlogPosts
+	}
+	return nil
+}
+
+func (x *ListBlogPostsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetBlogPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlogPostRequest) Reset() {
+	*x = GetBlogPostRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlogPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlogPostRequest) ProtoMessage() {}
+
+func (x *GetBlogPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlogPostRequest.ProtoReflect.Descriptor instead.
+func (*GetBlogPostRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetBlogPostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateBlogPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlogPost      *BlogPost              `protobuf:"bytes,1,opt,name=blog_post,json=blogPost,proto3" json:"blog_post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBlogPostRequest) Reset() {
+	*x = CreateBlogPostRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBlogPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBlogPostRequest) ProtoMessage() {}
+
+func (x *CreateBlogPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBlogPostRequest.ProtoReflect.Descriptor instead.
+func (*CreateBlogPostRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateBlogPostRequest) GetBlogPost() *BlogPost {
+	if x != nil {
+		return x.BlogPost
+	}
+	return nil
+}
+
+type UpdateBlogPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tags listed here are added to the post; existing tags are kept
+	BlogPost      *BlogPost `protobuf:"bytes,1,opt,name=blog_post,json=blogPost,proto3" json:"blog_post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBlogPostRequest) Reset() {
+	*x = UpdateBlogPostRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBlogPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBlogPostRequest) ProtoMessage() {}
+
+func (x *UpdateBlogPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBlogPostRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBlogPostRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateBlogPostRequest) GetBlogPost() *BlogPost {
+	if x != nil {
+		return x.BlogPost
+	}
+	return nil
+}
+
+type DeleteBlogPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBlogPostRequest) Reset() {
+	*x = DeleteBlogPostRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBlogPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlogPostRequest) ProtoMessage() {}
+
+func (x *DeleteBlogPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlogPostRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBlogPostRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteBlogPostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteBlogPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBlogPostResponse) Reset() {
+	*x = DeleteBlogPostResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBlogPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlogPostResponse) ProtoMessage() {}
+
+func (x *DeleteBlogPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlogPostResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBlogPostResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{8}
+}
+
+type ListProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Same syntax as the REST ?sort= parameter, e.g. "dateAdded:desc,title:asc"
+	Sort          string `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsRequest) ProtoMessage() {}
+
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListProjectsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsResponse) Reset() {
+	*x = ListProjectsResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsResponse) ProtoMessage() {}
+
+func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *ListProjectsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectRequest) Reset() {
+	*x = GetProjectRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRequest) ProtoMessage() {}
+
+func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateProjectRequest) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type UpdateProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tags listed here are added to the project; existing tags are kept
+	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectRequest) Reset() {
+	*x = UpdateProjectRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectRequest) ProtoMessage() {}
+
+func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateProjectRequest) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type DeleteProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectResponse) Reset() {
+	*x = DeleteProjectResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectResponse) ProtoMessage() {}
+
+func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{15}
+}
+
+var File_content_v1_content_proto protoreflect.FileDescriptor
+
+const file_content_v1_content_proto_rawDesc = "" +
+	"\n" +
+	"\x18content/v1/content.proto\x12\n" +
+	"content.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xff\x02\n" +
+	"\bBlogPost\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\asummary\x18\x03 \x01(\tH\x00R\asummary\x88\x01\x01\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"date_added\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdateAdded\x12;\n" +
+	"\vdate_edited\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"dateEdited\x12\x15\n" +
+	"\x03url\x18\b \x01(\tH\x01R\x03url\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\n" +
+	" \x01(\x05R\twordCount\x120\n" +
+	"\x14reading_time_minutes\x18\v \x01(\x05R\x12readingTimeMinutesB\n" +
+	"\n" +
+	"\b_summaryB\x06\n" +
+	"\x04_urlJ\x04\b\a\x10\bR\x06length\"\xdc\x02\n" +
+	"\aProject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vgithub_link\x18\x04 \x01(\tR\n" +
+	"githubLink\x12\x1b\n" +
+	"\tdemo_link\x18\x05 \x01(\tR\bdemoLink\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x1e\n" +
+	"\bgif_link\x18\a \x01(\tH\x00R\agifLink\x88\x01\x01\x129\n" +
+	"\n" +
+	"date_added\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdateAdded\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12;\n" +
+	"\vdate_edited\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"dateEditedB\v\n" +
+	"\t_gif_link\"*\n" +
+	"\x14ListBlogPostsRequest\x12\x12\n" +
+	"\x04sort\x18\x01 \x01(\tR\x04sort\"b\n" +
+	"\x15ListBlogPostsResponse\x123\n" +
+	"\n" +
+	"blog_posts\x18\x01 \x03(\v2\x14.content.v1.BlogPostR\tblogPosts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"$\n" +
+	"\x12GetBlogPostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
+	"\x15CreateBlogPostRequest\x121\n" +
+	"\tblog_post\x18\x01 \x01(\v2\x14.content.v1.BlogPostR\bblogPost\"J\n" +
+	"\x15UpdateBlogPostRequest\x121\n" +
+	"\tblog_post\x18\x01 \x01(\v2\x14.content.v1.BlogPostR\bblogPost\"'\n" +
+	"\x15DeleteBlogPostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeleteBlogPostResponse\")\n" +
+	"\x13ListProjectsRequest\x12\x12\n" +
+	"\x04sort\x18\x01 \x01(\tR\x04sort\"]\n" +
+	"\x14ListProjectsResponse\x12/\n" +
+	"\bprojects\x18\x01 \x03(\v2\x13.content.v1.ProjectR\bprojects\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"#\n" +
+	"\x11GetProjectRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"E\n" +
+	"\x14CreateProjectRequest\x12-\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.content.v1.ProjectR\aproject\"E\n" +
+	"\x14UpdateProjectRequest\x12-\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.content.v1.ProjectR\aproject\"&\n" +
+	"\x14DeleteProjectRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15DeleteProjectResponse2\x9b\x03\n" +
+	"\x0fBlogPostService\x12T\n" +
+	"\rListBlogPosts\x12 .content.v1.ListBlogPostsRequest\x1a!.content.v1.ListBlogPostsResponse\x12C\n" +
+	"\vGetBlogPost\x12\x1e.content.v1.GetBlogPostRequest\x1a\x14.content.v1.BlogPost\x12I\n" +
+	"\x0eCreateBlogPost\x12!.content.v1.CreateBlogPostRequest\x1a\x14.content.v1.BlogPost\x12I\n" +
+	"\x0eUpdateBlogPost\x12!.content.v1.UpdateBlogPostRequest\x1a\x14.content.v1.BlogPost\x12W\n" +
+	"\x0eDeleteBlogPost\x12!.content.v1.DeleteBlogPostRequest\x1a\".content.v1.DeleteBlogPostResponse2\x8b\x03\n" +
+	"\x0eProjectService\x12Q\n" +
+	"\fListProjects\x12\x1f.content.v1.ListProjectsRequest\x1a .content.v1.ListProjectsResponse\x12@\n" +
+	"\n" +
+	"GetProject\x12\x1d.content.v1.GetProjectRequest\x1a\x13.content.v1.Project\x12F\n" +
+	"\rCreateProject\x12 .content.v1.CreateProjectRequest\x1a\x13.content.v1.Project\x12F\n" +
+	"\rUpdateProject\x12 .content.v1.UpdateProjectRequest\x1a\x13.content.v1.Project\x12T\n" +
+	"\rDeleteProject\x12 .content.v1.DeleteProjectRequest\x1a!.content.v1.DeleteProjectResponseBMZKgithub.com/rpupo63/unified-personal-site-backend/proto/content/v1;contentv1b\x06proto3"
+
+var (
+	file_content_v1_content_proto_rawDescOnce sync.Once
+	file_content_v1_content_proto_rawDescData []byte
+)
+
+func file_content_v1_content_proto_rawDescGZIP() []byte {
+	file_content_v1_content_proto_rawDescOnce.Do(func() {
+		file_content_v1_content_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_content_v1_content_proto_rawDesc), len(file_content_v1_content_proto_rawDesc)))
+	})
+	return file_content_v1_content_proto_rawDescData
+}
+
+var file_content_v1_content_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_content_v1_content_proto_goTypes = []any{
+	(*BlogPost)(nil),               // 0: content.v1.BlogPost
+	(*Project)(nil),                // 1: content.v1.Project
+	(*ListBlogPostsRequest)(nil),   // 2: content.v1.ListBlogPostsRequest
+	(*ListBlogPostsResponse)(nil),  // 3: content.v1.ListBlogPostsResponse
+	(*GetBlogPostRequest)(nil),     // 4: content.v1.GetBlogPostRequest
+	(*CreateBlogPostRequest)(nil),  // 5: content.v1.CreateBlogPostRequest
+	(*UpdateBlogPostRequest)(nil),  // 6: content.v1.UpdateBlogPostRequest
+	(*DeleteBlogPostRequest)(nil),  // 7: content.v1.DeleteBlogPostRequest
+	(*DeleteBlogPostResponse)(nil), // 8: content.v1.DeleteBlogPostResponse
+	(*ListProjectsRequest)(nil),    // 9: content.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),   // 10: content.v1.ListProjectsResponse
+	(*GetProjectRequest)(nil),      // 11: content.v1.GetProjectRequest
+	(*CreateProjectRequest)(nil),   // 12: content.v1.CreateProjectRequest
+	(*UpdateProjectRequest)(nil),   // 13: content.v1.UpdateProjectRequest
+	(*DeleteProjectRequest)(nil),   // 14: content.v1.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),  // 15: content.v1.DeleteProjectResponse
+	(*timestamppb.Timestamp)(nil),  // 16: google.protobuf.Timestamp
+}
+var file_content_v1_content_proto_depIdxs = []int32{
+	16, // 0: content.v1.BlogPost.date_added:type_name -> google.protobuf.Timestamp
+	16, // 1: content.v1.BlogPost.date_edited:type_name -> google.protobuf.Timestamp
+	16, // 2: content.v1.Project.date_added:type_name -> google.protobuf.Timestamp
+	16, // 3: content.v1.Project.date_edited:type_name -> google.protobuf.Timestamp
+	0,  // 4: content.v1.ListBlogPostsResponse.blog_posts:type_name -> content.v1.BlogPost
+	0,  // 5: content.v1.CreateBlogPostRequest.blog_post:type_name -> content.v1.BlogPost
+	0,  // 6: content.v1.UpdateBlogPostRequest.blog_post:type_name -> content.v1.BlogPost
+	1,  // 7: content.v1.ListProjectsResponse.projects:type_name -> content.v1.Project
+	1,  // 8: content.v1.CreateProjectRequest.project:type_name -> content.v1.Project
+	1,  // 9: content.v1.UpdateProjectRequest.project:type_name -> content.v1.Project
+	2,  // 10: content.v1.BlogPostService.ListBlogPosts:input_type -> content.v1.ListBlogPostsRequest
+	4,  // 11: content.v1.BlogPostService.GetBlogPost:input_type -> content.v1.GetBlogPostRequest
+	5,  // 12: content.v1.BlogPostService.CreateBlogPost:input_type -> content.v1.CreateBlogPostRequest
+	6,  // 13: content.v1.BlogPostService.UpdateBlogPost:input_type -> content.v1.UpdateBlogPostRequest
+	7,  // 14: content.v1.BlogPostService.DeleteBlogPost:input_type -> content.v1.DeleteBlogPostRequest
+	9,  // 15: content.v1.ProjectService.ListProjects:input_type -> content.v1.ListProjectsRequest
+	11, // 16: content.v1.ProjectService.GetProject:input_type -> content.v1.GetProjectRequest
+	12, // 17: content.v1.ProjectService.CreateProject:input_type -> content.v1.CreateProjectRequest
+	13, // 18: content.v1.ProjectService.UpdateProject:input_type -> content.v1.UpdateProjectRequest
+	14, // 19: content.v1.ProjectService.DeleteProject:input_type -> content.v1.DeleteProjectRequest
+	3,  // 20: content.v1.BlogPostService.ListBlogPosts:output_type -> content.v1.ListBlogPostsResponse
+	0,  // 21: content.v1.BlogPostService.GetBlogPost:output_type -> content.v1.BlogPost
+	0,  // 22: content.v1.BlogPostService.CreateBlogPost:output_type -> content.v1.BlogPost
+	0,  // 23: content.v1.BlogPostService.UpdateBlogPost:output_type -> content.v1.BlogPost
+	8,  // 24: content.v1.BlogPostService.DeleteBlogPost:output_type -> content.v1.DeleteBlogPostResponse
+	10, // 25: content.v1.ProjectService.ListProjects:output_type -> content.v1.ListProjectsResponse
+	1,  // 26: content.v1.ProjectService.GetProject:output_type -> content.v1.Project
+	1,  // 27: content.v1.ProjectService.CreateProject:output_type -> content.v1.Project
+	1,  // 28: content.v1.ProjectService.UpdateProject:output_type -> content.v1.Project
+	15, // 29: content.v1.ProjectService.DeleteProject:output_type -> content.v1.DeleteProjectResponse
+	20, // [20:30] is the sub-list for method output_type
+	10, // [10:20] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_content_v1_content_proto_init() }
+func file_content_v1_content_proto_init() {
+	if File_content_v1_content_proto != nil {
+		return
+	}
+	file_content_v1_content_proto_msgTypes[0].OneofWrappers = []any{}
+	file_content_v1_content_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_content_v1_content_proto_rawDesc), len(file_content_v1_content_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_content_v1_content_proto_goTypes,
+		DependencyIndexes: file_content_v1_content_proto_depIdxs,
+		MessageInfos:      file_content_v1_content_proto_msgTypes,
+	}.Build()
+	File_content_v1_content_proto = out.File
+	file_content_v1_content_proto_goTypes = nil
+	file_content_v1_content_proto_depIdxs = nil
+}
@@ -0,0 +1,539 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: content/v1/content.proto
+
+package contentv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BlogPostService_ListBlogPosts_FullMethodName  = "/content.v1.BlogPostService/ListBlogPosts"
+	BlogPostService_GetBlogPost_FullMethodName    = "/content.v1.BlogPostService/GetBlogPost"
+	BlogPostService_CreateBlogPost_FullMethodName = "/content.v1.BlogPostService/CreateBlogPost"
+	BlogPostService_UpdateBlogPost_FullMethodName = "/content.v1.BlogPostService/UpdateBlogPost"
+	BlogPostService_DeleteBlogPost_FullMethodName = "/content.v1.BlogPostService/DeleteBlogPost"
+)
+
+// BlogPostServiceClient is the client API for BlogPostService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BlogPostService exposes the same blog post operations as the /blog-post REST routes
+// Mutating calls require "authorization: Bearer <BACKEND_PASSWORD>" metadata
+type BlogPostServiceClient interface {
+	ListBlogPosts(ctx context.Context, in *ListBlogPostsRequest, opts ...grpc.CallOption) (*ListBlogPostsResponse, error)
+	GetBlogPost(ctx context.Context, in *GetBlogPostRequest, opts ...grpc.CallOption) (*BlogPost, error)
+	CreateBlogPost(ctx context.Context, in *CreateBlogPostRequest, opts ...grpc.CallOption) (*BlogPost, error)
+	UpdateBlogPost(ctx context.Context, in *UpdateBlogPostRequest, opts ...grpc.CallOption) (*BlogPost, error)
+	DeleteBlogPost(ctx context.Context, in *DeleteBlogPostRequest, opts ...grpc.CallOption) (*DeleteBlogPostResponse, error)
+}
+
+type blogPostServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlogPostServiceClient(cc grpc.ClientConnInterface) BlogPostServiceClient {
+	return &blogPostServiceClient{cc}
+}
+
+func (c *blogPostServiceClient) ListBlogPosts(ctx context.Context, in *ListBlogPostsRequest, opts ...grpc.CallOption) (*ListBlogPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlogPostsResponse)
+	err := c.cc.Invoke(ctx, BlogPostService_ListBlogPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogPostServiceClient) GetBlogPost(ctx context.Context, in *GetBlogPostRequest, opts ...grpc.CallOption) (*BlogPost, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlogPost)
+	err := c.cc.Invoke(ctx, BlogPostService_GetBlogPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogPostServiceClient) CreateBlogPost(ctx context.Context, in *CreateBlogPostRequest, opts ...grpc.CallOption) (*BlogPost, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlogPost)
+	err := c.cc.Invoke(ctx, BlogPostService_CreateBlogPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogPostServiceClient) UpdateBlogPost(ctx context.Context, in *UpdateBlogPostRequest, opts ...grpc.CallOption) (*BlogPost, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlogPost)
+	err := c.cc.Invoke(ctx, BlogPostService_UpdateBlogPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogPostServiceClient) DeleteBlogPost(ctx context.Context, in *DeleteBlogPostRequest, opts ...grpc.CallOption) (*DeleteBlogPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBlogPostResponse)
+	err := c.cc.Invoke(ctx, BlogPostService_DeleteBlogPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlogPostServiceServer is the server API for BlogPostService service.
+// All implementations must embed UnimplementedBlogPostServiceServer
+// for forward compatibility.
+//
+// BlogPostService exposes the same blog post operations as the /blog-post REST routes
+// Mutating calls require "authorization: Bearer <BACKEND_PASSWORD>" metadata
+type BlogPostServiceServer interface {
+	ListBlogPosts(context.Context, *ListBlogPostsRequest) (*ListBlogPostsResponse, error)
+	GetBlogPost(context.Context, *GetBlogPostRequest) (*BlogPost, error)
+	CreateBlogPost(context.Context, *CreateBlogPostRequest) (*BlogPost, error)
+	UpdateBlogPost(context.Context, *UpdateBlogPostRequest) (*BlogPost, error)
+	DeleteBlogPost(context.Context, *DeleteBlogPostRequest) (*DeleteBlogPostResponse, error)
+	mustEmbedUnimplementedBlogPostServiceServer()
+}
+
+// UnimplementedBlogPostServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBlogPostServiceServer struct{}
+
+func (UnimplementedBlogPostServiceServer) ListBlogPosts(context.Context, *ListBlogPostsRequest) (*ListBlogPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlogPosts not implemented")
+}
+func (UnimplementedBlogPostServiceServer) GetBlogPost(context.Context, *GetBlogPostRequest) (*BlogPost, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlogPost not implemented")
+}
+func (UnimplementedBlogPostServiceServer) CreateBlogPost(context.Context, *CreateBlogPostRequest) (*BlogPost, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBlogPost not implemented")
+}
+func (UnimplementedBlogPostServiceServer) UpdateBlogPost(context.Context, *UpdateBlogPostRequest) (*BlogPost, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlogPost not implemented")
+}
+func (UnimplementedBlogPostServiceServer) DeleteBlogPost(context.Context, *DeleteBlogPostRequest) (*DeleteBlogPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBlogPost not implemented")
+}
+func (UnimplementedBlogPostServiceServer) mustEmbedUnimplementedBlogPostServiceServer() {}
+func (UnimplementedBlogPostServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeBlogPostServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BlogPostServiceServer will
+// result in compilation errors.
+type UnsafeBlogPostServiceServer interface {
+	mustEmbedUnimplementedBlogPostServiceServer()
+}
+
+func RegisterBlogPostServiceServer(s grpc.ServiceRegistrar, srv BlogPostServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBlogPostServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BlogPostService_ServiceDesc, srv)
+}
+
+func _BlogPostService_ListBlogPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlogPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogPostServiceServer).ListBlogPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogPostService_ListBlogPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogPostServiceServer).ListBlogPosts(ctx, req.(*ListBlogPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogPostService_GetBlogPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlogPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogPostServiceServer).GetBlogPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogPostService_GetBlogPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogPostServiceServer).GetBlogPost(ctx, req.(*GetBlogPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogPostService_CreateBlogPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBlogPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogPostServiceServer).CreateBlogPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogPostService_CreateBlogPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogPostServiceServer).CreateBlogPost(ctx, req.(*CreateBlogPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogPostService_UpdateBlogPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBlogPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogPostServiceServer).UpdateBlogPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogPostService_UpdateBlogPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogPostServiceServer).UpdateBlogPost(ctx, req.(*UpdateBlogPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogPostService_DeleteBlogPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBlogPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogPostServiceServer).DeleteBlogPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogPostService_DeleteBlogPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogPostServiceServer).DeleteBlogPost(ctx, req.(*DeleteBlogPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BlogPostService_ServiceDesc is the grpc.ServiceDesc for BlogPostService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BlogPostService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "content.v1.BlogPostService",
+	HandlerType: (*BlogPostServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListBlogPosts",
+			Handler:    _BlogPostService_ListBlogPosts_Handler,
+		},
+		{
+			MethodName: "GetBlogPost",
+			Handler:    _BlogPostService_GetBlogPost_Handler,
+		},
+		{
+			MethodName: "CreateBlogPost",
+			Handler:    _BlogPostService_CreateBlogPost_Handler,
+		},
+		{
+			MethodName: "UpdateBlogPost",
+			Handler:    _BlogPostService_UpdateBlogPost_Handler,
+		},
+		{
+			MethodName: "DeleteBlogPost",
+			Handler:    _BlogPostService_DeleteBlogPost_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "content/v1/content.proto",
+}
+
+const (
+	ProjectService_ListProjects_FullMethodName  = "/content.v1.ProjectService/ListProjects"
+	ProjectService_GetProject_FullMethodName    = "/content.v1.ProjectService/GetProject"
+	ProjectService_CreateProject_FullMethodName = "/content.v1.ProjectService/CreateProject"
+	ProjectService_UpdateProject_FullMethodName = "/content.v1.ProjectService/UpdateProject"
+	ProjectService_DeleteProject_FullMethodName = "/content.v1.ProjectService/DeleteProject"
+)
+
+// ProjectServiceClient is the client API for ProjectService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ProjectService exposes the same project operations as the /project REST routes
+// Mutating calls require "authorization: Bearer <BACKEND_PASSWORD>" metadata
+type ProjectServiceClient interface {
+	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
+	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
+}
+
+type projectServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProjectServiceClient(cc grpc.ClientConnInterface) ProjectServiceClient {
+	return &projectServiceClient{cc}
+}
+
+func (c *projectServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProjectsResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ListProjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Project)
+	err := c.cc.Invoke(ctx, ProjectService_GetProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Project)
+	err := c.cc.Invoke(ctx, ProjectService_CreateProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Project)
+	err := c.cc.Invoke(ctx, ProjectService_UpdateProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProjectResponse)
+	err := c.cc.Invoke(ctx, ProjectService_DeleteProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProjectServiceServer is the server API for ProjectService service.
+// All implementations must embed UnimplementedProjectServiceServer
+// for forward compatibility.
+//
+// ProjectService exposes the same project operations as the /project REST routes
+// Mutating calls require "authorization: Bearer <BACKEND_PASSWORD>" metadata
+type ProjectServiceServer interface {
+	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
+	GetProject(context.Context, *GetProjectRequest) (*Project, error)
+	CreateProject(context.Context, *CreateProjectRequest) (*Project, error)
+	UpdateProject(context.Context, *UpdateProjectRequest) (*Project, error)
+	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	mustEmbedUnimplementedProjectServiceServer()
+}
+
+// UnimplementedProjectServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProjectServiceServer struct{}
+
+func (UnimplementedProjectServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
+}
+func (UnimplementedProjectServiceServer) GetProject(context.Context, *GetProjectRequest) (*Project, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProject not implemented")
+}
+func (UnimplementedProjectServiceServer) CreateProject(context.Context, *CreateProjectRequest) (*Project, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
+}
+func (UnimplementedProjectServiceServer) UpdateProject(context.Context, *UpdateProjectRequest) (*Project, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProject not implemented")
+}
+func (UnimplementedProjectServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+}
+func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
+func (UnimplementedProjectServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeProjectServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProjectServiceServer will
+// result in compilation errors.
+type UnsafeProjectServiceServer interface {
+	mustEmbedUnimplementedProjectServiceServer()
+}
+
+func RegisterProjectServiceServer(s grpc.ServiceRegistrar, srv ProjectServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProjectServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProjectService_ServiceDesc, srv)
+}
+
+func _ProjectService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ListProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetProject(ctx, req.(*GetProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_CreateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).UpdateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_UpdateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_DeleteProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProjectService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "content.v1.ProjectService",
+	HandlerType: (*ProjectServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListProjects",
+			Handler:    _ProjectService_ListProjects_Handler,
+		},
+		{
+			MethodName: "GetProject",
+			Handler:    _ProjectService_GetProject_Handler,
+		},
+		{
+			MethodName: "CreateProject",
+			Handler:    _ProjectService_CreateProject_Handler,
+		},
+		{
+			MethodName: "UpdateProject",
+			Handler:    _ProjectService_UpdateProject_Handler,
+		},
+		{
+			MethodName: "DeleteProject",
+			Handler:    _ProjectService_DeleteProject_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "content/v1/content.proto",
+}
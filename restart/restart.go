@@ -0,0 +1,274 @@
+// Package restart hands listening sockets from a running server to its replacement so deploys
+// don't drop connections
+// On Upgrade the running process starts a new copy of its binary with every listener it opened passed down as
+// an inherited file descriptor. The new process serves on those same sockets, and once it reports Ready the old
+// one is told to exit through Exit, so it stops accepting and drains its in-flight requests. Connections queue in
+// the shared sockets the whole time instead of being refused
+package restart
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Environment variables the parent uses to describe the inherited descriptors to its child
+const (
+	listenersEnv = "RESTART_LISTENERS"
+	readyFdEnv   = "RESTART_READY_FD"
+)
+
+// readyTimeout is how long Upgrade waits for the new process to start serving before giving up on it
+const readyTimeout = time.Minute
+
+// firstInheritedFd is the descriptor of the first file in exec.Cmd.ExtraFiles, after stdin, stdout and stderr
+const firstInheritedFd = 3
+
+// ListenFunc opens a listener, matching the signature of net.Listen
+type ListenFunc func(network, address string) (net.Listener, error)
+
+// Listen is net.Listen, except that a socket file left behind by a previous run is removed first,
+// since binding to a Unix socket path fails while the file exists
+func Listen(network, address string) (net.Listener, error) {
+	if network == "unix" {
+		if info, err := os.Lstat(address); err == nil {
+			if info.Mode()&fs.ModeSocket == 0 {
+				return nil, fmt.Errorf("%s exists and is not a socket", address)
+			}
+			if err := os.Remove(address); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return net.Listen(network, address)
+}
+
+// filer is implemented by the TCP and Unix listeners, whose descriptors can be passed to a child
+type filer interface {
+	File() (*os.File, error)
+}
+
+type namedListener struct {
+	key      string
+	listener net.Listener
+}
+
+// Upgrader opens listeners that survive a restart and performs the handoff to a new process
+type Upgrader struct {
+	pidFile string
+
+	mu        sync.Mutex
+	inherited map[string]*os.File
+	listeners []namedListener
+	ready     *os.File // Pipe to the parent waiting for Ready, nil when not started by Upgrade
+	upgrading bool
+
+	exit     chan struct{}
+	exitOnce sync.Once
+}
+
+// New returns an Upgrader that picks up any listeners handed down by a parent process
+// pidFile, when set, receives the process ID once the process is ready, so a supervisor such as systemd
+// can follow the server across restarts
+func New(pidFile string) (*Upgrader, error) {
+	u := &Upgrader{
+		pidFile:   pidFile,
+		inherited: map[string]*os.File{},
+		exit:      make(chan struct{}),
+	}
+
+	if keys := os.Getenv(listenersEnv); keys != "" {
+		for i, key := range strings.Split(keys, ",") {
+			u.inherited[key] = os.NewFile(uintptr(firstInheritedFd+i), key)
+		}
+	}
+	if fd := os.Getenv(readyFdEnv); fd != "" {
+		n, err := strconv.Atoi(fd)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", readyFdEnv, fd, err)
+		}
+		u.ready = os.NewFile(uintptr(n), "ready")
+	}
+
+	// Children of this process must not mistake these for descriptors of their own
+	os.Unsetenv(listenersEnv)
+	os.Unsetenv(readyFdEnv)
+	return u, nil
+}
+
+// Listen returns the listener inherited from the parent for network and address, or opens a new one
+func (u *Upgrader) Listen(network, address string) (net.Listener, error) {
+	key := network + ":" + address
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	var listener net.Listener
+	if file, ok := u.inherited[key]; ok {
+		delete(u.inherited, key)
+		var err error
+		listener, err = net.FileListener(file)
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to inherit listener %s: %w", key, err)
+		}
+	} else {
+		var err error
+		if listener, err = Listen(network, address); err != nil {
+			return nil, err
+		}
+	}
+
+	// The socket file is shared with the next process, so closing the listener here must leave it in place
+	if unixListener, ok := listener.(*net.UnixListener); ok {
+		unixListener.SetUnlinkOnClose(false)
+	}
+
+	u.listeners = append(u.listeners, namedListener{key, listener})
+	return listener, nil
+}
+
+// Ready reports that every listener is open and serving
+// Inherited listeners that weren't asked for again are closed, and the parent, if any, is told to exit
+func (u *Upgrader) Ready() error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	for key, file := range u.inherited {
+		file.Close()
+		delete(u.inherited, key)
+	}
+
+	if u.pidFile != "" {
+		if err := writePIDFile(u.pidFile); err != nil {
+			return fmt.Errorf("failed to write PID file: %w", err)
+		}
+	}
+
+	if u.ready == nil {
+		return nil
+	}
+	defer func() {
+		u.ready.Close()
+		u.ready = nil
+	}()
+	_, err := u.ready.Write([]byte{1})
+	return err
+}
+
+// Upgrade starts a new process from the current binary and hands it every listener
+// It returns once the new process is Ready, after which Exit is closed, or with an error if it failed to
+// start or didn't become ready in time, in which case this process keeps serving
+func (u *Upgrader) Upgrade() error {
+	u.mu.Lock()
+	if u.upgrading {
+		u.mu.Unlock()
+		return errors.New("an upgrade is already in progress")
+	}
+	u.upgrading = true
+	listeners := append([]namedListener(nil), u.listeners...)
+	u.mu.Unlock()
+
+	defer func() {
+		u.mu.Lock()
+		u.upgrading = false
+		u.mu.Unlock()
+	}()
+
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	// Duplicates of the listening sockets, closed here once the child holds its own copies
+	var files []*os.File
+	defer func() {
+		for _, file := range files {
+			file.Close()
+		}
+	}()
+	keys := make([]string, 0, len(listeners))
+	for _, l := range listeners {
+		f, ok := l.listener.(filer)
+		if !ok {
+			return fmt.Errorf("listener %s can't be handed to another process", l.key)
+		}
+		file, err := f.File()
+		if err != nil {
+			return fmt.Errorf("failed to duplicate listener %s: %w", l.key, err)
+		}
+		files = append(files, file)
+		keys = append(keys, l.key)
+	}
+
+	readyRead, readyWrite, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	defer readyRead.Close()
+	files = append(files, readyWrite)
+
+	cmd := exec.Command(executable, os.Args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.ExtraFiles = files
+	cmd.Env = append(os.Environ(),
+		listenersEnv+"="+strings.Join(keys, ","),
+		readyFdEnv+"="+strconv.Itoa(firstInheritedFd+len(files)-1),
+	)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start new process: %w", err)
+	}
+	readyWrite.Close()
+	files = files[:len(files)-1]
+
+	readyChannel := make(chan error, 1)
+	go func() {
+		buf := make([]byte, 1)
+		if _, err := readyRead.Read(buf); err != nil {
+			readyChannel <- errors.New("new process exited before it was ready")
+			return
+		}
+		readyChannel <- nil
+	}()
+
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+
+	select {
+	case err := <-readyChannel:
+		if err != nil {
+			return err
+		}
+	case err := <-exited:
+		return fmt.Errorf("new process exited before it was ready: %v", err)
+	case <-time.After(readyTimeout):
+		cmd.Process.Kill()
+		return errors.New("timed out waiting for the new process to be ready")
+	}
+
+	u.exitOnce.Do(func() { close(u.exit) })
+	return nil
+}
+
+// Exit is closed once a new process has taken over the listeners and this one should shut down
+func (u *Upgrader) Exit() <-chan struct{} {
+	return u.exit
+}
+
+// writePIDFile replaces path with the current process ID, atomically so a supervisor never reads a partial file
+func writePIDFile(path string) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.Itoa(os.Getpid())+"\n"), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	api "github.com/rpupo63/unified-personal-site-backend/api"
+	"github.com/rpupo63/unified-personal-site-backend/database"
+	"github.com/rpupo63/unified-personal-site-backend/grpcapi"
+	"github.com/rpupo63/unified-personal-site-backend/restart"
+	"github.com/rpupo63/unified-personal-site-backend/services"
+)
+
+// runServe runs the HTTP API, and the gRPC API when GRPC_PORT is set, until interrupted or restarted
+func runServe(args []string) error {
+	if err := flag.NewFlagSet("serve", flag.ContinueOnError).Parse(args); err != nil {
+		return err
+	}
+	fmt.Println("Initializing app...")
+
+	db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+	currentDB := database.New(db)
+	// Credentials saved by refresh-substack take the place of the environment's
+	services.UseStoredCredentials(currentDB.CredentialRepo().FindAll)
+
+	// Initialize Server
+	errChannel := make(chan error)
+	defer close(errChannel)
+
+	// With GRACEFUL_RESTARTS, SIGHUP starts a new process on the same sockets and this one drains and exits
+	var upgrader *restart.Upgrader
+	var serverOpts []func(*api.Server)
+	var grpcOpts []func(*grpcapi.Server)
+	if getEnv("GRACEFUL_RESTARTS", "false") == "true" {
+		upgrader, err = restart.New(os.Getenv("PID_FILE"))
+		if err != nil {
+			return fmt.Errorf("error initializing graceful restarts: %w", err)
+		}
+		serverOpts = append(serverOpts, api.WithListenFunc(upgrader.Listen))
+		grpcOpts = append(grpcOpts, grpcapi.WithListenFunc(upgrader.Listen))
+	}
+
+	server, err := api.NewServer(currentDB, serverOpts...)
+	if err != nil {
+		return fmt.Errorf("error initializing server: %w", err)
+	}
+
+	// The gRPC API only runs when GRPC_PORT is set
+	grpcServer, grpcEnabled := grpcapi.NewServer(currentDB, grpcOpts...)
+
+	if err := server.Listen(); err != nil {
+		return fmt.Errorf("error opening listeners: %w", err)
+	}
+	if grpcEnabled {
+		if err := grpcServer.Listen(); err != nil {
+			return fmt.Errorf("error opening gRPC listener: %w", err)
+		}
+	}
+
+	go server.Start(errChannel)
+	if grpcEnabled {
+		go grpcServer.Start(errChannel)
+	}
+	go listenToInterrupt(errChannel)
+
+	if upgrader != nil {
+		// Every socket is open, so a parent handing over to this process can exit now
+		if err := upgrader.Ready(); err != nil {
+			return fmt.Errorf("error reporting the restart as ready: %w", err)
+		}
+		go listenToRestart(upgrader, errChannel)
+	}
+
+	fatalErr := <-errChannel
+	fmt.Printf("Closing server: %v\n", fatalErr)
+
+	if grpcEnabled {
+		grpcServer.ShutdownGracefully(30 * time.Second)
+	}
+	server.ShutdownGracefully(30 * time.Second)
+	return nil
+}
+
+// listenToInterrupt waits for SIGINT or SIGTERM
+func listenToInterrupt(errChannel chan<- error) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+	errChannel <- fmt.Errorf("%s", <-c)
+}
+
+// listenToRestart upgrades to a new process on every SIGHUP and reports once one has taken over
+func listenToRestart(upgrader *restart.Upgrader, errChannel chan<- error) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP)
+	go func() {
+		for range c {
+			fmt.Println("Restarting...")
+			if err := upgrader.Upgrade(); err != nil {
+				fmt.Printf("Restart failed, still serving: %v\n", err)
+			}
+		}
+	}()
+
+	<-upgrader.Exit()
+	errChannel <- fmt.Errorf("restarted as a new process")
+}
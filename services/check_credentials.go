@@ -0,0 +1,309 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/config"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"golang.org/x/sync/errgroup"
+)
+
+// Credential statuses reported by CheckCredentials
+const (
+	CredentialOK           = "ok"
+	CredentialExpiring     = "expiring"     // Valid, but expires within CredentialExpiryWarning
+	CredentialInvalid      = "invalid"      // The platform rejected the credentials, e.g. a revoked or expired token
+	CredentialUnconfigured = "unconfigured" // The environment variables the platform needs aren't set
+	CredentialUnreachable  = "unreachable"  // The platform couldn't be asked, so the credentials may still be fine
+)
+
+// CredentialExpiryWarning is how close to its expiry a token is reported as expiring
+const CredentialExpiryWarning = 14 * 24 * time.Hour
+
+// CredentialPlatforms are the platforms CheckCredentials knows how to check, in the order it reports them
+var CredentialPlatforms = []string{"substack", "medium", "twitter", "linkedin", "mastodon"}
+
+// CredentialCheck is the outcome of checking one platform's credentials
+type CredentialCheck struct {
+	Platform  string
+	Status    string
+	Account   string     // The account the credentials belong to, when the platform says
+	ExpiresAt *time.Time // When the token expires, for platforms that report it
+	Err       error      // Why the credentials aren't usable, for any status but ok and expiring
+}
+
+// CheckCredentials verifies each platform's configured credentials with a lightweight authenticated call that
+// changes nothing, such as fetching the account they belong to. Platforms are checked at once and reported in the
+// order of CredentialPlatforms; unknown platform names are ignored
+func CheckCredentials(ctx context.Context, platforms []string) []CredentialCheck {
+	// Loads the .env file and stored credentials as posting does, so both see the same credentials
+	stored := loadStoredCredentials()
+	cfg := overlayCredentials(loadTwitterConfig(), stored)
+
+	checkers := map[string]func(context.Context, map[string]string) CredentialCheck{
+		"substack": checkSubstackCredentials,
+		"medium":   checkMediumCredentials,
+		"twitter":  checkTwitterCredentials,
+		"linkedin": checkLinkedInCredentials,
+		"mastodon": checkMastodonCredentials,
+	}
+
+	var checks []CredentialCheck
+	var run []func(context.Context, map[string]string) CredentialCheck
+	for _, platform := range CredentialPlatforms {
+		if contains(platforms, platform) {
+			checks = append(checks, CredentialCheck{Platform: platform})
+			run = append(run, checkers[platform])
+		}
+	}
+
+	var g errgroup.Group
+	for i := range checks {
+		g.Go(func() error {
+			check := run[i](ctx, cfg)
+			check.Platform = checks[i].Platform
+			// Platforms that don't report an expiry, such as Substack, can have one recorded when the credential was stored
+			if check.ExpiresAt == nil {
+				check.ExpiresAt = storedExpiry(stored, check.Platform)
+			}
+			if check.Status == CredentialOK && check.ExpiresAt != nil && time.Until(*check.ExpiresAt) < CredentialExpiryWarning {
+				check.Status = CredentialExpiring
+			}
+			checks[i] = check
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return checks
+}
+
+// storedExpiry returns when the stored credential for platform that expires soonest does, if any records it
+func storedExpiry(stored []models.Credential, platform string) *time.Time {
+	var expiresAt *time.Time
+	for _, credential := range stored {
+		if credential.Platform == platform && credential.ExpiresAt != nil && (expiresAt == nil || credential.ExpiresAt.Before(*expiresAt)) {
+			expiresAt = credential.ExpiresAt
+		}
+	}
+	return expiresAt
+}
+
+// CredentialsHealthy reports whether every configured platform's credentials are usable and not about to expire
+// Unconfigured platforms don't count against it, since they're not meant to be posted to
+func CredentialsHealthy(checks []CredentialCheck) bool {
+	for _, check := range checks {
+		if check.Status != CredentialOK && check.Status != CredentialUnconfigured {
+			return false
+		}
+	}
+	return true
+}
+
+// unconfigured reports which of the environment variables a platform needs are missing
+func unconfigured(cfg map[string]string, keys ...string) CredentialCheck {
+	var missing []string
+	for _, key := range keys {
+		if config.GetString(cfg, key, "") == "" {
+			missing = append(missing, key)
+		}
+	}
+	return CredentialCheck{Status: CredentialUnconfigured, Err: fmt.Errorf("%s not set", strings.Join(missing, ", "))}
+}
+
+// sendCredentialCheck sends req and decodes a successful response into out, also returning the response status, if
+// there was one. A 401 or 403 means the credentials were rejected; any other failure means the platform couldn't say
+// either way
+func sendCredentialCheck(client *http.Client, req *http.Request, platform string, out any) (CredentialCheck, int) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return CredentialCheck{Status: CredentialUnreachable, Err: fmt.Errorf("failed to send request to %s: %w", platform, err)}, 0
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return CredentialCheck{Status: CredentialUnreachable, Err: fmt.Errorf("failed to read %s response: %w", platform, err)}, resp.StatusCode
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return CredentialCheck{Status: CredentialInvalid, Err: fmt.Errorf("%s rejected the credentials (status %d): %s", platform, resp.StatusCode, string(bodyBytes))}, resp.StatusCode
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return CredentialCheck{Status: CredentialUnreachable, Err: fmt.Errorf("%s error (status %d): %s", platform, resp.StatusCode, string(bodyBytes))}, resp.StatusCode
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(bodyBytes, out); err != nil {
+			return CredentialCheck{Status: CredentialUnreachable, Err: fmt.Errorf("failed to parse %s response: %w", platform, err)}, resp.StatusCode
+		}
+	}
+	return CredentialCheck{Status: CredentialOK}, resp.StatusCode
+}
+
+// checkSubstackCredentials lists one draft, which needs a signed-in session cookie
+// Substack session cookies don't say when they expire, so no expiry is reported
+func checkSubstackCredentials(ctx context.Context, cfg map[string]string) CredentialCheck {
+	cookie := config.GetString(cfg, "SUBSTACK_COOKIE", "")
+	subdomain := config.GetString(cfg, "SUBSTACK_DOMAIN", "")
+	if cookie == "" || subdomain == "" {
+		return unconfigured(cfg, "SUBSTACK_COOKIE", "SUBSTACK_DOMAIN")
+	}
+
+	// Note: This endpoint is reverse-engineered and unofficial, like the one posts are made to
+	draftsURL := fmt.Sprintf("https://%s.substack.com/api/v1/drafts?offset=0&limit=1", subdomain)
+	req, err := http.NewRequestWithContext(ctx, "GET", draftsURL, nil)
+	if err != nil {
+		return CredentialCheck{Status: CredentialUnreachable, Err: fmt.Errorf("failed to create Substack request: %w", err)}
+	}
+	req.Header.Set("Cookie", fmt.Sprintf("connect.sid=%s", cookie))
+	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36")
+
+	check, _ := sendCredentialCheck(platformClient(), req, "Substack", nil)
+	if check.Status == CredentialOK {
+		check.Account = subdomain
+	}
+	return check
+}
+
+// CheckSubstackCookie checks a connect.sid cookie against the Substack publication at subdomain the way
+// CheckCredentials checks the configured one, so a new cookie can be tried before it's stored
+func CheckSubstackCookie(ctx context.Context, subdomain, cookie string) CredentialCheck {
+	check := checkSubstackCredentials(ctx, map[string]string{"SUBSTACK_COOKIE": cookie, "SUBSTACK_DOMAIN": subdomain})
+	check.Platform = "substack"
+	return check
+}
+
+// checkMediumCredentials fetches the user the integration token belongs to
+// Medium integration tokens don't expire
+func checkMediumCredentials(ctx context.Context, cfg map[string]string) CredentialCheck {
+	integrationToken := config.GetString(cfg, "MEDIUM_INTEGRATION_TOKEN", "")
+	if integrationToken == "" {
+		return unconfigured(cfg, "MEDIUM_INTEGRATION_TOKEN")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://api.medium.com/v1/me", nil)
+	if err != nil {
+		return CredentialCheck{Status: CredentialUnreachable, Err: fmt.Errorf("failed to create Medium API request: %w", err)}
+	}
+	req.Header.Set("Authorization", "Bearer "+integrationToken)
+	req.Header.Set("Accept", "application/json")
+
+	var userResponse MediumUserResponse
+	check, _ := sendCredentialCheck(platformClient(), req, "Medium", &userResponse)
+	check.Account = userResponse.Data.Username
+	return check
+}
+
+// checkTwitterCredentials fetches the user the OAuth 1.0a access token belongs to
+// OAuth 1.0a access tokens don't expire until they're revoked
+func checkTwitterCredentials(ctx context.Context, cfg map[string]string) CredentialCheck {
+	httpClient, err := newTwitterClient(ctx, cfg)
+	if err != nil {
+		return unconfigured(cfg, "TWITTER_API_KEY", "TWITTER_API_KEY_SECRET", "TWITTER_ACCESS_TOKEN", "TWITTER_ACCESS_TOKEN_SECRET")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://api.twitter.com/2/users/me", nil)
+	if err != nil {
+		return CredentialCheck{Status: CredentialUnreachable, Err: fmt.Errorf("failed to create Twitter API request: %w", err)}
+	}
+
+	var userResponse struct {
+		Data struct {
+			Username string `json:"username"`
+		} `json:"data"`
+	}
+	check, _ := sendCredentialCheck(httpClient, req, "Twitter", &userResponse)
+	if userResponse.Data.Username != "" {
+		check.Account = "@" + userResponse.Data.Username
+	}
+	return check
+}
+
+// checkLinkedInCredentials introspects the access token, which also gives its expiry, when LINKEDIN_CLIENT_ID and
+// LINKEDIN_CLIENT_SECRET are set. Otherwise it calls the userinfo endpoint: a token without the openid scope gets a
+// 403 there, but only a valid token gets that far, so only a 401 means it's been revoked or has expired
+func checkLinkedInCredentials(ctx context.Context, cfg map[string]string) CredentialCheck {
+	accessToken := config.GetString(cfg, "LINKEDIN_ACCESS_TOKEN", "")
+	personURN := config.GetString(cfg, "LINKEDIN_PERSON_URN", "")
+	if accessToken == "" || personURN == "" {
+		return unconfigured(cfg, "LINKEDIN_ACCESS_TOKEN", "LINKEDIN_PERSON_URN")
+	}
+
+	clientID := config.GetString(cfg, "LINKEDIN_CLIENT_ID", "")
+	clientSecret := config.GetString(cfg, "LINKEDIN_CLIENT_SECRET", "")
+	if clientID == "" || clientSecret == "" {
+		req, err := http.NewRequestWithContext(ctx, "GET", "https://api.linkedin.com/v2/userinfo", nil)
+		if err != nil {
+			return CredentialCheck{Status: CredentialUnreachable, Err: fmt.Errorf("failed to create LinkedIn API request: %w", err)}
+		}
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+
+		check, statusCode := sendCredentialCheck(platformClient(), req, "LinkedIn", nil)
+		if statusCode == http.StatusForbidden {
+			check = CredentialCheck{Status: CredentialOK}
+		}
+		if check.Status == CredentialOK {
+			check.Account = personURN
+		}
+		return check
+	}
+
+	form := url.Values{
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+		"token":         {accessToken},
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://www.linkedin.com/oauth/v2/introspectToken", strings.NewReader(form.Encode()))
+	if err != nil {
+		return CredentialCheck{Status: CredentialUnreachable, Err: fmt.Errorf("failed to create LinkedIn introspection request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var introspection struct {
+		Active    bool  `json:"active"`
+		ExpiresAt int64 `json:"expires_at"`
+	}
+	check, _ := sendCredentialCheck(platformClient(), req, "LinkedIn", &introspection)
+	if check.Status != CredentialOK {
+		return check
+	}
+	if introspection.ExpiresAt > 0 {
+		expiresAt := time.Unix(introspection.ExpiresAt, 0).UTC()
+		check.ExpiresAt = &expiresAt
+	}
+	if !introspection.Active {
+		return CredentialCheck{Status: CredentialInvalid, ExpiresAt: check.ExpiresAt, Err: fmt.Errorf("LinkedIn access token is no longer active")}
+	}
+	check.Account = personURN
+	return check
+}
+
+// checkMastodonCredentials fetches the account the access token belongs to
+// Mastodon access tokens don't expire until they're revoked
+func checkMastodonCredentials(ctx context.Context, cfg map[string]string) CredentialCheck {
+	instanceURL := strings.TrimSuffix(config.GetString(cfg, "MASTODON_INSTANCE_URL", ""), "/")
+	accessToken := config.GetString(cfg, "MASTODON_ACCESS_TOKEN", "")
+	if instanceURL == "" || accessToken == "" {
+		return unconfigured(cfg, "MASTODON_INSTANCE_URL", "MASTODON_ACCESS_TOKEN")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", instanceURL+"/api/v1/accounts/verify_credentials", nil)
+	if err != nil {
+		return CredentialCheck{Status: CredentialUnreachable, Err: fmt.Errorf("failed to create Mastodon API request: %w", err)}
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	var account struct {
+		Acct string `json:"acct"`
+	}
+	check, _ := sendCredentialCheck(platformClient(), req, "Mastodon", &account)
+	check.Account = account.Acct
+	return check
+}
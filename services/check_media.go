@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/rpupo63/unified-personal-site-backend/config"
+	"golang.org/x/sync/errgroup"
+)
+
+// maxMediaChecksInFlight limits how many media URLs CheckMediaURLs requests at once
+const maxMediaChecksInFlight = 8
+
+// CheckMediaURLs requests each image URL and returns why the ones that don't load failed, keyed by URL
+// Relative URLs are resolved against BASE_URL, and skipped when it isn't set. Requests go through the same client as
+// link previews, so stored URLs can't be used to reach the host's private network
+func CheckMediaURLs(ctx context.Context, mediaURLs []string) map[string]error {
+	base, _ := url.Parse(GetBaseURL(config.New(), ""))
+
+	var mu sync.Mutex
+	broken := make(map[string]error)
+	var g errgroup.Group
+	g.SetLimit(maxMediaChecksInFlight)
+	for _, mediaURL := range mediaURLs {
+		g.Go(func() error {
+			if err := checkMediaURL(ctx, base, mediaURL); err != nil {
+				mu.Lock()
+				broken[mediaURL] = err
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return broken
+}
+
+func checkMediaURL(ctx context.Context, base *url.URL, mediaURL string) error {
+	parsedURL, err := url.Parse(mediaURL)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+	if !parsedURL.IsAbs() {
+		if base == nil || !base.IsAbs() {
+			return nil
+		}
+		parsedURL = base.ResolveReference(parsedURL)
+	}
+	if parsedURL.Scheme == "data" {
+		// Embedded in the page, so there's nothing to load
+		return nil
+	}
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q", parsedURL.Scheme)
+	}
+
+	// Some hosts don't answer HEAD, so a refusal is retried as a GET whose body is discarded
+	status, err := requestMedia(ctx, http.MethodHead, parsedURL.String())
+	if err == nil && (status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented) {
+		status, err = requestMedia(ctx, http.MethodGet, parsedURL.String())
+	}
+	if err != nil {
+		return err
+	}
+	if status < 200 || status >= 300 {
+		return fmt.Errorf("returned status %d", status)
+	}
+	return nil
+}
+
+func requestMedia(ctx context.Context, method, mediaURL string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, mediaURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; PersonalSiteBot/1.0)")
+
+	resp, err := metadataClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxMetadataBodySize))
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// webhookClient is used for all webhook deliveries; receivers should answer quickly and do heavy work async
+var webhookClient = &http.Client{Timeout: 10 * time.Second}
+
+// SignWebhookPayload returns the hex HMAC-SHA256 of "<timestamp>.<body>" keyed with secret
+// Including the timestamp lets receivers reject replays of old deliveries
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// DeliverWebhook POSTs a signed event payload to url and returns the response status code
+// Any non-2xx response is returned as an error along with its status code
+// Headers sent:
+//   - X-Webhook-Event: the event name
+//   - X-Webhook-Delivery: the delivery ID, stable across retries so receivers can deduplicate
+//   - X-Webhook-Timestamp: unix seconds the signature was made at
+//   - X-Webhook-Signature: "sha256=" followed by SignWebhookPayload(secret, timestamp, body)
+func DeliverWebhook(ctx context.Context, url, secret, event, deliveryID string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "PersonalSiteWebhooks/1.0")
+	req.Header.Set("X-Webhook-Event", event)
+	req.Header.Set("X-Webhook-Delivery", deliveryID)
+	req.Header.Set("X-Webhook-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-Webhook-Signature", "sha256="+SignWebhookPayload(secret, timestamp, body))
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send webhook to %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	// Drain a little of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook %s returned status %d", url, resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
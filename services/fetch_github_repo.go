@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	githubAPIURL = "https://api.github.com"
+	// githubAPIVersion pins the shape of GitHub's responses
+	githubAPIVersion = "2022-11-28"
+	// maxGitHubReadmeSize caps how much of a README is read, well past any README meant for people
+	maxGitHubReadmeSize = 1 << 20
+)
+
+var githubClient = &http.Client{Timeout: 30 * time.Second}
+
+// ErrGitHubRepoNotFound is returned when GitHub has no such repository, or it's private and the token can't see it
+var ErrGitHubRepoNotFound = errors.New("GitHub repository not found")
+
+// GitHubRepo is what a project is filled in with from its GitHub repository
+type GitHubRepo struct {
+	Owner       string
+	Name        string
+	Description string
+	Homepage    string
+	HTMLURL     string
+	Topics      []string
+	Readme      string // The README's markdown, or empty when the repository has none
+}
+
+type githubRepoResponse struct {
+	Name  string `json:"name"`
+	Owner struct {
+		Login string `json:"login"`
+	} `json:"owner"`
+	Description *string  `json:"description"`
+	Homepage    *string  `json:"homepage"`
+	HTMLURL     string   `json:"html_url"`
+	Topics      []string `json:"topics"`
+}
+
+// ParseGitHubRepoURL returns the owner and name of the repository at rawURL, which can be its page, a page within
+// it or its clone URL, e.g. https://github.com/owner/repo/tree/main, github.com/owner/repo or
+// git@github.com:owner/repo.git
+func ParseGitHubRepoURL(rawURL string) (string, string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rest, ok := strings.CutPrefix(rawURL, "git@github.com:"); ok {
+		rawURL = "https://github.com/" + rest
+	} else if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", fmt.Errorf("%q isn't a URL", rawURL)
+	}
+	if host := strings.ToLower(parsed.Hostname()); host != "github.com" && host != "www.github.com" {
+		return "", "", fmt.Errorf("%q isn't a github.com URL", rawURL)
+	}
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(segments) < 2 || segments[0] == "" || segments[1] == "" {
+		return "", "", fmt.Errorf("%q doesn't name a repository, like https://github.com/owner/repo", rawURL)
+	}
+	return segments[0], strings.TrimSuffix(segments[1], ".git"), nil
+}
+
+// FetchGitHubRepo reads the repository owner/name and its README from the GitHub API. token is optional: without
+// one only public repositories can be read, at GitHub's lower rate limit for anonymous requests
+func FetchGitHubRepo(ctx context.Context, token, owner, name string) (GitHubRepo, error) {
+	path := "/repos/" + url.PathEscape(owner) + "/" + url.PathEscape(name)
+
+	var body []byte
+	status, err := githubRequest(ctx, token, path, "application/vnd.github+json", &body)
+	if err != nil {
+		return GitHubRepo{}, err
+	}
+	if status == http.StatusNotFound {
+		return GitHubRepo{}, ErrGitHubRepoNotFound
+	}
+	if status != http.StatusOK {
+		return GitHubRepo{}, githubError(status, body)
+	}
+	var response githubRepoResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return GitHubRepo{}, fmt.Errorf("failed to parse GitHub response: %w", err)
+	}
+
+	repo := GitHubRepo{
+		Owner:   response.Owner.Login,
+		Name:    response.Name,
+		HTMLURL: response.HTMLURL,
+		Topics:  response.Topics,
+	}
+	if response.Description != nil {
+		repo.Description = strings.TrimSpace(*response.Description)
+	}
+	if response.Homepage != nil {
+		repo.Homepage = strings.TrimSpace(*response.Homepage)
+	}
+
+	// The raw media type returns the README's markdown as it is, rather than base64 in JSON
+	status, err = githubRequest(ctx, token, path+"/readme", "application/vnd.github.raw+json", &body)
+	if err != nil {
+		return GitHubRepo{}, err
+	}
+	switch status {
+	case http.StatusOK:
+		repo.Readme = strings.TrimSpace(string(body))
+	case http.StatusNotFound:
+	default:
+		return GitHubRepo{}, githubError(status, body)
+	}
+	return repo, nil
+}
+
+// githubRequest GETs path from the GitHub API as accept, reading the response body into body and returning its status
+func githubRequest(ctx context.Context, token, path, accept string, body *[]byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, githubAPIURL+path, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create GitHub request: %w", err)
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("X-GitHub-Api-Version", githubAPIVersion)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := githubClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send request to GitHub: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if *body, err = io.ReadAll(io.LimitReader(resp.Body, maxGitHubReadmeSize)); err != nil {
+		return 0, fmt.Errorf("failed to read GitHub response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
+
+// githubError describes a GitHub API error response, calling out the rate limit, which is what anonymous requests
+// usually run into
+func githubError(status int, body []byte) error {
+	var response struct {
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(body, &response)
+	if (status == http.StatusForbidden || status == http.StatusTooManyRequests) && strings.Contains(strings.ToLower(response.Message), "rate limit") {
+		return fmt.Errorf("GitHub API rate limit exceeded; set GITHUB_TOKEN to raise it: %s", response.Message)
+	}
+	return fmt.Errorf("GitHub API error (status %d): %s", status, response.Message)
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// maxMetadataBodySize limits how much of a page is read when looking for metadata
+// The <head> is almost always within the first few hundred kilobytes
+const maxMetadataBodySize = 1 << 20 // 1MB
+
+// LinkMetadata holds the preview information extracted from a web page
+type LinkMetadata struct {
+	Title       string
+	Description string
+	ImageURL    string
+}
+
+// metadataClient only connects to public addresses so user-supplied URLs
+// can't be used to probe services on the host's private network
+var metadataClient = &http.Client{
+	Timeout: 10 * time.Second,
+	Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout: 5 * time.Second,
+			Control: rejectNonPublicAddress,
+		}).DialContext,
+		TLSHandshakeTimeout: 5 * time.Second,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 5 {
+			return errors.New("stopped after 5 redirects")
+		}
+		return nil
+	},
+}
+
+// FetchLinkMetadata downloads the page at pageURL and extracts its title, description and preview image
+// OpenGraph tags take precedence over the <title> element and the description meta tag.
+// Relative image URLs are resolved against the final page URL.
+func FetchLinkMetadata(ctx context.Context, pageURL string) (LinkMetadata, error) {
+	parsedURL, err := url.Parse(pageURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return LinkMetadata{}, fmt.Errorf("invalid URL %q: must be an absolute http(s) URL", pageURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsedURL.String(), nil)
+	if err != nil {
+		return LinkMetadata{}, fmt.Errorf("failed to create metadata request: %w", err)
+	}
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; PersonalSiteBot/1.0)")
+
+	resp, err := metadataClient.Do(req)
+	if err != nil {
+		return LinkMetadata{}, fmt.Errorf("failed to fetch %s: %w", pageURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return LinkMetadata{}, fmt.Errorf("fetching %s returned status %d", pageURL, resp.StatusCode)
+	}
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" && !strings.Contains(contentType, "html") {
+		return LinkMetadata{}, fmt.Errorf("%s is not an HTML page (content type %s)", pageURL, contentType)
+	}
+
+	metadata := parseLinkMetadata(io.LimitReader(resp.Body, maxMetadataBodySize))
+
+	if metadata.ImageURL != "" {
+		if imageURL, err := resp.Request.URL.Parse(metadata.ImageURL); err == nil {
+			metadata.ImageURL = imageURL.String()
+		}
+	}
+
+	return metadata, nil
+}
+
+// parseLinkMetadata scans the document's head for title, description and image tags
+func parseLinkMetadata(body io.Reader) LinkMetadata {
+	var title, description, ogTitle, ogDescription, ogImage string
+
+	tokenizer := html.NewTokenizer(body)
+	inTitle := false
+
+scan:
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			// io.EOF or a malformed document; use whatever was found so far
+			break scan
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			switch token.Data {
+			case "title":
+				inTitle = true
+			case "meta":
+				var key, content string
+				for _, attr := range token.Attr {
+					switch strings.ToLower(attr.Key) {
+					case "property", "name":
+						key = strings.ToLower(attr.Val)
+					case "content":
+						content = strings.TrimSpace(attr.Val)
+					}
+				}
+				switch key {
+				case "og:title":
+					ogTitle = content
+				case "og:description":
+					ogDescription = content
+				case "og:image", "og:image:url":
+					if ogImage == "" {
+						ogImage = content
+					}
+				case "description":
+					description = content
+				case "twitter:image":
+					if ogImage == "" {
+						ogImage = content
+					}
+				}
+			case "body":
+				// Metadata only lives in the head
+				break scan
+			}
+		case html.TextToken:
+			if inTitle && title == "" {
+				title = strings.TrimSpace(string(tokenizer.Text()))
+			}
+		case html.EndTagToken:
+			if tokenizer.Token().Data == "title" {
+				inTitle = false
+			}
+		}
+	}
+
+	return LinkMetadata{
+		Title:       firstNonEmpty(ogTitle, title),
+		Description: firstNonEmpty(ogDescription, description),
+		ImageURL:    ogImage,
+	}
+}
+
+// rejectNonPublicAddress is a net.Dialer Control hook that refuses loopback, private and link-local addresses
+func rejectNonPublicAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("refusing to connect to unresolved address %s", host)
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("refusing to connect to non-public address %s", ip)
+	}
+	return nil
+}
+
+// firstNonEmpty returns the first of values that is not empty
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	htmltomarkdown "github.com/JohannesKaufmann/html-to-markdown/v2"
+	"golang.org/x/net/html"
+)
+
+// MaxImportFileSize caps how much of one file in an export archive is read, so a crafted archive can't exhaust memory
+const MaxImportFileSize = 10 << 20
+
+// ImportedPost is a blog post read from another platform's export
+type ImportedPost struct {
+	Title        string
+	Summary      string
+	Content      string // Markdown
+	CanonicalURL string
+	PublishedAt  *time.Time // Nil for posts that were never published
+	ScheduledAt  *time.Time // When a post that isn't published yet is due to be, if it's scheduled
+	Tags         []string
+}
+
+// readImportFile reads at most MaxImportFileSize bytes of an archived file
+func readImportFile(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxImportFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxImportFileSize {
+		return nil, fmt.Errorf("file is larger than %d MB", MaxImportFileSize>>20)
+	}
+	return data, nil
+}
+
+// htmlToMarkdown converts node and everything in it to markdown
+func htmlToMarkdown(node *html.Node) (string, error) {
+	markdown, err := htmltomarkdown.ConvertNode(node)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert HTML to markdown: %w", err)
+	}
+	return strings.TrimSpace(string(markdown)), nil
+}
+
+// findElement returns the first element under node, node included, that match accepts
+func findElement(node *html.Node, match func(*html.Node) bool) *html.Node {
+	if node.Type == html.ElementNode && match(node) {
+		return node
+	}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if found := findElement(child, match); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// findElements returns every element under node, node included, that match accepts
+func findElements(node *html.Node, match func(*html.Node) bool) []*html.Node {
+	var found []*html.Node
+	if node.Type == html.ElementNode && match(node) {
+		found = append(found, node)
+	}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		found = append(found, findElements(child, match)...)
+	}
+	return found
+}
+
+// withClass matches elements that have class among their classes
+func withClass(class string) func(*html.Node) bool {
+	return func(node *html.Node) bool {
+		for _, name := range strings.Fields(attribute(node, "class")) {
+			if name == class {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func attribute(node *html.Node, name string) string {
+	for _, attr := range node.Attr {
+		if attr.Key == name {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+// textContent returns the text inside node with runs of whitespace collapsed
+func textContent(node *html.Node) string {
+	var text strings.Builder
+	var collect func(*html.Node)
+	collect = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			text.WriteString(n.Data)
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			collect(child)
+		}
+	}
+	collect(node)
+	return strings.Join(strings.Fields(text.String()), " ")
+}
+
+// removeNode detaches node from its parent, if it has one
+func removeNode(node *html.Node) {
+	if node != nil && node.Parent != nil {
+		node.Parent.RemoveChild(node)
+	}
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// MarkdownNote is a markdown file with optional YAML frontmatter, such as an Obsidian note or a post exported by
+// GET /blog-post/{id}/export.md
+type MarkdownNote struct {
+	ImportedPost
+	Slug    string // From the frontmatter, or derived from the title
+	Draft   bool
+	Publish *bool // Obsidian's publish property; false leaves the note out
+}
+
+// markdownFrontmatter holds the frontmatter keys that are read, which are the ones export.md writes along with the
+// common spellings Obsidian, Hugo and Jekyll use
+type markdownFrontmatter struct {
+	Title             string `yaml:"title"`
+	Slug              string `yaml:"slug"`
+	Summary           string `yaml:"summary"`
+	Description       string `yaml:"description"`
+	Date              string `yaml:"date"`
+	PublishAt         string `yaml:"publishAt"`
+	Draft             bool   `yaml:"draft"`
+	Publish           *bool  `yaml:"publish"`
+	Tags              any    `yaml:"tags"`
+	CanonicalURL      string `yaml:"canonicalURL"`
+	CanonicalURLSnake string `yaml:"canonical_url"`
+}
+
+// markdownDateLayouts are the date formats accepted in frontmatter, tried in order
+var markdownDateLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+
+// wikiLink matches Obsidian's [[Note]] and [[Note|label]] links, and ![[file]] embeds
+var wikiLink = regexp.MustCompile(`(!?)\[\[([^\]|]+)(?:\|([^\]]+))?\]\]`)
+
+// IsMarkdownFile reports whether name is a markdown note in an uploaded folder
+// Hidden folders, such as a vault's .obsidian settings and .trash, and macOS's __MACOSX metadata are left out
+func IsMarkdownFile(name string) bool {
+	for _, part := range strings.Split(path.Dir(name), "/") {
+		if (strings.HasPrefix(part, ".") && part != ".") || part == "__MACOSX" {
+			return false
+		}
+	}
+	ext := strings.ToLower(path.Ext(name))
+	return (ext == ".md" || ext == ".markdown") && !strings.HasPrefix(path.Base(name), ".")
+}
+
+// ParseMarkdownNote reads the note at name, whose file name is the title when the frontmatter has none
+// Wiki links are turned into their label, since the notes they point at may not be published; embeds are kept
+// as they are
+func ParseMarkdownNote(name string, r io.Reader) (MarkdownNote, error) {
+	data, err := readImportFile(r)
+	if err != nil {
+		return MarkdownNote{}, err
+	}
+	data = bytes.TrimPrefix(bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n")), []byte("\ufeff"))
+
+	var frontmatter markdownFrontmatter
+	body := string(data)
+	if rest, ok := strings.CutPrefix(body, "---\n"); ok {
+		end := strings.Index(rest, "\n---")
+		if end < 0 {
+			return MarkdownNote{}, fmt.Errorf("frontmatter is never closed with ---")
+		}
+		if err := yaml.Unmarshal([]byte(rest[:end]), &frontmatter); err != nil {
+			return MarkdownNote{}, fmt.Errorf("failed to read frontmatter: %w", err)
+		}
+		body = rest[end+len("\n---"):]
+		if newline := strings.IndexByte(body, '\n'); newline >= 0 {
+			body = body[newline+1:]
+		} else {
+			body = ""
+		}
+	}
+
+	note := MarkdownNote{
+		ImportedPost: ImportedPost{
+			Title:        strings.TrimSpace(frontmatter.Title),
+			Summary:      strings.TrimSpace(frontmatter.Summary),
+			CanonicalURL: strings.TrimSpace(frontmatter.CanonicalURL),
+		},
+		Slug:    strings.TrimSpace(frontmatter.Slug),
+		Draft:   frontmatter.Draft,
+		Publish: frontmatter.Publish,
+	}
+	if note.Title == "" {
+		note.Title = strings.TrimSuffix(path.Base(name), path.Ext(name))
+	}
+	if note.Slug == "" {
+		note.Slug = BlogPostSlug(note.Title)
+	}
+	if note.Summary == "" {
+		note.Summary = strings.TrimSpace(frontmatter.Description)
+	}
+	if note.CanonicalURL == "" {
+		note.CanonicalURL = strings.TrimSpace(frontmatter.CanonicalURLSnake)
+	}
+
+	if frontmatter.Date != "" {
+		if note.PublishedAt, err = parseMarkdownDate("date", frontmatter.Date); err != nil {
+			return MarkdownNote{}, err
+		}
+	}
+	if frontmatter.PublishAt != "" {
+		if note.ScheduledAt, err = parseMarkdownDate("publishAt", frontmatter.PublishAt); err != nil {
+			return MarkdownNote{}, err
+		}
+	}
+
+	// Tags are a list, or a single comma-separated string
+	switch tags := frontmatter.Tags.(type) {
+	case string:
+		note.Tags = strings.Split(tags, ",")
+	case []any:
+		for _, tag := range tags {
+			note.Tags = append(note.Tags, fmt.Sprint(tag))
+		}
+	}
+	for i, tag := range note.Tags {
+		note.Tags[i] = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+	}
+
+	note.Content = strings.TrimSpace(wikiLink.ReplaceAllStringFunc(body, func(link string) string {
+		match := wikiLink.FindStringSubmatch(link)
+		if match[1] == "!" {
+			return link
+		}
+		if match[3] != "" {
+			return match[3]
+		}
+		// [[Note#Heading]] links to a heading of a note
+		target, _, _ := strings.Cut(match[2], "#")
+		return target
+	}))
+	return note, nil
+}
+
+func parseMarkdownDate(key, value string) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range markdownDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			date = date.UTC()
+			return &date, nil
+		}
+	}
+	return nil, fmt.Errorf("%s %q isn't a date, use e.g. 2024-01-15 or 2024-01-15T10:00:00Z", key, value)
+}
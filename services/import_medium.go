@@ -0,0 +1,83 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// IsMediumPostFile reports whether name is a post in a Medium export archive
+// Medium exports each post as posts/<date>_<slug>.html and each draft as posts/draft_<slug>.html
+func IsMediumPostFile(name string) bool {
+	return path.Base(path.Dir(name)) == "posts" && strings.EqualFold(path.Ext(name), ".html")
+}
+
+// ParseMediumPost reads one post from a Medium export and converts its body to markdown
+// Drafts have no PublishedAt. Medium's export doesn't include tags, so Tags stays empty unless the HTML links any
+// with class p-category or rel=tag
+func ParseMediumPost(r io.Reader) (ImportedPost, error) {
+	data, err := readImportFile(r)
+	if err != nil {
+		return ImportedPost{}, err
+	}
+	doc, err := html.Parse(bytes.NewReader(data))
+	if err != nil {
+		return ImportedPost{}, fmt.Errorf("failed to parse HTML: %w", err)
+	}
+
+	article := findElement(doc, withClass("h-entry"))
+	if article == nil {
+		article = doc
+	}
+	body := findElement(article, withClass("e-content"))
+	if body == nil {
+		return ImportedPost{}, errors.New("no post body found, this doesn't look like a Medium post")
+	}
+
+	var post ImportedPost
+	if title := findElement(article, withClass("p-name")); title != nil {
+		post.Title = textContent(title)
+	} else if title := findElement(doc, func(n *html.Node) bool { return n.Data == "title" }); title != nil {
+		post.Title = textContent(title)
+	}
+	if summary := findElement(article, withClass("p-summary")); summary != nil {
+		post.Summary = textContent(summary)
+	}
+	if canonical := findElement(article, withClass("p-canonical")); canonical != nil {
+		post.CanonicalURL = attribute(canonical, "href")
+	}
+	if published := findElement(article, withClass("dt-published")); published != nil {
+		if publishedAt, err := time.Parse(time.RFC3339, attribute(published, "datetime")); err == nil {
+			post.PublishedAt = &publishedAt
+		}
+	}
+	for _, tag := range findElements(article, func(n *html.Node) bool {
+		return withClass("p-category")(n) || attribute(n, "rel") == "tag"
+	}) {
+		if value := textContent(tag); value != "" {
+			post.Tags = append(post.Tags, value)
+		}
+	}
+
+	// Medium repeats the title and subtitle at the top of the body and separates sections with rules
+	if title := findElement(body, withClass("graf--title")); title != nil && textContent(title) == post.Title {
+		removeNode(title)
+	}
+	if subtitle := findElement(body, withClass("graf--subtitle")); subtitle != nil && textContent(subtitle) == post.Summary {
+		removeNode(subtitle)
+	}
+	for _, divider := range findElements(body, withClass("section-divider")) {
+		removeNode(divider)
+	}
+
+	if post.Content, err = htmlToMarkdown(body); err != nil {
+		return ImportedPost{}, err
+	}
+	return post, nil
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// SubstackPost is one row of posts.csv in a Substack export
+type SubstackPost struct {
+	ID          string // e.g. 123456.hello-world, which also names the post's HTML file
+	Title       string
+	Subtitle    string
+	Type        string     // newsletter, podcast or thread
+	PublishedAt *time.Time // Nil for drafts
+}
+
+// Slug returns the part of the post's URL after /p/, e.g. hello-world
+func (p SubstackPost) Slug() string {
+	_, slug, found := strings.Cut(p.ID, ".")
+	if !found {
+		return p.ID
+	}
+	return slug
+}
+
+// IsSubstackPostList reports whether name is the list of posts in a Substack export archive
+func IsSubstackPostList(name string) bool {
+	return path.Base(name) == "posts.csv"
+}
+
+// SubstackPostFile returns where the archive keeps the body of post, next to the posts.csv at listName
+func SubstackPostFile(listName string, post SubstackPost) string {
+	return path.Join(path.Dir(listName), "posts", post.ID+".html")
+}
+
+// ParseSubstackPosts reads the rows of a Substack export's posts.csv
+func ParseSubstackPosts(r io.Reader) ([]SubstackPost, error) {
+	data, err := readImportFile(r)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read posts.csv: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("posts.csv is empty")
+	}
+
+	columns := map[string]int{}
+	for i, name := range rows[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, required := range []string{"post_id", "title"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("posts.csv has no %s column, this doesn't look like a Substack export", required)
+		}
+	}
+	column := func(row []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	posts := make([]SubstackPost, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		post := SubstackPost{
+			ID:       column(row, "post_id"),
+			Title:    column(row, "title"),
+			Subtitle: column(row, "subtitle"),
+			Type:     column(row, "type"),
+		}
+		if column(row, "is_published") == "true" {
+			if publishedAt, err := time.Parse(time.RFC3339, column(row, "post_date")); err == nil {
+				post.PublishedAt = &publishedAt
+			}
+		}
+		posts = append(posts, post)
+	}
+	return posts, nil
+}
+
+// ParseSubstackPost converts the HTML body of post to markdown
+// publicationURL, e.g. https://example.substack.com, makes the post's page on it the canonical URL when it's set
+// Subscribe buttons and widgets are dropped; images keep pointing at Substack's CDN
+func ParseSubstackPost(post SubstackPost, body io.Reader, publicationURL string) (ImportedPost, error) {
+	data, err := readImportFile(body)
+	if err != nil {
+		return ImportedPost{}, err
+	}
+	doc, err := html.Parse(bytes.NewReader(data))
+	if err != nil {
+		return ImportedPost{}, fmt.Errorf("failed to parse HTML: %w", err)
+	}
+
+	for _, class := range []string{"subscription-widget-wrap", "subscription-widget-wrap-editor", "button-wrapper"} {
+		for _, widget := range findElements(doc, withClass(class)) {
+			removeNode(widget)
+		}
+	}
+
+	imported := ImportedPost{
+		Title:       post.Title,
+		Summary:     post.Subtitle,
+		PublishedAt: post.PublishedAt,
+	}
+	if publicationURL != "" {
+		imported.CanonicalURL = strings.TrimSuffix(publicationURL, "/") + "/p/" + post.Slug()
+	}
+	if imported.Content, err = htmlToMarkdown(doc); err != nil {
+		return ImportedPost{}, err
+	}
+	return imported, nil
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// wordPressContentSpace is the namespace of content:encoded, which holds a post's body in every WXR version
+const wordPressContentSpace = "http://purl.org/rss/1.0/modules/content/"
+
+// wordPressDateLayout is the layout of wp:post_date and wp:post_date_gmt
+const wordPressDateLayout = "2006-01-02 15:04:05"
+
+// WordPressPost is a post read from a WordPress export (WXR) file
+type WordPressPost struct {
+	ImportedPost
+	ID          string   // WordPress's post ID
+	Attachments []string // URLs of the media attached to the post, its featured image first
+}
+
+// wxrItem is an item in a WXR file, which is a post, page, attachment or one of WordPress's internal types
+// Fields without a namespace match any, since it changes with the WXR version
+type wxrItem struct {
+	Title      string        `xml:"title"`
+	Link       string        `xml:"link"`
+	PubDate    string        `xml:"pubDate"`
+	Encoded    []wxrEncoded  `xml:"encoded"`
+	ID         string        `xml:"post_id"`
+	Date       string        `xml:"post_date"`
+	DateGMT    string        `xml:"post_date_gmt"`
+	Status     string        `xml:"status"`
+	Parent     string        `xml:"post_parent"`
+	Type       string        `xml:"post_type"`
+	URL        string        `xml:"attachment_url"`
+	Categories []wxrCategory `xml:"category"`
+	Meta       []wxrMeta     `xml:"postmeta"`
+}
+
+// wxrEncoded is content:encoded or excerpt:encoded, told apart by their namespace
+type wxrEncoded struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+type wxrCategory struct {
+	Domain   string `xml:"domain,attr"`
+	Nicename string `xml:"nicename,attr"`
+	Name     string `xml:",chardata"`
+}
+
+type wxrMeta struct {
+	Key   string `xml:"meta_key"`
+	Value string `xml:"meta_value"`
+}
+
+func (item wxrItem) meta(key string) string {
+	for _, meta := range item.Meta {
+		if meta.Key == key {
+			return strings.TrimSpace(meta.Value)
+		}
+	}
+	return ""
+}
+
+// date returns when the item was or is due to be published, preferring the GMT date, which drafts leave unset
+func (item wxrItem) date() *time.Time {
+	for _, value := range []string{item.DateGMT, item.Date} {
+		if date, err := time.Parse(wordPressDateLayout, strings.TrimSpace(value)); err == nil && date.Year() > 1 {
+			return &date
+		}
+	}
+	if date, err := time.Parse(time.RFC1123Z, strings.TrimSpace(item.PubDate)); err == nil {
+		date = date.UTC()
+		return &date
+	}
+	return nil
+}
+
+// ParseWordPressExport reads the posts in a WordPress export (WXR) file, from Tools > Export
+// Pages and WordPress's internal types are left out, as are trashed posts and auto-drafts. Posts and categories
+// both become tags, except for Uncategorized. Images in the body keep pointing at the WordPress site
+func ParseWordPressExport(r io.Reader) ([]WordPressPost, error) {
+	var export struct {
+		Items []wxrItem `xml:"channel>item"`
+	}
+	if err := xml.NewDecoder(r).Decode(&export); err != nil {
+		return nil, fmt.Errorf("failed to read WordPress export: %w", err)
+	}
+
+	attachments := map[string]string{}
+	attachmentsByParent := map[string][]string{}
+	for _, item := range export.Items {
+		if item.Type != "attachment" || item.URL == "" {
+			continue
+		}
+		url := strings.TrimSpace(item.URL)
+		attachments[item.ID] = url
+		attachmentsByParent[item.Parent] = append(attachmentsByParent[item.Parent], url)
+	}
+
+	var posts []WordPressPost
+	for _, item := range export.Items {
+		if item.Type != "post" || item.Status == "trash" || item.Status == "auto-draft" {
+			continue
+		}
+
+		post := WordPressPost{
+			ImportedPost: ImportedPost{
+				Title:        strings.TrimSpace(item.Title),
+				CanonicalURL: strings.TrimSpace(item.Link),
+			},
+			ID: strings.TrimSpace(item.ID),
+		}
+		switch item.Status {
+		case "publish":
+			post.PublishedAt = item.date()
+		case "future":
+			post.ScheduledAt = item.date()
+		}
+		if item.Status != "publish" {
+			// Only published posts have a page; the rest link to a preview
+			post.CanonicalURL = ""
+		}
+
+		for _, category := range item.Categories {
+			name := strings.TrimSpace(category.Name)
+			if (category.Domain == "post_tag" || category.Domain == "category") && name != "" && category.Nicename != "uncategorized" {
+				post.Tags = append(post.Tags, name)
+			}
+		}
+
+		if featured, ok := attachments[item.meta("_thumbnail_id")]; ok {
+			post.Attachments = append(post.Attachments, featured)
+		}
+		for _, url := range attachmentsByParent[item.ID] {
+			if len(post.Attachments) == 0 || url != post.Attachments[0] {
+				post.Attachments = append(post.Attachments, url)
+			}
+		}
+
+		var content string
+		for _, encoded := range item.Encoded {
+			switch {
+			case encoded.XMLName.Space == wordPressContentSpace:
+				content = encoded.Value
+			case strings.HasSuffix(encoded.XMLName.Space, "/excerpt/"):
+				post.Summary = strings.TrimSpace(encoded.Value)
+			}
+		}
+		doc, err := html.Parse(strings.NewReader(wordPressParagraphs(content)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse HTML of post %s: %w", post.ID, err)
+		}
+		if post.Content, err = htmlToMarkdown(doc); err != nil {
+			return nil, err
+		}
+
+		posts = append(posts, post)
+	}
+	return posts, nil
+}
+
+var (
+	wordPressBlankLines = regexp.MustCompile(`\n\s*\n`)
+	wordPressBlockTag   = regexp.MustCompile(`(?i)<(p|div|h[1-6]|ul|ol|blockquote|pre|table|figure)[\s>]|<!-- wp:`)
+)
+
+// wordPressParagraphs wraps the paragraphs of a classic editor post, which WordPress stores separated by blank
+// lines and only turns into HTML when it renders them. Block editor posts are already HTML and are left alone
+func wordPressParagraphs(content string) string {
+	if wordPressBlockTag.MatchString(content) {
+		return content
+	}
+	var paragraphs strings.Builder
+	for _, paragraph := range wordPressBlankLines.Split(strings.ReplaceAll(content, "\r\n", "\n"), -1) {
+		if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+			paragraphs.WriteString("<p>" + strings.ReplaceAll(paragraph, "\n", "<br>\n") + "</p>\n")
+		}
+	}
+	return paragraphs.String()
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const openLibrarySearchURL = "https://openlibrary.org/search.json"
+
+// OpenLibraryBook holds the metadata found for a book on OpenLibrary
+type OpenLibraryBook struct {
+	Key              string
+	Title            string
+	Author           string
+	ISBN             string
+	CoverURL         string
+	FirstPublishYear int
+}
+
+// openLibrarySearchResponse represents the parts of the OpenLibrary search API response we use
+type openLibrarySearchResponse struct {
+	NumFound int `json:"numFound"`
+	Docs     []struct {
+		Key              string   `json:"key"`
+		Title            string   `json:"title"`
+		AuthorName       []string `json:"author_name"`
+		ISBN             []string `json:"isbn"`
+		CoverID          int      `json:"cover_i"`
+		FirstPublishYear int      `json:"first_publish_year"`
+	} `json:"docs"`
+}
+
+var openLibraryClient = &http.Client{Timeout: 10 * time.Second}
+
+// LookupOpenLibraryBook searches OpenLibrary for a book, by ISBN when one is given and otherwise by title and author
+// It returns nil without an error when OpenLibrary has no match
+func LookupOpenLibraryBook(ctx context.Context, title, author, isbn string) (*OpenLibraryBook, error) {
+	query := url.Values{}
+	if isbn != "" {
+		query.Set("isbn", isbn)
+	} else {
+		query.Set("title", title)
+		if author != "" {
+			query.Set("author", author)
+		}
+	}
+	query.Set("limit", "1")
+	query.Set("fields", "key,title,author_name,isbn,cover_i,first_publish_year")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, openLibrarySearchURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OpenLibrary request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "PersonalSiteBot/1.0")
+
+	resp, err := openLibraryClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request to OpenLibrary: %w", err)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OpenLibrary response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("open library API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var searchResponse openLibrarySearchResponse
+	if err := json.Unmarshal(bodyBytes, &searchResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenLibrary response: %w", err)
+	}
+
+	if len(searchResponse.Docs) == 0 {
+		return nil, nil
+	}
+
+	doc := searchResponse.Docs[0]
+	book := &OpenLibraryBook{
+		Key:              doc.Key,
+		Title:            doc.Title,
+		Author:           strings.Join(doc.AuthorName, ", "),
+		FirstPublishYear: doc.FirstPublishYear,
+	}
+	if len(doc.ISBN) > 0 {
+		book.ISBN = doc.ISBN[0]
+	}
+	if doc.CoverID > 0 {
+		book.CoverURL = "https://covers.openlibrary.org/b/id/" + strconv.Itoa(doc.CoverID) + "-L.jpg"
+	}
+
+	return book, nil
+}
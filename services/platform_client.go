@@ -0,0 +1,38 @@
+package services
+
+import (
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/config"
+)
+
+// platformClient returns the client shared by every call to a social platform API
+// Sharing one transport keeps connections to each platform alive between posts instead of
+// paying for a new TCP and TLS handshake on every request. It is tuned with:
+//   - OUTBOUND_TIMEOUT (default 30s): limit for a whole request, response body included
+//   - OUTBOUND_MAX_IDLE_CONNS_PER_HOST (default 4): idle connections kept open to each platform
+//   - OUTBOUND_MAX_CONNS_PER_HOST (default 0, unlimited): connections open at once to each platform
+//   - OUTBOUND_IDLE_CONN_TIMEOUT (default 90s): how long an idle connection is kept
+var platformClient = sync.OnceValue(func() *http.Client {
+	c := config.New()
+	return &http.Client{
+		Timeout: config.GetDuration(c, "OUTBOUND_TIMEOUT", 30*time.Second),
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   10 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			MaxIdleConnsPerHost:   config.GetInt(c, "OUTBOUND_MAX_IDLE_CONNS_PER_HOST", 4),
+			MaxConnsPerHost:       config.GetInt(c, "OUTBOUND_MAX_CONNS_PER_HOST", 0),
+			IdleConnTimeout:       config.GetDuration(c, "OUTBOUND_IDLE_CONN_TIMEOUT", 90*time.Second),
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+	}
+})
@@ -1,13 +1,27 @@
 package services
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/rpupo63/unified-personal-site-backend/config"
 	"github.com/rpupo63/unified-personal-site-backend/models"
 	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
 )
 
+// defaultPlatformPostTimeout leaves room for Medium, which makes two requests of up to OUTBOUND_TIMEOUT each
+const defaultPlatformPostTimeout = time.Minute
+
+// BlogPostPlatforms are the platforms PostEverywhere can post a blog post to, in the order it posts them
+var BlogPostPlatforms = []string{"substack", "medium", "twitter", "linkedin"}
+
+// NotePlatforms are the platforms PostNoteEverywhere can post a note to, in the order it posts them
+var NotePlatforms = []string{"twitter", "mastodon"}
+
 // contains checks if a string slice contains a specific string
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
@@ -18,84 +32,67 @@ func contains(slice []string, item string) bool {
 	return false
 }
 
+// PlatformResult is the outcome of posting to one platform
+// Err is nil when the post went through
+type PlatformResult struct {
+	Platform string
+	Err      error
+}
+
 // PostEverywhere posts a blog post to selected social media platforms
 // It calls PostToSubstack, PostToMedium, PostToTwitter, and PostToLinkedIn
 // based on the platforms specified in the platformsToPost parameter.
+// The platforms are posted to concurrently, each limited to PLATFORM_POST_TIMEOUT,
+// so the slowest platform bounds how long this takes rather than all of them added up.
 //
 // Parameters:
+//   - ctx: Cancels every post still in flight when done
 //   - blogPost: The blog post to share
 //   - tags: List of tags associated with the blog post
 //   - mainImageURL: Optional URL of the main image for the post (required for Substack)
 //   - platformsToPost: Slice of platform names to post to. Valid values: "substack", "medium", "twitter", "linkedin"
 //     If empty or nil, no platforms will be posted to. Platform names are case-insensitive.
+//   - links: Optional URL per lowercase platform name to link to instead of the post's own URL, such as a
+//     tracked share link. Only used by Twitter and LinkedIn; Medium and Substack keep the canonical URL.
 //
 // Returns:
+//   - []PlatformResult: One result per selected platform, always in the order Substack, Medium, Twitter, LinkedIn
 //   - error: Combined error message if any platform failed, nil if all succeeded
-//     Individual platform errors are logged but the function continues to attempt
-//     posting to all selected platforms even if some fail.
-func PostEverywhere(blogPost models.BlogPost, tags []models.BlogTag, mainImageURL string, platformsToPost []string) error {
-	var errors []string
-	var successes []string
+//     Individual platform errors are logged, and a platform failing doesn't stop
+//     the others from being posted to.
+func PostEverywhere(ctx context.Context, blogPost models.BlogPost, tags []models.BlogTag, mainImageURL string, platformsToPost []string, links map[string]string) ([]PlatformResult, error) {
+	var posts []platformPost
 
-	// Post to Substack (requires mainImageURL)
+	// Substack requires mainImageURL
 	if contains(platformsToPost, "substack") {
-		if mainImageURL != "" {
-			log.Info().Msg("Posting to Substack...")
-			if err := PostToSubstack(blogPost, tags, mainImageURL); err != nil {
-				log.Error().Err(err).Msg("Failed to post to Substack")
-				errors = append(errors, fmt.Sprintf("Substack: %v", err))
-			} else {
-				successes = append(successes, "Substack")
-			}
-		} else {
-			log.Warn().Msg("Skipping Substack: mainImageURL is required but not provided")
-			errors = append(errors, "Substack: mainImageURL is required but not provided")
+		post := platformPost{platform: "substack", name: "Substack", post: func(ctx context.Context) error {
+			return PostToSubstack(ctx, blogPost, tags, mainImageURL)
+		}}
+		if mainImageURL == "" {
+			post.skipped = fmt.Errorf("mainImageURL is required but not provided")
 		}
+		posts = append(posts, post)
 	}
-
-	// Post to Medium
 	if contains(platformsToPost, "medium") {
-		log.Info().Msg("Posting to Medium...")
-		if err := PostToMedium(blogPost, tags); err != nil {
-			log.Error().Err(err).Msg("Failed to post to Medium")
-			errors = append(errors, fmt.Sprintf("Medium: %v", err))
-		} else {
-			successes = append(successes, "Medium")
-		}
+		posts = append(posts, platformPost{platform: "medium", name: "Medium", post: func(ctx context.Context) error {
+			return PostToMedium(ctx, blogPost, tags)
+		}})
 	}
-
-	// Post to Twitter
 	if contains(platformsToPost, "twitter") {
-		log.Info().Msg("Posting to Twitter...")
-		if err := PostToTwitter(blogPost, tags); err != nil {
-			log.Error().Err(err).Msg("Failed to post to Twitter")
-			errors = append(errors, fmt.Sprintf("Twitter: %v", err))
-		} else {
-			successes = append(successes, "Twitter")
-		}
+		posts = append(posts, platformPost{platform: "twitter", name: "Twitter", post: func(ctx context.Context) error {
+			return PostToTwitter(ctx, withLink(blogPost, links, "twitter"), tags)
+		}})
 	}
-
-	// Post to LinkedIn
 	if contains(platformsToPost, "linkedin") {
-		log.Info().Msg("Posting to LinkedIn...")
-		if err := PostToLinkedIn(blogPost, tags); err != nil {
-			log.Error().Err(err).Msg("Failed to post to LinkedIn")
-			errors = append(errors, fmt.Sprintf("LinkedIn: %v", err))
-		} else {
-			successes = append(successes, "LinkedIn")
-		}
+		posts = append(posts, platformPost{platform: "linkedin", name: "LinkedIn", post: func(ctx context.Context) error {
+			return PostToLinkedIn(ctx, withLink(blogPost, links, "linkedin"), tags)
+		}})
 	}
 
-	// Log summary
-	if len(successes) > 0 {
-		log.Info().Strs("platforms", successes).Msg("Successfully posted to platforms")
-	}
-
-	// Return combined error if any failures occurred
-	if len(errors) > 0 {
-		errorMsg := fmt.Sprintf("some platforms failed: %s", strings.Join(errors, "; "))
-		log.Error().Msg(errorMsg)
-		return fmt.Errorf("some platforms failed: %s", strings.Join(errors, "; "))
+	results, err := postConcurrently(ctx, "", posts)
+	if err != nil {
+		log.Error().Msg(err.Error())
+		return results, err
 	}
 
 	if len(platformsToPost) > 0 {
@@ -103,5 +100,101 @@ func PostEverywhere(blogPost models.BlogPost, tags []models.BlogTag, mainImageUR
 	} else {
 		log.Info().Msg("No platforms selected for posting")
 	}
-	return nil
+	return results, nil
+}
+
+// withLink returns blogPost with its URL replaced by the link given for platform, if there is one
+func withLink(blogPost models.BlogPost, links map[string]string, platform string) models.BlogPost {
+	if link := links[platform]; link != "" {
+		blogPost.URL = &link
+	}
+	return blogPost
+}
+
+// PostNoteEverywhere cross-posts a note to selected short-form platforms
+// It calls PostNoteToTwitter and PostNoteToMastodon based on the platforms specified in platformsToPost,
+// concurrently and each limited to PLATFORM_POST_TIMEOUT, like PostEverywhere.
+//
+// Parameters:
+//   - ctx: Cancels every post still in flight when done
+//   - note: The note to share
+//   - platformsToPost: Slice of platform names to post to. Valid values: "twitter", "mastodon"
+//     If empty or nil, no platforms will be posted to. Platform names are case-insensitive.
+//
+// Returns:
+//   - []PlatformResult: One result per selected platform, always in the order Twitter, Mastodon
+//   - error: Combined error message if any platform failed, nil if all succeeded
+func PostNoteEverywhere(ctx context.Context, note models.Note, platformsToPost []string) ([]PlatformResult, error) {
+	var posts []platformPost
+	if contains(platformsToPost, "twitter") {
+		posts = append(posts, platformPost{platform: "twitter", name: "Twitter", post: func(ctx context.Context) error {
+			return PostNoteToTwitter(ctx, note)
+		}})
+	}
+	if contains(platformsToPost, "mastodon") {
+		posts = append(posts, platformPost{platform: "mastodon", name: "Mastodon", post: func(ctx context.Context) error {
+			return PostNoteToMastodon(ctx, note)
+		}})
+	}
+
+	return postConcurrently(ctx, "note ", posts)
+}
+
+// platformPost is a post to one platform that postConcurrently runs
+type platformPost struct {
+	platform string // Lowercase name, as in PlatformResult
+	name     string // Display name, for logs and errors
+	post     func(ctx context.Context) error
+	skipped  error // Why the post can't be attempted, in which case post isn't called
+}
+
+// postConcurrently runs posts at once, each under its own PLATFORM_POST_TIMEOUT, and waits for all of them
+// A post failing or timing out doesn't cancel the others. Results, and the failures in the combined error, are in
+// the order posts are given whichever platform answers first; kind is prefixed to "to <platform>" in logs
+func postConcurrently(ctx context.Context, kind string, posts []platformPost) ([]PlatformResult, error) {
+	timeout := config.GetDuration(config.New(), "PLATFORM_POST_TIMEOUT", defaultPlatformPostTimeout)
+	results := make([]PlatformResult, len(posts))
+
+	var group errgroup.Group
+	for i, p := range posts {
+		results[i] = PlatformResult{Platform: p.platform, Err: p.skipped}
+		if p.skipped != nil {
+			log.Warn().Msgf("Skipping %s: %v", p.name, p.skipped)
+			continue
+		}
+		group.Go(func() error {
+			postCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			log.Info().Msgf("Posting %sto %s...", kind, p.name)
+			err := p.post(postCtx)
+			if err != nil && errors.Is(postCtx.Err(), context.DeadlineExceeded) {
+				err = fmt.Errorf("timed out after %s: %w", timeout, err)
+			}
+			if err != nil {
+				log.Error().Err(err).Msgf("Failed to post %sto %s", kind, p.name)
+			}
+			// Each goroutine writes only its own slot, so results needs no lock
+			results[i].Err = err
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	var failures []string
+	var successes []string
+	for i, result := range results {
+		if result.Err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", posts[i].name, result.Err))
+		} else {
+			successes = append(successes, posts[i].name)
+		}
+	}
+	if len(successes) > 0 {
+		log.Info().Strs("platforms", successes).Msgf("Successfully posted %sto platforms", kind)
+	}
+	if len(failures) > 0 {
+		return results, fmt.Errorf("some platforms failed: %s", strings.Join(failures, "; "))
+	}
+	return results, nil
 }
@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -36,7 +37,7 @@ type LinkedInErrorResponse struct {
 //   - LINKEDIN_BASE_URL: Optional platform-specific base URL (fallback for backward compatibility)
 //
 // Note: If tags parameter is empty, it will use blogPost.Tags if available
-func PostToLinkedIn(blogPost models.BlogPost, tags []models.BlogTag) error {
+func PostToLinkedIn(ctx context.Context, blogPost models.BlogPost, tags []models.BlogTag) error {
 	// Load .env file from backend root directory
 	// Try multiple possible paths to find the .env file
 	possiblePaths := []string{
@@ -59,7 +60,7 @@ func PostToLinkedIn(blogPost models.BlogPost, tags []models.BlogTag) error {
 	}
 
 	// Get config from environment variables
-	cfg := config.New()
+	cfg := withStoredCredentials(config.New())
 
 	// Get required configuration
 	accessToken := config.GetString(cfg, "LINKEDIN_ACCESS_TOKEN", "")
@@ -96,7 +97,7 @@ func PostToLinkedIn(blogPost models.BlogPost, tags []models.BlogTag) error {
 	}
 
 	// Create HTTP request
-	req, err := http.NewRequest("POST", "https://api.linkedin.com/v2/ugcPosts", bytes.NewBuffer(jsonPayload))
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.linkedin.com/v2/ugcPosts", bytes.NewBuffer(jsonPayload))
 	if err != nil {
 		return fmt.Errorf("failed to create LinkedIn API request: %w", err)
 	}
@@ -107,8 +108,7 @@ func PostToLinkedIn(blogPost models.BlogPost, tags []models.BlogTag) error {
 	req.Header.Set("X-Restli-Protocol-Version", "2.0.0")
 
 	// Send request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := platformClient().Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to send request to LinkedIn API: %w", err)
 	}
@@ -153,8 +153,12 @@ func buildLinkedInPostText(blogPost models.BlogPost, tags []models.BlogTag, base
 	if blogPost.Summary != nil && *blogPost.Summary != "" {
 		parts = append(parts, *blogPost.Summary)
 	} else if blogPost.Content != "" {
+		// Content is Markdown, which LinkedIn would show as written, so only its text is posted
+		content, err := MarkdownPlainText(blogPost.Content)
+		if err != nil {
+			content = blogPost.Content
+		}
 		// Truncate content to reasonable length for LinkedIn (max ~3000 chars total)
-		content := blogPost.Content
 		maxContentLength := 2000 // Leave room for title, tags, and URL
 		if len(content) > maxContentLength {
 			// Try to truncate at a sentence boundary
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/joho/godotenv"
+	"github.com/rpupo63/unified-personal-site-backend/config"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog/log"
+)
+
+// mastodonStatusLimit is the default character limit for statuses on a Mastodon instance
+const mastodonStatusLimit = 500
+
+// MastodonStatusResponse represents the response from the Mastodon statuses API
+type MastodonStatusResponse struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
+
+// MastodonErrorResponse represents an error response from the Mastodon API
+type MastodonErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// PostNoteToMastodon posts a note to Mastodon as a public status
+// Notes that exceed the status limit, or have an image, are truncated and linked back to the note on the site
+// Loads configuration from .env file in the backend root directory
+// Requires environment variables in .env:
+//   - MASTODON_INSTANCE_URL: Base URL of your Mastodon instance (e.g., "https://mastodon.social")
+//   - MASTODON_ACCESS_TOKEN: Access token for an application with the write:statuses scope
+//   - BASE_URL: Optional unified base URL for constructing note links (defaults to empty if not set)
+func PostNoteToMastodon(ctx context.Context, note models.Note) error {
+	// Load .env file from backend root directory
+	// Try multiple possible paths to find the .env file
+	possiblePaths := []string{
+		".env",                           // Current directory (if running from backend/)
+		filepath.Join("..", ".env"),      // Parent directory
+		filepath.Join("backend", ".env"), // backend/.env from project root
+	}
+
+	var envLoaded bool
+	for _, envPath := range possiblePaths {
+		if err := godotenv.Load(envPath); err == nil {
+			envLoaded = true
+			log.Debug().Str("path", envPath).Msg("Loaded .env file")
+			break
+		}
+	}
+
+	if !envLoaded {
+		log.Debug().Msg("No .env file found, using system environment variables (e.g., from Coolify)")
+	}
+
+	// Get config from environment variables
+	cfg := withStoredCredentials(config.New())
+
+	instanceURL := strings.TrimSuffix(config.GetString(cfg, "MASTODON_INSTANCE_URL", ""), "/")
+	accessToken := config.GetString(cfg, "MASTODON_ACCESS_TOKEN", "")
+
+	if instanceURL == "" {
+		return fmt.Errorf("MASTODON_INSTANCE_URL environment variable is required")
+	}
+	if accessToken == "" {
+		return fmt.Errorf("MASTODON_ACCESS_TOKEN environment variable is required")
+	}
+
+	baseURL := GetBaseURL(cfg, "")
+
+	payload := map[string]interface{}{
+		"status":     buildMastodonNoteText(note, baseURL),
+		"visibility": "public",
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal Mastodon payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", instanceURL+"/api/v1/statuses", bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return fmt.Errorf("failed to create Mastodon API request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	// Prevents duplicate statuses if the request is retried
+	req.Header.Set("Idempotency-Key", note.ID.String())
+
+	resp, err := platformClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request to Mastodon API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read Mastodon API response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errorResp MastodonErrorResponse
+		if err := json.Unmarshal(bodyBytes, &errorResp); err == nil && errorResp.Error != "" {
+			return fmt.Errorf("mastodon API error (status %d): %s", resp.StatusCode, errorResp.Error)
+		}
+		return fmt.Errorf("mastodon API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var statusResponse MastodonStatusResponse
+	if err := json.Unmarshal(bodyBytes, &statusResponse); err != nil {
+		log.Warn().Err(err).Msg("Failed to parse Mastodon status response, but status was created")
+	} else {
+		log.Info().Str("statusId", statusResponse.ID).Str("url", statusResponse.URL).Msg("Successfully posted to Mastodon")
+	}
+
+	return nil
+}
+
+// buildMastodonNoteText constructs the status text for a note
+// Mastodon counts every URL as 23 characters, the same as Twitter
+func buildMastodonNoteText(note models.Note, baseURL string) string {
+	noteURL := BuildNoteURL(baseURL, note.ID.String())
+	content := strings.TrimSpace(note.Content)
+	contentLength := len([]rune(content))
+
+	if contentLength <= mastodonStatusLimit && (note.ImageURL == nil || noteURL == "") {
+		return content
+	}
+	if noteURL == "" {
+		return truncateRunes(content, mastodonStatusLimit-3) + "..."
+	}
+
+	// Leave room for the URL (23 chars) and the separating blank line
+	availableSpace := mastodonStatusLimit - 23 - 2
+	if contentLength > availableSpace {
+		content = truncateRunes(content, availableSpace-3) + "..."
+	}
+
+	return content + "\n\n" + noteURL
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -56,7 +57,7 @@ type MediumErrorResponse struct {
 //
 // Optional environment variables:
 //   - BASE_URL: Optional unified base URL for constructing blog post links (defaults to empty if not set)
-func PostToMedium(blogPost models.BlogPost, tags []models.BlogTag) error {
+func PostToMedium(ctx context.Context, blogPost models.BlogPost, tags []models.BlogTag) error {
 	// Load .env file from backend root directory
 	// Try multiple possible paths to find the .env file
 	possiblePaths := []string{
@@ -79,7 +80,7 @@ func PostToMedium(blogPost models.BlogPost, tags []models.BlogTag) error {
 	}
 
 	// Get config from environment variables
-	cfg := config.New()
+	cfg := withStoredCredentials(config.New())
 
 	// Get required configuration
 	integrationToken := config.GetString(cfg, "MEDIUM_INTEGRATION_TOKEN", "")
@@ -100,7 +101,7 @@ func PostToMedium(blogPost models.BlogPost, tags []models.BlogTag) error {
 	}
 
 	// First, get the user ID by calling /me endpoint
-	userID, err := getMediumUserID(integrationToken)
+	userID, err := getMediumUserID(ctx, integrationToken)
 	if err != nil {
 		return fmt.Errorf("failed to get Medium user ID: %w", err)
 	}
@@ -118,7 +119,7 @@ func PostToMedium(blogPost models.BlogPost, tags []models.BlogTag) error {
 
 	// Create HTTP request to create post
 	url := fmt.Sprintf("https://api.medium.com/v1/users/%s/posts", userID)
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonPayload))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonPayload))
 	if err != nil {
 		return fmt.Errorf("failed to create Medium API request: %w", err)
 	}
@@ -130,8 +131,7 @@ func PostToMedium(blogPost models.BlogPost, tags []models.BlogTag) error {
 	req.Header.Set("Accept-Charset", "utf-8")
 
 	// Send request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := platformClient().Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to send request to Medium API: %w", err)
 	}
@@ -170,8 +170,8 @@ func PostToMedium(blogPost models.BlogPost, tags []models.BlogTag) error {
 }
 
 // getMediumUserID retrieves the user ID from Medium API /me endpoint
-func getMediumUserID(integrationToken string) (string, error) {
-	req, err := http.NewRequest("GET", "https://api.medium.com/v1/me", nil)
+func getMediumUserID(ctx context.Context, integrationToken string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://api.medium.com/v1/me", nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to create Medium API request: %w", err)
 	}
@@ -182,8 +182,7 @@ func getMediumUserID(integrationToken string) (string, error) {
 	req.Header.Set("Accept-Charset", "utf-8")
 
 	// Send request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := platformClient().Do(req)
 	if err != nil {
 		return "", fmt.Errorf("failed to send request to Medium API: %w", err)
 	}
@@ -221,10 +220,15 @@ func getMediumUserID(integrationToken string) (string, error) {
 
 // buildMediumPayload constructs the Medium API payload
 func buildMediumPayload(blogPost models.BlogPost, tags []models.BlogTag, contentFormat, publishStatus, baseURL string) map[string]interface{} {
+	// Content is Markdown, so html posts send the rendered copy of it
+	content := blogPost.Content
+	if contentFormat == "html" {
+		content = blogPost.ContentHTML
+	}
 	payload := map[string]interface{}{
 		"title":         blogPost.Title,
 		"contentFormat": contentFormat,
-		"content":       blogPost.Content,
+		"content":       content,
 		"publishStatus": publishStatus,
 	}
 
@@ -2,13 +2,13 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"path/filepath"
 	"strings"
-	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/rpupo63/unified-personal-site-backend/config"
@@ -37,7 +37,7 @@ type SubstackErrorResponse struct {
 //
 // Optional environment variables:
 //   - BASE_URL: Optional unified base URL for constructing blog post links (defaults to empty if not set)
-func PostToSubstack(blogPost models.BlogPost, tags []models.BlogTag, mainImageURL string) error {
+func PostToSubstack(ctx context.Context, blogPost models.BlogPost, tags []models.BlogTag, mainImageURL string) error {
 	// 1. Load Configuration (Copying logic from your LinkedIn function)
 	possiblePaths := []string{
 		".env",
@@ -57,7 +57,7 @@ func PostToSubstack(blogPost models.BlogPost, tags []models.BlogTag, mainImageUR
 		log.Debug().Msg("No .env file found, using system environment variables (e.g., from Coolify)")
 	}
 
-	cfg := config.New()
+	cfg := withStoredCredentials(config.New())
 
 	// 2. Get Substack Specific Credentials
 	cookie := config.GetString(cfg, "SUBSTACK_COOKIE", "")
@@ -113,7 +113,7 @@ func PostToSubstack(blogPost models.BlogPost, tags []models.BlogTag, mainImageUR
 	// Note: This endpoint is reverse-engineered and unofficial
 	url := fmt.Sprintf("https://%s.substack.com/api/v1/posts", subdomain)
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonPayload))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonPayload))
 	if err != nil {
 		return fmt.Errorf("failed to create Substack request: %w", err)
 	}
@@ -125,8 +125,7 @@ func PostToSubstack(blogPost models.BlogPost, tags []models.BlogTag, mainImageUR
 	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36")
 
 	// 7. Send Request
-	client := &http.Client{Timeout: 30 * time.Second}
-	resp, err := client.Do(req)
+	resp, err := platformClient().Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to send request to Substack: %w", err)
 	}
@@ -153,7 +152,7 @@ func PostToSubstack(blogPost models.BlogPost, tags []models.BlogTag, mainImageUR
 	return nil
 }
 
-// buildSubstackHtml converts the raw text content into simple HTML
+// buildSubstackHtml builds the post body from the rendered content
 // and embeds the main image at the top if provided. Also includes tags as hashtags.
 func buildSubstackHtml(blogPost models.BlogPost, tags []models.BlogTag, imageURL, baseURL string) string {
 	var sb strings.Builder
@@ -165,20 +164,8 @@ func buildSubstackHtml(blogPost models.BlogPost, tags []models.BlogTag, imageURL
 	}
 
 	// 2. Add Content
-	// Assuming blogPost.Content is plain text. We wrap paragraphs.
-	// If blogPost.Content is already HTML, you can append it directly.
-	content := blogPost.Content
-	if !strings.Contains(content, "<p>") {
-		// rudimentary plain-text to HTML conversion
-		paragraphs := strings.Split(content, "\n\n")
-		for _, p := range paragraphs {
-			if strings.TrimSpace(p) != "" {
-				sb.WriteString(fmt.Sprintf("<p>%s</p>", p))
-			}
-		}
-	} else {
-		sb.WriteString(content)
-	}
+	// blogPost.Content is Markdown, so the copy rendered when it was saved is sent
+	sb.WriteString(blogPost.ContentHTML)
 
 	// 3. Add Tags as Hashtags (if available)
 	// Include tags in the HTML body so they're visible even if the API doesn't support the tags field
@@ -46,7 +46,30 @@ type TwitterErrorResponse struct {
 //   - TWITTER_ACCESS_TOKEN_SECRET: OAuth 1.0a Access Token Secret
 //   - BASE_URL: Optional unified base URL for constructing blog post links (defaults to empty if not set)
 //   - TWITTER_BASE_URL: Optional platform-specific base URL (fallback for backward compatibility)
-func PostToTwitter(blogPost models.BlogPost, tags []models.BlogTag) error {
+func PostToTwitter(ctx context.Context, blogPost models.BlogPost, tags []models.BlogTag) error {
+	cfg := withStoredCredentials(loadTwitterConfig())
+
+	baseURL := GetBaseURL(cfg, "twitter")
+
+	// Construct the post text
+	postText := buildTwitterPostText(blogPost, tags, baseURL)
+
+	return sendTweet(ctx, cfg, postText)
+}
+
+// PostNoteToTwitter posts a note to Twitter as a plain tweet using the Twitter API v2
+// Notes longer than 280 characters, or with an image, are truncated and linked back to the note on the site
+// Requires the same environment variables as PostToTwitter
+func PostNoteToTwitter(ctx context.Context, note models.Note) error {
+	cfg := withStoredCredentials(loadTwitterConfig())
+
+	baseURL := GetBaseURL(cfg, "twitter")
+
+	return sendTweet(ctx, cfg, buildTwitterNoteText(note, baseURL))
+}
+
+// loadTwitterConfig loads the .env file from the backend root directory and returns the configuration
+func loadTwitterConfig() map[string]string {
 	// Load .env file from backend root directory
 	// Try multiple possible paths to find the .env file
 	possiblePaths := []string{
@@ -69,32 +92,16 @@ func PostToTwitter(blogPost models.BlogPost, tags []models.BlogTag) error {
 	}
 
 	// Get config from environment variables
-	cfg := config.New()
-
-	// Get required OAuth 1.0a configuration
-	apiKey := config.GetString(cfg, "TWITTER_API_KEY", "")
-	apiKeySecret := config.GetString(cfg, "TWITTER_API_KEY_SECRET", "")
-	accessToken := config.GetString(cfg, "TWITTER_ACCESS_TOKEN", "")
-	accessTokenSecret := config.GetString(cfg, "TWITTER_ACCESS_TOKEN_SECRET", "")
+	return config.New()
+}
 
-	if apiKey == "" {
-		return fmt.Errorf("TWITTER_API_KEY environment variable is required")
-	}
-	if apiKeySecret == "" {
-		return fmt.Errorf("TWITTER_API_KEY_SECRET environment variable is required")
-	}
-	if accessToken == "" {
-		return fmt.Errorf("TWITTER_ACCESS_TOKEN environment variable is required")
-	}
-	if accessTokenSecret == "" {
-		return fmt.Errorf("TWITTER_ACCESS_TOKEN_SECRET environment variable is required")
+// sendTweet publishes postText as a tweet, signing the request with the OAuth 1.0a credentials from cfg
+func sendTweet(ctx context.Context, cfg map[string]string, postText string) error {
+	httpClient, err := newTwitterClient(ctx, cfg)
+	if err != nil {
+		return err
 	}
 
-	baseURL := GetBaseURL(cfg, "twitter")
-
-	// Construct the post text
-	postText := buildTwitterPostText(blogPost, tags, baseURL)
-
 	// Build the Twitter API payload
 	payload := buildTwitterPayload(postText)
 
@@ -105,7 +112,7 @@ func PostToTwitter(blogPost models.BlogPost, tags []models.BlogTag) error {
 	}
 
 	// Create HTTP request
-	req, err := http.NewRequest("POST", "https://api.twitter.com/2/tweets", bytes.NewBuffer(jsonPayload))
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.twitter.com/2/tweets", bytes.NewBuffer(jsonPayload))
 	if err != nil {
 		return fmt.Errorf("failed to create Twitter API request: %w", err)
 	}
@@ -113,13 +120,6 @@ func PostToTwitter(blogPost models.BlogPost, tags []models.BlogTag) error {
 	// Set Content-Type header
 	req.Header.Set("Content-Type", "application/json")
 
-	// Configure OAuth 1.0a
-	oauthConfig := oauth1.NewConfig(apiKey, apiKeySecret)
-	oauthToken := oauth1.NewToken(accessToken, accessTokenSecret)
-
-	// Sign the request with OAuth 1.0a
-	httpClient := oauthConfig.Client(context.Background(), oauthToken)
-
 	// Send request
 	resp, err := httpClient.Do(req)
 	if err != nil {
@@ -158,6 +158,37 @@ func PostToTwitter(blogPost models.BlogPost, tags []models.BlogTag) error {
 	return nil
 }
 
+// newTwitterClient returns a client that signs requests with the OAuth 1.0a credentials from cfg, sending them over
+// the shared platform transport
+func newTwitterClient(ctx context.Context, cfg map[string]string) (*http.Client, error) {
+	// Get required OAuth 1.0a configuration
+	apiKey := config.GetString(cfg, "TWITTER_API_KEY", "")
+	apiKeySecret := config.GetString(cfg, "TWITTER_API_KEY_SECRET", "")
+	accessToken := config.GetString(cfg, "TWITTER_ACCESS_TOKEN", "")
+	accessTokenSecret := config.GetString(cfg, "TWITTER_ACCESS_TOKEN_SECRET", "")
+
+	if apiKey == "" {
+		return nil, fmt.Errorf("TWITTER_API_KEY environment variable is required")
+	}
+	if apiKeySecret == "" {
+		return nil, fmt.Errorf("TWITTER_API_KEY_SECRET environment variable is required")
+	}
+	if accessToken == "" {
+		return nil, fmt.Errorf("TWITTER_ACCESS_TOKEN environment variable is required")
+	}
+	if accessTokenSecret == "" {
+		return nil, fmt.Errorf("TWITTER_ACCESS_TOKEN_SECRET environment variable is required")
+	}
+
+	// Configure OAuth 1.0a
+	oauthConfig := oauth1.NewConfig(apiKey, apiKeySecret)
+	oauthToken := oauth1.NewToken(accessToken, accessTokenSecret)
+
+	httpClient := oauthConfig.Client(context.WithValue(ctx, oauth1.HTTPClient, platformClient()), oauthToken)
+	httpClient.Timeout = platformClient().Timeout
+	return httpClient, nil
+}
+
 // calculateTwitterLength calculates the effective length of text for Twitter's 280 char limit
 // URLs count as 23 characters regardless of their actual length
 func calculateTwitterLength(text string) int {
@@ -361,6 +392,29 @@ func buildTwitterPostText(blogPost models.BlogPost, tags []models.BlogTag, baseU
 	return postText
 }
 
+// buildTwitterNoteText constructs the tweet text for a note
+// The note is posted as-is when it fits; otherwise it is truncated and the note's URL is appended
+func buildTwitterNoteText(note models.Note, baseURL string) string {
+	noteURL := BuildNoteURL(baseURL, note.ID.String())
+	content := strings.TrimSpace(note.Content)
+
+	// Images can't be attached without the media upload API, so link to the note instead
+	if calculateTwitterLength(content) <= 280 && (note.ImageURL == nil || noteURL == "") {
+		return content
+	}
+	if noteURL == "" {
+		return truncateRunes(content, 277) + "..."
+	}
+
+	// Leave room for the URL (23 chars) and the separating blank line
+	availableSpace := 280 - 23 - 2
+	if calculateTwitterLength(content) > availableSpace {
+		content = truncateRunes(content, availableSpace-3) + "..."
+	}
+
+	return content + "\n\n" + noteURL
+}
+
 // buildTwitterPayload constructs the Twitter API v2 payload
 // Twitter API v2 only requires the text field for a simple tweet
 func buildTwitterPayload(postText string) map[string]interface{} {
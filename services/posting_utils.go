@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/rpupo63/unified-personal-site-backend/config"
+	"github.com/rpupo63/unified-personal-site-backend/models"
 )
 
 // FormatHashtag formats a tag value as a valid hashtag for social media platforms
@@ -88,3 +89,53 @@ func BuildBlogPostURL(baseURL, postID string) string {
 	}
 	return fmt.Sprintf("%s/blog/%s", strings.TrimSuffix(baseURL, "/"), postID)
 }
+
+// BlogPostLink returns the URL a social post on platform links to for blogPost
+// That is the post's own URL when set, otherwise one built from the configured base URL, or empty if neither is set
+func BlogPostLink(blogPost models.BlogPost, platform string) string {
+	if blogPost.URL != nil && *blogPost.URL != "" {
+		return *blogPost.URL
+	}
+	baseURL := GetBaseURL(config.New(), platform)
+	if baseURL == "" {
+		return ""
+	}
+	return BuildBlogPostURL(baseURL, blogPost.ID.String())
+}
+
+// BuildProjectURL constructs a project URL from base URL and project ID
+// Parameters:
+//   - baseURL: The base URL (e.g., "https://example.com")
+//   - projectID: The project ID (UUID string)
+//
+// Returns:
+//   - The full project URL (e.g., "https://example.com/projects/{projectID}")
+func BuildProjectURL(baseURL, projectID string) string {
+	if baseURL == "" || projectID == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/projects/%s", strings.TrimSuffix(baseURL, "/"), projectID)
+}
+
+// BuildNoteURL constructs a note URL from base URL and note ID
+// Parameters:
+//   - baseURL: The base URL (e.g., "https://example.com")
+//   - noteID: The note ID (UUID string)
+//
+// Returns:
+//   - The full note URL (e.g., "https://example.com/notes/{noteID}")
+func BuildNoteURL(baseURL, noteID string) string {
+	if baseURL == "" || noteID == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/notes/%s", strings.TrimSuffix(baseURL, "/"), noteID)
+}
+
+// truncateRunes shortens text to at most maxRunes characters without splitting a multi-byte character
+func truncateRunes(text string, maxRunes int) string {
+	runes := []rune(text)
+	if len(runes) <= maxRunes {
+		return text
+	}
+	return strings.TrimSpace(string(runes[:maxRunes]))
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/rpupo63/unified-personal-site-backend/config"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+)
+
+// PlatformPreview is what PostEverywhere would send to one platform
+type PlatformPreview struct {
+	Platform string
+	Title    string // Empty for Twitter and LinkedIn, whose posts have no title
+	Text     string // The tweet or LinkedIn update, or the body of the Medium or Substack post
+	Err      error  // Why the post couldn't be made, in which case Text is empty
+}
+
+// PreviewEverywhere builds the posts PostEverywhere would make, from the same text builders, without sending
+// anything or needing any platform's credentials. Previews are in the same order as PostEverywhere's results
+func PreviewEverywhere(blogPost models.BlogPost, tags []models.BlogTag, mainImageURL string, platformsToPost []string, links map[string]string) []PlatformPreview {
+	cfg := config.New()
+	var previews []PlatformPreview
+
+	if contains(platformsToPost, "substack") {
+		preview := PlatformPreview{Platform: "substack", Title: blogPost.Title}
+		if mainImageURL == "" {
+			preview.Err = fmt.Errorf("mainImageURL is required but not provided")
+		} else {
+			preview.Text = buildSubstackHtml(blogPost, tags, mainImageURL, GetBaseURL(cfg, ""))
+		}
+		previews = append(previews, preview)
+	}
+	if contains(platformsToPost, "medium") {
+		contentFormat := config.GetString(cfg, "MEDIUM_CONTENT_FORMAT", "html")
+		payload := buildMediumPayload(blogPost, tags, contentFormat, "draft", GetBaseURL(cfg, ""))
+		content, _ := payload["content"].(string)
+		previews = append(previews, PlatformPreview{Platform: "medium", Title: blogPost.Title, Text: content})
+	}
+	if contains(platformsToPost, "twitter") {
+		text := buildTwitterPostText(withLink(blogPost, links, "twitter"), tags, GetBaseURL(cfg, "twitter"))
+		previews = append(previews, PlatformPreview{Platform: "twitter", Text: text})
+	}
+	if contains(platformsToPost, "linkedin") {
+		// PostToLinkedIn falls back to the post's own tags when none are given
+		tagsToUse := tags
+		if len(tagsToUse) == 0 {
+			tagsToUse = blogPost.Tags
+		}
+		text := buildLinkedInPostText(withLink(blogPost, links, "linkedin"), tagsToUse, GetBaseURL(cfg, "linkedin"))
+		previews = append(previews, PlatformPreview{Platform: "linkedin", Text: text})
+	}
+	return previews
+}
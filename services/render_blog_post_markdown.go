@@ -0,0 +1,103 @@
+package services
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"go.yaml.in/yaml/v3"
+)
+
+// blogPostFrontmatter is the YAML frontmatter of an exported blog post
+// The keys are the ones Hugo, Jekyll and Astro read by default, so the file can be dropped into a static site
+type blogPostFrontmatter struct {
+	Title        string   `yaml:"title"`
+	Slug         string   `yaml:"slug"`
+	Summary      string   `yaml:"summary,omitempty"`
+	Date         string   `yaml:"date"`
+	Updated      string   `yaml:"updated,omitempty"`
+	PublishAt    string   `yaml:"publishAt,omitempty"`
+	Draft        bool     `yaml:"draft,omitempty"`
+	Tags         []string `yaml:"tags,omitempty"`
+	CanonicalURL string   `yaml:"canonicalURL,omitempty"`
+}
+
+// BlogPostSlug turns a title into a URL slug, e.g. "Hello, World!" into hello-world
+// Letters and digits are kept, lowercased, and every other run of characters becomes a hyphen
+func BlogPostSlug(title string) string {
+	var slug strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if hyphen && slug.Len() > 0 {
+				slug.WriteByte('-')
+			}
+			slug.WriteRune(r)
+			hyphen = false
+			continue
+		}
+		hyphen = true
+	}
+	return slug.String()
+}
+
+// RenderBlogPostMarkdown writes post to w as markdown preceded by YAML frontmatter
+// Posts that aren't published are marked as drafts, and scheduled ones carry their publishAt. Snippet shortcodes are
+// replaced with the code of the matching snippet in snippets, found with SnippetShortcodeIDs
+func RenderBlogPostMarkdown(post models.BlogPost, snippets map[uuid.UUID]models.Snippet, w io.Writer) error {
+	frontmatter := blogPostFrontmatter{
+		Title: post.Title,
+		Slug:  BlogPostSlug(post.Title),
+		Date:  post.DateAdded.UTC().Format(time.RFC3339),
+		Draft: post.Status != models.BlogPostStatusPublished,
+	}
+	if post.Summary != nil {
+		frontmatter.Summary = *post.Summary
+	}
+	if post.DateEdited != nil {
+		frontmatter.Updated = post.DateEdited.UTC().Format(time.RFC3339)
+	}
+	if post.Status == models.BlogPostStatusScheduled && post.PublishAt != nil {
+		frontmatter.PublishAt = post.PublishAt.UTC().Format(time.RFC3339)
+	}
+	if post.URL != nil {
+		frontmatter.CanonicalURL = *post.URL
+	}
+	for _, tag := range post.Tags {
+		frontmatter.Tags = append(frontmatter.Tags, tag.Value)
+	}
+
+	return writeFrontmatterMarkdown(w, frontmatter, RenderSnippetShortcodes(post.Content, snippets))
+}
+
+// ExportFileName names the file the content with title and id is exported to, after its slug, and adds it to taken
+// Different titles can share a slug, e.g. "Hello!" and "Hello?", so later ones get their id too
+func ExportFileName(title string, id uuid.UUID, taken map[string]bool) string {
+	name := BlogPostSlug(title)
+	if name == "" {
+		name = id.String()
+	} else if taken[name] {
+		name += "-" + id.String()
+	}
+	taken[name] = true
+	return name
+}
+
+// writeFrontmatterMarkdown writes body to w preceded by frontmatter encoded as YAML
+func writeFrontmatterMarkdown(w io.Writer, frontmatter any, body string) error {
+	var encoded strings.Builder
+	encoder := yaml.NewEncoder(&encoded)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(frontmatter); err != nil {
+		return fmt.Errorf("failed to encode frontmatter: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to encode frontmatter: %w", err)
+	}
+	_, err := fmt.Fprintf(w, "---\n%s---\n\n%s\n", encoded.String(), strings.TrimSpace(body))
+	return err
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"golang.org/x/net/html"
+)
+
+// markdownRenderer renders blog posts' Markdown, with GitHub's tables, task lists, strikethrough and autolinks
+// It isn't told the Markdown is safe, so raw HTML in it is left out and links and images with javascript:, vbscript:,
+// file: or non-image data: URLs lose them. What it renders can be served and cross-posted as is
+var markdownRenderer = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+)
+
+// RenderMarkdownHTML renders markdown as sanitized HTML
+func RenderMarkdownHTML(markdown string) (string, error) {
+	var rendered bytes.Buffer
+	if err := markdownRenderer.Convert([]byte(markdown), &rendered); err != nil {
+		return "", fmt.Errorf("failed to render markdown: %w", err)
+	}
+	return rendered.String(), nil
+}
+
+// RenderBlogPost works out what's stored alongside a blog post's Markdown content: its rendered HTML, word count and
+// reading time. It's called before every save that sets the content
+func RenderBlogPost(blogPost *models.BlogPost) error {
+	contentHTML, err := RenderMarkdownHTML(blogPost.Content)
+	if err != nil {
+		return err
+	}
+	blogPost.ContentHTML = contentHTML
+	blogPost.Measure()
+	return nil
+}
+
+// RenderProject renders a project's case study, as RenderBlogPost does a blog post's content. It's called before
+// every save that sets the case study
+func RenderProject(project *models.Project) error {
+	caseStudyHTML, err := RenderMarkdownHTML(project.CaseStudy)
+	if err != nil {
+		return err
+	}
+	project.CaseStudyHTML = caseStudyHTML
+	return nil
+}
+
+// blockElements end a paragraph of the plain text MarkdownPlainText makes, as do hr and br, which have no end tag
+var blockElements = map[string]bool{
+	"p": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "li": true, "pre": true,
+	"blockquote": true, "tr": true,
+}
+
+var blankLinesPattern = regexp.MustCompile(`\n{3,}`)
+
+// MarkdownPlainText is markdown's text without its markup, for platforms such as LinkedIn that only take plain text
+// Paragraphs, headings, list items and code blocks are separated by blank lines
+func MarkdownPlainText(markdown string) (string, error) {
+	rendered, err := RenderMarkdownHTML(markdown)
+	if err != nil {
+		return "", err
+	}
+
+	var text strings.Builder
+	tokenizer := html.NewTokenizer(strings.NewReader(rendered))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return strings.TrimSpace(blankLinesPattern.ReplaceAllString(text.String(), "\n\n")), nil
+		case html.TextToken:
+			text.Write(tokenizer.Text())
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if name, _ := tokenizer.TagName(); string(name) == "hr" || string(name) == "br" {
+				text.WriteString("\n\n")
+			}
+		case html.EndTagToken:
+			if name, _ := tokenizer.TagName(); blockElements[string(name)] {
+				text.WriteString("\n\n")
+			}
+		}
+	}
+}
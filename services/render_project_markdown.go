@@ -0,0 +1,48 @@
+package services
+
+import (
+	"io"
+	"time"
+
+	"github.com/rpupo63/unified-personal-site-backend/models"
+)
+
+// projectFrontmatter is the YAML frontmatter of an exported project
+// The keys follow the blog posts' frontmatter, with the project's links alongside
+type projectFrontmatter struct {
+	Title        string   `yaml:"title"`
+	Slug         string   `yaml:"slug"`
+	Type         string   `yaml:"type,omitempty"`
+	Date         string   `yaml:"date"`
+	Updated      string   `yaml:"updated,omitempty"`
+	GithubLink   string   `yaml:"githubLink,omitempty"`
+	DemoLink     string   `yaml:"demoLink,omitempty"`
+	GifLink      string   `yaml:"gifLink,omitempty"`
+	Tags         []string `yaml:"tags,omitempty"`
+	CanonicalURL string   `yaml:"canonicalURL,omitempty"`
+}
+
+// RenderProjectMarkdown writes project to w as its description preceded by YAML frontmatter holding the rest of it
+func RenderProjectMarkdown(project models.Project, w io.Writer) error {
+	frontmatter := projectFrontmatter{
+		Title:      project.Title,
+		Slug:       BlogPostSlug(project.Title),
+		Type:       project.Type,
+		Date:       project.DateAdded.UTC().Format(time.RFC3339),
+		GithubLink: project.GithubLink,
+		DemoLink:   project.DemoLink,
+	}
+	if project.DateEdited != nil {
+		frontmatter.Updated = project.DateEdited.UTC().Format(time.RFC3339)
+	}
+	if project.GifLink != nil {
+		frontmatter.GifLink = *project.GifLink
+	}
+	if project.CanonicalURL != nil {
+		frontmatter.CanonicalURL = *project.CanonicalURL
+	}
+	for _, tag := range project.Tags {
+		frontmatter.Tags = append(frontmatter.Tags, tag.Value)
+	}
+	return writeFrontmatterMarkdown(w, frontmatter, project.Description)
+}
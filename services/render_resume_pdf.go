@@ -0,0 +1,118 @@
+package services
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+)
+
+// ResumeContent holds the sections that are rendered into the resume PDF
+type ResumeContent struct {
+	Name           string
+	WorkExperience []models.WorkExperience
+	Education      []models.Education
+	Certifications []models.Certification
+}
+
+// RenderResumePDF renders the resume as a single-column A4 PDF and writes it to w
+// Sections with no entries are omitted from the document.
+func RenderResumePDF(content ResumeContent, w io.Writer) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(20, 20, 20)
+	pdf.SetAutoPageBreak(true, 20)
+	pdf.AddPage()
+
+	// fpdf's core fonts are Latin-1, so convert UTF-8 input before writing it
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	if content.Name != "" {
+		pdf.SetFont("Helvetica", "B", 20)
+		pdf.CellFormat(0, 10, tr(content.Name), "", 1, "L", false, 0, "")
+		pdf.Ln(4)
+	}
+
+	if len(content.WorkExperience) > 0 {
+		writeResumeSectionHeading(pdf, tr, "Experience")
+		for _, experience := range content.WorkExperience {
+			writeResumeEntry(pdf, tr,
+				fmt.Sprintf("%s - %s", experience.Role, experience.Company),
+				formatResumeDateRange(experience.StartDate, experience.EndDate),
+				experience.Description,
+				experience.Highlights,
+			)
+		}
+	}
+
+	if len(content.Education) > 0 {
+		writeResumeSectionHeading(pdf, tr, "Education")
+		for _, education := range content.Education {
+			title := fmt.Sprintf("%s - %s", education.Degree, education.Institution)
+			if education.FieldOfStudy != nil && *education.FieldOfStudy != "" {
+				title = fmt.Sprintf("%s, %s - %s", education.Degree, *education.FieldOfStudy, education.Institution)
+			}
+			writeResumeEntry(pdf, tr, title,
+				formatResumeDateRange(education.StartDate, education.EndDate),
+				education.Description,
+				nil,
+			)
+		}
+	}
+
+	if len(content.Certifications) > 0 {
+		writeResumeSectionHeading(pdf, tr, "Certifications")
+		for _, certification := range content.Certifications {
+			dateLabel := certification.IssueDate.Format("Jan 2006")
+			if certification.ExpiryDate != nil {
+				dateLabel = fmt.Sprintf("%s - %s", dateLabel, certification.ExpiryDate.Format("Jan 2006"))
+			}
+			writeResumeEntry(pdf, tr,
+				fmt.Sprintf("%s - %s", certification.Name, certification.Issuer),
+				dateLabel,
+				nil,
+				nil,
+			)
+		}
+	}
+
+	if err := pdf.Output(w); err != nil {
+		return fmt.Errorf("failed to render resume PDF: %w", err)
+	}
+	return nil
+}
+
+// writeResumeSectionHeading writes a bold section heading followed by a rule
+func writeResumeSectionHeading(pdf *fpdf.Fpdf, tr func(string) string, heading string) {
+	pdf.SetFont("Helvetica", "B", 14)
+	pdf.CellFormat(0, 8, tr(heading), "B", 1, "L", false, 0, "")
+	pdf.Ln(2)
+}
+
+// writeResumeEntry writes a single entry: title and date range on one line, then the description and any highlights as bullets
+func writeResumeEntry(pdf *fpdf.Fpdf, tr func(string) string, title, dateRange string, description *string, highlights []string) {
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.CellFormat(130, 6, tr(title), "", 0, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 6, tr(dateRange), "", 1, "R", false, 0, "")
+
+	if description != nil && *description != "" {
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.MultiCell(0, 5, tr(*description), "", "L", false)
+	}
+	for _, highlight := range highlights {
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.MultiCell(0, 5, tr("• "+highlight), "", "L", false)
+	}
+	pdf.Ln(3)
+}
+
+// formatResumeDateRange formats a start/end pair as "Jan 2020 - Present"
+func formatResumeDateRange(start time.Time, end *time.Time) string {
+	endLabel := "Present"
+	if end != nil {
+		endLabel = end.Format("Jan 2006")
+	}
+	return fmt.Sprintf("%s - %s", start.Format("Jan 2006"), endLabel)
+}
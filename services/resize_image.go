@@ -0,0 +1,147 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+
+	// GIFs are decoded for their first frame
+	_ "image/gif"
+)
+
+// maxResizePixels caps the images variants are made of, since a small file can decode to a huge image
+const maxResizePixels = 50_000_000
+
+// ThumbnailVariant names the square thumbnail among an image's variants
+const ThumbnailVariant = "thumbnail"
+
+// ImageVariantOptions says which variants ResizeImage makes of an image
+//   - Widths: the widths of the web-sized copies; those the image isn't wider than are skipped, as it's never scaled up
+//   - ThumbnailSize: the side of the square thumbnail cropped from the image's center, or 0 for none
+//   - Quality: the JPEG quality, from 1 to 100, of the copies of images without transparency
+type ImageVariantOptions struct {
+	Widths        []int
+	ThumbnailSize int
+	Quality       int
+}
+
+// ImageVariant is a resized copy of an image, encoded as ContentType
+type ImageVariant struct {
+	Name        string
+	Width       int
+	Height      int
+	ContentType string
+	Extension   string
+	Data        []byte
+}
+
+// ImageSize returns the width and height of the PNG, JPEG or GIF image in data, without decoding all of it
+func ImageSize(data []byte) (int, int, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read image size: %w", err)
+	}
+	return config.Width, config.Height, nil
+}
+
+// ResizeImage makes the variants options asks for of the PNG, JPEG or GIF image in data, named w{width} for the
+// web-sized copies and ThumbnailVariant for the thumbnail. Images with transparency are encoded as PNG and the rest
+// as JPEG; WebP isn't offered, as the standard library can't encode it. Animated GIFs only have their first frame
+// resized
+func ResizeImage(data []byte, options ImageVariantOptions) ([]ImageVariant, error) {
+	width, height, err := ImageSize(data)
+	if err != nil {
+		return nil, err
+	}
+	if width*height > maxResizePixels {
+		return nil, fmt.Errorf("image is %dx%d, over the %d megapixels variants are made of", width, height, maxResizePixels/1_000_000)
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	src := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(src, src.Bounds(), decoded, decoded.Bounds().Min, draw.Src)
+	opaque := src.Opaque()
+
+	var variants []ImageVariant
+	for _, variantWidth := range options.Widths {
+		if variantWidth <= 0 || variantWidth >= width {
+			continue
+		}
+		variantHeight := max(1, (height*variantWidth+width/2)/width)
+		variant, err := encodeVariant(fmt.Sprintf("w%d", variantWidth), scaleImage(src, src.Bounds(), variantWidth, variantHeight), opaque, options.Quality)
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, variant)
+	}
+
+	if options.ThumbnailSize > 0 {
+		side := min(width, height)
+		crop := image.Rect((width-side)/2, (height-side)/2, (width-side)/2+side, (height-side)/2+side)
+		size := min(options.ThumbnailSize, side)
+		variant, err := encodeVariant(ThumbnailVariant, scaleImage(src, crop, size, size), opaque, options.Quality)
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, variant)
+	}
+	return variants, nil
+}
+
+// encodeVariant encodes img as the variant name, as JPEG if it's opaque and PNG otherwise
+func encodeVariant(name string, img *image.RGBA, opaque bool, quality int) (ImageVariant, error) {
+	variant := ImageVariant{Name: name, Width: img.Bounds().Dx(), Height: img.Bounds().Dy()}
+	var buf bytes.Buffer
+	if opaque {
+		variant.ContentType, variant.Extension = "image/jpeg", ".jpg"
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: min(max(quality, 1), 100)}); err != nil {
+			return ImageVariant{}, fmt.Errorf("failed to encode %s: %w", name, err)
+		}
+	} else {
+		variant.ContentType, variant.Extension = "image/png", ".png"
+		encoder := png.Encoder{CompressionLevel: png.BestCompression}
+		if err := encoder.Encode(&buf, img); err != nil {
+			return ImageVariant{}, fmt.Errorf("failed to encode %s: %w", name, err)
+		}
+	}
+	variant.Data = buf.Bytes()
+	return variant, nil
+}
+
+// scaleImage shrinks the rect part of src to width by height, averaging the source pixels each destination pixel
+// covers. Averaging premultiplied colors keeps transparent pixels from darkening the edges next to them
+func scaleImage(src *image.RGBA, rect image.Rectangle, width, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	srcWidth, srcHeight := rect.Dx(), rect.Dy()
+	for y := 0; y < height; y++ {
+		y0 := rect.Min.Y + y*srcHeight/height
+		y1 := max(y0+1, rect.Min.Y+(y+1)*srcHeight/height)
+		for x := 0; x < width; x++ {
+			x0 := rect.Min.X + x*srcWidth/width
+			x1 := max(x0+1, rect.Min.X+(x+1)*srcWidth/width)
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[src.PixOffset(x0, sy):src.PixOffset(x1, sy)]
+				for i := 0; i < len(row); i += 4 {
+					r += uint64(row[i])
+					g += uint64(row[i+1])
+					b += uint64(row[i+2])
+					a += uint64(row[i+3])
+					count++
+				}
+			}
+			offset := dst.PixOffset(x, y)
+			dst.Pix[offset] = uint8((r + count/2) / count)
+			dst.Pix[offset+1] = uint8((g + count/2) / count)
+			dst.Pix[offset+2] = uint8((b + count/2) / count)
+			dst.Pix[offset+3] = uint8((a + count/2) / count)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Analytics providers that page views can be forwarded to instead of the built-in store
+const (
+	AnalyticsProviderPlausible = "plausible"
+	AnalyticsProviderUmami     = "umami"
+)
+
+// analyticsClient is used for all analytics events; they're fire-and-forget, so a slow instance is cut off quickly
+var analyticsClient = &http.Client{Timeout: 5 * time.Second}
+
+// AnalyticsEvent is a server-side event to forward to a Plausible or Umami instance
+// URL is the absolute URL of the page, including any UTM parameters the provider should read.
+// UserAgent and ClientIP are the visitor's, not the server's; both providers use them to count unique visitors
+type AnalyticsEvent struct {
+	Name      string
+	URL       string
+	Referrer  string
+	UserAgent string
+	ClientIP  string
+}
+
+// SendAnalyticsEvent forwards event to the self-hosted analytics instance at instanceURL
+// Parameters:
+//   - provider: AnalyticsProviderPlausible or AnalyticsProviderUmami
+//   - instanceURL: Base URL of the instance (e.g., "https://plausible.example.com")
+//   - siteID: The site's domain as registered in Plausible, or the Umami website ID
+//
+// Any non-2xx response is returned as an error
+func SendAnalyticsEvent(ctx context.Context, provider, instanceURL, siteID string, event AnalyticsEvent) error {
+	instanceURL = strings.TrimSuffix(instanceURL, "/")
+
+	var endpoint string
+	var payload interface{}
+	switch provider {
+	case AnalyticsProviderPlausible:
+		endpoint = instanceURL + "/api/event"
+		payload = map[string]interface{}{
+			"name":     event.Name,
+			"url":      event.URL,
+			"domain":   siteID,
+			"referrer": event.Referrer,
+		}
+	case AnalyticsProviderUmami:
+		parsed, err := url.Parse(event.URL)
+		if err != nil {
+			return fmt.Errorf("invalid analytics event URL %q: %w", event.URL, err)
+		}
+		eventPayload := map[string]interface{}{
+			"website":  siteID,
+			"hostname": parsed.Hostname(),
+			"url":      parsed.RequestURI(),
+			"referrer": event.Referrer,
+		}
+		// Umami records an event without a name as a page view
+		if event.Name != "pageview" {
+			eventPayload["name"] = event.Name
+		}
+		endpoint = instanceURL + "/api/send"
+		payload = map[string]interface{}{
+			"type":    "event",
+			"payload": eventPayload,
+		}
+	default:
+		return fmt.Errorf("unknown analytics provider %q", provider)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal analytics event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create analytics request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", event.UserAgent)
+	if event.ClientIP != "" {
+		req.Header.Set("X-Forwarded-For", event.ClientIP)
+	}
+
+	resp, err := analyticsClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send analytics event to %s: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	// Drain a little of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("analytics instance %s returned status %d", endpoint, resp.StatusCode)
+	}
+	return nil
+}
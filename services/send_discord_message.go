@@ -0,0 +1,44 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// discordMessageLimit is the most characters Discord accepts in a message's content
+const discordMessageLimit = 2000
+
+// SendDiscordMessage posts content to a Discord channel through one of its incoming webhook URLs
+// Content longer than Discord allows is cut short
+func SendDiscordMessage(ctx context.Context, webhookURL, content string) error {
+	if runes := []rune(content); len(runes) > discordMessageLimit {
+		content = string(runes[:discordMessageLimit-1]) + "…"
+	}
+
+	body, err := json.Marshal(map[string]string{"content": content})
+	if err != nil {
+		return fmt.Errorf("failed to encode Discord message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create Discord request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send Discord message: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("discord returned status %d", resp.StatusCode)
+	}
+	return nil
+}
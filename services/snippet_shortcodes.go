@@ -0,0 +1,63 @@
+package services
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rpupo63/unified-personal-site-backend/models"
+)
+
+// snippetShortcode matches a {{< snippet id >}} shortcode on a line of its own, so one quoted inline, such as in
+// backticks, is left alone
+var snippetShortcode = regexp.MustCompile(`(?m)^[ \t]*\{\{<\s*snippet\s+([0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12})\s*>\}\}[ \t]*$`)
+
+// SnippetShortcodeIDs returns the IDs of the snippets content embeds, each once, in the order they first appear
+func SnippetShortcodeIDs(content string) []uuid.UUID {
+	var ids []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, match := range snippetShortcode.FindAllStringSubmatch(content, -1) {
+		id, err := uuid.Parse(match[1])
+		if err != nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// RenderSnippetShortcodes replaces each snippet shortcode in content with a fenced code block of the snippet's code,
+// tagged with its language. Shortcodes for snippets that aren't in snippets, such as deleted ones, are kept as they are
+func RenderSnippetShortcodes(content string, snippets map[uuid.UUID]models.Snippet) string {
+	if len(snippets) == 0 {
+		return content
+	}
+	return snippetShortcode.ReplaceAllStringFunc(content, func(shortcode string) string {
+		id, err := uuid.Parse(snippetShortcode.FindStringSubmatch(shortcode)[1])
+		if err != nil {
+			return shortcode
+		}
+		snippet, ok := snippets[id]
+		if !ok {
+			return shortcode
+		}
+		return snippetCodeBlock(snippet)
+	})
+}
+
+// snippetCodeBlock fences snippet's code with one more backtick than the longest run in it, so backticks in the code
+// can't close the block early
+func snippetCodeBlock(snippet models.Snippet) string {
+	longest, run := 0, 0
+	for _, r := range snippet.Code {
+		if r == '`' {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	fence := strings.Repeat("`", max(3, longest+1))
+	return fence + strings.ToLower(strings.TrimSpace(snippet.Language)) + "\n" + strings.TrimRight(snippet.Code, "\n") + "\n" + fence
+}
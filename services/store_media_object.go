@@ -0,0 +1,219 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Object stores that media uploaded with POST /media can be kept in
+const (
+	MediaStorageSupabase = "supabase"
+	MediaStorageS3       = "s3"
+)
+
+// MaxMediaUploadSize is the default limit on a file uploaded with POST /media
+const MaxMediaUploadSize = 25 << 20 // 25MB
+
+// mediaUploadTypes are the types of file POST /media accepts, with the extension each is stored under. Like the
+// images markdown posts upload, SVG and HTML are left out, since they can carry scripts
+var mediaUploadTypes = map[string]string{
+	"image/png":       ".png",
+	"image/jpeg":      ".jpg",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+	"video/mp4":       ".mp4",
+	"video/webm":      ".webm",
+	"audio/mpeg":      ".mp3",
+}
+
+// mediaStorageClient is used for every object store request; uploads can be large, so it's given longer than most
+var mediaStorageClient = &http.Client{Timeout: 2 * time.Minute}
+
+// MediaStorage is the bucket uploaded media is kept in
+// Parameters:
+//   - Provider: MediaStorageSupabase or MediaStorageS3
+//   - Endpoint: the Supabase project URL (e.g., "https://abc.supabase.co"), or the S3 endpoint (e.g.,
+//     "https://s3.us-east-1.amazonaws.com"); S3 buckets are addressed by path, which every S3-compatible store supports
+//   - Bucket: the bucket, which must allow public reads
+//   - Region: the S3 region requests are signed for; unused for Supabase
+//   - AccessKeyID, SecretKey: the S3 credentials, or for Supabase only SecretKey, its service role key
+//   - PublicBaseURL: where the bucket's objects are served from, when not the provider's own public URL, such as a CDN
+type MediaStorage struct {
+	Provider      string
+	Endpoint      string
+	Bucket        string
+	Region        string
+	AccessKeyID   string
+	SecretKey     string
+	PublicBaseURL string
+}
+
+// CheckMediaUpload makes sure the file in data, named name in errors, is no larger than maxSize and of a type POST
+// /media accepts, and returns its content type, sniffed from data, and the extension it's stored under
+func CheckMediaUpload(name string, data []byte, maxSize int) (string, string, error) {
+	if len(data) > maxSize {
+		return "", "", fmt.Errorf("%s is over %d MB", name, maxSize>>20)
+	}
+	contentType := http.DetectContentType(data)
+	extension, ok := mediaUploadTypes[contentType]
+	if !ok {
+		return "", "", fmt.Errorf("%s is %s, not a PNG, JPEG, GIF or WebP image, a PDF, an MP4 or WebM video or an MP3", name, contentType)
+	}
+	return contentType, extension, nil
+}
+
+// PublicURL returns the URL the object stored under key is served from
+func (s MediaStorage) PublicURL(key string) string {
+	escaped := escapeObjectKey(key)
+	if s.PublicBaseURL != "" {
+		return strings.TrimSuffix(s.PublicBaseURL, "/") + "/" + escaped
+	}
+	if s.Provider == MediaStorageSupabase {
+		return s.storageURL("/storage/v1/object/public/" + url.PathEscape(s.Bucket) + "/" + escaped)
+	}
+	return s.objectURL(key)
+}
+
+// PutMediaObject stores data in storage's bucket under key, replacing any object already there. Objects are named
+// by what they hold, never change, and so are cached for a year
+func PutMediaObject(ctx context.Context, storage MediaStorage, key, contentType string, data []byte) error {
+	req, err := storage.newObjectRequest(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if storage.Provider == MediaStorageSupabase {
+		req.Header.Set("Cache-Control", "max-age=31536000")
+		req.Header.Set("x-upsert", "true")
+	} else {
+		req.Header.Set("Cache-Control", "public, max-age=31536000, immutable")
+	}
+	return storage.do(req, "upload")
+}
+
+// DeleteMediaObject removes the object stored under key from storage's bucket. The object being gone already isn't
+// an error, so a delete that failed halfway can be retried
+func DeleteMediaObject(ctx context.Context, storage MediaStorage, key string) error {
+	req, err := storage.newObjectRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	return storage.do(req, "delete")
+}
+
+// newObjectRequest builds a request to the object under key, authorized for storage's provider
+func (s MediaStorage) newObjectRequest(ctx context.Context, method, key string, data []byte) (*http.Request, error) {
+	switch s.Provider {
+	case MediaStorageSupabase:
+		// Supabase's PUT updates an existing object, so uploads POST with x-upsert instead
+		if method == http.MethodPut {
+			method = http.MethodPost
+		}
+		req, err := http.NewRequestWithContext(ctx, method, s.storageURL("/storage/v1/object/"+url.PathEscape(s.Bucket)+"/"+escapeObjectKey(key)), bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create storage request: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+s.SecretKey)
+		req.Header.Set("apikey", s.SecretKey)
+		return req, nil
+	case MediaStorageS3:
+		req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key), bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create storage request: %w", err)
+		}
+		s.signS3Request(req, data, time.Now())
+		return req, nil
+	default:
+		return nil, fmt.Errorf("unknown media storage provider %q", s.Provider)
+	}
+}
+
+// do sends req, returning an error for any response but a 2xx, or for a delete a 404
+func (s MediaStorage) do(req *http.Request, action string) error {
+	resp, err := mediaStorageClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to %s media: %w", action, err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+
+	if req.Method == http.MethodDelete && resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s storage returned status %d on %s: %s", s.Provider, resp.StatusCode, action, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// storageURL returns path on the Supabase project
+func (s MediaStorage) storageURL(path string) string {
+	return strings.TrimSuffix(s.Endpoint, "/") + path
+}
+
+// objectURL returns the path-style S3 URL of the object under key
+func (s MediaStorage) objectURL(key string) string {
+	return strings.TrimSuffix(s.Endpoint, "/") + "/" + url.PathEscape(s.Bucket) + "/" + escapeObjectKey(key)
+}
+
+// signS3Request signs req, whose body is payload, with AWS Signature Version 4 as of now
+func (s MediaStorage) signS3Request(req *http.Request, payload []byte, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+	payloadHash := sha256Hex(payload)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host + "\nx-amz-content-sha256:" + payloadHash + "\nx-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := day + "/" + s.Region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+s.SecretKey), day)
+	key = hmacSHA256(key, s.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+s.AccessKeyID+"/"+scope+", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+// escapeObjectKey escapes each segment of key for a URL path, keeping the slashes between them
+func escapeObjectKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"sync/atomic"
+
+	"github.com/rpupo63/unified-personal-site-backend/models"
+	"github.com/rs/zerolog/log"
+)
+
+// storedCredentials loads the credentials saved in the database, once UseStoredCredentials has been called
+var storedCredentials atomic.Pointer[func() ([]models.Credential, error)]
+
+// UseStoredCredentials makes posting and CheckCredentials prefer the credentials load returns over the environment
+// variables they're named after. They're loaded on every post, so one saved by refresh-substack is picked up by a
+// running server
+func UseStoredCredentials(load func() ([]models.Credential, error)) {
+	storedCredentials.Store(&load)
+}
+
+// loadStoredCredentials returns the stored credentials, or none when they can't be loaded, so posting falls back to
+// the environment rather than failing
+func loadStoredCredentials() []models.Credential {
+	load := storedCredentials.Load()
+	if load == nil {
+		return nil
+	}
+	credentials, err := (*load)()
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to load stored credentials, using environment variables")
+		return nil
+	}
+	return credentials
+}
+
+// withStoredCredentials returns cfg with the stored credentials in place of the environment variables they're named
+// after
+func withStoredCredentials(cfg map[string]string) map[string]string {
+	return overlayCredentials(cfg, loadStoredCredentials())
+}
+
+func overlayCredentials(cfg map[string]string, credentials []models.Credential) map[string]string {
+	if len(credentials) == 0 {
+		return cfg
+	}
+	merged := make(map[string]string, len(cfg)+len(credentials))
+	for key, value := range cfg {
+		merged[key] = value
+	}
+	for _, credential := range credentials {
+		merged[credential.Name] = credential.Value
+	}
+	return merged
+}
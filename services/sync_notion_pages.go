@@ -0,0 +1,411 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	notionAPIURL = "https://api.notion.com/v1"
+	// notionVersion pins the shape of Notion's responses
+	notionVersion = "2022-06-28"
+)
+
+var notionClient = &http.Client{Timeout: 30 * time.Second}
+
+// NotionPage is a page in a Notion database, read as a blog post draft
+type NotionPage struct {
+	ID           string
+	Title        string
+	Summary      string // From a rich text property named Summary, if the database has one
+	Tags         []string
+	LastEditedAt time.Time
+	Archived     bool // Archived or moved to the trash
+}
+
+type notionRichText struct {
+	PlainText   string `json:"plain_text"`
+	Href        string `json:"href"`
+	Annotations struct {
+		Bold          bool `json:"bold"`
+		Italic        bool `json:"italic"`
+		Strikethrough bool `json:"strikethrough"`
+		Code          bool `json:"code"`
+	} `json:"annotations"`
+}
+
+type notionProperty struct {
+	Type        string           `json:"type"`
+	Title       []notionRichText `json:"title"`
+	RichText    []notionRichText `json:"rich_text"`
+	MultiSelect []struct {
+		Name string `json:"name"`
+	} `json:"multi_select"`
+}
+
+type notionQueryResponse struct {
+	Results []struct {
+		ID             string                    `json:"id"`
+		LastEditedTime time.Time                 `json:"last_edited_time"`
+		Archived       bool                      `json:"archived"`
+		InTrash        bool                      `json:"in_trash"`
+		Properties     map[string]notionProperty `json:"properties"`
+	} `json:"results"`
+	HasMore    bool   `json:"has_more"`
+	NextCursor string `json:"next_cursor"`
+}
+
+// notionBlock is a block of a Notion page, with its type-specific fields kept in Content
+type notionBlock struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"`
+	HasChildren bool            `json:"has_children"`
+	Content     json.RawMessage `json:"-"`
+	children    []notionBlock
+}
+
+// notionBlockContent holds the type-specific fields of the block types that are converted to markdown
+type notionBlockContent struct {
+	RichText []notionRichText `json:"rich_text"`
+	Caption  []notionRichText `json:"caption"`
+	Language string           `json:"language"`
+	Checked  bool             `json:"checked"`
+	URL      string           `json:"url"`
+	Type     string           `json:"type"`
+	External struct {
+		URL string `json:"url"`
+	} `json:"external"`
+	File struct {
+		URL string `json:"url"`
+	} `json:"file"`
+	Expression string `json:"expression"`
+}
+
+type notionChildrenResponse struct {
+	Results    []json.RawMessage `json:"results"`
+	HasMore    bool              `json:"has_more"`
+	NextCursor string            `json:"next_cursor"`
+}
+
+// QueryNotionDatabase lists every page in the Notion database with databaseID, most recently edited first
+// token is an internal integration secret, and the database has to be shared with that integration
+func QueryNotionDatabase(ctx context.Context, token, databaseID string) ([]NotionPage, error) {
+	var pages []NotionPage
+	cursor := ""
+	for {
+		query := map[string]any{
+			"page_size": 100,
+			"sorts":     []map[string]string{{"timestamp": "last_edited_time", "direction": "descending"}},
+		}
+		if cursor != "" {
+			query["start_cursor"] = cursor
+		}
+
+		var response notionQueryResponse
+		if err := notionRequest(ctx, token, http.MethodPost, "/databases/"+url.PathEscape(databaseID)+"/query", query, &response); err != nil {
+			return nil, err
+		}
+
+		for _, result := range response.Results {
+			page := NotionPage{
+				ID:           result.ID,
+				LastEditedAt: result.LastEditedTime,
+				Archived:     result.Archived || result.InTrash,
+			}
+			for name, property := range result.Properties {
+				switch {
+				case property.Type == "title":
+					page.Title = strings.TrimSpace(plainText(property.Title))
+				case property.Type == "rich_text" && strings.EqualFold(name, "Summary"):
+					page.Summary = strings.TrimSpace(plainText(property.RichText))
+				case property.Type == "multi_select" && strings.EqualFold(name, "Tags"):
+					for _, option := range property.MultiSelect {
+						page.Tags = append(page.Tags, option.Name)
+					}
+				}
+			}
+			pages = append(pages, page)
+		}
+
+		if !response.HasMore {
+			return pages, nil
+		}
+		cursor = response.NextCursor
+	}
+}
+
+// FetchNotionPageMarkdown converts the blocks of the page with pageID to markdown
+// Files uploaded to Notion are linked with the signed URL Notion returns, which expires after an hour, so images
+// should be embedded from elsewhere
+func FetchNotionPageMarkdown(ctx context.Context, token, pageID string) (string, error) {
+	blocks, err := fetchNotionBlocks(ctx, token, pageID)
+	if err != nil {
+		return "", err
+	}
+	var markdown strings.Builder
+	writeNotionBlocks(&markdown, blocks, "")
+	return strings.TrimSpace(markdown.String()), nil
+}
+
+// fetchNotionBlocks returns the children of the block with blockID, with their own children filled in
+func fetchNotionBlocks(ctx context.Context, token, blockID string) ([]notionBlock, error) {
+	var blocks []notionBlock
+	cursor := ""
+	for {
+		path := "/blocks/" + url.PathEscape(blockID) + "/children?page_size=100"
+		if cursor != "" {
+			path += "&start_cursor=" + url.QueryEscape(cursor)
+		}
+
+		var response notionChildrenResponse
+		if err := notionRequest(ctx, token, http.MethodGet, path, nil, &response); err != nil {
+			return nil, err
+		}
+
+		for _, raw := range response.Results {
+			var block notionBlock
+			if err := json.Unmarshal(raw, &block); err != nil {
+				return nil, fmt.Errorf("failed to parse Notion block: %w", err)
+			}
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &fields); err != nil {
+				return nil, fmt.Errorf("failed to parse Notion block: %w", err)
+			}
+			block.Content = fields[block.Type]
+
+			// Child pages and databases are documents of their own, not part of this page's body
+			if block.HasChildren && block.Type != "child_page" && block.Type != "child_database" {
+				children, err := fetchNotionBlocks(ctx, token, block.ID)
+				if err != nil {
+					return nil, err
+				}
+				block.children = children
+			}
+			blocks = append(blocks, block)
+		}
+
+		if !response.HasMore {
+			return blocks, nil
+		}
+		cursor = response.NextCursor
+	}
+}
+
+// notionBlockTypes are the block types converted to markdown
+// Other blocks are left out, except that the contents of containers such as columns and synced blocks are kept
+var notionBlockTypes = map[string]bool{
+	"paragraph": true, "heading_1": true, "heading_2": true, "heading_3": true,
+	"bulleted_list_item": true, "numbered_list_item": true, "to_do": true, "quote": true, "callout": true,
+	"toggle": true, "code": true, "equation": true, "divider": true, "image": true,
+	"bookmark": true, "embed": true, "link_preview": true, "video": true,
+}
+
+// writeNotionBlocks writes blocks as markdown, with indent before every line of nested blocks
+func writeNotionBlocks(markdown *strings.Builder, blocks []notionBlock, indent string) {
+	number := 0
+	previous := ""
+	for _, block := range blocks {
+		// List items of the same list sit on consecutive lines; every other block is its own paragraph
+		separate := func() {
+			kind := notionListKind(block.Type)
+			if previous != "" && (kind == "" || kind != notionListKind(previous)) {
+				markdown.WriteString(strings.TrimRight(indent, " ") + "\n")
+			}
+			previous = block.Type
+		}
+
+		if !notionBlockTypes[block.Type] {
+			var inner strings.Builder
+			writeNotionBlocks(&inner, block.children, indent)
+			if inner.Len() > 0 {
+				separate()
+				markdown.WriteString(inner.String())
+			}
+			continue
+		}
+		separate()
+
+		var content notionBlockContent
+		if len(block.Content) > 0 {
+			_ = json.Unmarshal(block.Content, &content)
+		}
+		text := richTextMarkdown(content.RichText)
+
+		if block.Type == "numbered_list_item" {
+			number++
+		} else {
+			number = 0
+		}
+
+		childIndent := indent
+		switch block.Type {
+		case "paragraph":
+			writeNotionLines(markdown, indent, text)
+		case "heading_1":
+			markdown.WriteString(indent + "# " + text + "\n")
+		case "heading_2":
+			markdown.WriteString(indent + "## " + text + "\n")
+		case "heading_3":
+			markdown.WriteString(indent + "### " + text + "\n")
+		case "bulleted_list_item":
+			writeNotionLines(markdown, indent, "- "+text)
+			childIndent = indent + "  "
+		case "numbered_list_item":
+			writeNotionLines(markdown, indent, fmt.Sprintf("%d. %s", number, text))
+			childIndent = indent + "   "
+		case "to_do":
+			box := "[ ]"
+			if content.Checked {
+				box = "[x]"
+			}
+			writeNotionLines(markdown, indent, "- "+box+" "+text)
+			childIndent = indent + "  "
+		case "quote", "callout":
+			writeNotionLines(markdown, indent+"> ", text)
+			childIndent = indent + "> "
+		case "toggle":
+			writeNotionLines(markdown, indent, "**"+text+"**")
+		case "code":
+			markdown.WriteString(indent + "```" + content.Language + "\n")
+			writeNotionLines(markdown, indent, plainText(content.RichText))
+			markdown.WriteString(indent + "```\n")
+		case "equation":
+			markdown.WriteString(indent + "$$\n" + indent + content.Expression + "\n" + indent + "$$\n")
+		case "divider":
+			markdown.WriteString(indent + "---\n")
+		case "image":
+			source := content.External.URL
+			if content.Type == "file" {
+				source = content.File.URL
+			}
+			markdown.WriteString(indent + "![" + plainText(content.Caption) + "](" + source + ")\n")
+		case "bookmark", "embed", "link_preview", "video":
+			link := content.URL
+			if link == "" {
+				link = content.External.URL
+			}
+			label := plainText(content.Caption)
+			if label == "" {
+				label = link
+			}
+			markdown.WriteString(indent + "[" + label + "](" + link + ")\n")
+		}
+
+		if len(block.children) > 0 {
+			if notionListKind(block.Type) == "" {
+				markdown.WriteString(strings.TrimRight(childIndent, " ") + "\n")
+			}
+			writeNotionBlocks(markdown, block.children, childIndent)
+		}
+	}
+}
+
+// notionListKind returns which markdown list a block is an item of, or "" if it isn't a list item
+func notionListKind(blockType string) string {
+	switch blockType {
+	case "bulleted_list_item", "to_do":
+		return "bulleted"
+	case "numbered_list_item":
+		return "numbered"
+	}
+	return ""
+}
+
+// writeNotionLines writes text with prefix before each of its lines
+func writeNotionLines(markdown *strings.Builder, prefix, text string) {
+	for _, line := range strings.Split(text, "\n") {
+		markdown.WriteString(prefix + line + "\n")
+	}
+}
+
+func plainText(richText []notionRichText) string {
+	var text strings.Builder
+	for _, part := range richText {
+		text.WriteString(part.PlainText)
+	}
+	return text.String()
+}
+
+// richTextMarkdown converts Notion rich text to inline markdown, keeping links and emphasis
+func richTextMarkdown(richText []notionRichText) string {
+	var text strings.Builder
+	for _, part := range richText {
+		value := part.PlainText
+		// Markdown emphasis can't wrap surrounding whitespace, so it's moved outside the markers
+		trimmed := strings.TrimSpace(value)
+		if trimmed == "" {
+			text.WriteString(value)
+			continue
+		}
+		leading := value[:strings.Index(value, trimmed)]
+		trailing := value[len(leading)+len(trimmed):]
+
+		if part.Annotations.Code {
+			trimmed = "`" + trimmed + "`"
+		}
+		if part.Annotations.Bold {
+			trimmed = "**" + trimmed + "**"
+		}
+		if part.Annotations.Italic {
+			trimmed = "_" + trimmed + "_"
+		}
+		if part.Annotations.Strikethrough {
+			trimmed = "~~" + trimmed + "~~"
+		}
+		if part.Href != "" {
+			trimmed = "[" + trimmed + "](" + part.Href + ")"
+		}
+		text.WriteString(leading + trimmed + trailing)
+	}
+	return text.String()
+}
+
+// notionRequest sends a request to the Notion API and decodes its JSON response into out
+func notionRequest(ctx context.Context, token, method, path string, body, out any) error {
+	var bodyReader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode Notion request: %w", err)
+		}
+		bodyReader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, notionAPIURL+path, bodyReader)
+	if err != nil {
+		return fmt.Errorf("failed to create Notion request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Notion-Version", notionVersion)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := notionClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request to Notion: %w", err)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read Notion response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("notion API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	if err := json.Unmarshal(bodyBytes, out); err != nil {
+		return fmt.Errorf("failed to parse Notion response: %w", err)
+	}
+	return nil
+}
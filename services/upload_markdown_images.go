@@ -0,0 +1,146 @@
+package services
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// MaxMediaFileSize is the largest image a markdown post can have uploaded
+const MaxMediaFileSize = 10 << 20 // 10MB
+
+// mediaContentTypes are the image types that are uploaded. SVG is left out, since it can carry scripts that would run
+// on the API's origin
+var mediaContentTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// markdownImage matches ![alt](target) and ![alt](target "title") images, with the target optionally in <>
+var markdownImage = regexp.MustCompile(`!\[([^\]]*)\]\(\s*(<[^>]+>|[^)\s]+)(\s+"[^"]*")?\s*\)`)
+
+// embedImageExtensions are the file types an Obsidian ![[file]] embed is treated as an image for
+var embedImageExtensions = map[string]bool{".png": true, ".jpg": true, ".jpeg": true, ".gif": true, ".webp": true}
+
+// UploadLocalImages finds the images content links to by a path on disk rather than a URL, resolved against dir, and
+// has upload store each one, linking to the URL it returns instead. Obsidian's ![[image.png]] embeds, which
+// ParseMarkdownNote keeps, become markdown images. Paths starting with / are only taken as files when one exists
+// there, since they're otherwise links relative to the site. Each file is uploaded once however often it's linked
+func UploadLocalImages(content, dir string, upload func(fileName, contentType string, data []byte) (string, error)) (string, error) {
+	uploaded := make(map[string]string)
+	uploadPath := func(target string) (string, bool, error) {
+		path, ok := localImagePath(dir, target)
+		if !ok {
+			return "", false, nil
+		}
+		if mediaURL, done := uploaded[path]; done {
+			return mediaURL, true, nil
+		}
+		data, contentType, err := readMediaFile(path)
+		if err != nil {
+			return "", false, err
+		}
+		mediaURL, err := upload(filepath.Base(path), contentType, data)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to upload %s: %w", path, err)
+		}
+		uploaded[path] = mediaURL
+		return mediaURL, true, nil
+	}
+
+	var uploadErr error
+	content = markdownImage.ReplaceAllStringFunc(content, func(image string) string {
+		match := markdownImage.FindStringSubmatch(image)
+		target := strings.TrimSuffix(strings.TrimPrefix(match[2], "<"), ">")
+		mediaURL, ok, err := uploadPath(target)
+		if err != nil && uploadErr == nil {
+			uploadErr = err
+		}
+		if !ok {
+			return image
+		}
+		return fmt.Sprintf("![%s](%s%s)", match[1], mediaURL, match[3])
+	})
+	content = wikiLink.ReplaceAllStringFunc(content, func(link string) string {
+		match := wikiLink.FindStringSubmatch(link)
+		if match[1] != "!" || !embedImageExtensions[strings.ToLower(filepath.Ext(match[2]))] {
+			return link
+		}
+		mediaURL, ok, err := uploadPath(match[2])
+		if err != nil && uploadErr == nil {
+			uploadErr = err
+		}
+		if !ok {
+			return link
+		}
+		// An embed's label is its alt text, unless it's a size such as ![[image.png|300]]
+		alt := match[3]
+		if strings.Trim(alt, "0123456789x") == "" {
+			alt = ""
+		}
+		return fmt.Sprintf("![%s](%s)", alt, mediaURL)
+	})
+	if uploadErr != nil {
+		return "", uploadErr
+	}
+	return content, nil
+}
+
+// localImagePath returns the file target points at, if it's a path rather than a URL
+func localImagePath(dir, target string) (string, bool) {
+	parsed, err := url.Parse(target)
+	if err != nil || parsed.Scheme != "" || parsed.Host != "" || strings.HasPrefix(target, "#") {
+		return "", false
+	}
+	// Markdown editors escape spaces in paths as %20
+	path, err := url.PathUnescape(parsed.Path)
+	if err != nil || path == "" {
+		return "", false
+	}
+	if filepath.IsAbs(path) {
+		if _, err := os.Stat(path); err != nil {
+			return "", false
+		}
+		return path, true
+	}
+	return filepath.Join(dir, filepath.FromSlash(path)), true
+}
+
+// readMediaFile reads the image at path, making sure it's small enough and of a type that's uploaded
+func readMediaFile(path string) ([]byte, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open image: %w", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, MaxMediaFileSize+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	contentType, err := CheckMediaFile(path, data)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, contentType, nil
+}
+
+// CheckMediaFile makes sure the image in data, named name in errors, is small enough and of a type that's uploaded,
+// and returns its content type, sniffed from data rather than trusted from wherever the file came from
+func CheckMediaFile(name string, data []byte) (string, error) {
+	if len(data) > MaxMediaFileSize {
+		return "", fmt.Errorf("%s is over %d MB", name, MaxMediaFileSize>>20)
+	}
+	contentType := http.DetectContentType(data)
+	if !mediaContentTypes[contentType] {
+		return "", fmt.Errorf("%s is %s, not a PNG, JPEG, GIF or WebP image", name, contentType)
+	}
+	return contentType, nil
+}